@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/spf13/cobra"
+)
+
+// accountsOpts holds the flags shared by the accounts commands
+var accountsOpts struct {
+	server    string
+	portfolio string
+}
+
+// accountsSetOpts holds the accounts set command flags
+var accountsSetOpts struct {
+	name         string
+	accountType  string
+	taxTreatment string
+	custodian    string
+	currency     string
+}
+
+// accountsCmd groups the account commands
+var accountsCmd = &cobra.Command{
+	Use:   "accounts",
+	Short: "Manage the accounts of a portfolio and their tax treatment",
+}
+
+// accountsListCmd lists the accounts of a portfolio
+var accountsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List accounts with their tax treatment and balances",
+	Long: `List the registered accounts of a portfolio and the accounts its transactions
+refer to. Accounts that were never registered are taxable accounts in the
+portfolio currency.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient(accountsOpts.server)
+		if err != nil {
+			return err
+		}
+		res, err := c.ListAccounts()(context.Background(), &genportfolio.ListAccountsPayload{PortfolioID: accountsOpts.portfolio})
+		if err != nil {
+			return err
+		}
+		return printAccounts(cmd.OutOrStdout(), res.([]*genportfolio.Account))
+	},
+}
+
+// accountsSetCmd registers or updates an account
+var accountsSetCmd = &cobra.Command{
+	Use:   "set ID",
+	Short: "Register or update an account",
+	Long: `Register an account with its type, tax treatment, custodian and base currency,
+or update it. The tax treatment defaults to that of the type: ira and 401k are
+tax deferred, roth_ira, roth_401k and hsa tax exempt. Gains realized in
+tax-advantaged accounts are left out of tax reports and loss harvesting. Use ""
+for the default account.`,
+	Example: `  portfolio-server accounts set ira --type ira --name "Rollover IRA" --custodian Vanguard
+  portfolio-server accounts set hsa --type hsa --currency USD`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient(accountsOpts.server)
+		if err != nil {
+			return err
+		}
+		p := &genportfolio.SetAccountPayload{
+			PortfolioID: accountsOpts.portfolio,
+			ID:          args[0],
+			Type:        accountsSetOpts.accountType,
+		}
+		if cmd.Flags().Changed("name") {
+			p.Name = &accountsSetOpts.name
+		}
+		if cmd.Flags().Changed("tax-treatment") {
+			p.TaxTreatment = &accountsSetOpts.taxTreatment
+		}
+		if cmd.Flags().Changed("custodian") {
+			p.Custodian = &accountsSetOpts.custodian
+		}
+		if cmd.Flags().Changed("currency") {
+			p.Currency = &accountsSetOpts.currency
+		}
+		res, err := c.SetAccount()(context.Background(), p)
+		if err != nil {
+			return err
+		}
+		return printAccounts(cmd.OutOrStdout(), []*genportfolio.Account{res.(*genportfolio.Account)})
+	},
+}
+
+// accountsRemoveCmd removes a registered account
+var accountsRemoveCmd = &cobra.Command{
+	Use:   "remove ID",
+	Short: "Remove a registered account without transactions",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient(accountsOpts.server)
+		if err != nil {
+			return err
+		}
+		res, err := c.RemoveAccount()(context.Background(), &genportfolio.RemoveAccountPayload{PortfolioID: accountsOpts.portfolio, ID: args[0]})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Removed account %s\n", res.(*genportfolio.Account).Name)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(accountsCmd)
+	accountsCmd.AddCommand(accountsListCmd, accountsSetCmd, accountsRemoveCmd)
+
+	pf := accountsCmd.PersistentFlags()
+	pf.StringVar(&accountsOpts.server, "server", "", "Portfolio server URL (default: http://api.host:api.port)")
+	pf.StringVar(&accountsOpts.portfolio, "portfolio", "default", "Portfolio of the accounts")
+
+	f := accountsSetCmd.Flags()
+	f.StringVar(&accountsSetOpts.name, "name", "", "Display name (default: the ID)")
+	f.StringVar(&accountsSetOpts.accountType, "type", "taxable", "Account type: taxable, ira, roth_ira, 401k, roth_401k, hsa or other")
+	f.StringVar(&accountsSetOpts.taxTreatment, "tax-treatment", "", "taxable, tax_deferred or tax_exempt (default: from the type)")
+	f.StringVar(&accountsSetOpts.custodian, "custodian", "", "Broker or institution holding the account")
+	f.StringVar(&accountsSetOpts.currency, "currency", "", "Base currency, which must be the portfolio currency (default: the portfolio currency)")
+}
+
+func printAccounts(out io.Writer, accts []*genportfolio.Account) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Account\tName\tType\tTax treatment\tCustodian\tCurrency\tCash\tHoldings\tTotal")
+	for _, a := range accts {
+		id, custodian := a.ID, ""
+		if id == "" {
+			id = "(default)"
+		}
+		if a.Custodian != nil {
+			custodian = *a.Custodian
+		}
+		if !a.Registered {
+			custodian = "(unregistered)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%.2f\t%.2f\t%.2f\n", id, a.Name, a.Type, a.TaxTreatment,
+			custodian, a.Currency, a.Cash, a.HoldingsValue, a.TotalValue)
+	}
+	return w.Flush()
+}
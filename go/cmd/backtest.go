@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/backtest"
+	"github.com/spf13/cobra"
+)
+
+// backtestOpts holds the backtest command flags
+var backtestOpts struct {
+	server   string
+	strategy string
+	curve    bool
+}
+
+// backtestCmd replays stored prices through an allocation strategy
+var backtestCmd = &cobra.Command{
+	Use:   "backtest",
+	Short: "Backtest an allocation strategy over stored historical prices",
+	Example: `  portfolio-server backtest --strategy strategy.yaml
+  portfolio-server backtest --strategy strategy.yaml --curve`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		st, err := backtest.LoadFile(backtestOpts.strategy)
+		if err != nil {
+			return err
+		}
+		st = st.WithDefaults()
+		p := &genportfolio.RunBacktestPayload{
+			Name:         st.Name,
+			Strategy:     string(st.Kind),
+			Start:        st.Start,
+			End:          st.End,
+			Currency:     st.Currency,
+			InitialCash:  st.InitialCash,
+			Targets:      make([]*genportfolio.BacktestTarget, len(st.Targets)),
+			Frequency:    string(st.Frequency),
+			Threshold:    st.Threshold,
+			Contribution: st.Contribution,
+			FeeRate:      st.FeeRate,
+		}
+		for i, t := range st.Targets {
+			p.Targets[i] = &genportfolio.BacktestTarget{Symbol: t.Symbol, Weight: t.Weight}
+		}
+		c, err := newAPIClient(backtestOpts.server)
+		if err != nil {
+			return err
+		}
+		res, err := c.RunBacktest()(context.Background(), p)
+		if err != nil {
+			return err
+		}
+		return printBacktest(cmd.OutOrStdout(), res.(*genportfolio.BacktestResult), backtestOpts.curve)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(backtestCmd)
+
+	f := backtestCmd.Flags()
+	f.StringVar(&backtestOpts.server, "server", "", "Portfolio server URL (default: http://api.host:api.port)")
+	f.StringVar(&backtestOpts.strategy, "strategy", "", "Strategy YAML file")
+	f.BoolVar(&backtestOpts.curve, "curve", false, "Print the daily equity curve")
+	_ = backtestCmd.MarkFlagRequired("strategy")
+}
+
+func printBacktest(out io.Writer, res *genportfolio.BacktestResult, curve bool) error {
+	name := res.Strategy
+	if res.Name != "" {
+		name = res.Name + " (" + res.Strategy + ")"
+	}
+	fmt.Fprintf(out, "Backtest of %s from %s to %s (%s)\n\n", name, res.Start, res.End, res.Currency)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "End value\t%.2f\n", res.EndValue)
+	fmt.Fprintf(w, "Net contributions\t%.2f\n", res.NetContributions)
+	fmt.Fprintf(w, "Gain\t%.2f\n", res.Gain)
+	fmt.Fprintf(w, "Time-weighted return\t%.2f%%\n", res.TimeWeightedReturn*100)
+	if res.AnnualizedReturn != nil {
+		fmt.Fprintf(w, "Annualized return\t%.2f%%\n", *res.AnnualizedReturn*100)
+	}
+	if res.MoneyWeightedReturn != nil {
+		fmt.Fprintf(w, "Money-weighted return\t%.2f%%\n", *res.MoneyWeightedReturn*100)
+	}
+	fmt.Fprintf(w, "Volatility\t%.2f%%\n", res.Volatility*100)
+	fmt.Fprintf(w, "Sharpe ratio\t%.2f\n", res.SharpeRatio)
+	if res.MaxDrawdownPeak != nil {
+		fmt.Fprintf(w, "Max drawdown\t%.2f%% (%s to %s)\n", res.MaxDrawdown*100, *res.MaxDrawdownPeak, *res.MaxDrawdownTrough)
+	} else {
+		fmt.Fprintf(w, "Max drawdown\t%.2f%%\n", res.MaxDrawdown*100)
+	}
+	fmt.Fprintf(w, "Trades\t%d\n", res.Trades)
+	fmt.Fprintf(w, "Fees\t%.2f\n", res.Fees)
+	fmt.Fprintf(w, "Turnover\t%.2f%%\n", res.Turnover*100)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if !curve {
+		return nil
+	}
+
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Date\tValue\tFlow\tDrawdown\t")
+	for _, p := range res.EquityCurve {
+		fmt.Fprintf(w, "%s\t%.2f\t%.2f\t%.2f%%\t\n", p.Date, p.Value, p.Flow, p.Drawdown*100)
+	}
+	return w.Flush()
+}
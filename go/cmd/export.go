@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/spf13/cobra"
+)
+
+// exportOpts holds the flags shared by the export commands
+var exportOpts struct {
+	server    string
+	portfolio string
+	output    string
+}
+
+// exportJournalOpts holds the export journal command flags
+var exportJournalOpts struct {
+	format string
+}
+
+// exportCmd groups the commands downloading portfolio data
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export portfolio data from a running portfolio server",
+}
+
+// exportJournalCmd downloads the ledger as a plain-text accounting journal
+var exportJournalCmd = &cobra.Command{
+	Use:   "journal",
+	Short: "Export the ledger as a Beancount or hledger journal",
+	Example: `  portfolio-server export journal --output default.beancount
+  portfolio-server export journal --format hledger > default.journal`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient(exportOpts.server)
+		if err != nil {
+			return err
+		}
+		res, err := c.ExportJournal()(context.Background(), &genportfolio.ExportJournalPayload{
+			PortfolioID: exportOpts.portfolio,
+			Format:      exportJournalOpts.format,
+		})
+		if err != nil {
+			return err
+		}
+		return writeExport(cmd, exportOpts.output, []byte(res.(*genportfolio.ExportFile).Content))
+	},
+}
+
+// exportArchiveCmd downloads a portfolio archive
+var exportArchiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Export the portfolio as a versioned JSON archive",
+	Long: `Export the portfolio as a versioned JSON archive holding its ledger, the
+instruments it refers to, target weights and settings, to move it to another
+environment with "portfolio-server import archive".`,
+	Example: `  portfolio-server export archive --output default.portfolio.json
+  portfolio-server export archive --portfolio default --server http://staging:8000 > default.portfolio.json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient(exportOpts.server)
+		if err != nil {
+			return err
+		}
+		res, err := c.ExportArchive()(context.Background(), &genportfolio.ExportArchivePayload{
+			PortfolioID: exportOpts.portfolio,
+		})
+		if err != nil {
+			return err
+		}
+		return writeExport(cmd, exportOpts.output, []byte(res.(*genportfolio.ExportFile).Content))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.AddCommand(exportJournalCmd, exportArchiveCmd)
+
+	pf := exportCmd.PersistentFlags()
+	pf.StringVar(&exportOpts.server, "server", "", "Portfolio server URL (default: http://api.host:api.port)")
+	pf.StringVar(&exportOpts.portfolio, "portfolio", "default", "Portfolio to export")
+	pf.StringVarP(&exportOpts.output, "output", "o", "", "File to write (default: standard output)")
+
+	exportJournalCmd.Flags().StringVar(&exportJournalOpts.format, "format", "beancount", "Journal format: beancount or hledger")
+}
+
+// writeExport writes content to the output file, or to standard output when
+// output is empty.
+func writeExport(cmd *cobra.Command, output string, content []byte) error {
+	if output == "" {
+		_, err := cmd.OutOrStdout().Write(content)
+		return err
+	}
+	if err := os.WriteFile(filepath.Clean(output), content, 0o600); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "Wrote %s\n", output)
+	return nil
+}
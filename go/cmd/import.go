@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	portfolioClient "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/http/portfolio/client"
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// importOpts holds the flags shared by the import commands
+var importOpts struct {
+	server    string
+	portfolio string
+	account   string
+	dryRun    bool
+}
+
+// importCSVOpts holds the import csv command flags
+var importCSVOpts struct {
+	profile string
+}
+
+// importJournalOpts holds the import journal command flags
+var importJournalOpts struct {
+	root string
+}
+
+// importArchiveOpts holds the import archive command flags
+var importArchiveOpts struct {
+	replace bool
+}
+
+// importCmd groups the statement import commands
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import broker statements and journals into a running portfolio server",
+}
+
+// importCSVCmd uploads a broker CSV statement
+var importCSVCmd = &cobra.Command{
+	Use:   "csv FILE",
+	Short: "Import a broker CSV statement with a mapping profile",
+	Example: `  portfolio-server import csv trades.csv --profile ibkr --dry-run
+  portfolio-server import csv trades.csv --profile ibkr --account brokerage --server http://localhost:8000`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		content, err := os.ReadFile(filepath.Clean(args[0]))
+		if err != nil {
+			return err
+		}
+		c, err := newAPIClient(importOpts.server)
+		if err != nil {
+			return err
+		}
+		res, err := c.ImportCsv()(context.Background(), &genportfolio.ImportCsvPayload{
+			PortfolioID: importOpts.portfolio,
+			Profile:     importCSVOpts.profile,
+			Account:     importOpts.account,
+			DryRun:      importOpts.dryRun,
+			Content:     string(content),
+		})
+		if err != nil {
+			return err
+		}
+		return printImportReport(cmd.OutOrStdout(), res.(*genportfolio.ImportReport))
+	},
+}
+
+// importOFXCmd uploads an OFX or QFX statement
+var importOFXCmd = &cobra.Command{
+	Use:   "ofx FILE",
+	Short: "Import an OFX or QFX statement and reconcile its positions",
+	Example: `  portfolio-server import ofx statement.qfx --dry-run
+  portfolio-server import ofx statement.ofx --account brokerage`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		content, err := os.ReadFile(filepath.Clean(args[0]))
+		if err != nil {
+			return err
+		}
+		c, err := newAPIClient(importOpts.server)
+		if err != nil {
+			return err
+		}
+		res, err := c.ImportOfx()(context.Background(), &genportfolio.ImportOfxPayload{
+			PortfolioID: importOpts.portfolio,
+			Account:     importOpts.account,
+			DryRun:      importOpts.dryRun,
+			Content:     string(content),
+		})
+		if err != nil {
+			return err
+		}
+		return printImportReport(cmd.OutOrStdout(), res.(*genportfolio.ImportReport))
+	},
+}
+
+// importJournalCmd uploads a Beancount or hledger journal
+var importJournalCmd = &cobra.Command{
+	Use:   "journal FILE",
+	Short: "Import a Beancount or hledger journal",
+	Example: `  portfolio-server import journal default.beancount --dry-run
+  portfolio-server import journal broker.journal --root Assets:Broker --account brokerage`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		content, err := os.ReadFile(filepath.Clean(args[0]))
+		if err != nil {
+			return err
+		}
+		c, err := newAPIClient(importOpts.server)
+		if err != nil {
+			return err
+		}
+		res, err := c.ImportJournal()(context.Background(), &genportfolio.ImportJournalPayload{
+			PortfolioID: importOpts.portfolio,
+			Account:     importOpts.account,
+			Root:        importJournalOpts.root,
+			DryRun:      importOpts.dryRun,
+			Content:     string(content),
+		})
+		if err != nil {
+			return err
+		}
+		return printImportReport(cmd.OutOrStdout(), res.(*genportfolio.ImportReport))
+	},
+}
+
+// importArchiveCmd uploads a portfolio archive
+var importArchiveCmd = &cobra.Command{
+	Use:   "archive FILE",
+	Short: "Import a portfolio archive written by export archive",
+	Long: `Import a portfolio archive written by "portfolio-server export archive",
+migrating archives of older schema versions. The portfolio keeps the ID in the
+archive unless --portfolio is given, and an existing portfolio is only
+overwritten with --replace. --account and --dry-run do not apply.`,
+	Example: `  portfolio-server import archive default.portfolio.json --portfolio staging-copy
+  portfolio-server import archive default.portfolio.json --replace --server http://localhost:8000`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		content, err := os.ReadFile(filepath.Clean(args[0]))
+		if err != nil {
+			return err
+		}
+		c, err := newAPIClient(importOpts.server)
+		if err != nil {
+			return err
+		}
+		p := &genportfolio.ImportArchivePayload{Replace: importArchiveOpts.replace, Content: string(content)}
+		if cmd.Flags().Changed("portfolio") {
+			p.PortfolioID = &importOpts.portfolio
+		}
+		res, err := c.ImportArchive()(context.Background(), p)
+		if err != nil {
+			return err
+		}
+		r := res.(*genportfolio.ArchiveImport)
+		verb := "Created"
+		if r.Replaced {
+			verb = "Replaced"
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s from an archive of %s (schema version %d", verb, r.PortfolioID, r.CreatedAt, r.SchemaVersion)
+		if r.Migrated {
+			fmt.Fprint(cmd.OutOrStdout(), ", migrated")
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "): %d transactions, %d instruments, %d targets, %d accounts, %d margin accounts\n",
+			r.Transactions, r.Instruments, r.Targets, r.Accounts, r.MarginAccounts)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.AddCommand(importCSVCmd, importOFXCmd, importJournalCmd, importArchiveCmd)
+
+	pf := importCmd.PersistentFlags()
+	pf.StringVar(&importOpts.server, "server", "", "Portfolio server URL (default: http://api.host:api.port)")
+	pf.StringVar(&importOpts.portfolio, "portfolio", "default", "Portfolio to import into")
+	pf.StringVar(&importOpts.account, "account", "", "Account for rows without one; for OFX, instead of the statement account number")
+	pf.BoolVar(&importOpts.dryRun, "dry-run", false, "Report the outcome without posting anything")
+
+	importCSVCmd.Flags().StringVar(&importCSVOpts.profile, "profile", "generic", "Mapping profile from portfolio.import-profiles")
+	importJournalCmd.Flags().StringVar(&importJournalOpts.root, "root", "Assets:Portfolio", "Parent account of the portfolio cash and positions")
+	importArchiveCmd.Flags().BoolVar(&importArchiveOpts.replace, "replace", false, "Overwrite an existing portfolio of the same ID")
+}
+
+// newAPIClient returns a client of the portfolio server at server, which
+// falls back to the api.host and api.port settings.
+func newAPIClient(server string) (*portfolioClient.Client, error) {
+	if server == "" {
+		server = fmt.Sprintf("http://%s:%d", viper.GetString("api.host"), viper.GetInt("api.port"))
+	}
+	u, err := url.Parse(server)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server %q: %w", server, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid server %q: want scheme://host:port", server)
+	}
+	return portfolioClient.NewClient(u.Scheme, u.Host, http.DefaultClient, goahttp.RequestEncoder, goahttp.ResponseDecoder, false), nil
+}
+
+func printImportReport(out io.Writer, res *genportfolio.ImportReport) error {
+	verb := "Imported"
+	if res.DryRun {
+		verb = "Dry run: would import"
+	}
+	fmt.Fprintf(out, "%s %d rows into %s (%d duplicates, %d skipped, %d errors)\n\n",
+		verb, res.Imported, res.PortfolioID, res.Duplicates, res.Skipped, res.Errors)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Line\tStatus\tDate\tType\tAccount\tSymbol\tQuantity\tPrice\tAmount\tDetail")
+	for _, r := range res.Rows {
+		detail := ""
+		if r.Error != nil {
+			detail = *r.Error
+		} else if r.Fingerprint != nil {
+			detail = *r.Fingerprint
+		}
+		t := r.Transaction
+		if t == nil {
+			fmt.Fprintf(w, "%d\t%s\t\t\t\t\t\t\t\t%s\n", r.Line, r.Status, detail)
+			continue
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%g\t%.4f\t%.2f\t%s\n",
+			r.Line, r.Status, t.Date, t.Type, t.Account, t.Symbol, t.Quantity, t.Price, t.Amount, detail)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, rec := range res.Reconciliation {
+		status := "matched"
+		if !rec.Matched {
+			status = "differences found"
+		}
+		fmt.Fprintf(out, "\nReconciliation of account %q on %s: %s\n", rec.Account, rec.AsOf, status)
+		w = tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(w, "Symbol\tStatement\tLedger\tDifference\t")
+		for _, p := range rec.Positions {
+			fmt.Fprintf(w, "%s\t%g\t%g\t%g\t\n", p.Symbol, p.StatementQuantity, p.LedgerQuantity, p.Difference)
+		}
+		if rec.StatementCash != nil {
+			fmt.Fprintf(w, "Cash\t%.2f\t%.2f\t%.2f\t\n", *rec.StatementCash, rec.LedgerCash, *rec.StatementCash-rec.LedgerCash)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/spf13/cobra"
+)
+
+// projectOpts holds the project command flags
+var projectOpts struct {
+	server          string
+	portfolio       string
+	end             string
+	startValue      float64
+	contribution    float64
+	withdrawal      float64
+	withdrawalStart string
+	inflation       float64
+	goal            float64
+	goalDate        string
+	assumptions     []string
+	paths           int
+	seed            int64
+}
+
+// projectCmd runs a Monte Carlo projection of a portfolio
+var projectCmd = &cobra.Command{
+	Use:   "project",
+	Short: "Project future portfolio value and the chance of reaching a goal",
+	Example: `  portfolio-server project --end 2055-12-31 --monthly-contribution 500 --goal 1000000
+  portfolio-server project --end 2060-01-01 --monthly-withdrawal 4000 --withdrawal-start 2040-01-01 --assumption equity:0.07:0.16:0.6 --assumption fixed_income:0.04:0.06:0.4`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		o := projectOpts
+		flags := cmd.Flags()
+		p := &genportfolio.ProjectPortfolioPayload{
+			PortfolioID:         o.portfolio,
+			End:                 o.end,
+			MonthlyContribution: o.contribution,
+			MonthlyWithdrawal:   o.withdrawal,
+			Inflation:           o.inflation,
+			Paths:               o.paths,
+		}
+		if flags.Changed("start-value") {
+			p.StartValue = &o.startValue
+		}
+		if flags.Changed("goal") {
+			p.Goal = &o.goal
+		}
+		if flags.Changed("seed") {
+			p.Seed = &o.seed
+		}
+		if o.withdrawalStart != "" {
+			p.WithdrawalStart = &o.withdrawalStart
+		}
+		if o.goalDate != "" {
+			p.GoalDate = &o.goalDate
+		}
+		for _, spec := range o.assumptions {
+			a, err := parseAssumption(spec)
+			if err != nil {
+				return err
+			}
+			p.Assumptions = append(p.Assumptions, a)
+		}
+
+		c, err := newAPIClient(o.server)
+		if err != nil {
+			return err
+		}
+		res, err := c.ProjectPortfolio()(context.Background(), p)
+		if err != nil {
+			return err
+		}
+		return printProjection(cmd.OutOrStdout(), res.(*genportfolio.Projection))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(projectCmd)
+
+	f := projectCmd.Flags()
+	f.StringVar(&projectOpts.server, "server", "", "Portfolio server URL (default: http://api.host:api.port)")
+	f.StringVar(&projectOpts.portfolio, "portfolio", "default", "Portfolio to project")
+	f.StringVar(&projectOpts.end, "end", "", "Last day of the projection (YYYY-MM-DD)")
+	f.Float64Var(&projectOpts.startValue, "start-value", 0, "Starting value (default: current portfolio value)")
+	f.Float64Var(&projectOpts.contribution, "monthly-contribution", 0, "Monthly contribution in today's money")
+	f.Float64Var(&projectOpts.withdrawal, "monthly-withdrawal", 0, "Monthly withdrawal in today's money")
+	f.StringVar(&projectOpts.withdrawalStart, "withdrawal-start", "", "Date withdrawals begin (default: today)")
+	f.Float64Var(&projectOpts.inflation, "inflation", 0.02, "Annual inflation rate")
+	f.Float64Var(&projectOpts.goal, "goal", 0, "Goal amount in today's money")
+	f.StringVar(&projectOpts.goalDate, "goal-date", "", "Date the goal should be reached by (default: end)")
+	f.StringArrayVar(&projectOpts.assumptions, "assumption", nil, "Asset class assumption as class:return:volatility[:weight]; repeatable")
+	f.IntVar(&projectOpts.paths, "paths", 5000, "Number of simulated paths")
+	f.Int64Var(&projectOpts.seed, "seed", 0, "Seed for reproducible results")
+	_ = projectCmd.MarkFlagRequired("end")
+}
+
+// parseAssumption reads "class:return:volatility[:weight]".
+func parseAssumption(spec string) (*genportfolio.AssetClassAssumption, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 3 || len(parts) > 4 || parts[0] == "" {
+		return nil, fmt.Errorf("invalid assumption %q: want class:return:volatility[:weight]", spec)
+	}
+	values := make([]float64, len(parts)-1)
+	for i, s := range parts[1:] {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid assumption %q: %w", spec, err)
+		}
+		values[i] = v
+	}
+	a := &genportfolio.AssetClassAssumption{AssetClass: parts[0], ExpectedReturn: &values[0], Volatility: &values[1]}
+	if len(values) == 3 {
+		a.Weight = &values[2]
+	}
+	return a, nil
+}
+
+func printProjection(out io.Writer, res *genportfolio.Projection) error {
+	fmt.Fprintf(out, "Projection of %s from %s to %s, %d paths, starting value %.2f (values in today's money)\n\n",
+		res.PortfolioID, res.Start, res.End, res.Paths, res.StartValue)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Asset class\tWeight\tReturn\tVolatility\t")
+	for _, a := range res.Assumptions {
+		fmt.Fprintf(w, "%s\t%.1f%%\t%.1f%%\t%.1f%%\t\n", a.AssetClass, *a.Weight*100, *a.ExpectedReturn*100, *a.Volatility*100)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Date\tP5\tP25\tP50\tP75\tP95\t")
+	for _, b := range res.Bands {
+		fmt.Fprintf(w, "%s\t%.0f\t%.0f\t%.0f\t%.0f\t%.0f\t\n", b.Date, b.P5, b.P25, b.P50, b.P75, b.P95)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(out)
+	if res.GoalProbability != nil {
+		fmt.Fprintf(out, "Probability of reaching %.2f by %s: %.1f%%\n", *res.Goal, *res.GoalDate, *res.GoalProbability*100)
+	}
+	fmt.Fprintf(out, "Probability of running out of money: %.1f%%\n", res.DepletionProbability*100)
+	return nil
+}
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/spf13/cobra"
+)
+
+// reconcileOpts holds the flags shared by the reconcile commands
+var reconcileOpts struct {
+	server    string
+	portfolio string
+}
+
+// reconcileRunOpts holds the reconcile run command flags
+var reconcileRunOpts struct {
+	format  string
+	profile string
+	account string
+	asOf    string
+}
+
+// reconcileBreaksOpts holds the reconcile breaks command flags
+var reconcileBreaksOpts struct {
+	status  string
+	account string
+}
+
+// reconcileSetOpts holds the reconcile set command flags
+var reconcileSetOpts struct {
+	note  string
+	apply bool
+}
+
+// reconcileCmd groups the position reconciliation commands
+var reconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Reconcile ledger positions against broker position files",
+}
+
+// reconcileRunCmd uploads a broker position snapshot
+var reconcileRunCmd = &cobra.Command{
+	Use:   "run FILE",
+	Short: "Compare the ledger with a CSV position file or OFX statement",
+	Long: `Compare the holdings and cash derived from the ledger with a broker position
+snapshot, per account and date, and track the breaks found. CSV files are read
+with the symbol, quantity, price, amount, account and date columns of a mapping
+profile; rows without a symbol, or with the symbol CASH, hold the cash balance.`,
+	Example: `  portfolio-server reconcile run positions.csv --as-of 2024-06-28 --account ira
+  portfolio-server reconcile run statement.ofx --format ofx`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		content, err := os.ReadFile(filepath.Clean(args[0]))
+		if err != nil {
+			return err
+		}
+		c, err := newAPIClient(reconcileOpts.server)
+		if err != nil {
+			return err
+		}
+		p := &genportfolio.ReconcilePositionsPayload{
+			PortfolioID: reconcileOpts.portfolio,
+			Format:      reconcileRunOpts.format,
+			Profile:     reconcileRunOpts.profile,
+			Account:     reconcileRunOpts.account,
+			Content:     string(content),
+		}
+		if reconcileRunOpts.asOf != "" {
+			p.AsOf = &reconcileRunOpts.asOf
+		}
+		res, err := c.ReconcilePositions()(context.Background(), p)
+		if err != nil {
+			return err
+		}
+		r := res.(*genportfolio.Reconciliation)
+		out := cmd.OutOrStdout()
+		fmt.Fprintf(out, "Reconciled %d account snapshots of %s: %d new breaks, %d still unresolved, %d resolved\n\n",
+			len(r.Accounts), r.PortfolioID, r.Opened, r.Updated, r.Resolved)
+		return printBreaks(out, r.Breaks)
+	},
+}
+
+// reconcileBreaksCmd lists tracked breaks
+var reconcileBreaksCmd = &cobra.Command{
+	Use:     "breaks",
+	Short:   "List reconciliation breaks",
+	Example: `  portfolio-server reconcile breaks --status open --account ira`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient(reconcileOpts.server)
+		if err != nil {
+			return err
+		}
+		p := &genportfolio.ListReconciliationBreaksPayload{PortfolioID: reconcileOpts.portfolio}
+		if reconcileBreaksOpts.status != "" {
+			p.Status = &reconcileBreaksOpts.status
+		}
+		if cmd.Flags().Changed("account") {
+			p.Account = &reconcileBreaksOpts.account
+		}
+		res, err := c.ListReconciliationBreaks()(context.Background(), p)
+		if err != nil {
+			return err
+		}
+		return printBreaks(cmd.OutOrStdout(), res.([]*genportfolio.ReconciliationBreak))
+	},
+}
+
+// reconcileSetCmd changes the status of a break
+var reconcileSetCmd = &cobra.Command{
+	Use:   "set ID STATUS",
+	Short: "Set the status of a break to open, resolved or ignored",
+	Example: `  portfolio-server reconcile set brk-000001 resolved --apply
+  portfolio-server reconcile set brk-000002 ignored --note "transfer in flight"`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient(reconcileOpts.server)
+		if err != nil {
+			return err
+		}
+		p := &genportfolio.UpdateReconciliationBreakPayload{
+			PortfolioID: reconcileOpts.portfolio,
+			ID:          args[0],
+			Status:      args[1],
+			Apply:       reconcileSetOpts.apply,
+		}
+		if reconcileSetOpts.note != "" {
+			p.Note = &reconcileSetOpts.note
+		}
+		res, err := c.UpdateReconciliationBreak()(context.Background(), p)
+		if err != nil {
+			return err
+		}
+		b := res.(*genportfolio.ReconciliationBreak)
+		fmt.Fprintf(cmd.OutOrStdout(), "Break %s is %s", b.ID, b.Status)
+		if len(b.Posted) > 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "; posted %s", strings.Join(b.Posted, ", "))
+		}
+		fmt.Fprintln(cmd.OutOrStdout())
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reconcileCmd)
+	reconcileCmd.AddCommand(reconcileRunCmd, reconcileBreaksCmd, reconcileSetCmd)
+
+	pf := reconcileCmd.PersistentFlags()
+	pf.StringVar(&reconcileOpts.server, "server", "", "Portfolio server URL (default: http://api.host:api.port)")
+	pf.StringVar(&reconcileOpts.portfolio, "portfolio", "default", "Portfolio to reconcile")
+
+	f := reconcileRunCmd.Flags()
+	f.StringVar(&reconcileRunOpts.format, "format", "csv", "Snapshot format: csv or ofx")
+	f.StringVar(&reconcileRunOpts.profile, "profile", "generic", "Mapping profile from portfolio.import-profiles naming the CSV columns")
+	f.StringVar(&reconcileRunOpts.account, "account", "", "Account for CSV rows without one; for OFX, instead of the statement account number")
+	f.StringVar(&reconcileRunOpts.asOf, "as-of", "", "Date (YYYY-MM-DD) of CSV rows without one (default: today)")
+
+	reconcileBreaksCmd.Flags().StringVar(&reconcileBreaksOpts.status, "status", "", "Only breaks with this status: open, resolved or ignored")
+	reconcileBreaksCmd.Flags().StringVar(&reconcileBreaksOpts.account, "account", "", "Only breaks of this account")
+
+	reconcileSetCmd.Flags().StringVar(&reconcileSetOpts.note, "note", "", "Why the status is set")
+	reconcileSetCmd.Flags().BoolVar(&reconcileSetOpts.apply, "apply", false, "Post the suggested adjustments when resolving")
+}
+
+func printBreaks(out io.Writer, breaks []*genportfolio.ReconciliationBreak) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tStatus\tAs of\tAccount\tKind\tSymbol\tStatement\tLedger\tDifference\tSuggested")
+	for _, b := range breaks {
+		symbol := ""
+		if b.Symbol != nil {
+			symbol = *b.Symbol
+		}
+		var suggested []string
+		for _, t := range b.Adjustments {
+			if t.Symbol != "" {
+				suggested = append(suggested, fmt.Sprintf("%s %s %s @ %.2f", t.Type, breakNumber(t.Quantity), t.Symbol, t.Price))
+			} else {
+				suggested = append(suggested, fmt.Sprintf("%s %.2f", t.Type, t.Amount))
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", b.ID, b.Status, b.AsOf, b.Account, b.Kind, symbol,
+			breakNumber(b.Statement), breakNumber(b.Ledger), breakNumber(b.Difference), strings.Join(suggested, ", "))
+	}
+	return w.Flush()
+}
+
+// breakNumber formats a quantity or cash balance to at most four decimals.
+func breakNumber(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e4)/1e4, 'f', -1, 64)
+}
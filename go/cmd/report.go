@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/spf13/cobra"
+)
+
+// reportOpts holds the flags shared by the report commands
+var reportOpts struct {
+	server    string
+	portfolio string
+	output    string
+}
+
+// reportTaxOpts holds the report tax command flags
+var reportTaxOpts struct {
+	year   int
+	format string
+}
+
+// reportLotsOpts holds the report lots command flags
+var reportLotsOpts struct {
+	account string
+	symbol  string
+}
+
+// reportHarvestOpts holds the report harvest command flags
+var reportHarvestOpts struct {
+	account       string
+	minLoss       float64
+	shortTermRate float64
+	longTermRate  float64
+}
+
+// reportCmd groups the reporting commands
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Produce year-end reports of a portfolio",
+}
+
+// reportTaxCmd reports the capital gains realized in a year
+var reportTaxCmd = &cobra.Command{
+	Use:   "tax",
+	Short: "Report realized capital gains per lot for a calendar year",
+	Long: `Report every lot disposed of in a calendar year with its acquisition and
+disposal dates, proceeds, cost basis, short-term or long-term classification and
+wash-sale adjustment, grouped by account. The table format prints the report;
+csv and json write the file for a tax preparer.`,
+	Example: `  portfolio-server report tax --year 2024
+  portfolio-server report tax --year 2024 --format csv -o gains-2024.csv`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient(reportOpts.server)
+		if err != nil {
+			return err
+		}
+		switch reportTaxOpts.format {
+		case "csv", "json":
+			res, err := c.ExportTaxReport()(context.Background(), &genportfolio.ExportTaxReportPayload{
+				PortfolioID: reportOpts.portfolio,
+				Year:        reportTaxOpts.year,
+				Format:      reportTaxOpts.format,
+			})
+			if err != nil {
+				return err
+			}
+			return writeExport(cmd, reportOpts.output, []byte(res.(*genportfolio.ExportFile).Content))
+		case "table":
+			res, err := c.GetTaxReport()(context.Background(), &genportfolio.GetTaxReportPayload{
+				PortfolioID: reportOpts.portfolio,
+				Year:        reportTaxOpts.year,
+			})
+			if err != nil {
+				return err
+			}
+			return printTaxReport(cmd.OutOrStdout(), res.(*genportfolio.TaxReport))
+		default:
+			return fmt.Errorf("unknown format %q: use table, csv or json", reportTaxOpts.format)
+		}
+	},
+}
+
+// reportLotsCmd lists the open tax lots
+var reportLotsCmd = &cobra.Command{
+	Use:   "lots",
+	Short: "List open tax lots with wash sale basis adjustments",
+	Long: `List the open tax lots of a portfolio with their cost basis, including losses
+deferred into them by wash sales, the start of their holding period and their
+unrealized gain at the latest close.`,
+	Example: `  portfolio-server report lots --account ira
+  portfolio-server report lots --symbol VOO`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient(reportOpts.server)
+		if err != nil {
+			return err
+		}
+		p := &genportfolio.ListLotsPayload{PortfolioID: reportOpts.portfolio}
+		if cmd.Flags().Changed("account") {
+			p.Account = &reportLotsOpts.account
+		}
+		if reportLotsOpts.symbol != "" {
+			p.Symbol = &reportLotsOpts.symbol
+		}
+		res, err := c.ListLots()(context.Background(), p)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Lot\tAccount\tSymbol\tQuantity\tAcquired\tHolding since\tTerm\tCost basis\tDeferred loss\tValue\tUnrealized")
+		for _, lot := range res.([]*genportfolio.TaxLot) {
+			value, gain := "", ""
+			if lot.MarketValue != nil && lot.UnrealizedGain != nil {
+				value, gain = fmt.Sprintf("%.2f", *lot.MarketValue), fmt.Sprintf("%.2f", *lot.UnrealizedGain)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%.2f\t%.2f\t%s\t%s\n", lot.ID, lot.Account, lot.Symbol,
+				breakNumber(lot.Quantity), lot.Acquired, lot.HoldingSince, lot.Term, lot.CostBasis, lot.BasisAdjustment, value, gain)
+		}
+		return w.Flush()
+	},
+}
+
+// reportHarvestCmd suggests tax-loss harvests
+var reportHarvestCmd = &cobra.Command{
+	Use:   "harvest",
+	Short: "Suggest tax-loss harvesting sales and replacements",
+	Long: `Scan the open lots for unrealized losses of at least --min-loss per account and
+symbol. Each suggestion sells the oldest lots, as sales relieve lots first in,
+first out, and names a replacement from portfolio.harvest-replacements that is
+not substantially identical. Losses that recent purchases would turn into wash
+sales are skipped. Savings are estimated at the given marginal rates.`,
+	Example: `  portfolio-server report harvest --short-term-rate 0.37 --long-term-rate 0.2 --min-loss 500`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newAPIClient(reportOpts.server)
+		if err != nil {
+			return err
+		}
+		p := &genportfolio.FindTaxLossHarvestsPayload{
+			PortfolioID:   reportOpts.portfolio,
+			MinLoss:       reportHarvestOpts.minLoss,
+			ShortTermRate: reportHarvestOpts.shortTermRate,
+			LongTermRate:  reportHarvestOpts.longTermRate,
+		}
+		if cmd.Flags().Changed("account") {
+			p.Account = &reportHarvestOpts.account
+		}
+		res, err := c.FindTaxLossHarvests()(context.Background(), p)
+		if err != nil {
+			return err
+		}
+		r := res.(*genportfolio.TaxLossHarvest)
+		out := cmd.OutOrStdout()
+		fmt.Fprintf(out, "Harvestable losses of %s as of %s: %.2f, saving an estimated %.2f\n\n", r.PortfolioID, r.AsOf, r.TotalLoss, r.EstimatedSavings)
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Account\tSell\tQuantity\tPrice\tLoss\tShort-term\tLong-term\tSavings\tBuy\tQuantity\tRepurchase after")
+		for _, o := range r.Opportunities {
+			replacement, quantity := "", ""
+			if o.Replacement != nil {
+				replacement = *o.Replacement
+			}
+			if o.ReplacementQuantity != nil {
+				quantity = breakNumber(*o.ReplacementQuantity)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%s\t%s\t%s\n", o.Account, o.Symbol, breakNumber(o.Quantity),
+				o.Price, o.Loss, o.ShortTermLoss, o.LongTermLoss, o.EstimatedSavings, replacement, quantity, o.RepurchaseAfter)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		for _, sk := range r.Skipped {
+			fmt.Fprintf(out, "\nSkipped %s %s (loss %.2f): %s", sk.Account, sk.Symbol, sk.Loss, sk.Reason)
+		}
+		if len(r.Skipped) > 0 {
+			fmt.Fprintln(out)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+	reportCmd.AddCommand(reportTaxCmd, reportLotsCmd, reportHarvestCmd)
+
+	pf := reportCmd.PersistentFlags()
+	pf.StringVar(&reportOpts.server, "server", "", "Portfolio server URL (default: http://api.host:api.port)")
+	pf.StringVar(&reportOpts.portfolio, "portfolio", "default", "Portfolio to report on")
+	pf.StringVarP(&reportOpts.output, "output", "o", "", "File to write csv and json reports to (default: standard output)")
+
+	f := reportTaxCmd.Flags()
+	f.IntVar(&reportTaxOpts.year, "year", time.Now().Year(), "Calendar year of the disposals")
+	f.StringVar(&reportTaxOpts.format, "format", "table", "Output format: table, csv or json")
+
+	reportLotsCmd.Flags().StringVar(&reportLotsOpts.account, "account", "", "Only lots of this account")
+	reportLotsCmd.Flags().StringVar(&reportLotsOpts.symbol, "symbol", "", "Only lots of this instrument")
+
+	f = reportHarvestCmd.Flags()
+	f.StringVar(&reportHarvestOpts.account, "account", "", "Only lots of this account")
+	f.Float64Var(&reportHarvestOpts.minLoss, "min-loss", 0, "Smallest net loss worth harvesting per account and symbol")
+	f.Float64Var(&reportHarvestOpts.shortTermRate, "short-term-rate", 0, "Marginal tax rate on short-term gains, e.g. 0.37")
+	f.Float64Var(&reportHarvestOpts.longTermRate, "long-term-rate", 0, "Marginal tax rate on long-term gains, e.g. 0.2")
+	_ = reportHarvestCmd.MarkFlagRequired("short-term-rate")
+	_ = reportHarvestCmd.MarkFlagRequired("long-term-rate")
+}
+
+func printTaxReport(out io.Writer, r *genportfolio.TaxReport) error {
+	fmt.Fprintf(out, "Realized gains of %s in %d (%s)\n", r.PortfolioID, r.Year, r.Currency)
+	// Headings and blank lines carry every column so that the lines of all
+	// accounts and the portfolio totals stay aligned.
+	const empty = "\t\t\t\t\t\t\t\t\n"
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, acct := range r.Accounts {
+		name := acct.Account
+		if name == "" {
+			name = "(default)"
+		}
+		fmt.Fprint(w, empty)
+		fmt.Fprintf(w, "Account %s"+empty, name)
+		fmt.Fprintln(w, "Symbol\tQuantity\tAcquired\tDisposed\tTerm\tProceeds\tCost basis\tWash sale\tGain")
+		for _, g := range acct.Lots {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.2f\t%.2f\t%.2f\t%.2f\n", g.Symbol, breakNumber(g.Quantity),
+				g.Acquired, g.Disposed, g.Term, g.Proceeds, g.CostBasis, g.WashSaleAdjustment, g.Gain)
+		}
+		printTaxTotals(w, "Short-term", acct.ShortTerm)
+		printTaxTotals(w, "Long-term", acct.LongTerm)
+		printTaxTotals(w, "Total", acct.Total)
+	}
+	fmt.Fprint(w, empty)
+	printTaxTotals(w, "Portfolio short-term", r.ShortTerm)
+	printTaxTotals(w, "Portfolio long-term", r.LongTerm)
+	printTaxTotals(w, "Portfolio total", r.Total)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(r.WashSales) == 0 {
+		return nil
+	}
+	fmt.Fprintln(out, "\nWash sales")
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Sold\tAccount\tSymbol\tQuantity\tDisallowed\tReplacement lot\tAccount\tSymbol\tAcquired\tLost")
+	for _, ws := range r.WashSales {
+		lost := ""
+		if ws.Permanent {
+			lost = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.2f\t%s\t%s\t%s\t%s\t%s\n", ws.Disposed, ws.Account, ws.Symbol, breakNumber(ws.Quantity),
+			ws.Disallowed, ws.ReplacementLotID, ws.ReplacementAccount, ws.ReplacementSymbol, ws.ReplacementAcquired, lost)
+	}
+	return w.Flush()
+}
+
+func printTaxTotals(w io.Writer, label string, t *genportfolio.TaxTotals) {
+	fmt.Fprintf(w, "%s\t\t\t\t\t%.2f\t%.2f\t%.2f\t%.2f\n", label, t.Proceeds, t.CostBasis, t.WashSaleAdjustment, t.Gain)
+}
@@ -6,6 +6,11 @@ import (
 	"strings"
 
 	"github.com/reidlai/ta-workspace/modules/portfolio/go/internal/server"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/accounts"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/margin"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/orders"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/risk"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/statement"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -81,16 +86,60 @@ var startCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Start the portfolio API server",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		var scenarios []risk.Scenario
+		if err := viper.UnmarshalKey("portfolio.stress-scenarios", &scenarios); err != nil {
+			return fmt.Errorf("portfolio.stress-scenarios: %w", err)
+		}
+		var volatilities map[string]float64
+		if err := viper.UnmarshalKey("portfolio.option-volatilities", &volatilities); err != nil {
+			return fmt.Errorf("portfolio.option-volatilities: %w", err)
+		}
+		var accts []accounts.Account
+		if err := viper.UnmarshalKey("portfolio.accounts", &accts); err != nil {
+			return fmt.Errorf("portfolio.accounts: %w", err)
+		}
+		var marginAccounts []margin.Terms
+		if err := viper.UnmarshalKey("portfolio.margin-accounts", &marginAccounts); err != nil {
+			return fmt.Errorf("portfolio.margin-accounts: %w", err)
+		}
+		var paperTrading orders.Simulator
+		if err := viper.UnmarshalKey("portfolio.paper-trading", &paperTrading); err != nil {
+			return fmt.Errorf("portfolio.paper-trading: %w", err)
+		}
+		var importProfiles []statement.Profile
+		if err := viper.UnmarshalKey("portfolio.import-profiles", &importProfiles); err != nil {
+			return fmt.Errorf("portfolio.import-profiles: %w", err)
+		}
+		var identicalSecurities [][]string
+		if err := viper.UnmarshalKey("portfolio.identical-securities", &identicalSecurities); err != nil {
+			return fmt.Errorf("portfolio.identical-securities: %w", err)
+		}
+		var harvestReplacements map[string][]string
+		if err := viper.UnmarshalKey("portfolio.harvest-replacements", &harvestReplacements); err != nil {
+			return fmt.Errorf("portfolio.harvest-replacements: %w", err)
+		}
 		cfg := &server.Config{
-			Host:              viper.GetString("api.host"),
-			Port:              viper.GetInt("api.port"),
-			Debug:             viper.GetBool("server.debug"),
-			LogLevel:          viper.GetString("server.log-level"),
-			LogFormat:         viper.GetString("server.log-format"),
-			ReadHeaderTimeout: viper.GetDuration("api.read-header-timeout"),
-			WriteTimeout:      viper.GetDuration("api.write-timeout"),
-			IdleTimeout:       viper.GetDuration("api.idle-timeout"),
-			MaxHeaderBytes:    viper.GetInt("api.max-header-bytes"),
+			Host:                 viper.GetString("api.host"),
+			Port:                 viper.GetInt("api.port"),
+			Debug:                viper.GetBool("server.debug"),
+			LogLevel:             viper.GetString("server.log-level"),
+			LogFormat:            viper.GetString("server.log-format"),
+			ReadHeaderTimeout:    viper.GetDuration("api.read-header-timeout"),
+			WriteTimeout:         viper.GetDuration("api.write-timeout"),
+			IdleTimeout:          viper.GetDuration("api.idle-timeout"),
+			MaxHeaderBytes:       viper.GetInt("api.max-header-bytes"),
+			InstrumentsFile:      viper.GetString("portfolio.instruments-file"),
+			RiskFreeRate:         viper.GetFloat64("portfolio.risk-free-rate"),
+			CorporateActionsFile: viper.GetString("portfolio.corporate-actions-file"),
+			StressScenarios:      scenarios,
+			OptionVolatility:     viper.GetFloat64("portfolio.option-volatility"),
+			OptionVolatilities:   volatilities,
+			Accounts:             accts,
+			MarginAccounts:       marginAccounts,
+			PaperTrading:         paperTrading,
+			ImportProfiles:       importProfiles,
+			IdenticalSecurities:  identicalSecurities,
+			HarvestReplacements:  harvestReplacements,
 		}
 		return server.Run(cfg)
 	},
@@ -105,6 +154,10 @@ func init() {
 	startCmd.Flags().String("write-timeout", "60s", "Write timeout")
 	startCmd.Flags().String("idle-timeout", "120s", "Idle timeout")
 	startCmd.Flags().Int("max-header-bytes", 1<<20, "Max header bytes")
+	startCmd.Flags().String("instruments-file", "", "Instrument reference data file (YAML or CSV)")
+	startCmd.Flags().String("corporate-actions-file", "", "Corporate actions file (YAML or CSV) applied at startup")
+	startCmd.Flags().Float64("risk-free-rate", 0, "Default annual risk-free rate for risk metrics, e.g. 0.04")
+	startCmd.Flags().Float64("option-volatility", 0.25, "Default annual volatility for option valuation")
 
 	_ = viper.BindPFlag("api.host", startCmd.Flags().Lookup("host"))
 	_ = viper.BindPFlag("api.port", startCmd.Flags().Lookup("port"))
@@ -112,6 +165,10 @@ func init() {
 	_ = viper.BindPFlag("api.write-timeout", startCmd.Flags().Lookup("write-timeout"))
 	_ = viper.BindPFlag("api.idle-timeout", startCmd.Flags().Lookup("idle-timeout"))
 	_ = viper.BindPFlag("api.max-header-bytes", startCmd.Flags().Lookup("max-header-bytes"))
+	_ = viper.BindPFlag("portfolio.instruments-file", startCmd.Flags().Lookup("instruments-file"))
+	_ = viper.BindPFlag("portfolio.corporate-actions-file", startCmd.Flags().Lookup("corporate-actions-file"))
+	_ = viper.BindPFlag("portfolio.risk-free-rate", startCmd.Flags().Lookup("risk-free-rate"))
+	_ = viper.BindPFlag("portfolio.option-volatility", startCmd.Flags().Lookup("option-volatility"))
 
 	viper.SetDefault("api.host", "localhost")
 	viper.SetDefault("api.port", 8000)
@@ -20,15 +20,1907 @@ var PortfolioSummarySchema = Type("PortfolioSummary", func() { // Type "Portfoli
 	Required("balance", "currency", "change_percent")
 })
 
+var PortfolioReturnsSchema = Type("PortfolioReturns", func() {
+	Description("Time-weighted and money-weighted returns over a period. Returns are decimal fractions (0.05 = 5%).")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("period", String, "Requested period")
+	Attribute("account", String, "Account the returns were limited to")
+	Attribute("start", String, "First day of the period", func() { Format(FormatDate) })
+	Attribute("end", String, "Last day of the period", func() { Format(FormatDate) })
+	Attribute("start_value", Float64, "Portfolio value at the close before the period")
+	Attribute("end_value", Float64, "Portfolio value at the close of the last day")
+	Attribute("net_contributions", Float64, "Deposits less withdrawals during the period")
+	Attribute("gain", Float64, "Change in value not explained by contributions")
+	Attribute("time_weighted_return", Float64, "Chain-linked time-weighted return over the period")
+	Attribute("annualized_time_weighted_return", Float64, "Annualized time-weighted return, only for periods of at least one year")
+	Attribute("money_weighted_return", Float64, "Money-weighted return (XIRR), annualized only for periods of at least one year")
+
+	Required("portfolio_id", "period", "start", "end", "start_value", "end_value", "net_contributions", "gain", "time_weighted_return")
+})
+
+var BenchmarkComponentSchema = Type("BenchmarkComponent", func() {
+	Attribute("symbol", String, "Instrument symbol priced through the market data provider")
+	Attribute("weight", Float64, "Target weight as a decimal fraction", func() { Minimum(0) })
+	Required("symbol", "weight")
+})
+
+var BenchmarkDefinitionSchema = Type("BenchmarkDefinition", func() {
+	Description("Benchmark expressed as a weighted blend of instruments, e.g. 60% SPY / 40% AGG.")
+	Attribute("name", String, "Display name")
+	Attribute("components", ArrayOf(BenchmarkComponentSchema), "Blend components; weights must sum to 1")
+	Attribute("blend", String, "Blend in the form 60% SPY / 40% AGG; set either components or blend", func() {
+		Example("60% SPY / 40% AGG")
+	})
+	Attribute("rebalance", String, "How often the blend is reset to its weights; none keeps static initial weights", func() {
+		Enum("none", "daily", "monthly", "quarterly", "annual")
+		Default("none")
+	})
+	Required("name", "rebalance")
+})
+
+var BenchmarkComparisonPointSchema = Type("BenchmarkComparisonPoint", func() {
+	Attribute("date", String, "Trading day", func() { Format(FormatDate) })
+	Attribute("portfolio", Float64, "Portfolio cumulative return")
+	Attribute("benchmark", Float64, "Benchmark cumulative return")
+	Required("date", "portfolio", "benchmark")
+})
+
+var BenchmarkComparisonSchema = Type("BenchmarkComparison", func() {
+	Description("Portfolio versus benchmark performance. Returns are decimal fractions.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("benchmark", BenchmarkDefinitionSchema, "Benchmark measured against")
+	Attribute("start", String, "First day of the period", func() { Format(FormatDate) })
+	Attribute("end", String, "Last day of the period", func() { Format(FormatDate) })
+	Attribute("portfolio_return", Float64, "Portfolio time-weighted return")
+	Attribute("benchmark_return", Float64, "Benchmark cumulative return")
+	Attribute("excess_return", Float64, "Portfolio return less benchmark return")
+	Attribute("tracking_error", Float64, "Annualized standard deviation of daily excess returns")
+	Attribute("series", ArrayOf(BenchmarkComparisonPointSchema), "Cumulative returns per trading day")
+	Required("portfolio_id", "benchmark", "start", "end", "portfolio_return", "benchmark_return", "excess_return", "tracking_error", "series")
+})
+
+var AllocationBucketSchema = Type("AllocationBucket", func() {
+	Attribute("key", String, "Bucket name, e.g. an asset class or sector")
+	Attribute("value", Float64, "Market value of the bucket")
+	Attribute("weight", Float64, "Share of total portfolio value as a decimal fraction")
+	Attribute("symbols", ArrayOf(String), "Symbols held in the bucket")
+	Required("key", "value", "weight", "symbols")
+})
+
+var AllocationSchema = Type("Allocation", func() {
+	Description("Holdings grouped by a classification dimension.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("dimension", String, "Dimension holdings are grouped by")
+	Attribute("tag", String, "Custom tag key when grouping by tag")
+	Attribute("account", String, "Account the holdings were limited to")
+	Attribute("as_of", String, "Valuation date", func() { Format(FormatDate) })
+	Attribute("currency", String, "Currency of the values")
+	Attribute("total_value", Float64, "Total portfolio value including cash")
+	Attribute("buckets", ArrayOf(AllocationBucketSchema), "Buckets ordered by value, largest first")
+	Required("portfolio_id", "dimension", "as_of", "currency", "total_value", "buckets")
+})
+
+var TargetWeightSchema = Type("TargetWeight", func() {
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("weight", Float64, "Target weight as a decimal fraction", func() {
+		Minimum(0)
+		Maximum(1)
+	})
+	Attribute("tolerance", Float64, "Absolute drift allowed either side of the target weight", func() {
+		Minimum(0)
+		Default(0.05)
+	})
+	Required("symbol", "weight")
+})
+
+var TargetAllocationSchema = Type("TargetAllocation", func() {
+	Description("Target allocation model of a portfolio. Weight not assigned to symbols is held as cash.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("targets", ArrayOf(TargetWeightSchema), "Target weights per symbol")
+	Attribute("cash_weight", Float64, "Implied target cash weight")
+	Required("portfolio_id", "targets", "cash_weight")
+})
+
+var ProposedTradeSchema = Type("ProposedTrade", func() {
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("side", String, "Trade direction", func() { Enum("buy", "sell") })
+	Attribute("quantity", Float64, "Quantity, rounded to the instrument lot size")
+	Attribute("price", Float64, "Assumed execution price")
+	Attribute("value", Float64, "Trade value")
+	Attribute("current_weight", Float64, "Weight before the trade")
+	Attribute("target_weight", Float64, "Target weight")
+	Attribute("projected_weight", Float64, "Weight after the trade")
+	Required("symbol", "side", "quantity", "price", "value", "current_weight", "target_weight", "projected_weight")
+})
+
+var RebalanceProposalSchema = Type("RebalanceProposal", func() {
+	Description("Reviewable list of trades that bring drifted positions back within their bands. Nothing is executed.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("as_of", String, "Pricing date", func() { Format(FormatDate) })
+	Attribute("cash_before", Float64, "Cash on hand before the trades")
+	Attribute("cash_after", Float64, "Projected cash after the trades")
+	Attribute("trades", ArrayOf(ProposedTradeSchema), "Proposed trades, sells first")
+	Attribute("warnings", ArrayOf(String), "Constraints that prevented a full rebalance")
+	Required("portfolio_id", "as_of", "cash_before", "cash_after", "trades", "warnings")
+})
+
+var RiskWindowSchema = Type("RiskWindow", func() {
+	Description("Risk metrics over one rolling window.")
+	Attribute("start", String, "Base day of the window", func() { Format(FormatDate) })
+	Attribute("end", String, "Last day of the window", func() { Format(FormatDate) })
+	Attribute("volatility", Float64, "Annualized volatility of daily returns")
+	Attribute("sharpe_ratio", Float64, "Annualized excess return over volatility")
+	Attribute("sortino_ratio", Float64, "Annualized excess return over downside deviation")
+	Attribute("beta", Float64, "Beta against the benchmark")
+	Attribute("correlation", Float64, "Correlation of daily returns with the benchmark")
+	Attribute("max_drawdown", Float64, "Largest peak-to-trough decline as a positive fraction")
+	Required("start", "end", "volatility", "sharpe_ratio", "sortino_ratio", "max_drawdown")
+})
+
+var RiskMetricsSchema = Type("RiskMetrics", func() {
+	Description("Risk statistics of daily portfolio returns. Ratios and rates are decimal fractions.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("benchmark", BenchmarkDefinitionSchema, "Benchmark beta and correlation are measured against")
+	Attribute("start", String, "First day of the period", func() { Format(FormatDate) })
+	Attribute("end", String, "Last day of the period", func() { Format(FormatDate) })
+	Attribute("risk_free_rate", Float64, "Annual risk-free rate used for Sharpe and Sortino")
+	Attribute("volatility", Float64, "Annualized volatility of daily returns")
+	Attribute("sharpe_ratio", Float64, "Annualized excess return over volatility")
+	Attribute("sortino_ratio", Float64, "Annualized excess return over downside deviation")
+	Attribute("beta", Float64, "Beta against the benchmark")
+	Attribute("correlation", Float64, "Correlation of daily returns with the benchmark")
+	Attribute("max_drawdown", Float64, "Largest peak-to-trough decline as a positive fraction")
+	Attribute("max_drawdown_peak", String, "Day of the peak before the largest decline", func() { Format(FormatDate) })
+	Attribute("max_drawdown_trough", String, "Day of the trough of the largest decline", func() { Format(FormatDate) })
+	Attribute("rolling", ArrayOf(RiskWindowSchema), "Metrics per rolling window when a window is requested")
+	Required("portfolio_id", "start", "end", "risk_free_rate", "volatility", "sharpe_ratio", "sortino_ratio", "max_drawdown", "rolling")
+})
+
+var VaRContributionSchema = Type("VaRContribution", func() {
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("value", Float64, "Current market value of the position")
+	Attribute("marginal_var", Float64, "Change in VaR per unit of value added to the position")
+	Attribute("component_var", Float64, "Share of portfolio VaR attributed to the position; components sum to the VaR")
+	Required("symbol", "value", "marginal_var", "component_var")
+})
+
+var ValueAtRiskSchema = Type("ValueAtRisk", func() {
+	Description("Loss not exceeded with the given confidence over the horizon, and the expected loss beyond it. Losses are positive amounts in the portfolio currency.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("as_of", String, "Valuation date", func() { Format(FormatDate) })
+	Attribute("method", String, "Estimation method")
+	Attribute("confidence", Float64, "Confidence level")
+	Attribute("horizon", Int, "Holding period in trading days")
+	Attribute("lookback", Int, "Trading days of price history used")
+	Attribute("portfolio_value", Float64, "Market value of the risky positions; cash is treated as riskless")
+	Attribute("value_at_risk", Float64, "Value-at-Risk")
+	Attribute("expected_shortfall", Float64, "Conditional VaR: the average loss beyond the VaR")
+	Attribute("contributions", ArrayOf(VaRContributionSchema), "Per-position VaR contributions")
+	Required("portfolio_id", "as_of", "method", "confidence", "horizon", "lookback", "portfolio_value", "value_at_risk", "expected_shortfall", "contributions")
+})
+
+var StressScenarioSchema = Type("StressScenario", func() {
+	Description("Named set of shocks. Shocks are decimal price changes, e.g. -0.4 for a 40% fall.")
+	Attribute("name", String, "Scenario name")
+	Attribute("description", String, "What the scenario represents")
+	Attribute("asset_classes", MapOf(String, Float64), "Shock per asset class")
+	Attribute("symbols", MapOf(String, Float64), "Shock per symbol, overriding asset class shocks")
+	Attribute("rate_shift", Float64, "Parallel change in yields, e.g. 0.02 for +200bp, applied through instrument duration")
+	Attribute("currencies", MapOf(String, Float64), "Move of each currency against all others")
+	Attribute("replay_start", String, "Start of a replayed historical window", func() { Format(FormatDate) })
+	Attribute("replay_end", String, "End of a replayed historical window", func() { Format(FormatDate) })
+	Required("name", "description", "rate_shift")
+})
+
+var StressImpactSchema = Type("StressImpact", func() {
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("asset_class", String, "Asset class of the instrument")
+	Attribute("value", Float64, "Current market value")
+	Attribute("projected_value", Float64, "Market value under the scenario")
+	Attribute("pnl", Float64, "Projected profit or loss")
+	Attribute("shock", Float64, "Total price change applied, including currency effects")
+	Required("symbol", "asset_class", "value", "projected_value", "pnl", "shock")
+})
+
+var StressTestResultSchema = Type("StressTestResult", func() {
+	Description("Projected effect of a stress scenario on current holdings. Nothing is changed.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("as_of", String, "Valuation date", func() { Format(FormatDate) })
+	Attribute("scenario", StressScenarioSchema, "Scenario applied")
+	Attribute("currency", String, "Currency of the values")
+	Attribute("current_value", Float64, "Portfolio value including cash")
+	Attribute("projected_value", Float64, "Portfolio value under the scenario")
+	Attribute("loss", Float64, "Current value less projected value")
+	Attribute("loss_percent", Float64, "Loss as a decimal fraction of current value")
+	Attribute("worst_contributors", ArrayOf(StressImpactSchema), "Positions ordered from the largest loss")
+	Attribute("warnings", ArrayOf(String), "Approximations made while applying the scenario")
+	Required("portfolio_id", "as_of", "scenario", "currency", "current_value", "projected_value", "loss", "loss_percent", "worst_contributors", "warnings")
+})
+
+var AssetClassAssumptionSchema = Type("AssetClassAssumption", func() {
+	Description("Capital market assumption for an asset class. Rates are annual decimal fractions.")
+	Attribute("asset_class", String, "Asset class, e.g. equity")
+	Attribute("weight", Float64, "Share of the portfolio; defaults to the current allocation", func() {
+		Minimum(0)
+		Maximum(1)
+	})
+	Attribute("expected_return", Float64, "Expected annual return")
+	Attribute("volatility", Float64, "Annual volatility", func() { Minimum(0) })
+	Required("asset_class")
+})
+
+var ProjectionBandSchema = Type("ProjectionBand", func() {
+	Description("Percentiles of the simulated portfolio value on a date, in today's money.")
+	Attribute("date", String, "Date", func() { Format(FormatDate) })
+	Attribute("p5", Float64, "5th percentile")
+	Attribute("p25", Float64, "25th percentile")
+	Attribute("p50", Float64, "Median")
+	Attribute("p75", Float64, "75th percentile")
+	Attribute("p95", Float64, "95th percentile")
+	Required("date", "p5", "p25", "p50", "p75", "p95")
+})
+
+var ProjectionSchema = Type("Projection", func() {
+	Description("Monte Carlo projection of future portfolio value. Values are in today's money.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("start", String, "First day of the projection", func() { Format(FormatDate) })
+	Attribute("end", String, "Last day of the projection", func() { Format(FormatDate) })
+	Attribute("start_value", Float64, "Value the projection starts from")
+	Attribute("paths", Int, "Number of simulated paths")
+	Attribute("assumptions", ArrayOf(AssetClassAssumptionSchema), "Assumptions used per asset class")
+	Attribute("bands", ArrayOf(ProjectionBandSchema), "Percentile bands at each anniversary and at the end")
+	Attribute("goal", Float64, "Goal amount")
+	Attribute("goal_date", String, "Date the goal should be reached by", func() { Format(FormatDate) })
+	Attribute("goal_probability", Float64, "Share of paths reaching the goal by the goal date")
+	Attribute("depletion_probability", Float64, "Share of paths that ran out of money")
+	Required("portfolio_id", "start", "end", "start_value", "paths", "assumptions", "bands", "depletion_probability")
+})
+
+// corporateActionAttributes declares the terms shared by corporate action
+// requests and results.
+func corporateActionAttributes() {
+	Attribute("date", String, "Effective date", func() { Format(FormatDate) })
+	Attribute("type", String, "Action type", func() {
+		Enum("split", "symbol_change", "spin_off", "merger")
+	})
+	Attribute("symbol", String, "Symbol affected")
+	Attribute("new_symbol", String, "Renamed, spun-off or acquiring symbol")
+	Attribute("ratio", Float64, "Resulting shares per share held, e.g. 1.5 for a 3-for-2 split or 0.1 for a 1-for-10 reverse split", func() {
+		Minimum(0)
+		Default(0)
+	})
+	Attribute("cash_per_share", Float64, "Cash paid per share held in a merger", func() {
+		Minimum(0)
+		Default(0)
+	})
+	Attribute("basis_fraction", Float64, "Share of cost basis moved to spun-off shares", func() {
+		Minimum(0)
+		Maximum(1)
+		Default(0)
+	})
+	Attribute("price", Float64, "Price of the resulting shares on the effective date, for cash in lieu and merger basis allocation", func() {
+		Minimum(0)
+		Default(0)
+	})
+	Attribute("cash_in_lieu", Boolean, "Pay fractional resulting shares in cash", func() { Default(false) })
+	Attribute("note", String, "Free-form note")
+}
+
+var CorporateActionInputSchema = Type("CorporateActionInput", func() {
+	Description("Corporate action to apply to every account holding the symbol on the effective date.")
+	corporateActionAttributes()
+	Required("date", "type", "symbol")
+})
+
+var CorporateActionSchema = Type("CorporateAction", func() {
+	Description("Corporate action recorded in the ledger.")
+	Attribute("id", String, "Ledger transaction ID")
+	corporateActionAttributes()
+	Required("id", "date", "type", "symbol")
+})
+
+var IncomeRecordSchema = Type("IncomeRecord", func() {
+	Description("Dividend or interest payment recorded in the ledger.")
+	Attribute("id", String, "Ledger transaction ID")
+	Attribute("type", String, "Income type", func() { Enum("dividend", "interest") })
+	Attribute("symbol", String, "Paying instrument; optional for interest")
+	Attribute("account", String, "Account credited")
+	Attribute("pay_date", String, "Pay date", func() { Format(FormatDate) })
+	Attribute("ex_date", String, "Ex-dividend date", func() { Format(FormatDate) })
+	Attribute("amount", Float64, "Gross amount")
+	Attribute("withholding", Float64, "Tax withheld at source")
+	Attribute("net", Float64, "Cash received after withholding")
+	Attribute("qualified", Boolean, "Dividend taxed at qualified rates")
+	Attribute("reinvestment_id", String, "Ledger ID of the purchase reinvesting the dividend")
+	Attribute("reinvested_quantity", Float64, "Shares bought by reinvestment")
+	Required("id", "type", "account", "pay_date", "amount", "withholding", "net", "qualified")
+})
+
+var IncomeBucketSchema = Type("IncomeBucket", func() {
+	Description("Income totals for a period, holding or currency.")
+	Attribute("key", String, "Period label, symbol or currency")
+	Attribute("gross", Float64, "Gross income")
+	Attribute("withholding", Float64, "Tax withheld at source")
+	Attribute("net", Float64, "Income after withholding")
+	Required("key", "gross", "withholding", "net")
+})
+
+var IncomeForecastSchema = Type("IncomeForecast", func() {
+	Description("Expected gross income for a month.")
+	Attribute("month", String, "Month as YYYY-MM")
+	Attribute("amount", Float64, "Expected gross income")
+	Required("month", "amount")
+})
+
+var IncomeSummarySchema = Type("IncomeSummary", func() {
+	Description("Dividend and interest income over a period with a forecast for the next 12 months.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("start", String, "First day of the period", func() { Format(FormatDate) })
+	Attribute("end", String, "Last day of the period", func() { Format(FormatDate) })
+	Attribute("currency", String, "Currency of the amounts")
+	Attribute("interval", String, "Length of the periods in by_period")
+	Attribute("gross", Float64, "Gross income")
+	Attribute("withholding", Float64, "Tax withheld at source")
+	Attribute("net", Float64, "Income after withholding")
+	Attribute("qualified", Float64, "Gross qualified dividends")
+	Attribute("ordinary", Float64, "Gross ordinary dividends and interest")
+	Attribute("by_period", ArrayOf(IncomeBucketSchema), "Income per period")
+	Attribute("by_holding", ArrayOf(IncomeBucketSchema), "Income per symbol; interest without a symbol is keyed cash")
+	Attribute("by_currency", ArrayOf(IncomeBucketSchema), "Income per instrument currency")
+	Attribute("forecast", ArrayOf(IncomeForecastSchema), "Expected income per month over the next 12 months")
+	Attribute("forecast_total", Float64, "Expected income over the next 12 months")
+	Required("portfolio_id", "start", "end", "currency", "interval", "gross", "withholding", "net", "qualified", "ordinary",
+		"by_period", "by_holding", "by_currency", "forecast", "forecast_total")
+})
+
+// bondTermAttributes declares the terms of a fixed-rate bond.
+func bondTermAttributes() {
+	Attribute("coupon", Float64, "Annual coupon rate as a decimal fraction", func() {
+		Minimum(0)
+		Default(0)
+	})
+	Attribute("coupon_frequency", Int, "Coupons per year; 0 for zero-coupon bonds", func() {
+		Enum(0, 1, 2, 4, 12)
+		Default(2)
+	})
+	Attribute("day_count", String, "Day count convention", func() {
+		Enum("30/360", "ACT/360", "ACT/365", "ACT/ACT")
+		Default("30/360")
+	})
+	Attribute("maturity", String, "Maturity date", func() { Format(FormatDate) })
+	Attribute("face_value", Float64, "Face value per bond", func() {
+		Minimum(0)
+		Default(100)
+	})
+}
+
+var BondAnalyticsSchema = Type("BondAnalytics", func() {
+	Description("Price measures of a bond. Prices and accrued interest are per bond.")
+	Attribute("settlement", String, "Settlement date", func() { Format(FormatDate) })
+	Attribute("clean_price", Float64, "Price excluding accrued interest")
+	Attribute("dirty_price", Float64, "Price including accrued interest")
+	Attribute("accrued_interest", Float64, "Coupon earned since the last coupon date")
+	Attribute("yield_to_maturity", Float64, "Annual yield compounded at the coupon frequency")
+	Attribute("macaulay_duration", Float64, "Macaulay duration in years")
+	Attribute("modified_duration", Float64, "Modified duration in years")
+	Required("settlement", "clean_price", "dirty_price", "accrued_interest", "yield_to_maturity", "macaulay_duration", "modified_duration")
+})
+
+var BondPositionSchema = Type("BondPosition", func() {
+	Description("Bond holding valued at its latest clean price.")
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("quantity", Float64, "Number of bonds held")
+	bondTermAttributes()
+	Attribute("analytics", BondAnalyticsSchema, "Price measures per bond")
+	Attribute("market_value", Float64, "Quantity times dirty price")
+	Attribute("accrued_value", Float64, "Quantity times accrued interest")
+	Required("symbol", "quantity", "coupon", "coupon_frequency", "day_count", "maturity", "face_value", "analytics", "market_value", "accrued_value")
+})
+
+var BondCashFlowSchema = Type("BondCashFlow", func() {
+	Description("Coupon and principal expected from a holding on a date.")
+	Attribute("date", String, "Payment date", func() { Format(FormatDate) })
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("coupon", Float64, "Coupon income")
+	Attribute("principal", Float64, "Principal repaid at maturity")
+	Attribute("total", Float64, "Coupon plus principal")
+	Required("date", "symbol", "coupon", "principal", "total")
+})
+
+var CashFlowLadderYearSchema = Type("CashFlowLadderYear", func() {
+	Description("Bond cash flows expected in a calendar year.")
+	Attribute("year", Int, "Calendar year")
+	Attribute("coupon", Float64, "Coupon income")
+	Attribute("principal", Float64, "Principal repaid")
+	Attribute("total", Float64, "Coupon plus principal")
+	Required("year", "coupon", "principal", "total")
+})
+
+var CashFlowLadderSchema = Type("CashFlowLadder", func() {
+	Description("Upcoming coupon and maturity cash flows of the bonds held.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("as_of", String, "First day of the ladder", func() { Format(FormatDate) })
+	Attribute("end", String, "Last day of the ladder", func() { Format(FormatDate) })
+	Attribute("currency", String, "Currency of the portfolio")
+	Attribute("flows", ArrayOf(BondCashFlowSchema), "Cash flows in date order")
+	Attribute("by_year", ArrayOf(CashFlowLadderYearSchema), "Cash flows per calendar year")
+	Attribute("total", Float64, "Sum of all cash flows")
+	Required("portfolio_id", "as_of", "end", "currency", "flows", "by_year", "total")
+})
+
+var OptionPositionSchema = Type("OptionPosition", func() {
+	Description("Option holding valued with Black-Scholes (Black-76 for options on futures). Greeks are for the whole position.")
+	Attribute("account", String, "Account holding the contracts")
+	Attribute("symbol", String, "Option symbol")
+	Attribute("underlying", String, "Underlying symbol")
+	Attribute("option_type", String, "Option type", func() { Enum("call", "put") })
+	Attribute("strike", Float64, "Strike price")
+	Attribute("expiry", String, "Expiry date", func() { Format(FormatDate) })
+	Attribute("multiplier", Float64, "Units of the underlying per contract")
+	Attribute("futures_option", Boolean, "Option on a futures contract")
+	Attribute("quantity", Float64, "Contracts held")
+	Attribute("underlying_price", Float64, "Latest underlying price")
+	Attribute("volatility", Float64, "Annual volatility input")
+	Attribute("price", Float64, "Model price per contract")
+	Attribute("market_value", Float64, "Model value of the position")
+	Attribute("delta", Float64, "Change in value per unit move of the underlying, in underlying units")
+	Attribute("gamma", Float64, "Change in delta per unit move of the underlying")
+	Attribute("theta", Float64, "Change in value per calendar day")
+	Attribute("vega", Float64, "Change in value per volatility point")
+	Required("account", "symbol", "underlying", "option_type", "strike", "expiry", "multiplier", "futures_option", "quantity",
+		"underlying_price", "volatility", "price", "market_value", "delta", "gamma", "theta", "vega")
+})
+
+var UnderlyingExposureSchema = Type("UnderlyingExposure", func() {
+	Description("Exposure to an underlying through direct holdings and option deltas.")
+	Attribute("underlying", String, "Underlying symbol")
+	Attribute("price", Float64, "Latest underlying price")
+	Attribute("shares", Float64, "Units of the underlying held directly")
+	Attribute("option_delta", Float64, "Delta of the options on the underlying, in underlying units")
+	Attribute("delta_adjusted_shares", Float64, "Shares plus option delta")
+	Attribute("delta_adjusted_exposure", Float64, "Delta-adjusted shares times price")
+	Required("underlying", "price", "shares", "option_delta", "delta_adjusted_shares", "delta_adjusted_exposure")
+})
+
+var OptionPositionsSchema = Type("OptionPositions", func() {
+	Description("Option holdings with greeks and delta-adjusted exposure per underlying.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("as_of", String, "Valuation date", func() { Format(FormatDate) })
+	Attribute("currency", String, "Currency of the values")
+	Attribute("positions", ArrayOf(OptionPositionSchema), "Option positions by account and symbol")
+	Attribute("exposures", ArrayOf(UnderlyingExposureSchema), "Exposure per underlying of the options held")
+	Required("portfolio_id", "as_of", "currency", "positions", "exposures")
+})
+
+var OptionSettlementSchema = Type("OptionSettlement", func() {
+	Description("Ledger transaction posted when an option expired, was exercised or assigned.")
+	Attribute("id", String, "Ledger transaction ID")
+	Attribute("date", String, "Expiry date", func() { Format(FormatDate) })
+	Attribute("account", String, "Account")
+	Attribute("symbol", String, "Option or underlying symbol")
+	Attribute("type", String, "Transaction type", func() { Enum("buy", "sell") })
+	Attribute("quantity", Float64, "Quantity")
+	Attribute("price", Float64, "Price")
+	Attribute("note", String, "What happened")
+	Required("id", "date", "account", "symbol", "type", "quantity", "price", "note")
+})
+
+// marginTermAttributes declares the margin requirements and financing rates of
+// an account.
+func marginTermAttributes() {
+	Attribute("initial_requirement", Float64, "Equity required to open positions, as a share of their value", func() {
+		Minimum(0)
+		Maximum(1)
+		Default(0.5)
+	})
+	Attribute("maintenance_requirement", Float64, "Equity required to keep long positions", func() {
+		Minimum(0)
+		Maximum(1)
+		Default(0.25)
+	})
+	Attribute("short_maintenance_requirement", Float64, "Equity required to keep short positions", func() {
+		Minimum(0)
+		Maximum(1)
+		Default(0.3)
+	})
+	Attribute("loan_rate", Float64, "Annual interest rate on the margin loan", func() {
+		Minimum(0)
+		Default(0)
+	})
+	Attribute("borrow_rate", Float64, "Annual fee for borrowing shares held short", func() {
+		Minimum(0)
+		Default(0)
+	})
+	Attribute("borrow_rates", MapOf(String, Float64), "Borrow fee rates by symbol overriding borrow_rate")
+}
+
+var MarginTermsSchema = Type("MarginTerms", func() {
+	Description("Margin requirements and financing rates of a margin account.")
+	Attribute("account", String, "Account")
+	marginTermAttributes()
+	Required("account", "initial_requirement", "maintenance_requirement", "short_maintenance_requirement", "loan_rate", "borrow_rate")
+})
+
+var MarginAccountStatusSchema = Type("MarginAccountStatus", func() {
+	Description("Margin position of an account.")
+	Attribute("account", String, "Account")
+	Attribute("cash", Float64, "Cash balance; negative when borrowing")
+	Attribute("long_value", Float64, "Market value of long positions")
+	Attribute("short_value", Float64, "Market value of short positions")
+	Attribute("short_proceeds", Float64, "Proceeds of the open short sales")
+	Attribute("equity", Float64, "Cash plus long value less short value")
+	Attribute("loan_balance", Float64, "Margin loan balance")
+	Attribute("initial_requirement", Float64, "Equity required by the initial requirement")
+	Attribute("maintenance_requirement", Float64, "Equity required by the maintenance requirements")
+	Attribute("maintenance_excess", Float64, "Equity above the maintenance requirement; negative under a margin call")
+	Attribute("buying_power", Float64, "Value of new positions the equity supports")
+	Attribute("margin_call", Boolean, "Equity is below the maintenance requirement")
+	Attribute("interest_charged", Float64, "Margin interest charged to date")
+	Attribute("borrow_fees_charged", Float64, "Stock borrow fees charged to date")
+	Attribute("accrued_interest", Float64, "Margin interest accrued this month, not yet charged")
+	Attribute("accrued_borrow_fees", Float64, "Borrow fees accrued this month, not yet charged")
+	Required("account", "cash", "long_value", "short_value", "short_proceeds", "equity", "loan_balance", "initial_requirement",
+		"maintenance_requirement", "maintenance_excess", "buying_power", "margin_call", "interest_charged", "borrow_fees_charged",
+		"accrued_interest", "accrued_borrow_fees")
+})
+
+var MarginStatusSchema = Type("MarginStatus", func() {
+	Description("Margin position of the margin accounts of a portfolio.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("as_of", String, "Valuation date", func() { Format(FormatDate) })
+	Attribute("currency", String, "Currency of the values")
+	Attribute("accounts", ArrayOf(MarginAccountStatusSchema), "Margin accounts by name")
+	Required("portfolio_id", "as_of", "currency", "accounts")
+})
+
+var PortfolioEventSchema = Type("PortfolioEvent", func() {
+	Description("Notable portfolio event such as a margin call.")
+	Attribute("id", Int, "Event sequence number")
+	Attribute("time", String, "When the event occurred", func() { Format(FormatDateTime) })
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("type", String, "Event type")
+	Attribute("account", String, "Account concerned, if any")
+	Attribute("message", String, "Description")
+	Required("id", "time", "portfolio_id", "type", "message")
+})
+
+var OrderSchema = Type("Order", func() {
+	Description("Paper trading order.")
+	Attribute("id", String, "Order ID")
+	Attribute("account", String, "Account trading; empty for the default account")
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("side", String, "Order side", func() { Enum("buy", "sell") })
+	Attribute("type", String, "Order type", func() { Enum("market", "limit", "stop", "stop_limit") })
+	Attribute("quantity", Float64, "Quantity")
+	Attribute("limit_price", Float64, "Limit price of limit and stop-limit orders")
+	Attribute("stop_price", Float64, "Stop price of stop and stop-limit orders")
+	Attribute("time_in_force", String, "How long the order works", func() { Enum("day", "gtc") })
+	Attribute("status", String, "Order status", func() { Enum("open", "filled", "cancelled", "expired", "rejected") })
+	Attribute("triggered", Boolean, "The stop price of a stop or stop-limit order traded")
+	Attribute("created", String, "When the order was placed", func() { Format(FormatDateTime) })
+	Attribute("updated", String, "When the status last changed", func() { Format(FormatDateTime) })
+	Attribute("fill_price", Float64, "Fill price including slippage; 0 until filled")
+	Attribute("commission", Float64, "Commission charged; 0 until filled")
+	Attribute("reason", String, "Why the order was rejected")
+	Required("id", "account", "symbol", "side", "type", "quantity", "time_in_force", "status", "triggered",
+		"created", "updated", "fill_price", "commission")
+})
+
+var TradeInputSchema = Type("TradeInput", func() {
+	Description("Hypothetical trade.")
+	Attribute("account", String, "Account trading; empty for the default account")
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("side", String, "Trade side", func() { Enum("buy", "sell") })
+	Attribute("quantity", Float64, "Quantity", func() { Minimum(0) })
+	Attribute("price", Float64, "Trade price; defaults to the latest price", func() { Minimum(0) })
+	Attribute("fee", Float64, "Commission", func() {
+		Minimum(0)
+		Default(0)
+	})
+	Required("symbol", "side", "quantity")
+})
+
+var AllocationChangeSchema = Type("AllocationChange", func() {
+	Description("Allocation bucket before and after hypothetical trades.")
+	Attribute("key", String, "Bucket key")
+	Attribute("value_before", Float64, "Market value before")
+	Attribute("weight_before", Float64, "Share of portfolio value before")
+	Attribute("value_after", Float64, "Market value after")
+	Attribute("weight_after", Float64, "Share of portfolio value after")
+	Required("key", "value_before", "weight_before", "value_after", "weight_after")
+})
+
+var RealizedLotSchema = Type("RealizedLot", func() {
+	Description("Part of a tax lot a hypothetical sale would relieve.")
+	Attribute("account", String, "Account")
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("lot_id", String, "Ledger transaction that opened the lot")
+	Attribute("acquired", String, "Acquisition date", func() { Format(FormatDate) })
+	Attribute("quantity", Float64, "Quantity relieved")
+	Attribute("proceeds", Float64, "Net proceeds")
+	Attribute("cost_basis", Float64, "Cost basis relieved")
+	Attribute("gain", Float64, "Realized gain; negative for losses")
+	Attribute("long_term", Boolean, "Held long enough for long-term treatment")
+	Required("account", "symbol", "lot_id", "acquired", "quantity", "proceeds", "cost_basis", "gain", "long_term")
+})
+
+var HoldingsRiskSchema = Type("HoldingsRisk", func() {
+	Description("Ex-ante risk of holdings from the daily returns of their instruments.")
+	Attribute("volatility", Float64, "Annualized volatility as a share of portfolio value")
+	Attribute("value_at_risk", Float64, "One-day parametric value at risk")
+	Attribute("expected_shortfall", Float64, "One-day expected shortfall beyond the value at risk")
+	Required("volatility", "value_at_risk", "expected_shortfall")
+})
+
+var TradePreviewSchema = Type("TradePreview", func() {
+	Description("Effect of hypothetical trades on a portfolio. Nothing is persisted.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("as_of", String, "Valuation date", func() { Format(FormatDate) })
+	Attribute("currency", String, "Currency of the values")
+	Attribute("before", PortfolioSummarySchema, "Summary before the trades")
+	Attribute("after", PortfolioSummarySchema, "Summary after the trades")
+	Attribute("cash_before", Float64, "Cash before the trades")
+	Attribute("cash_after", Float64, "Cash after the trades")
+	Attribute("dimension", String, "Allocation dimension")
+	Attribute("allocation", ArrayOf(AllocationChangeSchema), "Allocation buckets before and after, by key")
+	Attribute("realized", ArrayOf(RealizedLotSchema), "Lots the sales would relieve")
+	Attribute("short_term_gain", Float64, "Net short-term realized gain")
+	Attribute("long_term_gain", Float64, "Net long-term realized gain")
+	Attribute("estimated_tax", Float64, "Tax on net realized gains at the given rates; 0 for net losses")
+	Attribute("risk_before", HoldingsRiskSchema, "Risk before the trades")
+	Attribute("risk_after", HoldingsRiskSchema, "Risk after the trades")
+	Attribute("warnings", ArrayOf(String), "Issues such as negative cash")
+	Required("portfolio_id", "as_of", "currency", "before", "after", "cash_before", "cash_after", "dimension", "allocation",
+		"realized", "short_term_gain", "long_term_gain", "estimated_tax", "risk_before", "risk_after", "warnings")
+})
+
+var BacktestTargetSchema = Type("BacktestTarget", func() {
+	Description("Target weight of a symbol in a backtested strategy.")
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("weight", Float64, "Target weight", func() {
+		Minimum(0)
+		Maximum(1)
+	})
+	Required("symbol", "weight")
+})
+
+var BacktestPointSchema = Type("BacktestPoint", func() {
+	Description("Value of a backtested strategy at the close of a trading day.")
+	Attribute("date", String, "Trading day", func() { Format(FormatDate) })
+	Attribute("value", Float64, "Cash plus market value")
+	Attribute("flow", Float64, "External cash flow on the day")
+	Attribute("drawdown", Float64, "Decline of the time-weighted index from its running peak, as a positive fraction")
+	Required("date", "value", "flow", "drawdown")
+})
+
+var BacktestResultSchema = Type("BacktestResult", func() {
+	Description("Outcome of replaying a strategy over historical prices.")
+	Attribute("name", String, "Strategy name")
+	Attribute("strategy", String, "Strategy kind")
+	Attribute("start", String, "First trading day", func() { Format(FormatDate) })
+	Attribute("end", String, "Last trading day", func() { Format(FormatDate) })
+	Attribute("currency", String, "Currency of the values")
+	Attribute("end_value", Float64, "Value at the end")
+	Attribute("net_contributions", Float64, "Initial cash plus contributions")
+	Attribute("gain", Float64, "Change in value not explained by contributions")
+	Attribute("time_weighted_return", Float64, "Cumulative time-weighted return")
+	Attribute("annualized_return", Float64, "Annualized time-weighted return, for periods of at least one year")
+	Attribute("money_weighted_return", Float64, "Money-weighted return (XIRR)")
+	Attribute("volatility", Float64, "Annualized volatility of daily returns")
+	Attribute("sharpe_ratio", Float64, "Annualized Sharpe ratio")
+	Attribute("max_drawdown", Float64, "Largest peak-to-trough decline as a positive fraction")
+	Attribute("max_drawdown_peak", String, "Date of the peak before the largest decline", func() { Format(FormatDate) })
+	Attribute("max_drawdown_trough", String, "Date of the trough of the largest decline", func() { Format(FormatDate) })
+	Attribute("trades", Int, "Number of trades")
+	Attribute("traded_value", Float64, "Value bought plus sold")
+	Attribute("fees", Float64, "Commission paid")
+	Attribute("turnover", Float64, "Lesser of the value bought and sold divided by the average value; investing deposited cash is not counted")
+	Attribute("equity_curve", ArrayOf(BacktestPointSchema), "Daily values, starting with the base day before start")
+	Required("name", "strategy", "start", "end", "currency", "end_value", "net_contributions", "gain", "time_weighted_return",
+		"volatility", "sharpe_ratio", "max_drawdown", "trades", "traded_value", "fees", "turnover", "equity_curve")
+})
+
+var LedgerTransactionSchema = Type("LedgerTransaction", func() {
+	Description("Cash or trade transaction in a portfolio ledger.")
+	Attribute("id", String, "Transaction identifier, once posted")
+	Attribute("date", String, "Transaction date", func() { Format(FormatDate) })
+	Attribute("type", String, "Transaction type", func() {
+		Enum("deposit", "withdrawal", "buy", "sell", "dividend", "interest", "fee", "margin_interest", "borrow_fee")
+	})
+	Attribute("account", String, "Account; empty for the default account")
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("quantity", Float64, "Quantity traded")
+	Attribute("price", Float64, "Price per unit")
+	Attribute("amount", Float64, "Cash amount of deposits, withdrawals, income and fees")
+	Attribute("fee", Float64, "Commission")
+	Attribute("note", String, "Description")
+	Required("date", "type", "account", "symbol", "quantity", "price", "amount", "fee")
+})
+
+var ImportRowSchema = Type("ImportRow", func() {
+	Description("Outcome of importing a statement row.")
+	Attribute("line", Int, "Line of the row in the file")
+	Attribute("status", String, "Row outcome", func() {
+		Enum("imported", "duplicate", "skipped", "error")
+	})
+	Attribute("fingerprint", String, "Stable fingerprint of the transaction used to detect duplicates")
+	Attribute("transaction", LedgerTransactionSchema, "Transaction read from the row")
+	Attribute("error", String, "Why the row could not be read or posted, or was skipped")
+	Required("line", "status")
+})
+
+var PositionCheckSchema = Type("PositionCheck", func() {
+	Description("Quantity of a symbol in a statement compared with the ledger.")
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("statement_quantity", Float64, "Quantity in the statement")
+	Attribute("ledger_quantity", Float64, "Quantity in the ledger")
+	Attribute("difference", Float64, "Statement less ledger quantity")
+	Attribute("matched", Boolean, "Whether the quantities agree")
+	Required("symbol", "statement_quantity", "ledger_quantity", "difference", "matched")
+})
+
+var AccountReconciliationSchema = Type("AccountReconciliation", func() {
+	Description("Positions and cash of a statement account compared with the ledger after an import.")
+	Attribute("account", String, "Ledger account")
+	Attribute("as_of", String, "Statement date", func() { Format(FormatDate) })
+	Attribute("positions", ArrayOf(PositionCheckSchema), "Positions by symbol, including ledger holdings the statement does not list")
+	Attribute("statement_cash", Float64, "Cash balance in the statement")
+	Attribute("ledger_cash", Float64, "Cash in the ledger account")
+	Attribute("matched", Boolean, "Whether every position and the cash agree")
+	Required("account", "as_of", "positions", "ledger_cash", "matched")
+})
+
+var ImportReportSchema = Type("ImportReport", func() {
+	Description("Report of a statement import.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("format", String, "Statement format")
+	Attribute("profile", String, "Mapping profile used")
+	Attribute("dry_run", Boolean, "Whether the import was only previewed")
+	Attribute("imported", Int, "Rows posted, or that would be posted in a dry run")
+	Attribute("duplicates", Int, "Rows already in the ledger")
+	Attribute("skipped", Int, "Rows ignored by the profile")
+	Attribute("errors", Int, "Rows that could not be read or posted")
+	Attribute("rows", ArrayOf(ImportRowSchema), "Outcome of every row in file order")
+	Attribute("reconciliation", ArrayOf(AccountReconciliationSchema), "Statement positions and cash compared with the ledger after the import")
+	Required("portfolio_id", "format", "dry_run", "imported", "duplicates", "skipped", "errors", "rows")
+})
+
+var ExportFileSchema = Type("ExportFile", func() {
+	Description("File downloaded from a portfolio export.")
+	Attribute("content_disposition", String, "Attachment header naming the file")
+	Attribute("content", String, "File content")
+	Required("content_disposition", "content")
+})
+
+var ArchiveImportSchema = Type("ArchiveImport", func() {
+	Description("Outcome of a portfolio archive import.")
+	Attribute("portfolio_id", String, "Portfolio created or replaced")
+	Attribute("schema_version", Int, "Schema version the archive was written in")
+	Attribute("migrated", Boolean, "Whether the archive was migrated from an older schema version")
+	Attribute("replaced", Boolean, "Whether an existing portfolio was replaced")
+	Attribute("created_at", String, "When the archive was created", func() { Format(FormatDateTime) })
+	Attribute("transactions", Int, "Ledger transactions imported")
+	Attribute("instruments", Int, "Instruments added or updated")
+	Attribute("targets", Int, "Target weights imported")
+	Attribute("accounts", Int, "Registered accounts imported")
+	Attribute("margin_accounts", Int, "Margin accounts imported")
+	Required("portfolio_id", "schema_version", "migrated", "replaced", "created_at", "transactions", "instruments", "targets", "accounts", "margin_accounts")
+})
+
+var ReconciliationBreakSchema = Type("ReconciliationBreak", func() {
+	Description("Difference between the ledger and a broker position snapshot, with the entries that would clear it.")
+	Attribute("id", String, "Break ID")
+	Attribute("account", String, "Ledger account; empty for the default account")
+	Attribute("as_of", String, "Snapshot date", func() { Format(FormatDate) })
+	Attribute("kind", String, "Break kind", func() {
+		Enum("quantity_mismatch", "missing_in_ledger", "missing_in_statement", "cash_difference")
+	})
+	Attribute("symbol", String, "Instrument symbol; absent for cash differences")
+	Attribute("statement", Float64, "Quantity, or cash balance, in the snapshot")
+	Attribute("ledger", Float64, "Quantity, or cash balance, in the ledger")
+	Attribute("difference", Float64, "Snapshot less ledger")
+	Attribute("price", Float64, "Price valuing the suggested position adjustments")
+	Attribute("adjustments", ArrayOf(LedgerTransactionSchema), "Suggested adjusting entries; empty when no price is known for a position")
+	Attribute("status", String, "Review status", func() { Enum("open", "resolved", "ignored") })
+	Attribute("note", String, "Why the status was set")
+	Attribute("detected", String, "When the break was first found", func() { Format(FormatDateTime) })
+	Attribute("updated", String, "When the break last changed", func() { Format(FormatDateTime) })
+	Attribute("posted", ArrayOf(String), "IDs of the adjustments posted to resolve the break")
+	Required("id", "account", "as_of", "kind", "statement", "ledger", "difference", "adjustments", "status", "detected", "updated")
+})
+
+var ReconciledAccountSchema = Type("ReconciledAccount", func() {
+	Description("Account and date checked by a reconciliation run.")
+	Attribute("account", String, "Ledger account; empty for the default account")
+	Attribute("as_of", String, "Snapshot date", func() { Format(FormatDate) })
+	Attribute("positions", Int, "Positions in the snapshot")
+	Attribute("cash", Boolean, "Whether the snapshot has a cash balance")
+	Attribute("breaks", Int, "Breaks found")
+	Required("account", "as_of", "positions", "cash", "breaks")
+})
+
+var ReconciliationSchema = Type("Reconciliation", func() {
+	Description("Outcome of reconciling a portfolio ledger against a broker position snapshot.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("format", String, "Snapshot format")
+	Attribute("accounts", ArrayOf(ReconciledAccountSchema), "Accounts and dates checked")
+	Attribute("opened", Int, "Breaks found for the first time, or again after being resolved")
+	Attribute("updated", Int, "Open or ignored breaks found again")
+	Attribute("resolved", Int, "Open breaks of the checked accounts and dates that now match")
+	Attribute("breaks", ArrayOf(ReconciliationBreakSchema), "Breaks found by this run")
+	Required("portfolio_id", "format", "accounts", "opened", "updated", "resolved", "breaks")
+})
+
+var TaxLotGainSchema = Type("TaxLotGain", func() {
+	Description("Realized gain or loss on part of a tax lot.")
+	Attribute("account", String, "Account; empty for the default account")
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("lot_id", String, "Ledger transaction that opened the lot")
+	Attribute("sale_id", String, "Ledger transaction that closed the lot")
+	Attribute("acquired", String, "Acquisition date; the short sale date for covered shorts", func() { Format(FormatDate) })
+	Attribute("disposed", String, "Disposal date", func() { Format(FormatDate) })
+	Attribute("quantity", Float64, "Quantity disposed")
+	Attribute("proceeds", Float64, "Net proceeds")
+	Attribute("cost_basis", Float64, "Cost basis relieved, including basis_adjustment")
+	Attribute("basis_adjustment", Float64, "Loss deferred into these shares by earlier wash sales")
+	Attribute("wash_sale_adjustment", Float64, "Loss disallowed by the wash-sale rule and added back")
+	Attribute("gain", Float64, "Reportable gain; negative for losses")
+	Attribute("term", String, "Holding period, counted from the sold shares' holding period for wash sale replacements", func() { Enum("short", "long") })
+	Attribute("short_sale", Boolean, "Whether the disposal covered a short sale")
+	Required("account", "symbol", "lot_id", "sale_id", "acquired", "disposed", "quantity", "proceeds", "cost_basis",
+		"basis_adjustment", "wash_sale_adjustment", "gain", "term", "short_sale")
+})
+
+var WashSaleSchema = Type("WashSale", func() {
+	Description("Loss disallowed because substantially identical shares were bought within 30 days before or after the sale, and deferred into the replacement shares unless they are held in a tax-advantaged account.")
+	Attribute("sale_id", String, "Ledger transaction of the loss sale")
+	Attribute("lot_id", String, "Lot sold at a loss")
+	Attribute("account", String, "Account of the sale; empty for the default account")
+	Attribute("symbol", String, "Instrument sold")
+	Attribute("disposed", String, "Sale date", func() { Format(FormatDate) })
+	Attribute("quantity", Float64, "Shares sold matched with replacement shares")
+	Attribute("disallowed", Float64, "Loss disallowed")
+	Attribute("replacement_lot_id", String, "Lot of the replacement shares")
+	Attribute("replacement_account", String, "Account of the replacement shares")
+	Attribute("replacement_symbol", String, "Instrument of the replacement shares")
+	Attribute("replacement_acquired", String, "Purchase date of the replacement shares", func() { Format(FormatDate) })
+	Attribute("permanent", Boolean, "The replacement shares are in a tax-advantaged account, so the loss is lost rather than deferred")
+	Required("sale_id", "lot_id", "account", "symbol", "disposed", "quantity", "disallowed",
+		"replacement_lot_id", "replacement_account", "replacement_symbol", "replacement_acquired", "permanent")
+})
+
+var TaxTotalsSchema = Type("TaxTotals", func() {
+	Description("Totals of realized gains.")
+	Attribute("proceeds", Float64, "Net proceeds")
+	Attribute("cost_basis", Float64, "Cost basis relieved")
+	Attribute("wash_sale_adjustment", Float64, "Losses disallowed by the wash-sale rule")
+	Attribute("gain", Float64, "Reportable gain")
+	Required("proceeds", "cost_basis", "wash_sale_adjustment", "gain")
+})
+
+var TaxAccountSchema = Type("TaxAccount", func() {
+	Description("Realized gains of one account.")
+	Attribute("account", String, "Account; empty for the default account")
+	Attribute("lots", ArrayOf(TaxLotGainSchema), "Lots disposed, by disposal date")
+	Attribute("short_term", TaxTotalsSchema, "Short-term totals")
+	Attribute("long_term", TaxTotalsSchema, "Long-term totals")
+	Attribute("total", TaxTotalsSchema, "Totals")
+	Required("account", "lots", "short_term", "long_term", "total")
+})
+
+var TaxReportSchema = Type("TaxReport", func() {
+	Description("Capital gains report of a year, per lot and grouped by account.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("year", Int, "Tax year")
+	Attribute("currency", String, "Currency of the amounts")
+	Attribute("accounts", ArrayOf(TaxAccountSchema), "Accounts with disposals in the year")
+	Attribute("short_term", TaxTotalsSchema, "Short-term totals")
+	Attribute("long_term", TaxTotalsSchema, "Long-term totals")
+	Attribute("total", TaxTotalsSchema, "Totals")
+	Attribute("wash_sales", ArrayOf(WashSaleSchema), "Wash sales of the losses realized in the year")
+	Required("portfolio_id", "year", "currency", "accounts", "short_term", "long_term", "total", "wash_sales")
+})
+
+var TaxLotSchema = Type("TaxLot", func() {
+	Description("Open tax lot with the losses deferred into it by wash sales.")
+	Attribute("id", String, "Ledger transaction that opened the lot")
+	Attribute("account", String, "Account; empty for the default account")
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("acquired", String, "Acquisition date; the short sale date for short lots", func() { Format(FormatDate) })
+	Attribute("holding_since", String, "Start of the holding period of the oldest shares, earlier than acquired for wash sale replacement shares", func() { Format(FormatDate) })
+	Attribute("quantity", Float64, "Quantity held; negative for short lots")
+	Attribute("cost_basis", Float64, "Cost basis, including basis_adjustment; minus the net proceeds for short lots")
+	Attribute("basis_adjustment", Float64, "Losses deferred into the lot by wash sales")
+	Attribute("unit_cost", Float64, "Cost basis per unit")
+	Attribute("term", String, "Holding period of the oldest shares today", func() { Enum("short", "long") })
+	Attribute("price", Float64, "Latest close, when known")
+	Attribute("market_value", Float64, "Quantity at the latest close, when known")
+	Attribute("unrealized_gain", Float64, "Market value less cost basis, when known")
+	Required("id", "account", "symbol", "acquired", "holding_since", "quantity", "cost_basis", "basis_adjustment", "unit_cost", "term")
+})
+
+var HarvestOpportunitySchema = Type("HarvestOpportunity", func() {
+	Description("Suggested sale of lots at a loss, paired with a replacement that is not substantially identical.")
+	Attribute("account", String, "Account; empty for the default account")
+	Attribute("symbol", String, "Instrument to sell")
+	Attribute("lots", ArrayOf(String), "Lots the sale relieves, first in, first out")
+	Attribute("quantity", Float64, "Quantity to sell")
+	Attribute("price", Float64, "Latest close")
+	Attribute("cost_basis", Float64, "Cost basis of the lots, including wash sale adjustments")
+	Attribute("market_value", Float64, "Value of the lots at the latest close")
+	Attribute("loss", Float64, "Net loss realized by the sale")
+	Attribute("short_term_loss", Float64, "Net short-term loss; negative for a net gain")
+	Attribute("long_term_loss", Float64, "Net long-term loss; negative for a net gain")
+	Attribute("estimated_savings", Float64, "Tax saved on the losses at the marginal rates")
+	Attribute("replacement", String, "First eligible replacement from the configured mapping, if any")
+	Attribute("replacement_price", Float64, "Latest close of the replacement, when known")
+	Attribute("replacement_quantity", Float64, "Quantity of the replacement the sale proceeds buy, when its price is known")
+	Attribute("repurchase_after", String, "First day the sold security can be bought back without a wash sale", func() { Format(FormatDate) })
+	Required("account", "symbol", "lots", "quantity", "price", "cost_basis", "market_value", "loss",
+		"short_term_loss", "long_term_loss", "estimated_savings", "repurchase_after")
+})
+
+var SkippedHarvestSchema = Type("SkippedHarvest", func() {
+	Description("Unrealized loss above the threshold that is not suggested for harvest.")
+	Attribute("account", String, "Account; empty for the default account")
+	Attribute("symbol", String, "Instrument symbol")
+	Attribute("loss", Float64, "Net loss a sale would realize")
+	Attribute("reason", String, "Why the loss is not harvested")
+	Required("account", "symbol", "loss", "reason")
+})
+
+var TaxLossHarvestSchema = Type("TaxLossHarvest", func() {
+	Description("Tax-loss harvesting opportunities of a portfolio.")
+	Attribute("portfolio_id", String, "Portfolio identifier")
+	Attribute("as_of", String, "Date of the prices", func() { Format(FormatDate) })
+	Attribute("opportunities", ArrayOf(HarvestOpportunitySchema), "Suggested harvests, largest saving first")
+	Attribute("skipped", ArrayOf(SkippedHarvestSchema), "Losses skipped to avoid wash sales")
+	Attribute("total_loss", Float64, "Losses realized by all suggested harvests")
+	Attribute("estimated_savings", Float64, "Tax saved by all suggested harvests")
+	Required("portfolio_id", "as_of", "opportunities", "skipped", "total_loss", "estimated_savings")
+})
+
+var AccountSchema = Type("Account", func() {
+	Description("Account of a portfolio with its tax treatment and balances in the portfolio currency.")
+	Attribute("id", String, "Account identifier transactions refer to; empty for the default account")
+	Attribute("name", String, "Display name")
+	Attribute("type", String, "Account type", func() {
+		Enum("taxable", "ira", "roth_ira", "401k", "roth_401k", "hsa", "other")
+	})
+	Attribute("tax_treatment", String, "How gains and income of the account are taxed", func() {
+		Enum("taxable", "tax_deferred", "tax_exempt")
+	})
+	Attribute("custodian", String, "Broker or institution holding the account")
+	Attribute("currency", String, "Base currency of the account")
+	Attribute("registered", Boolean, "Set explicitly; otherwise implied by transactions as a taxable account")
+	Attribute("cash", Float64, "Cash balance")
+	Attribute("holdings_value", Float64, "Market value of the positions")
+	Attribute("total_value", Float64, "Cash plus holdings value")
+	Required("id", "name", "type", "tax_treatment", "currency", "registered", "cash", "holdings_value", "total_value")
+})
+
+// portfolioIDAttribute declares the portfolio selector shared by portfolio-scoped methods.
+func portfolioIDAttribute() {
+	Attribute("portfolio_id", String, "Portfolio identifier", func() { Default("default") })
+}
+
+// taxYearAttribute declares the calendar year of tax reports.
+func taxYearAttribute() {
+	Attribute("year", Int, "Tax year", func() { Minimum(1900) })
+}
+
+// periodAttributes declares the reporting period shared by performance methods.
+func periodAttributes() {
+	Attribute("period", String, "Reporting period", func() {
+		Enum("1D", "MTD", "QTD", "YTD", "1Y", "inception", "custom")
+		Default("inception")
+	})
+	Attribute("start", String, "Start date for custom periods", func() { Format(FormatDate) })
+	Attribute("end", String, "End date for custom periods (defaults to today)", func() { Format(FormatDate) })
+}
+
+// periodParams maps periodAttributes to query string parameters.
+func periodParams() {
+	Param("period")
+	Param("start")
+	Param("end")
+}
+
 // Match zodios API defined in zod schema file ts/src/schema/portfolio.ts as baseline. Security schema, Error schema, and HTTP schema are revised here. Benefit of converting zod schema to Goa DSL is that it can be used to generate client and server stubs together with future MCP extensions.
 var _ = Service("portfolio", func() {
 	Description("Portfolio API")
 	Error("unauthorized", String, "Missing or invalid token")
 	Error("not_found", String, "Portfolio not found for user")
+	Error("bad_request", String, "Invalid request parameters")
+	HTTP(func() {
+		Response("unauthorized", StatusUnauthorized)
+		Response("not_found", StatusNotFound)
+		Response("bad_request", StatusBadRequest)
+	})
 	Method("getPortfolioSummary", func() {
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("account", String, "Only this account")
+		})
 		Result(PortfolioSummarySchema)
 		HTTP(func() {
 			GET("/portfolio/summary")
+			Param("portfolio_id")
+			Param("account")
+			Response(StatusOK)
+		})
+	})
+	Method("getReturns", func() {
+		Description("Compute time-weighted and money-weighted returns so deposits and withdrawals do not look like performance.")
+		Payload(func() {
+			portfolioIDAttribute()
+			periodAttributes()
+			Attribute("account", String, "Only this account")
+		})
+		Result(PortfolioReturnsSchema)
+		HTTP(func() {
+			GET("/portfolio/returns")
+			Param("portfolio_id")
+			periodParams()
+			Param("account")
+			Response(StatusOK)
+		})
+	})
+	Method("getAllocation", func() {
+		Description("Break holdings down by asset class, sector, country, region, currency, account or custom tag.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("dimension", String, "Grouping dimension", func() {
+				Enum("asset_class", "sector", "country", "region", "currency", "account", "tag")
+				Default("asset_class")
+			})
+			Attribute("tag", String, "Custom tag key, required when dimension is tag")
+			Attribute("account", String, "Only holdings of this account")
+		})
+		Result(AllocationSchema)
+		HTTP(func() {
+			GET("/portfolio/allocation")
+			Param("portfolio_id")
+			Param("dimension")
+			Param("tag")
+			Param("account")
+			Response(StatusOK)
+		})
+	})
+	Method("getTargetAllocation", func() {
+		Description("Return the target allocation model of the portfolio.")
+		Payload(func() {
+			portfolioIDAttribute()
+		})
+		Result(TargetAllocationSchema)
+		HTTP(func() {
+			GET("/portfolio/targets")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("setTargetAllocation", func() {
+		Description("Replace the target weights and tolerance bands of the portfolio.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("targets", ArrayOf(TargetWeightSchema), "Target weights per symbol")
+			Required("targets")
+		})
+		Result(TargetAllocationSchema)
+		HTTP(func() {
+			PUT("/portfolio/targets")
+			Param("portfolio_id")
+			Body("targets")
+			Response(StatusOK)
+		})
+	})
+	Method("proposeRebalance", func() {
+		Description("Propose the trades needed to bring positions back within their tolerance bands.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("min_trade_value", Float64, "Drop trades worth less than this amount", func() {
+				Minimum(0)
+				Default(0)
+			})
+			Attribute("avoid_short_term_gains", Boolean, "Only sell lots that are long-term or at a loss", func() { Default(false) })
+		})
+		Result(RebalanceProposalSchema)
+		HTTP(func() {
+			POST("/portfolio/rebalance")
+			Param("portfolio_id")
+			Body(func() {
+				Attribute("min_trade_value")
+				Attribute("avoid_short_term_gains")
+			})
+			Response(StatusOK)
+		})
+	})
+	Method("setBenchmark", func() {
+		Description("Define the benchmark the portfolio is measured against.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("benchmark", BenchmarkDefinitionSchema, "Benchmark definition")
+			Required("benchmark")
+		})
+		Result(BenchmarkDefinitionSchema)
+		HTTP(func() {
+			PUT("/portfolio/benchmark")
+			Param("portfolio_id")
+			Body("benchmark")
+			Response(StatusOK)
+		})
+	})
+	Method("getBenchmarkComparison", func() {
+		Description("Compare portfolio cumulative returns against its benchmark over a period.")
+		Payload(func() {
+			portfolioIDAttribute()
+			periodAttributes()
+		})
+		Result(BenchmarkComparisonSchema)
+		HTTP(func() {
+			GET("/portfolio/benchmark/comparison")
+			Param("portfolio_id")
+			periodParams()
+			Response(StatusOK)
+		})
+	})
+	Method("getRiskMetrics", func() {
+		Description("Measure volatility, Sharpe and Sortino ratios, beta and correlation against the benchmark and maximum drawdown, optionally over rolling windows.")
+		Payload(func() {
+			portfolioIDAttribute()
+			periodAttributes()
+			Attribute("risk_free_rate", Float64, "Annual risk-free rate; defaults to the configured rate")
+			Attribute("window", Int, "Rolling window length in trading days", func() { Minimum(2) })
+		})
+		Result(RiskMetricsSchema)
+		HTTP(func() {
+			GET("/portfolio/risk")
+			Param("portfolio_id")
+			periodParams()
+			Param("risk_free_rate")
+			Param("window")
+			Response(StatusOK)
+		})
+	})
+	Method("getValueAtRisk", func() {
+		Description("Estimate Value-at-Risk and expected shortfall by historical simulation, variance-covariance or Monte Carlo from locally stored price history.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("method", String, "Estimation method", func() {
+				Enum("historical", "parametric", "monte_carlo")
+				Default("historical")
+			})
+			Attribute("confidence", Float64, "Confidence level", func() {
+				Minimum(0.5)
+				Maximum(0.9999)
+				Default(0.95)
+			})
+			Attribute("horizon", Int, "Holding period in trading days", func() {
+				Minimum(1)
+				Default(1)
+			})
+			Attribute("lookback", Int, "Trading days of price history to use", func() {
+				Minimum(20)
+				Default(252)
+			})
+			Attribute("simulations", Int, "Number of Monte Carlo paths", func() {
+				Minimum(100)
+				Maximum(1000000)
+				Default(10000)
+			})
+			Attribute("seed", Int64, "Monte Carlo seed for reproducible results")
+		})
+		Result(ValueAtRiskSchema)
+		HTTP(func() {
+			GET("/portfolio/var")
+			Param("portfolio_id")
+			Param("method")
+			Param("confidence")
+			Param("horizon")
+			Param("lookback")
+			Param("simulations")
+			Param("seed")
+			Response(StatusOK)
+		})
+	})
+	Method("listStressScenarios", func() {
+		Description("List the built-in and configured stress scenarios.")
+		Result(ArrayOf(StressScenarioSchema))
+		HTTP(func() {
+			GET("/portfolio/stress/scenarios")
+			Response(StatusOK)
+		})
+	})
+	Method("runStressTest", func() {
+		Description("Apply a stress scenario to current holdings and report the projected value, loss and worst contributors.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("scenario", String, "Scenario name")
+			Attribute("top", Int, "Number of worst contributors to return", func() {
+				Minimum(1)
+				Default(5)
+			})
+			Required("scenario")
+		})
+		Result(StressTestResultSchema)
+		HTTP(func() {
+			GET("/portfolio/stress")
+			Param("portfolio_id")
+			Param("scenario")
+			Param("top")
+			Response(StatusOK)
+		})
+	})
+	Method("projectPortfolio", func() {
+		Description("Simulate future paths of the portfolio with contributions, withdrawals and inflation, and estimate the probability of reaching a goal.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("end", String, "Last day of the projection", func() { Format(FormatDate) })
+			Attribute("start_value", Float64, "Starting value; defaults to the current portfolio value", func() { Minimum(0) })
+			Attribute("monthly_contribution", Float64, "Monthly contribution in today's money", func() {
+				Minimum(0)
+				Default(0)
+			})
+			Attribute("monthly_withdrawal", Float64, "Monthly withdrawal in today's money", func() {
+				Minimum(0)
+				Default(0)
+			})
+			Attribute("withdrawal_start", String, "Date withdrawals begin, e.g. retirement; defaults to today", func() { Format(FormatDate) })
+			Attribute("inflation", Float64, "Annual inflation rate", func() { Default(0.02) })
+			Attribute("goal", Float64, "Goal amount in today's money", func() { Minimum(0) })
+			Attribute("goal_date", String, "Date the goal should be reached by; defaults to the end", func() { Format(FormatDate) })
+			Attribute("assumptions", ArrayOf(AssetClassAssumptionSchema), "Assumptions overriding the defaults per asset class")
+			Attribute("paths", Int, "Number of simulated paths", func() {
+				Minimum(1)
+				Maximum(100000)
+				Default(5000)
+			})
+			Attribute("seed", Int64, "Seed for reproducible results")
+			Required("end")
+		})
+		Result(ProjectionSchema)
+		HTTP(func() {
+			POST("/portfolio/projection")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("listCorporateActions", func() {
+		Description("List the corporate actions recorded in the portfolio ledger.")
+		Payload(func() {
+			portfolioIDAttribute()
+		})
+		Result(ArrayOf(CorporateActionSchema))
+		HTTP(func() {
+			GET("/portfolio/corporate-actions")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("applyCorporateActions", func() {
+		Description("Record splits, reverse splits, spin-offs, mergers and symbol changes in the ledger. Holdings and lots are adjusted from the effective date.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("actions", ArrayOf(CorporateActionInputSchema), "Actions to apply")
+			Required("actions")
+		})
+		Result(ArrayOf(CorporateActionSchema))
+		HTTP(func() {
+			POST("/portfolio/corporate-actions")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("recordIncome", func() {
+		Description("Record a dividend or interest payment. Dividends can be reinvested at the pay-date price, creating a new lot.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("type", String, "Income type", func() {
+				Enum("dividend", "interest")
+				Default("dividend")
+			})
+			Attribute("symbol", String, "Paying instrument; required for dividends")
+			Attribute("account", String, "Account credited", func() { Default("") })
+			Attribute("pay_date", String, "Pay date", func() { Format(FormatDate) })
+			Attribute("ex_date", String, "Ex-dividend date", func() { Format(FormatDate) })
+			Attribute("amount", Float64, "Gross amount", func() { Minimum(0) })
+			Attribute("withholding", Float64, "Tax withheld at source", func() {
+				Minimum(0)
+				Default(0)
+			})
+			Attribute("qualified", Boolean, "Dividend taxed at qualified rates", func() { Default(false) })
+			Attribute("reinvest", Boolean, "Reinvest the net dividend in the paying instrument", func() { Default(false) })
+			Attribute("price", Float64, "Reinvestment price; defaults to the pay-date close", func() { Minimum(0) })
+			Attribute("note", String, "Free-form note")
+			Required("pay_date", "amount")
+		})
+		Result(IncomeRecordSchema)
+		HTTP(func() {
+			POST("/portfolio/income")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("getIncome", func() {
+		Description("Summarize dividend and interest income by period, holding and currency, with the expected income of the next 12 months.")
+		Payload(func() {
+			portfolioIDAttribute()
+			periodAttributes()
+			Attribute("interval", String, "Length of the periods income is grouped by", func() {
+				Enum("month", "quarter", "year")
+				Default("month")
+			})
+		})
+		Result(IncomeSummarySchema)
+		HTTP(func() {
+			GET("/portfolio/income")
+			Param("portfolio_id")
+			periodParams()
+			Param("interval")
+			Response(StatusOK)
+		})
+	})
+	Method("priceBond", func() {
+		Description("Compute clean and dirty price, accrued interest, yield to maturity and duration of a bond from a clean price or a yield.")
+		Payload(func() {
+			bondTermAttributes()
+			Attribute("settlement", String, "Settlement date; defaults to today", func() { Format(FormatDate) })
+			Attribute("clean_price", Float64, "Clean price per bond", func() { Minimum(0) })
+			Attribute("yield", Float64, "Yield to maturity")
+			Required("maturity")
+		})
+		Result(BondAnalyticsSchema)
+		HTTP(func() {
+			POST("/portfolio/bonds/price")
+			Response(StatusOK)
+		})
+	})
+	Method("listBondPositions", func() {
+		Description("Value the bonds held before maturity at their latest clean price with accrued interest, yield and duration.")
+		Payload(func() {
+			portfolioIDAttribute()
+		})
+		Result(ArrayOf(BondPositionSchema))
+		HTTP(func() {
+			GET("/portfolio/bonds")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("getCashFlowLadder", func() {
+		Description("List the upcoming coupon and principal payments of the bonds held, by date and by year.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("years", Int, "Number of years ahead to include", func() {
+				Minimum(1)
+				Maximum(50)
+				Default(10)
+			})
+		})
+		Result(CashFlowLadderSchema)
+		HTTP(func() {
+			GET("/portfolio/bonds/ladder")
+			Param("portfolio_id")
+			Param("years")
+			Response(StatusOK)
+		})
+	})
+	Method("listOptionPositions", func() {
+		Description("Value option holdings with Black-Scholes or Black-76 and return their greeks and the delta-adjusted exposure per underlying. Expired options not yet settled are valued at their intrinsic value.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("volatility", Float64, "Annual volatility for every underlying; defaults to the configured inputs", func() { Minimum(0) })
+		})
+		Result(OptionPositionsSchema)
+		HTTP(func() {
+			GET("/portfolio/options")
+			Param("portfolio_id")
+			Param("volatility")
+			Response(StatusOK)
+		})
+	})
+	Method("settleOptionExpiries", func() {
+		Description("Close expired options: out-of-the-money options expire worthless and in-the-money options are exercised or assigned.")
+		Payload(func() {
+			portfolioIDAttribute()
+		})
+		Result(ArrayOf(OptionSettlementSchema))
+		HTTP(func() {
+			POST("/portfolio/options/settle")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("setMarginAccount", func() {
+		Description("Make an account a margin account, allowing a negative cash balance and short positions, with its requirements and financing rates.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("account", String, "Account")
+			marginTermAttributes()
+			Required("account")
+		})
+		Result(MarginTermsSchema)
+		HTTP(func() {
+			PUT("/portfolio/margin/accounts")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("getMarginStatus", func() {
+		Description("Return the equity, loan balance, maintenance excess and buying power of each margin account, with interest and borrow fees not yet charged as accrued.")
+		Payload(func() {
+			portfolioIDAttribute()
+		})
+		Result(MarginStatusSchema)
+		HTTP(func() {
+			GET("/portfolio/margin")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("chargeMargin", func() {
+		Description("Charge the margin interest and borrow fees of completed months to the margin accounts, then return their status.")
+		Payload(func() {
+			portfolioIDAttribute()
+		})
+		Result(MarginStatusSchema)
+		HTTP(func() {
+			POST("/portfolio/margin/charge")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("listEvents", func() {
+		Description("List recent portfolio events, oldest first.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("since", String, "Only events after this time", func() { Format(FormatDateTime) })
+			Attribute("type", String, "Only events of this type")
+		})
+		Result(ArrayOf(PortfolioEventSchema))
+		HTTP(func() {
+			GET("/portfolio/events")
+			Param("portfolio_id")
+			Param("since")
+			Param("type")
+			Response(StatusOK)
+		})
+	})
+	Method("placeOrder", func() {
+		Description("Place a paper trading order. Orders are filled by the matching simulator against market ticks, with the configured slippage and commission, and fills post to the ledger. Status changes are listed as order_status events.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("account", String, "Account trading; defaults to the default account")
+			Attribute("symbol", String, "Instrument symbol")
+			Attribute("side", String, "Order side", func() { Enum("buy", "sell") })
+			Attribute("type", String, "Order type", func() {
+				Enum("market", "limit", "stop", "stop_limit")
+				Default("market")
+			})
+			Attribute("quantity", Float64, "Quantity", func() { Minimum(0) })
+			Attribute("limit_price", Float64, "Limit price of limit and stop-limit orders", func() { Minimum(0) })
+			Attribute("stop_price", Float64, "Stop price of stop and stop-limit orders", func() { Minimum(0) })
+			Attribute("time_in_force", String, "How long the order works", func() {
+				Enum("day", "gtc")
+				Default("day")
+			})
+			Required("symbol", "side", "quantity")
+		})
+		Result(OrderSchema)
+		HTTP(func() {
+			POST("/portfolio/orders")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("listOrders", func() {
+		Description("List the paper trading orders of a portfolio, oldest first.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("status", String, "Only orders with this status", func() {
+				Enum("open", "filled", "cancelled", "expired", "rejected")
+			})
+		})
+		Result(ArrayOf(OrderSchema))
+		HTTP(func() {
+			GET("/portfolio/orders")
+			Param("portfolio_id")
+			Param("status")
+			Response(StatusOK)
+		})
+	})
+	Method("cancelOrder", func() {
+		Description("Cancel an open paper trading order.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("id", String, "Order ID")
+			Required("id")
+		})
+		Result(OrderSchema)
+		HTTP(func() {
+			POST("/portfolio/orders/cancel")
+			Param("portfolio_id")
+			Param("id")
+			Response(StatusOK)
+		})
+	})
+	Method("previewTrades", func() {
+		Description("Show the effect of hypothetical buys and sells on the summary, allocation, realized gains and tax, and risk of a portfolio without persisting anything.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("trades", ArrayOf(TradeInputSchema), "Hypothetical trades", func() { MinLength(1) })
+			Attribute("dimension", String, "Allocation dimension", func() {
+				Enum("asset_class", "sector", "country", "region", "currency", "account")
+				Default("asset_class")
+			})
+			Attribute("short_term_tax_rate", Float64, "Tax rate on net short-term gains", func() {
+				Minimum(0)
+				Maximum(1)
+				Default(0.24)
+			})
+			Attribute("long_term_tax_rate", Float64, "Tax rate on net long-term gains", func() {
+				Minimum(0)
+				Maximum(1)
+				Default(0.15)
+			})
+			Attribute("lookback", Int, "Trading days of returns used for risk", func() {
+				Minimum(2)
+				Default(252)
+			})
+			Required("trades")
+		})
+		Result(TradePreviewSchema)
+		HTTP(func() {
+			POST("/portfolio/trades/preview")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("runBacktest", func() {
+		Description("Replay stored historical prices through an allocation strategy (buy-and-hold, periodic or threshold rebalancing, or dollar-cost averaging) and report the equity curve, returns, drawdown and turnover.")
+		Payload(func() {
+			Attribute("name", String, "Strategy name", func() { Default("") })
+			Attribute("strategy", String, "Strategy kind", func() {
+				Enum("buy_and_hold", "periodic_rebalance", "threshold_rebalance", "dca")
+			})
+			Attribute("start", String, "First day", func() { Format(FormatDate) })
+			Attribute("end", String, "Last day", func() { Format(FormatDate) })
+			Attribute("currency", String, "Currency of the simulated portfolio", func() { Default("USD") })
+			Attribute("initial_cash", Float64, "Cash invested on the first day", func() {
+				Minimum(0)
+				Default(0)
+			})
+			Attribute("targets", ArrayOf(BacktestTargetSchema), "Target weights", func() { MinLength(1) })
+			Attribute("frequency", String, "Rebalancing or contribution frequency", func() {
+				Enum("monthly", "quarterly", "annual")
+				Default("monthly")
+			})
+			Attribute("threshold", Float64, "Drift from target weight that triggers a threshold rebalance", func() {
+				Minimum(0)
+				Default(0)
+			})
+			Attribute("contribution", Float64, "Amount invested every period by dca", func() {
+				Minimum(0)
+				Default(0)
+			})
+			Attribute("fee_rate", Float64, "Commission as a share of traded value", func() {
+				Minimum(0)
+				Default(0)
+			})
+			Required("strategy", "start", "end", "targets")
+		})
+		Result(BacktestResultSchema)
+		HTTP(func() {
+			POST("/portfolio/backtest")
+			Response(StatusOK)
+		})
+	})
+	Method("importCsv", func() {
+		Description("Import a broker CSV statement into a portfolio ledger with a named mapping profile. Rows already in the ledger are skipped as duplicates and rows that cannot be read are reported rather than failing the import; a dry run reports the outcome without posting anything.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("profile", String, "Mapping profile name", func() { Default("generic") })
+			Attribute("account", String, "Account for rows without one", func() { Default("") })
+			Attribute("dry_run", Boolean, "Preview the import without posting", func() { Default(false) })
+			Attribute("content", String, "CSV statement", func() { MinLength(1) })
+			Required("content")
+		})
+		Result(ImportReportSchema)
+		HTTP(func() {
+			POST("/portfolio/import/csv")
+			Param("portfolio_id")
+			Param("profile")
+			Param("account")
+			Param("dry_run")
+			Response(StatusOK)
+		})
+	})
+	Method("importOfx", func() {
+		Description("Import an OFX 1.x (SGML) or 2.x (XML) bank or investment statement, including Quicken QFX files, into a portfolio ledger and reconcile the positions and cash of the statement against the ledger afterwards. Rows already in the ledger are skipped as duplicates; a dry run reports the outcome without posting anything.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("account", String, "Account to book to; defaults to the account number in the statement", func() { Default("") })
+			Attribute("dry_run", Boolean, "Preview the import without posting", func() { Default(false) })
+			Attribute("content", String, "OFX or QFX statement", func() { MinLength(1) })
+			Required("content")
+		})
+		Result(ImportReportSchema)
+		HTTP(func() {
+			POST("/portfolio/import/ofx")
+			Param("portfolio_id")
+			Param("account")
+			Param("dry_run")
+			Response(StatusOK)
+		})
+	})
+	Method("exportJournal", func() {
+		Description("Export the ledger of a portfolio as a Beancount or hledger journal for download: lots at cost with their acquisition dates, sales relieving the lots they disposed of, commodity declarations and month-end prices of the instruments held.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("format", String, "Journal format", func() {
+				Enum("beancount", "hledger")
+				Default("beancount")
+			})
+		})
+		Result(ExportFileSchema)
+		HTTP(func() {
+			GET("/portfolio/export/journal")
+			Param("portfolio_id")
+			Param("format")
+			Response(StatusOK, func() {
+				Header("content_disposition:Content-Disposition")
+				Body("content")
+				ContentType("text/plain")
+			})
+		})
+	})
+	Method("importJournal", func() {
+		Description("Import the transactions of a Beancount or hledger journal into a portfolio ledger. Journals exported by exportJournal read back exactly; other transactions are read from their postings under the root account. Transactions already in the ledger are skipped as duplicates; a dry run reports the outcome without posting anything.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("account", String, "Account for transactions booked to the root account itself", func() { Default("") })
+			Attribute("root", String, "Parent account of the portfolio cash and positions", func() { Default("Assets:Portfolio") })
+			Attribute("dry_run", Boolean, "Preview the import without posting", func() { Default(false) })
+			Attribute("content", String, "Beancount or hledger journal", func() { MinLength(1) })
+			Required("content")
+		})
+		Result(ImportReportSchema)
+		HTTP(func() {
+			POST("/portfolio/import/journal")
+			Param("portfolio_id")
+			Param("account")
+			Param("root")
+			Param("dry_run")
+			Response(StatusOK)
+		})
+	})
+	Method("exportArchive", func() {
+		Description("Export a portfolio as a versioned JSON archive for download, to move it to another environment: its metadata, ledger, the instruments it refers to, target weights and settings, with the schema version and a checksum.")
+		Payload(func() {
+			portfolioIDAttribute()
+		})
+		Result(ExportFileSchema)
+		HTTP(func() {
+			GET("/portfolio/export/archive")
+			Param("portfolio_id")
+			Response(StatusOK, func() {
+				Header("content_disposition:Content-Disposition")
+				Body("content")
+				ContentType("text/plain")
+			})
+		})
+	})
+	Method("importArchive", func() {
+		Description("Import a portfolio archive written by exportArchive, migrating archives of older schema versions forward. Archives that fail their checksum or come from a newer schema version are refused. An existing portfolio is only overwritten when replace is set.")
+		Payload(func() {
+			Attribute("portfolio_id", String, "Portfolio to import as; defaults to the portfolio in the archive")
+			Attribute("replace", Boolean, "Replace an existing portfolio of the same ID", func() { Default(false) })
+			Attribute("content", String, "Portfolio archive", func() { MinLength(1) })
+			Required("content")
+		})
+		Result(ArchiveImportSchema)
+		HTTP(func() {
+			POST("/portfolio/import/archive")
+			Param("portfolio_id")
+			Param("replace")
+			Response(StatusOK)
+		})
+	})
+	Method("reconcilePositions", func() {
+		Description("Reconcile the holdings derived from a portfolio ledger against a broker position snapshot, a CSV position file read with a mapping profile or an OFX statement, per account and date. Breaks (quantity mismatches, symbols missing on either side and cash differences) are tracked with suggested adjusting entries: a break found again is updated rather than duplicated, and open breaks of the checked accounts and dates that now match are resolved.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("format", String, "Snapshot format", func() {
+				Enum("csv", "ofx")
+				Default("csv")
+			})
+			Attribute("profile", String, "Mapping profile naming the CSV columns", func() { Default("generic") })
+			Attribute("account", String, "Account for CSV rows without one; for OFX, instead of the statement account number", func() { Default("") })
+			Attribute("as_of", String, "Date of CSV rows without one, and of OFX statements without a date; defaults to today", func() { Format(FormatDate) })
+			Attribute("content", String, "Position snapshot", func() { MinLength(1) })
+			Required("content")
+		})
+		Result(ReconciliationSchema)
+		HTTP(func() {
+			POST("/portfolio/reconcile")
+			Param("portfolio_id")
+			Param("format")
+			Param("profile")
+			Param("account")
+			Param("as_of")
+			Response(StatusOK)
+		})
+	})
+	Method("listReconciliationBreaks", func() {
+		Description("List the reconciliation breaks of a portfolio in the order they were found.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("status", String, "Only breaks with this status", func() { Enum("open", "resolved", "ignored") })
+			Attribute("account", String, "Only breaks of this account")
+		})
+		Result(ArrayOf(ReconciliationBreakSchema))
+		HTTP(func() {
+			GET("/portfolio/reconcile/breaks")
+			Param("portfolio_id")
+			Param("status")
+			Param("account")
+			Response(StatusOK)
+		})
+	})
+	Method("updateReconciliationBreak", func() {
+		Description("Set the status of a reconciliation break. Resolving an open break with apply posts its suggested adjustments to the ledger. Resolved breaks can only be reopened.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("id", String, "Break ID")
+			Attribute("status", String, "New status", func() { Enum("open", "resolved", "ignored") })
+			Attribute("note", String, "Why the status is set")
+			Attribute("apply", Boolean, "Post the suggested adjustments when resolving", func() { Default(false) })
+			Required("id", "status")
+		})
+		Result(ReconciliationBreakSchema)
+		HTTP(func() {
+			POST("/portfolio/reconcile/breaks/status")
+			Param("portfolio_id")
+			Param("id")
+			Param("status")
+			Param("note")
+			Param("apply")
+			Response(StatusOK)
+		})
+	})
+	Method("getTaxReport", func() {
+		Description("Report the capital gains realized in a calendar year, one line per lot disposed with its acquisition and disposal dates, proceeds, cost basis, short-term or long-term classification and wash-sale adjustment, grouped by account.")
+		Payload(func() {
+			portfolioIDAttribute()
+			taxYearAttribute()
+			Required("year")
+		})
+		Result(TaxReportSchema)
+		HTTP(func() {
+			GET("/portfolio/tax")
+			Param("portfolio_id")
+			Param("year")
+			Response(StatusOK)
+		})
+	})
+	Method("exportTaxReport", func() {
+		Description("Export the capital gains report of a calendar year for download, as CSV with one row per lot disposed or as JSON shaped like getTaxReport.")
+		Payload(func() {
+			portfolioIDAttribute()
+			taxYearAttribute()
+			Attribute("format", String, "File format", func() {
+				Enum("csv", "json")
+				Default("csv")
+			})
+			Required("year")
+		})
+		Result(ExportFileSchema)
+		HTTP(func() {
+			GET("/portfolio/export/tax")
+			Param("portfolio_id")
+			Param("year")
+			Param("format")
+			Response(StatusOK, func() {
+				Header("content_disposition:Content-Disposition")
+				Body("content")
+				ContentType("text/plain")
+			})
+		})
+	})
+	Method("listLots", func() {
+		Description("List the open tax lots of a portfolio in acquisition order, with the losses deferred into them by wash sales and their unrealized gains.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("account", String, "Only lots of this account")
+			Attribute("symbol", String, "Only lots of this instrument")
+		})
+		Result(ArrayOf(TaxLotSchema))
+		HTTP(func() {
+			GET("/portfolio/lots")
+			Param("portfolio_id")
+			Param("account")
+			Param("symbol")
+			Response(StatusOK)
+		})
+	})
+	Method("findTaxLossHarvests", func() {
+		Description("Scan the open lots of a portfolio for unrealized losses above a threshold and suggest sales paired with replacements that are not substantially identical, avoiding wash-sale windows, with the tax saved at the given marginal rates.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("account", String, "Only lots of this account")
+			Attribute("min_loss", Float64, "Smallest net loss worth harvesting in an account and symbol", func() {
+				Minimum(0)
+				Default(0)
+			})
+			Attribute("short_term_rate", Float64, "Marginal tax rate on short-term gains as a decimal fraction", func() {
+				Minimum(0)
+				Maximum(1)
+			})
+			Attribute("long_term_rate", Float64, "Marginal tax rate on long-term gains as a decimal fraction", func() {
+				Minimum(0)
+				Maximum(1)
+			})
+			Required("short_term_rate", "long_term_rate")
+		})
+		Result(TaxLossHarvestSchema)
+		HTTP(func() {
+			GET("/portfolio/tax/harvest")
+			Param("portfolio_id")
+			Param("account")
+			Param("min_loss")
+			Param("short_term_rate")
+			Param("long_term_rate")
+			Response(StatusOK)
+		})
+	})
+	Method("listAccounts", func() {
+		Description("List the accounts of a portfolio, registered or referred to by transactions, with their tax treatment and balances.")
+		Payload(func() {
+			portfolioIDAttribute()
+		})
+		Result(ArrayOf(AccountSchema))
+		HTTP(func() {
+			GET("/portfolio/accounts")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("setAccount", func() {
+		Description("Register or update an account of a portfolio with its type, tax treatment, custodian and base currency. The tax treatment defaults to that of the type.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("id", String, "Account identifier transactions refer to; empty for the default account")
+			Attribute("name", String, "Display name; defaults to the identifier")
+			Attribute("type", String, "Account type", func() {
+				Enum("taxable", "ira", "roth_ira", "401k", "roth_401k", "hsa", "other")
+				Default("taxable")
+			})
+			Attribute("tax_treatment", String, "How gains and income are taxed; defaults to the treatment of the type", func() {
+				Enum("taxable", "tax_deferred", "tax_exempt")
+			})
+			Attribute("custodian", String, "Broker or institution holding the account")
+			Attribute("currency", String, "Base currency; must be the portfolio currency, which it defaults to")
+			Required("id")
+		})
+		Result(AccountSchema)
+		HTTP(func() {
+			PUT("/portfolio/accounts")
+			Param("portfolio_id")
+			Response(StatusOK)
+		})
+	})
+	Method("removeAccount", func() {
+		Description("Remove a registered account that no transaction refers to and return it.")
+		Payload(func() {
+			portfolioIDAttribute()
+			Attribute("id", String, "Account identifier")
+			Required("id")
+		})
+		Result(AccountSchema)
+		HTTP(func() {
+			POST("/portfolio/accounts/remove")
+			Param("portfolio_id")
+			Param("id")
 			Response(StatusOK)
 		})
 	})
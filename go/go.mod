@@ -8,6 +8,7 @@ require (
 	github.com/spf13/viper v1.21.0
 	goa.design/clue v1.2.4
 	goa.design/goa/v3 v3.24.2
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -51,7 +52,6 @@ require (
 	golang.org/x/text v0.33.0 // indirect
 	golang.org/x/tools v0.41.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 replace github.com/reidlai/ta-workspace/apps/go-server => ../../../apps/go-server
@@ -24,13 +24,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"portfolio get-portfolio-summary",
+		"portfolio (get-portfolio-summary|get-returns|get-allocation|get-target-allocation|set-target-allocation|propose-rebalance|set-benchmark|get-benchmark-comparison|get-risk-metrics|get-value-at-risk|list-stress-scenarios|run-stress-test|project-portfolio|list-corporate-actions|apply-corporate-actions|record-income|get-income|price-bond|list-bond-positions|get-cash-flow-ladder|list-option-positions|settle-option-expiries|set-margin-account|get-margin-status|charge-margin|list-events|place-order|list-orders|cancel-order|preview-trades|run-backtest|import-csv|import-ofx|export-journal|import-journal|export-archive|import-archive|reconcile-positions|list-reconciliation-breaks|update-reconciliation-break|get-tax-report|export-tax-report|list-lots|find-tax-loss-harvests|list-accounts|set-account|remove-account)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "portfolio get-portfolio-summary" + "\n" +
+	return os.Args[0] + " " + "portfolio get-portfolio-summary --portfolio-id \"Sit quibusdam.\" --account \"Recusandae dolorum dolores qui.\"" + "\n" +
 		""
 }
 
@@ -46,10 +46,273 @@ func ParseEndpoint(
 	var (
 		portfolioFlags = flag.NewFlagSet("portfolio", flag.ContinueOnError)
 
-		portfolioGetPortfolioSummaryFlags = flag.NewFlagSet("get-portfolio-summary", flag.ExitOnError)
+		portfolioGetPortfolioSummaryFlags           = flag.NewFlagSet("get-portfolio-summary", flag.ExitOnError)
+		portfolioGetPortfolioSummaryPortfolioIDFlag = portfolioGetPortfolioSummaryFlags.String("portfolio-id", "default", "")
+		portfolioGetPortfolioSummaryAccountFlag     = portfolioGetPortfolioSummaryFlags.String("account", "", "")
+
+		portfolioGetReturnsFlags           = flag.NewFlagSet("get-returns", flag.ExitOnError)
+		portfolioGetReturnsPortfolioIDFlag = portfolioGetReturnsFlags.String("portfolio-id", "default", "")
+		portfolioGetReturnsPeriodFlag      = portfolioGetReturnsFlags.String("period", "inception", "")
+		portfolioGetReturnsStartFlag       = portfolioGetReturnsFlags.String("start", "", "")
+		portfolioGetReturnsEndFlag         = portfolioGetReturnsFlags.String("end", "", "")
+		portfolioGetReturnsAccountFlag     = portfolioGetReturnsFlags.String("account", "", "")
+
+		portfolioGetAllocationFlags           = flag.NewFlagSet("get-allocation", flag.ExitOnError)
+		portfolioGetAllocationPortfolioIDFlag = portfolioGetAllocationFlags.String("portfolio-id", "default", "")
+		portfolioGetAllocationDimensionFlag   = portfolioGetAllocationFlags.String("dimension", "asset_class", "")
+		portfolioGetAllocationTagFlag         = portfolioGetAllocationFlags.String("tag", "", "")
+		portfolioGetAllocationAccountFlag     = portfolioGetAllocationFlags.String("account", "", "")
+
+		portfolioGetTargetAllocationFlags           = flag.NewFlagSet("get-target-allocation", flag.ExitOnError)
+		portfolioGetTargetAllocationPortfolioIDFlag = portfolioGetTargetAllocationFlags.String("portfolio-id", "default", "")
+
+		portfolioSetTargetAllocationFlags           = flag.NewFlagSet("set-target-allocation", flag.ExitOnError)
+		portfolioSetTargetAllocationBodyFlag        = portfolioSetTargetAllocationFlags.String("body", "REQUIRED", "")
+		portfolioSetTargetAllocationPortfolioIDFlag = portfolioSetTargetAllocationFlags.String("portfolio-id", "default", "")
+
+		portfolioProposeRebalanceFlags           = flag.NewFlagSet("propose-rebalance", flag.ExitOnError)
+		portfolioProposeRebalanceBodyFlag        = portfolioProposeRebalanceFlags.String("body", "REQUIRED", "")
+		portfolioProposeRebalancePortfolioIDFlag = portfolioProposeRebalanceFlags.String("portfolio-id", "default", "")
+
+		portfolioSetBenchmarkFlags           = flag.NewFlagSet("set-benchmark", flag.ExitOnError)
+		portfolioSetBenchmarkBodyFlag        = portfolioSetBenchmarkFlags.String("body", "REQUIRED", "")
+		portfolioSetBenchmarkPortfolioIDFlag = portfolioSetBenchmarkFlags.String("portfolio-id", "default", "")
+
+		portfolioGetBenchmarkComparisonFlags           = flag.NewFlagSet("get-benchmark-comparison", flag.ExitOnError)
+		portfolioGetBenchmarkComparisonPortfolioIDFlag = portfolioGetBenchmarkComparisonFlags.String("portfolio-id", "default", "")
+		portfolioGetBenchmarkComparisonPeriodFlag      = portfolioGetBenchmarkComparisonFlags.String("period", "inception", "")
+		portfolioGetBenchmarkComparisonStartFlag       = portfolioGetBenchmarkComparisonFlags.String("start", "", "")
+		portfolioGetBenchmarkComparisonEndFlag         = portfolioGetBenchmarkComparisonFlags.String("end", "", "")
+
+		portfolioGetRiskMetricsFlags            = flag.NewFlagSet("get-risk-metrics", flag.ExitOnError)
+		portfolioGetRiskMetricsPortfolioIDFlag  = portfolioGetRiskMetricsFlags.String("portfolio-id", "default", "")
+		portfolioGetRiskMetricsPeriodFlag       = portfolioGetRiskMetricsFlags.String("period", "inception", "")
+		portfolioGetRiskMetricsStartFlag        = portfolioGetRiskMetricsFlags.String("start", "", "")
+		portfolioGetRiskMetricsEndFlag          = portfolioGetRiskMetricsFlags.String("end", "", "")
+		portfolioGetRiskMetricsRiskFreeRateFlag = portfolioGetRiskMetricsFlags.String("risk-free-rate", "", "")
+		portfolioGetRiskMetricsWindowFlag       = portfolioGetRiskMetricsFlags.String("window", "", "")
+
+		portfolioGetValueAtRiskFlags           = flag.NewFlagSet("get-value-at-risk", flag.ExitOnError)
+		portfolioGetValueAtRiskPortfolioIDFlag = portfolioGetValueAtRiskFlags.String("portfolio-id", "default", "")
+		portfolioGetValueAtRiskMethodFlag      = portfolioGetValueAtRiskFlags.String("method", "historical", "")
+		portfolioGetValueAtRiskConfidenceFlag  = portfolioGetValueAtRiskFlags.String("confidence", "0.95", "")
+		portfolioGetValueAtRiskHorizonFlag     = portfolioGetValueAtRiskFlags.String("horizon", "1", "")
+		portfolioGetValueAtRiskLookbackFlag    = portfolioGetValueAtRiskFlags.String("lookback", "252", "")
+		portfolioGetValueAtRiskSimulationsFlag = portfolioGetValueAtRiskFlags.String("simulations", "10000", "")
+		portfolioGetValueAtRiskSeedFlag        = portfolioGetValueAtRiskFlags.String("seed", "", "")
+
+		portfolioListStressScenariosFlags = flag.NewFlagSet("list-stress-scenarios", flag.ExitOnError)
+
+		portfolioRunStressTestFlags           = flag.NewFlagSet("run-stress-test", flag.ExitOnError)
+		portfolioRunStressTestPortfolioIDFlag = portfolioRunStressTestFlags.String("portfolio-id", "default", "")
+		portfolioRunStressTestScenarioFlag    = portfolioRunStressTestFlags.String("scenario", "REQUIRED", "")
+		portfolioRunStressTestTopFlag         = portfolioRunStressTestFlags.String("top", "5", "")
+
+		portfolioProjectPortfolioFlags           = flag.NewFlagSet("project-portfolio", flag.ExitOnError)
+		portfolioProjectPortfolioBodyFlag        = portfolioProjectPortfolioFlags.String("body", "REQUIRED", "")
+		portfolioProjectPortfolioPortfolioIDFlag = portfolioProjectPortfolioFlags.String("portfolio-id", "default", "")
+
+		portfolioListCorporateActionsFlags           = flag.NewFlagSet("list-corporate-actions", flag.ExitOnError)
+		portfolioListCorporateActionsPortfolioIDFlag = portfolioListCorporateActionsFlags.String("portfolio-id", "default", "")
+
+		portfolioApplyCorporateActionsFlags           = flag.NewFlagSet("apply-corporate-actions", flag.ExitOnError)
+		portfolioApplyCorporateActionsBodyFlag        = portfolioApplyCorporateActionsFlags.String("body", "REQUIRED", "")
+		portfolioApplyCorporateActionsPortfolioIDFlag = portfolioApplyCorporateActionsFlags.String("portfolio-id", "default", "")
+
+		portfolioRecordIncomeFlags           = flag.NewFlagSet("record-income", flag.ExitOnError)
+		portfolioRecordIncomeBodyFlag        = portfolioRecordIncomeFlags.String("body", "REQUIRED", "")
+		portfolioRecordIncomePortfolioIDFlag = portfolioRecordIncomeFlags.String("portfolio-id", "default", "")
+
+		portfolioGetIncomeFlags           = flag.NewFlagSet("get-income", flag.ExitOnError)
+		portfolioGetIncomePortfolioIDFlag = portfolioGetIncomeFlags.String("portfolio-id", "default", "")
+		portfolioGetIncomePeriodFlag      = portfolioGetIncomeFlags.String("period", "inception", "")
+		portfolioGetIncomeStartFlag       = portfolioGetIncomeFlags.String("start", "", "")
+		portfolioGetIncomeEndFlag         = portfolioGetIncomeFlags.String("end", "", "")
+		portfolioGetIncomeIntervalFlag    = portfolioGetIncomeFlags.String("interval", "month", "")
+
+		portfolioPriceBondFlags    = flag.NewFlagSet("price-bond", flag.ExitOnError)
+		portfolioPriceBondBodyFlag = portfolioPriceBondFlags.String("body", "REQUIRED", "")
+
+		portfolioListBondPositionsFlags           = flag.NewFlagSet("list-bond-positions", flag.ExitOnError)
+		portfolioListBondPositionsPortfolioIDFlag = portfolioListBondPositionsFlags.String("portfolio-id", "default", "")
+
+		portfolioGetCashFlowLadderFlags           = flag.NewFlagSet("get-cash-flow-ladder", flag.ExitOnError)
+		portfolioGetCashFlowLadderPortfolioIDFlag = portfolioGetCashFlowLadderFlags.String("portfolio-id", "default", "")
+		portfolioGetCashFlowLadderYearsFlag       = portfolioGetCashFlowLadderFlags.String("years", "10", "")
+
+		portfolioListOptionPositionsFlags           = flag.NewFlagSet("list-option-positions", flag.ExitOnError)
+		portfolioListOptionPositionsPortfolioIDFlag = portfolioListOptionPositionsFlags.String("portfolio-id", "default", "")
+		portfolioListOptionPositionsVolatilityFlag  = portfolioListOptionPositionsFlags.String("volatility", "", "")
+
+		portfolioSettleOptionExpiriesFlags           = flag.NewFlagSet("settle-option-expiries", flag.ExitOnError)
+		portfolioSettleOptionExpiriesPortfolioIDFlag = portfolioSettleOptionExpiriesFlags.String("portfolio-id", "default", "")
+
+		portfolioSetMarginAccountFlags           = flag.NewFlagSet("set-margin-account", flag.ExitOnError)
+		portfolioSetMarginAccountBodyFlag        = portfolioSetMarginAccountFlags.String("body", "REQUIRED", "")
+		portfolioSetMarginAccountPortfolioIDFlag = portfolioSetMarginAccountFlags.String("portfolio-id", "default", "")
+
+		portfolioGetMarginStatusFlags           = flag.NewFlagSet("get-margin-status", flag.ExitOnError)
+		portfolioGetMarginStatusPortfolioIDFlag = portfolioGetMarginStatusFlags.String("portfolio-id", "default", "")
+
+		portfolioChargeMarginFlags           = flag.NewFlagSet("charge-margin", flag.ExitOnError)
+		portfolioChargeMarginPortfolioIDFlag = portfolioChargeMarginFlags.String("portfolio-id", "default", "")
+
+		portfolioListEventsFlags           = flag.NewFlagSet("list-events", flag.ExitOnError)
+		portfolioListEventsPortfolioIDFlag = portfolioListEventsFlags.String("portfolio-id", "default", "")
+		portfolioListEventsSinceFlag       = portfolioListEventsFlags.String("since", "", "")
+		portfolioListEventsTypeFlag        = portfolioListEventsFlags.String("type", "", "")
+
+		portfolioPlaceOrderFlags           = flag.NewFlagSet("place-order", flag.ExitOnError)
+		portfolioPlaceOrderBodyFlag        = portfolioPlaceOrderFlags.String("body", "REQUIRED", "")
+		portfolioPlaceOrderPortfolioIDFlag = portfolioPlaceOrderFlags.String("portfolio-id", "default", "")
+
+		portfolioListOrdersFlags           = flag.NewFlagSet("list-orders", flag.ExitOnError)
+		portfolioListOrdersPortfolioIDFlag = portfolioListOrdersFlags.String("portfolio-id", "default", "")
+		portfolioListOrdersStatusFlag      = portfolioListOrdersFlags.String("status", "", "")
+
+		portfolioCancelOrderFlags           = flag.NewFlagSet("cancel-order", flag.ExitOnError)
+		portfolioCancelOrderPortfolioIDFlag = portfolioCancelOrderFlags.String("portfolio-id", "default", "")
+		portfolioCancelOrderIDFlag          = portfolioCancelOrderFlags.String("id", "REQUIRED", "")
+
+		portfolioPreviewTradesFlags           = flag.NewFlagSet("preview-trades", flag.ExitOnError)
+		portfolioPreviewTradesBodyFlag        = portfolioPreviewTradesFlags.String("body", "REQUIRED", "")
+		portfolioPreviewTradesPortfolioIDFlag = portfolioPreviewTradesFlags.String("portfolio-id", "default", "")
+
+		portfolioRunBacktestFlags    = flag.NewFlagSet("run-backtest", flag.ExitOnError)
+		portfolioRunBacktestBodyFlag = portfolioRunBacktestFlags.String("body", "REQUIRED", "")
+
+		portfolioImportCsvFlags           = flag.NewFlagSet("import-csv", flag.ExitOnError)
+		portfolioImportCsvBodyFlag        = portfolioImportCsvFlags.String("body", "REQUIRED", "")
+		portfolioImportCsvPortfolioIDFlag = portfolioImportCsvFlags.String("portfolio-id", "default", "")
+		portfolioImportCsvProfileFlag     = portfolioImportCsvFlags.String("profile", "generic", "")
+		portfolioImportCsvAccountFlag     = portfolioImportCsvFlags.String("account", "", "")
+		portfolioImportCsvDryRunFlag      = portfolioImportCsvFlags.String("dry-run", "", "")
+
+		portfolioImportOfxFlags           = flag.NewFlagSet("import-ofx", flag.ExitOnError)
+		portfolioImportOfxBodyFlag        = portfolioImportOfxFlags.String("body", "REQUIRED", "")
+		portfolioImportOfxPortfolioIDFlag = portfolioImportOfxFlags.String("portfolio-id", "default", "")
+		portfolioImportOfxAccountFlag     = portfolioImportOfxFlags.String("account", "", "")
+		portfolioImportOfxDryRunFlag      = portfolioImportOfxFlags.String("dry-run", "", "")
+
+		portfolioExportJournalFlags           = flag.NewFlagSet("export-journal", flag.ExitOnError)
+		portfolioExportJournalPortfolioIDFlag = portfolioExportJournalFlags.String("portfolio-id", "default", "")
+		portfolioExportJournalFormatFlag      = portfolioExportJournalFlags.String("format", "beancount", "")
+
+		portfolioImportJournalFlags           = flag.NewFlagSet("import-journal", flag.ExitOnError)
+		portfolioImportJournalBodyFlag        = portfolioImportJournalFlags.String("body", "REQUIRED", "")
+		portfolioImportJournalPortfolioIDFlag = portfolioImportJournalFlags.String("portfolio-id", "default", "")
+		portfolioImportJournalAccountFlag     = portfolioImportJournalFlags.String("account", "", "")
+		portfolioImportJournalRootFlag        = portfolioImportJournalFlags.String("root", "Assets:Portfolio", "")
+		portfolioImportJournalDryRunFlag      = portfolioImportJournalFlags.String("dry-run", "", "")
+
+		portfolioExportArchiveFlags           = flag.NewFlagSet("export-archive", flag.ExitOnError)
+		portfolioExportArchivePortfolioIDFlag = portfolioExportArchiveFlags.String("portfolio-id", "default", "")
+
+		portfolioImportArchiveFlags           = flag.NewFlagSet("import-archive", flag.ExitOnError)
+		portfolioImportArchiveBodyFlag        = portfolioImportArchiveFlags.String("body", "REQUIRED", "")
+		portfolioImportArchivePortfolioIDFlag = portfolioImportArchiveFlags.String("portfolio-id", "", "")
+		portfolioImportArchiveReplaceFlag     = portfolioImportArchiveFlags.String("replace", "", "")
+
+		portfolioReconcilePositionsFlags           = flag.NewFlagSet("reconcile-positions", flag.ExitOnError)
+		portfolioReconcilePositionsBodyFlag        = portfolioReconcilePositionsFlags.String("body", "REQUIRED", "")
+		portfolioReconcilePositionsPortfolioIDFlag = portfolioReconcilePositionsFlags.String("portfolio-id", "default", "")
+		portfolioReconcilePositionsFormatFlag      = portfolioReconcilePositionsFlags.String("format", "csv", "")
+		portfolioReconcilePositionsProfileFlag     = portfolioReconcilePositionsFlags.String("profile", "generic", "")
+		portfolioReconcilePositionsAccountFlag     = portfolioReconcilePositionsFlags.String("account", "", "")
+		portfolioReconcilePositionsAsOfFlag        = portfolioReconcilePositionsFlags.String("as-of", "", "")
+
+		portfolioListReconciliationBreaksFlags           = flag.NewFlagSet("list-reconciliation-breaks", flag.ExitOnError)
+		portfolioListReconciliationBreaksPortfolioIDFlag = portfolioListReconciliationBreaksFlags.String("portfolio-id", "default", "")
+		portfolioListReconciliationBreaksStatusFlag      = portfolioListReconciliationBreaksFlags.String("status", "", "")
+		portfolioListReconciliationBreaksAccountFlag     = portfolioListReconciliationBreaksFlags.String("account", "", "")
+
+		portfolioUpdateReconciliationBreakFlags           = flag.NewFlagSet("update-reconciliation-break", flag.ExitOnError)
+		portfolioUpdateReconciliationBreakPortfolioIDFlag = portfolioUpdateReconciliationBreakFlags.String("portfolio-id", "default", "")
+		portfolioUpdateReconciliationBreakIDFlag          = portfolioUpdateReconciliationBreakFlags.String("id", "REQUIRED", "")
+		portfolioUpdateReconciliationBreakStatusFlag      = portfolioUpdateReconciliationBreakFlags.String("status", "REQUIRED", "")
+		portfolioUpdateReconciliationBreakNoteFlag        = portfolioUpdateReconciliationBreakFlags.String("note", "", "")
+		portfolioUpdateReconciliationBreakApplyFlag       = portfolioUpdateReconciliationBreakFlags.String("apply", "", "")
+
+		portfolioGetTaxReportFlags           = flag.NewFlagSet("get-tax-report", flag.ExitOnError)
+		portfolioGetTaxReportPortfolioIDFlag = portfolioGetTaxReportFlags.String("portfolio-id", "default", "")
+		portfolioGetTaxReportYearFlag        = portfolioGetTaxReportFlags.String("year", "REQUIRED", "")
+
+		portfolioExportTaxReportFlags           = flag.NewFlagSet("export-tax-report", flag.ExitOnError)
+		portfolioExportTaxReportPortfolioIDFlag = portfolioExportTaxReportFlags.String("portfolio-id", "default", "")
+		portfolioExportTaxReportYearFlag        = portfolioExportTaxReportFlags.String("year", "REQUIRED", "")
+		portfolioExportTaxReportFormatFlag      = portfolioExportTaxReportFlags.String("format", "csv", "")
+
+		portfolioListLotsFlags           = flag.NewFlagSet("list-lots", flag.ExitOnError)
+		portfolioListLotsPortfolioIDFlag = portfolioListLotsFlags.String("portfolio-id", "default", "")
+		portfolioListLotsAccountFlag     = portfolioListLotsFlags.String("account", "", "")
+		portfolioListLotsSymbolFlag      = portfolioListLotsFlags.String("symbol", "", "")
+
+		portfolioFindTaxLossHarvestsFlags             = flag.NewFlagSet("find-tax-loss-harvests", flag.ExitOnError)
+		portfolioFindTaxLossHarvestsPortfolioIDFlag   = portfolioFindTaxLossHarvestsFlags.String("portfolio-id", "default", "")
+		portfolioFindTaxLossHarvestsAccountFlag       = portfolioFindTaxLossHarvestsFlags.String("account", "", "")
+		portfolioFindTaxLossHarvestsMinLossFlag       = portfolioFindTaxLossHarvestsFlags.String("min-loss", "", "")
+		portfolioFindTaxLossHarvestsShortTermRateFlag = portfolioFindTaxLossHarvestsFlags.String("short-term-rate", "REQUIRED", "")
+		portfolioFindTaxLossHarvestsLongTermRateFlag  = portfolioFindTaxLossHarvestsFlags.String("long-term-rate", "REQUIRED", "")
+
+		portfolioListAccountsFlags           = flag.NewFlagSet("list-accounts", flag.ExitOnError)
+		portfolioListAccountsPortfolioIDFlag = portfolioListAccountsFlags.String("portfolio-id", "default", "")
+
+		portfolioSetAccountFlags           = flag.NewFlagSet("set-account", flag.ExitOnError)
+		portfolioSetAccountBodyFlag        = portfolioSetAccountFlags.String("body", "REQUIRED", "")
+		portfolioSetAccountPortfolioIDFlag = portfolioSetAccountFlags.String("portfolio-id", "default", "")
+
+		portfolioRemoveAccountFlags           = flag.NewFlagSet("remove-account", flag.ExitOnError)
+		portfolioRemoveAccountPortfolioIDFlag = portfolioRemoveAccountFlags.String("portfolio-id", "default", "")
+		portfolioRemoveAccountIDFlag          = portfolioRemoveAccountFlags.String("id", "REQUIRED", "")
 	)
 	portfolioFlags.Usage = portfolioUsage
 	portfolioGetPortfolioSummaryFlags.Usage = portfolioGetPortfolioSummaryUsage
+	portfolioGetReturnsFlags.Usage = portfolioGetReturnsUsage
+	portfolioGetAllocationFlags.Usage = portfolioGetAllocationUsage
+	portfolioGetTargetAllocationFlags.Usage = portfolioGetTargetAllocationUsage
+	portfolioSetTargetAllocationFlags.Usage = portfolioSetTargetAllocationUsage
+	portfolioProposeRebalanceFlags.Usage = portfolioProposeRebalanceUsage
+	portfolioSetBenchmarkFlags.Usage = portfolioSetBenchmarkUsage
+	portfolioGetBenchmarkComparisonFlags.Usage = portfolioGetBenchmarkComparisonUsage
+	portfolioGetRiskMetricsFlags.Usage = portfolioGetRiskMetricsUsage
+	portfolioGetValueAtRiskFlags.Usage = portfolioGetValueAtRiskUsage
+	portfolioListStressScenariosFlags.Usage = portfolioListStressScenariosUsage
+	portfolioRunStressTestFlags.Usage = portfolioRunStressTestUsage
+	portfolioProjectPortfolioFlags.Usage = portfolioProjectPortfolioUsage
+	portfolioListCorporateActionsFlags.Usage = portfolioListCorporateActionsUsage
+	portfolioApplyCorporateActionsFlags.Usage = portfolioApplyCorporateActionsUsage
+	portfolioRecordIncomeFlags.Usage = portfolioRecordIncomeUsage
+	portfolioGetIncomeFlags.Usage = portfolioGetIncomeUsage
+	portfolioPriceBondFlags.Usage = portfolioPriceBondUsage
+	portfolioListBondPositionsFlags.Usage = portfolioListBondPositionsUsage
+	portfolioGetCashFlowLadderFlags.Usage = portfolioGetCashFlowLadderUsage
+	portfolioListOptionPositionsFlags.Usage = portfolioListOptionPositionsUsage
+	portfolioSettleOptionExpiriesFlags.Usage = portfolioSettleOptionExpiriesUsage
+	portfolioSetMarginAccountFlags.Usage = portfolioSetMarginAccountUsage
+	portfolioGetMarginStatusFlags.Usage = portfolioGetMarginStatusUsage
+	portfolioChargeMarginFlags.Usage = portfolioChargeMarginUsage
+	portfolioListEventsFlags.Usage = portfolioListEventsUsage
+	portfolioPlaceOrderFlags.Usage = portfolioPlaceOrderUsage
+	portfolioListOrdersFlags.Usage = portfolioListOrdersUsage
+	portfolioCancelOrderFlags.Usage = portfolioCancelOrderUsage
+	portfolioPreviewTradesFlags.Usage = portfolioPreviewTradesUsage
+	portfolioRunBacktestFlags.Usage = portfolioRunBacktestUsage
+	portfolioImportCsvFlags.Usage = portfolioImportCsvUsage
+	portfolioImportOfxFlags.Usage = portfolioImportOfxUsage
+	portfolioExportJournalFlags.Usage = portfolioExportJournalUsage
+	portfolioImportJournalFlags.Usage = portfolioImportJournalUsage
+	portfolioExportArchiveFlags.Usage = portfolioExportArchiveUsage
+	portfolioImportArchiveFlags.Usage = portfolioImportArchiveUsage
+	portfolioReconcilePositionsFlags.Usage = portfolioReconcilePositionsUsage
+	portfolioListReconciliationBreaksFlags.Usage = portfolioListReconciliationBreaksUsage
+	portfolioUpdateReconciliationBreakFlags.Usage = portfolioUpdateReconciliationBreakUsage
+	portfolioGetTaxReportFlags.Usage = portfolioGetTaxReportUsage
+	portfolioExportTaxReportFlags.Usage = portfolioExportTaxReportUsage
+	portfolioListLotsFlags.Usage = portfolioListLotsUsage
+	portfolioFindTaxLossHarvestsFlags.Usage = portfolioFindTaxLossHarvestsUsage
+	portfolioListAccountsFlags.Usage = portfolioListAccountsUsage
+	portfolioSetAccountFlags.Usage = portfolioSetAccountUsage
+	portfolioRemoveAccountFlags.Usage = portfolioRemoveAccountUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -88,6 +351,144 @@ func ParseEndpoint(
 			case "get-portfolio-summary":
 				epf = portfolioGetPortfolioSummaryFlags
 
+			case "get-returns":
+				epf = portfolioGetReturnsFlags
+
+			case "get-allocation":
+				epf = portfolioGetAllocationFlags
+
+			case "get-target-allocation":
+				epf = portfolioGetTargetAllocationFlags
+
+			case "set-target-allocation":
+				epf = portfolioSetTargetAllocationFlags
+
+			case "propose-rebalance":
+				epf = portfolioProposeRebalanceFlags
+
+			case "set-benchmark":
+				epf = portfolioSetBenchmarkFlags
+
+			case "get-benchmark-comparison":
+				epf = portfolioGetBenchmarkComparisonFlags
+
+			case "get-risk-metrics":
+				epf = portfolioGetRiskMetricsFlags
+
+			case "get-value-at-risk":
+				epf = portfolioGetValueAtRiskFlags
+
+			case "list-stress-scenarios":
+				epf = portfolioListStressScenariosFlags
+
+			case "run-stress-test":
+				epf = portfolioRunStressTestFlags
+
+			case "project-portfolio":
+				epf = portfolioProjectPortfolioFlags
+
+			case "list-corporate-actions":
+				epf = portfolioListCorporateActionsFlags
+
+			case "apply-corporate-actions":
+				epf = portfolioApplyCorporateActionsFlags
+
+			case "record-income":
+				epf = portfolioRecordIncomeFlags
+
+			case "get-income":
+				epf = portfolioGetIncomeFlags
+
+			case "price-bond":
+				epf = portfolioPriceBondFlags
+
+			case "list-bond-positions":
+				epf = portfolioListBondPositionsFlags
+
+			case "get-cash-flow-ladder":
+				epf = portfolioGetCashFlowLadderFlags
+
+			case "list-option-positions":
+				epf = portfolioListOptionPositionsFlags
+
+			case "settle-option-expiries":
+				epf = portfolioSettleOptionExpiriesFlags
+
+			case "set-margin-account":
+				epf = portfolioSetMarginAccountFlags
+
+			case "get-margin-status":
+				epf = portfolioGetMarginStatusFlags
+
+			case "charge-margin":
+				epf = portfolioChargeMarginFlags
+
+			case "list-events":
+				epf = portfolioListEventsFlags
+
+			case "place-order":
+				epf = portfolioPlaceOrderFlags
+
+			case "list-orders":
+				epf = portfolioListOrdersFlags
+
+			case "cancel-order":
+				epf = portfolioCancelOrderFlags
+
+			case "preview-trades":
+				epf = portfolioPreviewTradesFlags
+
+			case "run-backtest":
+				epf = portfolioRunBacktestFlags
+
+			case "import-csv":
+				epf = portfolioImportCsvFlags
+
+			case "import-ofx":
+				epf = portfolioImportOfxFlags
+
+			case "export-journal":
+				epf = portfolioExportJournalFlags
+
+			case "import-journal":
+				epf = portfolioImportJournalFlags
+
+			case "export-archive":
+				epf = portfolioExportArchiveFlags
+
+			case "import-archive":
+				epf = portfolioImportArchiveFlags
+
+			case "reconcile-positions":
+				epf = portfolioReconcilePositionsFlags
+
+			case "list-reconciliation-breaks":
+				epf = portfolioListReconciliationBreaksFlags
+
+			case "update-reconciliation-break":
+				epf = portfolioUpdateReconciliationBreakFlags
+
+			case "get-tax-report":
+				epf = portfolioGetTaxReportFlags
+
+			case "export-tax-report":
+				epf = portfolioExportTaxReportFlags
+
+			case "list-lots":
+				epf = portfolioListLotsFlags
+
+			case "find-tax-loss-harvests":
+				epf = portfolioFindTaxLossHarvestsFlags
+
+			case "list-accounts":
+				epf = portfolioListAccountsFlags
+
+			case "set-account":
+				epf = portfolioSetAccountFlags
+
+			case "remove-account":
+				epf = portfolioRemoveAccountFlags
+
 			}
 
 		}
@@ -115,6 +516,144 @@ func ParseEndpoint(
 			switch epn {
 			case "get-portfolio-summary":
 				endpoint = c.GetPortfolioSummary()
+				data, err = portfolioc.BuildGetPortfolioSummaryPayload(*portfolioGetPortfolioSummaryPortfolioIDFlag, *portfolioGetPortfolioSummaryAccountFlag)
+			case "get-returns":
+				endpoint = c.GetReturns()
+				data, err = portfolioc.BuildGetReturnsPayload(*portfolioGetReturnsPortfolioIDFlag, *portfolioGetReturnsPeriodFlag, *portfolioGetReturnsStartFlag, *portfolioGetReturnsEndFlag, *portfolioGetReturnsAccountFlag)
+			case "get-allocation":
+				endpoint = c.GetAllocation()
+				data, err = portfolioc.BuildGetAllocationPayload(*portfolioGetAllocationPortfolioIDFlag, *portfolioGetAllocationDimensionFlag, *portfolioGetAllocationTagFlag, *portfolioGetAllocationAccountFlag)
+			case "get-target-allocation":
+				endpoint = c.GetTargetAllocation()
+				data, err = portfolioc.BuildGetTargetAllocationPayload(*portfolioGetTargetAllocationPortfolioIDFlag)
+			case "set-target-allocation":
+				endpoint = c.SetTargetAllocation()
+				data, err = portfolioc.BuildSetTargetAllocationPayload(*portfolioSetTargetAllocationBodyFlag, *portfolioSetTargetAllocationPortfolioIDFlag)
+			case "propose-rebalance":
+				endpoint = c.ProposeRebalance()
+				data, err = portfolioc.BuildProposeRebalancePayload(*portfolioProposeRebalanceBodyFlag, *portfolioProposeRebalancePortfolioIDFlag)
+			case "set-benchmark":
+				endpoint = c.SetBenchmark()
+				data, err = portfolioc.BuildSetBenchmarkPayload(*portfolioSetBenchmarkBodyFlag, *portfolioSetBenchmarkPortfolioIDFlag)
+			case "get-benchmark-comparison":
+				endpoint = c.GetBenchmarkComparison()
+				data, err = portfolioc.BuildGetBenchmarkComparisonPayload(*portfolioGetBenchmarkComparisonPortfolioIDFlag, *portfolioGetBenchmarkComparisonPeriodFlag, *portfolioGetBenchmarkComparisonStartFlag, *portfolioGetBenchmarkComparisonEndFlag)
+			case "get-risk-metrics":
+				endpoint = c.GetRiskMetrics()
+				data, err = portfolioc.BuildGetRiskMetricsPayload(*portfolioGetRiskMetricsPortfolioIDFlag, *portfolioGetRiskMetricsPeriodFlag, *portfolioGetRiskMetricsStartFlag, *portfolioGetRiskMetricsEndFlag, *portfolioGetRiskMetricsRiskFreeRateFlag, *portfolioGetRiskMetricsWindowFlag)
+			case "get-value-at-risk":
+				endpoint = c.GetValueAtRisk()
+				data, err = portfolioc.BuildGetValueAtRiskPayload(*portfolioGetValueAtRiskPortfolioIDFlag, *portfolioGetValueAtRiskMethodFlag, *portfolioGetValueAtRiskConfidenceFlag, *portfolioGetValueAtRiskHorizonFlag, *portfolioGetValueAtRiskLookbackFlag, *portfolioGetValueAtRiskSimulationsFlag, *portfolioGetValueAtRiskSeedFlag)
+			case "list-stress-scenarios":
+				endpoint = c.ListStressScenarios()
+			case "run-stress-test":
+				endpoint = c.RunStressTest()
+				data, err = portfolioc.BuildRunStressTestPayload(*portfolioRunStressTestPortfolioIDFlag, *portfolioRunStressTestScenarioFlag, *portfolioRunStressTestTopFlag)
+			case "project-portfolio":
+				endpoint = c.ProjectPortfolio()
+				data, err = portfolioc.BuildProjectPortfolioPayload(*portfolioProjectPortfolioBodyFlag, *portfolioProjectPortfolioPortfolioIDFlag)
+			case "list-corporate-actions":
+				endpoint = c.ListCorporateActions()
+				data, err = portfolioc.BuildListCorporateActionsPayload(*portfolioListCorporateActionsPortfolioIDFlag)
+			case "apply-corporate-actions":
+				endpoint = c.ApplyCorporateActions()
+				data, err = portfolioc.BuildApplyCorporateActionsPayload(*portfolioApplyCorporateActionsBodyFlag, *portfolioApplyCorporateActionsPortfolioIDFlag)
+			case "record-income":
+				endpoint = c.RecordIncome()
+				data, err = portfolioc.BuildRecordIncomePayload(*portfolioRecordIncomeBodyFlag, *portfolioRecordIncomePortfolioIDFlag)
+			case "get-income":
+				endpoint = c.GetIncome()
+				data, err = portfolioc.BuildGetIncomePayload(*portfolioGetIncomePortfolioIDFlag, *portfolioGetIncomePeriodFlag, *portfolioGetIncomeStartFlag, *portfolioGetIncomeEndFlag, *portfolioGetIncomeIntervalFlag)
+			case "price-bond":
+				endpoint = c.PriceBond()
+				data, err = portfolioc.BuildPriceBondPayload(*portfolioPriceBondBodyFlag)
+			case "list-bond-positions":
+				endpoint = c.ListBondPositions()
+				data, err = portfolioc.BuildListBondPositionsPayload(*portfolioListBondPositionsPortfolioIDFlag)
+			case "get-cash-flow-ladder":
+				endpoint = c.GetCashFlowLadder()
+				data, err = portfolioc.BuildGetCashFlowLadderPayload(*portfolioGetCashFlowLadderPortfolioIDFlag, *portfolioGetCashFlowLadderYearsFlag)
+			case "list-option-positions":
+				endpoint = c.ListOptionPositions()
+				data, err = portfolioc.BuildListOptionPositionsPayload(*portfolioListOptionPositionsPortfolioIDFlag, *portfolioListOptionPositionsVolatilityFlag)
+			case "settle-option-expiries":
+				endpoint = c.SettleOptionExpiries()
+				data, err = portfolioc.BuildSettleOptionExpiriesPayload(*portfolioSettleOptionExpiriesPortfolioIDFlag)
+			case "set-margin-account":
+				endpoint = c.SetMarginAccount()
+				data, err = portfolioc.BuildSetMarginAccountPayload(*portfolioSetMarginAccountBodyFlag, *portfolioSetMarginAccountPortfolioIDFlag)
+			case "get-margin-status":
+				endpoint = c.GetMarginStatus()
+				data, err = portfolioc.BuildGetMarginStatusPayload(*portfolioGetMarginStatusPortfolioIDFlag)
+			case "charge-margin":
+				endpoint = c.ChargeMargin()
+				data, err = portfolioc.BuildChargeMarginPayload(*portfolioChargeMarginPortfolioIDFlag)
+			case "list-events":
+				endpoint = c.ListEvents()
+				data, err = portfolioc.BuildListEventsPayload(*portfolioListEventsPortfolioIDFlag, *portfolioListEventsSinceFlag, *portfolioListEventsTypeFlag)
+			case "place-order":
+				endpoint = c.PlaceOrder()
+				data, err = portfolioc.BuildPlaceOrderPayload(*portfolioPlaceOrderBodyFlag, *portfolioPlaceOrderPortfolioIDFlag)
+			case "list-orders":
+				endpoint = c.ListOrders()
+				data, err = portfolioc.BuildListOrdersPayload(*portfolioListOrdersPortfolioIDFlag, *portfolioListOrdersStatusFlag)
+			case "cancel-order":
+				endpoint = c.CancelOrder()
+				data, err = portfolioc.BuildCancelOrderPayload(*portfolioCancelOrderPortfolioIDFlag, *portfolioCancelOrderIDFlag)
+			case "preview-trades":
+				endpoint = c.PreviewTrades()
+				data, err = portfolioc.BuildPreviewTradesPayload(*portfolioPreviewTradesBodyFlag, *portfolioPreviewTradesPortfolioIDFlag)
+			case "run-backtest":
+				endpoint = c.RunBacktest()
+				data, err = portfolioc.BuildRunBacktestPayload(*portfolioRunBacktestBodyFlag)
+			case "import-csv":
+				endpoint = c.ImportCsv()
+				data, err = portfolioc.BuildImportCsvPayload(*portfolioImportCsvBodyFlag, *portfolioImportCsvPortfolioIDFlag, *portfolioImportCsvProfileFlag, *portfolioImportCsvAccountFlag, *portfolioImportCsvDryRunFlag)
+			case "import-ofx":
+				endpoint = c.ImportOfx()
+				data, err = portfolioc.BuildImportOfxPayload(*portfolioImportOfxBodyFlag, *portfolioImportOfxPortfolioIDFlag, *portfolioImportOfxAccountFlag, *portfolioImportOfxDryRunFlag)
+			case "export-journal":
+				endpoint = c.ExportJournal()
+				data, err = portfolioc.BuildExportJournalPayload(*portfolioExportJournalPortfolioIDFlag, *portfolioExportJournalFormatFlag)
+			case "import-journal":
+				endpoint = c.ImportJournal()
+				data, err = portfolioc.BuildImportJournalPayload(*portfolioImportJournalBodyFlag, *portfolioImportJournalPortfolioIDFlag, *portfolioImportJournalAccountFlag, *portfolioImportJournalRootFlag, *portfolioImportJournalDryRunFlag)
+			case "export-archive":
+				endpoint = c.ExportArchive()
+				data, err = portfolioc.BuildExportArchivePayload(*portfolioExportArchivePortfolioIDFlag)
+			case "import-archive":
+				endpoint = c.ImportArchive()
+				data, err = portfolioc.BuildImportArchivePayload(*portfolioImportArchiveBodyFlag, *portfolioImportArchivePortfolioIDFlag, *portfolioImportArchiveReplaceFlag)
+			case "reconcile-positions":
+				endpoint = c.ReconcilePositions()
+				data, err = portfolioc.BuildReconcilePositionsPayload(*portfolioReconcilePositionsBodyFlag, *portfolioReconcilePositionsPortfolioIDFlag, *portfolioReconcilePositionsFormatFlag, *portfolioReconcilePositionsProfileFlag, *portfolioReconcilePositionsAccountFlag, *portfolioReconcilePositionsAsOfFlag)
+			case "list-reconciliation-breaks":
+				endpoint = c.ListReconciliationBreaks()
+				data, err = portfolioc.BuildListReconciliationBreaksPayload(*portfolioListReconciliationBreaksPortfolioIDFlag, *portfolioListReconciliationBreaksStatusFlag, *portfolioListReconciliationBreaksAccountFlag)
+			case "update-reconciliation-break":
+				endpoint = c.UpdateReconciliationBreak()
+				data, err = portfolioc.BuildUpdateReconciliationBreakPayload(*portfolioUpdateReconciliationBreakPortfolioIDFlag, *portfolioUpdateReconciliationBreakIDFlag, *portfolioUpdateReconciliationBreakStatusFlag, *portfolioUpdateReconciliationBreakNoteFlag, *portfolioUpdateReconciliationBreakApplyFlag)
+			case "get-tax-report":
+				endpoint = c.GetTaxReport()
+				data, err = portfolioc.BuildGetTaxReportPayload(*portfolioGetTaxReportPortfolioIDFlag, *portfolioGetTaxReportYearFlag)
+			case "export-tax-report":
+				endpoint = c.ExportTaxReport()
+				data, err = portfolioc.BuildExportTaxReportPayload(*portfolioExportTaxReportPortfolioIDFlag, *portfolioExportTaxReportYearFlag, *portfolioExportTaxReportFormatFlag)
+			case "list-lots":
+				endpoint = c.ListLots()
+				data, err = portfolioc.BuildListLotsPayload(*portfolioListLotsPortfolioIDFlag, *portfolioListLotsAccountFlag, *portfolioListLotsSymbolFlag)
+			case "find-tax-loss-harvests":
+				endpoint = c.FindTaxLossHarvests()
+				data, err = portfolioc.BuildFindTaxLossHarvestsPayload(*portfolioFindTaxLossHarvestsPortfolioIDFlag, *portfolioFindTaxLossHarvestsAccountFlag, *portfolioFindTaxLossHarvestsMinLossFlag, *portfolioFindTaxLossHarvestsShortTermRateFlag, *portfolioFindTaxLossHarvestsLongTermRateFlag)
+			case "list-accounts":
+				endpoint = c.ListAccounts()
+				data, err = portfolioc.BuildListAccountsPayload(*portfolioListAccountsPortfolioIDFlag)
+			case "set-account":
+				endpoint = c.SetAccount()
+				data, err = portfolioc.BuildSetAccountPayload(*portfolioSetAccountBodyFlag, *portfolioSetAccountPortfolioIDFlag)
+			case "remove-account":
+				endpoint = c.RemoveAccount()
+				data, err = portfolioc.BuildRemoveAccountPayload(*portfolioRemoveAccountPortfolioIDFlag, *portfolioRemoveAccountIDFlag)
 			}
 		}
 	}
@@ -132,6 +671,52 @@ func portfolioUsage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] portfolio COMMAND [flags]\n\n", os.Args[0])
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    get-portfolio-summary: GetPortfolioSummary implements getPortfolioSummary.`)
+	fmt.Fprintln(os.Stderr, `    get-returns: Compute time-weighted and money-weighted returns so deposits and withdrawals do not look like performance.`)
+	fmt.Fprintln(os.Stderr, `    get-allocation: Break holdings down by asset class, sector, country, region, currency, account or custom tag.`)
+	fmt.Fprintln(os.Stderr, `    get-target-allocation: Return the target allocation model of the portfolio.`)
+	fmt.Fprintln(os.Stderr, `    set-target-allocation: Replace the target weights and tolerance bands of the portfolio.`)
+	fmt.Fprintln(os.Stderr, `    propose-rebalance: Propose the trades needed to bring positions back within their tolerance bands.`)
+	fmt.Fprintln(os.Stderr, `    set-benchmark: Define the benchmark the portfolio is measured against.`)
+	fmt.Fprintln(os.Stderr, `    get-benchmark-comparison: Compare portfolio cumulative returns against its benchmark over a period.`)
+	fmt.Fprintln(os.Stderr, `    get-risk-metrics: Measure volatility, Sharpe and Sortino ratios, beta and correlation against the benchmark and maximum drawdown, optionally over rolling windows.`)
+	fmt.Fprintln(os.Stderr, `    get-value-at-risk: Estimate Value-at-Risk and expected shortfall by historical simulation, variance-covariance or Monte Carlo from locally stored price history.`)
+	fmt.Fprintln(os.Stderr, `    list-stress-scenarios: List the built-in and configured stress scenarios.`)
+	fmt.Fprintln(os.Stderr, `    run-stress-test: Apply a stress scenario to current holdings and report the projected value, loss and worst contributors.`)
+	fmt.Fprintln(os.Stderr, `    project-portfolio: Simulate future paths of the portfolio with contributions, withdrawals and inflation, and estimate the probability of reaching a goal.`)
+	fmt.Fprintln(os.Stderr, `    list-corporate-actions: List the corporate actions recorded in the portfolio ledger.`)
+	fmt.Fprintln(os.Stderr, `    apply-corporate-actions: Record splits, reverse splits, spin-offs, mergers and symbol changes in the ledger. Holdings and lots are adjusted from the effective date.`)
+	fmt.Fprintln(os.Stderr, `    record-income: Record a dividend or interest payment. Dividends can be reinvested at the pay-date price, creating a new lot.`)
+	fmt.Fprintln(os.Stderr, `    get-income: Summarize dividend and interest income by period, holding and currency, with the expected income of the next 12 months.`)
+	fmt.Fprintln(os.Stderr, `    price-bond: Compute clean and dirty price, accrued interest, yield to maturity and duration of a bond from a clean price or a yield.`)
+	fmt.Fprintln(os.Stderr, `    list-bond-positions: Value the bonds held before maturity at their latest clean price with accrued interest, yield and duration.`)
+	fmt.Fprintln(os.Stderr, `    get-cash-flow-ladder: List the upcoming coupon and principal payments of the bonds held, by date and by year.`)
+	fmt.Fprintln(os.Stderr, `    list-option-positions: Value option holdings with Black-Scholes or Black-76 and return their greeks and the delta-adjusted exposure per underlying. Expired options not yet settled are valued at their intrinsic value.`)
+	fmt.Fprintln(os.Stderr, `    settle-option-expiries: Close expired options: out-of-the-money options expire worthless and in-the-money options are exercised or assigned.`)
+	fmt.Fprintln(os.Stderr, `    set-margin-account: Make an account a margin account, allowing a negative cash balance and short positions, with its requirements and financing rates.`)
+	fmt.Fprintln(os.Stderr, `    get-margin-status: Return the equity, loan balance, maintenance excess and buying power of each margin account, with interest and borrow fees not yet charged as accrued.`)
+	fmt.Fprintln(os.Stderr, `    charge-margin: Charge the margin interest and borrow fees of completed months to the margin accounts, then return their status.`)
+	fmt.Fprintln(os.Stderr, `    list-events: List recent portfolio events, oldest first.`)
+	fmt.Fprintln(os.Stderr, `    place-order: Place a paper trading order. Orders are filled by the matching simulator against market ticks, with the configured slippage and commission, and fills post to the ledger. Status changes are listed as order_status events.`)
+	fmt.Fprintln(os.Stderr, `    list-orders: List the paper trading orders of a portfolio, oldest first.`)
+	fmt.Fprintln(os.Stderr, `    cancel-order: Cancel an open paper trading order.`)
+	fmt.Fprintln(os.Stderr, `    preview-trades: Show the effect of hypothetical buys and sells on the summary, allocation, realized gains and tax, and risk of a portfolio without persisting anything.`)
+	fmt.Fprintln(os.Stderr, `    run-backtest: Replay stored historical prices through an allocation strategy (buy-and-hold, periodic or threshold rebalancing, or dollar-cost averaging) and report the equity curve, returns, drawdown and turnover.`)
+	fmt.Fprintln(os.Stderr, `    import-csv: Import a broker CSV statement into a portfolio ledger with a named mapping profile. Rows already in the ledger are skipped as duplicates and rows that cannot be read are reported rather than failing the import; a dry run reports the outcome without posting anything.`)
+	fmt.Fprintln(os.Stderr, `    import-ofx: Import an OFX 1.x (SGML) or 2.x (XML) bank or investment statement, including Quicken QFX files, into a portfolio ledger and reconcile the positions and cash of the statement against the ledger afterwards. Rows already in the ledger are skipped as duplicates; a dry run reports the outcome without posting anything.`)
+	fmt.Fprintln(os.Stderr, `    export-journal: Export the ledger of a portfolio as a Beancount or hledger journal for download: lots at cost with their acquisition dates, sales relieving the lots they disposed of, commodity declarations and month-end prices of the instruments held.`)
+	fmt.Fprintln(os.Stderr, `    import-journal: Import the transactions of a Beancount or hledger journal into a portfolio ledger. Journals exported by exportJournal read back exactly; other transactions are read from their postings under the root account. Transactions already in the ledger are skipped as duplicates; a dry run reports the outcome without posting anything.`)
+	fmt.Fprintln(os.Stderr, `    export-archive: Export a portfolio as a versioned JSON archive for download, to move it to another environment: its metadata, ledger, the instruments it refers to, target weights and settings, with the schema version and a checksum.`)
+	fmt.Fprintln(os.Stderr, `    import-archive: Import a portfolio archive written by exportArchive, migrating archives of older schema versions forward. Archives that fail their checksum or come from a newer schema version are refused. An existing portfolio is only overwritten when replace is set.`)
+	fmt.Fprintln(os.Stderr, `    reconcile-positions: Reconcile the holdings derived from a portfolio ledger against a broker position snapshot, a CSV position file read with a mapping profile or an OFX statement, per account and date. Breaks (quantity mismatches, symbols missing on either side and cash differences) are tracked with suggested adjusting entries: a break found again is updated rather than duplicated, and open breaks of the checked accounts and dates that now match are resolved.`)
+	fmt.Fprintln(os.Stderr, `    list-reconciliation-breaks: List the reconciliation breaks of a portfolio in the order they were found.`)
+	fmt.Fprintln(os.Stderr, `    update-reconciliation-break: Set the status of a reconciliation break. Resolving an open break with apply posts its suggested adjustments to the ledger. Resolved breaks can only be reopened.`)
+	fmt.Fprintln(os.Stderr, `    get-tax-report: Report the capital gains realized in a calendar year, one line per lot disposed with its acquisition and disposal dates, proceeds, cost basis, short-term or long-term classification and wash-sale adjustment, grouped by account.`)
+	fmt.Fprintln(os.Stderr, `    export-tax-report: Export the capital gains report of a calendar year for download, as CSV with one row per lot disposed or as JSON shaped like getTaxReport.`)
+	fmt.Fprintln(os.Stderr, `    list-lots: List the open tax lots of a portfolio in acquisition order, with the losses deferred into them by wash sales and their unrealized gains.`)
+	fmt.Fprintln(os.Stderr, `    find-tax-loss-harvests: Scan the open lots of a portfolio for unrealized losses above a threshold and suggest sales paired with replacements that are not substantially identical, avoiding wash-sale windows, with the tax saved at the given marginal rates.`)
+	fmt.Fprintln(os.Stderr, `    list-accounts: List the accounts of a portfolio, registered or referred to by transactions, with their tax treatment and balances.`)
+	fmt.Fprintln(os.Stderr, `    set-account: Register or update an account of a portfolio with its type, tax treatment, custodian and base currency. The tax treatment defaults to that of the type.`)
+	fmt.Fprintln(os.Stderr, `    remove-account: Remove a registered account that no transaction refers to and return it.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s portfolio COMMAND --help\n", os.Args[0])
@@ -139,6 +724,8 @@ func portfolioUsage() {
 func portfolioGetPortfolioSummaryUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-portfolio-summary", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -account STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
@@ -146,8 +733,992 @@ func portfolioGetPortfolioSummaryUsage() {
 	fmt.Fprintln(os.Stderr, `GetPortfolioSummary implements getPortfolioSummary.`)
 
 	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -account STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-portfolio-summary --portfolio-id \"Sit quibusdam.\" --account \"Recusandae dolorum dolores qui.\"")
+}
+
+func portfolioGetReturnsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-returns", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -period STRING")
+	fmt.Fprint(os.Stderr, " -start STRING")
+	fmt.Fprint(os.Stderr, " -end STRING")
+	fmt.Fprint(os.Stderr, " -account STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Compute time-weighted and money-weighted returns so deposits and withdrawals do not look like performance.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -period STRING: `)
+	fmt.Fprintln(os.Stderr, `    -start STRING: `)
+	fmt.Fprintln(os.Stderr, `    -end STRING: `)
+	fmt.Fprintln(os.Stderr, `    -account STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-returns --portfolio-id \"Unde dolores aut labore.\" --period \"custom\" --start \"1984-10-19\" --end \"1986-06-12\" --account \"Vitae numquam quae perferendis nisi cupiditate minus.\"")
+}
+
+func portfolioGetAllocationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-allocation", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -dimension STRING")
+	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -account STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Break holdings down by asset class, sector, country, region, currency, account or custom tag.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -dimension STRING: `)
+	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -account STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-allocation --portfolio-id \"Ipsa veniam molestias tenetur.\" --dimension \"asset_class\" --tag \"Placeat officia sed minima.\" --account \"Nemo natus earum sint ducimus qui.\"")
+}
+
+func portfolioGetTargetAllocationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-target-allocation", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Return the target allocation model of the portfolio.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-target-allocation --portfolio-id \"Iure reiciendis.\"")
+}
+
+func portfolioSetTargetAllocationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio set-target-allocation", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replace the target weights and tolerance bands of the portfolio.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio set-target-allocation --body '[\n      {\n         \"symbol\": \"Aut quia.\",\n         \"tolerance\": 0.0745868946453716,\n         \"weight\": 0.8888044856851226\n      },\n      {\n         \"symbol\": \"Aut quia.\",\n         \"tolerance\": 0.0745868946453716,\n         \"weight\": 0.8888044856851226\n      },\n      {\n         \"symbol\": \"Aut quia.\",\n         \"tolerance\": 0.0745868946453716,\n         \"weight\": 0.8888044856851226\n      }\n   ]' --portfolio-id \"Iure ut et nihil.\"")
+}
+
+func portfolioProposeRebalanceUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio propose-rebalance", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Propose the trades needed to bring positions back within their tolerance bands.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio propose-rebalance --body '{\n      \"avoid_short_term_gains\": false,\n      \"min_trade_value\": 0.37044759305175057\n   }' --portfolio-id \"Voluptatibus omnis earum odit voluptates praesentium.\"")
+}
+
+func portfolioSetBenchmarkUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio set-benchmark", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Define the benchmark the portfolio is measured against.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio set-benchmark --body '{\n      \"blend\": \"60% SPY / 40% AGG\",\n      \"components\": [\n         {\n            \"symbol\": \"Molestias tenetur eum cumque eveniet.\",\n            \"weight\": 0.023374236656070052\n         },\n         {\n            \"symbol\": \"Molestias tenetur eum cumque eveniet.\",\n            \"weight\": 0.023374236656070052\n         },\n         {\n            \"symbol\": \"Molestias tenetur eum cumque eveniet.\",\n            \"weight\": 0.023374236656070052\n         }\n      ],\n      \"name\": \"Rerum debitis ut est.\",\n      \"rebalance\": \"daily\"\n   }' --portfolio-id \"Aut necessitatibus doloribus at nam.\"")
+}
+
+func portfolioGetBenchmarkComparisonUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-benchmark-comparison", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -period STRING")
+	fmt.Fprint(os.Stderr, " -start STRING")
+	fmt.Fprint(os.Stderr, " -end STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Compare portfolio cumulative returns against its benchmark over a period.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -period STRING: `)
+	fmt.Fprintln(os.Stderr, `    -start STRING: `)
+	fmt.Fprintln(os.Stderr, `    -end STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-benchmark-comparison --portfolio-id \"Quod facere quasi odio quo laboriosam.\" --period \"1D\" --start \"1987-05-17\" --end \"1984-09-04\"")
+}
+
+func portfolioGetRiskMetricsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-risk-metrics", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -period STRING")
+	fmt.Fprint(os.Stderr, " -start STRING")
+	fmt.Fprint(os.Stderr, " -end STRING")
+	fmt.Fprint(os.Stderr, " -risk-free-rate FLOAT64")
+	fmt.Fprint(os.Stderr, " -window INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Measure volatility, Sharpe and Sortino ratios, beta and correlation against the benchmark and maximum drawdown, optionally over rolling windows.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -period STRING: `)
+	fmt.Fprintln(os.Stderr, `    -start STRING: `)
+	fmt.Fprintln(os.Stderr, `    -end STRING: `)
+	fmt.Fprintln(os.Stderr, `    -risk-free-rate FLOAT64: `)
+	fmt.Fprintln(os.Stderr, `    -window INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-risk-metrics --portfolio-id \"Impedit ad et in in corporis eum.\" --period \"QTD\" --start \"2012-09-17\" --end \"2003-02-12\" --risk-free-rate 0.866257051929035 --window 6956137832705708304")
+}
+
+func portfolioGetValueAtRiskUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-value-at-risk", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -method STRING")
+	fmt.Fprint(os.Stderr, " -confidence FLOAT64")
+	fmt.Fprint(os.Stderr, " -horizon INT")
+	fmt.Fprint(os.Stderr, " -lookback INT")
+	fmt.Fprint(os.Stderr, " -simulations INT")
+	fmt.Fprint(os.Stderr, " -seed INT64")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Estimate Value-at-Risk and expected shortfall by historical simulation, variance-covariance or Monte Carlo from locally stored price history.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -method STRING: `)
+	fmt.Fprintln(os.Stderr, `    -confidence FLOAT64: `)
+	fmt.Fprintln(os.Stderr, `    -horizon INT: `)
+	fmt.Fprintln(os.Stderr, `    -lookback INT: `)
+	fmt.Fprintln(os.Stderr, `    -simulations INT: `)
+	fmt.Fprintln(os.Stderr, `    -seed INT64: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-value-at-risk --portfolio-id \"Sit molestias.\" --method \"monte_carlo\" --confidence 0.9551317102355402 --horizon 7934206167530184903 --lookback 2670221452689590955 --simulations 677973 --seed 5215702932281389869")
+}
+
+func portfolioListStressScenariosUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio list-stress-scenarios", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the built-in and configured stress scenarios.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio list-stress-scenarios")
+}
+
+func portfolioRunStressTestUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio run-stress-test", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -scenario STRING")
+	fmt.Fprint(os.Stderr, " -top INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Apply a stress scenario to current holdings and report the projected value, loss and worst contributors.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -scenario STRING: `)
+	fmt.Fprintln(os.Stderr, `    -top INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio run-stress-test --portfolio-id \"Voluptas accusantium optio id.\" --scenario \"Labore autem assumenda ut omnis.\" --top 7054544541036526341")
+}
+
+func portfolioProjectPortfolioUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio project-portfolio", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Simulate future paths of the portfolio with contributions, withdrawals and inflation, and estimate the probability of reaching a goal.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio project-portfolio --body '{\n      \"assumptions\": [\n         {\n            \"asset_class\": \"Itaque eum et ut.\",\n            \"expected_return\": 0.4070081525316062,\n            \"volatility\": 0.9064199896396314,\n            \"weight\": 0.3757730643289944\n         },\n         {\n            \"asset_class\": \"Itaque eum et ut.\",\n            \"expected_return\": 0.4070081525316062,\n            \"volatility\": 0.9064199896396314,\n            \"weight\": 0.3757730643289944\n         },\n         {\n            \"asset_class\": \"Itaque eum et ut.\",\n            \"expected_return\": 0.4070081525316062,\n            \"volatility\": 0.9064199896396314,\n            \"weight\": 0.3757730643289944\n         },\n         {\n            \"asset_class\": \"Itaque eum et ut.\",\n            \"expected_return\": 0.4070081525316062,\n            \"volatility\": 0.9064199896396314,\n            \"weight\": 0.3757730643289944\n         }\n      ],\n      \"end\": \"2008-04-26\",\n      \"goal\": 0.8592194644812313,\n      \"goal_date\": \"2007-02-20\",\n      \"inflation\": 0.25649533215294645,\n      \"monthly_contribution\": 0.28632495399362673,\n      \"monthly_withdrawal\": 0.8914453645705456,\n      \"paths\": 93949,\n      \"seed\": 3632234997435260961,\n      \"start_value\": 0.6429855506614686,\n      \"withdrawal_start\": \"1991-05-12\"\n   }' --portfolio-id \"Sint et.\"")
+}
+
+func portfolioListCorporateActionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio list-corporate-actions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the corporate actions recorded in the portfolio ledger.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio list-corporate-actions --portfolio-id \"Delectus nisi velit modi omnis quaerat.\"")
+}
+
+func portfolioApplyCorporateActionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio apply-corporate-actions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Record splits, reverse splits, spin-offs, mergers and symbol changes in the ledger. Holdings and lots are adjusted from the effective date.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio apply-corporate-actions --body '{\n      \"actions\": [\n         {\n            \"basis_fraction\": 0.29253903798555114,\n            \"cash_in_lieu\": false,\n            \"cash_per_share\": 0.44906702497063167,\n            \"date\": \"1998-01-15\",\n            \"new_symbol\": \"Voluptas suscipit.\",\n            \"note\": \"Perspiciatis et et nam et.\",\n            \"price\": 0.42498199096322026,\n            \"ratio\": 0.12358453831705202,\n            \"symbol\": \"Consequatur ipsa.\",\n            \"type\": \"split\"\n         },\n         {\n            \"basis_fraction\": 0.29253903798555114,\n            \"cash_in_lieu\": false,\n            \"cash_per_share\": 0.44906702497063167,\n            \"date\": \"1998-01-15\",\n            \"new_symbol\": \"Voluptas suscipit.\",\n            \"note\": \"Perspiciatis et et nam et.\",\n            \"price\": 0.42498199096322026,\n            \"ratio\": 0.12358453831705202,\n            \"symbol\": \"Consequatur ipsa.\",\n            \"type\": \"split\"\n         },\n         {\n            \"basis_fraction\": 0.29253903798555114,\n            \"cash_in_lieu\": false,\n            \"cash_per_share\": 0.44906702497063167,\n            \"date\": \"1998-01-15\",\n            \"new_symbol\": \"Voluptas suscipit.\",\n            \"note\": \"Perspiciatis et et nam et.\",\n            \"price\": 0.42498199096322026,\n            \"ratio\": 0.12358453831705202,\n            \"symbol\": \"Consequatur ipsa.\",\n            \"type\": \"split\"\n         }\n      ]\n   }' --portfolio-id \"Asperiores ea expedita accusantium.\"")
+}
+
+func portfolioRecordIncomeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio record-income", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Record a dividend or interest payment. Dividends can be reinvested at the pay-date price, creating a new lot.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio record-income --body '{\n      \"account\": \"Magnam rem vel ducimus.\",\n      \"amount\": 0.9599981700670774,\n      \"ex_date\": \"1987-07-27\",\n      \"note\": \"Quidem sed accusamus sed.\",\n      \"pay_date\": \"2015-03-10\",\n      \"price\": 0.5950016167996456,\n      \"qualified\": true,\n      \"reinvest\": false,\n      \"symbol\": \"Necessitatibus voluptas amet.\",\n      \"type\": \"interest\",\n      \"withholding\": 0.46180537831408885\n   }' --portfolio-id \"Tempore autem sed voluptate.\"")
+}
+
+func portfolioGetIncomeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-income", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -period STRING")
+	fmt.Fprint(os.Stderr, " -start STRING")
+	fmt.Fprint(os.Stderr, " -end STRING")
+	fmt.Fprint(os.Stderr, " -interval STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Summarize dividend and interest income by period, holding and currency, with the expected income of the next 12 months.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -period STRING: `)
+	fmt.Fprintln(os.Stderr, `    -start STRING: `)
+	fmt.Fprintln(os.Stderr, `    -end STRING: `)
+	fmt.Fprintln(os.Stderr, `    -interval STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-income --portfolio-id \"Rerum consequatur est ut enim et perferendis.\" --period \"YTD\" --start \"1975-02-13\" --end \"1985-05-31\" --interval \"year\"")
+}
+
+func portfolioPriceBondUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio price-bond", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Compute clean and dirty price, accrued interest, yield to maturity and duration of a bond from a clean price or a yield.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio price-bond --body '{\n      \"clean_price\": 0.750202503744584,\n      \"coupon\": 0.7635136607460532,\n      \"coupon_frequency\": 2,\n      \"day_count\": \"ACT/ACT\",\n      \"face_value\": 0.498524149599353,\n      \"maturity\": \"2012-03-06\",\n      \"settlement\": \"2014-05-15\",\n      \"yield\": 0.5245904251735487\n   }'")
+}
+
+func portfolioListBondPositionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio list-bond-positions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Value the bonds held before maturity at their latest clean price with accrued interest, yield and duration.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio list-bond-positions --portfolio-id \"Autem non.\"")
+}
+
+func portfolioGetCashFlowLadderUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-cash-flow-ladder", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -years INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the upcoming coupon and principal payments of the bonds held, by date and by year.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -years INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-cash-flow-ladder --portfolio-id \"Cumque dolorem ullam quos et et.\" --years 6")
+}
+
+func portfolioListOptionPositionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio list-option-positions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -volatility FLOAT64")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Value option holdings with Black-Scholes or Black-76 and return their greeks and the delta-adjusted exposure per underlying. Expired options not yet settled are valued at their intrinsic value.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -volatility FLOAT64: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio list-option-positions --portfolio-id \"Impedit molestias harum.\" --volatility 0.28746069519580403")
+}
+
+func portfolioSettleOptionExpiriesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio settle-option-expiries", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Close expired options: out-of-the-money options expire worthless and in-the-money options are exercised or assigned.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio settle-option-expiries --portfolio-id \"Quis qui ut ut molestias rerum expedita.\"")
+}
+
+func portfolioSetMarginAccountUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio set-margin-account", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Make an account a margin account, allowing a negative cash balance and short positions, with its requirements and financing rates.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio set-margin-account --body '{\n      \"account\": \"Nemo inventore.\",\n      \"borrow_rate\": 0.8841584996203483,\n      \"borrow_rates\": {\n         \"Veniam enim.\": 0.9191124863029354,\n         \"Voluptas consequuntur.\": 0.2356494593408973,\n         \"Voluptatem sint impedit voluptas assumenda provident.\": 0.7180698527192441\n      },\n      \"initial_requirement\": 0.8031352291323962,\n      \"loan_rate\": 0.2002814353835115,\n      \"maintenance_requirement\": 0.4790662663388617,\n      \"short_maintenance_requirement\": 0.700998039327302\n   }' --portfolio-id \"Officiis asperiores qui pariatur incidunt itaque.\"")
+}
+
+func portfolioGetMarginStatusUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-margin-status", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Return the equity, loan balance, maintenance excess and buying power of each margin account, with interest and borrow fees not yet charged as accrued.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-margin-status --portfolio-id \"Tempora quaerat et sit laborum maiores.\"")
+}
+
+func portfolioChargeMarginUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio charge-margin", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Charge the margin interest and borrow fees of completed months to the margin accounts, then return their status.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio charge-margin --portfolio-id \"Accusantium pariatur suscipit nesciunt totam provident totam.\"")
+}
+
+func portfolioListEventsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio list-events", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -since STRING")
+	fmt.Fprint(os.Stderr, " -type STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List recent portfolio events, oldest first.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -since STRING: `)
+	fmt.Fprintln(os.Stderr, `    -type STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio list-events --portfolio-id \"Minima aut.\" --since \"1974-12-29T15:25:41Z\" --type \"Qui sint.\"")
+}
+
+func portfolioPlaceOrderUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio place-order", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Place a paper trading order. Orders are filled by the matching simulator against market ticks, with the configured slippage and commission, and fills post to the ledger. Status changes are listed as order_status events.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio place-order --body '{\n      \"account\": \"Ut earum magnam.\",\n      \"limit_price\": 0.6049967965404179,\n      \"quantity\": 0.4464521216919483,\n      \"side\": \"buy\",\n      \"stop_price\": 0.20599903092961772,\n      \"symbol\": \"Sed sit.\",\n      \"time_in_force\": \"day\",\n      \"type\": \"stop\"\n   }' --portfolio-id \"Perspiciatis et nostrum labore accusantium ea facere.\"")
+}
+
+func portfolioListOrdersUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio list-orders", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -status STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the paper trading orders of a portfolio, oldest first.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -status STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio list-orders --portfolio-id \"Voluptates natus non hic incidunt labore veritatis.\" --status \"expired\"")
+}
+
+func portfolioCancelOrderUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio cancel-order", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Cancel an open paper trading order.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio cancel-order --portfolio-id \"Nostrum omnis repellat impedit.\" --id \"Autem aspernatur quod.\"")
+}
+
+func portfolioPreviewTradesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio preview-trades", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Show the effect of hypothetical buys and sells on the summary, allocation, realized gains and tax, and risk of a portfolio without persisting anything.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio preview-trades --body '{\n      \"dimension\": \"region\",\n      \"long_term_tax_rate\": 0.6653044668559718,\n      \"lookback\": 6347616431937563912,\n      \"short_term_tax_rate\": 0.42094713313871246,\n      \"trades\": [\n         {\n            \"account\": \"A et soluta a quas.\",\n            \"fee\": 0.20877598708539158,\n            \"price\": 0.6810067855877286,\n            \"quantity\": 0.6881311917459807,\n            \"side\": \"sell\",\n            \"symbol\": \"Veniam suscipit.\"\n         },\n         {\n            \"account\": \"A et soluta a quas.\",\n            \"fee\": 0.20877598708539158,\n            \"price\": 0.6810067855877286,\n            \"quantity\": 0.6881311917459807,\n            \"side\": \"sell\",\n            \"symbol\": \"Veniam suscipit.\"\n         }\n      ]\n   }' --portfolio-id \"Et ut quis.\"")
+}
+
+func portfolioRunBacktestUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio run-backtest", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replay stored historical prices through an allocation strategy (buy-and-hold, periodic or threshold rebalancing, or dollar-cost averaging) and report the equity curve, returns, drawdown and turnover.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio run-backtest --body '{\n      \"contribution\": 0.5695338434763579,\n      \"currency\": \"Deserunt qui ex officia ipsam aut molestias.\",\n      \"end\": \"1998-09-19\",\n      \"fee_rate\": 0.7988106715761776,\n      \"frequency\": \"annual\",\n      \"initial_cash\": 0.723926333761187,\n      \"name\": \"Laboriosam aperiam iusto.\",\n      \"start\": \"2011-10-09\",\n      \"strategy\": \"threshold_rebalance\",\n      \"targets\": [\n         {\n            \"symbol\": \"Sed non.\",\n            \"weight\": 0.9138776297323821\n         }\n      ],\n      \"threshold\": 0.03891810755402372\n   }'")
+}
+
+func portfolioImportCsvUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio import-csv", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -profile STRING")
+	fmt.Fprint(os.Stderr, " -account STRING")
+	fmt.Fprint(os.Stderr, " -dry-run BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Import a broker CSV statement into a portfolio ledger with a named mapping profile. Rows already in the ledger are skipped as duplicates and rows that cannot be read are reported rather than failing the import; a dry run reports the outcome without posting anything.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -profile STRING: `)
+	fmt.Fprintln(os.Stderr, `    -account STRING: `)
+	fmt.Fprintln(os.Stderr, `    -dry-run BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio import-csv --body '{\n      \"content\": \"85k\"\n   }' --portfolio-id \"Odit dicta laborum et earum harum veritatis.\" --profile \"Eius eaque qui non.\" --account \"Ut sapiente tempore temporibus voluptas doloremque.\" --dry-run false")
+}
+
+func portfolioImportOfxUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio import-ofx", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -account STRING")
+	fmt.Fprint(os.Stderr, " -dry-run BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Import an OFX 1.x (SGML) or 2.x (XML) bank or investment statement, including Quicken QFX files, into a portfolio ledger and reconcile the positions and cash of the statement against the ledger afterwards. Rows already in the ledger are skipped as duplicates; a dry run reports the outcome without posting anything.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -account STRING: `)
+	fmt.Fprintln(os.Stderr, `    -dry-run BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio import-ofx --body '{\n      \"content\": \"yxr\"\n   }' --portfolio-id \"Doloribus quam quia sit voluptatem voluptate veritatis.\" --account \"Vel illo repellat.\" --dry-run true")
+}
+
+func portfolioExportJournalUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio export-journal", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -format STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Export the ledger of a portfolio as a Beancount or hledger journal for download: lots at cost with their acquisition dates, sales relieving the lots they disposed of, commodity declarations and month-end prices of the instruments held.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -format STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio export-journal --portfolio-id \"Numquam quos voluptatem dignissimos similique sunt in.\" --format \"hledger\"")
+}
+
+func portfolioImportJournalUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio import-journal", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -account STRING")
+	fmt.Fprint(os.Stderr, " -root STRING")
+	fmt.Fprint(os.Stderr, " -dry-run BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Import the transactions of a Beancount or hledger journal into a portfolio ledger. Journals exported by exportJournal read back exactly; other transactions are read from their postings under the root account. Transactions already in the ledger are skipped as duplicates; a dry run reports the outcome without posting anything.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -account STRING: `)
+	fmt.Fprintln(os.Stderr, `    -root STRING: `)
+	fmt.Fprintln(os.Stderr, `    -dry-run BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio import-journal --body '{\n      \"content\": \"yk\"\n   }' --portfolio-id \"Illum voluptas error ratione ut hic.\" --account \"Ipsa et ducimus sunt et et illo.\" --root \"Dolorum ipsam doloribus veritatis nemo.\" --dry-run true")
+}
+
+func portfolioExportArchiveUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio export-archive", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Export a portfolio as a versioned JSON archive for download, to move it to another environment: its metadata, ledger, the instruments it refers to, target weights and settings, with the schema version and a checksum.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio export-archive --portfolio-id \"Et totam.\"")
+}
+
+func portfolioImportArchiveUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio import-archive", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -replace BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Import a portfolio archive written by exportArchive, migrating archives of older schema versions forward. Archives that fail their checksum or come from a newer schema version are refused. An existing portfolio is only overwritten when replace is set.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -replace BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio import-archive --body '{\n      \"content\": \"8\"\n   }' --portfolio-id \"Vero eveniet aut.\" --replace true")
+}
+
+func portfolioReconcilePositionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio reconcile-positions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -format STRING")
+	fmt.Fprint(os.Stderr, " -profile STRING")
+	fmt.Fprint(os.Stderr, " -account STRING")
+	fmt.Fprint(os.Stderr, " -as-of STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Reconcile the holdings derived from a portfolio ledger against a broker position snapshot, a CSV position file read with a mapping profile or an OFX statement, per account and date. Breaks (quantity mismatches, symbols missing on either side and cash differences) are tracked with suggested adjusting entries: a break found again is updated rather than duplicated, and open breaks of the checked accounts and dates that now match are resolved.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -format STRING: `)
+	fmt.Fprintln(os.Stderr, `    -profile STRING: `)
+	fmt.Fprintln(os.Stderr, `    -account STRING: `)
+	fmt.Fprintln(os.Stderr, `    -as-of STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio reconcile-positions --body '{\n      \"content\": \"xis\"\n   }' --portfolio-id \"Cupiditate ratione laborum.\" --format \"csv\" --profile \"Temporibus atque.\" --account \"Velit excepturi laudantium perferendis praesentium.\" --as-of \"1973-07-03\"")
+}
+
+func portfolioListReconciliationBreaksUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio list-reconciliation-breaks", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -status STRING")
+	fmt.Fprint(os.Stderr, " -account STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the reconciliation breaks of a portfolio in the order they were found.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -status STRING: `)
+	fmt.Fprintln(os.Stderr, `    -account STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio list-reconciliation-breaks --portfolio-id \"Repudiandae et neque.\" --status \"open\" --account \"Maxime nobis perspiciatis tenetur quia aut.\"")
+}
+
+func portfolioUpdateReconciliationBreakUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio update-reconciliation-break", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -status STRING")
+	fmt.Fprint(os.Stderr, " -note STRING")
+	fmt.Fprint(os.Stderr, " -apply BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Set the status of a reconciliation break. Resolving an open break with apply posts its suggested adjustments to the ledger. Resolved breaks can only be reopened.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -status STRING: `)
+	fmt.Fprintln(os.Stderr, `    -note STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apply BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio update-reconciliation-break --portfolio-id \"Corporis iure ipsam quia vitae accusamus.\" --id \"Illum modi.\" --status \"open\" --note \"Quod reprehenderit voluptate facilis eos quia.\" --apply true")
+}
+
+func portfolioGetTaxReportUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get-tax-report", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -year INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Report the capital gains realized in a calendar year, one line per lot disposed with its acquisition and disposal dates, proceeds, cost basis, short-term or long-term classification and wash-sale adjustment, grouped by account.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -year INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-tax-report --portfolio-id \"Quia consequatur.\" --year 1294367733691934987")
+}
+
+func portfolioExportTaxReportUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio export-tax-report", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -year INT")
+	fmt.Fprint(os.Stderr, " -format STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Export the capital gains report of a calendar year for download, as CSV with one row per lot disposed or as JSON shaped like getTaxReport.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -year INT: `)
+	fmt.Fprintln(os.Stderr, `    -format STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio export-tax-report --portfolio-id \"Officia maiores molestiae doloremque repellat quia.\" --year 8459054726622684697 --format \"json\"")
+}
+
+func portfolioListLotsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio list-lots", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -account STRING")
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the open tax lots of a portfolio in acquisition order, with the losses deferred into them by wash sales and their unrealized gains.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -account STRING: `)
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio list-lots --portfolio-id \"Quo eveniet et molestiae consequatur blanditiis.\" --account \"Sed voluptas.\" --symbol \"Exercitationem aut.\"")
+}
+
+func portfolioFindTaxLossHarvestsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio find-tax-loss-harvests", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -account STRING")
+	fmt.Fprint(os.Stderr, " -min-loss FLOAT64")
+	fmt.Fprint(os.Stderr, " -short-term-rate FLOAT64")
+	fmt.Fprint(os.Stderr, " -long-term-rate FLOAT64")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Scan the open lots of a portfolio for unrealized losses above a threshold and suggest sales paired with replacements that are not substantially identical, avoiding wash-sale windows, with the tax saved at the given marginal rates.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -account STRING: `)
+	fmt.Fprintln(os.Stderr, `    -min-loss FLOAT64: `)
+	fmt.Fprintln(os.Stderr, `    -short-term-rate FLOAT64: `)
+	fmt.Fprintln(os.Stderr, `    -long-term-rate FLOAT64: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio find-tax-loss-harvests --portfolio-id \"Similique voluptas dolor tempora veritatis.\" --account \"Quia maxime quidem aspernatur eum est.\" --min-loss 0.16635650695415652 --short-term-rate 0.23689034539921103 --long-term-rate 0.19078323697523777")
+}
+
+func portfolioListAccountsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio list-accounts", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the accounts of a portfolio, registered or referred to by transactions, with their tax treatment and balances.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio list-accounts --portfolio-id \"Quisquam incidunt est et consectetur.\"")
+}
+
+func portfolioSetAccountUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio set-account", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Register or update an account of a portfolio with its type, tax treatment, custodian and base currency. The tax treatment defaults to that of the type.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio set-account --body '{\n      \"currency\": \"Ab sit.\",\n      \"custodian\": \"Quia laudantium sunt asperiores.\",\n      \"id\": \"Qui praesentium sit.\",\n      \"name\": \"Ea sunt error dolor iste qui voluptatem.\",\n      \"tax_treatment\": \"taxable\",\n      \"type\": \"other\"\n   }' --portfolio-id \"Ut ut eveniet assumenda.\"")
+}
+
+func portfolioRemoveAccountUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio remove-account", os.Args[0])
+	fmt.Fprint(os.Stderr, " -portfolio-id STRING")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Remove a registered account that no transaction refers to and return it.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -portfolio-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get-portfolio-summary")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio remove-account --portfolio-id \"Vitae sunt inventore natus.\" --id \"Ut omnis et debitis consequuntur.\"")
 }
@@ -7,3 +7,2209 @@
 // --output goa_gen
 
 package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	portfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildGetPortfolioSummaryPayload builds the payload for the portfolio
+// getPortfolioSummary endpoint from CLI flags.
+func BuildGetPortfolioSummaryPayload(portfolioGetPortfolioSummaryPortfolioID string, portfolioGetPortfolioSummaryAccount string) (*portfolio.GetPortfolioSummaryPayload, error) {
+	var portfolioID string
+	{
+		if portfolioGetPortfolioSummaryPortfolioID != "" {
+			portfolioID = portfolioGetPortfolioSummaryPortfolioID
+		}
+	}
+	var account *string
+	{
+		if portfolioGetPortfolioSummaryAccount != "" {
+			account = &portfolioGetPortfolioSummaryAccount
+		}
+	}
+	v := &portfolio.GetPortfolioSummaryPayload{}
+	v.PortfolioID = portfolioID
+	v.Account = account
+
+	return v, nil
+}
+
+// BuildGetReturnsPayload builds the payload for the portfolio getReturns
+// endpoint from CLI flags.
+func BuildGetReturnsPayload(portfolioGetReturnsPortfolioID string, portfolioGetReturnsPeriod string, portfolioGetReturnsStart string, portfolioGetReturnsEnd string, portfolioGetReturnsAccount string) (*portfolio.GetReturnsPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioGetReturnsPortfolioID != "" {
+			portfolioID = portfolioGetReturnsPortfolioID
+		}
+	}
+	var period string
+	{
+		if portfolioGetReturnsPeriod != "" {
+			period = portfolioGetReturnsPeriod
+			if !(period == "1D" || period == "MTD" || period == "QTD" || period == "YTD" || period == "1Y" || period == "inception" || period == "custom") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("period", period, []any{"1D", "MTD", "QTD", "YTD", "1Y", "inception", "custom"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var start *string
+	{
+		if portfolioGetReturnsStart != "" {
+			start = &portfolioGetReturnsStart
+			err = goa.MergeErrors(err, goa.ValidateFormat("start", *start, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var end *string
+	{
+		if portfolioGetReturnsEnd != "" {
+			end = &portfolioGetReturnsEnd
+			err = goa.MergeErrors(err, goa.ValidateFormat("end", *end, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var account *string
+	{
+		if portfolioGetReturnsAccount != "" {
+			account = &portfolioGetReturnsAccount
+		}
+	}
+	v := &portfolio.GetReturnsPayload{}
+	v.PortfolioID = portfolioID
+	v.Period = period
+	v.Start = start
+	v.End = end
+	v.Account = account
+
+	return v, nil
+}
+
+// BuildGetAllocationPayload builds the payload for the portfolio getAllocation
+// endpoint from CLI flags.
+func BuildGetAllocationPayload(portfolioGetAllocationPortfolioID string, portfolioGetAllocationDimension string, portfolioGetAllocationTag string, portfolioGetAllocationAccount string) (*portfolio.GetAllocationPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioGetAllocationPortfolioID != "" {
+			portfolioID = portfolioGetAllocationPortfolioID
+		}
+	}
+	var dimension string
+	{
+		if portfolioGetAllocationDimension != "" {
+			dimension = portfolioGetAllocationDimension
+			if !(dimension == "asset_class" || dimension == "sector" || dimension == "country" || dimension == "region" || dimension == "currency" || dimension == "account" || dimension == "tag") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("dimension", dimension, []any{"asset_class", "sector", "country", "region", "currency", "account", "tag"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var tag *string
+	{
+		if portfolioGetAllocationTag != "" {
+			tag = &portfolioGetAllocationTag
+		}
+	}
+	var account *string
+	{
+		if portfolioGetAllocationAccount != "" {
+			account = &portfolioGetAllocationAccount
+		}
+	}
+	v := &portfolio.GetAllocationPayload{}
+	v.PortfolioID = portfolioID
+	v.Dimension = dimension
+	v.Tag = tag
+	v.Account = account
+
+	return v, nil
+}
+
+// BuildGetTargetAllocationPayload builds the payload for the portfolio
+// getTargetAllocation endpoint from CLI flags.
+func BuildGetTargetAllocationPayload(portfolioGetTargetAllocationPortfolioID string) (*portfolio.GetTargetAllocationPayload, error) {
+	var portfolioID string
+	{
+		if portfolioGetTargetAllocationPortfolioID != "" {
+			portfolioID = portfolioGetTargetAllocationPortfolioID
+		}
+	}
+	v := &portfolio.GetTargetAllocationPayload{}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildSetTargetAllocationPayload builds the payload for the portfolio
+// setTargetAllocation endpoint from CLI flags.
+func BuildSetTargetAllocationPayload(portfolioSetTargetAllocationBody string, portfolioSetTargetAllocationPortfolioID string) (*portfolio.SetTargetAllocationPayload, error) {
+	var err error
+	var body []*TargetWeightRequestBodyRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioSetTargetAllocationBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      {\n         \"symbol\": \"Aut quia.\",\n         \"tolerance\": 0.0745868946453716,\n         \"weight\": 0.8888044856851226\n      },\n      {\n         \"symbol\": \"Aut quia.\",\n         \"tolerance\": 0.0745868946453716,\n         \"weight\": 0.8888044856851226\n      },\n      {\n         \"symbol\": \"Aut quia.\",\n         \"tolerance\": 0.0745868946453716,\n         \"weight\": 0.8888044856851226\n      }\n   ]'")
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioSetTargetAllocationPortfolioID != "" {
+			portfolioID = portfolioSetTargetAllocationPortfolioID
+		}
+	}
+	v := make([]*portfolio.TargetWeight, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = marshalTargetWeightRequestBodyRequestBodyToPortfolioTargetWeight(val)
+	}
+	res := &portfolio.SetTargetAllocationPayload{
+		Targets: v,
+	}
+	res.PortfolioID = portfolioID
+
+	return res, nil
+}
+
+// BuildProposeRebalancePayload builds the payload for the portfolio
+// proposeRebalance endpoint from CLI flags.
+func BuildProposeRebalancePayload(portfolioProposeRebalanceBody string, portfolioProposeRebalancePortfolioID string) (*portfolio.ProposeRebalancePayload, error) {
+	var err error
+	var body struct {
+		// Drop trades worth less than this amount
+		MinTradeValue float64 `form:"min_trade_value" json:"min_trade_value" xml:"min_trade_value"`
+		// Only sell lots that are long-term or at a loss
+		AvoidShortTermGains bool `form:"avoid_short_term_gains" json:"avoid_short_term_gains" xml:"avoid_short_term_gains"`
+	}
+	{
+		err = json.Unmarshal([]byte(portfolioProposeRebalanceBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"avoid_short_term_gains\": false,\n      \"min_trade_value\": 0.37044759305175057\n   }'")
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioProposeRebalancePortfolioID != "" {
+			portfolioID = portfolioProposeRebalancePortfolioID
+		}
+	}
+	v := &portfolio.ProposeRebalancePayload{
+		MinTradeValue:       body.MinTradeValue,
+		AvoidShortTermGains: body.AvoidShortTermGains,
+	}
+	{
+		var zero float64
+		if v.MinTradeValue == zero {
+			v.MinTradeValue = 0
+		}
+	}
+	{
+		var zero bool
+		if v.AvoidShortTermGains == zero {
+			v.AvoidShortTermGains = false
+		}
+	}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildSetBenchmarkPayload builds the payload for the portfolio setBenchmark
+// endpoint from CLI flags.
+func BuildSetBenchmarkPayload(portfolioSetBenchmarkBody string, portfolioSetBenchmarkPortfolioID string) (*portfolio.SetBenchmarkPayload, error) {
+	var err error
+	var body SetBenchmarkRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioSetBenchmarkBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"blend\": \"60% SPY / 40% AGG\",\n      \"components\": [\n         {\n            \"symbol\": \"Molestias tenetur eum cumque eveniet.\",\n            \"weight\": 0.023374236656070052\n         },\n         {\n            \"symbol\": \"Molestias tenetur eum cumque eveniet.\",\n            \"weight\": 0.023374236656070052\n         },\n         {\n            \"symbol\": \"Molestias tenetur eum cumque eveniet.\",\n            \"weight\": 0.023374236656070052\n         }\n      ],\n      \"name\": \"Rerum debitis ut est.\",\n      \"rebalance\": \"daily\"\n   }'")
+		}
+		for _, e := range body.Components {
+			if e != nil {
+				if err2 := ValidateBenchmarkComponentRequestBodyRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if !(body.Rebalance == "none" || body.Rebalance == "daily" || body.Rebalance == "monthly" || body.Rebalance == "quarterly" || body.Rebalance == "annual") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.rebalance", body.Rebalance, []any{"none", "daily", "monthly", "quarterly", "annual"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioSetBenchmarkPortfolioID != "" {
+			portfolioID = portfolioSetBenchmarkPortfolioID
+		}
+	}
+	v := &portfolio.BenchmarkDefinition{
+		Name:      body.Name,
+		Blend:     body.Blend,
+		Rebalance: body.Rebalance,
+	}
+	if body.Components != nil {
+		v.Components = make([]*portfolio.BenchmarkComponent, len(body.Components))
+		for i, val := range body.Components {
+			if val == nil {
+				v.Components[i] = nil
+				continue
+			}
+			v.Components[i] = marshalBenchmarkComponentRequestBodyRequestBodyToPortfolioBenchmarkComponent(val)
+		}
+	}
+	res := &portfolio.SetBenchmarkPayload{
+		Benchmark: v,
+	}
+	res.PortfolioID = portfolioID
+
+	return res, nil
+}
+
+// BuildGetBenchmarkComparisonPayload builds the payload for the portfolio
+// getBenchmarkComparison endpoint from CLI flags.
+func BuildGetBenchmarkComparisonPayload(portfolioGetBenchmarkComparisonPortfolioID string, portfolioGetBenchmarkComparisonPeriod string, portfolioGetBenchmarkComparisonStart string, portfolioGetBenchmarkComparisonEnd string) (*portfolio.GetBenchmarkComparisonPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioGetBenchmarkComparisonPortfolioID != "" {
+			portfolioID = portfolioGetBenchmarkComparisonPortfolioID
+		}
+	}
+	var period string
+	{
+		if portfolioGetBenchmarkComparisonPeriod != "" {
+			period = portfolioGetBenchmarkComparisonPeriod
+			if !(period == "1D" || period == "MTD" || period == "QTD" || period == "YTD" || period == "1Y" || period == "inception" || period == "custom") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("period", period, []any{"1D", "MTD", "QTD", "YTD", "1Y", "inception", "custom"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var start *string
+	{
+		if portfolioGetBenchmarkComparisonStart != "" {
+			start = &portfolioGetBenchmarkComparisonStart
+			err = goa.MergeErrors(err, goa.ValidateFormat("start", *start, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var end *string
+	{
+		if portfolioGetBenchmarkComparisonEnd != "" {
+			end = &portfolioGetBenchmarkComparisonEnd
+			err = goa.MergeErrors(err, goa.ValidateFormat("end", *end, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &portfolio.GetBenchmarkComparisonPayload{}
+	v.PortfolioID = portfolioID
+	v.Period = period
+	v.Start = start
+	v.End = end
+
+	return v, nil
+}
+
+// BuildGetRiskMetricsPayload builds the payload for the portfolio
+// getRiskMetrics endpoint from CLI flags.
+func BuildGetRiskMetricsPayload(portfolioGetRiskMetricsPortfolioID string, portfolioGetRiskMetricsPeriod string, portfolioGetRiskMetricsStart string, portfolioGetRiskMetricsEnd string, portfolioGetRiskMetricsRiskFreeRate string, portfolioGetRiskMetricsWindow string) (*portfolio.GetRiskMetricsPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioGetRiskMetricsPortfolioID != "" {
+			portfolioID = portfolioGetRiskMetricsPortfolioID
+		}
+	}
+	var period string
+	{
+		if portfolioGetRiskMetricsPeriod != "" {
+			period = portfolioGetRiskMetricsPeriod
+			if !(period == "1D" || period == "MTD" || period == "QTD" || period == "YTD" || period == "1Y" || period == "inception" || period == "custom") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("period", period, []any{"1D", "MTD", "QTD", "YTD", "1Y", "inception", "custom"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var start *string
+	{
+		if portfolioGetRiskMetricsStart != "" {
+			start = &portfolioGetRiskMetricsStart
+			err = goa.MergeErrors(err, goa.ValidateFormat("start", *start, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var end *string
+	{
+		if portfolioGetRiskMetricsEnd != "" {
+			end = &portfolioGetRiskMetricsEnd
+			err = goa.MergeErrors(err, goa.ValidateFormat("end", *end, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var riskFreeRate *float64
+	{
+		if portfolioGetRiskMetricsRiskFreeRate != "" {
+			val, err := strconv.ParseFloat(portfolioGetRiskMetricsRiskFreeRate, 64)
+			riskFreeRate = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for riskFreeRate, must be FLOAT64")
+			}
+		}
+	}
+	var window *int
+	{
+		if portfolioGetRiskMetricsWindow != "" {
+			var v int64
+			v, err = strconv.ParseInt(portfolioGetRiskMetricsWindow, 10, strconv.IntSize)
+			val := int(v)
+			window = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for window, must be INT")
+			}
+			if *window < 2 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("window", *window, 2, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &portfolio.GetRiskMetricsPayload{}
+	v.PortfolioID = portfolioID
+	v.Period = period
+	v.Start = start
+	v.End = end
+	v.RiskFreeRate = riskFreeRate
+	v.Window = window
+
+	return v, nil
+}
+
+// BuildGetValueAtRiskPayload builds the payload for the portfolio
+// getValueAtRisk endpoint from CLI flags.
+func BuildGetValueAtRiskPayload(portfolioGetValueAtRiskPortfolioID string, portfolioGetValueAtRiskMethod string, portfolioGetValueAtRiskConfidence string, portfolioGetValueAtRiskHorizon string, portfolioGetValueAtRiskLookback string, portfolioGetValueAtRiskSimulations string, portfolioGetValueAtRiskSeed string) (*portfolio.GetValueAtRiskPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioGetValueAtRiskPortfolioID != "" {
+			portfolioID = portfolioGetValueAtRiskPortfolioID
+		}
+	}
+	var method string
+	{
+		if portfolioGetValueAtRiskMethod != "" {
+			method = portfolioGetValueAtRiskMethod
+			if !(method == "historical" || method == "parametric" || method == "monte_carlo") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("method", method, []any{"historical", "parametric", "monte_carlo"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var confidence float64
+	{
+		if portfolioGetValueAtRiskConfidence != "" {
+			confidence, err = strconv.ParseFloat(portfolioGetValueAtRiskConfidence, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for confidence, must be FLOAT64")
+			}
+			if confidence < 0.5 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("confidence", confidence, 0.5, true))
+			}
+			if confidence > 0.9999 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("confidence", confidence, 0.9999, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var horizon int
+	{
+		if portfolioGetValueAtRiskHorizon != "" {
+			var v int64
+			v, err = strconv.ParseInt(portfolioGetValueAtRiskHorizon, 10, strconv.IntSize)
+			horizon = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for horizon, must be INT")
+			}
+			if horizon < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("horizon", horizon, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var lookback int
+	{
+		if portfolioGetValueAtRiskLookback != "" {
+			var v int64
+			v, err = strconv.ParseInt(portfolioGetValueAtRiskLookback, 10, strconv.IntSize)
+			lookback = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for lookback, must be INT")
+			}
+			if lookback < 20 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("lookback", lookback, 20, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var simulations int
+	{
+		if portfolioGetValueAtRiskSimulations != "" {
+			var v int64
+			v, err = strconv.ParseInt(portfolioGetValueAtRiskSimulations, 10, strconv.IntSize)
+			simulations = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for simulations, must be INT")
+			}
+			if simulations < 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("simulations", simulations, 100, true))
+			}
+			if simulations > 1e+06 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("simulations", simulations, 1e+06, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var seed *int64
+	{
+		if portfolioGetValueAtRiskSeed != "" {
+			val, err := strconv.ParseInt(portfolioGetValueAtRiskSeed, 10, 64)
+			seed = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for seed, must be INT64")
+			}
+		}
+	}
+	v := &portfolio.GetValueAtRiskPayload{}
+	v.PortfolioID = portfolioID
+	v.Method = method
+	v.Confidence = confidence
+	v.Horizon = horizon
+	v.Lookback = lookback
+	v.Simulations = simulations
+	v.Seed = seed
+
+	return v, nil
+}
+
+// BuildRunStressTestPayload builds the payload for the portfolio runStressTest
+// endpoint from CLI flags.
+func BuildRunStressTestPayload(portfolioRunStressTestPortfolioID string, portfolioRunStressTestScenario string, portfolioRunStressTestTop string) (*portfolio.RunStressTestPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioRunStressTestPortfolioID != "" {
+			portfolioID = portfolioRunStressTestPortfolioID
+		}
+	}
+	var scenario string
+	{
+		scenario = portfolioRunStressTestScenario
+	}
+	var top int
+	{
+		if portfolioRunStressTestTop != "" {
+			var v int64
+			v, err = strconv.ParseInt(portfolioRunStressTestTop, 10, strconv.IntSize)
+			top = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for top, must be INT")
+			}
+			if top < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("top", top, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &portfolio.RunStressTestPayload{}
+	v.PortfolioID = portfolioID
+	v.Scenario = scenario
+	v.Top = top
+
+	return v, nil
+}
+
+// BuildProjectPortfolioPayload builds the payload for the portfolio
+// projectPortfolio endpoint from CLI flags.
+func BuildProjectPortfolioPayload(portfolioProjectPortfolioBody string, portfolioProjectPortfolioPortfolioID string) (*portfolio.ProjectPortfolioPayload, error) {
+	var err error
+	var body ProjectPortfolioRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioProjectPortfolioBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"assumptions\": [\n         {\n            \"asset_class\": \"Itaque eum et ut.\",\n            \"expected_return\": 0.4070081525316062,\n            \"volatility\": 0.9064199896396314,\n            \"weight\": 0.3757730643289944\n         },\n         {\n            \"asset_class\": \"Itaque eum et ut.\",\n            \"expected_return\": 0.4070081525316062,\n            \"volatility\": 0.9064199896396314,\n            \"weight\": 0.3757730643289944\n         },\n         {\n            \"asset_class\": \"Itaque eum et ut.\",\n            \"expected_return\": 0.4070081525316062,\n            \"volatility\": 0.9064199896396314,\n            \"weight\": 0.3757730643289944\n         },\n         {\n            \"asset_class\": \"Itaque eum et ut.\",\n            \"expected_return\": 0.4070081525316062,\n            \"volatility\": 0.9064199896396314,\n            \"weight\": 0.3757730643289944\n         }\n      ],\n      \"end\": \"2008-04-26\",\n      \"goal\": 0.8592194644812313,\n      \"goal_date\": \"2007-02-20\",\n      \"inflation\": 0.25649533215294645,\n      \"monthly_contribution\": 0.28632495399362673,\n      \"monthly_withdrawal\": 0.8914453645705456,\n      \"paths\": 93949,\n      \"seed\": 3632234997435260961,\n      \"start_value\": 0.6429855506614686,\n      \"withdrawal_start\": \"1991-05-12\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", body.End, goa.FormatDate))
+		if body.StartValue != nil {
+			if *body.StartValue < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.start_value", *body.StartValue, 0, true))
+			}
+		}
+		if body.MonthlyContribution < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.monthly_contribution", body.MonthlyContribution, 0, true))
+		}
+		if body.MonthlyWithdrawal < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.monthly_withdrawal", body.MonthlyWithdrawal, 0, true))
+		}
+		if body.WithdrawalStart != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.withdrawal_start", *body.WithdrawalStart, goa.FormatDate))
+		}
+		if body.Goal != nil {
+			if *body.Goal < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.goal", *body.Goal, 0, true))
+			}
+		}
+		if body.GoalDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.goal_date", *body.GoalDate, goa.FormatDate))
+		}
+		for _, e := range body.Assumptions {
+			if e != nil {
+				if err2 := ValidateAssetClassAssumptionRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if body.Paths < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.paths", body.Paths, 1, true))
+		}
+		if body.Paths > 100000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.paths", body.Paths, 100000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioProjectPortfolioPortfolioID != "" {
+			portfolioID = portfolioProjectPortfolioPortfolioID
+		}
+	}
+	v := &portfolio.ProjectPortfolioPayload{
+		End:                 body.End,
+		StartValue:          body.StartValue,
+		MonthlyContribution: body.MonthlyContribution,
+		MonthlyWithdrawal:   body.MonthlyWithdrawal,
+		WithdrawalStart:     body.WithdrawalStart,
+		Inflation:           body.Inflation,
+		Goal:                body.Goal,
+		GoalDate:            body.GoalDate,
+		Paths:               body.Paths,
+		Seed:                body.Seed,
+	}
+	{
+		var zero float64
+		if v.MonthlyContribution == zero {
+			v.MonthlyContribution = 0
+		}
+	}
+	{
+		var zero float64
+		if v.MonthlyWithdrawal == zero {
+			v.MonthlyWithdrawal = 0
+		}
+	}
+	{
+		var zero float64
+		if v.Inflation == zero {
+			v.Inflation = 0.02
+		}
+	}
+	if body.Assumptions != nil {
+		v.Assumptions = make([]*portfolio.AssetClassAssumption, len(body.Assumptions))
+		for i, val := range body.Assumptions {
+			if val == nil {
+				v.Assumptions[i] = nil
+				continue
+			}
+			v.Assumptions[i] = marshalAssetClassAssumptionRequestBodyToPortfolioAssetClassAssumption(val)
+		}
+	}
+	{
+		var zero int
+		if v.Paths == zero {
+			v.Paths = 5000
+		}
+	}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildListCorporateActionsPayload builds the payload for the portfolio
+// listCorporateActions endpoint from CLI flags.
+func BuildListCorporateActionsPayload(portfolioListCorporateActionsPortfolioID string) (*portfolio.ListCorporateActionsPayload, error) {
+	var portfolioID string
+	{
+		if portfolioListCorporateActionsPortfolioID != "" {
+			portfolioID = portfolioListCorporateActionsPortfolioID
+		}
+	}
+	v := &portfolio.ListCorporateActionsPayload{}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildApplyCorporateActionsPayload builds the payload for the portfolio
+// applyCorporateActions endpoint from CLI flags.
+func BuildApplyCorporateActionsPayload(portfolioApplyCorporateActionsBody string, portfolioApplyCorporateActionsPortfolioID string) (*portfolio.ApplyCorporateActionsPayload, error) {
+	var err error
+	var body ApplyCorporateActionsRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioApplyCorporateActionsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"actions\": [\n         {\n            \"basis_fraction\": 0.29253903798555114,\n            \"cash_in_lieu\": false,\n            \"cash_per_share\": 0.44906702497063167,\n            \"date\": \"1998-01-15\",\n            \"new_symbol\": \"Voluptas suscipit.\",\n            \"note\": \"Perspiciatis et et nam et.\",\n            \"price\": 0.42498199096322026,\n            \"ratio\": 0.12358453831705202,\n            \"symbol\": \"Consequatur ipsa.\",\n            \"type\": \"split\"\n         },\n         {\n            \"basis_fraction\": 0.29253903798555114,\n            \"cash_in_lieu\": false,\n            \"cash_per_share\": 0.44906702497063167,\n            \"date\": \"1998-01-15\",\n            \"new_symbol\": \"Voluptas suscipit.\",\n            \"note\": \"Perspiciatis et et nam et.\",\n            \"price\": 0.42498199096322026,\n            \"ratio\": 0.12358453831705202,\n            \"symbol\": \"Consequatur ipsa.\",\n            \"type\": \"split\"\n         },\n         {\n            \"basis_fraction\": 0.29253903798555114,\n            \"cash_in_lieu\": false,\n            \"cash_per_share\": 0.44906702497063167,\n            \"date\": \"1998-01-15\",\n            \"new_symbol\": \"Voluptas suscipit.\",\n            \"note\": \"Perspiciatis et et nam et.\",\n            \"price\": 0.42498199096322026,\n            \"ratio\": 0.12358453831705202,\n            \"symbol\": \"Consequatur ipsa.\",\n            \"type\": \"split\"\n         }\n      ]\n   }'")
+		}
+		if body.Actions == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("actions", "body"))
+		}
+		for _, e := range body.Actions {
+			if e != nil {
+				if err2 := ValidateCorporateActionInputRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioApplyCorporateActionsPortfolioID != "" {
+			portfolioID = portfolioApplyCorporateActionsPortfolioID
+		}
+	}
+	v := &portfolio.ApplyCorporateActionsPayload{}
+	if body.Actions != nil {
+		v.Actions = make([]*portfolio.CorporateActionInput, len(body.Actions))
+		for i, val := range body.Actions {
+			if val == nil {
+				v.Actions[i] = nil
+				continue
+			}
+			v.Actions[i] = marshalCorporateActionInputRequestBodyToPortfolioCorporateActionInput(val)
+		}
+	} else {
+		v.Actions = []*portfolio.CorporateActionInput{}
+	}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildRecordIncomePayload builds the payload for the portfolio recordIncome
+// endpoint from CLI flags.
+func BuildRecordIncomePayload(portfolioRecordIncomeBody string, portfolioRecordIncomePortfolioID string) (*portfolio.RecordIncomePayload, error) {
+	var err error
+	var body RecordIncomeRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioRecordIncomeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"account\": \"Magnam rem vel ducimus.\",\n      \"amount\": 0.9599981700670774,\n      \"ex_date\": \"1987-07-27\",\n      \"note\": \"Quidem sed accusamus sed.\",\n      \"pay_date\": \"2015-03-10\",\n      \"price\": 0.5950016167996456,\n      \"qualified\": true,\n      \"reinvest\": false,\n      \"symbol\": \"Necessitatibus voluptas amet.\",\n      \"type\": \"interest\",\n      \"withholding\": 0.46180537831408885\n   }'")
+		}
+		if !(body.Type == "dividend" || body.Type == "interest") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", body.Type, []any{"dividend", "interest"}))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.pay_date", body.PayDate, goa.FormatDate))
+		if body.ExDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.ex_date", *body.ExDate, goa.FormatDate))
+		}
+		if body.Amount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.amount", body.Amount, 0, true))
+		}
+		if body.Withholding < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.withholding", body.Withholding, 0, true))
+		}
+		if body.Price != nil {
+			if *body.Price < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.price", *body.Price, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioRecordIncomePortfolioID != "" {
+			portfolioID = portfolioRecordIncomePortfolioID
+		}
+	}
+	v := &portfolio.RecordIncomePayload{
+		Type:        body.Type,
+		Symbol:      body.Symbol,
+		Account:     body.Account,
+		PayDate:     body.PayDate,
+		ExDate:      body.ExDate,
+		Amount:      body.Amount,
+		Withholding: body.Withholding,
+		Qualified:   body.Qualified,
+		Reinvest:    body.Reinvest,
+		Price:       body.Price,
+		Note:        body.Note,
+	}
+	{
+		var zero string
+		if v.Type == zero {
+			v.Type = "dividend"
+		}
+	}
+	{
+		var zero string
+		if v.Account == zero {
+			v.Account = ""
+		}
+	}
+	{
+		var zero float64
+		if v.Withholding == zero {
+			v.Withholding = 0
+		}
+	}
+	{
+		var zero bool
+		if v.Qualified == zero {
+			v.Qualified = false
+		}
+	}
+	{
+		var zero bool
+		if v.Reinvest == zero {
+			v.Reinvest = false
+		}
+	}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildGetIncomePayload builds the payload for the portfolio getIncome
+// endpoint from CLI flags.
+func BuildGetIncomePayload(portfolioGetIncomePortfolioID string, portfolioGetIncomePeriod string, portfolioGetIncomeStart string, portfolioGetIncomeEnd string, portfolioGetIncomeInterval string) (*portfolio.GetIncomePayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioGetIncomePortfolioID != "" {
+			portfolioID = portfolioGetIncomePortfolioID
+		}
+	}
+	var period string
+	{
+		if portfolioGetIncomePeriod != "" {
+			period = portfolioGetIncomePeriod
+			if !(period == "1D" || period == "MTD" || period == "QTD" || period == "YTD" || period == "1Y" || period == "inception" || period == "custom") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("period", period, []any{"1D", "MTD", "QTD", "YTD", "1Y", "inception", "custom"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var start *string
+	{
+		if portfolioGetIncomeStart != "" {
+			start = &portfolioGetIncomeStart
+			err = goa.MergeErrors(err, goa.ValidateFormat("start", *start, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var end *string
+	{
+		if portfolioGetIncomeEnd != "" {
+			end = &portfolioGetIncomeEnd
+			err = goa.MergeErrors(err, goa.ValidateFormat("end", *end, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var interval string
+	{
+		if portfolioGetIncomeInterval != "" {
+			interval = portfolioGetIncomeInterval
+			if !(interval == "month" || interval == "quarter" || interval == "year") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("interval", interval, []any{"month", "quarter", "year"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &portfolio.GetIncomePayload{}
+	v.PortfolioID = portfolioID
+	v.Period = period
+	v.Start = start
+	v.End = end
+	v.Interval = interval
+
+	return v, nil
+}
+
+// BuildPriceBondPayload builds the payload for the portfolio priceBond
+// endpoint from CLI flags.
+func BuildPriceBondPayload(portfolioPriceBondBody string) (*portfolio.PriceBondPayload, error) {
+	var err error
+	var body PriceBondRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioPriceBondBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"clean_price\": 0.750202503744584,\n      \"coupon\": 0.7635136607460532,\n      \"coupon_frequency\": 2,\n      \"day_count\": \"ACT/ACT\",\n      \"face_value\": 0.498524149599353,\n      \"maturity\": \"2012-03-06\",\n      \"settlement\": \"2014-05-15\",\n      \"yield\": 0.5245904251735487\n   }'")
+		}
+		if body.Coupon < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.coupon", body.Coupon, 0, true))
+		}
+		if !(body.CouponFrequency == 0 || body.CouponFrequency == 1 || body.CouponFrequency == 2 || body.CouponFrequency == 4 || body.CouponFrequency == 12) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.coupon_frequency", body.CouponFrequency, []any{0, 1, 2, 4, 12}))
+		}
+		if !(body.DayCount == "30/360" || body.DayCount == "ACT/360" || body.DayCount == "ACT/365" || body.DayCount == "ACT/ACT") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.day_count", body.DayCount, []any{"30/360", "ACT/360", "ACT/365", "ACT/ACT"}))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.maturity", body.Maturity, goa.FormatDate))
+		if body.FaceValue < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.face_value", body.FaceValue, 0, true))
+		}
+		if body.Settlement != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.settlement", *body.Settlement, goa.FormatDate))
+		}
+		if body.CleanPrice != nil {
+			if *body.CleanPrice < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.clean_price", *body.CleanPrice, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &portfolio.PriceBondPayload{
+		Coupon:          body.Coupon,
+		CouponFrequency: body.CouponFrequency,
+		DayCount:        body.DayCount,
+		Maturity:        body.Maturity,
+		FaceValue:       body.FaceValue,
+		Settlement:      body.Settlement,
+		CleanPrice:      body.CleanPrice,
+		Yield:           body.Yield,
+	}
+	{
+		var zero float64
+		if v.Coupon == zero {
+			v.Coupon = 0
+		}
+	}
+	{
+		var zero int
+		if v.CouponFrequency == zero {
+			v.CouponFrequency = 2
+		}
+	}
+	{
+		var zero string
+		if v.DayCount == zero {
+			v.DayCount = "30/360"
+		}
+	}
+	{
+		var zero float64
+		if v.FaceValue == zero {
+			v.FaceValue = 100
+		}
+	}
+
+	return v, nil
+}
+
+// BuildListBondPositionsPayload builds the payload for the portfolio
+// listBondPositions endpoint from CLI flags.
+func BuildListBondPositionsPayload(portfolioListBondPositionsPortfolioID string) (*portfolio.ListBondPositionsPayload, error) {
+	var portfolioID string
+	{
+		if portfolioListBondPositionsPortfolioID != "" {
+			portfolioID = portfolioListBondPositionsPortfolioID
+		}
+	}
+	v := &portfolio.ListBondPositionsPayload{}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildGetCashFlowLadderPayload builds the payload for the portfolio
+// getCashFlowLadder endpoint from CLI flags.
+func BuildGetCashFlowLadderPayload(portfolioGetCashFlowLadderPortfolioID string, portfolioGetCashFlowLadderYears string) (*portfolio.GetCashFlowLadderPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioGetCashFlowLadderPortfolioID != "" {
+			portfolioID = portfolioGetCashFlowLadderPortfolioID
+		}
+	}
+	var years int
+	{
+		if portfolioGetCashFlowLadderYears != "" {
+			var v int64
+			v, err = strconv.ParseInt(portfolioGetCashFlowLadderYears, 10, strconv.IntSize)
+			years = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for years, must be INT")
+			}
+			if years < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("years", years, 1, true))
+			}
+			if years > 50 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("years", years, 50, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &portfolio.GetCashFlowLadderPayload{}
+	v.PortfolioID = portfolioID
+	v.Years = years
+
+	return v, nil
+}
+
+// BuildListOptionPositionsPayload builds the payload for the portfolio
+// listOptionPositions endpoint from CLI flags.
+func BuildListOptionPositionsPayload(portfolioListOptionPositionsPortfolioID string, portfolioListOptionPositionsVolatility string) (*portfolio.ListOptionPositionsPayload, error) {
+	var portfolioID string
+	{
+		if portfolioListOptionPositionsPortfolioID != "" {
+			portfolioID = portfolioListOptionPositionsPortfolioID
+		}
+	}
+	var volatility *float64
+	{
+		if portfolioListOptionPositionsVolatility != "" {
+			val, err := strconv.ParseFloat(portfolioListOptionPositionsVolatility, 64)
+			volatility = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for volatility, must be FLOAT64")
+			}
+			if *volatility < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("volatility", *volatility, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &portfolio.ListOptionPositionsPayload{}
+	v.PortfolioID = portfolioID
+	v.Volatility = volatility
+
+	return v, nil
+}
+
+// BuildSettleOptionExpiriesPayload builds the payload for the portfolio
+// settleOptionExpiries endpoint from CLI flags.
+func BuildSettleOptionExpiriesPayload(portfolioSettleOptionExpiriesPortfolioID string) (*portfolio.SettleOptionExpiriesPayload, error) {
+	var portfolioID string
+	{
+		if portfolioSettleOptionExpiriesPortfolioID != "" {
+			portfolioID = portfolioSettleOptionExpiriesPortfolioID
+		}
+	}
+	v := &portfolio.SettleOptionExpiriesPayload{}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildSetMarginAccountPayload builds the payload for the portfolio
+// setMarginAccount endpoint from CLI flags.
+func BuildSetMarginAccountPayload(portfolioSetMarginAccountBody string, portfolioSetMarginAccountPortfolioID string) (*portfolio.SetMarginAccountPayload, error) {
+	var err error
+	var body SetMarginAccountRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioSetMarginAccountBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"account\": \"Nemo inventore.\",\n      \"borrow_rate\": 0.8841584996203483,\n      \"borrow_rates\": {\n         \"Veniam enim.\": 0.9191124863029354,\n         \"Voluptas consequuntur.\": 0.2356494593408973,\n         \"Voluptatem sint impedit voluptas assumenda provident.\": 0.7180698527192441\n      },\n      \"initial_requirement\": 0.8031352291323962,\n      \"loan_rate\": 0.2002814353835115,\n      \"maintenance_requirement\": 0.4790662663388617,\n      \"short_maintenance_requirement\": 0.700998039327302\n   }'")
+		}
+		if body.InitialRequirement < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_requirement", body.InitialRequirement, 0, true))
+		}
+		if body.InitialRequirement > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_requirement", body.InitialRequirement, 1, false))
+		}
+		if body.MaintenanceRequirement < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maintenance_requirement", body.MaintenanceRequirement, 0, true))
+		}
+		if body.MaintenanceRequirement > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maintenance_requirement", body.MaintenanceRequirement, 1, false))
+		}
+		if body.ShortMaintenanceRequirement < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.short_maintenance_requirement", body.ShortMaintenanceRequirement, 0, true))
+		}
+		if body.ShortMaintenanceRequirement > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.short_maintenance_requirement", body.ShortMaintenanceRequirement, 1, false))
+		}
+		if body.LoanRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.loan_rate", body.LoanRate, 0, true))
+		}
+		if body.BorrowRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.borrow_rate", body.BorrowRate, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioSetMarginAccountPortfolioID != "" {
+			portfolioID = portfolioSetMarginAccountPortfolioID
+		}
+	}
+	v := &portfolio.SetMarginAccountPayload{
+		Account:                     body.Account,
+		InitialRequirement:          body.InitialRequirement,
+		MaintenanceRequirement:      body.MaintenanceRequirement,
+		ShortMaintenanceRequirement: body.ShortMaintenanceRequirement,
+		LoanRate:                    body.LoanRate,
+		BorrowRate:                  body.BorrowRate,
+	}
+	{
+		var zero float64
+		if v.InitialRequirement == zero {
+			v.InitialRequirement = 0.5
+		}
+	}
+	{
+		var zero float64
+		if v.MaintenanceRequirement == zero {
+			v.MaintenanceRequirement = 0.25
+		}
+	}
+	{
+		var zero float64
+		if v.ShortMaintenanceRequirement == zero {
+			v.ShortMaintenanceRequirement = 0.3
+		}
+	}
+	{
+		var zero float64
+		if v.LoanRate == zero {
+			v.LoanRate = 0
+		}
+	}
+	{
+		var zero float64
+		if v.BorrowRate == zero {
+			v.BorrowRate = 0
+		}
+	}
+	if body.BorrowRates != nil {
+		v.BorrowRates = make(map[string]float64, len(body.BorrowRates))
+		for key, val := range body.BorrowRates {
+			tk := key
+			tv := val
+			v.BorrowRates[tk] = tv
+		}
+	}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildGetMarginStatusPayload builds the payload for the portfolio
+// getMarginStatus endpoint from CLI flags.
+func BuildGetMarginStatusPayload(portfolioGetMarginStatusPortfolioID string) (*portfolio.GetMarginStatusPayload, error) {
+	var portfolioID string
+	{
+		if portfolioGetMarginStatusPortfolioID != "" {
+			portfolioID = portfolioGetMarginStatusPortfolioID
+		}
+	}
+	v := &portfolio.GetMarginStatusPayload{}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildChargeMarginPayload builds the payload for the portfolio chargeMargin
+// endpoint from CLI flags.
+func BuildChargeMarginPayload(portfolioChargeMarginPortfolioID string) (*portfolio.ChargeMarginPayload, error) {
+	var portfolioID string
+	{
+		if portfolioChargeMarginPortfolioID != "" {
+			portfolioID = portfolioChargeMarginPortfolioID
+		}
+	}
+	v := &portfolio.ChargeMarginPayload{}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildListEventsPayload builds the payload for the portfolio listEvents
+// endpoint from CLI flags.
+func BuildListEventsPayload(portfolioListEventsPortfolioID string, portfolioListEventsSince string, portfolioListEventsType string) (*portfolio.ListEventsPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioListEventsPortfolioID != "" {
+			portfolioID = portfolioListEventsPortfolioID
+		}
+	}
+	var since *string
+	{
+		if portfolioListEventsSince != "" {
+			since = &portfolioListEventsSince
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var type_ *string
+	{
+		if portfolioListEventsType != "" {
+			type_ = &portfolioListEventsType
+		}
+	}
+	v := &portfolio.ListEventsPayload{}
+	v.PortfolioID = portfolioID
+	v.Since = since
+	v.Type = type_
+
+	return v, nil
+}
+
+// BuildPlaceOrderPayload builds the payload for the portfolio placeOrder
+// endpoint from CLI flags.
+func BuildPlaceOrderPayload(portfolioPlaceOrderBody string, portfolioPlaceOrderPortfolioID string) (*portfolio.PlaceOrderPayload, error) {
+	var err error
+	var body PlaceOrderRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioPlaceOrderBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"account\": \"Ut earum magnam.\",\n      \"limit_price\": 0.6049967965404179,\n      \"quantity\": 0.4464521216919483,\n      \"side\": \"buy\",\n      \"stop_price\": 0.20599903092961772,\n      \"symbol\": \"Sed sit.\",\n      \"time_in_force\": \"day\",\n      \"type\": \"stop\"\n   }'")
+		}
+		if !(body.Side == "buy" || body.Side == "sell") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", body.Side, []any{"buy", "sell"}))
+		}
+		if !(body.Type == "market" || body.Type == "limit" || body.Type == "stop" || body.Type == "stop_limit") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", body.Type, []any{"market", "limit", "stop", "stop_limit"}))
+		}
+		if body.Quantity < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.quantity", body.Quantity, 0, true))
+		}
+		if body.LimitPrice != nil {
+			if *body.LimitPrice < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit_price", *body.LimitPrice, 0, true))
+			}
+		}
+		if body.StopPrice != nil {
+			if *body.StopPrice < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.stop_price", *body.StopPrice, 0, true))
+			}
+		}
+		if !(body.TimeInForce == "day" || body.TimeInForce == "gtc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.time_in_force", body.TimeInForce, []any{"day", "gtc"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioPlaceOrderPortfolioID != "" {
+			portfolioID = portfolioPlaceOrderPortfolioID
+		}
+	}
+	v := &portfolio.PlaceOrderPayload{
+		Account:     body.Account,
+		Symbol:      body.Symbol,
+		Side:        body.Side,
+		Type:        body.Type,
+		Quantity:    body.Quantity,
+		LimitPrice:  body.LimitPrice,
+		StopPrice:   body.StopPrice,
+		TimeInForce: body.TimeInForce,
+	}
+	{
+		var zero string
+		if v.Type == zero {
+			v.Type = "market"
+		}
+	}
+	{
+		var zero string
+		if v.TimeInForce == zero {
+			v.TimeInForce = "day"
+		}
+	}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildListOrdersPayload builds the payload for the portfolio listOrders
+// endpoint from CLI flags.
+func BuildListOrdersPayload(portfolioListOrdersPortfolioID string, portfolioListOrdersStatus string) (*portfolio.ListOrdersPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioListOrdersPortfolioID != "" {
+			portfolioID = portfolioListOrdersPortfolioID
+		}
+	}
+	var status *string
+	{
+		if portfolioListOrdersStatus != "" {
+			status = &portfolioListOrdersStatus
+			if !(*status == "open" || *status == "filled" || *status == "cancelled" || *status == "expired" || *status == "rejected") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"open", "filled", "cancelled", "expired", "rejected"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &portfolio.ListOrdersPayload{}
+	v.PortfolioID = portfolioID
+	v.Status = status
+
+	return v, nil
+}
+
+// BuildCancelOrderPayload builds the payload for the portfolio cancelOrder
+// endpoint from CLI flags.
+func BuildCancelOrderPayload(portfolioCancelOrderPortfolioID string, portfolioCancelOrderID string) (*portfolio.CancelOrderPayload, error) {
+	var portfolioID string
+	{
+		if portfolioCancelOrderPortfolioID != "" {
+			portfolioID = portfolioCancelOrderPortfolioID
+		}
+	}
+	var id string
+	{
+		id = portfolioCancelOrderID
+	}
+	v := &portfolio.CancelOrderPayload{}
+	v.PortfolioID = portfolioID
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildPreviewTradesPayload builds the payload for the portfolio previewTrades
+// endpoint from CLI flags.
+func BuildPreviewTradesPayload(portfolioPreviewTradesBody string, portfolioPreviewTradesPortfolioID string) (*portfolio.PreviewTradesPayload, error) {
+	var err error
+	var body PreviewTradesRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioPreviewTradesBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"dimension\": \"region\",\n      \"long_term_tax_rate\": 0.6653044668559718,\n      \"lookback\": 6347616431937563912,\n      \"short_term_tax_rate\": 0.42094713313871246,\n      \"trades\": [\n         {\n            \"account\": \"A et soluta a quas.\",\n            \"fee\": 0.20877598708539158,\n            \"price\": 0.6810067855877286,\n            \"quantity\": 0.6881311917459807,\n            \"side\": \"sell\",\n            \"symbol\": \"Veniam suscipit.\"\n         },\n         {\n            \"account\": \"A et soluta a quas.\",\n            \"fee\": 0.20877598708539158,\n            \"price\": 0.6810067855877286,\n            \"quantity\": 0.6881311917459807,\n            \"side\": \"sell\",\n            \"symbol\": \"Veniam suscipit.\"\n         }\n      ]\n   }'")
+		}
+		if body.Trades == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("trades", "body"))
+		}
+		if len(body.Trades) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.trades", body.Trades, len(body.Trades), 1, true))
+		}
+		for _, e := range body.Trades {
+			if e != nil {
+				if err2 := ValidateTradeInputRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if !(body.Dimension == "asset_class" || body.Dimension == "sector" || body.Dimension == "country" || body.Dimension == "region" || body.Dimension == "currency" || body.Dimension == "account") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.dimension", body.Dimension, []any{"asset_class", "sector", "country", "region", "currency", "account"}))
+		}
+		if body.ShortTermTaxRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.short_term_tax_rate", body.ShortTermTaxRate, 0, true))
+		}
+		if body.ShortTermTaxRate > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.short_term_tax_rate", body.ShortTermTaxRate, 1, false))
+		}
+		if body.LongTermTaxRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.long_term_tax_rate", body.LongTermTaxRate, 0, true))
+		}
+		if body.LongTermTaxRate > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.long_term_tax_rate", body.LongTermTaxRate, 1, false))
+		}
+		if body.Lookback < 2 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.lookback", body.Lookback, 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioPreviewTradesPortfolioID != "" {
+			portfolioID = portfolioPreviewTradesPortfolioID
+		}
+	}
+	v := &portfolio.PreviewTradesPayload{
+		Dimension:        body.Dimension,
+		ShortTermTaxRate: body.ShortTermTaxRate,
+		LongTermTaxRate:  body.LongTermTaxRate,
+		Lookback:         body.Lookback,
+	}
+	if body.Trades != nil {
+		v.Trades = make([]*portfolio.TradeInput, len(body.Trades))
+		for i, val := range body.Trades {
+			if val == nil {
+				v.Trades[i] = nil
+				continue
+			}
+			v.Trades[i] = marshalTradeInputRequestBodyToPortfolioTradeInput(val)
+		}
+	} else {
+		v.Trades = []*portfolio.TradeInput{}
+	}
+	{
+		var zero string
+		if v.Dimension == zero {
+			v.Dimension = "asset_class"
+		}
+	}
+	{
+		var zero float64
+		if v.ShortTermTaxRate == zero {
+			v.ShortTermTaxRate = 0.24
+		}
+	}
+	{
+		var zero float64
+		if v.LongTermTaxRate == zero {
+			v.LongTermTaxRate = 0.15
+		}
+	}
+	{
+		var zero int
+		if v.Lookback == zero {
+			v.Lookback = 252
+		}
+	}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildRunBacktestPayload builds the payload for the portfolio runBacktest
+// endpoint from CLI flags.
+func BuildRunBacktestPayload(portfolioRunBacktestBody string) (*portfolio.RunBacktestPayload, error) {
+	var err error
+	var body RunBacktestRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioRunBacktestBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"contribution\": 0.5695338434763579,\n      \"currency\": \"Deserunt qui ex officia ipsam aut molestias.\",\n      \"end\": \"1998-09-19\",\n      \"fee_rate\": 0.7988106715761776,\n      \"frequency\": \"annual\",\n      \"initial_cash\": 0.723926333761187,\n      \"name\": \"Laboriosam aperiam iusto.\",\n      \"start\": \"2011-10-09\",\n      \"strategy\": \"threshold_rebalance\",\n      \"targets\": [\n         {\n            \"symbol\": \"Sed non.\",\n            \"weight\": 0.9138776297323821\n         }\n      ],\n      \"threshold\": 0.03891810755402372\n   }'")
+		}
+		if body.Targets == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("targets", "body"))
+		}
+		if !(body.Strategy == "buy_and_hold" || body.Strategy == "periodic_rebalance" || body.Strategy == "threshold_rebalance" || body.Strategy == "dca") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.strategy", body.Strategy, []any{"buy_and_hold", "periodic_rebalance", "threshold_rebalance", "dca"}))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start", body.Start, goa.FormatDate))
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", body.End, goa.FormatDate))
+		if body.InitialCash < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_cash", body.InitialCash, 0, true))
+		}
+		if len(body.Targets) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.targets", body.Targets, len(body.Targets), 1, true))
+		}
+		for _, e := range body.Targets {
+			if e != nil {
+				if err2 := ValidateBacktestTargetRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if !(body.Frequency == "monthly" || body.Frequency == "quarterly" || body.Frequency == "annual") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.frequency", body.Frequency, []any{"monthly", "quarterly", "annual"}))
+		}
+		if body.Threshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", body.Threshold, 0, true))
+		}
+		if body.Contribution < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.contribution", body.Contribution, 0, true))
+		}
+		if body.FeeRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.fee_rate", body.FeeRate, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &portfolio.RunBacktestPayload{
+		Name:         body.Name,
+		Strategy:     body.Strategy,
+		Start:        body.Start,
+		End:          body.End,
+		Currency:     body.Currency,
+		InitialCash:  body.InitialCash,
+		Frequency:    body.Frequency,
+		Threshold:    body.Threshold,
+		Contribution: body.Contribution,
+		FeeRate:      body.FeeRate,
+	}
+	{
+		var zero string
+		if v.Name == zero {
+			v.Name = ""
+		}
+	}
+	{
+		var zero string
+		if v.Currency == zero {
+			v.Currency = "USD"
+		}
+	}
+	{
+		var zero float64
+		if v.InitialCash == zero {
+			v.InitialCash = 0
+		}
+	}
+	if body.Targets != nil {
+		v.Targets = make([]*portfolio.BacktestTarget, len(body.Targets))
+		for i, val := range body.Targets {
+			if val == nil {
+				v.Targets[i] = nil
+				continue
+			}
+			v.Targets[i] = marshalBacktestTargetRequestBodyToPortfolioBacktestTarget(val)
+		}
+	} else {
+		v.Targets = []*portfolio.BacktestTarget{}
+	}
+	{
+		var zero string
+		if v.Frequency == zero {
+			v.Frequency = "monthly"
+		}
+	}
+	{
+		var zero float64
+		if v.Threshold == zero {
+			v.Threshold = 0
+		}
+	}
+	{
+		var zero float64
+		if v.Contribution == zero {
+			v.Contribution = 0
+		}
+	}
+	{
+		var zero float64
+		if v.FeeRate == zero {
+			v.FeeRate = 0
+		}
+	}
+
+	return v, nil
+}
+
+// BuildImportCsvPayload builds the payload for the portfolio importCsv
+// endpoint from CLI flags.
+func BuildImportCsvPayload(portfolioImportCsvBody string, portfolioImportCsvPortfolioID string, portfolioImportCsvProfile string, portfolioImportCsvAccount string, portfolioImportCsvDryRun string) (*portfolio.ImportCsvPayload, error) {
+	var err error
+	var body ImportCsvRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioImportCsvBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"content\": \"85k\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", body.Content, utf8.RuneCountInString(body.Content), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioImportCsvPortfolioID != "" {
+			portfolioID = portfolioImportCsvPortfolioID
+		}
+	}
+	var profile string
+	{
+		if portfolioImportCsvProfile != "" {
+			profile = portfolioImportCsvProfile
+		}
+	}
+	var account string
+	{
+		if portfolioImportCsvAccount != "" {
+			account = portfolioImportCsvAccount
+		}
+	}
+	var dryRun bool
+	{
+		if portfolioImportCsvDryRun != "" {
+			dryRun, err = strconv.ParseBool(portfolioImportCsvDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dryRun, must be BOOL")
+			}
+		}
+	}
+	v := &portfolio.ImportCsvPayload{
+		Content: body.Content,
+	}
+	v.PortfolioID = portfolioID
+	v.Profile = profile
+	v.Account = account
+	v.DryRun = dryRun
+
+	return v, nil
+}
+
+// BuildImportOfxPayload builds the payload for the portfolio importOfx
+// endpoint from CLI flags.
+func BuildImportOfxPayload(portfolioImportOfxBody string, portfolioImportOfxPortfolioID string, portfolioImportOfxAccount string, portfolioImportOfxDryRun string) (*portfolio.ImportOfxPayload, error) {
+	var err error
+	var body ImportOfxRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioImportOfxBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"content\": \"yxr\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", body.Content, utf8.RuneCountInString(body.Content), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioImportOfxPortfolioID != "" {
+			portfolioID = portfolioImportOfxPortfolioID
+		}
+	}
+	var account string
+	{
+		if portfolioImportOfxAccount != "" {
+			account = portfolioImportOfxAccount
+		}
+	}
+	var dryRun bool
+	{
+		if portfolioImportOfxDryRun != "" {
+			dryRun, err = strconv.ParseBool(portfolioImportOfxDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dryRun, must be BOOL")
+			}
+		}
+	}
+	v := &portfolio.ImportOfxPayload{
+		Content: body.Content,
+	}
+	v.PortfolioID = portfolioID
+	v.Account = account
+	v.DryRun = dryRun
+
+	return v, nil
+}
+
+// BuildExportJournalPayload builds the payload for the portfolio exportJournal
+// endpoint from CLI flags.
+func BuildExportJournalPayload(portfolioExportJournalPortfolioID string, portfolioExportJournalFormat string) (*portfolio.ExportJournalPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioExportJournalPortfolioID != "" {
+			portfolioID = portfolioExportJournalPortfolioID
+		}
+	}
+	var format string
+	{
+		if portfolioExportJournalFormat != "" {
+			format = portfolioExportJournalFormat
+			if !(format == "beancount" || format == "hledger") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"beancount", "hledger"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &portfolio.ExportJournalPayload{}
+	v.PortfolioID = portfolioID
+	v.Format = format
+
+	return v, nil
+}
+
+// BuildImportJournalPayload builds the payload for the portfolio importJournal
+// endpoint from CLI flags.
+func BuildImportJournalPayload(portfolioImportJournalBody string, portfolioImportJournalPortfolioID string, portfolioImportJournalAccount string, portfolioImportJournalRoot string, portfolioImportJournalDryRun string) (*portfolio.ImportJournalPayload, error) {
+	var err error
+	var body ImportJournalRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioImportJournalBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"content\": \"yk\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", body.Content, utf8.RuneCountInString(body.Content), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioImportJournalPortfolioID != "" {
+			portfolioID = portfolioImportJournalPortfolioID
+		}
+	}
+	var account string
+	{
+		if portfolioImportJournalAccount != "" {
+			account = portfolioImportJournalAccount
+		}
+	}
+	var root string
+	{
+		if portfolioImportJournalRoot != "" {
+			root = portfolioImportJournalRoot
+		}
+	}
+	var dryRun bool
+	{
+		if portfolioImportJournalDryRun != "" {
+			dryRun, err = strconv.ParseBool(portfolioImportJournalDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dryRun, must be BOOL")
+			}
+		}
+	}
+	v := &portfolio.ImportJournalPayload{
+		Content: body.Content,
+	}
+	v.PortfolioID = portfolioID
+	v.Account = account
+	v.Root = root
+	v.DryRun = dryRun
+
+	return v, nil
+}
+
+// BuildExportArchivePayload builds the payload for the portfolio exportArchive
+// endpoint from CLI flags.
+func BuildExportArchivePayload(portfolioExportArchivePortfolioID string) (*portfolio.ExportArchivePayload, error) {
+	var portfolioID string
+	{
+		if portfolioExportArchivePortfolioID != "" {
+			portfolioID = portfolioExportArchivePortfolioID
+		}
+	}
+	v := &portfolio.ExportArchivePayload{}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildImportArchivePayload builds the payload for the portfolio importArchive
+// endpoint from CLI flags.
+func BuildImportArchivePayload(portfolioImportArchiveBody string, portfolioImportArchivePortfolioID string, portfolioImportArchiveReplace string) (*portfolio.ImportArchivePayload, error) {
+	var err error
+	var body ImportArchiveRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioImportArchiveBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"content\": \"8\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", body.Content, utf8.RuneCountInString(body.Content), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID *string
+	{
+		if portfolioImportArchivePortfolioID != "" {
+			portfolioID = &portfolioImportArchivePortfolioID
+		}
+	}
+	var replace bool
+	{
+		if portfolioImportArchiveReplace != "" {
+			replace, err = strconv.ParseBool(portfolioImportArchiveReplace)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for replace, must be BOOL")
+			}
+		}
+	}
+	v := &portfolio.ImportArchivePayload{
+		Content: body.Content,
+	}
+	v.PortfolioID = portfolioID
+	v.Replace = replace
+
+	return v, nil
+}
+
+// BuildReconcilePositionsPayload builds the payload for the portfolio
+// reconcilePositions endpoint from CLI flags.
+func BuildReconcilePositionsPayload(portfolioReconcilePositionsBody string, portfolioReconcilePositionsPortfolioID string, portfolioReconcilePositionsFormat string, portfolioReconcilePositionsProfile string, portfolioReconcilePositionsAccount string, portfolioReconcilePositionsAsOf string) (*portfolio.ReconcilePositionsPayload, error) {
+	var err error
+	var body ReconcilePositionsRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioReconcilePositionsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"content\": \"xis\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", body.Content, utf8.RuneCountInString(body.Content), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioReconcilePositionsPortfolioID != "" {
+			portfolioID = portfolioReconcilePositionsPortfolioID
+		}
+	}
+	var format string
+	{
+		if portfolioReconcilePositionsFormat != "" {
+			format = portfolioReconcilePositionsFormat
+			if !(format == "csv" || format == "ofx") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "ofx"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var profile string
+	{
+		if portfolioReconcilePositionsProfile != "" {
+			profile = portfolioReconcilePositionsProfile
+		}
+	}
+	var account string
+	{
+		if portfolioReconcilePositionsAccount != "" {
+			account = portfolioReconcilePositionsAccount
+		}
+	}
+	var asOf *string
+	{
+		if portfolioReconcilePositionsAsOf != "" {
+			asOf = &portfolioReconcilePositionsAsOf
+			err = goa.MergeErrors(err, goa.ValidateFormat("as_of", *asOf, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &portfolio.ReconcilePositionsPayload{
+		Content: body.Content,
+	}
+	v.PortfolioID = portfolioID
+	v.Format = format
+	v.Profile = profile
+	v.Account = account
+	v.AsOf = asOf
+
+	return v, nil
+}
+
+// BuildListReconciliationBreaksPayload builds the payload for the portfolio
+// listReconciliationBreaks endpoint from CLI flags.
+func BuildListReconciliationBreaksPayload(portfolioListReconciliationBreaksPortfolioID string, portfolioListReconciliationBreaksStatus string, portfolioListReconciliationBreaksAccount string) (*portfolio.ListReconciliationBreaksPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioListReconciliationBreaksPortfolioID != "" {
+			portfolioID = portfolioListReconciliationBreaksPortfolioID
+		}
+	}
+	var status *string
+	{
+		if portfolioListReconciliationBreaksStatus != "" {
+			status = &portfolioListReconciliationBreaksStatus
+			if !(*status == "open" || *status == "resolved" || *status == "ignored") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"open", "resolved", "ignored"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var account *string
+	{
+		if portfolioListReconciliationBreaksAccount != "" {
+			account = &portfolioListReconciliationBreaksAccount
+		}
+	}
+	v := &portfolio.ListReconciliationBreaksPayload{}
+	v.PortfolioID = portfolioID
+	v.Status = status
+	v.Account = account
+
+	return v, nil
+}
+
+// BuildUpdateReconciliationBreakPayload builds the payload for the portfolio
+// updateReconciliationBreak endpoint from CLI flags.
+func BuildUpdateReconciliationBreakPayload(portfolioUpdateReconciliationBreakPortfolioID string, portfolioUpdateReconciliationBreakID string, portfolioUpdateReconciliationBreakStatus string, portfolioUpdateReconciliationBreakNote string, portfolioUpdateReconciliationBreakApply string) (*portfolio.UpdateReconciliationBreakPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioUpdateReconciliationBreakPortfolioID != "" {
+			portfolioID = portfolioUpdateReconciliationBreakPortfolioID
+		}
+	}
+	var id string
+	{
+		id = portfolioUpdateReconciliationBreakID
+	}
+	var status string
+	{
+		status = portfolioUpdateReconciliationBreakStatus
+		if !(status == "open" || status == "resolved" || status == "ignored") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", status, []any{"open", "resolved", "ignored"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var note *string
+	{
+		if portfolioUpdateReconciliationBreakNote != "" {
+			note = &portfolioUpdateReconciliationBreakNote
+		}
+	}
+	var apply bool
+	{
+		if portfolioUpdateReconciliationBreakApply != "" {
+			apply, err = strconv.ParseBool(portfolioUpdateReconciliationBreakApply)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for apply, must be BOOL")
+			}
+		}
+	}
+	v := &portfolio.UpdateReconciliationBreakPayload{}
+	v.PortfolioID = portfolioID
+	v.ID = id
+	v.Status = status
+	v.Note = note
+	v.Apply = apply
+
+	return v, nil
+}
+
+// BuildGetTaxReportPayload builds the payload for the portfolio getTaxReport
+// endpoint from CLI flags.
+func BuildGetTaxReportPayload(portfolioGetTaxReportPortfolioID string, portfolioGetTaxReportYear string) (*portfolio.GetTaxReportPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioGetTaxReportPortfolioID != "" {
+			portfolioID = portfolioGetTaxReportPortfolioID
+		}
+	}
+	var year int
+	{
+		var v int64
+		v, err = strconv.ParseInt(portfolioGetTaxReportYear, 10, strconv.IntSize)
+		year = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for year, must be INT")
+		}
+		if year < 1900 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("year", year, 1900, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &portfolio.GetTaxReportPayload{}
+	v.PortfolioID = portfolioID
+	v.Year = year
+
+	return v, nil
+}
+
+// BuildExportTaxReportPayload builds the payload for the portfolio
+// exportTaxReport endpoint from CLI flags.
+func BuildExportTaxReportPayload(portfolioExportTaxReportPortfolioID string, portfolioExportTaxReportYear string, portfolioExportTaxReportFormat string) (*portfolio.ExportTaxReportPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioExportTaxReportPortfolioID != "" {
+			portfolioID = portfolioExportTaxReportPortfolioID
+		}
+	}
+	var year int
+	{
+		var v int64
+		v, err = strconv.ParseInt(portfolioExportTaxReportYear, 10, strconv.IntSize)
+		year = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for year, must be INT")
+		}
+		if year < 1900 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("year", year, 1900, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var format string
+	{
+		if portfolioExportTaxReportFormat != "" {
+			format = portfolioExportTaxReportFormat
+			if !(format == "csv" || format == "json") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "json"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &portfolio.ExportTaxReportPayload{}
+	v.PortfolioID = portfolioID
+	v.Year = year
+	v.Format = format
+
+	return v, nil
+}
+
+// BuildListLotsPayload builds the payload for the portfolio listLots endpoint
+// from CLI flags.
+func BuildListLotsPayload(portfolioListLotsPortfolioID string, portfolioListLotsAccount string, portfolioListLotsSymbol string) (*portfolio.ListLotsPayload, error) {
+	var portfolioID string
+	{
+		if portfolioListLotsPortfolioID != "" {
+			portfolioID = portfolioListLotsPortfolioID
+		}
+	}
+	var account *string
+	{
+		if portfolioListLotsAccount != "" {
+			account = &portfolioListLotsAccount
+		}
+	}
+	var symbol *string
+	{
+		if portfolioListLotsSymbol != "" {
+			symbol = &portfolioListLotsSymbol
+		}
+	}
+	v := &portfolio.ListLotsPayload{}
+	v.PortfolioID = portfolioID
+	v.Account = account
+	v.Symbol = symbol
+
+	return v, nil
+}
+
+// BuildFindTaxLossHarvestsPayload builds the payload for the portfolio
+// findTaxLossHarvests endpoint from CLI flags.
+func BuildFindTaxLossHarvestsPayload(portfolioFindTaxLossHarvestsPortfolioID string, portfolioFindTaxLossHarvestsAccount string, portfolioFindTaxLossHarvestsMinLoss string, portfolioFindTaxLossHarvestsShortTermRate string, portfolioFindTaxLossHarvestsLongTermRate string) (*portfolio.FindTaxLossHarvestsPayload, error) {
+	var err error
+	var portfolioID string
+	{
+		if portfolioFindTaxLossHarvestsPortfolioID != "" {
+			portfolioID = portfolioFindTaxLossHarvestsPortfolioID
+		}
+	}
+	var account *string
+	{
+		if portfolioFindTaxLossHarvestsAccount != "" {
+			account = &portfolioFindTaxLossHarvestsAccount
+		}
+	}
+	var minLoss float64
+	{
+		if portfolioFindTaxLossHarvestsMinLoss != "" {
+			minLoss, err = strconv.ParseFloat(portfolioFindTaxLossHarvestsMinLoss, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for minLoss, must be FLOAT64")
+			}
+			if minLoss < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("min_loss", minLoss, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var shortTermRate float64
+	{
+		shortTermRate, err = strconv.ParseFloat(portfolioFindTaxLossHarvestsShortTermRate, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for shortTermRate, must be FLOAT64")
+		}
+		if shortTermRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("short_term_rate", shortTermRate, 0, true))
+		}
+		if shortTermRate > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("short_term_rate", shortTermRate, 1, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var longTermRate float64
+	{
+		longTermRate, err = strconv.ParseFloat(portfolioFindTaxLossHarvestsLongTermRate, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for longTermRate, must be FLOAT64")
+		}
+		if longTermRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("long_term_rate", longTermRate, 0, true))
+		}
+		if longTermRate > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("long_term_rate", longTermRate, 1, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &portfolio.FindTaxLossHarvestsPayload{}
+	v.PortfolioID = portfolioID
+	v.Account = account
+	v.MinLoss = minLoss
+	v.ShortTermRate = shortTermRate
+	v.LongTermRate = longTermRate
+
+	return v, nil
+}
+
+// BuildListAccountsPayload builds the payload for the portfolio listAccounts
+// endpoint from CLI flags.
+func BuildListAccountsPayload(portfolioListAccountsPortfolioID string) (*portfolio.ListAccountsPayload, error) {
+	var portfolioID string
+	{
+		if portfolioListAccountsPortfolioID != "" {
+			portfolioID = portfolioListAccountsPortfolioID
+		}
+	}
+	v := &portfolio.ListAccountsPayload{}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildSetAccountPayload builds the payload for the portfolio setAccount
+// endpoint from CLI flags.
+func BuildSetAccountPayload(portfolioSetAccountBody string, portfolioSetAccountPortfolioID string) (*portfolio.SetAccountPayload, error) {
+	var err error
+	var body SetAccountRequestBody
+	{
+		err = json.Unmarshal([]byte(portfolioSetAccountBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"currency\": \"Ab sit.\",\n      \"custodian\": \"Quia laudantium sunt asperiores.\",\n      \"id\": \"Qui praesentium sit.\",\n      \"name\": \"Ea sunt error dolor iste qui voluptatem.\",\n      \"tax_treatment\": \"taxable\",\n      \"type\": \"other\"\n   }'")
+		}
+		if !(body.Type == "taxable" || body.Type == "ira" || body.Type == "roth_ira" || body.Type == "401k" || body.Type == "roth_401k" || body.Type == "hsa" || body.Type == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", body.Type, []any{"taxable", "ira", "roth_ira", "401k", "roth_401k", "hsa", "other"}))
+		}
+		if body.TaxTreatment != nil {
+			if !(*body.TaxTreatment == "taxable" || *body.TaxTreatment == "tax_deferred" || *body.TaxTreatment == "tax_exempt") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tax_treatment", *body.TaxTreatment, []any{"taxable", "tax_deferred", "tax_exempt"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var portfolioID string
+	{
+		if portfolioSetAccountPortfolioID != "" {
+			portfolioID = portfolioSetAccountPortfolioID
+		}
+	}
+	v := &portfolio.SetAccountPayload{
+		ID:           body.ID,
+		Name:         body.Name,
+		Type:         body.Type,
+		TaxTreatment: body.TaxTreatment,
+		Custodian:    body.Custodian,
+		Currency:     body.Currency,
+	}
+	{
+		var zero string
+		if v.Type == zero {
+			v.Type = "taxable"
+		}
+	}
+	v.PortfolioID = portfolioID
+
+	return v, nil
+}
+
+// BuildRemoveAccountPayload builds the payload for the portfolio removeAccount
+// endpoint from CLI flags.
+func BuildRemoveAccountPayload(portfolioRemoveAccountPortfolioID string, portfolioRemoveAccountID string) (*portfolio.RemoveAccountPayload, error) {
+	var portfolioID string
+	{
+		if portfolioRemoveAccountPortfolioID != "" {
+			portfolioID = portfolioRemoveAccountPortfolioID
+		}
+	}
+	var id string
+	{
+		id = portfolioRemoveAccountID
+	}
+	v := &portfolio.RemoveAccountPayload{}
+	v.PortfolioID = portfolioID
+	v.ID = id
+
+	return v, nil
+}
@@ -22,6 +22,190 @@ type Client struct {
 	// getPortfolioSummary endpoint.
 	GetPortfolioSummaryDoer goahttp.Doer
 
+	// GetReturns Doer is the HTTP client used to make requests to the getReturns
+	// endpoint.
+	GetReturnsDoer goahttp.Doer
+
+	// GetAllocation Doer is the HTTP client used to make requests to the
+	// getAllocation endpoint.
+	GetAllocationDoer goahttp.Doer
+
+	// GetTargetAllocation Doer is the HTTP client used to make requests to the
+	// getTargetAllocation endpoint.
+	GetTargetAllocationDoer goahttp.Doer
+
+	// SetTargetAllocation Doer is the HTTP client used to make requests to the
+	// setTargetAllocation endpoint.
+	SetTargetAllocationDoer goahttp.Doer
+
+	// ProposeRebalance Doer is the HTTP client used to make requests to the
+	// proposeRebalance endpoint.
+	ProposeRebalanceDoer goahttp.Doer
+
+	// SetBenchmark Doer is the HTTP client used to make requests to the
+	// setBenchmark endpoint.
+	SetBenchmarkDoer goahttp.Doer
+
+	// GetBenchmarkComparison Doer is the HTTP client used to make requests to the
+	// getBenchmarkComparison endpoint.
+	GetBenchmarkComparisonDoer goahttp.Doer
+
+	// GetRiskMetrics Doer is the HTTP client used to make requests to the
+	// getRiskMetrics endpoint.
+	GetRiskMetricsDoer goahttp.Doer
+
+	// GetValueAtRisk Doer is the HTTP client used to make requests to the
+	// getValueAtRisk endpoint.
+	GetValueAtRiskDoer goahttp.Doer
+
+	// ListStressScenarios Doer is the HTTP client used to make requests to the
+	// listStressScenarios endpoint.
+	ListStressScenariosDoer goahttp.Doer
+
+	// RunStressTest Doer is the HTTP client used to make requests to the
+	// runStressTest endpoint.
+	RunStressTestDoer goahttp.Doer
+
+	// ProjectPortfolio Doer is the HTTP client used to make requests to the
+	// projectPortfolio endpoint.
+	ProjectPortfolioDoer goahttp.Doer
+
+	// ListCorporateActions Doer is the HTTP client used to make requests to the
+	// listCorporateActions endpoint.
+	ListCorporateActionsDoer goahttp.Doer
+
+	// ApplyCorporateActions Doer is the HTTP client used to make requests to the
+	// applyCorporateActions endpoint.
+	ApplyCorporateActionsDoer goahttp.Doer
+
+	// RecordIncome Doer is the HTTP client used to make requests to the
+	// recordIncome endpoint.
+	RecordIncomeDoer goahttp.Doer
+
+	// GetIncome Doer is the HTTP client used to make requests to the getIncome
+	// endpoint.
+	GetIncomeDoer goahttp.Doer
+
+	// PriceBond Doer is the HTTP client used to make requests to the priceBond
+	// endpoint.
+	PriceBondDoer goahttp.Doer
+
+	// ListBondPositions Doer is the HTTP client used to make requests to the
+	// listBondPositions endpoint.
+	ListBondPositionsDoer goahttp.Doer
+
+	// GetCashFlowLadder Doer is the HTTP client used to make requests to the
+	// getCashFlowLadder endpoint.
+	GetCashFlowLadderDoer goahttp.Doer
+
+	// ListOptionPositions Doer is the HTTP client used to make requests to the
+	// listOptionPositions endpoint.
+	ListOptionPositionsDoer goahttp.Doer
+
+	// SettleOptionExpiries Doer is the HTTP client used to make requests to the
+	// settleOptionExpiries endpoint.
+	SettleOptionExpiriesDoer goahttp.Doer
+
+	// SetMarginAccount Doer is the HTTP client used to make requests to the
+	// setMarginAccount endpoint.
+	SetMarginAccountDoer goahttp.Doer
+
+	// GetMarginStatus Doer is the HTTP client used to make requests to the
+	// getMarginStatus endpoint.
+	GetMarginStatusDoer goahttp.Doer
+
+	// ChargeMargin Doer is the HTTP client used to make requests to the
+	// chargeMargin endpoint.
+	ChargeMarginDoer goahttp.Doer
+
+	// ListEvents Doer is the HTTP client used to make requests to the listEvents
+	// endpoint.
+	ListEventsDoer goahttp.Doer
+
+	// PlaceOrder Doer is the HTTP client used to make requests to the placeOrder
+	// endpoint.
+	PlaceOrderDoer goahttp.Doer
+
+	// ListOrders Doer is the HTTP client used to make requests to the listOrders
+	// endpoint.
+	ListOrdersDoer goahttp.Doer
+
+	// CancelOrder Doer is the HTTP client used to make requests to the cancelOrder
+	// endpoint.
+	CancelOrderDoer goahttp.Doer
+
+	// PreviewTrades Doer is the HTTP client used to make requests to the
+	// previewTrades endpoint.
+	PreviewTradesDoer goahttp.Doer
+
+	// RunBacktest Doer is the HTTP client used to make requests to the runBacktest
+	// endpoint.
+	RunBacktestDoer goahttp.Doer
+
+	// ImportCsv Doer is the HTTP client used to make requests to the importCsv
+	// endpoint.
+	ImportCsvDoer goahttp.Doer
+
+	// ImportOfx Doer is the HTTP client used to make requests to the importOfx
+	// endpoint.
+	ImportOfxDoer goahttp.Doer
+
+	// ExportJournal Doer is the HTTP client used to make requests to the
+	// exportJournal endpoint.
+	ExportJournalDoer goahttp.Doer
+
+	// ImportJournal Doer is the HTTP client used to make requests to the
+	// importJournal endpoint.
+	ImportJournalDoer goahttp.Doer
+
+	// ExportArchive Doer is the HTTP client used to make requests to the
+	// exportArchive endpoint.
+	ExportArchiveDoer goahttp.Doer
+
+	// ImportArchive Doer is the HTTP client used to make requests to the
+	// importArchive endpoint.
+	ImportArchiveDoer goahttp.Doer
+
+	// ReconcilePositions Doer is the HTTP client used to make requests to the
+	// reconcilePositions endpoint.
+	ReconcilePositionsDoer goahttp.Doer
+
+	// ListReconciliationBreaks Doer is the HTTP client used to make requests to
+	// the listReconciliationBreaks endpoint.
+	ListReconciliationBreaksDoer goahttp.Doer
+
+	// UpdateReconciliationBreak Doer is the HTTP client used to make requests to
+	// the updateReconciliationBreak endpoint.
+	UpdateReconciliationBreakDoer goahttp.Doer
+
+	// GetTaxReport Doer is the HTTP client used to make requests to the
+	// getTaxReport endpoint.
+	GetTaxReportDoer goahttp.Doer
+
+	// ExportTaxReport Doer is the HTTP client used to make requests to the
+	// exportTaxReport endpoint.
+	ExportTaxReportDoer goahttp.Doer
+
+	// ListLots Doer is the HTTP client used to make requests to the listLots
+	// endpoint.
+	ListLotsDoer goahttp.Doer
+
+	// FindTaxLossHarvests Doer is the HTTP client used to make requests to the
+	// findTaxLossHarvests endpoint.
+	FindTaxLossHarvestsDoer goahttp.Doer
+
+	// ListAccounts Doer is the HTTP client used to make requests to the
+	// listAccounts endpoint.
+	ListAccountsDoer goahttp.Doer
+
+	// SetAccount Doer is the HTTP client used to make requests to the setAccount
+	// endpoint.
+	SetAccountDoer goahttp.Doer
+
+	// RemoveAccount Doer is the HTTP client used to make requests to the
+	// removeAccount endpoint.
+	RemoveAccountDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -42,12 +226,58 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		GetPortfolioSummaryDoer: doer,
-		RestoreResponseBody:     restoreBody,
-		scheme:                  scheme,
-		host:                    host,
-		decoder:                 dec,
-		encoder:                 enc,
+		GetPortfolioSummaryDoer:       doer,
+		GetReturnsDoer:                doer,
+		GetAllocationDoer:             doer,
+		GetTargetAllocationDoer:       doer,
+		SetTargetAllocationDoer:       doer,
+		ProposeRebalanceDoer:          doer,
+		SetBenchmarkDoer:              doer,
+		GetBenchmarkComparisonDoer:    doer,
+		GetRiskMetricsDoer:            doer,
+		GetValueAtRiskDoer:            doer,
+		ListStressScenariosDoer:       doer,
+		RunStressTestDoer:             doer,
+		ProjectPortfolioDoer:          doer,
+		ListCorporateActionsDoer:      doer,
+		ApplyCorporateActionsDoer:     doer,
+		RecordIncomeDoer:              doer,
+		GetIncomeDoer:                 doer,
+		PriceBondDoer:                 doer,
+		ListBondPositionsDoer:         doer,
+		GetCashFlowLadderDoer:         doer,
+		ListOptionPositionsDoer:       doer,
+		SettleOptionExpiriesDoer:      doer,
+		SetMarginAccountDoer:          doer,
+		GetMarginStatusDoer:           doer,
+		ChargeMarginDoer:              doer,
+		ListEventsDoer:                doer,
+		PlaceOrderDoer:                doer,
+		ListOrdersDoer:                doer,
+		CancelOrderDoer:               doer,
+		PreviewTradesDoer:             doer,
+		RunBacktestDoer:               doer,
+		ImportCsvDoer:                 doer,
+		ImportOfxDoer:                 doer,
+		ExportJournalDoer:             doer,
+		ImportJournalDoer:             doer,
+		ExportArchiveDoer:             doer,
+		ImportArchiveDoer:             doer,
+		ReconcilePositionsDoer:        doer,
+		ListReconciliationBreaksDoer:  doer,
+		UpdateReconciliationBreakDoer: doer,
+		GetTaxReportDoer:              doer,
+		ExportTaxReportDoer:           doer,
+		ListLotsDoer:                  doer,
+		FindTaxLossHarvestsDoer:       doer,
+		ListAccountsDoer:              doer,
+		SetAccountDoer:                doer,
+		RemoveAccountDoer:             doer,
+		RestoreResponseBody:           restoreBody,
+		scheme:                        scheme,
+		host:                          host,
+		decoder:                       dec,
+		encoder:                       enc,
 	}
 }
 
@@ -55,6 +285,7 @@ func NewClient(
 // portfolio service getPortfolioSummary server.
 func (c *Client) GetPortfolioSummary() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeGetPortfolioSummaryRequest(c.encoder)
 		decodeResponse = DecodeGetPortfolioSummaryResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -62,6 +293,10 @@ func (c *Client) GetPortfolioSummary() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.GetPortfolioSummaryDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("portfolio", "getPortfolioSummary", err)
@@ -69,3 +304,1102 @@ func (c *Client) GetPortfolioSummary() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// GetReturns returns an endpoint that makes HTTP requests to the portfolio
+// service getReturns server.
+func (c *Client) GetReturns() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetReturnsRequest(c.encoder)
+		decodeResponse = DecodeGetReturnsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetReturnsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetReturnsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "getReturns", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetAllocation returns an endpoint that makes HTTP requests to the portfolio
+// service getAllocation server.
+func (c *Client) GetAllocation() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetAllocationRequest(c.encoder)
+		decodeResponse = DecodeGetAllocationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetAllocationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAllocationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "getAllocation", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetTargetAllocation returns an endpoint that makes HTTP requests to the
+// portfolio service getTargetAllocation server.
+func (c *Client) GetTargetAllocation() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetTargetAllocationRequest(c.encoder)
+		decodeResponse = DecodeGetTargetAllocationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetTargetAllocationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetTargetAllocationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "getTargetAllocation", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetTargetAllocation returns an endpoint that makes HTTP requests to the
+// portfolio service setTargetAllocation server.
+func (c *Client) SetTargetAllocation() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetTargetAllocationRequest(c.encoder)
+		decodeResponse = DecodeSetTargetAllocationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetTargetAllocationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetTargetAllocationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "setTargetAllocation", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ProposeRebalance returns an endpoint that makes HTTP requests to the
+// portfolio service proposeRebalance server.
+func (c *Client) ProposeRebalance() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeProposeRebalanceRequest(c.encoder)
+		decodeResponse = DecodeProposeRebalanceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildProposeRebalanceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ProposeRebalanceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "proposeRebalance", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetBenchmark returns an endpoint that makes HTTP requests to the portfolio
+// service setBenchmark server.
+func (c *Client) SetBenchmark() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetBenchmarkRequest(c.encoder)
+		decodeResponse = DecodeSetBenchmarkResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetBenchmarkRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetBenchmarkDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "setBenchmark", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetBenchmarkComparison returns an endpoint that makes HTTP requests to the
+// portfolio service getBenchmarkComparison server.
+func (c *Client) GetBenchmarkComparison() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetBenchmarkComparisonRequest(c.encoder)
+		decodeResponse = DecodeGetBenchmarkComparisonResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetBenchmarkComparisonRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetBenchmarkComparisonDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "getBenchmarkComparison", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetRiskMetrics returns an endpoint that makes HTTP requests to the portfolio
+// service getRiskMetrics server.
+func (c *Client) GetRiskMetrics() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetRiskMetricsRequest(c.encoder)
+		decodeResponse = DecodeGetRiskMetricsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetRiskMetricsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetRiskMetricsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "getRiskMetrics", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetValueAtRisk returns an endpoint that makes HTTP requests to the portfolio
+// service getValueAtRisk server.
+func (c *Client) GetValueAtRisk() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetValueAtRiskRequest(c.encoder)
+		decodeResponse = DecodeGetValueAtRiskResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetValueAtRiskRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetValueAtRiskDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "getValueAtRisk", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListStressScenarios returns an endpoint that makes HTTP requests to the
+// portfolio service listStressScenarios server.
+func (c *Client) ListStressScenarios() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListStressScenariosResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListStressScenariosRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListStressScenariosDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "listStressScenarios", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RunStressTest returns an endpoint that makes HTTP requests to the portfolio
+// service runStressTest server.
+func (c *Client) RunStressTest() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRunStressTestRequest(c.encoder)
+		decodeResponse = DecodeRunStressTestResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRunStressTestRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RunStressTestDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "runStressTest", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ProjectPortfolio returns an endpoint that makes HTTP requests to the
+// portfolio service projectPortfolio server.
+func (c *Client) ProjectPortfolio() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeProjectPortfolioRequest(c.encoder)
+		decodeResponse = DecodeProjectPortfolioResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildProjectPortfolioRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ProjectPortfolioDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "projectPortfolio", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListCorporateActions returns an endpoint that makes HTTP requests to the
+// portfolio service listCorporateActions server.
+func (c *Client) ListCorporateActions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListCorporateActionsRequest(c.encoder)
+		decodeResponse = DecodeListCorporateActionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListCorporateActionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListCorporateActionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "listCorporateActions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ApplyCorporateActions returns an endpoint that makes HTTP requests to the
+// portfolio service applyCorporateActions server.
+func (c *Client) ApplyCorporateActions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeApplyCorporateActionsRequest(c.encoder)
+		decodeResponse = DecodeApplyCorporateActionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildApplyCorporateActionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ApplyCorporateActionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "applyCorporateActions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RecordIncome returns an endpoint that makes HTTP requests to the portfolio
+// service recordIncome server.
+func (c *Client) RecordIncome() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRecordIncomeRequest(c.encoder)
+		decodeResponse = DecodeRecordIncomeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRecordIncomeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RecordIncomeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "recordIncome", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetIncome returns an endpoint that makes HTTP requests to the portfolio
+// service getIncome server.
+func (c *Client) GetIncome() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetIncomeRequest(c.encoder)
+		decodeResponse = DecodeGetIncomeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetIncomeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetIncomeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "getIncome", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PriceBond returns an endpoint that makes HTTP requests to the portfolio
+// service priceBond server.
+func (c *Client) PriceBond() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePriceBondRequest(c.encoder)
+		decodeResponse = DecodePriceBondResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPriceBondRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PriceBondDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "priceBond", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListBondPositions returns an endpoint that makes HTTP requests to the
+// portfolio service listBondPositions server.
+func (c *Client) ListBondPositions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListBondPositionsRequest(c.encoder)
+		decodeResponse = DecodeListBondPositionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListBondPositionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListBondPositionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "listBondPositions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetCashFlowLadder returns an endpoint that makes HTTP requests to the
+// portfolio service getCashFlowLadder server.
+func (c *Client) GetCashFlowLadder() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetCashFlowLadderRequest(c.encoder)
+		decodeResponse = DecodeGetCashFlowLadderResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetCashFlowLadderRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetCashFlowLadderDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "getCashFlowLadder", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListOptionPositions returns an endpoint that makes HTTP requests to the
+// portfolio service listOptionPositions server.
+func (c *Client) ListOptionPositions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListOptionPositionsRequest(c.encoder)
+		decodeResponse = DecodeListOptionPositionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListOptionPositionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListOptionPositionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "listOptionPositions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SettleOptionExpiries returns an endpoint that makes HTTP requests to the
+// portfolio service settleOptionExpiries server.
+func (c *Client) SettleOptionExpiries() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSettleOptionExpiriesRequest(c.encoder)
+		decodeResponse = DecodeSettleOptionExpiriesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSettleOptionExpiriesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SettleOptionExpiriesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "settleOptionExpiries", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetMarginAccount returns an endpoint that makes HTTP requests to the
+// portfolio service setMarginAccount server.
+func (c *Client) SetMarginAccount() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetMarginAccountRequest(c.encoder)
+		decodeResponse = DecodeSetMarginAccountResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetMarginAccountRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetMarginAccountDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "setMarginAccount", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetMarginStatus returns an endpoint that makes HTTP requests to the
+// portfolio service getMarginStatus server.
+func (c *Client) GetMarginStatus() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetMarginStatusRequest(c.encoder)
+		decodeResponse = DecodeGetMarginStatusResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetMarginStatusRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetMarginStatusDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "getMarginStatus", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ChargeMargin returns an endpoint that makes HTTP requests to the portfolio
+// service chargeMargin server.
+func (c *Client) ChargeMargin() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeChargeMarginRequest(c.encoder)
+		decodeResponse = DecodeChargeMarginResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildChargeMarginRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ChargeMarginDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "chargeMargin", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListEvents returns an endpoint that makes HTTP requests to the portfolio
+// service listEvents server.
+func (c *Client) ListEvents() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListEventsRequest(c.encoder)
+		decodeResponse = DecodeListEventsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListEventsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListEventsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "listEvents", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PlaceOrder returns an endpoint that makes HTTP requests to the portfolio
+// service placeOrder server.
+func (c *Client) PlaceOrder() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePlaceOrderRequest(c.encoder)
+		decodeResponse = DecodePlaceOrderResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPlaceOrderRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PlaceOrderDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "placeOrder", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListOrders returns an endpoint that makes HTTP requests to the portfolio
+// service listOrders server.
+func (c *Client) ListOrders() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListOrdersRequest(c.encoder)
+		decodeResponse = DecodeListOrdersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListOrdersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListOrdersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "listOrders", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CancelOrder returns an endpoint that makes HTTP requests to the portfolio
+// service cancelOrder server.
+func (c *Client) CancelOrder() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCancelOrderRequest(c.encoder)
+		decodeResponse = DecodeCancelOrderResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCancelOrderRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CancelOrderDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "cancelOrder", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PreviewTrades returns an endpoint that makes HTTP requests to the portfolio
+// service previewTrades server.
+func (c *Client) PreviewTrades() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePreviewTradesRequest(c.encoder)
+		decodeResponse = DecodePreviewTradesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPreviewTradesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PreviewTradesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "previewTrades", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RunBacktest returns an endpoint that makes HTTP requests to the portfolio
+// service runBacktest server.
+func (c *Client) RunBacktest() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRunBacktestRequest(c.encoder)
+		decodeResponse = DecodeRunBacktestResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRunBacktestRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RunBacktestDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "runBacktest", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ImportCsv returns an endpoint that makes HTTP requests to the portfolio
+// service importCsv server.
+func (c *Client) ImportCsv() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeImportCsvRequest(c.encoder)
+		decodeResponse = DecodeImportCsvResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportCsvRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportCsvDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "importCsv", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ImportOfx returns an endpoint that makes HTTP requests to the portfolio
+// service importOfx server.
+func (c *Client) ImportOfx() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeImportOfxRequest(c.encoder)
+		decodeResponse = DecodeImportOfxResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportOfxRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportOfxDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "importOfx", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ExportJournal returns an endpoint that makes HTTP requests to the portfolio
+// service exportJournal server.
+func (c *Client) ExportJournal() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExportJournalRequest(c.encoder)
+		decodeResponse = DecodeExportJournalResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExportJournalRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExportJournalDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "exportJournal", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ImportJournal returns an endpoint that makes HTTP requests to the portfolio
+// service importJournal server.
+func (c *Client) ImportJournal() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeImportJournalRequest(c.encoder)
+		decodeResponse = DecodeImportJournalResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportJournalRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportJournalDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "importJournal", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ExportArchive returns an endpoint that makes HTTP requests to the portfolio
+// service exportArchive server.
+func (c *Client) ExportArchive() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExportArchiveRequest(c.encoder)
+		decodeResponse = DecodeExportArchiveResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExportArchiveRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExportArchiveDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "exportArchive", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ImportArchive returns an endpoint that makes HTTP requests to the portfolio
+// service importArchive server.
+func (c *Client) ImportArchive() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeImportArchiveRequest(c.encoder)
+		decodeResponse = DecodeImportArchiveResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportArchiveRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportArchiveDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "importArchive", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ReconcilePositions returns an endpoint that makes HTTP requests to the
+// portfolio service reconcilePositions server.
+func (c *Client) ReconcilePositions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeReconcilePositionsRequest(c.encoder)
+		decodeResponse = DecodeReconcilePositionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildReconcilePositionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReconcilePositionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "reconcilePositions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListReconciliationBreaks returns an endpoint that makes HTTP requests to the
+// portfolio service listReconciliationBreaks server.
+func (c *Client) ListReconciliationBreaks() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListReconciliationBreaksRequest(c.encoder)
+		decodeResponse = DecodeListReconciliationBreaksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListReconciliationBreaksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListReconciliationBreaksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "listReconciliationBreaks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateReconciliationBreak returns an endpoint that makes HTTP requests to
+// the portfolio service updateReconciliationBreak server.
+func (c *Client) UpdateReconciliationBreak() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateReconciliationBreakRequest(c.encoder)
+		decodeResponse = DecodeUpdateReconciliationBreakResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateReconciliationBreakRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateReconciliationBreakDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "updateReconciliationBreak", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetTaxReport returns an endpoint that makes HTTP requests to the portfolio
+// service getTaxReport server.
+func (c *Client) GetTaxReport() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetTaxReportRequest(c.encoder)
+		decodeResponse = DecodeGetTaxReportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetTaxReportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetTaxReportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "getTaxReport", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ExportTaxReport returns an endpoint that makes HTTP requests to the
+// portfolio service exportTaxReport server.
+func (c *Client) ExportTaxReport() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExportTaxReportRequest(c.encoder)
+		decodeResponse = DecodeExportTaxReportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExportTaxReportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExportTaxReportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "exportTaxReport", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListLots returns an endpoint that makes HTTP requests to the portfolio
+// service listLots server.
+func (c *Client) ListLots() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListLotsRequest(c.encoder)
+		decodeResponse = DecodeListLotsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListLotsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListLotsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "listLots", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// FindTaxLossHarvests returns an endpoint that makes HTTP requests to the
+// portfolio service findTaxLossHarvests server.
+func (c *Client) FindTaxLossHarvests() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeFindTaxLossHarvestsRequest(c.encoder)
+		decodeResponse = DecodeFindTaxLossHarvestsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildFindTaxLossHarvestsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.FindTaxLossHarvestsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "findTaxLossHarvests", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListAccounts returns an endpoint that makes HTTP requests to the portfolio
+// service listAccounts server.
+func (c *Client) ListAccounts() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListAccountsRequest(c.encoder)
+		decodeResponse = DecodeListAccountsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListAccountsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListAccountsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "listAccounts", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetAccount returns an endpoint that makes HTTP requests to the portfolio
+// service setAccount server.
+func (c *Client) SetAccount() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetAccountRequest(c.encoder)
+		decodeResponse = DecodeSetAccountResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetAccountRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetAccountDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "setAccount", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RemoveAccount returns an endpoint that makes HTTP requests to the portfolio
+// service removeAccount server.
+func (c *Client) RemoveAccount() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRemoveAccountRequest(c.encoder)
+		decodeResponse = DecodeRemoveAccountResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRemoveAccountRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RemoveAccountDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("portfolio", "removeAccount", err)
+		}
+		return decodeResponse(resp)
+	}
+}
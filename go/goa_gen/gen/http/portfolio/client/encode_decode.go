@@ -11,11 +11,14 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	portfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildGetPortfolioSummaryRequest instantiates a HTTP request object with
@@ -34,9 +37,32 @@ func (c *Client) BuildGetPortfolioSummaryRequest(ctx context.Context, v any) (*h
 	return req, nil
 }
 
+// EncodeGetPortfolioSummaryRequest returns an encoder for requests sent to the
+// portfolio getPortfolioSummary server.
+func EncodeGetPortfolioSummaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetPortfolioSummaryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getPortfolioSummary", "*portfolio.GetPortfolioSummaryPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		if p.Account != nil {
+			values.Add("account", *p.Account)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
 // DecodeGetPortfolioSummaryResponse returns a decoder for responses returned
 // by the portfolio getPortfolioSummary endpoint. restoreBody controls whether
 // the response body should be restored after having been read.
+// DecodeGetPortfolioSummaryResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
 func DecodeGetPortfolioSummaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -67,9 +93,6331 @@ func DecodeGetPortfolioSummaryResponse(decoder func(*http.Response) goahttp.Deco
 			}
 			res := NewGetPortfolioSummaryPortfolioSummaryOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getPortfolioSummary", err)
+			}
+			return nil, NewGetPortfolioSummaryBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getPortfolioSummary", err)
+			}
+			return nil, NewGetPortfolioSummaryNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getPortfolioSummary", err)
+			}
+			return nil, NewGetPortfolioSummaryUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("portfolio", "getPortfolioSummary", resp.StatusCode, string(body))
 		}
 	}
 }
+
+// BuildGetReturnsRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "getReturns" endpoint
+func (c *Client) BuildGetReturnsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetReturnsPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "getReturns", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetReturnsRequest returns an encoder for requests sent to the
+// portfolio getReturns server.
+func EncodeGetReturnsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetReturnsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getReturns", "*portfolio.GetReturnsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("period", p.Period)
+		if p.Start != nil {
+			values.Add("start", *p.Start)
+		}
+		if p.End != nil {
+			values.Add("end", *p.End)
+		}
+		if p.Account != nil {
+			values.Add("account", *p.Account)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetReturnsResponse returns a decoder for responses returned by the
+// portfolio getReturns endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetReturnsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetReturnsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetReturnsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getReturns", err)
+			}
+			err = ValidateGetReturnsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "getReturns", err)
+			}
+			res := NewGetReturnsPortfolioReturnsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getReturns", err)
+			}
+			return nil, NewGetReturnsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getReturns", err)
+			}
+			return nil, NewGetReturnsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getReturns", err)
+			}
+			return nil, NewGetReturnsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "getReturns", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAllocationRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "getAllocation" endpoint
+func (c *Client) BuildGetAllocationRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAllocationPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "getAllocation", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAllocationRequest returns an encoder for requests sent to the
+// portfolio getAllocation server.
+func EncodeGetAllocationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetAllocationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getAllocation", "*portfolio.GetAllocationPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("dimension", p.Dimension)
+		if p.Tag != nil {
+			values.Add("tag", *p.Tag)
+		}
+		if p.Account != nil {
+			values.Add("account", *p.Account)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetAllocationResponse returns a decoder for responses returned by the
+// portfolio getAllocation endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetAllocationResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetAllocationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAllocationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getAllocation", err)
+			}
+			err = ValidateGetAllocationResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "getAllocation", err)
+			}
+			res := NewGetAllocationAllocationOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getAllocation", err)
+			}
+			return nil, NewGetAllocationBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getAllocation", err)
+			}
+			return nil, NewGetAllocationNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getAllocation", err)
+			}
+			return nil, NewGetAllocationUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "getAllocation", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetTargetAllocationRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service "getTargetAllocation"
+// endpoint
+func (c *Client) BuildGetTargetAllocationRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetTargetAllocationPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "getTargetAllocation", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetTargetAllocationRequest returns an encoder for requests sent to the
+// portfolio getTargetAllocation server.
+func EncodeGetTargetAllocationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetTargetAllocationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getTargetAllocation", "*portfolio.GetTargetAllocationPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetTargetAllocationResponse returns a decoder for responses returned
+// by the portfolio getTargetAllocation endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetTargetAllocationResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetTargetAllocationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetTargetAllocationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getTargetAllocation", err)
+			}
+			err = ValidateGetTargetAllocationResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "getTargetAllocation", err)
+			}
+			res := NewGetTargetAllocationTargetAllocationOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getTargetAllocation", err)
+			}
+			return nil, NewGetTargetAllocationBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getTargetAllocation", err)
+			}
+			return nil, NewGetTargetAllocationNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getTargetAllocation", err)
+			}
+			return nil, NewGetTargetAllocationUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "getTargetAllocation", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetTargetAllocationRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service "setTargetAllocation"
+// endpoint
+func (c *Client) BuildSetTargetAllocationRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetTargetAllocationPortfolioPath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "setTargetAllocation", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetTargetAllocationRequest returns an encoder for requests sent to the
+// portfolio setTargetAllocation server.
+func EncodeSetTargetAllocationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.SetTargetAllocationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "setTargetAllocation", "*portfolio.SetTargetAllocationPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		body := NewTargetWeightRequestBodyRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "setTargetAllocation", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetTargetAllocationResponse returns a decoder for responses returned
+// by the portfolio setTargetAllocation endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeSetTargetAllocationResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSetTargetAllocationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetTargetAllocationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setTargetAllocation", err)
+			}
+			err = ValidateSetTargetAllocationResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "setTargetAllocation", err)
+			}
+			res := NewSetTargetAllocationTargetAllocationOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setTargetAllocation", err)
+			}
+			return nil, NewSetTargetAllocationBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setTargetAllocation", err)
+			}
+			return nil, NewSetTargetAllocationNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setTargetAllocation", err)
+			}
+			return nil, NewSetTargetAllocationUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "setTargetAllocation", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildProposeRebalanceRequest instantiates a HTTP request object with method
+// and path set to call the "portfolio" service "proposeRebalance" endpoint
+func (c *Client) BuildProposeRebalanceRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ProposeRebalancePortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "proposeRebalance", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeProposeRebalanceRequest returns an encoder for requests sent to the
+// portfolio proposeRebalance server.
+func EncodeProposeRebalanceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ProposeRebalancePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "proposeRebalance", "*portfolio.ProposeRebalancePayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		body := p
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "proposeRebalance", err)
+		}
+		return nil
+	}
+}
+
+// DecodeProposeRebalanceResponse returns a decoder for responses returned by
+// the portfolio proposeRebalance endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeProposeRebalanceResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeProposeRebalanceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ProposeRebalanceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "proposeRebalance", err)
+			}
+			err = ValidateProposeRebalanceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "proposeRebalance", err)
+			}
+			res := NewProposeRebalanceRebalanceProposalOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "proposeRebalance", err)
+			}
+			return nil, NewProposeRebalanceBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "proposeRebalance", err)
+			}
+			return nil, NewProposeRebalanceNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "proposeRebalance", err)
+			}
+			return nil, NewProposeRebalanceUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "proposeRebalance", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetBenchmarkRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "setBenchmark" endpoint
+func (c *Client) BuildSetBenchmarkRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetBenchmarkPortfolioPath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "setBenchmark", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetBenchmarkRequest returns an encoder for requests sent to the
+// portfolio setBenchmark server.
+func EncodeSetBenchmarkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.SetBenchmarkPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "setBenchmark", "*portfolio.SetBenchmarkPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		body := NewSetBenchmarkRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "setBenchmark", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetBenchmarkResponse returns a decoder for responses returned by the
+// portfolio setBenchmark endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeSetBenchmarkResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSetBenchmarkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetBenchmarkResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setBenchmark", err)
+			}
+			err = ValidateSetBenchmarkResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "setBenchmark", err)
+			}
+			res := NewSetBenchmarkBenchmarkDefinitionOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setBenchmark", err)
+			}
+			return nil, NewSetBenchmarkBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setBenchmark", err)
+			}
+			return nil, NewSetBenchmarkNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setBenchmark", err)
+			}
+			return nil, NewSetBenchmarkUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "setBenchmark", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetBenchmarkComparisonRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service "getBenchmarkComparison"
+// endpoint
+func (c *Client) BuildGetBenchmarkComparisonRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetBenchmarkComparisonPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "getBenchmarkComparison", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetBenchmarkComparisonRequest returns an encoder for requests sent to
+// the portfolio getBenchmarkComparison server.
+func EncodeGetBenchmarkComparisonRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetBenchmarkComparisonPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getBenchmarkComparison", "*portfolio.GetBenchmarkComparisonPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("period", p.Period)
+		if p.Start != nil {
+			values.Add("start", *p.Start)
+		}
+		if p.End != nil {
+			values.Add("end", *p.End)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetBenchmarkComparisonResponse returns a decoder for responses
+// returned by the portfolio getBenchmarkComparison endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeGetBenchmarkComparisonResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetBenchmarkComparisonResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetBenchmarkComparisonResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getBenchmarkComparison", err)
+			}
+			err = ValidateGetBenchmarkComparisonResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "getBenchmarkComparison", err)
+			}
+			res := NewGetBenchmarkComparisonBenchmarkComparisonOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getBenchmarkComparison", err)
+			}
+			return nil, NewGetBenchmarkComparisonBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getBenchmarkComparison", err)
+			}
+			return nil, NewGetBenchmarkComparisonNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getBenchmarkComparison", err)
+			}
+			return nil, NewGetBenchmarkComparisonUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "getBenchmarkComparison", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRiskMetricsRequest instantiates a HTTP request object with method
+// and path set to call the "portfolio" service "getRiskMetrics" endpoint
+func (c *Client) BuildGetRiskMetricsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetRiskMetricsPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "getRiskMetrics", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetRiskMetricsRequest returns an encoder for requests sent to the
+// portfolio getRiskMetrics server.
+func EncodeGetRiskMetricsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetRiskMetricsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getRiskMetrics", "*portfolio.GetRiskMetricsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("period", p.Period)
+		if p.Start != nil {
+			values.Add("start", *p.Start)
+		}
+		if p.End != nil {
+			values.Add("end", *p.End)
+		}
+		if p.RiskFreeRate != nil {
+			values.Add("risk_free_rate", fmt.Sprintf("%v", *p.RiskFreeRate))
+		}
+		if p.Window != nil {
+			values.Add("window", fmt.Sprintf("%v", *p.Window))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetRiskMetricsResponse returns a decoder for responses returned by the
+// portfolio getRiskMetrics endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetRiskMetricsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetRiskMetricsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetRiskMetricsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getRiskMetrics", err)
+			}
+			err = ValidateGetRiskMetricsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "getRiskMetrics", err)
+			}
+			res := NewGetRiskMetricsRiskMetricsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getRiskMetrics", err)
+			}
+			return nil, NewGetRiskMetricsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getRiskMetrics", err)
+			}
+			return nil, NewGetRiskMetricsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getRiskMetrics", err)
+			}
+			return nil, NewGetRiskMetricsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "getRiskMetrics", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetValueAtRiskRequest instantiates a HTTP request object with method
+// and path set to call the "portfolio" service "getValueAtRisk" endpoint
+func (c *Client) BuildGetValueAtRiskRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetValueAtRiskPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "getValueAtRisk", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetValueAtRiskRequest returns an encoder for requests sent to the
+// portfolio getValueAtRisk server.
+func EncodeGetValueAtRiskRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetValueAtRiskPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getValueAtRisk", "*portfolio.GetValueAtRiskPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("method", p.Method)
+		values.Add("confidence", fmt.Sprintf("%v", p.Confidence))
+		values.Add("horizon", fmt.Sprintf("%v", p.Horizon))
+		values.Add("lookback", fmt.Sprintf("%v", p.Lookback))
+		values.Add("simulations", fmt.Sprintf("%v", p.Simulations))
+		if p.Seed != nil {
+			values.Add("seed", fmt.Sprintf("%v", *p.Seed))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetValueAtRiskResponse returns a decoder for responses returned by the
+// portfolio getValueAtRisk endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetValueAtRiskResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetValueAtRiskResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetValueAtRiskResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getValueAtRisk", err)
+			}
+			err = ValidateGetValueAtRiskResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "getValueAtRisk", err)
+			}
+			res := NewGetValueAtRiskValueAtRiskOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getValueAtRisk", err)
+			}
+			return nil, NewGetValueAtRiskBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getValueAtRisk", err)
+			}
+			return nil, NewGetValueAtRiskNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getValueAtRisk", err)
+			}
+			return nil, NewGetValueAtRiskUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "getValueAtRisk", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListStressScenariosRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service "listStressScenarios"
+// endpoint
+func (c *Client) BuildListStressScenariosRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListStressScenariosPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "listStressScenarios", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListStressScenariosResponse returns a decoder for responses returned
+// by the portfolio listStressScenarios endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListStressScenariosResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListStressScenariosResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListStressScenariosResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listStressScenarios", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateStressScenarioResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "listStressScenarios", err)
+			}
+			res := NewListStressScenariosStressScenarioOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listStressScenarios", err)
+			}
+			return nil, NewListStressScenariosBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listStressScenarios", err)
+			}
+			return nil, NewListStressScenariosNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listStressScenarios", err)
+			}
+			return nil, NewListStressScenariosUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "listStressScenarios", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRunStressTestRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "runStressTest" endpoint
+func (c *Client) BuildRunStressTestRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RunStressTestPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "runStressTest", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRunStressTestRequest returns an encoder for requests sent to the
+// portfolio runStressTest server.
+func EncodeRunStressTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.RunStressTestPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "runStressTest", "*portfolio.RunStressTestPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("scenario", p.Scenario)
+		values.Add("top", fmt.Sprintf("%v", p.Top))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeRunStressTestResponse returns a decoder for responses returned by the
+// portfolio runStressTest endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRunStressTestResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRunStressTestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RunStressTestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "runStressTest", err)
+			}
+			err = ValidateRunStressTestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "runStressTest", err)
+			}
+			res := NewRunStressTestStressTestResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "runStressTest", err)
+			}
+			return nil, NewRunStressTestBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "runStressTest", err)
+			}
+			return nil, NewRunStressTestNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "runStressTest", err)
+			}
+			return nil, NewRunStressTestUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "runStressTest", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildProjectPortfolioRequest instantiates a HTTP request object with method
+// and path set to call the "portfolio" service "projectPortfolio" endpoint
+func (c *Client) BuildProjectPortfolioRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ProjectPortfolioPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "projectPortfolio", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeProjectPortfolioRequest returns an encoder for requests sent to the
+// portfolio projectPortfolio server.
+func EncodeProjectPortfolioRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ProjectPortfolioPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "projectPortfolio", "*portfolio.ProjectPortfolioPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		body := NewProjectPortfolioRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "projectPortfolio", err)
+		}
+		return nil
+	}
+}
+
+// DecodeProjectPortfolioResponse returns a decoder for responses returned by
+// the portfolio projectPortfolio endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeProjectPortfolioResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeProjectPortfolioResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ProjectPortfolioResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "projectPortfolio", err)
+			}
+			err = ValidateProjectPortfolioResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "projectPortfolio", err)
+			}
+			res := NewProjectPortfolioProjectionOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "projectPortfolio", err)
+			}
+			return nil, NewProjectPortfolioBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "projectPortfolio", err)
+			}
+			return nil, NewProjectPortfolioNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "projectPortfolio", err)
+			}
+			return nil, NewProjectPortfolioUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "projectPortfolio", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListCorporateActionsRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service "listCorporateActions"
+// endpoint
+func (c *Client) BuildListCorporateActionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListCorporateActionsPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "listCorporateActions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListCorporateActionsRequest returns an encoder for requests sent to
+// the portfolio listCorporateActions server.
+func EncodeListCorporateActionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ListCorporateActionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "listCorporateActions", "*portfolio.ListCorporateActionsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListCorporateActionsResponse returns a decoder for responses returned
+// by the portfolio listCorporateActions endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListCorporateActionsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListCorporateActionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListCorporateActionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listCorporateActions", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateCorporateActionResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "listCorporateActions", err)
+			}
+			res := NewListCorporateActionsCorporateActionOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listCorporateActions", err)
+			}
+			return nil, NewListCorporateActionsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listCorporateActions", err)
+			}
+			return nil, NewListCorporateActionsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listCorporateActions", err)
+			}
+			return nil, NewListCorporateActionsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "listCorporateActions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildApplyCorporateActionsRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service "applyCorporateActions"
+// endpoint
+func (c *Client) BuildApplyCorporateActionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ApplyCorporateActionsPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "applyCorporateActions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeApplyCorporateActionsRequest returns an encoder for requests sent to
+// the portfolio applyCorporateActions server.
+func EncodeApplyCorporateActionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ApplyCorporateActionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "applyCorporateActions", "*portfolio.ApplyCorporateActionsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		body := NewApplyCorporateActionsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "applyCorporateActions", err)
+		}
+		return nil
+	}
+}
+
+// DecodeApplyCorporateActionsResponse returns a decoder for responses returned
+// by the portfolio applyCorporateActions endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeApplyCorporateActionsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeApplyCorporateActionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ApplyCorporateActionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "applyCorporateActions", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateCorporateActionResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "applyCorporateActions", err)
+			}
+			res := NewApplyCorporateActionsCorporateActionOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "applyCorporateActions", err)
+			}
+			return nil, NewApplyCorporateActionsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "applyCorporateActions", err)
+			}
+			return nil, NewApplyCorporateActionsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "applyCorporateActions", err)
+			}
+			return nil, NewApplyCorporateActionsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "applyCorporateActions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRecordIncomeRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "recordIncome" endpoint
+func (c *Client) BuildRecordIncomeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RecordIncomePortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "recordIncome", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRecordIncomeRequest returns an encoder for requests sent to the
+// portfolio recordIncome server.
+func EncodeRecordIncomeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.RecordIncomePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "recordIncome", "*portfolio.RecordIncomePayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		body := NewRecordIncomeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "recordIncome", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRecordIncomeResponse returns a decoder for responses returned by the
+// portfolio recordIncome endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRecordIncomeResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRecordIncomeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RecordIncomeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "recordIncome", err)
+			}
+			err = ValidateRecordIncomeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "recordIncome", err)
+			}
+			res := NewRecordIncomeIncomeRecordOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "recordIncome", err)
+			}
+			return nil, NewRecordIncomeBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "recordIncome", err)
+			}
+			return nil, NewRecordIncomeNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "recordIncome", err)
+			}
+			return nil, NewRecordIncomeUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "recordIncome", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetIncomeRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "getIncome" endpoint
+func (c *Client) BuildGetIncomeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetIncomePortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "getIncome", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetIncomeRequest returns an encoder for requests sent to the portfolio
+// getIncome server.
+func EncodeGetIncomeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetIncomePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getIncome", "*portfolio.GetIncomePayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("period", p.Period)
+		if p.Start != nil {
+			values.Add("start", *p.Start)
+		}
+		if p.End != nil {
+			values.Add("end", *p.End)
+		}
+		values.Add("interval", p.Interval)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetIncomeResponse returns a decoder for responses returned by the
+// portfolio getIncome endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetIncomeResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetIncomeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetIncomeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getIncome", err)
+			}
+			err = ValidateGetIncomeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "getIncome", err)
+			}
+			res := NewGetIncomeIncomeSummaryOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getIncome", err)
+			}
+			return nil, NewGetIncomeBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getIncome", err)
+			}
+			return nil, NewGetIncomeNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getIncome", err)
+			}
+			return nil, NewGetIncomeUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "getIncome", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPriceBondRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "priceBond" endpoint
+func (c *Client) BuildPriceBondRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PriceBondPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "priceBond", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePriceBondRequest returns an encoder for requests sent to the portfolio
+// priceBond server.
+func EncodePriceBondRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.PriceBondPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "priceBond", "*portfolio.PriceBondPayload", v)
+		}
+		body := NewPriceBondRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "priceBond", err)
+		}
+		return nil
+	}
+}
+
+// DecodePriceBondResponse returns a decoder for responses returned by the
+// portfolio priceBond endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodePriceBondResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodePriceBondResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PriceBondResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "priceBond", err)
+			}
+			err = ValidatePriceBondResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "priceBond", err)
+			}
+			res := NewPriceBondBondAnalyticsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "priceBond", err)
+			}
+			return nil, NewPriceBondBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "priceBond", err)
+			}
+			return nil, NewPriceBondNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "priceBond", err)
+			}
+			return nil, NewPriceBondUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "priceBond", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListBondPositionsRequest instantiates a HTTP request object with method
+// and path set to call the "portfolio" service "listBondPositions" endpoint
+func (c *Client) BuildListBondPositionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListBondPositionsPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "listBondPositions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListBondPositionsRequest returns an encoder for requests sent to the
+// portfolio listBondPositions server.
+func EncodeListBondPositionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ListBondPositionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "listBondPositions", "*portfolio.ListBondPositionsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListBondPositionsResponse returns a decoder for responses returned by
+// the portfolio listBondPositions endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListBondPositionsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListBondPositionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListBondPositionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listBondPositions", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateBondPositionResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "listBondPositions", err)
+			}
+			res := NewListBondPositionsBondPositionOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listBondPositions", err)
+			}
+			return nil, NewListBondPositionsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listBondPositions", err)
+			}
+			return nil, NewListBondPositionsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listBondPositions", err)
+			}
+			return nil, NewListBondPositionsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "listBondPositions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetCashFlowLadderRequest instantiates a HTTP request object with method
+// and path set to call the "portfolio" service "getCashFlowLadder" endpoint
+func (c *Client) BuildGetCashFlowLadderRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetCashFlowLadderPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "getCashFlowLadder", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetCashFlowLadderRequest returns an encoder for requests sent to the
+// portfolio getCashFlowLadder server.
+func EncodeGetCashFlowLadderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetCashFlowLadderPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getCashFlowLadder", "*portfolio.GetCashFlowLadderPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("years", fmt.Sprintf("%v", p.Years))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetCashFlowLadderResponse returns a decoder for responses returned by
+// the portfolio getCashFlowLadder endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetCashFlowLadderResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetCashFlowLadderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetCashFlowLadderResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getCashFlowLadder", err)
+			}
+			err = ValidateGetCashFlowLadderResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "getCashFlowLadder", err)
+			}
+			res := NewGetCashFlowLadderCashFlowLadderOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getCashFlowLadder", err)
+			}
+			return nil, NewGetCashFlowLadderBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getCashFlowLadder", err)
+			}
+			return nil, NewGetCashFlowLadderNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getCashFlowLadder", err)
+			}
+			return nil, NewGetCashFlowLadderUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "getCashFlowLadder", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListOptionPositionsRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service "listOptionPositions"
+// endpoint
+func (c *Client) BuildListOptionPositionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListOptionPositionsPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "listOptionPositions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListOptionPositionsRequest returns an encoder for requests sent to the
+// portfolio listOptionPositions server.
+func EncodeListOptionPositionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ListOptionPositionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "listOptionPositions", "*portfolio.ListOptionPositionsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		if p.Volatility != nil {
+			values.Add("volatility", fmt.Sprintf("%v", *p.Volatility))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListOptionPositionsResponse returns a decoder for responses returned
+// by the portfolio listOptionPositions endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListOptionPositionsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListOptionPositionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListOptionPositionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listOptionPositions", err)
+			}
+			err = ValidateListOptionPositionsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "listOptionPositions", err)
+			}
+			res := NewListOptionPositionsOptionPositionsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listOptionPositions", err)
+			}
+			return nil, NewListOptionPositionsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listOptionPositions", err)
+			}
+			return nil, NewListOptionPositionsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listOptionPositions", err)
+			}
+			return nil, NewListOptionPositionsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "listOptionPositions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSettleOptionExpiriesRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service "settleOptionExpiries"
+// endpoint
+func (c *Client) BuildSettleOptionExpiriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SettleOptionExpiriesPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "settleOptionExpiries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSettleOptionExpiriesRequest returns an encoder for requests sent to
+// the portfolio settleOptionExpiries server.
+func EncodeSettleOptionExpiriesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.SettleOptionExpiriesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "settleOptionExpiries", "*portfolio.SettleOptionExpiriesPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSettleOptionExpiriesResponse returns a decoder for responses returned
+// by the portfolio settleOptionExpiries endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeSettleOptionExpiriesResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSettleOptionExpiriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SettleOptionExpiriesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "settleOptionExpiries", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateOptionSettlementResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "settleOptionExpiries", err)
+			}
+			res := NewSettleOptionExpiriesOptionSettlementOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "settleOptionExpiries", err)
+			}
+			return nil, NewSettleOptionExpiriesBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "settleOptionExpiries", err)
+			}
+			return nil, NewSettleOptionExpiriesNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "settleOptionExpiries", err)
+			}
+			return nil, NewSettleOptionExpiriesUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "settleOptionExpiries", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetMarginAccountRequest instantiates a HTTP request object with method
+// and path set to call the "portfolio" service "setMarginAccount" endpoint
+func (c *Client) BuildSetMarginAccountRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetMarginAccountPortfolioPath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "setMarginAccount", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetMarginAccountRequest returns an encoder for requests sent to the
+// portfolio setMarginAccount server.
+func EncodeSetMarginAccountRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.SetMarginAccountPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "setMarginAccount", "*portfolio.SetMarginAccountPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		body := NewSetMarginAccountRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "setMarginAccount", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetMarginAccountResponse returns a decoder for responses returned by
+// the portfolio setMarginAccount endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeSetMarginAccountResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSetMarginAccountResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetMarginAccountResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setMarginAccount", err)
+			}
+			err = ValidateSetMarginAccountResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "setMarginAccount", err)
+			}
+			res := NewSetMarginAccountMarginTermsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setMarginAccount", err)
+			}
+			return nil, NewSetMarginAccountBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setMarginAccount", err)
+			}
+			return nil, NewSetMarginAccountNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setMarginAccount", err)
+			}
+			return nil, NewSetMarginAccountUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "setMarginAccount", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetMarginStatusRequest instantiates a HTTP request object with method
+// and path set to call the "portfolio" service "getMarginStatus" endpoint
+func (c *Client) BuildGetMarginStatusRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetMarginStatusPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "getMarginStatus", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetMarginStatusRequest returns an encoder for requests sent to the
+// portfolio getMarginStatus server.
+func EncodeGetMarginStatusRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetMarginStatusPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getMarginStatus", "*portfolio.GetMarginStatusPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetMarginStatusResponse returns a decoder for responses returned by
+// the portfolio getMarginStatus endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetMarginStatusResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetMarginStatusResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetMarginStatusResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getMarginStatus", err)
+			}
+			err = ValidateGetMarginStatusResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "getMarginStatus", err)
+			}
+			res := NewGetMarginStatusMarginStatusOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getMarginStatus", err)
+			}
+			return nil, NewGetMarginStatusBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getMarginStatus", err)
+			}
+			return nil, NewGetMarginStatusNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getMarginStatus", err)
+			}
+			return nil, NewGetMarginStatusUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "getMarginStatus", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildChargeMarginRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "chargeMargin" endpoint
+func (c *Client) BuildChargeMarginRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ChargeMarginPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "chargeMargin", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeChargeMarginRequest returns an encoder for requests sent to the
+// portfolio chargeMargin server.
+func EncodeChargeMarginRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ChargeMarginPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "chargeMargin", "*portfolio.ChargeMarginPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeChargeMarginResponse returns a decoder for responses returned by the
+// portfolio chargeMargin endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeChargeMarginResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeChargeMarginResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ChargeMarginResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "chargeMargin", err)
+			}
+			err = ValidateChargeMarginResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "chargeMargin", err)
+			}
+			res := NewChargeMarginMarginStatusOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "chargeMargin", err)
+			}
+			return nil, NewChargeMarginBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "chargeMargin", err)
+			}
+			return nil, NewChargeMarginNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "chargeMargin", err)
+			}
+			return nil, NewChargeMarginUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "chargeMargin", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListEventsRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "listEvents" endpoint
+func (c *Client) BuildListEventsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListEventsPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "listEvents", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListEventsRequest returns an encoder for requests sent to the
+// portfolio listEvents server.
+func EncodeListEventsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ListEventsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "listEvents", "*portfolio.ListEventsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		if p.Since != nil {
+			values.Add("since", *p.Since)
+		}
+		if p.Type != nil {
+			values.Add("type", *p.Type)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListEventsResponse returns a decoder for responses returned by the
+// portfolio listEvents endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListEventsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListEventsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListEventsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listEvents", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidatePortfolioEventResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "listEvents", err)
+			}
+			res := NewListEventsPortfolioEventOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listEvents", err)
+			}
+			return nil, NewListEventsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listEvents", err)
+			}
+			return nil, NewListEventsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listEvents", err)
+			}
+			return nil, NewListEventsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "listEvents", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPlaceOrderRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "placeOrder" endpoint
+func (c *Client) BuildPlaceOrderRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PlaceOrderPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "placeOrder", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePlaceOrderRequest returns an encoder for requests sent to the
+// portfolio placeOrder server.
+func EncodePlaceOrderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.PlaceOrderPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "placeOrder", "*portfolio.PlaceOrderPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		body := NewPlaceOrderRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "placeOrder", err)
+		}
+		return nil
+	}
+}
+
+// DecodePlaceOrderResponse returns a decoder for responses returned by the
+// portfolio placeOrder endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodePlaceOrderResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodePlaceOrderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PlaceOrderResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "placeOrder", err)
+			}
+			err = ValidatePlaceOrderResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "placeOrder", err)
+			}
+			res := NewPlaceOrderOrderOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "placeOrder", err)
+			}
+			return nil, NewPlaceOrderBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "placeOrder", err)
+			}
+			return nil, NewPlaceOrderNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "placeOrder", err)
+			}
+			return nil, NewPlaceOrderUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "placeOrder", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListOrdersRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "listOrders" endpoint
+func (c *Client) BuildListOrdersRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListOrdersPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "listOrders", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListOrdersRequest returns an encoder for requests sent to the
+// portfolio listOrders server.
+func EncodeListOrdersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ListOrdersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "listOrders", "*portfolio.ListOrdersPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListOrdersResponse returns a decoder for responses returned by the
+// portfolio listOrders endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListOrdersResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListOrdersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListOrdersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listOrders", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateOrderResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "listOrders", err)
+			}
+			res := NewListOrdersOrderOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listOrders", err)
+			}
+			return nil, NewListOrdersBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listOrders", err)
+			}
+			return nil, NewListOrdersNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listOrders", err)
+			}
+			return nil, NewListOrdersUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "listOrders", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCancelOrderRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "cancelOrder" endpoint
+func (c *Client) BuildCancelOrderRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CancelOrderPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "cancelOrder", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCancelOrderRequest returns an encoder for requests sent to the
+// portfolio cancelOrder server.
+func EncodeCancelOrderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.CancelOrderPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "cancelOrder", "*portfolio.CancelOrderPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("id", p.ID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCancelOrderResponse returns a decoder for responses returned by the
+// portfolio cancelOrder endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCancelOrderResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCancelOrderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CancelOrderResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "cancelOrder", err)
+			}
+			err = ValidateCancelOrderResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "cancelOrder", err)
+			}
+			res := NewCancelOrderOrderOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "cancelOrder", err)
+			}
+			return nil, NewCancelOrderBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "cancelOrder", err)
+			}
+			return nil, NewCancelOrderNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "cancelOrder", err)
+			}
+			return nil, NewCancelOrderUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "cancelOrder", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPreviewTradesRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "previewTrades" endpoint
+func (c *Client) BuildPreviewTradesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PreviewTradesPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "previewTrades", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePreviewTradesRequest returns an encoder for requests sent to the
+// portfolio previewTrades server.
+func EncodePreviewTradesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.PreviewTradesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "previewTrades", "*portfolio.PreviewTradesPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		body := NewPreviewTradesRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "previewTrades", err)
+		}
+		return nil
+	}
+}
+
+// DecodePreviewTradesResponse returns a decoder for responses returned by the
+// portfolio previewTrades endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodePreviewTradesResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodePreviewTradesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PreviewTradesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "previewTrades", err)
+			}
+			err = ValidatePreviewTradesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "previewTrades", err)
+			}
+			res := NewPreviewTradesTradePreviewOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "previewTrades", err)
+			}
+			return nil, NewPreviewTradesBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "previewTrades", err)
+			}
+			return nil, NewPreviewTradesNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "previewTrades", err)
+			}
+			return nil, NewPreviewTradesUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "previewTrades", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRunBacktestRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "runBacktest" endpoint
+func (c *Client) BuildRunBacktestRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RunBacktestPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "runBacktest", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRunBacktestRequest returns an encoder for requests sent to the
+// portfolio runBacktest server.
+func EncodeRunBacktestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.RunBacktestPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "runBacktest", "*portfolio.RunBacktestPayload", v)
+		}
+		body := NewRunBacktestRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "runBacktest", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRunBacktestResponse returns a decoder for responses returned by the
+// portfolio runBacktest endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRunBacktestResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRunBacktestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RunBacktestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "runBacktest", err)
+			}
+			err = ValidateRunBacktestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "runBacktest", err)
+			}
+			res := NewRunBacktestBacktestResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "runBacktest", err)
+			}
+			return nil, NewRunBacktestBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "runBacktest", err)
+			}
+			return nil, NewRunBacktestNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "runBacktest", err)
+			}
+			return nil, NewRunBacktestUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "runBacktest", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportCsvRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "importCsv" endpoint
+func (c *Client) BuildImportCsvRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportCsvPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "importCsv", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeImportCsvRequest returns an encoder for requests sent to the portfolio
+// importCsv server.
+func EncodeImportCsvRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ImportCsvPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "importCsv", "*portfolio.ImportCsvPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("profile", p.Profile)
+		values.Add("account", p.Account)
+		values.Add("dry_run", fmt.Sprintf("%v", p.DryRun))
+		req.URL.RawQuery = values.Encode()
+		body := NewImportCsvRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "importCsv", err)
+		}
+		return nil
+	}
+}
+
+// DecodeImportCsvResponse returns a decoder for responses returned by the
+// portfolio importCsv endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeImportCsvResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeImportCsvResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ImportCsvResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importCsv", err)
+			}
+			err = ValidateImportCsvResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "importCsv", err)
+			}
+			res := NewImportCsvImportReportOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importCsv", err)
+			}
+			return nil, NewImportCsvBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importCsv", err)
+			}
+			return nil, NewImportCsvNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importCsv", err)
+			}
+			return nil, NewImportCsvUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "importCsv", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportOfxRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "importOfx" endpoint
+func (c *Client) BuildImportOfxRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportOfxPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "importOfx", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeImportOfxRequest returns an encoder for requests sent to the portfolio
+// importOfx server.
+func EncodeImportOfxRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ImportOfxPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "importOfx", "*portfolio.ImportOfxPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("account", p.Account)
+		values.Add("dry_run", fmt.Sprintf("%v", p.DryRun))
+		req.URL.RawQuery = values.Encode()
+		body := NewImportOfxRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "importOfx", err)
+		}
+		return nil
+	}
+}
+
+// DecodeImportOfxResponse returns a decoder for responses returned by the
+// portfolio importOfx endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeImportOfxResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeImportOfxResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ImportOfxResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importOfx", err)
+			}
+			err = ValidateImportOfxResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "importOfx", err)
+			}
+			res := NewImportOfxImportReportOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importOfx", err)
+			}
+			return nil, NewImportOfxBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importOfx", err)
+			}
+			return nil, NewImportOfxNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importOfx", err)
+			}
+			return nil, NewImportOfxUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "importOfx", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildExportJournalRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "exportJournal" endpoint
+func (c *Client) BuildExportJournalRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExportJournalPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "exportJournal", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExportJournalRequest returns an encoder for requests sent to the
+// portfolio exportJournal server.
+func EncodeExportJournalRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ExportJournalPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "exportJournal", "*portfolio.ExportJournalPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("format", p.Format)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeExportJournalResponse returns a decoder for responses returned by the
+// portfolio exportJournal endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeExportJournalResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeExportJournalResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportJournal", err)
+			}
+			var (
+				contentDisposition string
+			)
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "exportJournal", err)
+			}
+			res := NewExportJournalExportFileOK(body, contentDisposition)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportJournal", err)
+			}
+			return nil, NewExportJournalBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportJournal", err)
+			}
+			return nil, NewExportJournalNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportJournal", err)
+			}
+			return nil, NewExportJournalUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "exportJournal", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportJournalRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "importJournal" endpoint
+func (c *Client) BuildImportJournalRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportJournalPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "importJournal", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeImportJournalRequest returns an encoder for requests sent to the
+// portfolio importJournal server.
+func EncodeImportJournalRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ImportJournalPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "importJournal", "*portfolio.ImportJournalPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("account", p.Account)
+		values.Add("root", p.Root)
+		values.Add("dry_run", fmt.Sprintf("%v", p.DryRun))
+		req.URL.RawQuery = values.Encode()
+		body := NewImportJournalRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "importJournal", err)
+		}
+		return nil
+	}
+}
+
+// DecodeImportJournalResponse returns a decoder for responses returned by the
+// portfolio importJournal endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeImportJournalResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeImportJournalResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ImportJournalResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importJournal", err)
+			}
+			err = ValidateImportJournalResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "importJournal", err)
+			}
+			res := NewImportJournalImportReportOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importJournal", err)
+			}
+			return nil, NewImportJournalBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importJournal", err)
+			}
+			return nil, NewImportJournalNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importJournal", err)
+			}
+			return nil, NewImportJournalUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "importJournal", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildExportArchiveRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "exportArchive" endpoint
+func (c *Client) BuildExportArchiveRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExportArchivePortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "exportArchive", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExportArchiveRequest returns an encoder for requests sent to the
+// portfolio exportArchive server.
+func EncodeExportArchiveRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ExportArchivePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "exportArchive", "*portfolio.ExportArchivePayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeExportArchiveResponse returns a decoder for responses returned by the
+// portfolio exportArchive endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeExportArchiveResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeExportArchiveResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportArchive", err)
+			}
+			var (
+				contentDisposition string
+			)
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "exportArchive", err)
+			}
+			res := NewExportArchiveExportFileOK(body, contentDisposition)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportArchive", err)
+			}
+			return nil, NewExportArchiveBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportArchive", err)
+			}
+			return nil, NewExportArchiveNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportArchive", err)
+			}
+			return nil, NewExportArchiveUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "exportArchive", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportArchiveRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "importArchive" endpoint
+func (c *Client) BuildImportArchiveRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportArchivePortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "importArchive", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeImportArchiveRequest returns an encoder for requests sent to the
+// portfolio importArchive server.
+func EncodeImportArchiveRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ImportArchivePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "importArchive", "*portfolio.ImportArchivePayload", v)
+		}
+		values := req.URL.Query()
+		if p.PortfolioID != nil {
+			values.Add("portfolio_id", *p.PortfolioID)
+		}
+		values.Add("replace", fmt.Sprintf("%v", p.Replace))
+		req.URL.RawQuery = values.Encode()
+		body := NewImportArchiveRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "importArchive", err)
+		}
+		return nil
+	}
+}
+
+// DecodeImportArchiveResponse returns a decoder for responses returned by the
+// portfolio importArchive endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeImportArchiveResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeImportArchiveResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ImportArchiveResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importArchive", err)
+			}
+			err = ValidateImportArchiveResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "importArchive", err)
+			}
+			res := NewImportArchiveArchiveImportOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importArchive", err)
+			}
+			return nil, NewImportArchiveBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importArchive", err)
+			}
+			return nil, NewImportArchiveNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "importArchive", err)
+			}
+			return nil, NewImportArchiveUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "importArchive", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReconcilePositionsRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service "reconcilePositions"
+// endpoint
+func (c *Client) BuildReconcilePositionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReconcilePositionsPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "reconcilePositions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeReconcilePositionsRequest returns an encoder for requests sent to the
+// portfolio reconcilePositions server.
+func EncodeReconcilePositionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ReconcilePositionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "reconcilePositions", "*portfolio.ReconcilePositionsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("format", p.Format)
+		values.Add("profile", p.Profile)
+		values.Add("account", p.Account)
+		if p.AsOf != nil {
+			values.Add("as_of", *p.AsOf)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewReconcilePositionsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "reconcilePositions", err)
+		}
+		return nil
+	}
+}
+
+// DecodeReconcilePositionsResponse returns a decoder for responses returned by
+// the portfolio reconcilePositions endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeReconcilePositionsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeReconcilePositionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ReconcilePositionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "reconcilePositions", err)
+			}
+			err = ValidateReconcilePositionsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "reconcilePositions", err)
+			}
+			res := NewReconcilePositionsReconciliationOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "reconcilePositions", err)
+			}
+			return nil, NewReconcilePositionsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "reconcilePositions", err)
+			}
+			return nil, NewReconcilePositionsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "reconcilePositions", err)
+			}
+			return nil, NewReconcilePositionsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "reconcilePositions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListReconciliationBreaksRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service
+// "listReconciliationBreaks" endpoint
+func (c *Client) BuildListReconciliationBreaksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListReconciliationBreaksPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "listReconciliationBreaks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListReconciliationBreaksRequest returns an encoder for requests sent
+// to the portfolio listReconciliationBreaks server.
+func EncodeListReconciliationBreaksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ListReconciliationBreaksPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "listReconciliationBreaks", "*portfolio.ListReconciliationBreaksPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		if p.Account != nil {
+			values.Add("account", *p.Account)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListReconciliationBreaksResponse returns a decoder for responses
+// returned by the portfolio listReconciliationBreaks endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeListReconciliationBreaksResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListReconciliationBreaksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListReconciliationBreaksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listReconciliationBreaks", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateReconciliationBreakResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "listReconciliationBreaks", err)
+			}
+			res := NewListReconciliationBreaksReconciliationBreakOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listReconciliationBreaks", err)
+			}
+			return nil, NewListReconciliationBreaksBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listReconciliationBreaks", err)
+			}
+			return nil, NewListReconciliationBreaksNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listReconciliationBreaks", err)
+			}
+			return nil, NewListReconciliationBreaksUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "listReconciliationBreaks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateReconciliationBreakRequest instantiates a HTTP request object
+// with method and path set to call the "portfolio" service
+// "updateReconciliationBreak" endpoint
+func (c *Client) BuildUpdateReconciliationBreakRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateReconciliationBreakPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "updateReconciliationBreak", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateReconciliationBreakRequest returns an encoder for requests sent
+// to the portfolio updateReconciliationBreak server.
+func EncodeUpdateReconciliationBreakRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.UpdateReconciliationBreakPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "updateReconciliationBreak", "*portfolio.UpdateReconciliationBreakPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("id", p.ID)
+		values.Add("status", p.Status)
+		if p.Note != nil {
+			values.Add("note", *p.Note)
+		}
+		values.Add("apply", fmt.Sprintf("%v", p.Apply))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeUpdateReconciliationBreakResponse returns a decoder for responses
+// returned by the portfolio updateReconciliationBreak endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeUpdateReconciliationBreakResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateReconciliationBreakResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateReconciliationBreakResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "updateReconciliationBreak", err)
+			}
+			err = ValidateUpdateReconciliationBreakResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "updateReconciliationBreak", err)
+			}
+			res := NewUpdateReconciliationBreakReconciliationBreakOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "updateReconciliationBreak", err)
+			}
+			return nil, NewUpdateReconciliationBreakBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "updateReconciliationBreak", err)
+			}
+			return nil, NewUpdateReconciliationBreakNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "updateReconciliationBreak", err)
+			}
+			return nil, NewUpdateReconciliationBreakUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "updateReconciliationBreak", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetTaxReportRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "getTaxReport" endpoint
+func (c *Client) BuildGetTaxReportRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetTaxReportPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "getTaxReport", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetTaxReportRequest returns an encoder for requests sent to the
+// portfolio getTaxReport server.
+func EncodeGetTaxReportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.GetTaxReportPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "getTaxReport", "*portfolio.GetTaxReportPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("year", fmt.Sprintf("%v", p.Year))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetTaxReportResponse returns a decoder for responses returned by the
+// portfolio getTaxReport endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetTaxReportResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetTaxReportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetTaxReportResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getTaxReport", err)
+			}
+			err = ValidateGetTaxReportResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "getTaxReport", err)
+			}
+			res := NewGetTaxReportTaxReportOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getTaxReport", err)
+			}
+			return nil, NewGetTaxReportBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getTaxReport", err)
+			}
+			return nil, NewGetTaxReportNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "getTaxReport", err)
+			}
+			return nil, NewGetTaxReportUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "getTaxReport", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildExportTaxReportRequest instantiates a HTTP request object with method
+// and path set to call the "portfolio" service "exportTaxReport" endpoint
+func (c *Client) BuildExportTaxReportRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExportTaxReportPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "exportTaxReport", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExportTaxReportRequest returns an encoder for requests sent to the
+// portfolio exportTaxReport server.
+func EncodeExportTaxReportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ExportTaxReportPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "exportTaxReport", "*portfolio.ExportTaxReportPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("year", fmt.Sprintf("%v", p.Year))
+		values.Add("format", p.Format)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeExportTaxReportResponse returns a decoder for responses returned by
+// the portfolio exportTaxReport endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeExportTaxReportResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeExportTaxReportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportTaxReport", err)
+			}
+			var (
+				contentDisposition string
+			)
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "exportTaxReport", err)
+			}
+			res := NewExportTaxReportExportFileOK(body, contentDisposition)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportTaxReport", err)
+			}
+			return nil, NewExportTaxReportBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportTaxReport", err)
+			}
+			return nil, NewExportTaxReportNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "exportTaxReport", err)
+			}
+			return nil, NewExportTaxReportUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "exportTaxReport", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListLotsRequest instantiates a HTTP request object with method and path
+// set to call the "portfolio" service "listLots" endpoint
+func (c *Client) BuildListLotsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListLotsPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "listLots", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListLotsRequest returns an encoder for requests sent to the portfolio
+// listLots server.
+func EncodeListLotsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ListLotsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "listLots", "*portfolio.ListLotsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		if p.Account != nil {
+			values.Add("account", *p.Account)
+		}
+		if p.Symbol != nil {
+			values.Add("symbol", *p.Symbol)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListLotsResponse returns a decoder for responses returned by the
+// portfolio listLots endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListLotsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListLotsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListLotsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listLots", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateTaxLotResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "listLots", err)
+			}
+			res := NewListLotsTaxLotOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listLots", err)
+			}
+			return nil, NewListLotsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listLots", err)
+			}
+			return nil, NewListLotsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listLots", err)
+			}
+			return nil, NewListLotsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "listLots", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildFindTaxLossHarvestsRequest instantiates a HTTP request object with
+// method and path set to call the "portfolio" service "findTaxLossHarvests"
+// endpoint
+func (c *Client) BuildFindTaxLossHarvestsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: FindTaxLossHarvestsPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "findTaxLossHarvests", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeFindTaxLossHarvestsRequest returns an encoder for requests sent to the
+// portfolio findTaxLossHarvests server.
+func EncodeFindTaxLossHarvestsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.FindTaxLossHarvestsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "findTaxLossHarvests", "*portfolio.FindTaxLossHarvestsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		if p.Account != nil {
+			values.Add("account", *p.Account)
+		}
+		values.Add("min_loss", fmt.Sprintf("%v", p.MinLoss))
+		values.Add("short_term_rate", fmt.Sprintf("%v", p.ShortTermRate))
+		values.Add("long_term_rate", fmt.Sprintf("%v", p.LongTermRate))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeFindTaxLossHarvestsResponse returns a decoder for responses returned
+// by the portfolio findTaxLossHarvests endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeFindTaxLossHarvestsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeFindTaxLossHarvestsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body FindTaxLossHarvestsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "findTaxLossHarvests", err)
+			}
+			err = ValidateFindTaxLossHarvestsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "findTaxLossHarvests", err)
+			}
+			res := NewFindTaxLossHarvestsTaxLossHarvestOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "findTaxLossHarvests", err)
+			}
+			return nil, NewFindTaxLossHarvestsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "findTaxLossHarvests", err)
+			}
+			return nil, NewFindTaxLossHarvestsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "findTaxLossHarvests", err)
+			}
+			return nil, NewFindTaxLossHarvestsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "findTaxLossHarvests", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListAccountsRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "listAccounts" endpoint
+func (c *Client) BuildListAccountsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAccountsPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "listAccounts", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListAccountsRequest returns an encoder for requests sent to the
+// portfolio listAccounts server.
+func EncodeListAccountsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.ListAccountsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "listAccounts", "*portfolio.ListAccountsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListAccountsResponse returns a decoder for responses returned by the
+// portfolio listAccounts endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListAccountsResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListAccountsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListAccountsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listAccounts", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateAccountResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "listAccounts", err)
+			}
+			res := NewListAccountsAccountOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listAccounts", err)
+			}
+			return nil, NewListAccountsBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listAccounts", err)
+			}
+			return nil, NewListAccountsNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "listAccounts", err)
+			}
+			return nil, NewListAccountsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "listAccounts", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetAccountRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "setAccount" endpoint
+func (c *Client) BuildSetAccountRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetAccountPortfolioPath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "setAccount", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetAccountRequest returns an encoder for requests sent to the
+// portfolio setAccount server.
+func EncodeSetAccountRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.SetAccountPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "setAccount", "*portfolio.SetAccountPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		req.URL.RawQuery = values.Encode()
+		body := NewSetAccountRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("portfolio", "setAccount", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetAccountResponse returns a decoder for responses returned by the
+// portfolio setAccount endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeSetAccountResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSetAccountResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetAccountResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setAccount", err)
+			}
+			err = ValidateSetAccountResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "setAccount", err)
+			}
+			res := NewSetAccountAccountOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setAccount", err)
+			}
+			return nil, NewSetAccountBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setAccount", err)
+			}
+			return nil, NewSetAccountNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "setAccount", err)
+			}
+			return nil, NewSetAccountUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "setAccount", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRemoveAccountRequest instantiates a HTTP request object with method and
+// path set to call the "portfolio" service "removeAccount" endpoint
+func (c *Client) BuildRemoveAccountRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RemoveAccountPortfolioPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "removeAccount", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRemoveAccountRequest returns an encoder for requests sent to the
+// portfolio removeAccount server.
+func EncodeRemoveAccountRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*portfolio.RemoveAccountPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("portfolio", "removeAccount", "*portfolio.RemoveAccountPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("portfolio_id", p.PortfolioID)
+		values.Add("id", p.ID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeRemoveAccountResponse returns a decoder for responses returned by the
+// portfolio removeAccount endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRemoveAccountResponse may return the following errors:
+//   - "bad_request" (type portfolio.BadRequest): http.StatusBadRequest
+//   - "not_found" (type portfolio.NotFound): http.StatusNotFound
+//   - "unauthorized" (type portfolio.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRemoveAccountResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RemoveAccountResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "removeAccount", err)
+			}
+			err = ValidateRemoveAccountResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "removeAccount", err)
+			}
+			res := NewRemoveAccountAccountOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "removeAccount", err)
+			}
+			return nil, NewRemoveAccountBadRequest(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "removeAccount", err)
+			}
+			return nil, NewRemoveAccountNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "removeAccount", err)
+			}
+			return nil, NewRemoveAccountUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "removeAccount", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalAllocationBucketResponseBodyToPortfolioAllocationBucket builds a
+// value of type *portfolio.AllocationBucket from a value of type
+// *AllocationBucketResponseBody.
+func unmarshalAllocationBucketResponseBodyToPortfolioAllocationBucket(v *AllocationBucketResponseBody) *portfolio.AllocationBucket {
+	res := &portfolio.AllocationBucket{
+		Key:    *v.Key,
+		Value:  *v.Value,
+		Weight: *v.Weight,
+	}
+	res.Symbols = make([]string, len(v.Symbols))
+	for i, val := range v.Symbols {
+		res.Symbols[i] = val
+	}
+
+	return res
+}
+
+// unmarshalTargetWeightResponseBodyToPortfolioTargetWeight builds a value of
+// type *portfolio.TargetWeight from a value of type *TargetWeightResponseBody.
+func unmarshalTargetWeightResponseBodyToPortfolioTargetWeight(v *TargetWeightResponseBody) *portfolio.TargetWeight {
+	res := &portfolio.TargetWeight{
+		Symbol: *v.Symbol,
+		Weight: *v.Weight,
+	}
+	if v.Tolerance != nil {
+		res.Tolerance = *v.Tolerance
+	}
+	if v.Tolerance == nil {
+		res.Tolerance = 0.05
+	}
+
+	return res
+}
+
+// marshalPortfolioTargetWeightToTargetWeightRequestBodyRequestBody builds a
+// value of type *TargetWeightRequestBodyRequestBody from a value of type
+// *portfolio.TargetWeight.
+func marshalPortfolioTargetWeightToTargetWeightRequestBodyRequestBody(v *portfolio.TargetWeight) *TargetWeightRequestBodyRequestBody {
+	res := &TargetWeightRequestBodyRequestBody{
+		Symbol:    v.Symbol,
+		Weight:    v.Weight,
+		Tolerance: v.Tolerance,
+	}
+	{
+		var zero float64
+		if res.Tolerance == zero {
+			res.Tolerance = 0.05
+		}
+	}
+
+	return res
+}
+
+// marshalTargetWeightRequestBodyRequestBodyToPortfolioTargetWeight builds a
+// value of type *portfolio.TargetWeight from a value of type
+// *TargetWeightRequestBodyRequestBody.
+func marshalTargetWeightRequestBodyRequestBodyToPortfolioTargetWeight(v *TargetWeightRequestBodyRequestBody) *portfolio.TargetWeight {
+	res := &portfolio.TargetWeight{
+		Symbol:    v.Symbol,
+		Weight:    v.Weight,
+		Tolerance: v.Tolerance,
+	}
+	{
+		var zero float64
+		if res.Tolerance == zero {
+			res.Tolerance = 0.05
+		}
+	}
+
+	return res
+}
+
+// unmarshalProposedTradeResponseBodyToPortfolioProposedTrade builds a value of
+// type *portfolio.ProposedTrade from a value of type
+// *ProposedTradeResponseBody.
+func unmarshalProposedTradeResponseBodyToPortfolioProposedTrade(v *ProposedTradeResponseBody) *portfolio.ProposedTrade {
+	res := &portfolio.ProposedTrade{
+		Symbol:          *v.Symbol,
+		Side:            *v.Side,
+		Quantity:        *v.Quantity,
+		Price:           *v.Price,
+		Value:           *v.Value,
+		CurrentWeight:   *v.CurrentWeight,
+		TargetWeight:    *v.TargetWeight,
+		ProjectedWeight: *v.ProjectedWeight,
+	}
+
+	return res
+}
+
+// marshalPortfolioBenchmarkComponentToBenchmarkComponentRequestBodyRequestBody
+// builds a value of type *BenchmarkComponentRequestBodyRequestBody from a
+// value of type *portfolio.BenchmarkComponent.
+func marshalPortfolioBenchmarkComponentToBenchmarkComponentRequestBodyRequestBody(v *portfolio.BenchmarkComponent) *BenchmarkComponentRequestBodyRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &BenchmarkComponentRequestBodyRequestBody{
+		Symbol: v.Symbol,
+		Weight: v.Weight,
+	}
+
+	return res
+}
+
+// marshalBenchmarkComponentRequestBodyRequestBodyToPortfolioBenchmarkComponent
+// builds a value of type *portfolio.BenchmarkComponent from a value of type
+// *BenchmarkComponentRequestBodyRequestBody.
+func marshalBenchmarkComponentRequestBodyRequestBodyToPortfolioBenchmarkComponent(v *BenchmarkComponentRequestBodyRequestBody) *portfolio.BenchmarkComponent {
+	if v == nil {
+		return nil
+	}
+	res := &portfolio.BenchmarkComponent{
+		Symbol: v.Symbol,
+		Weight: v.Weight,
+	}
+
+	return res
+}
+
+// unmarshalBenchmarkComponentResponseBodyToPortfolioBenchmarkComponent builds
+// a value of type *portfolio.BenchmarkComponent from a value of type
+// *BenchmarkComponentResponseBody.
+func unmarshalBenchmarkComponentResponseBodyToPortfolioBenchmarkComponent(v *BenchmarkComponentResponseBody) *portfolio.BenchmarkComponent {
+	if v == nil {
+		return nil
+	}
+	res := &portfolio.BenchmarkComponent{
+		Symbol: *v.Symbol,
+		Weight: *v.Weight,
+	}
+
+	return res
+}
+
+// unmarshalBenchmarkDefinitionResponseBodyToPortfolioBenchmarkDefinition
+// builds a value of type *portfolio.BenchmarkDefinition from a value of type
+// *BenchmarkDefinitionResponseBody.
+func unmarshalBenchmarkDefinitionResponseBodyToPortfolioBenchmarkDefinition(v *BenchmarkDefinitionResponseBody) *portfolio.BenchmarkDefinition {
+	res := &portfolio.BenchmarkDefinition{
+		Name:      *v.Name,
+		Blend:     v.Blend,
+		Rebalance: *v.Rebalance,
+	}
+	if v.Components != nil {
+		res.Components = make([]*portfolio.BenchmarkComponent, len(v.Components))
+		for i, val := range v.Components {
+			if val == nil {
+				res.Components[i] = nil
+				continue
+			}
+			res.Components[i] = unmarshalBenchmarkComponentResponseBodyToPortfolioBenchmarkComponent(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalBenchmarkComparisonPointResponseBodyToPortfolioBenchmarkComparisonPoint
+// builds a value of type *portfolio.BenchmarkComparisonPoint from a value of
+// type *BenchmarkComparisonPointResponseBody.
+func unmarshalBenchmarkComparisonPointResponseBodyToPortfolioBenchmarkComparisonPoint(v *BenchmarkComparisonPointResponseBody) *portfolio.BenchmarkComparisonPoint {
+	res := &portfolio.BenchmarkComparisonPoint{
+		Date:      *v.Date,
+		Portfolio: *v.Portfolio,
+		Benchmark: *v.Benchmark,
+	}
+
+	return res
+}
+
+// unmarshalRiskWindowResponseBodyToPortfolioRiskWindow builds a value of type
+// *portfolio.RiskWindow from a value of type *RiskWindowResponseBody.
+func unmarshalRiskWindowResponseBodyToPortfolioRiskWindow(v *RiskWindowResponseBody) *portfolio.RiskWindow {
+	res := &portfolio.RiskWindow{
+		Start:        *v.Start,
+		End:          *v.End,
+		Volatility:   *v.Volatility,
+		SharpeRatio:  *v.SharpeRatio,
+		SortinoRatio: *v.SortinoRatio,
+		Beta:         v.Beta,
+		Correlation:  v.Correlation,
+		MaxDrawdown:  *v.MaxDrawdown,
+	}
+
+	return res
+}
+
+// unmarshalVaRContributionResponseBodyToPortfolioVaRContribution builds a
+// value of type *portfolio.VaRContribution from a value of type
+// *VaRContributionResponseBody.
+func unmarshalVaRContributionResponseBodyToPortfolioVaRContribution(v *VaRContributionResponseBody) *portfolio.VaRContribution {
+	res := &portfolio.VaRContribution{
+		Symbol:       *v.Symbol,
+		Value:        *v.Value,
+		MarginalVar:  *v.MarginalVar,
+		ComponentVar: *v.ComponentVar,
+	}
+
+	return res
+}
+
+// unmarshalStressScenarioResponseToPortfolioStressScenario builds a value of
+// type *portfolio.StressScenario from a value of type *StressScenarioResponse.
+func unmarshalStressScenarioResponseToPortfolioStressScenario(v *StressScenarioResponse) *portfolio.StressScenario {
+	res := &portfolio.StressScenario{
+		Name:        *v.Name,
+		Description: *v.Description,
+		RateShift:   *v.RateShift,
+		ReplayStart: v.ReplayStart,
+		ReplayEnd:   v.ReplayEnd,
+	}
+	if v.AssetClasses != nil {
+		res.AssetClasses = make(map[string]float64, len(v.AssetClasses))
+		for key, val := range v.AssetClasses {
+			tk := key
+			tv := val
+			res.AssetClasses[tk] = tv
+		}
+	}
+	if v.Symbols != nil {
+		res.Symbols = make(map[string]float64, len(v.Symbols))
+		for key, val := range v.Symbols {
+			tk := key
+			tv := val
+			res.Symbols[tk] = tv
+		}
+	}
+	if v.Currencies != nil {
+		res.Currencies = make(map[string]float64, len(v.Currencies))
+		for key, val := range v.Currencies {
+			tk := key
+			tv := val
+			res.Currencies[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalStressScenarioResponseBodyToPortfolioStressScenario builds a value
+// of type *portfolio.StressScenario from a value of type
+// *StressScenarioResponseBody.
+func unmarshalStressScenarioResponseBodyToPortfolioStressScenario(v *StressScenarioResponseBody) *portfolio.StressScenario {
+	res := &portfolio.StressScenario{
+		Name:        *v.Name,
+		Description: *v.Description,
+		RateShift:   *v.RateShift,
+		ReplayStart: v.ReplayStart,
+		ReplayEnd:   v.ReplayEnd,
+	}
+	if v.AssetClasses != nil {
+		res.AssetClasses = make(map[string]float64, len(v.AssetClasses))
+		for key, val := range v.AssetClasses {
+			tk := key
+			tv := val
+			res.AssetClasses[tk] = tv
+		}
+	}
+	if v.Symbols != nil {
+		res.Symbols = make(map[string]float64, len(v.Symbols))
+		for key, val := range v.Symbols {
+			tk := key
+			tv := val
+			res.Symbols[tk] = tv
+		}
+	}
+	if v.Currencies != nil {
+		res.Currencies = make(map[string]float64, len(v.Currencies))
+		for key, val := range v.Currencies {
+			tk := key
+			tv := val
+			res.Currencies[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalStressImpactResponseBodyToPortfolioStressImpact builds a value of
+// type *portfolio.StressImpact from a value of type *StressImpactResponseBody.
+func unmarshalStressImpactResponseBodyToPortfolioStressImpact(v *StressImpactResponseBody) *portfolio.StressImpact {
+	res := &portfolio.StressImpact{
+		Symbol:         *v.Symbol,
+		AssetClass:     *v.AssetClass,
+		Value:          *v.Value,
+		ProjectedValue: *v.ProjectedValue,
+		Pnl:            *v.Pnl,
+		Shock:          *v.Shock,
+	}
+
+	return res
+}
+
+// marshalPortfolioAssetClassAssumptionToAssetClassAssumptionRequestBody builds
+// a value of type *AssetClassAssumptionRequestBody from a value of type
+// *portfolio.AssetClassAssumption.
+func marshalPortfolioAssetClassAssumptionToAssetClassAssumptionRequestBody(v *portfolio.AssetClassAssumption) *AssetClassAssumptionRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &AssetClassAssumptionRequestBody{
+		AssetClass:     v.AssetClass,
+		Weight:         v.Weight,
+		ExpectedReturn: v.ExpectedReturn,
+		Volatility:     v.Volatility,
+	}
+
+	return res
+}
+
+// marshalAssetClassAssumptionRequestBodyToPortfolioAssetClassAssumption builds
+// a value of type *portfolio.AssetClassAssumption from a value of type
+// *AssetClassAssumptionRequestBody.
+func marshalAssetClassAssumptionRequestBodyToPortfolioAssetClassAssumption(v *AssetClassAssumptionRequestBody) *portfolio.AssetClassAssumption {
+	if v == nil {
+		return nil
+	}
+	res := &portfolio.AssetClassAssumption{
+		AssetClass:     v.AssetClass,
+		Weight:         v.Weight,
+		ExpectedReturn: v.ExpectedReturn,
+		Volatility:     v.Volatility,
+	}
+
+	return res
+}
+
+// unmarshalAssetClassAssumptionResponseBodyToPortfolioAssetClassAssumption
+// builds a value of type *portfolio.AssetClassAssumption from a value of type
+// *AssetClassAssumptionResponseBody.
+func unmarshalAssetClassAssumptionResponseBodyToPortfolioAssetClassAssumption(v *AssetClassAssumptionResponseBody) *portfolio.AssetClassAssumption {
+	res := &portfolio.AssetClassAssumption{
+		AssetClass:     *v.AssetClass,
+		Weight:         v.Weight,
+		ExpectedReturn: v.ExpectedReturn,
+		Volatility:     v.Volatility,
+	}
+
+	return res
+}
+
+// unmarshalProjectionBandResponseBodyToPortfolioProjectionBand builds a value
+// of type *portfolio.ProjectionBand from a value of type
+// *ProjectionBandResponseBody.
+func unmarshalProjectionBandResponseBodyToPortfolioProjectionBand(v *ProjectionBandResponseBody) *portfolio.ProjectionBand {
+	res := &portfolio.ProjectionBand{
+		Date: *v.Date,
+		P5:   *v.P5,
+		P25:  *v.P25,
+		P50:  *v.P50,
+		P75:  *v.P75,
+		P95:  *v.P95,
+	}
+
+	return res
+}
+
+// unmarshalCorporateActionResponseToPortfolioCorporateAction builds a value of
+// type *portfolio.CorporateAction from a value of type
+// *CorporateActionResponse.
+func unmarshalCorporateActionResponseToPortfolioCorporateAction(v *CorporateActionResponse) *portfolio.CorporateAction {
+	res := &portfolio.CorporateAction{
+		ID:        *v.ID,
+		Date:      *v.Date,
+		Type:      *v.Type,
+		Symbol:    *v.Symbol,
+		NewSymbol: v.NewSymbol,
+		Note:      v.Note,
+	}
+	if v.Ratio != nil {
+		res.Ratio = *v.Ratio
+	}
+	if v.CashPerShare != nil {
+		res.CashPerShare = *v.CashPerShare
+	}
+	if v.BasisFraction != nil {
+		res.BasisFraction = *v.BasisFraction
+	}
+	if v.Price != nil {
+		res.Price = *v.Price
+	}
+	if v.CashInLieu != nil {
+		res.CashInLieu = *v.CashInLieu
+	}
+	if v.Ratio == nil {
+		res.Ratio = 0
+	}
+	if v.CashPerShare == nil {
+		res.CashPerShare = 0
+	}
+	if v.BasisFraction == nil {
+		res.BasisFraction = 0
+	}
+	if v.Price == nil {
+		res.Price = 0
+	}
+	if v.CashInLieu == nil {
+		res.CashInLieu = false
+	}
+
+	return res
+}
+
+// marshalPortfolioCorporateActionInputToCorporateActionInputRequestBody builds
+// a value of type *CorporateActionInputRequestBody from a value of type
+// *portfolio.CorporateActionInput.
+func marshalPortfolioCorporateActionInputToCorporateActionInputRequestBody(v *portfolio.CorporateActionInput) *CorporateActionInputRequestBody {
+	res := &CorporateActionInputRequestBody{
+		Date:          v.Date,
+		Type:          v.Type,
+		Symbol:        v.Symbol,
+		NewSymbol:     v.NewSymbol,
+		Ratio:         v.Ratio,
+		CashPerShare:  v.CashPerShare,
+		BasisFraction: v.BasisFraction,
+		Price:         v.Price,
+		CashInLieu:    v.CashInLieu,
+		Note:          v.Note,
+	}
+	{
+		var zero float64
+		if res.Ratio == zero {
+			res.Ratio = 0
+		}
+	}
+	{
+		var zero float64
+		if res.CashPerShare == zero {
+			res.CashPerShare = 0
+		}
+	}
+	{
+		var zero float64
+		if res.BasisFraction == zero {
+			res.BasisFraction = 0
+		}
+	}
+	{
+		var zero float64
+		if res.Price == zero {
+			res.Price = 0
+		}
+	}
+	{
+		var zero bool
+		if res.CashInLieu == zero {
+			res.CashInLieu = false
+		}
+	}
+
+	return res
+}
+
+// marshalCorporateActionInputRequestBodyToPortfolioCorporateActionInput builds
+// a value of type *portfolio.CorporateActionInput from a value of type
+// *CorporateActionInputRequestBody.
+func marshalCorporateActionInputRequestBodyToPortfolioCorporateActionInput(v *CorporateActionInputRequestBody) *portfolio.CorporateActionInput {
+	res := &portfolio.CorporateActionInput{
+		Date:          v.Date,
+		Type:          v.Type,
+		Symbol:        v.Symbol,
+		NewSymbol:     v.NewSymbol,
+		Ratio:         v.Ratio,
+		CashPerShare:  v.CashPerShare,
+		BasisFraction: v.BasisFraction,
+		Price:         v.Price,
+		CashInLieu:    v.CashInLieu,
+		Note:          v.Note,
+	}
+	{
+		var zero float64
+		if res.Ratio == zero {
+			res.Ratio = 0
+		}
+	}
+	{
+		var zero float64
+		if res.CashPerShare == zero {
+			res.CashPerShare = 0
+		}
+	}
+	{
+		var zero float64
+		if res.BasisFraction == zero {
+			res.BasisFraction = 0
+		}
+	}
+	{
+		var zero float64
+		if res.Price == zero {
+			res.Price = 0
+		}
+	}
+	{
+		var zero bool
+		if res.CashInLieu == zero {
+			res.CashInLieu = false
+		}
+	}
+
+	return res
+}
+
+// unmarshalIncomeBucketResponseBodyToPortfolioIncomeBucket builds a value of
+// type *portfolio.IncomeBucket from a value of type *IncomeBucketResponseBody.
+func unmarshalIncomeBucketResponseBodyToPortfolioIncomeBucket(v *IncomeBucketResponseBody) *portfolio.IncomeBucket {
+	res := &portfolio.IncomeBucket{
+		Key:         *v.Key,
+		Gross:       *v.Gross,
+		Withholding: *v.Withholding,
+		Net:         *v.Net,
+	}
+
+	return res
+}
+
+// unmarshalIncomeForecastResponseBodyToPortfolioIncomeForecast builds a value
+// of type *portfolio.IncomeForecast from a value of type
+// *IncomeForecastResponseBody.
+func unmarshalIncomeForecastResponseBodyToPortfolioIncomeForecast(v *IncomeForecastResponseBody) *portfolio.IncomeForecast {
+	res := &portfolio.IncomeForecast{
+		Month:  *v.Month,
+		Amount: *v.Amount,
+	}
+
+	return res
+}
+
+// unmarshalBondPositionResponseToPortfolioBondPosition builds a value of type
+// *portfolio.BondPosition from a value of type *BondPositionResponse.
+func unmarshalBondPositionResponseToPortfolioBondPosition(v *BondPositionResponse) *portfolio.BondPosition {
+	res := &portfolio.BondPosition{
+		Symbol:          *v.Symbol,
+		Quantity:        *v.Quantity,
+		Coupon:          *v.Coupon,
+		CouponFrequency: *v.CouponFrequency,
+		DayCount:        *v.DayCount,
+		Maturity:        *v.Maturity,
+		FaceValue:       *v.FaceValue,
+		MarketValue:     *v.MarketValue,
+		AccruedValue:    *v.AccruedValue,
+	}
+	res.Analytics = unmarshalBondAnalyticsResponseToPortfolioBondAnalytics(v.Analytics)
+
+	return res
+}
+
+// unmarshalBondAnalyticsResponseToPortfolioBondAnalytics builds a value of
+// type *portfolio.BondAnalytics from a value of type *BondAnalyticsResponse.
+func unmarshalBondAnalyticsResponseToPortfolioBondAnalytics(v *BondAnalyticsResponse) *portfolio.BondAnalytics {
+	res := &portfolio.BondAnalytics{
+		Settlement:       *v.Settlement,
+		CleanPrice:       *v.CleanPrice,
+		DirtyPrice:       *v.DirtyPrice,
+		AccruedInterest:  *v.AccruedInterest,
+		YieldToMaturity:  *v.YieldToMaturity,
+		MacaulayDuration: *v.MacaulayDuration,
+		ModifiedDuration: *v.ModifiedDuration,
+	}
+
+	return res
+}
+
+// unmarshalBondCashFlowResponseBodyToPortfolioBondCashFlow builds a value of
+// type *portfolio.BondCashFlow from a value of type *BondCashFlowResponseBody.
+func unmarshalBondCashFlowResponseBodyToPortfolioBondCashFlow(v *BondCashFlowResponseBody) *portfolio.BondCashFlow {
+	res := &portfolio.BondCashFlow{
+		Date:      *v.Date,
+		Symbol:    *v.Symbol,
+		Coupon:    *v.Coupon,
+		Principal: *v.Principal,
+		Total:     *v.Total,
+	}
+
+	return res
+}
+
+// unmarshalCashFlowLadderYearResponseBodyToPortfolioCashFlowLadderYear builds
+// a value of type *portfolio.CashFlowLadderYear from a value of type
+// *CashFlowLadderYearResponseBody.
+func unmarshalCashFlowLadderYearResponseBodyToPortfolioCashFlowLadderYear(v *CashFlowLadderYearResponseBody) *portfolio.CashFlowLadderYear {
+	res := &portfolio.CashFlowLadderYear{
+		Year:      *v.Year,
+		Coupon:    *v.Coupon,
+		Principal: *v.Principal,
+		Total:     *v.Total,
+	}
+
+	return res
+}
+
+// unmarshalOptionPositionResponseBodyToPortfolioOptionPosition builds a value
+// of type *portfolio.OptionPosition from a value of type
+// *OptionPositionResponseBody.
+func unmarshalOptionPositionResponseBodyToPortfolioOptionPosition(v *OptionPositionResponseBody) *portfolio.OptionPosition {
+	res := &portfolio.OptionPosition{
+		Account:         *v.Account,
+		Symbol:          *v.Symbol,
+		Underlying:      *v.Underlying,
+		OptionType:      *v.OptionType,
+		Strike:          *v.Strike,
+		Expiry:          *v.Expiry,
+		Multiplier:      *v.Multiplier,
+		FuturesOption:   *v.FuturesOption,
+		Quantity:        *v.Quantity,
+		UnderlyingPrice: *v.UnderlyingPrice,
+		Volatility:      *v.Volatility,
+		Price:           *v.Price,
+		MarketValue:     *v.MarketValue,
+		Delta:           *v.Delta,
+		Gamma:           *v.Gamma,
+		Theta:           *v.Theta,
+		Vega:            *v.Vega,
+	}
+
+	return res
+}
+
+// unmarshalUnderlyingExposureResponseBodyToPortfolioUnderlyingExposure builds
+// a value of type *portfolio.UnderlyingExposure from a value of type
+// *UnderlyingExposureResponseBody.
+func unmarshalUnderlyingExposureResponseBodyToPortfolioUnderlyingExposure(v *UnderlyingExposureResponseBody) *portfolio.UnderlyingExposure {
+	res := &portfolio.UnderlyingExposure{
+		Underlying:            *v.Underlying,
+		Price:                 *v.Price,
+		Shares:                *v.Shares,
+		OptionDelta:           *v.OptionDelta,
+		DeltaAdjustedShares:   *v.DeltaAdjustedShares,
+		DeltaAdjustedExposure: *v.DeltaAdjustedExposure,
+	}
+
+	return res
+}
+
+// unmarshalOptionSettlementResponseToPortfolioOptionSettlement builds a value
+// of type *portfolio.OptionSettlement from a value of type
+// *OptionSettlementResponse.
+func unmarshalOptionSettlementResponseToPortfolioOptionSettlement(v *OptionSettlementResponse) *portfolio.OptionSettlement {
+	res := &portfolio.OptionSettlement{
+		ID:       *v.ID,
+		Date:     *v.Date,
+		Account:  *v.Account,
+		Symbol:   *v.Symbol,
+		Type:     *v.Type,
+		Quantity: *v.Quantity,
+		Price:    *v.Price,
+		Note:     *v.Note,
+	}
+
+	return res
+}
+
+// unmarshalMarginAccountStatusResponseBodyToPortfolioMarginAccountStatus
+// builds a value of type *portfolio.MarginAccountStatus from a value of type
+// *MarginAccountStatusResponseBody.
+func unmarshalMarginAccountStatusResponseBodyToPortfolioMarginAccountStatus(v *MarginAccountStatusResponseBody) *portfolio.MarginAccountStatus {
+	res := &portfolio.MarginAccountStatus{
+		Account:                *v.Account,
+		Cash:                   *v.Cash,
+		LongValue:              *v.LongValue,
+		ShortValue:             *v.ShortValue,
+		ShortProceeds:          *v.ShortProceeds,
+		Equity:                 *v.Equity,
+		LoanBalance:            *v.LoanBalance,
+		InitialRequirement:     *v.InitialRequirement,
+		MaintenanceRequirement: *v.MaintenanceRequirement,
+		MaintenanceExcess:      *v.MaintenanceExcess,
+		BuyingPower:            *v.BuyingPower,
+		MarginCall:             *v.MarginCall,
+		InterestCharged:        *v.InterestCharged,
+		BorrowFeesCharged:      *v.BorrowFeesCharged,
+		AccruedInterest:        *v.AccruedInterest,
+		AccruedBorrowFees:      *v.AccruedBorrowFees,
+	}
+
+	return res
+}
+
+// unmarshalPortfolioEventResponseToPortfolioPortfolioEvent builds a value of
+// type *portfolio.PortfolioEvent from a value of type *PortfolioEventResponse.
+func unmarshalPortfolioEventResponseToPortfolioPortfolioEvent(v *PortfolioEventResponse) *portfolio.PortfolioEvent {
+	res := &portfolio.PortfolioEvent{
+		ID:          *v.ID,
+		Time:        *v.Time,
+		PortfolioID: *v.PortfolioID,
+		Type:        *v.Type,
+		Account:     v.Account,
+		Message:     *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalOrderResponseToPortfolioOrder builds a value of type
+// *portfolio.Order from a value of type *OrderResponse.
+func unmarshalOrderResponseToPortfolioOrder(v *OrderResponse) *portfolio.Order {
+	res := &portfolio.Order{
+		ID:          *v.ID,
+		Account:     *v.Account,
+		Symbol:      *v.Symbol,
+		Side:        *v.Side,
+		Type:        *v.Type,
+		Quantity:    *v.Quantity,
+		LimitPrice:  v.LimitPrice,
+		StopPrice:   v.StopPrice,
+		TimeInForce: *v.TimeInForce,
+		Status:      *v.Status,
+		Triggered:   *v.Triggered,
+		Created:     *v.Created,
+		Updated:     *v.Updated,
+		FillPrice:   *v.FillPrice,
+		Commission:  *v.Commission,
+		Reason:      v.Reason,
+	}
+
+	return res
+}
+
+// marshalPortfolioTradeInputToTradeInputRequestBody builds a value of type
+// *TradeInputRequestBody from a value of type *portfolio.TradeInput.
+func marshalPortfolioTradeInputToTradeInputRequestBody(v *portfolio.TradeInput) *TradeInputRequestBody {
+	res := &TradeInputRequestBody{
+		Account:  v.Account,
+		Symbol:   v.Symbol,
+		Side:     v.Side,
+		Quantity: v.Quantity,
+		Price:    v.Price,
+		Fee:      v.Fee,
+	}
+	{
+		var zero float64
+		if res.Fee == zero {
+			res.Fee = 0
+		}
+	}
+
+	return res
+}
+
+// marshalTradeInputRequestBodyToPortfolioTradeInput builds a value of type
+// *portfolio.TradeInput from a value of type *TradeInputRequestBody.
+func marshalTradeInputRequestBodyToPortfolioTradeInput(v *TradeInputRequestBody) *portfolio.TradeInput {
+	res := &portfolio.TradeInput{
+		Account:  v.Account,
+		Symbol:   v.Symbol,
+		Side:     v.Side,
+		Quantity: v.Quantity,
+		Price:    v.Price,
+		Fee:      v.Fee,
+	}
+	{
+		var zero float64
+		if res.Fee == zero {
+			res.Fee = 0
+		}
+	}
+
+	return res
+}
+
+// unmarshalPortfolioSummaryResponseBodyToPortfolioPortfolioSummary builds a
+// value of type *portfolio.PortfolioSummary from a value of type
+// *PortfolioSummaryResponseBody.
+func unmarshalPortfolioSummaryResponseBodyToPortfolioPortfolioSummary(v *PortfolioSummaryResponseBody) *portfolio.PortfolioSummary {
+	res := &portfolio.PortfolioSummary{
+		Balance:       *v.Balance,
+		Currency:      *v.Currency,
+		ChangePercent: *v.ChangePercent,
+	}
+
+	return res
+}
+
+// unmarshalAllocationChangeResponseBodyToPortfolioAllocationChange builds a
+// value of type *portfolio.AllocationChange from a value of type
+// *AllocationChangeResponseBody.
+func unmarshalAllocationChangeResponseBodyToPortfolioAllocationChange(v *AllocationChangeResponseBody) *portfolio.AllocationChange {
+	res := &portfolio.AllocationChange{
+		Key:          *v.Key,
+		ValueBefore:  *v.ValueBefore,
+		WeightBefore: *v.WeightBefore,
+		ValueAfter:   *v.ValueAfter,
+		WeightAfter:  *v.WeightAfter,
+	}
+
+	return res
+}
+
+// unmarshalRealizedLotResponseBodyToPortfolioRealizedLot builds a value of
+// type *portfolio.RealizedLot from a value of type *RealizedLotResponseBody.
+func unmarshalRealizedLotResponseBodyToPortfolioRealizedLot(v *RealizedLotResponseBody) *portfolio.RealizedLot {
+	res := &portfolio.RealizedLot{
+		Account:   *v.Account,
+		Symbol:    *v.Symbol,
+		LotID:     *v.LotID,
+		Acquired:  *v.Acquired,
+		Quantity:  *v.Quantity,
+		Proceeds:  *v.Proceeds,
+		CostBasis: *v.CostBasis,
+		Gain:      *v.Gain,
+		LongTerm:  *v.LongTerm,
+	}
+
+	return res
+}
+
+// unmarshalHoldingsRiskResponseBodyToPortfolioHoldingsRisk builds a value of
+// type *portfolio.HoldingsRisk from a value of type *HoldingsRiskResponseBody.
+func unmarshalHoldingsRiskResponseBodyToPortfolioHoldingsRisk(v *HoldingsRiskResponseBody) *portfolio.HoldingsRisk {
+	res := &portfolio.HoldingsRisk{
+		Volatility:        *v.Volatility,
+		ValueAtRisk:       *v.ValueAtRisk,
+		ExpectedShortfall: *v.ExpectedShortfall,
+	}
+
+	return res
+}
+
+// marshalPortfolioBacktestTargetToBacktestTargetRequestBody builds a value of
+// type *BacktestTargetRequestBody from a value of type
+// *portfolio.BacktestTarget.
+func marshalPortfolioBacktestTargetToBacktestTargetRequestBody(v *portfolio.BacktestTarget) *BacktestTargetRequestBody {
+	res := &BacktestTargetRequestBody{
+		Symbol: v.Symbol,
+		Weight: v.Weight,
+	}
+
+	return res
+}
+
+// marshalBacktestTargetRequestBodyToPortfolioBacktestTarget builds a value of
+// type *portfolio.BacktestTarget from a value of type
+// *BacktestTargetRequestBody.
+func marshalBacktestTargetRequestBodyToPortfolioBacktestTarget(v *BacktestTargetRequestBody) *portfolio.BacktestTarget {
+	res := &portfolio.BacktestTarget{
+		Symbol: v.Symbol,
+		Weight: v.Weight,
+	}
+
+	return res
+}
+
+// unmarshalBacktestPointResponseBodyToPortfolioBacktestPoint builds a value of
+// type *portfolio.BacktestPoint from a value of type
+// *BacktestPointResponseBody.
+func unmarshalBacktestPointResponseBodyToPortfolioBacktestPoint(v *BacktestPointResponseBody) *portfolio.BacktestPoint {
+	res := &portfolio.BacktestPoint{
+		Date:     *v.Date,
+		Value:    *v.Value,
+		Flow:     *v.Flow,
+		Drawdown: *v.Drawdown,
+	}
+
+	return res
+}
+
+// unmarshalImportRowResponseBodyToPortfolioImportRow builds a value of type
+// *portfolio.ImportRow from a value of type *ImportRowResponseBody.
+func unmarshalImportRowResponseBodyToPortfolioImportRow(v *ImportRowResponseBody) *portfolio.ImportRow {
+	res := &portfolio.ImportRow{
+		Line:        *v.Line,
+		Status:      *v.Status,
+		Fingerprint: v.Fingerprint,
+		Error:       v.Error,
+	}
+	if v.Transaction != nil {
+		res.Transaction = unmarshalLedgerTransactionResponseBodyToPortfolioLedgerTransaction(v.Transaction)
+	}
+
+	return res
+}
+
+// unmarshalLedgerTransactionResponseBodyToPortfolioLedgerTransaction builds a
+// value of type *portfolio.LedgerTransaction from a value of type
+// *LedgerTransactionResponseBody.
+func unmarshalLedgerTransactionResponseBodyToPortfolioLedgerTransaction(v *LedgerTransactionResponseBody) *portfolio.LedgerTransaction {
+	if v == nil {
+		return nil
+	}
+	res := &portfolio.LedgerTransaction{
+		ID:       v.ID,
+		Date:     *v.Date,
+		Type:     *v.Type,
+		Account:  *v.Account,
+		Symbol:   *v.Symbol,
+		Quantity: *v.Quantity,
+		Price:    *v.Price,
+		Amount:   *v.Amount,
+		Fee:      *v.Fee,
+		Note:     v.Note,
+	}
+
+	return res
+}
+
+// unmarshalAccountReconciliationResponseBodyToPortfolioAccountReconciliation
+// builds a value of type *portfolio.AccountReconciliation from a value of type
+// *AccountReconciliationResponseBody.
+func unmarshalAccountReconciliationResponseBodyToPortfolioAccountReconciliation(v *AccountReconciliationResponseBody) *portfolio.AccountReconciliation {
+	if v == nil {
+		return nil
+	}
+	res := &portfolio.AccountReconciliation{
+		Account:       *v.Account,
+		AsOf:          *v.AsOf,
+		StatementCash: v.StatementCash,
+		LedgerCash:    *v.LedgerCash,
+		Matched:       *v.Matched,
+	}
+	res.Positions = make([]*portfolio.PositionCheck, len(v.Positions))
+	for i, val := range v.Positions {
+		if val == nil {
+			res.Positions[i] = nil
+			continue
+		}
+		res.Positions[i] = unmarshalPositionCheckResponseBodyToPortfolioPositionCheck(val)
+	}
+
+	return res
+}
+
+// unmarshalPositionCheckResponseBodyToPortfolioPositionCheck builds a value of
+// type *portfolio.PositionCheck from a value of type
+// *PositionCheckResponseBody.
+func unmarshalPositionCheckResponseBodyToPortfolioPositionCheck(v *PositionCheckResponseBody) *portfolio.PositionCheck {
+	res := &portfolio.PositionCheck{
+		Symbol:            *v.Symbol,
+		StatementQuantity: *v.StatementQuantity,
+		LedgerQuantity:    *v.LedgerQuantity,
+		Difference:        *v.Difference,
+		Matched:           *v.Matched,
+	}
+
+	return res
+}
+
+// unmarshalReconciledAccountResponseBodyToPortfolioReconciledAccount builds a
+// value of type *portfolio.ReconciledAccount from a value of type
+// *ReconciledAccountResponseBody.
+func unmarshalReconciledAccountResponseBodyToPortfolioReconciledAccount(v *ReconciledAccountResponseBody) *portfolio.ReconciledAccount {
+	res := &portfolio.ReconciledAccount{
+		Account:   *v.Account,
+		AsOf:      *v.AsOf,
+		Positions: *v.Positions,
+		Cash:      *v.Cash,
+		Breaks:    *v.Breaks,
+	}
+
+	return res
+}
+
+// unmarshalReconciliationBreakResponseBodyToPortfolioReconciliationBreak
+// builds a value of type *portfolio.ReconciliationBreak from a value of type
+// *ReconciliationBreakResponseBody.
+func unmarshalReconciliationBreakResponseBodyToPortfolioReconciliationBreak(v *ReconciliationBreakResponseBody) *portfolio.ReconciliationBreak {
+	res := &portfolio.ReconciliationBreak{
+		ID:         *v.ID,
+		Account:    *v.Account,
+		AsOf:       *v.AsOf,
+		Kind:       *v.Kind,
+		Symbol:     v.Symbol,
+		Statement:  *v.Statement,
+		Ledger:     *v.Ledger,
+		Difference: *v.Difference,
+		Price:      v.Price,
+		Status:     *v.Status,
+		Note:       v.Note,
+		Detected:   *v.Detected,
+		Updated:    *v.Updated,
+	}
+	res.Adjustments = make([]*portfolio.LedgerTransaction, len(v.Adjustments))
+	for i, val := range v.Adjustments {
+		if val == nil {
+			res.Adjustments[i] = nil
+			continue
+		}
+		res.Adjustments[i] = unmarshalLedgerTransactionResponseBodyToPortfolioLedgerTransaction(val)
+	}
+	if v.Posted != nil {
+		res.Posted = make([]string, len(v.Posted))
+		for i, val := range v.Posted {
+			res.Posted[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalReconciliationBreakResponseToPortfolioReconciliationBreak builds a
+// value of type *portfolio.ReconciliationBreak from a value of type
+// *ReconciliationBreakResponse.
+func unmarshalReconciliationBreakResponseToPortfolioReconciliationBreak(v *ReconciliationBreakResponse) *portfolio.ReconciliationBreak {
+	res := &portfolio.ReconciliationBreak{
+		ID:         *v.ID,
+		Account:    *v.Account,
+		AsOf:       *v.AsOf,
+		Kind:       *v.Kind,
+		Symbol:     v.Symbol,
+		Statement:  *v.Statement,
+		Ledger:     *v.Ledger,
+		Difference: *v.Difference,
+		Price:      v.Price,
+		Status:     *v.Status,
+		Note:       v.Note,
+		Detected:   *v.Detected,
+		Updated:    *v.Updated,
+	}
+	res.Adjustments = make([]*portfolio.LedgerTransaction, len(v.Adjustments))
+	for i, val := range v.Adjustments {
+		if val == nil {
+			res.Adjustments[i] = nil
+			continue
+		}
+		res.Adjustments[i] = unmarshalLedgerTransactionResponseToPortfolioLedgerTransaction(val)
+	}
+	if v.Posted != nil {
+		res.Posted = make([]string, len(v.Posted))
+		for i, val := range v.Posted {
+			res.Posted[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalLedgerTransactionResponseToPortfolioLedgerTransaction builds a
+// value of type *portfolio.LedgerTransaction from a value of type
+// *LedgerTransactionResponse.
+func unmarshalLedgerTransactionResponseToPortfolioLedgerTransaction(v *LedgerTransactionResponse) *portfolio.LedgerTransaction {
+	res := &portfolio.LedgerTransaction{
+		ID:       v.ID,
+		Date:     *v.Date,
+		Type:     *v.Type,
+		Account:  *v.Account,
+		Symbol:   *v.Symbol,
+		Quantity: *v.Quantity,
+		Price:    *v.Price,
+		Amount:   *v.Amount,
+		Fee:      *v.Fee,
+		Note:     v.Note,
+	}
+
+	return res
+}
+
+// unmarshalTaxAccountResponseBodyToPortfolioTaxAccount builds a value of type
+// *portfolio.TaxAccount from a value of type *TaxAccountResponseBody.
+func unmarshalTaxAccountResponseBodyToPortfolioTaxAccount(v *TaxAccountResponseBody) *portfolio.TaxAccount {
+	res := &portfolio.TaxAccount{
+		Account: *v.Account,
+	}
+	res.Lots = make([]*portfolio.TaxLotGain, len(v.Lots))
+	for i, val := range v.Lots {
+		if val == nil {
+			res.Lots[i] = nil
+			continue
+		}
+		res.Lots[i] = unmarshalTaxLotGainResponseBodyToPortfolioTaxLotGain(val)
+	}
+	res.ShortTerm = unmarshalTaxTotalsResponseBodyToPortfolioTaxTotals(v.ShortTerm)
+	res.LongTerm = unmarshalTaxTotalsResponseBodyToPortfolioTaxTotals(v.LongTerm)
+	res.Total = unmarshalTaxTotalsResponseBodyToPortfolioTaxTotals(v.Total)
+
+	return res
+}
+
+// unmarshalTaxLotGainResponseBodyToPortfolioTaxLotGain builds a value of type
+// *portfolio.TaxLotGain from a value of type *TaxLotGainResponseBody.
+func unmarshalTaxLotGainResponseBodyToPortfolioTaxLotGain(v *TaxLotGainResponseBody) *portfolio.TaxLotGain {
+	res := &portfolio.TaxLotGain{
+		Account:            *v.Account,
+		Symbol:             *v.Symbol,
+		LotID:              *v.LotID,
+		SaleID:             *v.SaleID,
+		Acquired:           *v.Acquired,
+		Disposed:           *v.Disposed,
+		Quantity:           *v.Quantity,
+		Proceeds:           *v.Proceeds,
+		CostBasis:          *v.CostBasis,
+		BasisAdjustment:    *v.BasisAdjustment,
+		WashSaleAdjustment: *v.WashSaleAdjustment,
+		Gain:               *v.Gain,
+		Term:               *v.Term,
+		ShortSale:          *v.ShortSale,
+	}
+
+	return res
+}
+
+// unmarshalTaxTotalsResponseBodyToPortfolioTaxTotals builds a value of type
+// *portfolio.TaxTotals from a value of type *TaxTotalsResponseBody.
+func unmarshalTaxTotalsResponseBodyToPortfolioTaxTotals(v *TaxTotalsResponseBody) *portfolio.TaxTotals {
+	res := &portfolio.TaxTotals{
+		Proceeds:           *v.Proceeds,
+		CostBasis:          *v.CostBasis,
+		WashSaleAdjustment: *v.WashSaleAdjustment,
+		Gain:               *v.Gain,
+	}
+
+	return res
+}
+
+// unmarshalWashSaleResponseBodyToPortfolioWashSale builds a value of type
+// *portfolio.WashSale from a value of type *WashSaleResponseBody.
+func unmarshalWashSaleResponseBodyToPortfolioWashSale(v *WashSaleResponseBody) *portfolio.WashSale {
+	res := &portfolio.WashSale{
+		SaleID:              *v.SaleID,
+		LotID:               *v.LotID,
+		Account:             *v.Account,
+		Symbol:              *v.Symbol,
+		Disposed:            *v.Disposed,
+		Quantity:            *v.Quantity,
+		Disallowed:          *v.Disallowed,
+		ReplacementLotID:    *v.ReplacementLotID,
+		ReplacementAccount:  *v.ReplacementAccount,
+		ReplacementSymbol:   *v.ReplacementSymbol,
+		ReplacementAcquired: *v.ReplacementAcquired,
+		Permanent:           *v.Permanent,
+	}
+
+	return res
+}
+
+// unmarshalTaxLotResponseToPortfolioTaxLot builds a value of type
+// *portfolio.TaxLot from a value of type *TaxLotResponse.
+func unmarshalTaxLotResponseToPortfolioTaxLot(v *TaxLotResponse) *portfolio.TaxLot {
+	res := &portfolio.TaxLot{
+		ID:              *v.ID,
+		Account:         *v.Account,
+		Symbol:          *v.Symbol,
+		Acquired:        *v.Acquired,
+		HoldingSince:    *v.HoldingSince,
+		Quantity:        *v.Quantity,
+		CostBasis:       *v.CostBasis,
+		BasisAdjustment: *v.BasisAdjustment,
+		UnitCost:        *v.UnitCost,
+		Term:            *v.Term,
+		Price:           v.Price,
+		MarketValue:     v.MarketValue,
+		UnrealizedGain:  v.UnrealizedGain,
+	}
+
+	return res
+}
+
+// unmarshalHarvestOpportunityResponseBodyToPortfolioHarvestOpportunity builds
+// a value of type *portfolio.HarvestOpportunity from a value of type
+// *HarvestOpportunityResponseBody.
+func unmarshalHarvestOpportunityResponseBodyToPortfolioHarvestOpportunity(v *HarvestOpportunityResponseBody) *portfolio.HarvestOpportunity {
+	res := &portfolio.HarvestOpportunity{
+		Account:             *v.Account,
+		Symbol:              *v.Symbol,
+		Quantity:            *v.Quantity,
+		Price:               *v.Price,
+		CostBasis:           *v.CostBasis,
+		MarketValue:         *v.MarketValue,
+		Loss:                *v.Loss,
+		ShortTermLoss:       *v.ShortTermLoss,
+		LongTermLoss:        *v.LongTermLoss,
+		EstimatedSavings:    *v.EstimatedSavings,
+		Replacement:         v.Replacement,
+		ReplacementPrice:    v.ReplacementPrice,
+		ReplacementQuantity: v.ReplacementQuantity,
+		RepurchaseAfter:     *v.RepurchaseAfter,
+	}
+	res.Lots = make([]string, len(v.Lots))
+	for i, val := range v.Lots {
+		res.Lots[i] = val
+	}
+
+	return res
+}
+
+// unmarshalSkippedHarvestResponseBodyToPortfolioSkippedHarvest builds a value
+// of type *portfolio.SkippedHarvest from a value of type
+// *SkippedHarvestResponseBody.
+func unmarshalSkippedHarvestResponseBodyToPortfolioSkippedHarvest(v *SkippedHarvestResponseBody) *portfolio.SkippedHarvest {
+	res := &portfolio.SkippedHarvest{
+		Account: *v.Account,
+		Symbol:  *v.Symbol,
+		Loss:    *v.Loss,
+		Reason:  *v.Reason,
+	}
+
+	return res
+}
+
+// unmarshalAccountResponseToPortfolioAccount builds a value of type
+// *portfolio.Account from a value of type *AccountResponse.
+func unmarshalAccountResponseToPortfolioAccount(v *AccountResponse) *portfolio.Account {
+	res := &portfolio.Account{
+		ID:            *v.ID,
+		Name:          *v.Name,
+		Type:          *v.Type,
+		TaxTreatment:  *v.TaxTreatment,
+		Custodian:     v.Custodian,
+		Currency:      *v.Currency,
+		Registered:    *v.Registered,
+		Cash:          *v.Cash,
+		HoldingsValue: *v.HoldingsValue,
+		TotalValue:    *v.TotalValue,
+	}
+
+	return res
+}
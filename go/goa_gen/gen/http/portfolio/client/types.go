@@ -13,6 +13,236 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
+// SetBenchmarkRequestBody is the type of the "portfolio" service
+// "setBenchmark" endpoint HTTP request body.
+type SetBenchmarkRequestBody struct {
+	// Display name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Blend components; weights must sum to 1
+	Components []*BenchmarkComponentRequestBodyRequestBody `form:"components,omitempty" json:"components,omitempty" xml:"components,omitempty"`
+	// Blend in the form 60% SPY / 40% AGG; set either components or blend
+	Blend *string `form:"blend,omitempty" json:"blend,omitempty" xml:"blend,omitempty"`
+	// How often the blend is reset to its weights; none keeps static initial
+	// weights
+	Rebalance string `form:"rebalance" json:"rebalance" xml:"rebalance"`
+}
+
+// ProjectPortfolioRequestBody is the type of the "portfolio" service
+// "projectPortfolio" endpoint HTTP request body.
+type ProjectPortfolioRequestBody struct {
+	// Last day of the projection
+	End string `form:"end" json:"end" xml:"end"`
+	// Starting value; defaults to the current portfolio value
+	StartValue *float64 `form:"start_value,omitempty" json:"start_value,omitempty" xml:"start_value,omitempty"`
+	// Monthly contribution in today's money
+	MonthlyContribution float64 `form:"monthly_contribution" json:"monthly_contribution" xml:"monthly_contribution"`
+	// Monthly withdrawal in today's money
+	MonthlyWithdrawal float64 `form:"monthly_withdrawal" json:"monthly_withdrawal" xml:"monthly_withdrawal"`
+	// Date withdrawals begin, e.g. retirement; defaults to today
+	WithdrawalStart *string `form:"withdrawal_start,omitempty" json:"withdrawal_start,omitempty" xml:"withdrawal_start,omitempty"`
+	// Annual inflation rate
+	Inflation float64 `form:"inflation" json:"inflation" xml:"inflation"`
+	// Goal amount in today's money
+	Goal *float64 `form:"goal,omitempty" json:"goal,omitempty" xml:"goal,omitempty"`
+	// Date the goal should be reached by; defaults to the end
+	GoalDate *string `form:"goal_date,omitempty" json:"goal_date,omitempty" xml:"goal_date,omitempty"`
+	// Assumptions overriding the defaults per asset class
+	Assumptions []*AssetClassAssumptionRequestBody `form:"assumptions,omitempty" json:"assumptions,omitempty" xml:"assumptions,omitempty"`
+	// Number of simulated paths
+	Paths int `form:"paths" json:"paths" xml:"paths"`
+	// Seed for reproducible results
+	Seed *int64 `form:"seed,omitempty" json:"seed,omitempty" xml:"seed,omitempty"`
+}
+
+// ApplyCorporateActionsRequestBody is the type of the "portfolio" service
+// "applyCorporateActions" endpoint HTTP request body.
+type ApplyCorporateActionsRequestBody struct {
+	// Actions to apply
+	Actions []*CorporateActionInputRequestBody `form:"actions" json:"actions" xml:"actions"`
+}
+
+// RecordIncomeRequestBody is the type of the "portfolio" service
+// "recordIncome" endpoint HTTP request body.
+type RecordIncomeRequestBody struct {
+	// Income type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Paying instrument; required for dividends
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Account credited
+	Account string `form:"account" json:"account" xml:"account"`
+	// Pay date
+	PayDate string `form:"pay_date" json:"pay_date" xml:"pay_date"`
+	// Ex-dividend date
+	ExDate *string `form:"ex_date,omitempty" json:"ex_date,omitempty" xml:"ex_date,omitempty"`
+	// Gross amount
+	Amount float64 `form:"amount" json:"amount" xml:"amount"`
+	// Tax withheld at source
+	Withholding float64 `form:"withholding" json:"withholding" xml:"withholding"`
+	// Dividend taxed at qualified rates
+	Qualified bool `form:"qualified" json:"qualified" xml:"qualified"`
+	// Reinvest the net dividend in the paying instrument
+	Reinvest bool `form:"reinvest" json:"reinvest" xml:"reinvest"`
+	// Reinvestment price; defaults to the pay-date close
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Free-form note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// PriceBondRequestBody is the type of the "portfolio" service "priceBond"
+// endpoint HTTP request body.
+type PriceBondRequestBody struct {
+	// Annual coupon rate as a decimal fraction
+	Coupon float64 `form:"coupon" json:"coupon" xml:"coupon"`
+	// Coupons per year; 0 for zero-coupon bonds
+	CouponFrequency int `form:"coupon_frequency" json:"coupon_frequency" xml:"coupon_frequency"`
+	// Day count convention
+	DayCount string `form:"day_count" json:"day_count" xml:"day_count"`
+	// Maturity date
+	Maturity string `form:"maturity" json:"maturity" xml:"maturity"`
+	// Face value per bond
+	FaceValue float64 `form:"face_value" json:"face_value" xml:"face_value"`
+	// Settlement date; defaults to today
+	Settlement *string `form:"settlement,omitempty" json:"settlement,omitempty" xml:"settlement,omitempty"`
+	// Clean price per bond
+	CleanPrice *float64 `form:"clean_price,omitempty" json:"clean_price,omitempty" xml:"clean_price,omitempty"`
+	// Yield to maturity
+	Yield *float64 `form:"yield,omitempty" json:"yield,omitempty" xml:"yield,omitempty"`
+}
+
+// SetMarginAccountRequestBody is the type of the "portfolio" service
+// "setMarginAccount" endpoint HTTP request body.
+type SetMarginAccountRequestBody struct {
+	// Account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Equity required to open positions, as a share of their value
+	InitialRequirement float64 `form:"initial_requirement" json:"initial_requirement" xml:"initial_requirement"`
+	// Equity required to keep long positions
+	MaintenanceRequirement float64 `form:"maintenance_requirement" json:"maintenance_requirement" xml:"maintenance_requirement"`
+	// Equity required to keep short positions
+	ShortMaintenanceRequirement float64 `form:"short_maintenance_requirement" json:"short_maintenance_requirement" xml:"short_maintenance_requirement"`
+	// Annual interest rate on the margin loan
+	LoanRate float64 `form:"loan_rate" json:"loan_rate" xml:"loan_rate"`
+	// Annual fee for borrowing shares held short
+	BorrowRate float64 `form:"borrow_rate" json:"borrow_rate" xml:"borrow_rate"`
+	// Borrow fee rates by symbol overriding borrow_rate
+	BorrowRates map[string]float64 `form:"borrow_rates,omitempty" json:"borrow_rates,omitempty" xml:"borrow_rates,omitempty"`
+}
+
+// PlaceOrderRequestBody is the type of the "portfolio" service "placeOrder"
+// endpoint HTTP request body.
+type PlaceOrderRequestBody struct {
+	// Account trading; defaults to the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Order side
+	Side string `form:"side" json:"side" xml:"side"`
+	// Order type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Quantity
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Limit price of limit and stop-limit orders
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// Stop price of stop and stop-limit orders
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// How long the order works
+	TimeInForce string `form:"time_in_force" json:"time_in_force" xml:"time_in_force"`
+}
+
+// PreviewTradesRequestBody is the type of the "portfolio" service
+// "previewTrades" endpoint HTTP request body.
+type PreviewTradesRequestBody struct {
+	// Hypothetical trades
+	Trades []*TradeInputRequestBody `form:"trades" json:"trades" xml:"trades"`
+	// Allocation dimension
+	Dimension string `form:"dimension" json:"dimension" xml:"dimension"`
+	// Tax rate on net short-term gains
+	ShortTermTaxRate float64 `form:"short_term_tax_rate" json:"short_term_tax_rate" xml:"short_term_tax_rate"`
+	// Tax rate on net long-term gains
+	LongTermTaxRate float64 `form:"long_term_tax_rate" json:"long_term_tax_rate" xml:"long_term_tax_rate"`
+	// Trading days of returns used for risk
+	Lookback int `form:"lookback" json:"lookback" xml:"lookback"`
+}
+
+// RunBacktestRequestBody is the type of the "portfolio" service "runBacktest"
+// endpoint HTTP request body.
+type RunBacktestRequestBody struct {
+	// Strategy name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Strategy kind
+	Strategy string `form:"strategy" json:"strategy" xml:"strategy"`
+	// First day
+	Start string `form:"start" json:"start" xml:"start"`
+	// Last day
+	End string `form:"end" json:"end" xml:"end"`
+	// Currency of the simulated portfolio
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Cash invested on the first day
+	InitialCash float64 `form:"initial_cash" json:"initial_cash" xml:"initial_cash"`
+	// Target weights
+	Targets []*BacktestTargetRequestBody `form:"targets" json:"targets" xml:"targets"`
+	// Rebalancing or contribution frequency
+	Frequency string `form:"frequency" json:"frequency" xml:"frequency"`
+	// Drift from target weight that triggers a threshold rebalance
+	Threshold float64 `form:"threshold" json:"threshold" xml:"threshold"`
+	// Amount invested every period by dca
+	Contribution float64 `form:"contribution" json:"contribution" xml:"contribution"`
+	// Commission as a share of traded value
+	FeeRate float64 `form:"fee_rate" json:"fee_rate" xml:"fee_rate"`
+}
+
+// ImportCsvRequestBody is the type of the "portfolio" service "importCsv"
+// endpoint HTTP request body.
+type ImportCsvRequestBody struct {
+	// CSV statement
+	Content string `form:"content" json:"content" xml:"content"`
+}
+
+// ImportOfxRequestBody is the type of the "portfolio" service "importOfx"
+// endpoint HTTP request body.
+type ImportOfxRequestBody struct {
+	// OFX or QFX statement
+	Content string `form:"content" json:"content" xml:"content"`
+}
+
+// ImportJournalRequestBody is the type of the "portfolio" service
+// "importJournal" endpoint HTTP request body.
+type ImportJournalRequestBody struct {
+	// Beancount or hledger journal
+	Content string `form:"content" json:"content" xml:"content"`
+}
+
+// ImportArchiveRequestBody is the type of the "portfolio" service
+// "importArchive" endpoint HTTP request body.
+type ImportArchiveRequestBody struct {
+	// Portfolio archive
+	Content string `form:"content" json:"content" xml:"content"`
+}
+
+// ReconcilePositionsRequestBody is the type of the "portfolio" service
+// "reconcilePositions" endpoint HTTP request body.
+type ReconcilePositionsRequestBody struct {
+	// Position snapshot
+	Content string `form:"content" json:"content" xml:"content"`
+}
+
+// SetAccountRequestBody is the type of the "portfolio" service "setAccount"
+// endpoint HTTP request body.
+type SetAccountRequestBody struct {
+	// Account identifier transactions refer to; empty for the default account
+	ID string `form:"id" json:"id" xml:"id"`
+	// Display name; defaults to the identifier
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Account type
+	Type string `form:"type" json:"type" xml:"type"`
+	// How gains and income are taxed; defaults to the treatment of the type
+	TaxTreatment *string `form:"tax_treatment,omitempty" json:"tax_treatment,omitempty" xml:"tax_treatment,omitempty"`
+	// Broker or institution holding the account
+	Custodian *string `form:"custodian,omitempty" json:"custodian,omitempty" xml:"custodian,omitempty"`
+	// Base currency; must be the portfolio currency, which it defaults to
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+}
+
 // GetPortfolioSummaryResponseBody is the type of the "portfolio" service
 // "getPortfolioSummary" endpoint HTTP response body.
 type GetPortfolioSummaryResponseBody struct {
@@ -24,29 +254,7739 @@ type GetPortfolioSummaryResponseBody struct {
 	ChangePercent *float64 `form:"change_percent,omitempty" json:"change_percent,omitempty" xml:"change_percent,omitempty"`
 }
 
-// NewGetPortfolioSummaryPortfolioSummaryOK builds a "portfolio" service
-// "getPortfolioSummary" endpoint result from a HTTP "OK" response.
-func NewGetPortfolioSummaryPortfolioSummaryOK(body *GetPortfolioSummaryResponseBody) *portfolio.PortfolioSummary {
-	v := &portfolio.PortfolioSummary{
-		Balance:       *body.Balance,
-		Currency:      *body.Currency,
-		ChangePercent: *body.ChangePercent,
+// GetReturnsResponseBody is the type of the "portfolio" service "getReturns"
+// endpoint HTTP response body.
+type GetReturnsResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Requested period
+	Period *string `form:"period,omitempty" json:"period,omitempty" xml:"period,omitempty"`
+	// Account the returns were limited to
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// First day of the period
+	Start *string `form:"start,omitempty" json:"start,omitempty" xml:"start,omitempty"`
+	// Last day of the period
+	End *string `form:"end,omitempty" json:"end,omitempty" xml:"end,omitempty"`
+	// Portfolio value at the close before the period
+	StartValue *float64 `form:"start_value,omitempty" json:"start_value,omitempty" xml:"start_value,omitempty"`
+	// Portfolio value at the close of the last day
+	EndValue *float64 `form:"end_value,omitempty" json:"end_value,omitempty" xml:"end_value,omitempty"`
+	// Deposits less withdrawals during the period
+	NetContributions *float64 `form:"net_contributions,omitempty" json:"net_contributions,omitempty" xml:"net_contributions,omitempty"`
+	// Change in value not explained by contributions
+	Gain *float64 `form:"gain,omitempty" json:"gain,omitempty" xml:"gain,omitempty"`
+	// Chain-linked time-weighted return over the period
+	TimeWeightedReturn *float64 `form:"time_weighted_return,omitempty" json:"time_weighted_return,omitempty" xml:"time_weighted_return,omitempty"`
+	// Annualized time-weighted return, only for periods of at least one year
+	AnnualizedTimeWeightedReturn *float64 `form:"annualized_time_weighted_return,omitempty" json:"annualized_time_weighted_return,omitempty" xml:"annualized_time_weighted_return,omitempty"`
+	// Money-weighted return (XIRR), annualized only for periods of at least one
+	// year
+	MoneyWeightedReturn *float64 `form:"money_weighted_return,omitempty" json:"money_weighted_return,omitempty" xml:"money_weighted_return,omitempty"`
+}
+
+// GetAllocationResponseBody is the type of the "portfolio" service
+// "getAllocation" endpoint HTTP response body.
+type GetAllocationResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Dimension holdings are grouped by
+	Dimension *string `form:"dimension,omitempty" json:"dimension,omitempty" xml:"dimension,omitempty"`
+	// Custom tag key when grouping by tag
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty" xml:"tag,omitempty"`
+	// Account the holdings were limited to
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Valuation date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Currency of the values
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Total portfolio value including cash
+	TotalValue *float64 `form:"total_value,omitempty" json:"total_value,omitempty" xml:"total_value,omitempty"`
+	// Buckets ordered by value, largest first
+	Buckets []*AllocationBucketResponseBody `form:"buckets,omitempty" json:"buckets,omitempty" xml:"buckets,omitempty"`
+}
+
+// GetTargetAllocationResponseBody is the type of the "portfolio" service
+// "getTargetAllocation" endpoint HTTP response body.
+type GetTargetAllocationResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Target weights per symbol
+	Targets []*TargetWeightResponseBody `form:"targets,omitempty" json:"targets,omitempty" xml:"targets,omitempty"`
+	// Implied target cash weight
+	CashWeight *float64 `form:"cash_weight,omitempty" json:"cash_weight,omitempty" xml:"cash_weight,omitempty"`
+}
+
+// SetTargetAllocationResponseBody is the type of the "portfolio" service
+// "setTargetAllocation" endpoint HTTP response body.
+type SetTargetAllocationResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Target weights per symbol
+	Targets []*TargetWeightResponseBody `form:"targets,omitempty" json:"targets,omitempty" xml:"targets,omitempty"`
+	// Implied target cash weight
+	CashWeight *float64 `form:"cash_weight,omitempty" json:"cash_weight,omitempty" xml:"cash_weight,omitempty"`
+}
+
+// ProposeRebalanceResponseBody is the type of the "portfolio" service
+// "proposeRebalance" endpoint HTTP response body.
+type ProposeRebalanceResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Pricing date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Cash on hand before the trades
+	CashBefore *float64 `form:"cash_before,omitempty" json:"cash_before,omitempty" xml:"cash_before,omitempty"`
+	// Projected cash after the trades
+	CashAfter *float64 `form:"cash_after,omitempty" json:"cash_after,omitempty" xml:"cash_after,omitempty"`
+	// Proposed trades, sells first
+	Trades []*ProposedTradeResponseBody `form:"trades,omitempty" json:"trades,omitempty" xml:"trades,omitempty"`
+	// Constraints that prevented a full rebalance
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+}
+
+// SetBenchmarkResponseBody is the type of the "portfolio" service
+// "setBenchmark" endpoint HTTP response body.
+type SetBenchmarkResponseBody struct {
+	// Display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Blend components; weights must sum to 1
+	Components []*BenchmarkComponentResponseBody `form:"components,omitempty" json:"components,omitempty" xml:"components,omitempty"`
+	// Blend in the form 60% SPY / 40% AGG; set either components or blend
+	Blend *string `form:"blend,omitempty" json:"blend,omitempty" xml:"blend,omitempty"`
+	// How often the blend is reset to its weights; none keeps static initial
+	// weights
+	Rebalance *string `form:"rebalance,omitempty" json:"rebalance,omitempty" xml:"rebalance,omitempty"`
+}
+
+// GetBenchmarkComparisonResponseBody is the type of the "portfolio" service
+// "getBenchmarkComparison" endpoint HTTP response body.
+type GetBenchmarkComparisonResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Benchmark measured against
+	Benchmark *BenchmarkDefinitionResponseBody `form:"benchmark,omitempty" json:"benchmark,omitempty" xml:"benchmark,omitempty"`
+	// First day of the period
+	Start *string `form:"start,omitempty" json:"start,omitempty" xml:"start,omitempty"`
+	// Last day of the period
+	End *string `form:"end,omitempty" json:"end,omitempty" xml:"end,omitempty"`
+	// Portfolio time-weighted return
+	PortfolioReturn *float64 `form:"portfolio_return,omitempty" json:"portfolio_return,omitempty" xml:"portfolio_return,omitempty"`
+	// Benchmark cumulative return
+	BenchmarkReturn *float64 `form:"benchmark_return,omitempty" json:"benchmark_return,omitempty" xml:"benchmark_return,omitempty"`
+	// Portfolio return less benchmark return
+	ExcessReturn *float64 `form:"excess_return,omitempty" json:"excess_return,omitempty" xml:"excess_return,omitempty"`
+	// Annualized standard deviation of daily excess returns
+	TrackingError *float64 `form:"tracking_error,omitempty" json:"tracking_error,omitempty" xml:"tracking_error,omitempty"`
+	// Cumulative returns per trading day
+	Series []*BenchmarkComparisonPointResponseBody `form:"series,omitempty" json:"series,omitempty" xml:"series,omitempty"`
+}
+
+// GetRiskMetricsResponseBody is the type of the "portfolio" service
+// "getRiskMetrics" endpoint HTTP response body.
+type GetRiskMetricsResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Benchmark beta and correlation are measured against
+	Benchmark *BenchmarkDefinitionResponseBody `form:"benchmark,omitempty" json:"benchmark,omitempty" xml:"benchmark,omitempty"`
+	// First day of the period
+	Start *string `form:"start,omitempty" json:"start,omitempty" xml:"start,omitempty"`
+	// Last day of the period
+	End *string `form:"end,omitempty" json:"end,omitempty" xml:"end,omitempty"`
+	// Annual risk-free rate used for Sharpe and Sortino
+	RiskFreeRate *float64 `form:"risk_free_rate,omitempty" json:"risk_free_rate,omitempty" xml:"risk_free_rate,omitempty"`
+	// Annualized volatility of daily returns
+	Volatility *float64 `form:"volatility,omitempty" json:"volatility,omitempty" xml:"volatility,omitempty"`
+	// Annualized excess return over volatility
+	SharpeRatio *float64 `form:"sharpe_ratio,omitempty" json:"sharpe_ratio,omitempty" xml:"sharpe_ratio,omitempty"`
+	// Annualized excess return over downside deviation
+	SortinoRatio *float64 `form:"sortino_ratio,omitempty" json:"sortino_ratio,omitempty" xml:"sortino_ratio,omitempty"`
+	// Beta against the benchmark
+	Beta *float64 `form:"beta,omitempty" json:"beta,omitempty" xml:"beta,omitempty"`
+	// Correlation of daily returns with the benchmark
+	Correlation *float64 `form:"correlation,omitempty" json:"correlation,omitempty" xml:"correlation,omitempty"`
+	// Largest peak-to-trough decline as a positive fraction
+	MaxDrawdown *float64 `form:"max_drawdown,omitempty" json:"max_drawdown,omitempty" xml:"max_drawdown,omitempty"`
+	// Day of the peak before the largest decline
+	MaxDrawdownPeak *string `form:"max_drawdown_peak,omitempty" json:"max_drawdown_peak,omitempty" xml:"max_drawdown_peak,omitempty"`
+	// Day of the trough of the largest decline
+	MaxDrawdownTrough *string `form:"max_drawdown_trough,omitempty" json:"max_drawdown_trough,omitempty" xml:"max_drawdown_trough,omitempty"`
+	// Metrics per rolling window when a window is requested
+	Rolling []*RiskWindowResponseBody `form:"rolling,omitempty" json:"rolling,omitempty" xml:"rolling,omitempty"`
+}
+
+// GetValueAtRiskResponseBody is the type of the "portfolio" service
+// "getValueAtRisk" endpoint HTTP response body.
+type GetValueAtRiskResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Valuation date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Estimation method
+	Method *string `form:"method,omitempty" json:"method,omitempty" xml:"method,omitempty"`
+	// Confidence level
+	Confidence *float64 `form:"confidence,omitempty" json:"confidence,omitempty" xml:"confidence,omitempty"`
+	// Holding period in trading days
+	Horizon *int `form:"horizon,omitempty" json:"horizon,omitempty" xml:"horizon,omitempty"`
+	// Trading days of price history used
+	Lookback *int `form:"lookback,omitempty" json:"lookback,omitempty" xml:"lookback,omitempty"`
+	// Market value of the risky positions; cash is treated as riskless
+	PortfolioValue *float64 `form:"portfolio_value,omitempty" json:"portfolio_value,omitempty" xml:"portfolio_value,omitempty"`
+	// Value-at-Risk
+	ValueAtRisk *float64 `form:"value_at_risk,omitempty" json:"value_at_risk,omitempty" xml:"value_at_risk,omitempty"`
+	// Conditional VaR: the average loss beyond the VaR
+	ExpectedShortfall *float64 `form:"expected_shortfall,omitempty" json:"expected_shortfall,omitempty" xml:"expected_shortfall,omitempty"`
+	// Per-position VaR contributions
+	Contributions []*VaRContributionResponseBody `form:"contributions,omitempty" json:"contributions,omitempty" xml:"contributions,omitempty"`
+}
+
+// ListStressScenariosResponseBody is the type of the "portfolio" service
+// "listStressScenarios" endpoint HTTP response body.
+type ListStressScenariosResponseBody []*StressScenarioResponse
+
+// RunStressTestResponseBody is the type of the "portfolio" service
+// "runStressTest" endpoint HTTP response body.
+type RunStressTestResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Valuation date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Scenario applied
+	Scenario *StressScenarioResponseBody `form:"scenario,omitempty" json:"scenario,omitempty" xml:"scenario,omitempty"`
+	// Currency of the values
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Portfolio value including cash
+	CurrentValue *float64 `form:"current_value,omitempty" json:"current_value,omitempty" xml:"current_value,omitempty"`
+	// Portfolio value under the scenario
+	ProjectedValue *float64 `form:"projected_value,omitempty" json:"projected_value,omitempty" xml:"projected_value,omitempty"`
+	// Current value less projected value
+	Loss *float64 `form:"loss,omitempty" json:"loss,omitempty" xml:"loss,omitempty"`
+	// Loss as a decimal fraction of current value
+	LossPercent *float64 `form:"loss_percent,omitempty" json:"loss_percent,omitempty" xml:"loss_percent,omitempty"`
+	// Positions ordered from the largest loss
+	WorstContributors []*StressImpactResponseBody `form:"worst_contributors,omitempty" json:"worst_contributors,omitempty" xml:"worst_contributors,omitempty"`
+	// Approximations made while applying the scenario
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+}
+
+// ProjectPortfolioResponseBody is the type of the "portfolio" service
+// "projectPortfolio" endpoint HTTP response body.
+type ProjectPortfolioResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// First day of the projection
+	Start *string `form:"start,omitempty" json:"start,omitempty" xml:"start,omitempty"`
+	// Last day of the projection
+	End *string `form:"end,omitempty" json:"end,omitempty" xml:"end,omitempty"`
+	// Value the projection starts from
+	StartValue *float64 `form:"start_value,omitempty" json:"start_value,omitempty" xml:"start_value,omitempty"`
+	// Number of simulated paths
+	Paths *int `form:"paths,omitempty" json:"paths,omitempty" xml:"paths,omitempty"`
+	// Assumptions used per asset class
+	Assumptions []*AssetClassAssumptionResponseBody `form:"assumptions,omitempty" json:"assumptions,omitempty" xml:"assumptions,omitempty"`
+	// Percentile bands at each anniversary and at the end
+	Bands []*ProjectionBandResponseBody `form:"bands,omitempty" json:"bands,omitempty" xml:"bands,omitempty"`
+	// Goal amount
+	Goal *float64 `form:"goal,omitempty" json:"goal,omitempty" xml:"goal,omitempty"`
+	// Date the goal should be reached by
+	GoalDate *string `form:"goal_date,omitempty" json:"goal_date,omitempty" xml:"goal_date,omitempty"`
+	// Share of paths reaching the goal by the goal date
+	GoalProbability *float64 `form:"goal_probability,omitempty" json:"goal_probability,omitempty" xml:"goal_probability,omitempty"`
+	// Share of paths that ran out of money
+	DepletionProbability *float64 `form:"depletion_probability,omitempty" json:"depletion_probability,omitempty" xml:"depletion_probability,omitempty"`
+}
+
+// ListCorporateActionsResponseBody is the type of the "portfolio" service
+// "listCorporateActions" endpoint HTTP response body.
+type ListCorporateActionsResponseBody []*CorporateActionResponse
+
+// ApplyCorporateActionsResponseBody is the type of the "portfolio" service
+// "applyCorporateActions" endpoint HTTP response body.
+type ApplyCorporateActionsResponseBody []*CorporateActionResponse
+
+// RecordIncomeResponseBody is the type of the "portfolio" service
+// "recordIncome" endpoint HTTP response body.
+type RecordIncomeResponseBody struct {
+	// Ledger transaction ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Income type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Paying instrument; optional for interest
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Account credited
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Pay date
+	PayDate *string `form:"pay_date,omitempty" json:"pay_date,omitempty" xml:"pay_date,omitempty"`
+	// Ex-dividend date
+	ExDate *string `form:"ex_date,omitempty" json:"ex_date,omitempty" xml:"ex_date,omitempty"`
+	// Gross amount
+	Amount *float64 `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// Tax withheld at source
+	Withholding *float64 `form:"withholding,omitempty" json:"withholding,omitempty" xml:"withholding,omitempty"`
+	// Cash received after withholding
+	Net *float64 `form:"net,omitempty" json:"net,omitempty" xml:"net,omitempty"`
+	// Dividend taxed at qualified rates
+	Qualified *bool `form:"qualified,omitempty" json:"qualified,omitempty" xml:"qualified,omitempty"`
+	// Ledger ID of the purchase reinvesting the dividend
+	ReinvestmentID *string `form:"reinvestment_id,omitempty" json:"reinvestment_id,omitempty" xml:"reinvestment_id,omitempty"`
+	// Shares bought by reinvestment
+	ReinvestedQuantity *float64 `form:"reinvested_quantity,omitempty" json:"reinvested_quantity,omitempty" xml:"reinvested_quantity,omitempty"`
+}
+
+// GetIncomeResponseBody is the type of the "portfolio" service "getIncome"
+// endpoint HTTP response body.
+type GetIncomeResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// First day of the period
+	Start *string `form:"start,omitempty" json:"start,omitempty" xml:"start,omitempty"`
+	// Last day of the period
+	End *string `form:"end,omitempty" json:"end,omitempty" xml:"end,omitempty"`
+	// Currency of the amounts
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Length of the periods in by_period
+	Interval *string `form:"interval,omitempty" json:"interval,omitempty" xml:"interval,omitempty"`
+	// Gross income
+	Gross *float64 `form:"gross,omitempty" json:"gross,omitempty" xml:"gross,omitempty"`
+	// Tax withheld at source
+	Withholding *float64 `form:"withholding,omitempty" json:"withholding,omitempty" xml:"withholding,omitempty"`
+	// Income after withholding
+	Net *float64 `form:"net,omitempty" json:"net,omitempty" xml:"net,omitempty"`
+	// Gross qualified dividends
+	Qualified *float64 `form:"qualified,omitempty" json:"qualified,omitempty" xml:"qualified,omitempty"`
+	// Gross ordinary dividends and interest
+	Ordinary *float64 `form:"ordinary,omitempty" json:"ordinary,omitempty" xml:"ordinary,omitempty"`
+	// Income per period
+	ByPeriod []*IncomeBucketResponseBody `form:"by_period,omitempty" json:"by_period,omitempty" xml:"by_period,omitempty"`
+	// Income per symbol; interest without a symbol is keyed cash
+	ByHolding []*IncomeBucketResponseBody `form:"by_holding,omitempty" json:"by_holding,omitempty" xml:"by_holding,omitempty"`
+	// Income per instrument currency
+	ByCurrency []*IncomeBucketResponseBody `form:"by_currency,omitempty" json:"by_currency,omitempty" xml:"by_currency,omitempty"`
+	// Expected income per month over the next 12 months
+	Forecast []*IncomeForecastResponseBody `form:"forecast,omitempty" json:"forecast,omitempty" xml:"forecast,omitempty"`
+	// Expected income over the next 12 months
+	ForecastTotal *float64 `form:"forecast_total,omitempty" json:"forecast_total,omitempty" xml:"forecast_total,omitempty"`
+}
+
+// PriceBondResponseBody is the type of the "portfolio" service "priceBond"
+// endpoint HTTP response body.
+type PriceBondResponseBody struct {
+	// Settlement date
+	Settlement *string `form:"settlement,omitempty" json:"settlement,omitempty" xml:"settlement,omitempty"`
+	// Price excluding accrued interest
+	CleanPrice *float64 `form:"clean_price,omitempty" json:"clean_price,omitempty" xml:"clean_price,omitempty"`
+	// Price including accrued interest
+	DirtyPrice *float64 `form:"dirty_price,omitempty" json:"dirty_price,omitempty" xml:"dirty_price,omitempty"`
+	// Coupon earned since the last coupon date
+	AccruedInterest *float64 `form:"accrued_interest,omitempty" json:"accrued_interest,omitempty" xml:"accrued_interest,omitempty"`
+	// Annual yield compounded at the coupon frequency
+	YieldToMaturity *float64 `form:"yield_to_maturity,omitempty" json:"yield_to_maturity,omitempty" xml:"yield_to_maturity,omitempty"`
+	// Macaulay duration in years
+	MacaulayDuration *float64 `form:"macaulay_duration,omitempty" json:"macaulay_duration,omitempty" xml:"macaulay_duration,omitempty"`
+	// Modified duration in years
+	ModifiedDuration *float64 `form:"modified_duration,omitempty" json:"modified_duration,omitempty" xml:"modified_duration,omitempty"`
+}
+
+// ListBondPositionsResponseBody is the type of the "portfolio" service
+// "listBondPositions" endpoint HTTP response body.
+type ListBondPositionsResponseBody []*BondPositionResponse
+
+// GetCashFlowLadderResponseBody is the type of the "portfolio" service
+// "getCashFlowLadder" endpoint HTTP response body.
+type GetCashFlowLadderResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// First day of the ladder
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Last day of the ladder
+	End *string `form:"end,omitempty" json:"end,omitempty" xml:"end,omitempty"`
+	// Currency of the portfolio
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Cash flows in date order
+	Flows []*BondCashFlowResponseBody `form:"flows,omitempty" json:"flows,omitempty" xml:"flows,omitempty"`
+	// Cash flows per calendar year
+	ByYear []*CashFlowLadderYearResponseBody `form:"by_year,omitempty" json:"by_year,omitempty" xml:"by_year,omitempty"`
+	// Sum of all cash flows
+	Total *float64 `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// ListOptionPositionsResponseBody is the type of the "portfolio" service
+// "listOptionPositions" endpoint HTTP response body.
+type ListOptionPositionsResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Valuation date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Currency of the values
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Option positions by account and symbol
+	Positions []*OptionPositionResponseBody `form:"positions,omitempty" json:"positions,omitempty" xml:"positions,omitempty"`
+	// Exposure per underlying of the options held
+	Exposures []*UnderlyingExposureResponseBody `form:"exposures,omitempty" json:"exposures,omitempty" xml:"exposures,omitempty"`
+}
+
+// SettleOptionExpiriesResponseBody is the type of the "portfolio" service
+// "settleOptionExpiries" endpoint HTTP response body.
+type SettleOptionExpiriesResponseBody []*OptionSettlementResponse
+
+// SetMarginAccountResponseBody is the type of the "portfolio" service
+// "setMarginAccount" endpoint HTTP response body.
+type SetMarginAccountResponseBody struct {
+	// Account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Equity required to open positions, as a share of their value
+	InitialRequirement *float64 `form:"initial_requirement,omitempty" json:"initial_requirement,omitempty" xml:"initial_requirement,omitempty"`
+	// Equity required to keep long positions
+	MaintenanceRequirement *float64 `form:"maintenance_requirement,omitempty" json:"maintenance_requirement,omitempty" xml:"maintenance_requirement,omitempty"`
+	// Equity required to keep short positions
+	ShortMaintenanceRequirement *float64 `form:"short_maintenance_requirement,omitempty" json:"short_maintenance_requirement,omitempty" xml:"short_maintenance_requirement,omitempty"`
+	// Annual interest rate on the margin loan
+	LoanRate *float64 `form:"loan_rate,omitempty" json:"loan_rate,omitempty" xml:"loan_rate,omitempty"`
+	// Annual fee for borrowing shares held short
+	BorrowRate *float64 `form:"borrow_rate,omitempty" json:"borrow_rate,omitempty" xml:"borrow_rate,omitempty"`
+	// Borrow fee rates by symbol overriding borrow_rate
+	BorrowRates map[string]float64 `form:"borrow_rates,omitempty" json:"borrow_rates,omitempty" xml:"borrow_rates,omitempty"`
+}
+
+// GetMarginStatusResponseBody is the type of the "portfolio" service
+// "getMarginStatus" endpoint HTTP response body.
+type GetMarginStatusResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Valuation date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Currency of the values
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Margin accounts by name
+	Accounts []*MarginAccountStatusResponseBody `form:"accounts,omitempty" json:"accounts,omitempty" xml:"accounts,omitempty"`
+}
+
+// ChargeMarginResponseBody is the type of the "portfolio" service
+// "chargeMargin" endpoint HTTP response body.
+type ChargeMarginResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Valuation date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Currency of the values
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Margin accounts by name
+	Accounts []*MarginAccountStatusResponseBody `form:"accounts,omitempty" json:"accounts,omitempty" xml:"accounts,omitempty"`
+}
+
+// ListEventsResponseBody is the type of the "portfolio" service "listEvents"
+// endpoint HTTP response body.
+type ListEventsResponseBody []*PortfolioEventResponse
+
+// PlaceOrderResponseBody is the type of the "portfolio" service "placeOrder"
+// endpoint HTTP response body.
+type PlaceOrderResponseBody struct {
+	// Order ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Account trading; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Order side
+	Side *string `form:"side,omitempty" json:"side,omitempty" xml:"side,omitempty"`
+	// Order type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Quantity
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Limit price of limit and stop-limit orders
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// Stop price of stop and stop-limit orders
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// How long the order works
+	TimeInForce *string `form:"time_in_force,omitempty" json:"time_in_force,omitempty" xml:"time_in_force,omitempty"`
+	// Order status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The stop price of a stop or stop-limit order traded
+	Triggered *bool `form:"triggered,omitempty" json:"triggered,omitempty" xml:"triggered,omitempty"`
+	// When the order was placed
+	Created *string `form:"created,omitempty" json:"created,omitempty" xml:"created,omitempty"`
+	// When the status last changed
+	Updated *string `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// Fill price including slippage; 0 until filled
+	FillPrice *float64 `form:"fill_price,omitempty" json:"fill_price,omitempty" xml:"fill_price,omitempty"`
+	// Commission charged; 0 until filled
+	Commission *float64 `form:"commission,omitempty" json:"commission,omitempty" xml:"commission,omitempty"`
+	// Why the order was rejected
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// ListOrdersResponseBody is the type of the "portfolio" service "listOrders"
+// endpoint HTTP response body.
+type ListOrdersResponseBody []*OrderResponse
+
+// CancelOrderResponseBody is the type of the "portfolio" service "cancelOrder"
+// endpoint HTTP response body.
+type CancelOrderResponseBody struct {
+	// Order ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Account trading; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Order side
+	Side *string `form:"side,omitempty" json:"side,omitempty" xml:"side,omitempty"`
+	// Order type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Quantity
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Limit price of limit and stop-limit orders
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// Stop price of stop and stop-limit orders
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// How long the order works
+	TimeInForce *string `form:"time_in_force,omitempty" json:"time_in_force,omitempty" xml:"time_in_force,omitempty"`
+	// Order status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The stop price of a stop or stop-limit order traded
+	Triggered *bool `form:"triggered,omitempty" json:"triggered,omitempty" xml:"triggered,omitempty"`
+	// When the order was placed
+	Created *string `form:"created,omitempty" json:"created,omitempty" xml:"created,omitempty"`
+	// When the status last changed
+	Updated *string `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// Fill price including slippage; 0 until filled
+	FillPrice *float64 `form:"fill_price,omitempty" json:"fill_price,omitempty" xml:"fill_price,omitempty"`
+	// Commission charged; 0 until filled
+	Commission *float64 `form:"commission,omitempty" json:"commission,omitempty" xml:"commission,omitempty"`
+	// Why the order was rejected
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// PreviewTradesResponseBody is the type of the "portfolio" service
+// "previewTrades" endpoint HTTP response body.
+type PreviewTradesResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Valuation date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Currency of the values
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Summary before the trades
+	Before *PortfolioSummaryResponseBody `form:"before,omitempty" json:"before,omitempty" xml:"before,omitempty"`
+	// Summary after the trades
+	After *PortfolioSummaryResponseBody `form:"after,omitempty" json:"after,omitempty" xml:"after,omitempty"`
+	// Cash before the trades
+	CashBefore *float64 `form:"cash_before,omitempty" json:"cash_before,omitempty" xml:"cash_before,omitempty"`
+	// Cash after the trades
+	CashAfter *float64 `form:"cash_after,omitempty" json:"cash_after,omitempty" xml:"cash_after,omitempty"`
+	// Allocation dimension
+	Dimension *string `form:"dimension,omitempty" json:"dimension,omitempty" xml:"dimension,omitempty"`
+	// Allocation buckets before and after, by key
+	Allocation []*AllocationChangeResponseBody `form:"allocation,omitempty" json:"allocation,omitempty" xml:"allocation,omitempty"`
+	// Lots the sales would relieve
+	Realized []*RealizedLotResponseBody `form:"realized,omitempty" json:"realized,omitempty" xml:"realized,omitempty"`
+	// Net short-term realized gain
+	ShortTermGain *float64 `form:"short_term_gain,omitempty" json:"short_term_gain,omitempty" xml:"short_term_gain,omitempty"`
+	// Net long-term realized gain
+	LongTermGain *float64 `form:"long_term_gain,omitempty" json:"long_term_gain,omitempty" xml:"long_term_gain,omitempty"`
+	// Tax on net realized gains at the given rates; 0 for net losses
+	EstimatedTax *float64 `form:"estimated_tax,omitempty" json:"estimated_tax,omitempty" xml:"estimated_tax,omitempty"`
+	// Risk before the trades
+	RiskBefore *HoldingsRiskResponseBody `form:"risk_before,omitempty" json:"risk_before,omitempty" xml:"risk_before,omitempty"`
+	// Risk after the trades
+	RiskAfter *HoldingsRiskResponseBody `form:"risk_after,omitempty" json:"risk_after,omitempty" xml:"risk_after,omitempty"`
+	// Issues such as negative cash
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+}
+
+// RunBacktestResponseBody is the type of the "portfolio" service "runBacktest"
+// endpoint HTTP response body.
+type RunBacktestResponseBody struct {
+	// Strategy name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Strategy kind
+	Strategy *string `form:"strategy,omitempty" json:"strategy,omitempty" xml:"strategy,omitempty"`
+	// First trading day
+	Start *string `form:"start,omitempty" json:"start,omitempty" xml:"start,omitempty"`
+	// Last trading day
+	End *string `form:"end,omitempty" json:"end,omitempty" xml:"end,omitempty"`
+	// Currency of the values
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Value at the end
+	EndValue *float64 `form:"end_value,omitempty" json:"end_value,omitempty" xml:"end_value,omitempty"`
+	// Initial cash plus contributions
+	NetContributions *float64 `form:"net_contributions,omitempty" json:"net_contributions,omitempty" xml:"net_contributions,omitempty"`
+	// Change in value not explained by contributions
+	Gain *float64 `form:"gain,omitempty" json:"gain,omitempty" xml:"gain,omitempty"`
+	// Cumulative time-weighted return
+	TimeWeightedReturn *float64 `form:"time_weighted_return,omitempty" json:"time_weighted_return,omitempty" xml:"time_weighted_return,omitempty"`
+	// Annualized time-weighted return, for periods of at least one year
+	AnnualizedReturn *float64 `form:"annualized_return,omitempty" json:"annualized_return,omitempty" xml:"annualized_return,omitempty"`
+	// Money-weighted return (XIRR)
+	MoneyWeightedReturn *float64 `form:"money_weighted_return,omitempty" json:"money_weighted_return,omitempty" xml:"money_weighted_return,omitempty"`
+	// Annualized volatility of daily returns
+	Volatility *float64 `form:"volatility,omitempty" json:"volatility,omitempty" xml:"volatility,omitempty"`
+	// Annualized Sharpe ratio
+	SharpeRatio *float64 `form:"sharpe_ratio,omitempty" json:"sharpe_ratio,omitempty" xml:"sharpe_ratio,omitempty"`
+	// Largest peak-to-trough decline as a positive fraction
+	MaxDrawdown *float64 `form:"max_drawdown,omitempty" json:"max_drawdown,omitempty" xml:"max_drawdown,omitempty"`
+	// Date of the peak before the largest decline
+	MaxDrawdownPeak *string `form:"max_drawdown_peak,omitempty" json:"max_drawdown_peak,omitempty" xml:"max_drawdown_peak,omitempty"`
+	// Date of the trough of the largest decline
+	MaxDrawdownTrough *string `form:"max_drawdown_trough,omitempty" json:"max_drawdown_trough,omitempty" xml:"max_drawdown_trough,omitempty"`
+	// Number of trades
+	Trades *int `form:"trades,omitempty" json:"trades,omitempty" xml:"trades,omitempty"`
+	// Value bought plus sold
+	TradedValue *float64 `form:"traded_value,omitempty" json:"traded_value,omitempty" xml:"traded_value,omitempty"`
+	// Commission paid
+	Fees *float64 `form:"fees,omitempty" json:"fees,omitempty" xml:"fees,omitempty"`
+	// Lesser of the value bought and sold divided by the average value; investing
+	// deposited cash is not counted
+	Turnover *float64 `form:"turnover,omitempty" json:"turnover,omitempty" xml:"turnover,omitempty"`
+	// Daily values, starting with the base day before start
+	EquityCurve []*BacktestPointResponseBody `form:"equity_curve,omitempty" json:"equity_curve,omitempty" xml:"equity_curve,omitempty"`
+}
+
+// ImportCsvResponseBody is the type of the "portfolio" service "importCsv"
+// endpoint HTTP response body.
+type ImportCsvResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Statement format
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Mapping profile used
+	Profile *string `form:"profile,omitempty" json:"profile,omitempty" xml:"profile,omitempty"`
+	// Whether the import was only previewed
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// Rows posted, or that would be posted in a dry run
+	Imported *int `form:"imported,omitempty" json:"imported,omitempty" xml:"imported,omitempty"`
+	// Rows already in the ledger
+	Duplicates *int `form:"duplicates,omitempty" json:"duplicates,omitempty" xml:"duplicates,omitempty"`
+	// Rows ignored by the profile
+	Skipped *int `form:"skipped,omitempty" json:"skipped,omitempty" xml:"skipped,omitempty"`
+	// Rows that could not be read or posted
+	Errors *int `form:"errors,omitempty" json:"errors,omitempty" xml:"errors,omitempty"`
+	// Outcome of every row in file order
+	Rows []*ImportRowResponseBody `form:"rows,omitempty" json:"rows,omitempty" xml:"rows,omitempty"`
+	// Statement positions and cash compared with the ledger after the import
+	Reconciliation []*AccountReconciliationResponseBody `form:"reconciliation,omitempty" json:"reconciliation,omitempty" xml:"reconciliation,omitempty"`
+}
+
+// ImportOfxResponseBody is the type of the "portfolio" service "importOfx"
+// endpoint HTTP response body.
+type ImportOfxResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Statement format
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Mapping profile used
+	Profile *string `form:"profile,omitempty" json:"profile,omitempty" xml:"profile,omitempty"`
+	// Whether the import was only previewed
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// Rows posted, or that would be posted in a dry run
+	Imported *int `form:"imported,omitempty" json:"imported,omitempty" xml:"imported,omitempty"`
+	// Rows already in the ledger
+	Duplicates *int `form:"duplicates,omitempty" json:"duplicates,omitempty" xml:"duplicates,omitempty"`
+	// Rows ignored by the profile
+	Skipped *int `form:"skipped,omitempty" json:"skipped,omitempty" xml:"skipped,omitempty"`
+	// Rows that could not be read or posted
+	Errors *int `form:"errors,omitempty" json:"errors,omitempty" xml:"errors,omitempty"`
+	// Outcome of every row in file order
+	Rows []*ImportRowResponseBody `form:"rows,omitempty" json:"rows,omitempty" xml:"rows,omitempty"`
+	// Statement positions and cash compared with the ledger after the import
+	Reconciliation []*AccountReconciliationResponseBody `form:"reconciliation,omitempty" json:"reconciliation,omitempty" xml:"reconciliation,omitempty"`
+}
+
+// ImportJournalResponseBody is the type of the "portfolio" service
+// "importJournal" endpoint HTTP response body.
+type ImportJournalResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Statement format
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Mapping profile used
+	Profile *string `form:"profile,omitempty" json:"profile,omitempty" xml:"profile,omitempty"`
+	// Whether the import was only previewed
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// Rows posted, or that would be posted in a dry run
+	Imported *int `form:"imported,omitempty" json:"imported,omitempty" xml:"imported,omitempty"`
+	// Rows already in the ledger
+	Duplicates *int `form:"duplicates,omitempty" json:"duplicates,omitempty" xml:"duplicates,omitempty"`
+	// Rows ignored by the profile
+	Skipped *int `form:"skipped,omitempty" json:"skipped,omitempty" xml:"skipped,omitempty"`
+	// Rows that could not be read or posted
+	Errors *int `form:"errors,omitempty" json:"errors,omitempty" xml:"errors,omitempty"`
+	// Outcome of every row in file order
+	Rows []*ImportRowResponseBody `form:"rows,omitempty" json:"rows,omitempty" xml:"rows,omitempty"`
+	// Statement positions and cash compared with the ledger after the import
+	Reconciliation []*AccountReconciliationResponseBody `form:"reconciliation,omitempty" json:"reconciliation,omitempty" xml:"reconciliation,omitempty"`
+}
+
+// ImportArchiveResponseBody is the type of the "portfolio" service
+// "importArchive" endpoint HTTP response body.
+type ImportArchiveResponseBody struct {
+	// Portfolio created or replaced
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Schema version the archive was written in
+	SchemaVersion *int `form:"schema_version,omitempty" json:"schema_version,omitempty" xml:"schema_version,omitempty"`
+	// Whether the archive was migrated from an older schema version
+	Migrated *bool `form:"migrated,omitempty" json:"migrated,omitempty" xml:"migrated,omitempty"`
+	// Whether an existing portfolio was replaced
+	Replaced *bool `form:"replaced,omitempty" json:"replaced,omitempty" xml:"replaced,omitempty"`
+	// When the archive was created
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Ledger transactions imported
+	Transactions *int `form:"transactions,omitempty" json:"transactions,omitempty" xml:"transactions,omitempty"`
+	// Instruments added or updated
+	Instruments *int `form:"instruments,omitempty" json:"instruments,omitempty" xml:"instruments,omitempty"`
+	// Target weights imported
+	Targets *int `form:"targets,omitempty" json:"targets,omitempty" xml:"targets,omitempty"`
+	// Registered accounts imported
+	Accounts *int `form:"accounts,omitempty" json:"accounts,omitempty" xml:"accounts,omitempty"`
+	// Margin accounts imported
+	MarginAccounts *int `form:"margin_accounts,omitempty" json:"margin_accounts,omitempty" xml:"margin_accounts,omitempty"`
+}
+
+// ReconcilePositionsResponseBody is the type of the "portfolio" service
+// "reconcilePositions" endpoint HTTP response body.
+type ReconcilePositionsResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Snapshot format
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Accounts and dates checked
+	Accounts []*ReconciledAccountResponseBody `form:"accounts,omitempty" json:"accounts,omitempty" xml:"accounts,omitempty"`
+	// Breaks found for the first time, or again after being resolved
+	Opened *int `form:"opened,omitempty" json:"opened,omitempty" xml:"opened,omitempty"`
+	// Open or ignored breaks found again
+	Updated *int `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// Open breaks of the checked accounts and dates that now match
+	Resolved *int `form:"resolved,omitempty" json:"resolved,omitempty" xml:"resolved,omitempty"`
+	// Breaks found by this run
+	Breaks []*ReconciliationBreakResponseBody `form:"breaks,omitempty" json:"breaks,omitempty" xml:"breaks,omitempty"`
+}
+
+// ListReconciliationBreaksResponseBody is the type of the "portfolio" service
+// "listReconciliationBreaks" endpoint HTTP response body.
+type ListReconciliationBreaksResponseBody []*ReconciliationBreakResponse
+
+// UpdateReconciliationBreakResponseBody is the type of the "portfolio" service
+// "updateReconciliationBreak" endpoint HTTP response body.
+type UpdateReconciliationBreakResponseBody struct {
+	// Break ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Ledger account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Snapshot date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Break kind
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Instrument symbol; absent for cash differences
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Quantity, or cash balance, in the snapshot
+	Statement *float64 `form:"statement,omitempty" json:"statement,omitempty" xml:"statement,omitempty"`
+	// Quantity, or cash balance, in the ledger
+	Ledger *float64 `form:"ledger,omitempty" json:"ledger,omitempty" xml:"ledger,omitempty"`
+	// Snapshot less ledger
+	Difference *float64 `form:"difference,omitempty" json:"difference,omitempty" xml:"difference,omitempty"`
+	// Price valuing the suggested position adjustments
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Suggested adjusting entries; empty when no price is known for a position
+	Adjustments []*LedgerTransactionResponseBody `form:"adjustments,omitempty" json:"adjustments,omitempty" xml:"adjustments,omitempty"`
+	// Review status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Why the status was set
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// When the break was first found
+	Detected *string `form:"detected,omitempty" json:"detected,omitempty" xml:"detected,omitempty"`
+	// When the break last changed
+	Updated *string `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// IDs of the adjustments posted to resolve the break
+	Posted []string `form:"posted,omitempty" json:"posted,omitempty" xml:"posted,omitempty"`
+}
+
+// GetTaxReportResponseBody is the type of the "portfolio" service
+// "getTaxReport" endpoint HTTP response body.
+type GetTaxReportResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Tax year
+	Year *int `form:"year,omitempty" json:"year,omitempty" xml:"year,omitempty"`
+	// Currency of the amounts
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Accounts with disposals in the year
+	Accounts []*TaxAccountResponseBody `form:"accounts,omitempty" json:"accounts,omitempty" xml:"accounts,omitempty"`
+	// Short-term totals
+	ShortTerm *TaxTotalsResponseBody `form:"short_term,omitempty" json:"short_term,omitempty" xml:"short_term,omitempty"`
+	// Long-term totals
+	LongTerm *TaxTotalsResponseBody `form:"long_term,omitempty" json:"long_term,omitempty" xml:"long_term,omitempty"`
+	// Totals
+	Total *TaxTotalsResponseBody `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Wash sales of the losses realized in the year
+	WashSales []*WashSaleResponseBody `form:"wash_sales,omitempty" json:"wash_sales,omitempty" xml:"wash_sales,omitempty"`
+}
+
+// ListLotsResponseBody is the type of the "portfolio" service "listLots"
+// endpoint HTTP response body.
+type ListLotsResponseBody []*TaxLotResponse
+
+// FindTaxLossHarvestsResponseBody is the type of the "portfolio" service
+// "findTaxLossHarvests" endpoint HTTP response body.
+type FindTaxLossHarvestsResponseBody struct {
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Date of the prices
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Suggested harvests, largest saving first
+	Opportunities []*HarvestOpportunityResponseBody `form:"opportunities,omitempty" json:"opportunities,omitempty" xml:"opportunities,omitempty"`
+	// Losses skipped to avoid wash sales
+	Skipped []*SkippedHarvestResponseBody `form:"skipped,omitempty" json:"skipped,omitempty" xml:"skipped,omitempty"`
+	// Losses realized by all suggested harvests
+	TotalLoss *float64 `form:"total_loss,omitempty" json:"total_loss,omitempty" xml:"total_loss,omitempty"`
+	// Tax saved by all suggested harvests
+	EstimatedSavings *float64 `form:"estimated_savings,omitempty" json:"estimated_savings,omitempty" xml:"estimated_savings,omitempty"`
+}
+
+// ListAccountsResponseBody is the type of the "portfolio" service
+// "listAccounts" endpoint HTTP response body.
+type ListAccountsResponseBody []*AccountResponse
+
+// SetAccountResponseBody is the type of the "portfolio" service "setAccount"
+// endpoint HTTP response body.
+type SetAccountResponseBody struct {
+	// Account identifier transactions refer to; empty for the default account
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Account type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// How gains and income of the account are taxed
+	TaxTreatment *string `form:"tax_treatment,omitempty" json:"tax_treatment,omitempty" xml:"tax_treatment,omitempty"`
+	// Broker or institution holding the account
+	Custodian *string `form:"custodian,omitempty" json:"custodian,omitempty" xml:"custodian,omitempty"`
+	// Base currency of the account
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Set explicitly; otherwise implied by transactions as a taxable account
+	Registered *bool `form:"registered,omitempty" json:"registered,omitempty" xml:"registered,omitempty"`
+	// Cash balance
+	Cash *float64 `form:"cash,omitempty" json:"cash,omitempty" xml:"cash,omitempty"`
+	// Market value of the positions
+	HoldingsValue *float64 `form:"holdings_value,omitempty" json:"holdings_value,omitempty" xml:"holdings_value,omitempty"`
+	// Cash plus holdings value
+	TotalValue *float64 `form:"total_value,omitempty" json:"total_value,omitempty" xml:"total_value,omitempty"`
+}
+
+// RemoveAccountResponseBody is the type of the "portfolio" service
+// "removeAccount" endpoint HTTP response body.
+type RemoveAccountResponseBody struct {
+	// Account identifier transactions refer to; empty for the default account
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Account type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// How gains and income of the account are taxed
+	TaxTreatment *string `form:"tax_treatment,omitempty" json:"tax_treatment,omitempty" xml:"tax_treatment,omitempty"`
+	// Broker or institution holding the account
+	Custodian *string `form:"custodian,omitempty" json:"custodian,omitempty" xml:"custodian,omitempty"`
+	// Base currency of the account
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Set explicitly; otherwise implied by transactions as a taxable account
+	Registered *bool `form:"registered,omitempty" json:"registered,omitempty" xml:"registered,omitempty"`
+	// Cash balance
+	Cash *float64 `form:"cash,omitempty" json:"cash,omitempty" xml:"cash,omitempty"`
+	// Market value of the positions
+	HoldingsValue *float64 `form:"holdings_value,omitempty" json:"holdings_value,omitempty" xml:"holdings_value,omitempty"`
+	// Cash plus holdings value
+	TotalValue *float64 `form:"total_value,omitempty" json:"total_value,omitempty" xml:"total_value,omitempty"`
+}
+
+// AllocationBucketResponseBody is used to define fields on response body types.
+type AllocationBucketResponseBody struct {
+	// Bucket name, e.g. an asset class or sector
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Market value of the bucket
+	Value *float64 `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Share of total portfolio value as a decimal fraction
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Symbols held in the bucket
+	Symbols []string `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+}
+
+// TargetWeightResponseBody is used to define fields on response body types.
+type TargetWeightResponseBody struct {
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Target weight as a decimal fraction
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Absolute drift allowed either side of the target weight
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+}
+
+// TargetWeightRequestBodyRequestBody is used to define fields on request body
+// types.
+type TargetWeightRequestBodyRequestBody struct {
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Target weight as a decimal fraction
+	Weight float64 `form:"weight" json:"weight" xml:"weight"`
+	// Absolute drift allowed either side of the target weight
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+}
+
+// ProposedTradeResponseBody is used to define fields on response body types.
+type ProposedTradeResponseBody struct {
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Trade direction
+	Side *string `form:"side,omitempty" json:"side,omitempty" xml:"side,omitempty"`
+	// Quantity, rounded to the instrument lot size
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Assumed execution price
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Trade value
+	Value *float64 `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Weight before the trade
+	CurrentWeight *float64 `form:"current_weight,omitempty" json:"current_weight,omitempty" xml:"current_weight,omitempty"`
+	// Target weight
+	TargetWeight *float64 `form:"target_weight,omitempty" json:"target_weight,omitempty" xml:"target_weight,omitempty"`
+	// Weight after the trade
+	ProjectedWeight *float64 `form:"projected_weight,omitempty" json:"projected_weight,omitempty" xml:"projected_weight,omitempty"`
+}
+
+// BenchmarkComponentRequestBodyRequestBody is used to define fields on request
+// body types.
+type BenchmarkComponentRequestBodyRequestBody struct {
+	// Instrument symbol priced through the market data provider
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Target weight as a decimal fraction
+	Weight float64 `form:"weight" json:"weight" xml:"weight"`
+}
+
+// BenchmarkComponentResponseBody is used to define fields on response body
+// types.
+type BenchmarkComponentResponseBody struct {
+	// Instrument symbol priced through the market data provider
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Target weight as a decimal fraction
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+}
+
+// BenchmarkDefinitionResponseBody is used to define fields on response body
+// types.
+type BenchmarkDefinitionResponseBody struct {
+	// Display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Blend components; weights must sum to 1
+	Components []*BenchmarkComponentResponseBody `form:"components,omitempty" json:"components,omitempty" xml:"components,omitempty"`
+	// Blend in the form 60% SPY / 40% AGG; set either components or blend
+	Blend *string `form:"blend,omitempty" json:"blend,omitempty" xml:"blend,omitempty"`
+	// How often the blend is reset to its weights; none keeps static initial
+	// weights
+	Rebalance *string `form:"rebalance,omitempty" json:"rebalance,omitempty" xml:"rebalance,omitempty"`
+}
+
+// BenchmarkComparisonPointResponseBody is used to define fields on response
+// body types.
+type BenchmarkComparisonPointResponseBody struct {
+	// Trading day
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Portfolio cumulative return
+	Portfolio *float64 `form:"portfolio,omitempty" json:"portfolio,omitempty" xml:"portfolio,omitempty"`
+	// Benchmark cumulative return
+	Benchmark *float64 `form:"benchmark,omitempty" json:"benchmark,omitempty" xml:"benchmark,omitempty"`
+}
+
+// RiskWindowResponseBody is used to define fields on response body types.
+type RiskWindowResponseBody struct {
+	// Base day of the window
+	Start *string `form:"start,omitempty" json:"start,omitempty" xml:"start,omitempty"`
+	// Last day of the window
+	End *string `form:"end,omitempty" json:"end,omitempty" xml:"end,omitempty"`
+	// Annualized volatility of daily returns
+	Volatility *float64 `form:"volatility,omitempty" json:"volatility,omitempty" xml:"volatility,omitempty"`
+	// Annualized excess return over volatility
+	SharpeRatio *float64 `form:"sharpe_ratio,omitempty" json:"sharpe_ratio,omitempty" xml:"sharpe_ratio,omitempty"`
+	// Annualized excess return over downside deviation
+	SortinoRatio *float64 `form:"sortino_ratio,omitempty" json:"sortino_ratio,omitempty" xml:"sortino_ratio,omitempty"`
+	// Beta against the benchmark
+	Beta *float64 `form:"beta,omitempty" json:"beta,omitempty" xml:"beta,omitempty"`
+	// Correlation of daily returns with the benchmark
+	Correlation *float64 `form:"correlation,omitempty" json:"correlation,omitempty" xml:"correlation,omitempty"`
+	// Largest peak-to-trough decline as a positive fraction
+	MaxDrawdown *float64 `form:"max_drawdown,omitempty" json:"max_drawdown,omitempty" xml:"max_drawdown,omitempty"`
+}
+
+// VaRContributionResponseBody is used to define fields on response body types.
+type VaRContributionResponseBody struct {
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Current market value of the position
+	Value *float64 `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Change in VaR per unit of value added to the position
+	MarginalVar *float64 `form:"marginal_var,omitempty" json:"marginal_var,omitempty" xml:"marginal_var,omitempty"`
+	// Share of portfolio VaR attributed to the position; components sum to the VaR
+	ComponentVar *float64 `form:"component_var,omitempty" json:"component_var,omitempty" xml:"component_var,omitempty"`
+}
+
+// StressScenarioResponse is used to define fields on response body types.
+type StressScenarioResponse struct {
+	// Scenario name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// What the scenario represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Shock per asset class
+	AssetClasses map[string]float64 `form:"asset_classes,omitempty" json:"asset_classes,omitempty" xml:"asset_classes,omitempty"`
+	// Shock per symbol, overriding asset class shocks
+	Symbols map[string]float64 `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Parallel change in yields, e.g. 0.02 for +200bp, applied through instrument
+	// duration
+	RateShift *float64 `form:"rate_shift,omitempty" json:"rate_shift,omitempty" xml:"rate_shift,omitempty"`
+	// Move of each currency against all others
+	Currencies map[string]float64 `form:"currencies,omitempty" json:"currencies,omitempty" xml:"currencies,omitempty"`
+	// Start of a replayed historical window
+	ReplayStart *string `form:"replay_start,omitempty" json:"replay_start,omitempty" xml:"replay_start,omitempty"`
+	// End of a replayed historical window
+	ReplayEnd *string `form:"replay_end,omitempty" json:"replay_end,omitempty" xml:"replay_end,omitempty"`
+}
+
+// StressScenarioResponseBody is used to define fields on response body types.
+type StressScenarioResponseBody struct {
+	// Scenario name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// What the scenario represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Shock per asset class
+	AssetClasses map[string]float64 `form:"asset_classes,omitempty" json:"asset_classes,omitempty" xml:"asset_classes,omitempty"`
+	// Shock per symbol, overriding asset class shocks
+	Symbols map[string]float64 `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Parallel change in yields, e.g. 0.02 for +200bp, applied through instrument
+	// duration
+	RateShift *float64 `form:"rate_shift,omitempty" json:"rate_shift,omitempty" xml:"rate_shift,omitempty"`
+	// Move of each currency against all others
+	Currencies map[string]float64 `form:"currencies,omitempty" json:"currencies,omitempty" xml:"currencies,omitempty"`
+	// Start of a replayed historical window
+	ReplayStart *string `form:"replay_start,omitempty" json:"replay_start,omitempty" xml:"replay_start,omitempty"`
+	// End of a replayed historical window
+	ReplayEnd *string `form:"replay_end,omitempty" json:"replay_end,omitempty" xml:"replay_end,omitempty"`
+}
+
+// StressImpactResponseBody is used to define fields on response body types.
+type StressImpactResponseBody struct {
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Asset class of the instrument
+	AssetClass *string `form:"asset_class,omitempty" json:"asset_class,omitempty" xml:"asset_class,omitempty"`
+	// Current market value
+	Value *float64 `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Market value under the scenario
+	ProjectedValue *float64 `form:"projected_value,omitempty" json:"projected_value,omitempty" xml:"projected_value,omitempty"`
+	// Projected profit or loss
+	Pnl *float64 `form:"pnl,omitempty" json:"pnl,omitempty" xml:"pnl,omitempty"`
+	// Total price change applied, including currency effects
+	Shock *float64 `form:"shock,omitempty" json:"shock,omitempty" xml:"shock,omitempty"`
+}
+
+// AssetClassAssumptionRequestBody is used to define fields on request body
+// types.
+type AssetClassAssumptionRequestBody struct {
+	// Asset class, e.g. equity
+	AssetClass string `form:"asset_class" json:"asset_class" xml:"asset_class"`
+	// Share of the portfolio; defaults to the current allocation
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Expected annual return
+	ExpectedReturn *float64 `form:"expected_return,omitempty" json:"expected_return,omitempty" xml:"expected_return,omitempty"`
+	// Annual volatility
+	Volatility *float64 `form:"volatility,omitempty" json:"volatility,omitempty" xml:"volatility,omitempty"`
+}
+
+// AssetClassAssumptionResponseBody is used to define fields on response body
+// types.
+type AssetClassAssumptionResponseBody struct {
+	// Asset class, e.g. equity
+	AssetClass *string `form:"asset_class,omitempty" json:"asset_class,omitempty" xml:"asset_class,omitempty"`
+	// Share of the portfolio; defaults to the current allocation
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Expected annual return
+	ExpectedReturn *float64 `form:"expected_return,omitempty" json:"expected_return,omitempty" xml:"expected_return,omitempty"`
+	// Annual volatility
+	Volatility *float64 `form:"volatility,omitempty" json:"volatility,omitempty" xml:"volatility,omitempty"`
+}
+
+// ProjectionBandResponseBody is used to define fields on response body types.
+type ProjectionBandResponseBody struct {
+	// Date
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// 5th percentile
+	P5 *float64 `form:"p5,omitempty" json:"p5,omitempty" xml:"p5,omitempty"`
+	// 25th percentile
+	P25 *float64 `form:"p25,omitempty" json:"p25,omitempty" xml:"p25,omitempty"`
+	// Median
+	P50 *float64 `form:"p50,omitempty" json:"p50,omitempty" xml:"p50,omitempty"`
+	// 75th percentile
+	P75 *float64 `form:"p75,omitempty" json:"p75,omitempty" xml:"p75,omitempty"`
+	// 95th percentile
+	P95 *float64 `form:"p95,omitempty" json:"p95,omitempty" xml:"p95,omitempty"`
+}
+
+// CorporateActionResponse is used to define fields on response body types.
+type CorporateActionResponse struct {
+	// Ledger transaction ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Effective date
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Action type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Symbol affected
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Renamed, spun-off or acquiring symbol
+	NewSymbol *string `form:"new_symbol,omitempty" json:"new_symbol,omitempty" xml:"new_symbol,omitempty"`
+	// Resulting shares per share held, e.g. 1.5 for a 3-for-2 split or 0.1 for a
+	// 1-for-10 reverse split
+	Ratio *float64 `form:"ratio,omitempty" json:"ratio,omitempty" xml:"ratio,omitempty"`
+	// Cash paid per share held in a merger
+	CashPerShare *float64 `form:"cash_per_share,omitempty" json:"cash_per_share,omitempty" xml:"cash_per_share,omitempty"`
+	// Share of cost basis moved to spun-off shares
+	BasisFraction *float64 `form:"basis_fraction,omitempty" json:"basis_fraction,omitempty" xml:"basis_fraction,omitempty"`
+	// Price of the resulting shares on the effective date, for cash in lieu and
+	// merger basis allocation
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Pay fractional resulting shares in cash
+	CashInLieu *bool `form:"cash_in_lieu,omitempty" json:"cash_in_lieu,omitempty" xml:"cash_in_lieu,omitempty"`
+	// Free-form note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// CorporateActionInputRequestBody is used to define fields on request body
+// types.
+type CorporateActionInputRequestBody struct {
+	// Effective date
+	Date string `form:"date" json:"date" xml:"date"`
+	// Action type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Symbol affected
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Renamed, spun-off or acquiring symbol
+	NewSymbol *string `form:"new_symbol,omitempty" json:"new_symbol,omitempty" xml:"new_symbol,omitempty"`
+	// Resulting shares per share held, e.g. 1.5 for a 3-for-2 split or 0.1 for a
+	// 1-for-10 reverse split
+	Ratio float64 `form:"ratio" json:"ratio" xml:"ratio"`
+	// Cash paid per share held in a merger
+	CashPerShare float64 `form:"cash_per_share" json:"cash_per_share" xml:"cash_per_share"`
+	// Share of cost basis moved to spun-off shares
+	BasisFraction float64 `form:"basis_fraction" json:"basis_fraction" xml:"basis_fraction"`
+	// Price of the resulting shares on the effective date, for cash in lieu and
+	// merger basis allocation
+	Price float64 `form:"price" json:"price" xml:"price"`
+	// Pay fractional resulting shares in cash
+	CashInLieu bool `form:"cash_in_lieu" json:"cash_in_lieu" xml:"cash_in_lieu"`
+	// Free-form note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// IncomeBucketResponseBody is used to define fields on response body types.
+type IncomeBucketResponseBody struct {
+	// Period label, symbol or currency
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Gross income
+	Gross *float64 `form:"gross,omitempty" json:"gross,omitempty" xml:"gross,omitempty"`
+	// Tax withheld at source
+	Withholding *float64 `form:"withholding,omitempty" json:"withholding,omitempty" xml:"withholding,omitempty"`
+	// Income after withholding
+	Net *float64 `form:"net,omitempty" json:"net,omitempty" xml:"net,omitempty"`
+}
+
+// IncomeForecastResponseBody is used to define fields on response body types.
+type IncomeForecastResponseBody struct {
+	// Month as YYYY-MM
+	Month *string `form:"month,omitempty" json:"month,omitempty" xml:"month,omitempty"`
+	// Expected gross income
+	Amount *float64 `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+}
+
+// BondPositionResponse is used to define fields on response body types.
+type BondPositionResponse struct {
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Number of bonds held
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Annual coupon rate as a decimal fraction
+	Coupon *float64 `form:"coupon,omitempty" json:"coupon,omitempty" xml:"coupon,omitempty"`
+	// Coupons per year; 0 for zero-coupon bonds
+	CouponFrequency *int `form:"coupon_frequency,omitempty" json:"coupon_frequency,omitempty" xml:"coupon_frequency,omitempty"`
+	// Day count convention
+	DayCount *string `form:"day_count,omitempty" json:"day_count,omitempty" xml:"day_count,omitempty"`
+	// Maturity date
+	Maturity *string `form:"maturity,omitempty" json:"maturity,omitempty" xml:"maturity,omitempty"`
+	// Face value per bond
+	FaceValue *float64 `form:"face_value,omitempty" json:"face_value,omitempty" xml:"face_value,omitempty"`
+	// Price measures per bond
+	Analytics *BondAnalyticsResponse `form:"analytics,omitempty" json:"analytics,omitempty" xml:"analytics,omitempty"`
+	// Quantity times dirty price
+	MarketValue *float64 `form:"market_value,omitempty" json:"market_value,omitempty" xml:"market_value,omitempty"`
+	// Quantity times accrued interest
+	AccruedValue *float64 `form:"accrued_value,omitempty" json:"accrued_value,omitempty" xml:"accrued_value,omitempty"`
+}
+
+// BondAnalyticsResponse is used to define fields on response body types.
+type BondAnalyticsResponse struct {
+	// Settlement date
+	Settlement *string `form:"settlement,omitempty" json:"settlement,omitempty" xml:"settlement,omitempty"`
+	// Price excluding accrued interest
+	CleanPrice *float64 `form:"clean_price,omitempty" json:"clean_price,omitempty" xml:"clean_price,omitempty"`
+	// Price including accrued interest
+	DirtyPrice *float64 `form:"dirty_price,omitempty" json:"dirty_price,omitempty" xml:"dirty_price,omitempty"`
+	// Coupon earned since the last coupon date
+	AccruedInterest *float64 `form:"accrued_interest,omitempty" json:"accrued_interest,omitempty" xml:"accrued_interest,omitempty"`
+	// Annual yield compounded at the coupon frequency
+	YieldToMaturity *float64 `form:"yield_to_maturity,omitempty" json:"yield_to_maturity,omitempty" xml:"yield_to_maturity,omitempty"`
+	// Macaulay duration in years
+	MacaulayDuration *float64 `form:"macaulay_duration,omitempty" json:"macaulay_duration,omitempty" xml:"macaulay_duration,omitempty"`
+	// Modified duration in years
+	ModifiedDuration *float64 `form:"modified_duration,omitempty" json:"modified_duration,omitempty" xml:"modified_duration,omitempty"`
+}
+
+// BondCashFlowResponseBody is used to define fields on response body types.
+type BondCashFlowResponseBody struct {
+	// Payment date
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Coupon income
+	Coupon *float64 `form:"coupon,omitempty" json:"coupon,omitempty" xml:"coupon,omitempty"`
+	// Principal repaid at maturity
+	Principal *float64 `form:"principal,omitempty" json:"principal,omitempty" xml:"principal,omitempty"`
+	// Coupon plus principal
+	Total *float64 `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// CashFlowLadderYearResponseBody is used to define fields on response body
+// types.
+type CashFlowLadderYearResponseBody struct {
+	// Calendar year
+	Year *int `form:"year,omitempty" json:"year,omitempty" xml:"year,omitempty"`
+	// Coupon income
+	Coupon *float64 `form:"coupon,omitempty" json:"coupon,omitempty" xml:"coupon,omitempty"`
+	// Principal repaid
+	Principal *float64 `form:"principal,omitempty" json:"principal,omitempty" xml:"principal,omitempty"`
+	// Coupon plus principal
+	Total *float64 `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// OptionPositionResponseBody is used to define fields on response body types.
+type OptionPositionResponseBody struct {
+	// Account holding the contracts
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Option symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Underlying symbol
+	Underlying *string `form:"underlying,omitempty" json:"underlying,omitempty" xml:"underlying,omitempty"`
+	// Option type
+	OptionType *string `form:"option_type,omitempty" json:"option_type,omitempty" xml:"option_type,omitempty"`
+	// Strike price
+	Strike *float64 `form:"strike,omitempty" json:"strike,omitempty" xml:"strike,omitempty"`
+	// Expiry date
+	Expiry *string `form:"expiry,omitempty" json:"expiry,omitempty" xml:"expiry,omitempty"`
+	// Units of the underlying per contract
+	Multiplier *float64 `form:"multiplier,omitempty" json:"multiplier,omitempty" xml:"multiplier,omitempty"`
+	// Option on a futures contract
+	FuturesOption *bool `form:"futures_option,omitempty" json:"futures_option,omitempty" xml:"futures_option,omitempty"`
+	// Contracts held
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Latest underlying price
+	UnderlyingPrice *float64 `form:"underlying_price,omitempty" json:"underlying_price,omitempty" xml:"underlying_price,omitempty"`
+	// Annual volatility input
+	Volatility *float64 `form:"volatility,omitempty" json:"volatility,omitempty" xml:"volatility,omitempty"`
+	// Model price per contract
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Model value of the position
+	MarketValue *float64 `form:"market_value,omitempty" json:"market_value,omitempty" xml:"market_value,omitempty"`
+	// Change in value per unit move of the underlying, in underlying units
+	Delta *float64 `form:"delta,omitempty" json:"delta,omitempty" xml:"delta,omitempty"`
+	// Change in delta per unit move of the underlying
+	Gamma *float64 `form:"gamma,omitempty" json:"gamma,omitempty" xml:"gamma,omitempty"`
+	// Change in value per calendar day
+	Theta *float64 `form:"theta,omitempty" json:"theta,omitempty" xml:"theta,omitempty"`
+	// Change in value per volatility point
+	Vega *float64 `form:"vega,omitempty" json:"vega,omitempty" xml:"vega,omitempty"`
+}
+
+// UnderlyingExposureResponseBody is used to define fields on response body
+// types.
+type UnderlyingExposureResponseBody struct {
+	// Underlying symbol
+	Underlying *string `form:"underlying,omitempty" json:"underlying,omitempty" xml:"underlying,omitempty"`
+	// Latest underlying price
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Units of the underlying held directly
+	Shares *float64 `form:"shares,omitempty" json:"shares,omitempty" xml:"shares,omitempty"`
+	// Delta of the options on the underlying, in underlying units
+	OptionDelta *float64 `form:"option_delta,omitempty" json:"option_delta,omitempty" xml:"option_delta,omitempty"`
+	// Shares plus option delta
+	DeltaAdjustedShares *float64 `form:"delta_adjusted_shares,omitempty" json:"delta_adjusted_shares,omitempty" xml:"delta_adjusted_shares,omitempty"`
+	// Delta-adjusted shares times price
+	DeltaAdjustedExposure *float64 `form:"delta_adjusted_exposure,omitempty" json:"delta_adjusted_exposure,omitempty" xml:"delta_adjusted_exposure,omitempty"`
+}
+
+// OptionSettlementResponse is used to define fields on response body types.
+type OptionSettlementResponse struct {
+	// Ledger transaction ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Expiry date
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Option or underlying symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Transaction type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Quantity
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Price
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// What happened
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// MarginAccountStatusResponseBody is used to define fields on response body
+// types.
+type MarginAccountStatusResponseBody struct {
+	// Account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Cash balance; negative when borrowing
+	Cash *float64 `form:"cash,omitempty" json:"cash,omitempty" xml:"cash,omitempty"`
+	// Market value of long positions
+	LongValue *float64 `form:"long_value,omitempty" json:"long_value,omitempty" xml:"long_value,omitempty"`
+	// Market value of short positions
+	ShortValue *float64 `form:"short_value,omitempty" json:"short_value,omitempty" xml:"short_value,omitempty"`
+	// Proceeds of the open short sales
+	ShortProceeds *float64 `form:"short_proceeds,omitempty" json:"short_proceeds,omitempty" xml:"short_proceeds,omitempty"`
+	// Cash plus long value less short value
+	Equity *float64 `form:"equity,omitempty" json:"equity,omitempty" xml:"equity,omitempty"`
+	// Margin loan balance
+	LoanBalance *float64 `form:"loan_balance,omitempty" json:"loan_balance,omitempty" xml:"loan_balance,omitempty"`
+	// Equity required by the initial requirement
+	InitialRequirement *float64 `form:"initial_requirement,omitempty" json:"initial_requirement,omitempty" xml:"initial_requirement,omitempty"`
+	// Equity required by the maintenance requirements
+	MaintenanceRequirement *float64 `form:"maintenance_requirement,omitempty" json:"maintenance_requirement,omitempty" xml:"maintenance_requirement,omitempty"`
+	// Equity above the maintenance requirement; negative under a margin call
+	MaintenanceExcess *float64 `form:"maintenance_excess,omitempty" json:"maintenance_excess,omitempty" xml:"maintenance_excess,omitempty"`
+	// Value of new positions the equity supports
+	BuyingPower *float64 `form:"buying_power,omitempty" json:"buying_power,omitempty" xml:"buying_power,omitempty"`
+	// Equity is below the maintenance requirement
+	MarginCall *bool `form:"margin_call,omitempty" json:"margin_call,omitempty" xml:"margin_call,omitempty"`
+	// Margin interest charged to date
+	InterestCharged *float64 `form:"interest_charged,omitempty" json:"interest_charged,omitempty" xml:"interest_charged,omitempty"`
+	// Stock borrow fees charged to date
+	BorrowFeesCharged *float64 `form:"borrow_fees_charged,omitempty" json:"borrow_fees_charged,omitempty" xml:"borrow_fees_charged,omitempty"`
+	// Margin interest accrued this month, not yet charged
+	AccruedInterest *float64 `form:"accrued_interest,omitempty" json:"accrued_interest,omitempty" xml:"accrued_interest,omitempty"`
+	// Borrow fees accrued this month, not yet charged
+	AccruedBorrowFees *float64 `form:"accrued_borrow_fees,omitempty" json:"accrued_borrow_fees,omitempty" xml:"accrued_borrow_fees,omitempty"`
+}
+
+// PortfolioEventResponse is used to define fields on response body types.
+type PortfolioEventResponse struct {
+	// Event sequence number
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// When the event occurred
+	Time *string `form:"time,omitempty" json:"time,omitempty" xml:"time,omitempty"`
+	// Portfolio identifier
+	PortfolioID *string `form:"portfolio_id,omitempty" json:"portfolio_id,omitempty" xml:"portfolio_id,omitempty"`
+	// Event type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Account concerned, if any
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Description
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// OrderResponse is used to define fields on response body types.
+type OrderResponse struct {
+	// Order ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Account trading; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Order side
+	Side *string `form:"side,omitempty" json:"side,omitempty" xml:"side,omitempty"`
+	// Order type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Quantity
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Limit price of limit and stop-limit orders
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// Stop price of stop and stop-limit orders
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// How long the order works
+	TimeInForce *string `form:"time_in_force,omitempty" json:"time_in_force,omitempty" xml:"time_in_force,omitempty"`
+	// Order status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The stop price of a stop or stop-limit order traded
+	Triggered *bool `form:"triggered,omitempty" json:"triggered,omitempty" xml:"triggered,omitempty"`
+	// When the order was placed
+	Created *string `form:"created,omitempty" json:"created,omitempty" xml:"created,omitempty"`
+	// When the status last changed
+	Updated *string `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// Fill price including slippage; 0 until filled
+	FillPrice *float64 `form:"fill_price,omitempty" json:"fill_price,omitempty" xml:"fill_price,omitempty"`
+	// Commission charged; 0 until filled
+	Commission *float64 `form:"commission,omitempty" json:"commission,omitempty" xml:"commission,omitempty"`
+	// Why the order was rejected
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// TradeInputRequestBody is used to define fields on request body types.
+type TradeInputRequestBody struct {
+	// Account trading; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Trade side
+	Side string `form:"side" json:"side" xml:"side"`
+	// Quantity
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Trade price; defaults to the latest price
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Commission
+	Fee float64 `form:"fee" json:"fee" xml:"fee"`
+}
+
+// PortfolioSummaryResponseBody is used to define fields on response body types.
+type PortfolioSummaryResponseBody struct {
+	// Total Balance
+	Balance *float64 `form:"balance,omitempty" json:"balance,omitempty" xml:"balance,omitempty"`
+	// Currency Code
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Change Percentage
+	ChangePercent *float64 `form:"change_percent,omitempty" json:"change_percent,omitempty" xml:"change_percent,omitempty"`
+}
+
+// AllocationChangeResponseBody is used to define fields on response body types.
+type AllocationChangeResponseBody struct {
+	// Bucket key
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Market value before
+	ValueBefore *float64 `form:"value_before,omitempty" json:"value_before,omitempty" xml:"value_before,omitempty"`
+	// Share of portfolio value before
+	WeightBefore *float64 `form:"weight_before,omitempty" json:"weight_before,omitempty" xml:"weight_before,omitempty"`
+	// Market value after
+	ValueAfter *float64 `form:"value_after,omitempty" json:"value_after,omitempty" xml:"value_after,omitempty"`
+	// Share of portfolio value after
+	WeightAfter *float64 `form:"weight_after,omitempty" json:"weight_after,omitempty" xml:"weight_after,omitempty"`
+}
+
+// RealizedLotResponseBody is used to define fields on response body types.
+type RealizedLotResponseBody struct {
+	// Account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Ledger transaction that opened the lot
+	LotID *string `form:"lot_id,omitempty" json:"lot_id,omitempty" xml:"lot_id,omitempty"`
+	// Acquisition date
+	Acquired *string `form:"acquired,omitempty" json:"acquired,omitempty" xml:"acquired,omitempty"`
+	// Quantity relieved
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Net proceeds
+	Proceeds *float64 `form:"proceeds,omitempty" json:"proceeds,omitempty" xml:"proceeds,omitempty"`
+	// Cost basis relieved
+	CostBasis *float64 `form:"cost_basis,omitempty" json:"cost_basis,omitempty" xml:"cost_basis,omitempty"`
+	// Realized gain; negative for losses
+	Gain *float64 `form:"gain,omitempty" json:"gain,omitempty" xml:"gain,omitempty"`
+	// Held long enough for long-term treatment
+	LongTerm *bool `form:"long_term,omitempty" json:"long_term,omitempty" xml:"long_term,omitempty"`
+}
+
+// HoldingsRiskResponseBody is used to define fields on response body types.
+type HoldingsRiskResponseBody struct {
+	// Annualized volatility as a share of portfolio value
+	Volatility *float64 `form:"volatility,omitempty" json:"volatility,omitempty" xml:"volatility,omitempty"`
+	// One-day parametric value at risk
+	ValueAtRisk *float64 `form:"value_at_risk,omitempty" json:"value_at_risk,omitempty" xml:"value_at_risk,omitempty"`
+	// One-day expected shortfall beyond the value at risk
+	ExpectedShortfall *float64 `form:"expected_shortfall,omitempty" json:"expected_shortfall,omitempty" xml:"expected_shortfall,omitempty"`
+}
+
+// BacktestTargetRequestBody is used to define fields on request body types.
+type BacktestTargetRequestBody struct {
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Target weight
+	Weight float64 `form:"weight" json:"weight" xml:"weight"`
+}
+
+// BacktestPointResponseBody is used to define fields on response body types.
+type BacktestPointResponseBody struct {
+	// Trading day
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Cash plus market value
+	Value *float64 `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// External cash flow on the day
+	Flow *float64 `form:"flow,omitempty" json:"flow,omitempty" xml:"flow,omitempty"`
+	// Decline of the time-weighted index from its running peak, as a positive
+	// fraction
+	Drawdown *float64 `form:"drawdown,omitempty" json:"drawdown,omitempty" xml:"drawdown,omitempty"`
+}
+
+// ImportRowResponseBody is used to define fields on response body types.
+type ImportRowResponseBody struct {
+	// Line of the row in the file
+	Line *int `form:"line,omitempty" json:"line,omitempty" xml:"line,omitempty"`
+	// Row outcome
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Stable fingerprint of the transaction used to detect duplicates
+	Fingerprint *string `form:"fingerprint,omitempty" json:"fingerprint,omitempty" xml:"fingerprint,omitempty"`
+	// Transaction read from the row
+	Transaction *LedgerTransactionResponseBody `form:"transaction,omitempty" json:"transaction,omitempty" xml:"transaction,omitempty"`
+	// Why the row could not be read or posted, or was skipped
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// LedgerTransactionResponseBody is used to define fields on response body
+// types.
+type LedgerTransactionResponseBody struct {
+	// Transaction identifier, once posted
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Transaction date
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Transaction type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Quantity traded
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Price per unit
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Cash amount of deposits, withdrawals, income and fees
+	Amount *float64 `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// Commission
+	Fee *float64 `form:"fee,omitempty" json:"fee,omitempty" xml:"fee,omitempty"`
+	// Description
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// AccountReconciliationResponseBody is used to define fields on response body
+// types.
+type AccountReconciliationResponseBody struct {
+	// Ledger account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Statement date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Positions by symbol, including ledger holdings the statement does not list
+	Positions []*PositionCheckResponseBody `form:"positions,omitempty" json:"positions,omitempty" xml:"positions,omitempty"`
+	// Cash balance in the statement
+	StatementCash *float64 `form:"statement_cash,omitempty" json:"statement_cash,omitempty" xml:"statement_cash,omitempty"`
+	// Cash in the ledger account
+	LedgerCash *float64 `form:"ledger_cash,omitempty" json:"ledger_cash,omitempty" xml:"ledger_cash,omitempty"`
+	// Whether every position and the cash agree
+	Matched *bool `form:"matched,omitempty" json:"matched,omitempty" xml:"matched,omitempty"`
+}
+
+// PositionCheckResponseBody is used to define fields on response body types.
+type PositionCheckResponseBody struct {
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Quantity in the statement
+	StatementQuantity *float64 `form:"statement_quantity,omitempty" json:"statement_quantity,omitempty" xml:"statement_quantity,omitempty"`
+	// Quantity in the ledger
+	LedgerQuantity *float64 `form:"ledger_quantity,omitempty" json:"ledger_quantity,omitempty" xml:"ledger_quantity,omitempty"`
+	// Statement less ledger quantity
+	Difference *float64 `form:"difference,omitempty" json:"difference,omitempty" xml:"difference,omitempty"`
+	// Whether the quantities agree
+	Matched *bool `form:"matched,omitempty" json:"matched,omitempty" xml:"matched,omitempty"`
+}
+
+// ReconciledAccountResponseBody is used to define fields on response body
+// types.
+type ReconciledAccountResponseBody struct {
+	// Ledger account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Snapshot date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Positions in the snapshot
+	Positions *int `form:"positions,omitempty" json:"positions,omitempty" xml:"positions,omitempty"`
+	// Whether the snapshot has a cash balance
+	Cash *bool `form:"cash,omitempty" json:"cash,omitempty" xml:"cash,omitempty"`
+	// Breaks found
+	Breaks *int `form:"breaks,omitempty" json:"breaks,omitempty" xml:"breaks,omitempty"`
+}
+
+// ReconciliationBreakResponseBody is used to define fields on response body
+// types.
+type ReconciliationBreakResponseBody struct {
+	// Break ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Ledger account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Snapshot date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Break kind
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Instrument symbol; absent for cash differences
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Quantity, or cash balance, in the snapshot
+	Statement *float64 `form:"statement,omitempty" json:"statement,omitempty" xml:"statement,omitempty"`
+	// Quantity, or cash balance, in the ledger
+	Ledger *float64 `form:"ledger,omitempty" json:"ledger,omitempty" xml:"ledger,omitempty"`
+	// Snapshot less ledger
+	Difference *float64 `form:"difference,omitempty" json:"difference,omitempty" xml:"difference,omitempty"`
+	// Price valuing the suggested position adjustments
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Suggested adjusting entries; empty when no price is known for a position
+	Adjustments []*LedgerTransactionResponseBody `form:"adjustments,omitempty" json:"adjustments,omitempty" xml:"adjustments,omitempty"`
+	// Review status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Why the status was set
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// When the break was first found
+	Detected *string `form:"detected,omitempty" json:"detected,omitempty" xml:"detected,omitempty"`
+	// When the break last changed
+	Updated *string `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// IDs of the adjustments posted to resolve the break
+	Posted []string `form:"posted,omitempty" json:"posted,omitempty" xml:"posted,omitempty"`
+}
+
+// ReconciliationBreakResponse is used to define fields on response body types.
+type ReconciliationBreakResponse struct {
+	// Break ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Ledger account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Snapshot date
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Break kind
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Instrument symbol; absent for cash differences
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Quantity, or cash balance, in the snapshot
+	Statement *float64 `form:"statement,omitempty" json:"statement,omitempty" xml:"statement,omitempty"`
+	// Quantity, or cash balance, in the ledger
+	Ledger *float64 `form:"ledger,omitempty" json:"ledger,omitempty" xml:"ledger,omitempty"`
+	// Snapshot less ledger
+	Difference *float64 `form:"difference,omitempty" json:"difference,omitempty" xml:"difference,omitempty"`
+	// Price valuing the suggested position adjustments
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Suggested adjusting entries; empty when no price is known for a position
+	Adjustments []*LedgerTransactionResponse `form:"adjustments,omitempty" json:"adjustments,omitempty" xml:"adjustments,omitempty"`
+	// Review status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Why the status was set
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// When the break was first found
+	Detected *string `form:"detected,omitempty" json:"detected,omitempty" xml:"detected,omitempty"`
+	// When the break last changed
+	Updated *string `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// IDs of the adjustments posted to resolve the break
+	Posted []string `form:"posted,omitempty" json:"posted,omitempty" xml:"posted,omitempty"`
+}
+
+// LedgerTransactionResponse is used to define fields on response body types.
+type LedgerTransactionResponse struct {
+	// Transaction identifier, once posted
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Transaction date
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Transaction type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Quantity traded
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Price per unit
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Cash amount of deposits, withdrawals, income and fees
+	Amount *float64 `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// Commission
+	Fee *float64 `form:"fee,omitempty" json:"fee,omitempty" xml:"fee,omitempty"`
+	// Description
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// TaxAccountResponseBody is used to define fields on response body types.
+type TaxAccountResponseBody struct {
+	// Account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Lots disposed, by disposal date
+	Lots []*TaxLotGainResponseBody `form:"lots,omitempty" json:"lots,omitempty" xml:"lots,omitempty"`
+	// Short-term totals
+	ShortTerm *TaxTotalsResponseBody `form:"short_term,omitempty" json:"short_term,omitempty" xml:"short_term,omitempty"`
+	// Long-term totals
+	LongTerm *TaxTotalsResponseBody `form:"long_term,omitempty" json:"long_term,omitempty" xml:"long_term,omitempty"`
+	// Totals
+	Total *TaxTotalsResponseBody `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// TaxLotGainResponseBody is used to define fields on response body types.
+type TaxLotGainResponseBody struct {
+	// Account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Ledger transaction that opened the lot
+	LotID *string `form:"lot_id,omitempty" json:"lot_id,omitempty" xml:"lot_id,omitempty"`
+	// Ledger transaction that closed the lot
+	SaleID *string `form:"sale_id,omitempty" json:"sale_id,omitempty" xml:"sale_id,omitempty"`
+	// Acquisition date; the short sale date for covered shorts
+	Acquired *string `form:"acquired,omitempty" json:"acquired,omitempty" xml:"acquired,omitempty"`
+	// Disposal date
+	Disposed *string `form:"disposed,omitempty" json:"disposed,omitempty" xml:"disposed,omitempty"`
+	// Quantity disposed
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Net proceeds
+	Proceeds *float64 `form:"proceeds,omitempty" json:"proceeds,omitempty" xml:"proceeds,omitempty"`
+	// Cost basis relieved, including basis_adjustment
+	CostBasis *float64 `form:"cost_basis,omitempty" json:"cost_basis,omitempty" xml:"cost_basis,omitempty"`
+	// Loss deferred into these shares by earlier wash sales
+	BasisAdjustment *float64 `form:"basis_adjustment,omitempty" json:"basis_adjustment,omitempty" xml:"basis_adjustment,omitempty"`
+	// Loss disallowed by the wash-sale rule and added back
+	WashSaleAdjustment *float64 `form:"wash_sale_adjustment,omitempty" json:"wash_sale_adjustment,omitempty" xml:"wash_sale_adjustment,omitempty"`
+	// Reportable gain; negative for losses
+	Gain *float64 `form:"gain,omitempty" json:"gain,omitempty" xml:"gain,omitempty"`
+	// Holding period, counted from the sold shares' holding period for wash sale
+	// replacements
+	Term *string `form:"term,omitempty" json:"term,omitempty" xml:"term,omitempty"`
+	// Whether the disposal covered a short sale
+	ShortSale *bool `form:"short_sale,omitempty" json:"short_sale,omitempty" xml:"short_sale,omitempty"`
+}
+
+// TaxTotalsResponseBody is used to define fields on response body types.
+type TaxTotalsResponseBody struct {
+	// Net proceeds
+	Proceeds *float64 `form:"proceeds,omitempty" json:"proceeds,omitempty" xml:"proceeds,omitempty"`
+	// Cost basis relieved
+	CostBasis *float64 `form:"cost_basis,omitempty" json:"cost_basis,omitempty" xml:"cost_basis,omitempty"`
+	// Losses disallowed by the wash-sale rule
+	WashSaleAdjustment *float64 `form:"wash_sale_adjustment,omitempty" json:"wash_sale_adjustment,omitempty" xml:"wash_sale_adjustment,omitempty"`
+	// Reportable gain
+	Gain *float64 `form:"gain,omitempty" json:"gain,omitempty" xml:"gain,omitempty"`
+}
+
+// WashSaleResponseBody is used to define fields on response body types.
+type WashSaleResponseBody struct {
+	// Ledger transaction of the loss sale
+	SaleID *string `form:"sale_id,omitempty" json:"sale_id,omitempty" xml:"sale_id,omitempty"`
+	// Lot sold at a loss
+	LotID *string `form:"lot_id,omitempty" json:"lot_id,omitempty" xml:"lot_id,omitempty"`
+	// Account of the sale; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument sold
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Sale date
+	Disposed *string `form:"disposed,omitempty" json:"disposed,omitempty" xml:"disposed,omitempty"`
+	// Shares sold matched with replacement shares
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Loss disallowed
+	Disallowed *float64 `form:"disallowed,omitempty" json:"disallowed,omitempty" xml:"disallowed,omitempty"`
+	// Lot of the replacement shares
+	ReplacementLotID *string `form:"replacement_lot_id,omitempty" json:"replacement_lot_id,omitempty" xml:"replacement_lot_id,omitempty"`
+	// Account of the replacement shares
+	ReplacementAccount *string `form:"replacement_account,omitempty" json:"replacement_account,omitempty" xml:"replacement_account,omitempty"`
+	// Instrument of the replacement shares
+	ReplacementSymbol *string `form:"replacement_symbol,omitempty" json:"replacement_symbol,omitempty" xml:"replacement_symbol,omitempty"`
+	// Purchase date of the replacement shares
+	ReplacementAcquired *string `form:"replacement_acquired,omitempty" json:"replacement_acquired,omitempty" xml:"replacement_acquired,omitempty"`
+	// The replacement shares are in a tax-advantaged account, so the loss is lost
+	// rather than deferred
+	Permanent *bool `form:"permanent,omitempty" json:"permanent,omitempty" xml:"permanent,omitempty"`
+}
+
+// TaxLotResponse is used to define fields on response body types.
+type TaxLotResponse struct {
+	// Ledger transaction that opened the lot
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Acquisition date; the short sale date for short lots
+	Acquired *string `form:"acquired,omitempty" json:"acquired,omitempty" xml:"acquired,omitempty"`
+	// Start of the holding period of the oldest shares, earlier than acquired for
+	// wash sale replacement shares
+	HoldingSince *string `form:"holding_since,omitempty" json:"holding_since,omitempty" xml:"holding_since,omitempty"`
+	// Quantity held; negative for short lots
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Cost basis, including basis_adjustment; minus the net proceeds for short lots
+	CostBasis *float64 `form:"cost_basis,omitempty" json:"cost_basis,omitempty" xml:"cost_basis,omitempty"`
+	// Losses deferred into the lot by wash sales
+	BasisAdjustment *float64 `form:"basis_adjustment,omitempty" json:"basis_adjustment,omitempty" xml:"basis_adjustment,omitempty"`
+	// Cost basis per unit
+	UnitCost *float64 `form:"unit_cost,omitempty" json:"unit_cost,omitempty" xml:"unit_cost,omitempty"`
+	// Holding period of the oldest shares today
+	Term *string `form:"term,omitempty" json:"term,omitempty" xml:"term,omitempty"`
+	// Latest close, when known
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Quantity at the latest close, when known
+	MarketValue *float64 `form:"market_value,omitempty" json:"market_value,omitempty" xml:"market_value,omitempty"`
+	// Market value less cost basis, when known
+	UnrealizedGain *float64 `form:"unrealized_gain,omitempty" json:"unrealized_gain,omitempty" xml:"unrealized_gain,omitempty"`
+}
+
+// HarvestOpportunityResponseBody is used to define fields on response body
+// types.
+type HarvestOpportunityResponseBody struct {
+	// Account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument to sell
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Lots the sale relieves, first in, first out
+	Lots []string `form:"lots,omitempty" json:"lots,omitempty" xml:"lots,omitempty"`
+	// Quantity to sell
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Latest close
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Cost basis of the lots, including wash sale adjustments
+	CostBasis *float64 `form:"cost_basis,omitempty" json:"cost_basis,omitempty" xml:"cost_basis,omitempty"`
+	// Value of the lots at the latest close
+	MarketValue *float64 `form:"market_value,omitempty" json:"market_value,omitempty" xml:"market_value,omitempty"`
+	// Net loss realized by the sale
+	Loss *float64 `form:"loss,omitempty" json:"loss,omitempty" xml:"loss,omitempty"`
+	// Net short-term loss; negative for a net gain
+	ShortTermLoss *float64 `form:"short_term_loss,omitempty" json:"short_term_loss,omitempty" xml:"short_term_loss,omitempty"`
+	// Net long-term loss; negative for a net gain
+	LongTermLoss *float64 `form:"long_term_loss,omitempty" json:"long_term_loss,omitempty" xml:"long_term_loss,omitempty"`
+	// Tax saved on the losses at the marginal rates
+	EstimatedSavings *float64 `form:"estimated_savings,omitempty" json:"estimated_savings,omitempty" xml:"estimated_savings,omitempty"`
+	// First eligible replacement from the configured mapping, if any
+	Replacement *string `form:"replacement,omitempty" json:"replacement,omitempty" xml:"replacement,omitempty"`
+	// Latest close of the replacement, when known
+	ReplacementPrice *float64 `form:"replacement_price,omitempty" json:"replacement_price,omitempty" xml:"replacement_price,omitempty"`
+	// Quantity of the replacement the sale proceeds buy, when its price is known
+	ReplacementQuantity *float64 `form:"replacement_quantity,omitempty" json:"replacement_quantity,omitempty" xml:"replacement_quantity,omitempty"`
+	// First day the sold security can be bought back without a wash sale
+	RepurchaseAfter *string `form:"repurchase_after,omitempty" json:"repurchase_after,omitempty" xml:"repurchase_after,omitempty"`
+}
+
+// SkippedHarvestResponseBody is used to define fields on response body types.
+type SkippedHarvestResponseBody struct {
+	// Account; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Net loss a sale would realize
+	Loss *float64 `form:"loss,omitempty" json:"loss,omitempty" xml:"loss,omitempty"`
+	// Why the loss is not harvested
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// AccountResponse is used to define fields on response body types.
+type AccountResponse struct {
+	// Account identifier transactions refer to; empty for the default account
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Account type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// How gains and income of the account are taxed
+	TaxTreatment *string `form:"tax_treatment,omitempty" json:"tax_treatment,omitempty" xml:"tax_treatment,omitempty"`
+	// Broker or institution holding the account
+	Custodian *string `form:"custodian,omitempty" json:"custodian,omitempty" xml:"custodian,omitempty"`
+	// Base currency of the account
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Set explicitly; otherwise implied by transactions as a taxable account
+	Registered *bool `form:"registered,omitempty" json:"registered,omitempty" xml:"registered,omitempty"`
+	// Cash balance
+	Cash *float64 `form:"cash,omitempty" json:"cash,omitempty" xml:"cash,omitempty"`
+	// Market value of the positions
+	HoldingsValue *float64 `form:"holdings_value,omitempty" json:"holdings_value,omitempty" xml:"holdings_value,omitempty"`
+	// Cash plus holdings value
+	TotalValue *float64 `form:"total_value,omitempty" json:"total_value,omitempty" xml:"total_value,omitempty"`
+}
+
+// NewTargetWeightRequestBodyRequestBody builds the HTTP request body from the
+// payload of the "setTargetAllocation" endpoint of the "portfolio" service.
+func NewTargetWeightRequestBodyRequestBody(p *portfolio.SetTargetAllocationPayload) []*TargetWeightRequestBodyRequestBody {
+	body := make([]*TargetWeightRequestBodyRequestBody, len(p.Targets))
+	for i, val := range p.Targets {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioTargetWeightToTargetWeightRequestBodyRequestBody(val)
 	}
+	return body
+}
 
-	return v
+// NewSetBenchmarkRequestBody builds the HTTP request body from the payload of
+// the "setBenchmark" endpoint of the "portfolio" service.
+func NewSetBenchmarkRequestBody(p *portfolio.SetBenchmarkPayload) *SetBenchmarkRequestBody {
+	body := &SetBenchmarkRequestBody{
+		Name:      p.Benchmark.Name,
+		Blend:     p.Benchmark.Blend,
+		Rebalance: p.Benchmark.Rebalance,
+	}
+	if p.Benchmark.Components != nil {
+		body.Components = make([]*BenchmarkComponentRequestBodyRequestBody, len(p.Benchmark.Components))
+		for i, val := range p.Benchmark.Components {
+			if val == nil {
+				body.Components[i] = nil
+				continue
+			}
+			body.Components[i] = marshalPortfolioBenchmarkComponentToBenchmarkComponentRequestBodyRequestBody(val)
+		}
+	}
+	return body
 }
 
-// ValidateGetPortfolioSummaryResponseBody runs the validations defined on
-// GetPortfolioSummaryResponseBody
-func ValidateGetPortfolioSummaryResponseBody(body *GetPortfolioSummaryResponseBody) (err error) {
-	if body.Balance == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("balance", "body"))
+// NewProjectPortfolioRequestBody builds the HTTP request body from the payload
+// of the "projectPortfolio" endpoint of the "portfolio" service.
+func NewProjectPortfolioRequestBody(p *portfolio.ProjectPortfolioPayload) *ProjectPortfolioRequestBody {
+	body := &ProjectPortfolioRequestBody{
+		End:                 p.End,
+		StartValue:          p.StartValue,
+		MonthlyContribution: p.MonthlyContribution,
+		MonthlyWithdrawal:   p.MonthlyWithdrawal,
+		WithdrawalStart:     p.WithdrawalStart,
+		Inflation:           p.Inflation,
+		Goal:                p.Goal,
+		GoalDate:            p.GoalDate,
+		Paths:               p.Paths,
+		Seed:                p.Seed,
 	}
-	if body.Currency == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	{
+		var zero float64
+		if body.MonthlyContribution == zero {
+			body.MonthlyContribution = 0
+		}
 	}
-	if body.ChangePercent == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("change_percent", "body"))
+	{
+		var zero float64
+		if body.MonthlyWithdrawal == zero {
+			body.MonthlyWithdrawal = 0
+		}
+	}
+	{
+		var zero float64
+		if body.Inflation == zero {
+			body.Inflation = 0.02
+		}
+	}
+	if p.Assumptions != nil {
+		body.Assumptions = make([]*AssetClassAssumptionRequestBody, len(p.Assumptions))
+		for i, val := range p.Assumptions {
+			if val == nil {
+				body.Assumptions[i] = nil
+				continue
+			}
+			body.Assumptions[i] = marshalPortfolioAssetClassAssumptionToAssetClassAssumptionRequestBody(val)
+		}
+	}
+	{
+		var zero int
+		if body.Paths == zero {
+			body.Paths = 5000
+		}
+	}
+	return body
+}
+
+// NewApplyCorporateActionsRequestBody builds the HTTP request body from the
+// payload of the "applyCorporateActions" endpoint of the "portfolio" service.
+func NewApplyCorporateActionsRequestBody(p *portfolio.ApplyCorporateActionsPayload) *ApplyCorporateActionsRequestBody {
+	body := &ApplyCorporateActionsRequestBody{}
+	if p.Actions != nil {
+		body.Actions = make([]*CorporateActionInputRequestBody, len(p.Actions))
+		for i, val := range p.Actions {
+			if val == nil {
+				body.Actions[i] = nil
+				continue
+			}
+			body.Actions[i] = marshalPortfolioCorporateActionInputToCorporateActionInputRequestBody(val)
+		}
+	} else {
+		body.Actions = []*CorporateActionInputRequestBody{}
+	}
+	return body
+}
+
+// NewRecordIncomeRequestBody builds the HTTP request body from the payload of
+// the "recordIncome" endpoint of the "portfolio" service.
+func NewRecordIncomeRequestBody(p *portfolio.RecordIncomePayload) *RecordIncomeRequestBody {
+	body := &RecordIncomeRequestBody{
+		Type:        p.Type,
+		Symbol:      p.Symbol,
+		Account:     p.Account,
+		PayDate:     p.PayDate,
+		ExDate:      p.ExDate,
+		Amount:      p.Amount,
+		Withholding: p.Withholding,
+		Qualified:   p.Qualified,
+		Reinvest:    p.Reinvest,
+		Price:       p.Price,
+		Note:        p.Note,
+	}
+	{
+		var zero string
+		if body.Type == zero {
+			body.Type = "dividend"
+		}
+	}
+	{
+		var zero string
+		if body.Account == zero {
+			body.Account = ""
+		}
+	}
+	{
+		var zero float64
+		if body.Withholding == zero {
+			body.Withholding = 0
+		}
+	}
+	{
+		var zero bool
+		if body.Qualified == zero {
+			body.Qualified = false
+		}
+	}
+	{
+		var zero bool
+		if body.Reinvest == zero {
+			body.Reinvest = false
+		}
+	}
+	return body
+}
+
+// NewPriceBondRequestBody builds the HTTP request body from the payload of the
+// "priceBond" endpoint of the "portfolio" service.
+func NewPriceBondRequestBody(p *portfolio.PriceBondPayload) *PriceBondRequestBody {
+	body := &PriceBondRequestBody{
+		Coupon:          p.Coupon,
+		CouponFrequency: p.CouponFrequency,
+		DayCount:        p.DayCount,
+		Maturity:        p.Maturity,
+		FaceValue:       p.FaceValue,
+		Settlement:      p.Settlement,
+		CleanPrice:      p.CleanPrice,
+		Yield:           p.Yield,
+	}
+	{
+		var zero float64
+		if body.Coupon == zero {
+			body.Coupon = 0
+		}
+	}
+	{
+		var zero int
+		if body.CouponFrequency == zero {
+			body.CouponFrequency = 2
+		}
+	}
+	{
+		var zero string
+		if body.DayCount == zero {
+			body.DayCount = "30/360"
+		}
+	}
+	{
+		var zero float64
+		if body.FaceValue == zero {
+			body.FaceValue = 100
+		}
+	}
+	return body
+}
+
+// NewSetMarginAccountRequestBody builds the HTTP request body from the payload
+// of the "setMarginAccount" endpoint of the "portfolio" service.
+func NewSetMarginAccountRequestBody(p *portfolio.SetMarginAccountPayload) *SetMarginAccountRequestBody {
+	body := &SetMarginAccountRequestBody{
+		Account:                     p.Account,
+		InitialRequirement:          p.InitialRequirement,
+		MaintenanceRequirement:      p.MaintenanceRequirement,
+		ShortMaintenanceRequirement: p.ShortMaintenanceRequirement,
+		LoanRate:                    p.LoanRate,
+		BorrowRate:                  p.BorrowRate,
+	}
+	{
+		var zero float64
+		if body.InitialRequirement == zero {
+			body.InitialRequirement = 0.5
+		}
+	}
+	{
+		var zero float64
+		if body.MaintenanceRequirement == zero {
+			body.MaintenanceRequirement = 0.25
+		}
+	}
+	{
+		var zero float64
+		if body.ShortMaintenanceRequirement == zero {
+			body.ShortMaintenanceRequirement = 0.3
+		}
+	}
+	{
+		var zero float64
+		if body.LoanRate == zero {
+			body.LoanRate = 0
+		}
+	}
+	{
+		var zero float64
+		if body.BorrowRate == zero {
+			body.BorrowRate = 0
+		}
+	}
+	if p.BorrowRates != nil {
+		body.BorrowRates = make(map[string]float64, len(p.BorrowRates))
+		for key, val := range p.BorrowRates {
+			tk := key
+			tv := val
+			body.BorrowRates[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewPlaceOrderRequestBody builds the HTTP request body from the payload of
+// the "placeOrder" endpoint of the "portfolio" service.
+func NewPlaceOrderRequestBody(p *portfolio.PlaceOrderPayload) *PlaceOrderRequestBody {
+	body := &PlaceOrderRequestBody{
+		Account:     p.Account,
+		Symbol:      p.Symbol,
+		Side:        p.Side,
+		Type:        p.Type,
+		Quantity:    p.Quantity,
+		LimitPrice:  p.LimitPrice,
+		StopPrice:   p.StopPrice,
+		TimeInForce: p.TimeInForce,
+	}
+	{
+		var zero string
+		if body.Type == zero {
+			body.Type = "market"
+		}
+	}
+	{
+		var zero string
+		if body.TimeInForce == zero {
+			body.TimeInForce = "day"
+		}
+	}
+	return body
+}
+
+// NewPreviewTradesRequestBody builds the HTTP request body from the payload of
+// the "previewTrades" endpoint of the "portfolio" service.
+func NewPreviewTradesRequestBody(p *portfolio.PreviewTradesPayload) *PreviewTradesRequestBody {
+	body := &PreviewTradesRequestBody{
+		Dimension:        p.Dimension,
+		ShortTermTaxRate: p.ShortTermTaxRate,
+		LongTermTaxRate:  p.LongTermTaxRate,
+		Lookback:         p.Lookback,
+	}
+	if p.Trades != nil {
+		body.Trades = make([]*TradeInputRequestBody, len(p.Trades))
+		for i, val := range p.Trades {
+			if val == nil {
+				body.Trades[i] = nil
+				continue
+			}
+			body.Trades[i] = marshalPortfolioTradeInputToTradeInputRequestBody(val)
+		}
+	} else {
+		body.Trades = []*TradeInputRequestBody{}
+	}
+	{
+		var zero string
+		if body.Dimension == zero {
+			body.Dimension = "asset_class"
+		}
+	}
+	{
+		var zero float64
+		if body.ShortTermTaxRate == zero {
+			body.ShortTermTaxRate = 0.24
+		}
+	}
+	{
+		var zero float64
+		if body.LongTermTaxRate == zero {
+			body.LongTermTaxRate = 0.15
+		}
+	}
+	{
+		var zero int
+		if body.Lookback == zero {
+			body.Lookback = 252
+		}
+	}
+	return body
+}
+
+// NewRunBacktestRequestBody builds the HTTP request body from the payload of
+// the "runBacktest" endpoint of the "portfolio" service.
+func NewRunBacktestRequestBody(p *portfolio.RunBacktestPayload) *RunBacktestRequestBody {
+	body := &RunBacktestRequestBody{
+		Name:         p.Name,
+		Strategy:     p.Strategy,
+		Start:        p.Start,
+		End:          p.End,
+		Currency:     p.Currency,
+		InitialCash:  p.InitialCash,
+		Frequency:    p.Frequency,
+		Threshold:    p.Threshold,
+		Contribution: p.Contribution,
+		FeeRate:      p.FeeRate,
+	}
+	{
+		var zero string
+		if body.Name == zero {
+			body.Name = ""
+		}
+	}
+	{
+		var zero string
+		if body.Currency == zero {
+			body.Currency = "USD"
+		}
+	}
+	{
+		var zero float64
+		if body.InitialCash == zero {
+			body.InitialCash = 0
+		}
+	}
+	if p.Targets != nil {
+		body.Targets = make([]*BacktestTargetRequestBody, len(p.Targets))
+		for i, val := range p.Targets {
+			if val == nil {
+				body.Targets[i] = nil
+				continue
+			}
+			body.Targets[i] = marshalPortfolioBacktestTargetToBacktestTargetRequestBody(val)
+		}
+	} else {
+		body.Targets = []*BacktestTargetRequestBody{}
+	}
+	{
+		var zero string
+		if body.Frequency == zero {
+			body.Frequency = "monthly"
+		}
+	}
+	{
+		var zero float64
+		if body.Threshold == zero {
+			body.Threshold = 0
+		}
+	}
+	{
+		var zero float64
+		if body.Contribution == zero {
+			body.Contribution = 0
+		}
+	}
+	{
+		var zero float64
+		if body.FeeRate == zero {
+			body.FeeRate = 0
+		}
+	}
+	return body
+}
+
+// NewImportCsvRequestBody builds the HTTP request body from the payload of the
+// "importCsv" endpoint of the "portfolio" service.
+func NewImportCsvRequestBody(p *portfolio.ImportCsvPayload) *ImportCsvRequestBody {
+	body := &ImportCsvRequestBody{
+		Content: p.Content,
+	}
+	return body
+}
+
+// NewImportOfxRequestBody builds the HTTP request body from the payload of the
+// "importOfx" endpoint of the "portfolio" service.
+func NewImportOfxRequestBody(p *portfolio.ImportOfxPayload) *ImportOfxRequestBody {
+	body := &ImportOfxRequestBody{
+		Content: p.Content,
+	}
+	return body
+}
+
+// NewImportJournalRequestBody builds the HTTP request body from the payload of
+// the "importJournal" endpoint of the "portfolio" service.
+func NewImportJournalRequestBody(p *portfolio.ImportJournalPayload) *ImportJournalRequestBody {
+	body := &ImportJournalRequestBody{
+		Content: p.Content,
+	}
+	return body
+}
+
+// NewImportArchiveRequestBody builds the HTTP request body from the payload of
+// the "importArchive" endpoint of the "portfolio" service.
+func NewImportArchiveRequestBody(p *portfolio.ImportArchivePayload) *ImportArchiveRequestBody {
+	body := &ImportArchiveRequestBody{
+		Content: p.Content,
+	}
+	return body
+}
+
+// NewReconcilePositionsRequestBody builds the HTTP request body from the
+// payload of the "reconcilePositions" endpoint of the "portfolio" service.
+func NewReconcilePositionsRequestBody(p *portfolio.ReconcilePositionsPayload) *ReconcilePositionsRequestBody {
+	body := &ReconcilePositionsRequestBody{
+		Content: p.Content,
+	}
+	return body
+}
+
+// NewSetAccountRequestBody builds the HTTP request body from the payload of
+// the "setAccount" endpoint of the "portfolio" service.
+func NewSetAccountRequestBody(p *portfolio.SetAccountPayload) *SetAccountRequestBody {
+	body := &SetAccountRequestBody{
+		ID:           p.ID,
+		Name:         p.Name,
+		Type:         p.Type,
+		TaxTreatment: p.TaxTreatment,
+		Custodian:    p.Custodian,
+		Currency:     p.Currency,
+	}
+	{
+		var zero string
+		if body.Type == zero {
+			body.Type = "taxable"
+		}
+	}
+	return body
+}
+
+// NewGetPortfolioSummaryPortfolioSummaryOK builds a "portfolio" service
+// "getPortfolioSummary" endpoint result from a HTTP "OK" response.
+func NewGetPortfolioSummaryPortfolioSummaryOK(body *GetPortfolioSummaryResponseBody) *portfolio.PortfolioSummary {
+	v := &portfolio.PortfolioSummary{
+		Balance:       *body.Balance,
+		Currency:      *body.Currency,
+		ChangePercent: *body.ChangePercent,
+	}
+
+	return v
+}
+
+// NewGetPortfolioSummaryBadRequest builds a portfolio service
+// getPortfolioSummary endpoint bad_request error.
+func NewGetPortfolioSummaryBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetPortfolioSummaryNotFound builds a portfolio service
+// getPortfolioSummary endpoint not_found error.
+func NewGetPortfolioSummaryNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetPortfolioSummaryUnauthorized builds a portfolio service
+// getPortfolioSummary endpoint unauthorized error.
+func NewGetPortfolioSummaryUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewGetReturnsPortfolioReturnsOK builds a "portfolio" service "getReturns"
+// endpoint result from a HTTP "OK" response.
+func NewGetReturnsPortfolioReturnsOK(body *GetReturnsResponseBody) *portfolio.PortfolioReturns {
+	v := &portfolio.PortfolioReturns{
+		PortfolioID:                  *body.PortfolioID,
+		Period:                       *body.Period,
+		Account:                      body.Account,
+		Start:                        *body.Start,
+		End:                          *body.End,
+		StartValue:                   *body.StartValue,
+		EndValue:                     *body.EndValue,
+		NetContributions:             *body.NetContributions,
+		Gain:                         *body.Gain,
+		TimeWeightedReturn:           *body.TimeWeightedReturn,
+		AnnualizedTimeWeightedReturn: body.AnnualizedTimeWeightedReturn,
+		MoneyWeightedReturn:          body.MoneyWeightedReturn,
+	}
+
+	return v
+}
+
+// NewGetReturnsBadRequest builds a portfolio service getReturns endpoint
+// bad_request error.
+func NewGetReturnsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetReturnsNotFound builds a portfolio service getReturns endpoint
+// not_found error.
+func NewGetReturnsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetReturnsUnauthorized builds a portfolio service getReturns endpoint
+// unauthorized error.
+func NewGetReturnsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewGetAllocationAllocationOK builds a "portfolio" service "getAllocation"
+// endpoint result from a HTTP "OK" response.
+func NewGetAllocationAllocationOK(body *GetAllocationResponseBody) *portfolio.Allocation {
+	v := &portfolio.Allocation{
+		PortfolioID: *body.PortfolioID,
+		Dimension:   *body.Dimension,
+		Tag:         body.Tag,
+		Account:     body.Account,
+		AsOf:        *body.AsOf,
+		Currency:    *body.Currency,
+		TotalValue:  *body.TotalValue,
+	}
+	v.Buckets = make([]*portfolio.AllocationBucket, len(body.Buckets))
+	for i, val := range body.Buckets {
+		if val == nil {
+			v.Buckets[i] = nil
+			continue
+		}
+		v.Buckets[i] = unmarshalAllocationBucketResponseBodyToPortfolioAllocationBucket(val)
+	}
+
+	return v
+}
+
+// NewGetAllocationBadRequest builds a portfolio service getAllocation endpoint
+// bad_request error.
+func NewGetAllocationBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetAllocationNotFound builds a portfolio service getAllocation endpoint
+// not_found error.
+func NewGetAllocationNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetAllocationUnauthorized builds a portfolio service getAllocation
+// endpoint unauthorized error.
+func NewGetAllocationUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewGetTargetAllocationTargetAllocationOK builds a "portfolio" service
+// "getTargetAllocation" endpoint result from a HTTP "OK" response.
+func NewGetTargetAllocationTargetAllocationOK(body *GetTargetAllocationResponseBody) *portfolio.TargetAllocation {
+	v := &portfolio.TargetAllocation{
+		PortfolioID: *body.PortfolioID,
+		CashWeight:  *body.CashWeight,
+	}
+	v.Targets = make([]*portfolio.TargetWeight, len(body.Targets))
+	for i, val := range body.Targets {
+		if val == nil {
+			v.Targets[i] = nil
+			continue
+		}
+		v.Targets[i] = unmarshalTargetWeightResponseBodyToPortfolioTargetWeight(val)
+	}
+
+	return v
+}
+
+// NewGetTargetAllocationBadRequest builds a portfolio service
+// getTargetAllocation endpoint bad_request error.
+func NewGetTargetAllocationBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetTargetAllocationNotFound builds a portfolio service
+// getTargetAllocation endpoint not_found error.
+func NewGetTargetAllocationNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetTargetAllocationUnauthorized builds a portfolio service
+// getTargetAllocation endpoint unauthorized error.
+func NewGetTargetAllocationUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewSetTargetAllocationTargetAllocationOK builds a "portfolio" service
+// "setTargetAllocation" endpoint result from a HTTP "OK" response.
+func NewSetTargetAllocationTargetAllocationOK(body *SetTargetAllocationResponseBody) *portfolio.TargetAllocation {
+	v := &portfolio.TargetAllocation{
+		PortfolioID: *body.PortfolioID,
+		CashWeight:  *body.CashWeight,
+	}
+	v.Targets = make([]*portfolio.TargetWeight, len(body.Targets))
+	for i, val := range body.Targets {
+		if val == nil {
+			v.Targets[i] = nil
+			continue
+		}
+		v.Targets[i] = unmarshalTargetWeightResponseBodyToPortfolioTargetWeight(val)
+	}
+
+	return v
+}
+
+// NewSetTargetAllocationBadRequest builds a portfolio service
+// setTargetAllocation endpoint bad_request error.
+func NewSetTargetAllocationBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewSetTargetAllocationNotFound builds a portfolio service
+// setTargetAllocation endpoint not_found error.
+func NewSetTargetAllocationNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewSetTargetAllocationUnauthorized builds a portfolio service
+// setTargetAllocation endpoint unauthorized error.
+func NewSetTargetAllocationUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewProposeRebalanceRebalanceProposalOK builds a "portfolio" service
+// "proposeRebalance" endpoint result from a HTTP "OK" response.
+func NewProposeRebalanceRebalanceProposalOK(body *ProposeRebalanceResponseBody) *portfolio.RebalanceProposal {
+	v := &portfolio.RebalanceProposal{
+		PortfolioID: *body.PortfolioID,
+		AsOf:        *body.AsOf,
+		CashBefore:  *body.CashBefore,
+		CashAfter:   *body.CashAfter,
+	}
+	v.Trades = make([]*portfolio.ProposedTrade, len(body.Trades))
+	for i, val := range body.Trades {
+		if val == nil {
+			v.Trades[i] = nil
+			continue
+		}
+		v.Trades[i] = unmarshalProposedTradeResponseBodyToPortfolioProposedTrade(val)
+	}
+	v.Warnings = make([]string, len(body.Warnings))
+	for i, val := range body.Warnings {
+		v.Warnings[i] = val
+	}
+
+	return v
+}
+
+// NewProposeRebalanceBadRequest builds a portfolio service proposeRebalance
+// endpoint bad_request error.
+func NewProposeRebalanceBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewProposeRebalanceNotFound builds a portfolio service proposeRebalance
+// endpoint not_found error.
+func NewProposeRebalanceNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewProposeRebalanceUnauthorized builds a portfolio service proposeRebalance
+// endpoint unauthorized error.
+func NewProposeRebalanceUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewSetBenchmarkBenchmarkDefinitionOK builds a "portfolio" service
+// "setBenchmark" endpoint result from a HTTP "OK" response.
+func NewSetBenchmarkBenchmarkDefinitionOK(body *SetBenchmarkResponseBody) *portfolio.BenchmarkDefinition {
+	v := &portfolio.BenchmarkDefinition{
+		Name:      *body.Name,
+		Blend:     body.Blend,
+		Rebalance: *body.Rebalance,
+	}
+	if body.Components != nil {
+		v.Components = make([]*portfolio.BenchmarkComponent, len(body.Components))
+		for i, val := range body.Components {
+			if val == nil {
+				v.Components[i] = nil
+				continue
+			}
+			v.Components[i] = unmarshalBenchmarkComponentResponseBodyToPortfolioBenchmarkComponent(val)
+		}
+	}
+
+	return v
+}
+
+// NewSetBenchmarkBadRequest builds a portfolio service setBenchmark endpoint
+// bad_request error.
+func NewSetBenchmarkBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewSetBenchmarkNotFound builds a portfolio service setBenchmark endpoint
+// not_found error.
+func NewSetBenchmarkNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewSetBenchmarkUnauthorized builds a portfolio service setBenchmark endpoint
+// unauthorized error.
+func NewSetBenchmarkUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewGetBenchmarkComparisonBenchmarkComparisonOK builds a "portfolio" service
+// "getBenchmarkComparison" endpoint result from a HTTP "OK" response.
+func NewGetBenchmarkComparisonBenchmarkComparisonOK(body *GetBenchmarkComparisonResponseBody) *portfolio.BenchmarkComparison {
+	v := &portfolio.BenchmarkComparison{
+		PortfolioID:     *body.PortfolioID,
+		Start:           *body.Start,
+		End:             *body.End,
+		PortfolioReturn: *body.PortfolioReturn,
+		BenchmarkReturn: *body.BenchmarkReturn,
+		ExcessReturn:    *body.ExcessReturn,
+		TrackingError:   *body.TrackingError,
+	}
+	v.Benchmark = unmarshalBenchmarkDefinitionResponseBodyToPortfolioBenchmarkDefinition(body.Benchmark)
+	v.Series = make([]*portfolio.BenchmarkComparisonPoint, len(body.Series))
+	for i, val := range body.Series {
+		if val == nil {
+			v.Series[i] = nil
+			continue
+		}
+		v.Series[i] = unmarshalBenchmarkComparisonPointResponseBodyToPortfolioBenchmarkComparisonPoint(val)
+	}
+
+	return v
+}
+
+// NewGetBenchmarkComparisonBadRequest builds a portfolio service
+// getBenchmarkComparison endpoint bad_request error.
+func NewGetBenchmarkComparisonBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetBenchmarkComparisonNotFound builds a portfolio service
+// getBenchmarkComparison endpoint not_found error.
+func NewGetBenchmarkComparisonNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetBenchmarkComparisonUnauthorized builds a portfolio service
+// getBenchmarkComparison endpoint unauthorized error.
+func NewGetBenchmarkComparisonUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewGetRiskMetricsRiskMetricsOK builds a "portfolio" service "getRiskMetrics"
+// endpoint result from a HTTP "OK" response.
+func NewGetRiskMetricsRiskMetricsOK(body *GetRiskMetricsResponseBody) *portfolio.RiskMetrics {
+	v := &portfolio.RiskMetrics{
+		PortfolioID:       *body.PortfolioID,
+		Start:             *body.Start,
+		End:               *body.End,
+		RiskFreeRate:      *body.RiskFreeRate,
+		Volatility:        *body.Volatility,
+		SharpeRatio:       *body.SharpeRatio,
+		SortinoRatio:      *body.SortinoRatio,
+		Beta:              body.Beta,
+		Correlation:       body.Correlation,
+		MaxDrawdown:       *body.MaxDrawdown,
+		MaxDrawdownPeak:   body.MaxDrawdownPeak,
+		MaxDrawdownTrough: body.MaxDrawdownTrough,
+	}
+	if body.Benchmark != nil {
+		v.Benchmark = unmarshalBenchmarkDefinitionResponseBodyToPortfolioBenchmarkDefinition(body.Benchmark)
+	}
+	v.Rolling = make([]*portfolio.RiskWindow, len(body.Rolling))
+	for i, val := range body.Rolling {
+		if val == nil {
+			v.Rolling[i] = nil
+			continue
+		}
+		v.Rolling[i] = unmarshalRiskWindowResponseBodyToPortfolioRiskWindow(val)
+	}
+
+	return v
+}
+
+// NewGetRiskMetricsBadRequest builds a portfolio service getRiskMetrics
+// endpoint bad_request error.
+func NewGetRiskMetricsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetRiskMetricsNotFound builds a portfolio service getRiskMetrics endpoint
+// not_found error.
+func NewGetRiskMetricsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetRiskMetricsUnauthorized builds a portfolio service getRiskMetrics
+// endpoint unauthorized error.
+func NewGetRiskMetricsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewGetValueAtRiskValueAtRiskOK builds a "portfolio" service "getValueAtRisk"
+// endpoint result from a HTTP "OK" response.
+func NewGetValueAtRiskValueAtRiskOK(body *GetValueAtRiskResponseBody) *portfolio.ValueAtRisk {
+	v := &portfolio.ValueAtRisk{
+		PortfolioID:       *body.PortfolioID,
+		AsOf:              *body.AsOf,
+		Method:            *body.Method,
+		Confidence:        *body.Confidence,
+		Horizon:           *body.Horizon,
+		Lookback:          *body.Lookback,
+		PortfolioValue:    *body.PortfolioValue,
+		ValueAtRisk:       *body.ValueAtRisk,
+		ExpectedShortfall: *body.ExpectedShortfall,
+	}
+	v.Contributions = make([]*portfolio.VaRContribution, len(body.Contributions))
+	for i, val := range body.Contributions {
+		if val == nil {
+			v.Contributions[i] = nil
+			continue
+		}
+		v.Contributions[i] = unmarshalVaRContributionResponseBodyToPortfolioVaRContribution(val)
+	}
+
+	return v
+}
+
+// NewGetValueAtRiskBadRequest builds a portfolio service getValueAtRisk
+// endpoint bad_request error.
+func NewGetValueAtRiskBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetValueAtRiskNotFound builds a portfolio service getValueAtRisk endpoint
+// not_found error.
+func NewGetValueAtRiskNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetValueAtRiskUnauthorized builds a portfolio service getValueAtRisk
+// endpoint unauthorized error.
+func NewGetValueAtRiskUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewListStressScenariosStressScenarioOK builds a "portfolio" service
+// "listStressScenarios" endpoint result from a HTTP "OK" response.
+func NewListStressScenariosStressScenarioOK(body []*StressScenarioResponse) []*portfolio.StressScenario {
+	v := make([]*portfolio.StressScenario, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalStressScenarioResponseToPortfolioStressScenario(val)
+	}
+
+	return v
+}
+
+// NewListStressScenariosBadRequest builds a portfolio service
+// listStressScenarios endpoint bad_request error.
+func NewListStressScenariosBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewListStressScenariosNotFound builds a portfolio service
+// listStressScenarios endpoint not_found error.
+func NewListStressScenariosNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewListStressScenariosUnauthorized builds a portfolio service
+// listStressScenarios endpoint unauthorized error.
+func NewListStressScenariosUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewRunStressTestStressTestResultOK builds a "portfolio" service
+// "runStressTest" endpoint result from a HTTP "OK" response.
+func NewRunStressTestStressTestResultOK(body *RunStressTestResponseBody) *portfolio.StressTestResult {
+	v := &portfolio.StressTestResult{
+		PortfolioID:    *body.PortfolioID,
+		AsOf:           *body.AsOf,
+		Currency:       *body.Currency,
+		CurrentValue:   *body.CurrentValue,
+		ProjectedValue: *body.ProjectedValue,
+		Loss:           *body.Loss,
+		LossPercent:    *body.LossPercent,
+	}
+	v.Scenario = unmarshalStressScenarioResponseBodyToPortfolioStressScenario(body.Scenario)
+	v.WorstContributors = make([]*portfolio.StressImpact, len(body.WorstContributors))
+	for i, val := range body.WorstContributors {
+		if val == nil {
+			v.WorstContributors[i] = nil
+			continue
+		}
+		v.WorstContributors[i] = unmarshalStressImpactResponseBodyToPortfolioStressImpact(val)
+	}
+	v.Warnings = make([]string, len(body.Warnings))
+	for i, val := range body.Warnings {
+		v.Warnings[i] = val
+	}
+
+	return v
+}
+
+// NewRunStressTestBadRequest builds a portfolio service runStressTest endpoint
+// bad_request error.
+func NewRunStressTestBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewRunStressTestNotFound builds a portfolio service runStressTest endpoint
+// not_found error.
+func NewRunStressTestNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewRunStressTestUnauthorized builds a portfolio service runStressTest
+// endpoint unauthorized error.
+func NewRunStressTestUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewProjectPortfolioProjectionOK builds a "portfolio" service
+// "projectPortfolio" endpoint result from a HTTP "OK" response.
+func NewProjectPortfolioProjectionOK(body *ProjectPortfolioResponseBody) *portfolio.Projection {
+	v := &portfolio.Projection{
+		PortfolioID:          *body.PortfolioID,
+		Start:                *body.Start,
+		End:                  *body.End,
+		StartValue:           *body.StartValue,
+		Paths:                *body.Paths,
+		Goal:                 body.Goal,
+		GoalDate:             body.GoalDate,
+		GoalProbability:      body.GoalProbability,
+		DepletionProbability: *body.DepletionProbability,
+	}
+	v.Assumptions = make([]*portfolio.AssetClassAssumption, len(body.Assumptions))
+	for i, val := range body.Assumptions {
+		if val == nil {
+			v.Assumptions[i] = nil
+			continue
+		}
+		v.Assumptions[i] = unmarshalAssetClassAssumptionResponseBodyToPortfolioAssetClassAssumption(val)
+	}
+	v.Bands = make([]*portfolio.ProjectionBand, len(body.Bands))
+	for i, val := range body.Bands {
+		if val == nil {
+			v.Bands[i] = nil
+			continue
+		}
+		v.Bands[i] = unmarshalProjectionBandResponseBodyToPortfolioProjectionBand(val)
+	}
+
+	return v
+}
+
+// NewProjectPortfolioBadRequest builds a portfolio service projectPortfolio
+// endpoint bad_request error.
+func NewProjectPortfolioBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewProjectPortfolioNotFound builds a portfolio service projectPortfolio
+// endpoint not_found error.
+func NewProjectPortfolioNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewProjectPortfolioUnauthorized builds a portfolio service projectPortfolio
+// endpoint unauthorized error.
+func NewProjectPortfolioUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewListCorporateActionsCorporateActionOK builds a "portfolio" service
+// "listCorporateActions" endpoint result from a HTTP "OK" response.
+func NewListCorporateActionsCorporateActionOK(body []*CorporateActionResponse) []*portfolio.CorporateAction {
+	v := make([]*portfolio.CorporateAction, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalCorporateActionResponseToPortfolioCorporateAction(val)
+	}
+
+	return v
+}
+
+// NewListCorporateActionsBadRequest builds a portfolio service
+// listCorporateActions endpoint bad_request error.
+func NewListCorporateActionsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewListCorporateActionsNotFound builds a portfolio service
+// listCorporateActions endpoint not_found error.
+func NewListCorporateActionsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewListCorporateActionsUnauthorized builds a portfolio service
+// listCorporateActions endpoint unauthorized error.
+func NewListCorporateActionsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewApplyCorporateActionsCorporateActionOK builds a "portfolio" service
+// "applyCorporateActions" endpoint result from a HTTP "OK" response.
+func NewApplyCorporateActionsCorporateActionOK(body []*CorporateActionResponse) []*portfolio.CorporateAction {
+	v := make([]*portfolio.CorporateAction, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalCorporateActionResponseToPortfolioCorporateAction(val)
+	}
+
+	return v
+}
+
+// NewApplyCorporateActionsBadRequest builds a portfolio service
+// applyCorporateActions endpoint bad_request error.
+func NewApplyCorporateActionsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewApplyCorporateActionsNotFound builds a portfolio service
+// applyCorporateActions endpoint not_found error.
+func NewApplyCorporateActionsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewApplyCorporateActionsUnauthorized builds a portfolio service
+// applyCorporateActions endpoint unauthorized error.
+func NewApplyCorporateActionsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewRecordIncomeIncomeRecordOK builds a "portfolio" service "recordIncome"
+// endpoint result from a HTTP "OK" response.
+func NewRecordIncomeIncomeRecordOK(body *RecordIncomeResponseBody) *portfolio.IncomeRecord {
+	v := &portfolio.IncomeRecord{
+		ID:                 *body.ID,
+		Type:               *body.Type,
+		Symbol:             body.Symbol,
+		Account:            *body.Account,
+		PayDate:            *body.PayDate,
+		ExDate:             body.ExDate,
+		Amount:             *body.Amount,
+		Withholding:        *body.Withholding,
+		Net:                *body.Net,
+		Qualified:          *body.Qualified,
+		ReinvestmentID:     body.ReinvestmentID,
+		ReinvestedQuantity: body.ReinvestedQuantity,
+	}
+
+	return v
+}
+
+// NewRecordIncomeBadRequest builds a portfolio service recordIncome endpoint
+// bad_request error.
+func NewRecordIncomeBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewRecordIncomeNotFound builds a portfolio service recordIncome endpoint
+// not_found error.
+func NewRecordIncomeNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewRecordIncomeUnauthorized builds a portfolio service recordIncome endpoint
+// unauthorized error.
+func NewRecordIncomeUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewGetIncomeIncomeSummaryOK builds a "portfolio" service "getIncome"
+// endpoint result from a HTTP "OK" response.
+func NewGetIncomeIncomeSummaryOK(body *GetIncomeResponseBody) *portfolio.IncomeSummary {
+	v := &portfolio.IncomeSummary{
+		PortfolioID:   *body.PortfolioID,
+		Start:         *body.Start,
+		End:           *body.End,
+		Currency:      *body.Currency,
+		Interval:      *body.Interval,
+		Gross:         *body.Gross,
+		Withholding:   *body.Withholding,
+		Net:           *body.Net,
+		Qualified:     *body.Qualified,
+		Ordinary:      *body.Ordinary,
+		ForecastTotal: *body.ForecastTotal,
+	}
+	v.ByPeriod = make([]*portfolio.IncomeBucket, len(body.ByPeriod))
+	for i, val := range body.ByPeriod {
+		if val == nil {
+			v.ByPeriod[i] = nil
+			continue
+		}
+		v.ByPeriod[i] = unmarshalIncomeBucketResponseBodyToPortfolioIncomeBucket(val)
+	}
+	v.ByHolding = make([]*portfolio.IncomeBucket, len(body.ByHolding))
+	for i, val := range body.ByHolding {
+		if val == nil {
+			v.ByHolding[i] = nil
+			continue
+		}
+		v.ByHolding[i] = unmarshalIncomeBucketResponseBodyToPortfolioIncomeBucket(val)
+	}
+	v.ByCurrency = make([]*portfolio.IncomeBucket, len(body.ByCurrency))
+	for i, val := range body.ByCurrency {
+		if val == nil {
+			v.ByCurrency[i] = nil
+			continue
+		}
+		v.ByCurrency[i] = unmarshalIncomeBucketResponseBodyToPortfolioIncomeBucket(val)
+	}
+	v.Forecast = make([]*portfolio.IncomeForecast, len(body.Forecast))
+	for i, val := range body.Forecast {
+		if val == nil {
+			v.Forecast[i] = nil
+			continue
+		}
+		v.Forecast[i] = unmarshalIncomeForecastResponseBodyToPortfolioIncomeForecast(val)
+	}
+
+	return v
+}
+
+// NewGetIncomeBadRequest builds a portfolio service getIncome endpoint
+// bad_request error.
+func NewGetIncomeBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetIncomeNotFound builds a portfolio service getIncome endpoint not_found
+// error.
+func NewGetIncomeNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetIncomeUnauthorized builds a portfolio service getIncome endpoint
+// unauthorized error.
+func NewGetIncomeUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewPriceBondBondAnalyticsOK builds a "portfolio" service "priceBond"
+// endpoint result from a HTTP "OK" response.
+func NewPriceBondBondAnalyticsOK(body *PriceBondResponseBody) *portfolio.BondAnalytics {
+	v := &portfolio.BondAnalytics{
+		Settlement:       *body.Settlement,
+		CleanPrice:       *body.CleanPrice,
+		DirtyPrice:       *body.DirtyPrice,
+		AccruedInterest:  *body.AccruedInterest,
+		YieldToMaturity:  *body.YieldToMaturity,
+		MacaulayDuration: *body.MacaulayDuration,
+		ModifiedDuration: *body.ModifiedDuration,
+	}
+
+	return v
+}
+
+// NewPriceBondBadRequest builds a portfolio service priceBond endpoint
+// bad_request error.
+func NewPriceBondBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewPriceBondNotFound builds a portfolio service priceBond endpoint not_found
+// error.
+func NewPriceBondNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewPriceBondUnauthorized builds a portfolio service priceBond endpoint
+// unauthorized error.
+func NewPriceBondUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewListBondPositionsBondPositionOK builds a "portfolio" service
+// "listBondPositions" endpoint result from a HTTP "OK" response.
+func NewListBondPositionsBondPositionOK(body []*BondPositionResponse) []*portfolio.BondPosition {
+	v := make([]*portfolio.BondPosition, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalBondPositionResponseToPortfolioBondPosition(val)
+	}
+
+	return v
+}
+
+// NewListBondPositionsBadRequest builds a portfolio service listBondPositions
+// endpoint bad_request error.
+func NewListBondPositionsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewListBondPositionsNotFound builds a portfolio service listBondPositions
+// endpoint not_found error.
+func NewListBondPositionsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewListBondPositionsUnauthorized builds a portfolio service
+// listBondPositions endpoint unauthorized error.
+func NewListBondPositionsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewGetCashFlowLadderCashFlowLadderOK builds a "portfolio" service
+// "getCashFlowLadder" endpoint result from a HTTP "OK" response.
+func NewGetCashFlowLadderCashFlowLadderOK(body *GetCashFlowLadderResponseBody) *portfolio.CashFlowLadder {
+	v := &portfolio.CashFlowLadder{
+		PortfolioID: *body.PortfolioID,
+		AsOf:        *body.AsOf,
+		End:         *body.End,
+		Currency:    *body.Currency,
+		Total:       *body.Total,
+	}
+	v.Flows = make([]*portfolio.BondCashFlow, len(body.Flows))
+	for i, val := range body.Flows {
+		if val == nil {
+			v.Flows[i] = nil
+			continue
+		}
+		v.Flows[i] = unmarshalBondCashFlowResponseBodyToPortfolioBondCashFlow(val)
+	}
+	v.ByYear = make([]*portfolio.CashFlowLadderYear, len(body.ByYear))
+	for i, val := range body.ByYear {
+		if val == nil {
+			v.ByYear[i] = nil
+			continue
+		}
+		v.ByYear[i] = unmarshalCashFlowLadderYearResponseBodyToPortfolioCashFlowLadderYear(val)
+	}
+
+	return v
+}
+
+// NewGetCashFlowLadderBadRequest builds a portfolio service getCashFlowLadder
+// endpoint bad_request error.
+func NewGetCashFlowLadderBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetCashFlowLadderNotFound builds a portfolio service getCashFlowLadder
+// endpoint not_found error.
+func NewGetCashFlowLadderNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetCashFlowLadderUnauthorized builds a portfolio service
+// getCashFlowLadder endpoint unauthorized error.
+func NewGetCashFlowLadderUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewListOptionPositionsOptionPositionsOK builds a "portfolio" service
+// "listOptionPositions" endpoint result from a HTTP "OK" response.
+func NewListOptionPositionsOptionPositionsOK(body *ListOptionPositionsResponseBody) *portfolio.OptionPositions {
+	v := &portfolio.OptionPositions{
+		PortfolioID: *body.PortfolioID,
+		AsOf:        *body.AsOf,
+		Currency:    *body.Currency,
+	}
+	v.Positions = make([]*portfolio.OptionPosition, len(body.Positions))
+	for i, val := range body.Positions {
+		if val == nil {
+			v.Positions[i] = nil
+			continue
+		}
+		v.Positions[i] = unmarshalOptionPositionResponseBodyToPortfolioOptionPosition(val)
+	}
+	v.Exposures = make([]*portfolio.UnderlyingExposure, len(body.Exposures))
+	for i, val := range body.Exposures {
+		if val == nil {
+			v.Exposures[i] = nil
+			continue
+		}
+		v.Exposures[i] = unmarshalUnderlyingExposureResponseBodyToPortfolioUnderlyingExposure(val)
+	}
+
+	return v
+}
+
+// NewListOptionPositionsBadRequest builds a portfolio service
+// listOptionPositions endpoint bad_request error.
+func NewListOptionPositionsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewListOptionPositionsNotFound builds a portfolio service
+// listOptionPositions endpoint not_found error.
+func NewListOptionPositionsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewListOptionPositionsUnauthorized builds a portfolio service
+// listOptionPositions endpoint unauthorized error.
+func NewListOptionPositionsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewSettleOptionExpiriesOptionSettlementOK builds a "portfolio" service
+// "settleOptionExpiries" endpoint result from a HTTP "OK" response.
+func NewSettleOptionExpiriesOptionSettlementOK(body []*OptionSettlementResponse) []*portfolio.OptionSettlement {
+	v := make([]*portfolio.OptionSettlement, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalOptionSettlementResponseToPortfolioOptionSettlement(val)
+	}
+
+	return v
+}
+
+// NewSettleOptionExpiriesBadRequest builds a portfolio service
+// settleOptionExpiries endpoint bad_request error.
+func NewSettleOptionExpiriesBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewSettleOptionExpiriesNotFound builds a portfolio service
+// settleOptionExpiries endpoint not_found error.
+func NewSettleOptionExpiriesNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewSettleOptionExpiriesUnauthorized builds a portfolio service
+// settleOptionExpiries endpoint unauthorized error.
+func NewSettleOptionExpiriesUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewSetMarginAccountMarginTermsOK builds a "portfolio" service
+// "setMarginAccount" endpoint result from a HTTP "OK" response.
+func NewSetMarginAccountMarginTermsOK(body *SetMarginAccountResponseBody) *portfolio.MarginTerms {
+	v := &portfolio.MarginTerms{
+		Account:                     *body.Account,
+		InitialRequirement:          *body.InitialRequirement,
+		MaintenanceRequirement:      *body.MaintenanceRequirement,
+		ShortMaintenanceRequirement: *body.ShortMaintenanceRequirement,
+		LoanRate:                    *body.LoanRate,
+		BorrowRate:                  *body.BorrowRate,
+	}
+	if body.BorrowRates != nil {
+		v.BorrowRates = make(map[string]float64, len(body.BorrowRates))
+		for key, val := range body.BorrowRates {
+			tk := key
+			tv := val
+			v.BorrowRates[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewSetMarginAccountBadRequest builds a portfolio service setMarginAccount
+// endpoint bad_request error.
+func NewSetMarginAccountBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewSetMarginAccountNotFound builds a portfolio service setMarginAccount
+// endpoint not_found error.
+func NewSetMarginAccountNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewSetMarginAccountUnauthorized builds a portfolio service setMarginAccount
+// endpoint unauthorized error.
+func NewSetMarginAccountUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewGetMarginStatusMarginStatusOK builds a "portfolio" service
+// "getMarginStatus" endpoint result from a HTTP "OK" response.
+func NewGetMarginStatusMarginStatusOK(body *GetMarginStatusResponseBody) *portfolio.MarginStatus {
+	v := &portfolio.MarginStatus{
+		PortfolioID: *body.PortfolioID,
+		AsOf:        *body.AsOf,
+		Currency:    *body.Currency,
+	}
+	v.Accounts = make([]*portfolio.MarginAccountStatus, len(body.Accounts))
+	for i, val := range body.Accounts {
+		if val == nil {
+			v.Accounts[i] = nil
+			continue
+		}
+		v.Accounts[i] = unmarshalMarginAccountStatusResponseBodyToPortfolioMarginAccountStatus(val)
+	}
+
+	return v
+}
+
+// NewGetMarginStatusBadRequest builds a portfolio service getMarginStatus
+// endpoint bad_request error.
+func NewGetMarginStatusBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetMarginStatusNotFound builds a portfolio service getMarginStatus
+// endpoint not_found error.
+func NewGetMarginStatusNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetMarginStatusUnauthorized builds a portfolio service getMarginStatus
+// endpoint unauthorized error.
+func NewGetMarginStatusUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewChargeMarginMarginStatusOK builds a "portfolio" service "chargeMargin"
+// endpoint result from a HTTP "OK" response.
+func NewChargeMarginMarginStatusOK(body *ChargeMarginResponseBody) *portfolio.MarginStatus {
+	v := &portfolio.MarginStatus{
+		PortfolioID: *body.PortfolioID,
+		AsOf:        *body.AsOf,
+		Currency:    *body.Currency,
+	}
+	v.Accounts = make([]*portfolio.MarginAccountStatus, len(body.Accounts))
+	for i, val := range body.Accounts {
+		if val == nil {
+			v.Accounts[i] = nil
+			continue
+		}
+		v.Accounts[i] = unmarshalMarginAccountStatusResponseBodyToPortfolioMarginAccountStatus(val)
+	}
+
+	return v
+}
+
+// NewChargeMarginBadRequest builds a portfolio service chargeMargin endpoint
+// bad_request error.
+func NewChargeMarginBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewChargeMarginNotFound builds a portfolio service chargeMargin endpoint
+// not_found error.
+func NewChargeMarginNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewChargeMarginUnauthorized builds a portfolio service chargeMargin endpoint
+// unauthorized error.
+func NewChargeMarginUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewListEventsPortfolioEventOK builds a "portfolio" service "listEvents"
+// endpoint result from a HTTP "OK" response.
+func NewListEventsPortfolioEventOK(body []*PortfolioEventResponse) []*portfolio.PortfolioEvent {
+	v := make([]*portfolio.PortfolioEvent, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalPortfolioEventResponseToPortfolioPortfolioEvent(val)
+	}
+
+	return v
+}
+
+// NewListEventsBadRequest builds a portfolio service listEvents endpoint
+// bad_request error.
+func NewListEventsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewListEventsNotFound builds a portfolio service listEvents endpoint
+// not_found error.
+func NewListEventsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewListEventsUnauthorized builds a portfolio service listEvents endpoint
+// unauthorized error.
+func NewListEventsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewPlaceOrderOrderOK builds a "portfolio" service "placeOrder" endpoint
+// result from a HTTP "OK" response.
+func NewPlaceOrderOrderOK(body *PlaceOrderResponseBody) *portfolio.Order {
+	v := &portfolio.Order{
+		ID:          *body.ID,
+		Account:     *body.Account,
+		Symbol:      *body.Symbol,
+		Side:        *body.Side,
+		Type:        *body.Type,
+		Quantity:    *body.Quantity,
+		LimitPrice:  body.LimitPrice,
+		StopPrice:   body.StopPrice,
+		TimeInForce: *body.TimeInForce,
+		Status:      *body.Status,
+		Triggered:   *body.Triggered,
+		Created:     *body.Created,
+		Updated:     *body.Updated,
+		FillPrice:   *body.FillPrice,
+		Commission:  *body.Commission,
+		Reason:      body.Reason,
+	}
+
+	return v
+}
+
+// NewPlaceOrderBadRequest builds a portfolio service placeOrder endpoint
+// bad_request error.
+func NewPlaceOrderBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewPlaceOrderNotFound builds a portfolio service placeOrder endpoint
+// not_found error.
+func NewPlaceOrderNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewPlaceOrderUnauthorized builds a portfolio service placeOrder endpoint
+// unauthorized error.
+func NewPlaceOrderUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewListOrdersOrderOK builds a "portfolio" service "listOrders" endpoint
+// result from a HTTP "OK" response.
+func NewListOrdersOrderOK(body []*OrderResponse) []*portfolio.Order {
+	v := make([]*portfolio.Order, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalOrderResponseToPortfolioOrder(val)
+	}
+
+	return v
+}
+
+// NewListOrdersBadRequest builds a portfolio service listOrders endpoint
+// bad_request error.
+func NewListOrdersBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewListOrdersNotFound builds a portfolio service listOrders endpoint
+// not_found error.
+func NewListOrdersNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewListOrdersUnauthorized builds a portfolio service listOrders endpoint
+// unauthorized error.
+func NewListOrdersUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewCancelOrderOrderOK builds a "portfolio" service "cancelOrder" endpoint
+// result from a HTTP "OK" response.
+func NewCancelOrderOrderOK(body *CancelOrderResponseBody) *portfolio.Order {
+	v := &portfolio.Order{
+		ID:          *body.ID,
+		Account:     *body.Account,
+		Symbol:      *body.Symbol,
+		Side:        *body.Side,
+		Type:        *body.Type,
+		Quantity:    *body.Quantity,
+		LimitPrice:  body.LimitPrice,
+		StopPrice:   body.StopPrice,
+		TimeInForce: *body.TimeInForce,
+		Status:      *body.Status,
+		Triggered:   *body.Triggered,
+		Created:     *body.Created,
+		Updated:     *body.Updated,
+		FillPrice:   *body.FillPrice,
+		Commission:  *body.Commission,
+		Reason:      body.Reason,
+	}
+
+	return v
+}
+
+// NewCancelOrderBadRequest builds a portfolio service cancelOrder endpoint
+// bad_request error.
+func NewCancelOrderBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewCancelOrderNotFound builds a portfolio service cancelOrder endpoint
+// not_found error.
+func NewCancelOrderNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewCancelOrderUnauthorized builds a portfolio service cancelOrder endpoint
+// unauthorized error.
+func NewCancelOrderUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewPreviewTradesTradePreviewOK builds a "portfolio" service "previewTrades"
+// endpoint result from a HTTP "OK" response.
+func NewPreviewTradesTradePreviewOK(body *PreviewTradesResponseBody) *portfolio.TradePreview {
+	v := &portfolio.TradePreview{
+		PortfolioID:   *body.PortfolioID,
+		AsOf:          *body.AsOf,
+		Currency:      *body.Currency,
+		CashBefore:    *body.CashBefore,
+		CashAfter:     *body.CashAfter,
+		Dimension:     *body.Dimension,
+		ShortTermGain: *body.ShortTermGain,
+		LongTermGain:  *body.LongTermGain,
+		EstimatedTax:  *body.EstimatedTax,
+	}
+	v.Before = unmarshalPortfolioSummaryResponseBodyToPortfolioPortfolioSummary(body.Before)
+	v.After = unmarshalPortfolioSummaryResponseBodyToPortfolioPortfolioSummary(body.After)
+	v.Allocation = make([]*portfolio.AllocationChange, len(body.Allocation))
+	for i, val := range body.Allocation {
+		if val == nil {
+			v.Allocation[i] = nil
+			continue
+		}
+		v.Allocation[i] = unmarshalAllocationChangeResponseBodyToPortfolioAllocationChange(val)
+	}
+	v.Realized = make([]*portfolio.RealizedLot, len(body.Realized))
+	for i, val := range body.Realized {
+		if val == nil {
+			v.Realized[i] = nil
+			continue
+		}
+		v.Realized[i] = unmarshalRealizedLotResponseBodyToPortfolioRealizedLot(val)
+	}
+	v.RiskBefore = unmarshalHoldingsRiskResponseBodyToPortfolioHoldingsRisk(body.RiskBefore)
+	v.RiskAfter = unmarshalHoldingsRiskResponseBodyToPortfolioHoldingsRisk(body.RiskAfter)
+	v.Warnings = make([]string, len(body.Warnings))
+	for i, val := range body.Warnings {
+		v.Warnings[i] = val
+	}
+
+	return v
+}
+
+// NewPreviewTradesBadRequest builds a portfolio service previewTrades endpoint
+// bad_request error.
+func NewPreviewTradesBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewPreviewTradesNotFound builds a portfolio service previewTrades endpoint
+// not_found error.
+func NewPreviewTradesNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewPreviewTradesUnauthorized builds a portfolio service previewTrades
+// endpoint unauthorized error.
+func NewPreviewTradesUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewRunBacktestBacktestResultOK builds a "portfolio" service "runBacktest"
+// endpoint result from a HTTP "OK" response.
+func NewRunBacktestBacktestResultOK(body *RunBacktestResponseBody) *portfolio.BacktestResult {
+	v := &portfolio.BacktestResult{
+		Name:                *body.Name,
+		Strategy:            *body.Strategy,
+		Start:               *body.Start,
+		End:                 *body.End,
+		Currency:            *body.Currency,
+		EndValue:            *body.EndValue,
+		NetContributions:    *body.NetContributions,
+		Gain:                *body.Gain,
+		TimeWeightedReturn:  *body.TimeWeightedReturn,
+		AnnualizedReturn:    body.AnnualizedReturn,
+		MoneyWeightedReturn: body.MoneyWeightedReturn,
+		Volatility:          *body.Volatility,
+		SharpeRatio:         *body.SharpeRatio,
+		MaxDrawdown:         *body.MaxDrawdown,
+		MaxDrawdownPeak:     body.MaxDrawdownPeak,
+		MaxDrawdownTrough:   body.MaxDrawdownTrough,
+		Trades:              *body.Trades,
+		TradedValue:         *body.TradedValue,
+		Fees:                *body.Fees,
+		Turnover:            *body.Turnover,
+	}
+	v.EquityCurve = make([]*portfolio.BacktestPoint, len(body.EquityCurve))
+	for i, val := range body.EquityCurve {
+		if val == nil {
+			v.EquityCurve[i] = nil
+			continue
+		}
+		v.EquityCurve[i] = unmarshalBacktestPointResponseBodyToPortfolioBacktestPoint(val)
+	}
+
+	return v
+}
+
+// NewRunBacktestBadRequest builds a portfolio service runBacktest endpoint
+// bad_request error.
+func NewRunBacktestBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewRunBacktestNotFound builds a portfolio service runBacktest endpoint
+// not_found error.
+func NewRunBacktestNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewRunBacktestUnauthorized builds a portfolio service runBacktest endpoint
+// unauthorized error.
+func NewRunBacktestUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewImportCsvImportReportOK builds a "portfolio" service "importCsv" endpoint
+// result from a HTTP "OK" response.
+func NewImportCsvImportReportOK(body *ImportCsvResponseBody) *portfolio.ImportReport {
+	v := &portfolio.ImportReport{
+		PortfolioID: *body.PortfolioID,
+		Format:      *body.Format,
+		Profile:     body.Profile,
+		DryRun:      *body.DryRun,
+		Imported:    *body.Imported,
+		Duplicates:  *body.Duplicates,
+		Skipped:     *body.Skipped,
+		Errors:      *body.Errors,
+	}
+	v.Rows = make([]*portfolio.ImportRow, len(body.Rows))
+	for i, val := range body.Rows {
+		if val == nil {
+			v.Rows[i] = nil
+			continue
+		}
+		v.Rows[i] = unmarshalImportRowResponseBodyToPortfolioImportRow(val)
+	}
+	if body.Reconciliation != nil {
+		v.Reconciliation = make([]*portfolio.AccountReconciliation, len(body.Reconciliation))
+		for i, val := range body.Reconciliation {
+			if val == nil {
+				v.Reconciliation[i] = nil
+				continue
+			}
+			v.Reconciliation[i] = unmarshalAccountReconciliationResponseBodyToPortfolioAccountReconciliation(val)
+		}
+	}
+
+	return v
+}
+
+// NewImportCsvBadRequest builds a portfolio service importCsv endpoint
+// bad_request error.
+func NewImportCsvBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewImportCsvNotFound builds a portfolio service importCsv endpoint not_found
+// error.
+func NewImportCsvNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewImportCsvUnauthorized builds a portfolio service importCsv endpoint
+// unauthorized error.
+func NewImportCsvUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewImportOfxImportReportOK builds a "portfolio" service "importOfx" endpoint
+// result from a HTTP "OK" response.
+func NewImportOfxImportReportOK(body *ImportOfxResponseBody) *portfolio.ImportReport {
+	v := &portfolio.ImportReport{
+		PortfolioID: *body.PortfolioID,
+		Format:      *body.Format,
+		Profile:     body.Profile,
+		DryRun:      *body.DryRun,
+		Imported:    *body.Imported,
+		Duplicates:  *body.Duplicates,
+		Skipped:     *body.Skipped,
+		Errors:      *body.Errors,
+	}
+	v.Rows = make([]*portfolio.ImportRow, len(body.Rows))
+	for i, val := range body.Rows {
+		if val == nil {
+			v.Rows[i] = nil
+			continue
+		}
+		v.Rows[i] = unmarshalImportRowResponseBodyToPortfolioImportRow(val)
+	}
+	if body.Reconciliation != nil {
+		v.Reconciliation = make([]*portfolio.AccountReconciliation, len(body.Reconciliation))
+		for i, val := range body.Reconciliation {
+			if val == nil {
+				v.Reconciliation[i] = nil
+				continue
+			}
+			v.Reconciliation[i] = unmarshalAccountReconciliationResponseBodyToPortfolioAccountReconciliation(val)
+		}
+	}
+
+	return v
+}
+
+// NewImportOfxBadRequest builds a portfolio service importOfx endpoint
+// bad_request error.
+func NewImportOfxBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewImportOfxNotFound builds a portfolio service importOfx endpoint not_found
+// error.
+func NewImportOfxNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewImportOfxUnauthorized builds a portfolio service importOfx endpoint
+// unauthorized error.
+func NewImportOfxUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewExportJournalExportFileOK builds a "portfolio" service "exportJournal"
+// endpoint result from a HTTP "OK" response.
+func NewExportJournalExportFileOK(body string, contentDisposition string) *portfolio.ExportFile {
+	v := body
+	res := &portfolio.ExportFile{
+		Content: v,
+	}
+	res.ContentDisposition = contentDisposition
+
+	return res
+}
+
+// NewExportJournalBadRequest builds a portfolio service exportJournal endpoint
+// bad_request error.
+func NewExportJournalBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewExportJournalNotFound builds a portfolio service exportJournal endpoint
+// not_found error.
+func NewExportJournalNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewExportJournalUnauthorized builds a portfolio service exportJournal
+// endpoint unauthorized error.
+func NewExportJournalUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewImportJournalImportReportOK builds a "portfolio" service "importJournal"
+// endpoint result from a HTTP "OK" response.
+func NewImportJournalImportReportOK(body *ImportJournalResponseBody) *portfolio.ImportReport {
+	v := &portfolio.ImportReport{
+		PortfolioID: *body.PortfolioID,
+		Format:      *body.Format,
+		Profile:     body.Profile,
+		DryRun:      *body.DryRun,
+		Imported:    *body.Imported,
+		Duplicates:  *body.Duplicates,
+		Skipped:     *body.Skipped,
+		Errors:      *body.Errors,
+	}
+	v.Rows = make([]*portfolio.ImportRow, len(body.Rows))
+	for i, val := range body.Rows {
+		if val == nil {
+			v.Rows[i] = nil
+			continue
+		}
+		v.Rows[i] = unmarshalImportRowResponseBodyToPortfolioImportRow(val)
+	}
+	if body.Reconciliation != nil {
+		v.Reconciliation = make([]*portfolio.AccountReconciliation, len(body.Reconciliation))
+		for i, val := range body.Reconciliation {
+			if val == nil {
+				v.Reconciliation[i] = nil
+				continue
+			}
+			v.Reconciliation[i] = unmarshalAccountReconciliationResponseBodyToPortfolioAccountReconciliation(val)
+		}
+	}
+
+	return v
+}
+
+// NewImportJournalBadRequest builds a portfolio service importJournal endpoint
+// bad_request error.
+func NewImportJournalBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewImportJournalNotFound builds a portfolio service importJournal endpoint
+// not_found error.
+func NewImportJournalNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewImportJournalUnauthorized builds a portfolio service importJournal
+// endpoint unauthorized error.
+func NewImportJournalUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewExportArchiveExportFileOK builds a "portfolio" service "exportArchive"
+// endpoint result from a HTTP "OK" response.
+func NewExportArchiveExportFileOK(body string, contentDisposition string) *portfolio.ExportFile {
+	v := body
+	res := &portfolio.ExportFile{
+		Content: v,
+	}
+	res.ContentDisposition = contentDisposition
+
+	return res
+}
+
+// NewExportArchiveBadRequest builds a portfolio service exportArchive endpoint
+// bad_request error.
+func NewExportArchiveBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewExportArchiveNotFound builds a portfolio service exportArchive endpoint
+// not_found error.
+func NewExportArchiveNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewExportArchiveUnauthorized builds a portfolio service exportArchive
+// endpoint unauthorized error.
+func NewExportArchiveUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewImportArchiveArchiveImportOK builds a "portfolio" service "importArchive"
+// endpoint result from a HTTP "OK" response.
+func NewImportArchiveArchiveImportOK(body *ImportArchiveResponseBody) *portfolio.ArchiveImport {
+	v := &portfolio.ArchiveImport{
+		PortfolioID:    *body.PortfolioID,
+		SchemaVersion:  *body.SchemaVersion,
+		Migrated:       *body.Migrated,
+		Replaced:       *body.Replaced,
+		CreatedAt:      *body.CreatedAt,
+		Transactions:   *body.Transactions,
+		Instruments:    *body.Instruments,
+		Targets:        *body.Targets,
+		Accounts:       *body.Accounts,
+		MarginAccounts: *body.MarginAccounts,
+	}
+
+	return v
+}
+
+// NewImportArchiveBadRequest builds a portfolio service importArchive endpoint
+// bad_request error.
+func NewImportArchiveBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewImportArchiveNotFound builds a portfolio service importArchive endpoint
+// not_found error.
+func NewImportArchiveNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewImportArchiveUnauthorized builds a portfolio service importArchive
+// endpoint unauthorized error.
+func NewImportArchiveUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewReconcilePositionsReconciliationOK builds a "portfolio" service
+// "reconcilePositions" endpoint result from a HTTP "OK" response.
+func NewReconcilePositionsReconciliationOK(body *ReconcilePositionsResponseBody) *portfolio.Reconciliation {
+	v := &portfolio.Reconciliation{
+		PortfolioID: *body.PortfolioID,
+		Format:      *body.Format,
+		Opened:      *body.Opened,
+		Updated:     *body.Updated,
+		Resolved:    *body.Resolved,
+	}
+	v.Accounts = make([]*portfolio.ReconciledAccount, len(body.Accounts))
+	for i, val := range body.Accounts {
+		if val == nil {
+			v.Accounts[i] = nil
+			continue
+		}
+		v.Accounts[i] = unmarshalReconciledAccountResponseBodyToPortfolioReconciledAccount(val)
+	}
+	v.Breaks = make([]*portfolio.ReconciliationBreak, len(body.Breaks))
+	for i, val := range body.Breaks {
+		if val == nil {
+			v.Breaks[i] = nil
+			continue
+		}
+		v.Breaks[i] = unmarshalReconciliationBreakResponseBodyToPortfolioReconciliationBreak(val)
+	}
+
+	return v
+}
+
+// NewReconcilePositionsBadRequest builds a portfolio service
+// reconcilePositions endpoint bad_request error.
+func NewReconcilePositionsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewReconcilePositionsNotFound builds a portfolio service reconcilePositions
+// endpoint not_found error.
+func NewReconcilePositionsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewReconcilePositionsUnauthorized builds a portfolio service
+// reconcilePositions endpoint unauthorized error.
+func NewReconcilePositionsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewListReconciliationBreaksReconciliationBreakOK builds a "portfolio"
+// service "listReconciliationBreaks" endpoint result from a HTTP "OK" response.
+func NewListReconciliationBreaksReconciliationBreakOK(body []*ReconciliationBreakResponse) []*portfolio.ReconciliationBreak {
+	v := make([]*portfolio.ReconciliationBreak, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalReconciliationBreakResponseToPortfolioReconciliationBreak(val)
+	}
+
+	return v
+}
+
+// NewListReconciliationBreaksBadRequest builds a portfolio service
+// listReconciliationBreaks endpoint bad_request error.
+func NewListReconciliationBreaksBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewListReconciliationBreaksNotFound builds a portfolio service
+// listReconciliationBreaks endpoint not_found error.
+func NewListReconciliationBreaksNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewListReconciliationBreaksUnauthorized builds a portfolio service
+// listReconciliationBreaks endpoint unauthorized error.
+func NewListReconciliationBreaksUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewUpdateReconciliationBreakReconciliationBreakOK builds a "portfolio"
+// service "updateReconciliationBreak" endpoint result from a HTTP "OK"
+// response.
+func NewUpdateReconciliationBreakReconciliationBreakOK(body *UpdateReconciliationBreakResponseBody) *portfolio.ReconciliationBreak {
+	v := &portfolio.ReconciliationBreak{
+		ID:         *body.ID,
+		Account:    *body.Account,
+		AsOf:       *body.AsOf,
+		Kind:       *body.Kind,
+		Symbol:     body.Symbol,
+		Statement:  *body.Statement,
+		Ledger:     *body.Ledger,
+		Difference: *body.Difference,
+		Price:      body.Price,
+		Status:     *body.Status,
+		Note:       body.Note,
+		Detected:   *body.Detected,
+		Updated:    *body.Updated,
+	}
+	v.Adjustments = make([]*portfolio.LedgerTransaction, len(body.Adjustments))
+	for i, val := range body.Adjustments {
+		if val == nil {
+			v.Adjustments[i] = nil
+			continue
+		}
+		v.Adjustments[i] = unmarshalLedgerTransactionResponseBodyToPortfolioLedgerTransaction(val)
+	}
+	if body.Posted != nil {
+		v.Posted = make([]string, len(body.Posted))
+		for i, val := range body.Posted {
+			v.Posted[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewUpdateReconciliationBreakBadRequest builds a portfolio service
+// updateReconciliationBreak endpoint bad_request error.
+func NewUpdateReconciliationBreakBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewUpdateReconciliationBreakNotFound builds a portfolio service
+// updateReconciliationBreak endpoint not_found error.
+func NewUpdateReconciliationBreakNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewUpdateReconciliationBreakUnauthorized builds a portfolio service
+// updateReconciliationBreak endpoint unauthorized error.
+func NewUpdateReconciliationBreakUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewGetTaxReportTaxReportOK builds a "portfolio" service "getTaxReport"
+// endpoint result from a HTTP "OK" response.
+func NewGetTaxReportTaxReportOK(body *GetTaxReportResponseBody) *portfolio.TaxReport {
+	v := &portfolio.TaxReport{
+		PortfolioID: *body.PortfolioID,
+		Year:        *body.Year,
+		Currency:    *body.Currency,
+	}
+	v.Accounts = make([]*portfolio.TaxAccount, len(body.Accounts))
+	for i, val := range body.Accounts {
+		if val == nil {
+			v.Accounts[i] = nil
+			continue
+		}
+		v.Accounts[i] = unmarshalTaxAccountResponseBodyToPortfolioTaxAccount(val)
+	}
+	v.ShortTerm = unmarshalTaxTotalsResponseBodyToPortfolioTaxTotals(body.ShortTerm)
+	v.LongTerm = unmarshalTaxTotalsResponseBodyToPortfolioTaxTotals(body.LongTerm)
+	v.Total = unmarshalTaxTotalsResponseBodyToPortfolioTaxTotals(body.Total)
+	v.WashSales = make([]*portfolio.WashSale, len(body.WashSales))
+	for i, val := range body.WashSales {
+		if val == nil {
+			v.WashSales[i] = nil
+			continue
+		}
+		v.WashSales[i] = unmarshalWashSaleResponseBodyToPortfolioWashSale(val)
+	}
+
+	return v
+}
+
+// NewGetTaxReportBadRequest builds a portfolio service getTaxReport endpoint
+// bad_request error.
+func NewGetTaxReportBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewGetTaxReportNotFound builds a portfolio service getTaxReport endpoint
+// not_found error.
+func NewGetTaxReportNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewGetTaxReportUnauthorized builds a portfolio service getTaxReport endpoint
+// unauthorized error.
+func NewGetTaxReportUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewExportTaxReportExportFileOK builds a "portfolio" service
+// "exportTaxReport" endpoint result from a HTTP "OK" response.
+func NewExportTaxReportExportFileOK(body string, contentDisposition string) *portfolio.ExportFile {
+	v := body
+	res := &portfolio.ExportFile{
+		Content: v,
+	}
+	res.ContentDisposition = contentDisposition
+
+	return res
+}
+
+// NewExportTaxReportBadRequest builds a portfolio service exportTaxReport
+// endpoint bad_request error.
+func NewExportTaxReportBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewExportTaxReportNotFound builds a portfolio service exportTaxReport
+// endpoint not_found error.
+func NewExportTaxReportNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewExportTaxReportUnauthorized builds a portfolio service exportTaxReport
+// endpoint unauthorized error.
+func NewExportTaxReportUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewListLotsTaxLotOK builds a "portfolio" service "listLots" endpoint result
+// from a HTTP "OK" response.
+func NewListLotsTaxLotOK(body []*TaxLotResponse) []*portfolio.TaxLot {
+	v := make([]*portfolio.TaxLot, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalTaxLotResponseToPortfolioTaxLot(val)
+	}
+
+	return v
+}
+
+// NewListLotsBadRequest builds a portfolio service listLots endpoint
+// bad_request error.
+func NewListLotsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewListLotsNotFound builds a portfolio service listLots endpoint not_found
+// error.
+func NewListLotsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewListLotsUnauthorized builds a portfolio service listLots endpoint
+// unauthorized error.
+func NewListLotsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewFindTaxLossHarvestsTaxLossHarvestOK builds a "portfolio" service
+// "findTaxLossHarvests" endpoint result from a HTTP "OK" response.
+func NewFindTaxLossHarvestsTaxLossHarvestOK(body *FindTaxLossHarvestsResponseBody) *portfolio.TaxLossHarvest {
+	v := &portfolio.TaxLossHarvest{
+		PortfolioID:      *body.PortfolioID,
+		AsOf:             *body.AsOf,
+		TotalLoss:        *body.TotalLoss,
+		EstimatedSavings: *body.EstimatedSavings,
+	}
+	v.Opportunities = make([]*portfolio.HarvestOpportunity, len(body.Opportunities))
+	for i, val := range body.Opportunities {
+		if val == nil {
+			v.Opportunities[i] = nil
+			continue
+		}
+		v.Opportunities[i] = unmarshalHarvestOpportunityResponseBodyToPortfolioHarvestOpportunity(val)
+	}
+	v.Skipped = make([]*portfolio.SkippedHarvest, len(body.Skipped))
+	for i, val := range body.Skipped {
+		if val == nil {
+			v.Skipped[i] = nil
+			continue
+		}
+		v.Skipped[i] = unmarshalSkippedHarvestResponseBodyToPortfolioSkippedHarvest(val)
+	}
+
+	return v
+}
+
+// NewFindTaxLossHarvestsBadRequest builds a portfolio service
+// findTaxLossHarvests endpoint bad_request error.
+func NewFindTaxLossHarvestsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewFindTaxLossHarvestsNotFound builds a portfolio service
+// findTaxLossHarvests endpoint not_found error.
+func NewFindTaxLossHarvestsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewFindTaxLossHarvestsUnauthorized builds a portfolio service
+// findTaxLossHarvests endpoint unauthorized error.
+func NewFindTaxLossHarvestsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewListAccountsAccountOK builds a "portfolio" service "listAccounts"
+// endpoint result from a HTTP "OK" response.
+func NewListAccountsAccountOK(body []*AccountResponse) []*portfolio.Account {
+	v := make([]*portfolio.Account, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalAccountResponseToPortfolioAccount(val)
+	}
+
+	return v
+}
+
+// NewListAccountsBadRequest builds a portfolio service listAccounts endpoint
+// bad_request error.
+func NewListAccountsBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewListAccountsNotFound builds a portfolio service listAccounts endpoint
+// not_found error.
+func NewListAccountsNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewListAccountsUnauthorized builds a portfolio service listAccounts endpoint
+// unauthorized error.
+func NewListAccountsUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewSetAccountAccountOK builds a "portfolio" service "setAccount" endpoint
+// result from a HTTP "OK" response.
+func NewSetAccountAccountOK(body *SetAccountResponseBody) *portfolio.Account {
+	v := &portfolio.Account{
+		ID:            *body.ID,
+		Name:          *body.Name,
+		Type:          *body.Type,
+		TaxTreatment:  *body.TaxTreatment,
+		Custodian:     body.Custodian,
+		Currency:      *body.Currency,
+		Registered:    *body.Registered,
+		Cash:          *body.Cash,
+		HoldingsValue: *body.HoldingsValue,
+		TotalValue:    *body.TotalValue,
+	}
+
+	return v
+}
+
+// NewSetAccountBadRequest builds a portfolio service setAccount endpoint
+// bad_request error.
+func NewSetAccountBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewSetAccountNotFound builds a portfolio service setAccount endpoint
+// not_found error.
+func NewSetAccountNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewSetAccountUnauthorized builds a portfolio service setAccount endpoint
+// unauthorized error.
+func NewSetAccountUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// NewRemoveAccountAccountOK builds a "portfolio" service "removeAccount"
+// endpoint result from a HTTP "OK" response.
+func NewRemoveAccountAccountOK(body *RemoveAccountResponseBody) *portfolio.Account {
+	v := &portfolio.Account{
+		ID:            *body.ID,
+		Name:          *body.Name,
+		Type:          *body.Type,
+		TaxTreatment:  *body.TaxTreatment,
+		Custodian:     body.Custodian,
+		Currency:      *body.Currency,
+		Registered:    *body.Registered,
+		Cash:          *body.Cash,
+		HoldingsValue: *body.HoldingsValue,
+		TotalValue:    *body.TotalValue,
+	}
+
+	return v
+}
+
+// NewRemoveAccountBadRequest builds a portfolio service removeAccount endpoint
+// bad_request error.
+func NewRemoveAccountBadRequest(body string) portfolio.BadRequest {
+	v := portfolio.BadRequest(body)
+
+	return v
+}
+
+// NewRemoveAccountNotFound builds a portfolio service removeAccount endpoint
+// not_found error.
+func NewRemoveAccountNotFound(body string) portfolio.NotFound {
+	v := portfolio.NotFound(body)
+
+	return v
+}
+
+// NewRemoveAccountUnauthorized builds a portfolio service removeAccount
+// endpoint unauthorized error.
+func NewRemoveAccountUnauthorized(body string) portfolio.Unauthorized {
+	v := portfolio.Unauthorized(body)
+
+	return v
+}
+
+// ValidateGetPortfolioSummaryResponseBody runs the validations defined on
+// GetPortfolioSummaryResponseBody
+func ValidateGetPortfolioSummaryResponseBody(body *GetPortfolioSummaryResponseBody) (err error) {
+	if body.Balance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("balance", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.ChangePercent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("change_percent", "body"))
+	}
+	return
+}
+
+// ValidateGetReturnsResponseBody runs the validations defined on
+// GetReturnsResponseBody
+func ValidateGetReturnsResponseBody(body *GetReturnsResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Period == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("period", "body"))
+	}
+	if body.Start == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start", "body"))
+	}
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.StartValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start_value", "body"))
+	}
+	if body.EndValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end_value", "body"))
+	}
+	if body.NetContributions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_contributions", "body"))
+	}
+	if body.Gain == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gain", "body"))
+	}
+	if body.TimeWeightedReturn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("time_weighted_return", "body"))
+	}
+	if body.Start != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start", *body.Start, goa.FormatDate))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", *body.End, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateGetAllocationResponseBody runs the validations defined on
+// GetAllocationResponseBody
+func ValidateGetAllocationResponseBody(body *GetAllocationResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Dimension == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dimension", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.TotalValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_value", "body"))
+	}
+	if body.Buckets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("buckets", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	for _, e := range body.Buckets {
+		if e != nil {
+			if err2 := ValidateAllocationBucketResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetTargetAllocationResponseBody runs the validations defined on
+// GetTargetAllocationResponseBody
+func ValidateGetTargetAllocationResponseBody(body *GetTargetAllocationResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Targets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("targets", "body"))
+	}
+	if body.CashWeight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_weight", "body"))
+	}
+	for _, e := range body.Targets {
+		if e != nil {
+			if err2 := ValidateTargetWeightResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSetTargetAllocationResponseBody runs the validations defined on
+// SetTargetAllocationResponseBody
+func ValidateSetTargetAllocationResponseBody(body *SetTargetAllocationResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Targets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("targets", "body"))
+	}
+	if body.CashWeight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_weight", "body"))
+	}
+	for _, e := range body.Targets {
+		if e != nil {
+			if err2 := ValidateTargetWeightResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateProposeRebalanceResponseBody runs the validations defined on
+// ProposeRebalanceResponseBody
+func ValidateProposeRebalanceResponseBody(body *ProposeRebalanceResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.CashBefore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_before", "body"))
+	}
+	if body.CashAfter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_after", "body"))
+	}
+	if body.Trades == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trades", "body"))
+	}
+	if body.Warnings == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("warnings", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	for _, e := range body.Trades {
+		if e != nil {
+			if err2 := ValidateProposedTradeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSetBenchmarkResponseBody runs the validations defined on
+// SetBenchmarkResponseBody
+func ValidateSetBenchmarkResponseBody(body *SetBenchmarkResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Rebalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rebalance", "body"))
+	}
+	for _, e := range body.Components {
+		if e != nil {
+			if err2 := ValidateBenchmarkComponentResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Rebalance != nil {
+		if !(*body.Rebalance == "none" || *body.Rebalance == "daily" || *body.Rebalance == "monthly" || *body.Rebalance == "quarterly" || *body.Rebalance == "annual") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.rebalance", *body.Rebalance, []any{"none", "daily", "monthly", "quarterly", "annual"}))
+		}
+	}
+	return
+}
+
+// ValidateGetBenchmarkComparisonResponseBody runs the validations defined on
+// GetBenchmarkComparisonResponseBody
+func ValidateGetBenchmarkComparisonResponseBody(body *GetBenchmarkComparisonResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Benchmark == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("benchmark", "body"))
+	}
+	if body.Start == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start", "body"))
+	}
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.PortfolioReturn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_return", "body"))
+	}
+	if body.BenchmarkReturn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("benchmark_return", "body"))
+	}
+	if body.ExcessReturn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("excess_return", "body"))
+	}
+	if body.TrackingError == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tracking_error", "body"))
+	}
+	if body.Series == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("series", "body"))
+	}
+	if body.Benchmark != nil {
+		if err2 := ValidateBenchmarkDefinitionResponseBody(body.Benchmark); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Start != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start", *body.Start, goa.FormatDate))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", *body.End, goa.FormatDate))
+	}
+	for _, e := range body.Series {
+		if e != nil {
+			if err2 := ValidateBenchmarkComparisonPointResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetRiskMetricsResponseBody runs the validations defined on
+// GetRiskMetricsResponseBody
+func ValidateGetRiskMetricsResponseBody(body *GetRiskMetricsResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Start == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start", "body"))
+	}
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.RiskFreeRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("risk_free_rate", "body"))
+	}
+	if body.Volatility == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volatility", "body"))
+	}
+	if body.SharpeRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sharpe_ratio", "body"))
+	}
+	if body.SortinoRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sortino_ratio", "body"))
+	}
+	if body.MaxDrawdown == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_drawdown", "body"))
+	}
+	if body.Rolling == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rolling", "body"))
+	}
+	if body.Benchmark != nil {
+		if err2 := ValidateBenchmarkDefinitionResponseBody(body.Benchmark); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Start != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start", *body.Start, goa.FormatDate))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", *body.End, goa.FormatDate))
+	}
+	if body.MaxDrawdownPeak != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.max_drawdown_peak", *body.MaxDrawdownPeak, goa.FormatDate))
+	}
+	if body.MaxDrawdownTrough != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.max_drawdown_trough", *body.MaxDrawdownTrough, goa.FormatDate))
+	}
+	for _, e := range body.Rolling {
+		if e != nil {
+			if err2 := ValidateRiskWindowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetValueAtRiskResponseBody runs the validations defined on
+// GetValueAtRiskResponseBody
+func ValidateGetValueAtRiskResponseBody(body *GetValueAtRiskResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Method == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("method", "body"))
+	}
+	if body.Confidence == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("confidence", "body"))
+	}
+	if body.Horizon == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("horizon", "body"))
+	}
+	if body.Lookback == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lookback", "body"))
+	}
+	if body.PortfolioValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_value", "body"))
+	}
+	if body.ValueAtRisk == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value_at_risk", "body"))
+	}
+	if body.ExpectedShortfall == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expected_shortfall", "body"))
+	}
+	if body.Contributions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("contributions", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	for _, e := range body.Contributions {
+		if e != nil {
+			if err2 := ValidateVaRContributionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRunStressTestResponseBody runs the validations defined on
+// RunStressTestResponseBody
+func ValidateRunStressTestResponseBody(body *RunStressTestResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Scenario == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scenario", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.CurrentValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("current_value", "body"))
+	}
+	if body.ProjectedValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("projected_value", "body"))
+	}
+	if body.Loss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loss", "body"))
+	}
+	if body.LossPercent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loss_percent", "body"))
+	}
+	if body.WorstContributors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("worst_contributors", "body"))
+	}
+	if body.Warnings == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("warnings", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	if body.Scenario != nil {
+		if err2 := ValidateStressScenarioResponseBody(body.Scenario); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.WorstContributors {
+		if e != nil {
+			if err2 := ValidateStressImpactResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateProjectPortfolioResponseBody runs the validations defined on
+// ProjectPortfolioResponseBody
+func ValidateProjectPortfolioResponseBody(body *ProjectPortfolioResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Start == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start", "body"))
+	}
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.StartValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start_value", "body"))
+	}
+	if body.Paths == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("paths", "body"))
+	}
+	if body.Assumptions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("assumptions", "body"))
+	}
+	if body.Bands == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("bands", "body"))
+	}
+	if body.DepletionProbability == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("depletion_probability", "body"))
+	}
+	if body.Start != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start", *body.Start, goa.FormatDate))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", *body.End, goa.FormatDate))
+	}
+	for _, e := range body.Assumptions {
+		if e != nil {
+			if err2 := ValidateAssetClassAssumptionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Bands {
+		if e != nil {
+			if err2 := ValidateProjectionBandResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.GoalDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.goal_date", *body.GoalDate, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateRecordIncomeResponseBody runs the validations defined on
+// RecordIncomeResponseBody
+func ValidateRecordIncomeResponseBody(body *RecordIncomeResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.PayDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pay_date", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Withholding == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("withholding", "body"))
+	}
+	if body.Net == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net", "body"))
+	}
+	if body.Qualified == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("qualified", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "dividend" || *body.Type == "interest") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"dividend", "interest"}))
+		}
+	}
+	if body.PayDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.pay_date", *body.PayDate, goa.FormatDate))
+	}
+	if body.ExDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.ex_date", *body.ExDate, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateGetIncomeResponseBody runs the validations defined on
+// GetIncomeResponseBody
+func ValidateGetIncomeResponseBody(body *GetIncomeResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Start == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start", "body"))
+	}
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Interval == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("interval", "body"))
+	}
+	if body.Gross == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gross", "body"))
+	}
+	if body.Withholding == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("withholding", "body"))
+	}
+	if body.Net == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net", "body"))
+	}
+	if body.Qualified == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("qualified", "body"))
+	}
+	if body.Ordinary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ordinary", "body"))
+	}
+	if body.ByPeriod == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("by_period", "body"))
+	}
+	if body.ByHolding == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("by_holding", "body"))
+	}
+	if body.ByCurrency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("by_currency", "body"))
+	}
+	if body.Forecast == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("forecast", "body"))
+	}
+	if body.ForecastTotal == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("forecast_total", "body"))
+	}
+	if body.Start != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start", *body.Start, goa.FormatDate))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", *body.End, goa.FormatDate))
+	}
+	for _, e := range body.ByPeriod {
+		if e != nil {
+			if err2 := ValidateIncomeBucketResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ByHolding {
+		if e != nil {
+			if err2 := ValidateIncomeBucketResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ByCurrency {
+		if e != nil {
+			if err2 := ValidateIncomeBucketResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Forecast {
+		if e != nil {
+			if err2 := ValidateIncomeForecastResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePriceBondResponseBody runs the validations defined on
+// PriceBondResponseBody
+func ValidatePriceBondResponseBody(body *PriceBondResponseBody) (err error) {
+	if body.Settlement == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("settlement", "body"))
+	}
+	if body.CleanPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("clean_price", "body"))
+	}
+	if body.DirtyPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dirty_price", "body"))
+	}
+	if body.AccruedInterest == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accrued_interest", "body"))
+	}
+	if body.YieldToMaturity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("yield_to_maturity", "body"))
+	}
+	if body.MacaulayDuration == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("macaulay_duration", "body"))
+	}
+	if body.ModifiedDuration == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("modified_duration", "body"))
+	}
+	if body.Settlement != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.settlement", *body.Settlement, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateGetCashFlowLadderResponseBody runs the validations defined on
+// GetCashFlowLadderResponseBody
+func ValidateGetCashFlowLadderResponseBody(body *GetCashFlowLadderResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Flows == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("flows", "body"))
+	}
+	if body.ByYear == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("by_year", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", *body.End, goa.FormatDate))
+	}
+	for _, e := range body.Flows {
+		if e != nil {
+			if err2 := ValidateBondCashFlowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ByYear {
+		if e != nil {
+			if err2 := ValidateCashFlowLadderYearResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListOptionPositionsResponseBody runs the validations defined on
+// ListOptionPositionsResponseBody
+func ValidateListOptionPositionsResponseBody(body *ListOptionPositionsResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Positions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("positions", "body"))
+	}
+	if body.Exposures == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exposures", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	for _, e := range body.Positions {
+		if e != nil {
+			if err2 := ValidateOptionPositionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Exposures {
+		if e != nil {
+			if err2 := ValidateUnderlyingExposureResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSetMarginAccountResponseBody runs the validations defined on
+// SetMarginAccountResponseBody
+func ValidateSetMarginAccountResponseBody(body *SetMarginAccountResponseBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.InitialRequirement == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("initial_requirement", "body"))
+	}
+	if body.MaintenanceRequirement == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("maintenance_requirement", "body"))
+	}
+	if body.ShortMaintenanceRequirement == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_maintenance_requirement", "body"))
+	}
+	if body.LoanRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loan_rate", "body"))
+	}
+	if body.BorrowRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("borrow_rate", "body"))
+	}
+	if body.InitialRequirement != nil {
+		if *body.InitialRequirement < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_requirement", *body.InitialRequirement, 0, true))
+		}
+	}
+	if body.InitialRequirement != nil {
+		if *body.InitialRequirement > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_requirement", *body.InitialRequirement, 1, false))
+		}
+	}
+	if body.MaintenanceRequirement != nil {
+		if *body.MaintenanceRequirement < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maintenance_requirement", *body.MaintenanceRequirement, 0, true))
+		}
+	}
+	if body.MaintenanceRequirement != nil {
+		if *body.MaintenanceRequirement > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maintenance_requirement", *body.MaintenanceRequirement, 1, false))
+		}
+	}
+	if body.ShortMaintenanceRequirement != nil {
+		if *body.ShortMaintenanceRequirement < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.short_maintenance_requirement", *body.ShortMaintenanceRequirement, 0, true))
+		}
+	}
+	if body.ShortMaintenanceRequirement != nil {
+		if *body.ShortMaintenanceRequirement > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.short_maintenance_requirement", *body.ShortMaintenanceRequirement, 1, false))
+		}
+	}
+	if body.LoanRate != nil {
+		if *body.LoanRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.loan_rate", *body.LoanRate, 0, true))
+		}
+	}
+	if body.BorrowRate != nil {
+		if *body.BorrowRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.borrow_rate", *body.BorrowRate, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateGetMarginStatusResponseBody runs the validations defined on
+// GetMarginStatusResponseBody
+func ValidateGetMarginStatusResponseBody(body *GetMarginStatusResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Accounts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accounts", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	for _, e := range body.Accounts {
+		if e != nil {
+			if err2 := ValidateMarginAccountStatusResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateChargeMarginResponseBody runs the validations defined on
+// ChargeMarginResponseBody
+func ValidateChargeMarginResponseBody(body *ChargeMarginResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Accounts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accounts", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	for _, e := range body.Accounts {
+		if e != nil {
+			if err2 := ValidateMarginAccountStatusResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePlaceOrderResponseBody runs the validations defined on
+// PlaceOrderResponseBody
+func ValidatePlaceOrderResponseBody(body *PlaceOrderResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.TimeInForce == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("time_in_force", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Triggered == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("triggered", "body"))
+	}
+	if body.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.FillPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fill_price", "body"))
+	}
+	if body.Commission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("commission", "body"))
+	}
+	if body.Side != nil {
+		if !(*body.Side == "buy" || *body.Side == "sell") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", *body.Side, []any{"buy", "sell"}))
+		}
+	}
+	if body.Type != nil {
+		if !(*body.Type == "market" || *body.Type == "limit" || *body.Type == "stop" || *body.Type == "stop_limit") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"market", "limit", "stop", "stop_limit"}))
+		}
+	}
+	if body.TimeInForce != nil {
+		if !(*body.TimeInForce == "day" || *body.TimeInForce == "gtc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.time_in_force", *body.TimeInForce, []any{"day", "gtc"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "open" || *body.Status == "filled" || *body.Status == "cancelled" || *body.Status == "expired" || *body.Status == "rejected") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"open", "filled", "cancelled", "expired", "rejected"}))
+		}
+	}
+	if body.Created != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created", *body.Created, goa.FormatDateTime))
+	}
+	if body.Updated != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated", *body.Updated, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCancelOrderResponseBody runs the validations defined on
+// CancelOrderResponseBody
+func ValidateCancelOrderResponseBody(body *CancelOrderResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.TimeInForce == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("time_in_force", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Triggered == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("triggered", "body"))
+	}
+	if body.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.FillPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fill_price", "body"))
+	}
+	if body.Commission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("commission", "body"))
+	}
+	if body.Side != nil {
+		if !(*body.Side == "buy" || *body.Side == "sell") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", *body.Side, []any{"buy", "sell"}))
+		}
+	}
+	if body.Type != nil {
+		if !(*body.Type == "market" || *body.Type == "limit" || *body.Type == "stop" || *body.Type == "stop_limit") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"market", "limit", "stop", "stop_limit"}))
+		}
+	}
+	if body.TimeInForce != nil {
+		if !(*body.TimeInForce == "day" || *body.TimeInForce == "gtc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.time_in_force", *body.TimeInForce, []any{"day", "gtc"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "open" || *body.Status == "filled" || *body.Status == "cancelled" || *body.Status == "expired" || *body.Status == "rejected") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"open", "filled", "cancelled", "expired", "rejected"}))
+		}
+	}
+	if body.Created != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created", *body.Created, goa.FormatDateTime))
+	}
+	if body.Updated != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated", *body.Updated, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidatePreviewTradesResponseBody runs the validations defined on
+// PreviewTradesResponseBody
+func ValidatePreviewTradesResponseBody(body *PreviewTradesResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Before == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("before", "body"))
+	}
+	if body.After == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("after", "body"))
+	}
+	if body.CashBefore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_before", "body"))
+	}
+	if body.CashAfter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_after", "body"))
+	}
+	if body.Dimension == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dimension", "body"))
+	}
+	if body.Allocation == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("allocation", "body"))
+	}
+	if body.Realized == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("realized", "body"))
+	}
+	if body.ShortTermGain == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_term_gain", "body"))
+	}
+	if body.LongTermGain == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_term_gain", "body"))
+	}
+	if body.EstimatedTax == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("estimated_tax", "body"))
+	}
+	if body.RiskBefore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("risk_before", "body"))
+	}
+	if body.RiskAfter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("risk_after", "body"))
+	}
+	if body.Warnings == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("warnings", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	if body.Before != nil {
+		if err2 := ValidatePortfolioSummaryResponseBody(body.Before); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.After != nil {
+		if err2 := ValidatePortfolioSummaryResponseBody(body.After); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Allocation {
+		if e != nil {
+			if err2 := ValidateAllocationChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Realized {
+		if e != nil {
+			if err2 := ValidateRealizedLotResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.RiskBefore != nil {
+		if err2 := ValidateHoldingsRiskResponseBody(body.RiskBefore); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.RiskAfter != nil {
+		if err2 := ValidateHoldingsRiskResponseBody(body.RiskAfter); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateRunBacktestResponseBody runs the validations defined on
+// RunBacktestResponseBody
+func ValidateRunBacktestResponseBody(body *RunBacktestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Strategy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("strategy", "body"))
+	}
+	if body.Start == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start", "body"))
+	}
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.EndValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end_value", "body"))
+	}
+	if body.NetContributions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_contributions", "body"))
+	}
+	if body.Gain == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gain", "body"))
+	}
+	if body.TimeWeightedReturn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("time_weighted_return", "body"))
+	}
+	if body.Volatility == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volatility", "body"))
+	}
+	if body.SharpeRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sharpe_ratio", "body"))
+	}
+	if body.MaxDrawdown == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_drawdown", "body"))
+	}
+	if body.Trades == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trades", "body"))
+	}
+	if body.TradedValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("traded_value", "body"))
+	}
+	if body.Fees == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fees", "body"))
+	}
+	if body.Turnover == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("turnover", "body"))
+	}
+	if body.EquityCurve == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("equity_curve", "body"))
+	}
+	if body.Start != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start", *body.Start, goa.FormatDate))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", *body.End, goa.FormatDate))
+	}
+	if body.MaxDrawdownPeak != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.max_drawdown_peak", *body.MaxDrawdownPeak, goa.FormatDate))
+	}
+	if body.MaxDrawdownTrough != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.max_drawdown_trough", *body.MaxDrawdownTrough, goa.FormatDate))
+	}
+	for _, e := range body.EquityCurve {
+		if e != nil {
+			if err2 := ValidateBacktestPointResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImportCsvResponseBody runs the validations defined on
+// ImportCsvResponseBody
+func ValidateImportCsvResponseBody(body *ImportCsvResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Format == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("format", "body"))
+	}
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.Imported == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("imported", "body"))
+	}
+	if body.Duplicates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duplicates", "body"))
+	}
+	if body.Skipped == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("skipped", "body"))
+	}
+	if body.Errors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errors", "body"))
+	}
+	if body.Rows == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rows", "body"))
+	}
+	for _, e := range body.Rows {
+		if e != nil {
+			if err2 := ValidateImportRowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Reconciliation {
+		if e != nil {
+			if err2 := ValidateAccountReconciliationResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImportOfxResponseBody runs the validations defined on
+// ImportOfxResponseBody
+func ValidateImportOfxResponseBody(body *ImportOfxResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Format == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("format", "body"))
+	}
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.Imported == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("imported", "body"))
+	}
+	if body.Duplicates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duplicates", "body"))
+	}
+	if body.Skipped == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("skipped", "body"))
+	}
+	if body.Errors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errors", "body"))
+	}
+	if body.Rows == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rows", "body"))
+	}
+	for _, e := range body.Rows {
+		if e != nil {
+			if err2 := ValidateImportRowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Reconciliation {
+		if e != nil {
+			if err2 := ValidateAccountReconciliationResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImportJournalResponseBody runs the validations defined on
+// ImportJournalResponseBody
+func ValidateImportJournalResponseBody(body *ImportJournalResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Format == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("format", "body"))
+	}
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.Imported == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("imported", "body"))
+	}
+	if body.Duplicates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duplicates", "body"))
+	}
+	if body.Skipped == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("skipped", "body"))
+	}
+	if body.Errors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errors", "body"))
+	}
+	if body.Rows == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rows", "body"))
+	}
+	for _, e := range body.Rows {
+		if e != nil {
+			if err2 := ValidateImportRowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Reconciliation {
+		if e != nil {
+			if err2 := ValidateAccountReconciliationResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImportArchiveResponseBody runs the validations defined on
+// ImportArchiveResponseBody
+func ValidateImportArchiveResponseBody(body *ImportArchiveResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.SchemaVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schema_version", "body"))
+	}
+	if body.Migrated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("migrated", "body"))
+	}
+	if body.Replaced == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("replaced", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Transactions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("transactions", "body"))
+	}
+	if body.Instruments == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("instruments", "body"))
+	}
+	if body.Targets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("targets", "body"))
+	}
+	if body.Accounts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accounts", "body"))
+	}
+	if body.MarginAccounts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_accounts", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateReconcilePositionsResponseBody runs the validations defined on
+// ReconcilePositionsResponseBody
+func ValidateReconcilePositionsResponseBody(body *ReconcilePositionsResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Format == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("format", "body"))
+	}
+	if body.Accounts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accounts", "body"))
+	}
+	if body.Opened == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("opened", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.Resolved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resolved", "body"))
+	}
+	if body.Breaks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("breaks", "body"))
+	}
+	for _, e := range body.Accounts {
+		if e != nil {
+			if err2 := ValidateReconciledAccountResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Breaks {
+		if e != nil {
+			if err2 := ValidateReconciliationBreakResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUpdateReconciliationBreakResponseBody runs the validations defined
+// on UpdateReconciliationBreakResponseBody
+func ValidateUpdateReconciliationBreakResponseBody(body *UpdateReconciliationBreakResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Statement == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statement", "body"))
+	}
+	if body.Ledger == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ledger", "body"))
+	}
+	if body.Difference == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("difference", "body"))
+	}
+	if body.Adjustments == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("adjustments", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detected == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detected", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "quantity_mismatch" || *body.Kind == "missing_in_ledger" || *body.Kind == "missing_in_statement" || *body.Kind == "cash_difference") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"quantity_mismatch", "missing_in_ledger", "missing_in_statement", "cash_difference"}))
+		}
+	}
+	for _, e := range body.Adjustments {
+		if e != nil {
+			if err2 := ValidateLedgerTransactionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "open" || *body.Status == "resolved" || *body.Status == "ignored") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"open", "resolved", "ignored"}))
+		}
+	}
+	if body.Detected != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.detected", *body.Detected, goa.FormatDateTime))
+	}
+	if body.Updated != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated", *body.Updated, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetTaxReportResponseBody runs the validations defined on
+// GetTaxReportResponseBody
+func ValidateGetTaxReportResponseBody(body *GetTaxReportResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Year == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("year", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Accounts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accounts", "body"))
+	}
+	if body.ShortTerm == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_term", "body"))
+	}
+	if body.LongTerm == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_term", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	if body.WashSales == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("wash_sales", "body"))
+	}
+	for _, e := range body.Accounts {
+		if e != nil {
+			if err2 := ValidateTaxAccountResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.ShortTerm != nil {
+		if err2 := ValidateTaxTotalsResponseBody(body.ShortTerm); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.LongTerm != nil {
+		if err2 := ValidateTaxTotalsResponseBody(body.LongTerm); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Total != nil {
+		if err2 := ValidateTaxTotalsResponseBody(body.Total); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.WashSales {
+		if e != nil {
+			if err2 := ValidateWashSaleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateFindTaxLossHarvestsResponseBody runs the validations defined on
+// FindTaxLossHarvestsResponseBody
+func ValidateFindTaxLossHarvestsResponseBody(body *FindTaxLossHarvestsResponseBody) (err error) {
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Opportunities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("opportunities", "body"))
+	}
+	if body.Skipped == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("skipped", "body"))
+	}
+	if body.TotalLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_loss", "body"))
+	}
+	if body.EstimatedSavings == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("estimated_savings", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	for _, e := range body.Opportunities {
+		if e != nil {
+			if err2 := ValidateHarvestOpportunityResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Skipped {
+		if e != nil {
+			if err2 := ValidateSkippedHarvestResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSetAccountResponseBody runs the validations defined on
+// SetAccountResponseBody
+func ValidateSetAccountResponseBody(body *SetAccountResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.TaxTreatment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tax_treatment", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Registered == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("registered", "body"))
+	}
+	if body.Cash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash", "body"))
+	}
+	if body.HoldingsValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("holdings_value", "body"))
+	}
+	if body.TotalValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_value", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "taxable" || *body.Type == "ira" || *body.Type == "roth_ira" || *body.Type == "401k" || *body.Type == "roth_401k" || *body.Type == "hsa" || *body.Type == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"taxable", "ira", "roth_ira", "401k", "roth_401k", "hsa", "other"}))
+		}
+	}
+	if body.TaxTreatment != nil {
+		if !(*body.TaxTreatment == "taxable" || *body.TaxTreatment == "tax_deferred" || *body.TaxTreatment == "tax_exempt") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tax_treatment", *body.TaxTreatment, []any{"taxable", "tax_deferred", "tax_exempt"}))
+		}
+	}
+	return
+}
+
+// ValidateRemoveAccountResponseBody runs the validations defined on
+// RemoveAccountResponseBody
+func ValidateRemoveAccountResponseBody(body *RemoveAccountResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.TaxTreatment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tax_treatment", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Registered == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("registered", "body"))
+	}
+	if body.Cash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash", "body"))
+	}
+	if body.HoldingsValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("holdings_value", "body"))
+	}
+	if body.TotalValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_value", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "taxable" || *body.Type == "ira" || *body.Type == "roth_ira" || *body.Type == "401k" || *body.Type == "roth_401k" || *body.Type == "hsa" || *body.Type == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"taxable", "ira", "roth_ira", "401k", "roth_401k", "hsa", "other"}))
+		}
+	}
+	if body.TaxTreatment != nil {
+		if !(*body.TaxTreatment == "taxable" || *body.TaxTreatment == "tax_deferred" || *body.TaxTreatment == "tax_exempt") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tax_treatment", *body.TaxTreatment, []any{"taxable", "tax_deferred", "tax_exempt"}))
+		}
+	}
+	return
+}
+
+// ValidateAllocationBucketResponseBody runs the validations defined on
+// AllocationBucketResponseBody
+func ValidateAllocationBucketResponseBody(body *AllocationBucketResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.Symbols == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbols", "body"))
+	}
+	return
+}
+
+// ValidateTargetWeightResponseBody runs the validations defined on
+// TargetWeightResponseBody
+func ValidateTargetWeightResponseBody(body *TargetWeightResponseBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, false))
+		}
+	}
+	if body.Tolerance != nil {
+		if *body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", *body.Tolerance, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateTargetWeightRequestBodyRequestBody runs the validations defined on
+// TargetWeightRequestBodyRequestBody
+func ValidateTargetWeightRequestBodyRequestBody(body *TargetWeightRequestBodyRequestBody) (err error) {
+	if body.Weight < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", body.Weight, 0, true))
+	}
+	if body.Weight > 1 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", body.Weight, 1, false))
+	}
+	if body.Tolerance < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", body.Tolerance, 0, true))
+	}
+	return
+}
+
+// ValidateProposedTradeResponseBody runs the validations defined on
+// ProposedTradeResponseBody
+func ValidateProposedTradeResponseBody(body *ProposedTradeResponseBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Price == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("price", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.CurrentWeight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("current_weight", "body"))
+	}
+	if body.TargetWeight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("target_weight", "body"))
+	}
+	if body.ProjectedWeight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("projected_weight", "body"))
+	}
+	if body.Side != nil {
+		if !(*body.Side == "buy" || *body.Side == "sell") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", *body.Side, []any{"buy", "sell"}))
+		}
+	}
+	return
+}
+
+// ValidateBenchmarkComponentRequestBodyRequestBody runs the validations
+// defined on BenchmarkComponentRequestBodyRequestBody
+func ValidateBenchmarkComponentRequestBodyRequestBody(body *BenchmarkComponentRequestBodyRequestBody) (err error) {
+	if body.Weight < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", body.Weight, 0, true))
+	}
+	return
+}
+
+// ValidateBenchmarkComponentResponseBody runs the validations defined on
+// BenchmarkComponentResponseBody
+func ValidateBenchmarkComponentResponseBody(body *BenchmarkComponentResponseBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateBenchmarkDefinitionResponseBody runs the validations defined on
+// BenchmarkDefinitionResponseBody
+func ValidateBenchmarkDefinitionResponseBody(body *BenchmarkDefinitionResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Rebalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rebalance", "body"))
+	}
+	for _, e := range body.Components {
+		if e != nil {
+			if err2 := ValidateBenchmarkComponentResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Rebalance != nil {
+		if !(*body.Rebalance == "none" || *body.Rebalance == "daily" || *body.Rebalance == "monthly" || *body.Rebalance == "quarterly" || *body.Rebalance == "annual") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.rebalance", *body.Rebalance, []any{"none", "daily", "monthly", "quarterly", "annual"}))
+		}
+	}
+	return
+}
+
+// ValidateBenchmarkComparisonPointResponseBody runs the validations defined on
+// BenchmarkComparisonPointResponseBody
+func ValidateBenchmarkComparisonPointResponseBody(body *BenchmarkComparisonPointResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Portfolio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio", "body"))
+	}
+	if body.Benchmark == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("benchmark", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateRiskWindowResponseBody runs the validations defined on
+// RiskWindowResponseBody
+func ValidateRiskWindowResponseBody(body *RiskWindowResponseBody) (err error) {
+	if body.Start == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start", "body"))
+	}
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.Volatility == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volatility", "body"))
+	}
+	if body.SharpeRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sharpe_ratio", "body"))
+	}
+	if body.SortinoRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sortino_ratio", "body"))
+	}
+	if body.MaxDrawdown == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_drawdown", "body"))
+	}
+	if body.Start != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start", *body.Start, goa.FormatDate))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", *body.End, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateVaRContributionResponseBody runs the validations defined on
+// VaRContributionResponseBody
+func ValidateVaRContributionResponseBody(body *VaRContributionResponseBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.MarginalVar == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("marginal_var", "body"))
+	}
+	if body.ComponentVar == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("component_var", "body"))
+	}
+	return
+}
+
+// ValidateStressScenarioResponse runs the validations defined on
+// StressScenarioResponse
+func ValidateStressScenarioResponse(body *StressScenarioResponse) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.RateShift == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rate_shift", "body"))
+	}
+	if body.ReplayStart != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.replay_start", *body.ReplayStart, goa.FormatDate))
+	}
+	if body.ReplayEnd != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.replay_end", *body.ReplayEnd, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateStressScenarioResponseBody runs the validations defined on
+// StressScenarioResponseBody
+func ValidateStressScenarioResponseBody(body *StressScenarioResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.RateShift == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rate_shift", "body"))
+	}
+	if body.ReplayStart != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.replay_start", *body.ReplayStart, goa.FormatDate))
+	}
+	if body.ReplayEnd != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.replay_end", *body.ReplayEnd, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateStressImpactResponseBody runs the validations defined on
+// StressImpactResponseBody
+func ValidateStressImpactResponseBody(body *StressImpactResponseBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.AssetClass == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset_class", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.ProjectedValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("projected_value", "body"))
+	}
+	if body.Pnl == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pnl", "body"))
+	}
+	if body.Shock == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("shock", "body"))
+	}
+	return
+}
+
+// ValidateAssetClassAssumptionRequestBody runs the validations defined on
+// AssetClassAssumptionRequestBody
+func ValidateAssetClassAssumptionRequestBody(body *AssetClassAssumptionRequestBody) (err error) {
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, false))
+		}
+	}
+	if body.Volatility != nil {
+		if *body.Volatility < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.volatility", *body.Volatility, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateAssetClassAssumptionResponseBody runs the validations defined on
+// AssetClassAssumptionResponseBody
+func ValidateAssetClassAssumptionResponseBody(body *AssetClassAssumptionResponseBody) (err error) {
+	if body.AssetClass == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset_class", "body"))
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, false))
+		}
+	}
+	if body.Volatility != nil {
+		if *body.Volatility < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.volatility", *body.Volatility, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateProjectionBandResponseBody runs the validations defined on
+// ProjectionBandResponseBody
+func ValidateProjectionBandResponseBody(body *ProjectionBandResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.P5 == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("p5", "body"))
+	}
+	if body.P25 == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("p25", "body"))
+	}
+	if body.P50 == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("p50", "body"))
+	}
+	if body.P75 == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("p75", "body"))
+	}
+	if body.P95 == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("p95", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateCorporateActionResponse runs the validations defined on
+// CorporateActionResponse
+func ValidateCorporateActionResponse(body *CorporateActionResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "split" || *body.Type == "symbol_change" || *body.Type == "spin_off" || *body.Type == "merger") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"split", "symbol_change", "spin_off", "merger"}))
+		}
+	}
+	if body.Ratio != nil {
+		if *body.Ratio < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.ratio", *body.Ratio, 0, true))
+		}
+	}
+	if body.CashPerShare != nil {
+		if *body.CashPerShare < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cash_per_share", *body.CashPerShare, 0, true))
+		}
+	}
+	if body.BasisFraction != nil {
+		if *body.BasisFraction < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.basis_fraction", *body.BasisFraction, 0, true))
+		}
+	}
+	if body.BasisFraction != nil {
+		if *body.BasisFraction > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.basis_fraction", *body.BasisFraction, 1, false))
+		}
+	}
+	if body.Price != nil {
+		if *body.Price < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.price", *body.Price, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateCorporateActionInputRequestBody runs the validations defined on
+// CorporateActionInputRequestBody
+func ValidateCorporateActionInputRequestBody(body *CorporateActionInputRequestBody) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("body.date", body.Date, goa.FormatDate))
+	if !(body.Type == "split" || body.Type == "symbol_change" || body.Type == "spin_off" || body.Type == "merger") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", body.Type, []any{"split", "symbol_change", "spin_off", "merger"}))
+	}
+	if body.Ratio < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.ratio", body.Ratio, 0, true))
+	}
+	if body.CashPerShare < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.cash_per_share", body.CashPerShare, 0, true))
+	}
+	if body.BasisFraction < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.basis_fraction", body.BasisFraction, 0, true))
+	}
+	if body.BasisFraction > 1 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.basis_fraction", body.BasisFraction, 1, false))
+	}
+	if body.Price < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.price", body.Price, 0, true))
+	}
+	return
+}
+
+// ValidateIncomeBucketResponseBody runs the validations defined on
+// IncomeBucketResponseBody
+func ValidateIncomeBucketResponseBody(body *IncomeBucketResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Gross == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gross", "body"))
+	}
+	if body.Withholding == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("withholding", "body"))
+	}
+	if body.Net == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net", "body"))
+	}
+	return
+}
+
+// ValidateIncomeForecastResponseBody runs the validations defined on
+// IncomeForecastResponseBody
+func ValidateIncomeForecastResponseBody(body *IncomeForecastResponseBody) (err error) {
+	if body.Month == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("month", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	return
+}
+
+// ValidateBondPositionResponse runs the validations defined on
+// BondPositionResponse
+func ValidateBondPositionResponse(body *BondPositionResponse) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Coupon == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("coupon", "body"))
+	}
+	if body.CouponFrequency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("coupon_frequency", "body"))
+	}
+	if body.DayCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("day_count", "body"))
+	}
+	if body.Maturity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("maturity", "body"))
+	}
+	if body.FaceValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("face_value", "body"))
+	}
+	if body.Analytics == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("analytics", "body"))
+	}
+	if body.MarketValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_value", "body"))
+	}
+	if body.AccruedValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accrued_value", "body"))
+	}
+	if body.Coupon != nil {
+		if *body.Coupon < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.coupon", *body.Coupon, 0, true))
+		}
+	}
+	if body.CouponFrequency != nil {
+		if !(*body.CouponFrequency == 0 || *body.CouponFrequency == 1 || *body.CouponFrequency == 2 || *body.CouponFrequency == 4 || *body.CouponFrequency == 12) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.coupon_frequency", *body.CouponFrequency, []any{0, 1, 2, 4, 12}))
+		}
+	}
+	if body.DayCount != nil {
+		if !(*body.DayCount == "30/360" || *body.DayCount == "ACT/360" || *body.DayCount == "ACT/365" || *body.DayCount == "ACT/ACT") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.day_count", *body.DayCount, []any{"30/360", "ACT/360", "ACT/365", "ACT/ACT"}))
+		}
+	}
+	if body.Maturity != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.maturity", *body.Maturity, goa.FormatDate))
+	}
+	if body.FaceValue != nil {
+		if *body.FaceValue < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.face_value", *body.FaceValue, 0, true))
+		}
+	}
+	if body.Analytics != nil {
+		if err2 := ValidateBondAnalyticsResponse(body.Analytics); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateBondAnalyticsResponse runs the validations defined on
+// BondAnalyticsResponse
+func ValidateBondAnalyticsResponse(body *BondAnalyticsResponse) (err error) {
+	if body.Settlement == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("settlement", "body"))
+	}
+	if body.CleanPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("clean_price", "body"))
+	}
+	if body.DirtyPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dirty_price", "body"))
+	}
+	if body.AccruedInterest == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accrued_interest", "body"))
+	}
+	if body.YieldToMaturity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("yield_to_maturity", "body"))
+	}
+	if body.MacaulayDuration == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("macaulay_duration", "body"))
+	}
+	if body.ModifiedDuration == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("modified_duration", "body"))
+	}
+	if body.Settlement != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.settlement", *body.Settlement, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateBondCashFlowResponseBody runs the validations defined on
+// BondCashFlowResponseBody
+func ValidateBondCashFlowResponseBody(body *BondCashFlowResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Coupon == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("coupon", "body"))
+	}
+	if body.Principal == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("principal", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateCashFlowLadderYearResponseBody runs the validations defined on
+// CashFlowLadderYearResponseBody
+func ValidateCashFlowLadderYearResponseBody(body *CashFlowLadderYearResponseBody) (err error) {
+	if body.Year == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("year", "body"))
+	}
+	if body.Coupon == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("coupon", "body"))
+	}
+	if body.Principal == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("principal", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	return
+}
+
+// ValidateOptionPositionResponseBody runs the validations defined on
+// OptionPositionResponseBody
+func ValidateOptionPositionResponseBody(body *OptionPositionResponseBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Underlying == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("underlying", "body"))
+	}
+	if body.OptionType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("option_type", "body"))
+	}
+	if body.Strike == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("strike", "body"))
+	}
+	if body.Expiry == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expiry", "body"))
+	}
+	if body.Multiplier == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("multiplier", "body"))
+	}
+	if body.FuturesOption == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("futures_option", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.UnderlyingPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("underlying_price", "body"))
+	}
+	if body.Volatility == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volatility", "body"))
+	}
+	if body.Price == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("price", "body"))
+	}
+	if body.MarketValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_value", "body"))
+	}
+	if body.Delta == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("delta", "body"))
+	}
+	if body.Gamma == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gamma", "body"))
+	}
+	if body.Theta == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("theta", "body"))
+	}
+	if body.Vega == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("vega", "body"))
+	}
+	if body.OptionType != nil {
+		if !(*body.OptionType == "call" || *body.OptionType == "put") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.option_type", *body.OptionType, []any{"call", "put"}))
+		}
+	}
+	if body.Expiry != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expiry", *body.Expiry, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateUnderlyingExposureResponseBody runs the validations defined on
+// UnderlyingExposureResponseBody
+func ValidateUnderlyingExposureResponseBody(body *UnderlyingExposureResponseBody) (err error) {
+	if body.Underlying == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("underlying", "body"))
+	}
+	if body.Price == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("price", "body"))
+	}
+	if body.Shares == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("shares", "body"))
+	}
+	if body.OptionDelta == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("option_delta", "body"))
+	}
+	if body.DeltaAdjustedShares == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("delta_adjusted_shares", "body"))
+	}
+	if body.DeltaAdjustedExposure == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("delta_adjusted_exposure", "body"))
+	}
+	return
+}
+
+// ValidateOptionSettlementResponse runs the validations defined on
+// OptionSettlementResponse
+func ValidateOptionSettlementResponse(body *OptionSettlementResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Price == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("price", "body"))
+	}
+	if body.Note == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("note", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "buy" || *body.Type == "sell") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"buy", "sell"}))
+		}
+	}
+	return
+}
+
+// ValidateMarginAccountStatusResponseBody runs the validations defined on
+// MarginAccountStatusResponseBody
+func ValidateMarginAccountStatusResponseBody(body *MarginAccountStatusResponseBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Cash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash", "body"))
+	}
+	if body.LongValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_value", "body"))
+	}
+	if body.ShortValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_value", "body"))
+	}
+	if body.ShortProceeds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_proceeds", "body"))
+	}
+	if body.Equity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("equity", "body"))
+	}
+	if body.LoanBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loan_balance", "body"))
+	}
+	if body.InitialRequirement == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("initial_requirement", "body"))
+	}
+	if body.MaintenanceRequirement == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("maintenance_requirement", "body"))
+	}
+	if body.MaintenanceExcess == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("maintenance_excess", "body"))
+	}
+	if body.BuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("buying_power", "body"))
+	}
+	if body.MarginCall == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_call", "body"))
+	}
+	if body.InterestCharged == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("interest_charged", "body"))
+	}
+	if body.BorrowFeesCharged == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("borrow_fees_charged", "body"))
+	}
+	if body.AccruedInterest == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accrued_interest", "body"))
+	}
+	if body.AccruedBorrowFees == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accrued_borrow_fees", "body"))
+	}
+	return
+}
+
+// ValidatePortfolioEventResponse runs the validations defined on
+// PortfolioEventResponse
+func ValidatePortfolioEventResponse(body *PortfolioEventResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Time == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("time", "body"))
+	}
+	if body.PortfolioID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("portfolio_id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Time != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.time", *body.Time, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateOrderResponse runs the validations defined on OrderResponse
+func ValidateOrderResponse(body *OrderResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.TimeInForce == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("time_in_force", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Triggered == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("triggered", "body"))
+	}
+	if body.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.FillPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fill_price", "body"))
+	}
+	if body.Commission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("commission", "body"))
+	}
+	if body.Side != nil {
+		if !(*body.Side == "buy" || *body.Side == "sell") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", *body.Side, []any{"buy", "sell"}))
+		}
+	}
+	if body.Type != nil {
+		if !(*body.Type == "market" || *body.Type == "limit" || *body.Type == "stop" || *body.Type == "stop_limit") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"market", "limit", "stop", "stop_limit"}))
+		}
+	}
+	if body.TimeInForce != nil {
+		if !(*body.TimeInForce == "day" || *body.TimeInForce == "gtc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.time_in_force", *body.TimeInForce, []any{"day", "gtc"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "open" || *body.Status == "filled" || *body.Status == "cancelled" || *body.Status == "expired" || *body.Status == "rejected") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"open", "filled", "cancelled", "expired", "rejected"}))
+		}
+	}
+	if body.Created != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created", *body.Created, goa.FormatDateTime))
+	}
+	if body.Updated != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated", *body.Updated, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateTradeInputRequestBody runs the validations defined on
+// TradeInputRequestBody
+func ValidateTradeInputRequestBody(body *TradeInputRequestBody) (err error) {
+	if !(body.Side == "buy" || body.Side == "sell") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", body.Side, []any{"buy", "sell"}))
+	}
+	if body.Quantity < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.quantity", body.Quantity, 0, true))
+	}
+	if body.Price != nil {
+		if *body.Price < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.price", *body.Price, 0, true))
+		}
+	}
+	if body.Fee < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.fee", body.Fee, 0, true))
+	}
+	return
+}
+
+// ValidatePortfolioSummaryResponseBody runs the validations defined on
+// PortfolioSummaryResponseBody
+func ValidatePortfolioSummaryResponseBody(body *PortfolioSummaryResponseBody) (err error) {
+	if body.Balance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("balance", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.ChangePercent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("change_percent", "body"))
+	}
+	return
+}
+
+// ValidateAllocationChangeResponseBody runs the validations defined on
+// AllocationChangeResponseBody
+func ValidateAllocationChangeResponseBody(body *AllocationChangeResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.ValueBefore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value_before", "body"))
+	}
+	if body.WeightBefore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight_before", "body"))
+	}
+	if body.ValueAfter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value_after", "body"))
+	}
+	if body.WeightAfter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight_after", "body"))
+	}
+	return
+}
+
+// ValidateRealizedLotResponseBody runs the validations defined on
+// RealizedLotResponseBody
+func ValidateRealizedLotResponseBody(body *RealizedLotResponseBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.LotID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lot_id", "body"))
+	}
+	if body.Acquired == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("acquired", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Proceeds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("proceeds", "body"))
+	}
+	if body.CostBasis == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cost_basis", "body"))
+	}
+	if body.Gain == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gain", "body"))
+	}
+	if body.LongTerm == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_term", "body"))
+	}
+	if body.Acquired != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.acquired", *body.Acquired, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateHoldingsRiskResponseBody runs the validations defined on
+// HoldingsRiskResponseBody
+func ValidateHoldingsRiskResponseBody(body *HoldingsRiskResponseBody) (err error) {
+	if body.Volatility == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("volatility", "body"))
+	}
+	if body.ValueAtRisk == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value_at_risk", "body"))
+	}
+	if body.ExpectedShortfall == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expected_shortfall", "body"))
+	}
+	return
+}
+
+// ValidateBacktestTargetRequestBody runs the validations defined on
+// BacktestTargetRequestBody
+func ValidateBacktestTargetRequestBody(body *BacktestTargetRequestBody) (err error) {
+	if body.Weight < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", body.Weight, 0, true))
+	}
+	if body.Weight > 1 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", body.Weight, 1, false))
+	}
+	return
+}
+
+// ValidateBacktestPointResponseBody runs the validations defined on
+// BacktestPointResponseBody
+func ValidateBacktestPointResponseBody(body *BacktestPointResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.Flow == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("flow", "body"))
+	}
+	if body.Drawdown == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("drawdown", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateImportRowResponseBody runs the validations defined on
+// ImportRowResponseBody
+func ValidateImportRowResponseBody(body *ImportRowResponseBody) (err error) {
+	if body.Line == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("line", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "imported" || *body.Status == "duplicate" || *body.Status == "skipped" || *body.Status == "error") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"imported", "duplicate", "skipped", "error"}))
+		}
+	}
+	if body.Transaction != nil {
+		if err2 := ValidateLedgerTransactionResponseBody(body.Transaction); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateLedgerTransactionResponseBody runs the validations defined on
+// LedgerTransactionResponseBody
+func ValidateLedgerTransactionResponseBody(body *LedgerTransactionResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Price == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("price", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Fee == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fee", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "deposit" || *body.Type == "withdrawal" || *body.Type == "buy" || *body.Type == "sell" || *body.Type == "dividend" || *body.Type == "interest" || *body.Type == "fee" || *body.Type == "margin_interest" || *body.Type == "borrow_fee") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"deposit", "withdrawal", "buy", "sell", "dividend", "interest", "fee", "margin_interest", "borrow_fee"}))
+		}
+	}
+	return
+}
+
+// ValidateAccountReconciliationResponseBody runs the validations defined on
+// AccountReconciliationResponseBody
+func ValidateAccountReconciliationResponseBody(body *AccountReconciliationResponseBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Positions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("positions", "body"))
+	}
+	if body.LedgerCash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ledger_cash", "body"))
+	}
+	if body.Matched == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matched", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	for _, e := range body.Positions {
+		if e != nil {
+			if err2 := ValidatePositionCheckResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePositionCheckResponseBody runs the validations defined on
+// PositionCheckResponseBody
+func ValidatePositionCheckResponseBody(body *PositionCheckResponseBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.StatementQuantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statement_quantity", "body"))
+	}
+	if body.LedgerQuantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ledger_quantity", "body"))
+	}
+	if body.Difference == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("difference", "body"))
+	}
+	if body.Matched == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matched", "body"))
+	}
+	return
+}
+
+// ValidateReconciledAccountResponseBody runs the validations defined on
+// ReconciledAccountResponseBody
+func ValidateReconciledAccountResponseBody(body *ReconciledAccountResponseBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Positions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("positions", "body"))
+	}
+	if body.Cash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash", "body"))
+	}
+	if body.Breaks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("breaks", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateReconciliationBreakResponseBody runs the validations defined on
+// ReconciliationBreakResponseBody
+func ValidateReconciliationBreakResponseBody(body *ReconciliationBreakResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Statement == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statement", "body"))
+	}
+	if body.Ledger == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ledger", "body"))
+	}
+	if body.Difference == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("difference", "body"))
+	}
+	if body.Adjustments == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("adjustments", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detected == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detected", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "quantity_mismatch" || *body.Kind == "missing_in_ledger" || *body.Kind == "missing_in_statement" || *body.Kind == "cash_difference") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"quantity_mismatch", "missing_in_ledger", "missing_in_statement", "cash_difference"}))
+		}
+	}
+	for _, e := range body.Adjustments {
+		if e != nil {
+			if err2 := ValidateLedgerTransactionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "open" || *body.Status == "resolved" || *body.Status == "ignored") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"open", "resolved", "ignored"}))
+		}
+	}
+	if body.Detected != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.detected", *body.Detected, goa.FormatDateTime))
+	}
+	if body.Updated != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated", *body.Updated, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateReconciliationBreakResponse runs the validations defined on
+// ReconciliationBreakResponse
+func ValidateReconciliationBreakResponse(body *ReconciliationBreakResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Statement == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("statement", "body"))
+	}
+	if body.Ledger == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ledger", "body"))
+	}
+	if body.Difference == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("difference", "body"))
+	}
+	if body.Adjustments == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("adjustments", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detected == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detected", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDate))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "quantity_mismatch" || *body.Kind == "missing_in_ledger" || *body.Kind == "missing_in_statement" || *body.Kind == "cash_difference") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"quantity_mismatch", "missing_in_ledger", "missing_in_statement", "cash_difference"}))
+		}
+	}
+	for _, e := range body.Adjustments {
+		if e != nil {
+			if err2 := ValidateLedgerTransactionResponse(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "open" || *body.Status == "resolved" || *body.Status == "ignored") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"open", "resolved", "ignored"}))
+		}
+	}
+	if body.Detected != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.detected", *body.Detected, goa.FormatDateTime))
+	}
+	if body.Updated != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated", *body.Updated, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateLedgerTransactionResponse runs the validations defined on
+// LedgerTransactionResponse
+func ValidateLedgerTransactionResponse(body *LedgerTransactionResponse) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Price == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("price", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Fee == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fee", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "deposit" || *body.Type == "withdrawal" || *body.Type == "buy" || *body.Type == "sell" || *body.Type == "dividend" || *body.Type == "interest" || *body.Type == "fee" || *body.Type == "margin_interest" || *body.Type == "borrow_fee") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"deposit", "withdrawal", "buy", "sell", "dividend", "interest", "fee", "margin_interest", "borrow_fee"}))
+		}
+	}
+	return
+}
+
+// ValidateTaxAccountResponseBody runs the validations defined on
+// TaxAccountResponseBody
+func ValidateTaxAccountResponseBody(body *TaxAccountResponseBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Lots == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lots", "body"))
+	}
+	if body.ShortTerm == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_term", "body"))
+	}
+	if body.LongTerm == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_term", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	for _, e := range body.Lots {
+		if e != nil {
+			if err2 := ValidateTaxLotGainResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.ShortTerm != nil {
+		if err2 := ValidateTaxTotalsResponseBody(body.ShortTerm); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.LongTerm != nil {
+		if err2 := ValidateTaxTotalsResponseBody(body.LongTerm); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Total != nil {
+		if err2 := ValidateTaxTotalsResponseBody(body.Total); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateTaxLotGainResponseBody runs the validations defined on
+// TaxLotGainResponseBody
+func ValidateTaxLotGainResponseBody(body *TaxLotGainResponseBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.LotID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lot_id", "body"))
+	}
+	if body.SaleID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sale_id", "body"))
+	}
+	if body.Acquired == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("acquired", "body"))
+	}
+	if body.Disposed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("disposed", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Proceeds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("proceeds", "body"))
+	}
+	if body.CostBasis == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cost_basis", "body"))
+	}
+	if body.BasisAdjustment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("basis_adjustment", "body"))
+	}
+	if body.WashSaleAdjustment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("wash_sale_adjustment", "body"))
+	}
+	if body.Gain == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gain", "body"))
+	}
+	if body.Term == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("term", "body"))
+	}
+	if body.ShortSale == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_sale", "body"))
+	}
+	if body.Acquired != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.acquired", *body.Acquired, goa.FormatDate))
+	}
+	if body.Disposed != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.disposed", *body.Disposed, goa.FormatDate))
+	}
+	if body.Term != nil {
+		if !(*body.Term == "short" || *body.Term == "long") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.term", *body.Term, []any{"short", "long"}))
+		}
+	}
+	return
+}
+
+// ValidateTaxTotalsResponseBody runs the validations defined on
+// TaxTotalsResponseBody
+func ValidateTaxTotalsResponseBody(body *TaxTotalsResponseBody) (err error) {
+	if body.Proceeds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("proceeds", "body"))
+	}
+	if body.CostBasis == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cost_basis", "body"))
+	}
+	if body.WashSaleAdjustment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("wash_sale_adjustment", "body"))
+	}
+	if body.Gain == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gain", "body"))
+	}
+	return
+}
+
+// ValidateWashSaleResponseBody runs the validations defined on
+// WashSaleResponseBody
+func ValidateWashSaleResponseBody(body *WashSaleResponseBody) (err error) {
+	if body.SaleID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sale_id", "body"))
+	}
+	if body.LotID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lot_id", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Disposed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("disposed", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Disallowed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("disallowed", "body"))
+	}
+	if body.ReplacementLotID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("replacement_lot_id", "body"))
+	}
+	if body.ReplacementAccount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("replacement_account", "body"))
+	}
+	if body.ReplacementSymbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("replacement_symbol", "body"))
+	}
+	if body.ReplacementAcquired == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("replacement_acquired", "body"))
+	}
+	if body.Permanent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("permanent", "body"))
+	}
+	if body.Disposed != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.disposed", *body.Disposed, goa.FormatDate))
+	}
+	if body.ReplacementAcquired != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.replacement_acquired", *body.ReplacementAcquired, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateTaxLotResponse runs the validations defined on TaxLotResponse
+func ValidateTaxLotResponse(body *TaxLotResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Acquired == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("acquired", "body"))
+	}
+	if body.HoldingSince == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("holding_since", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.CostBasis == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cost_basis", "body"))
+	}
+	if body.BasisAdjustment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("basis_adjustment", "body"))
+	}
+	if body.UnitCost == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unit_cost", "body"))
+	}
+	if body.Term == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("term", "body"))
+	}
+	if body.Acquired != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.acquired", *body.Acquired, goa.FormatDate))
+	}
+	if body.HoldingSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.holding_since", *body.HoldingSince, goa.FormatDate))
+	}
+	if body.Term != nil {
+		if !(*body.Term == "short" || *body.Term == "long") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.term", *body.Term, []any{"short", "long"}))
+		}
+	}
+	return
+}
+
+// ValidateHarvestOpportunityResponseBody runs the validations defined on
+// HarvestOpportunityResponseBody
+func ValidateHarvestOpportunityResponseBody(body *HarvestOpportunityResponseBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Lots == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lots", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Price == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("price", "body"))
+	}
+	if body.CostBasis == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cost_basis", "body"))
+	}
+	if body.MarketValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_value", "body"))
+	}
+	if body.Loss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loss", "body"))
+	}
+	if body.ShortTermLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_term_loss", "body"))
+	}
+	if body.LongTermLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_term_loss", "body"))
+	}
+	if body.EstimatedSavings == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("estimated_savings", "body"))
+	}
+	if body.RepurchaseAfter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("repurchase_after", "body"))
+	}
+	if body.RepurchaseAfter != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.repurchase_after", *body.RepurchaseAfter, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateSkippedHarvestResponseBody runs the validations defined on
+// SkippedHarvestResponseBody
+func ValidateSkippedHarvestResponseBody(body *SkippedHarvestResponseBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Loss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loss", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	return
+}
+
+// ValidateAccountResponse runs the validations defined on AccountResponse
+func ValidateAccountResponse(body *AccountResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.TaxTreatment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tax_treatment", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Registered == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("registered", "body"))
+	}
+	if body.Cash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash", "body"))
+	}
+	if body.HoldingsValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("holdings_value", "body"))
+	}
+	if body.TotalValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_value", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "taxable" || *body.Type == "ira" || *body.Type == "roth_ira" || *body.Type == "401k" || *body.Type == "roth_401k" || *body.Type == "hsa" || *body.Type == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"taxable", "ira", "roth_ira", "401k", "roth_401k", "hsa", "other"}))
+		}
+	}
+	if body.TaxTreatment != nil {
+		if !(*body.TaxTreatment == "taxable" || *body.TaxTreatment == "tax_deferred" || *body.TaxTreatment == "tax_exempt") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tax_treatment", *body.TaxTreatment, []any{"taxable", "tax_deferred", "tax_exempt"}))
+		}
 	}
 	return
 }
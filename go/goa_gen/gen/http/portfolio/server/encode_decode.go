@@ -10,10 +10,14 @@ package server
 
 import (
 	"context"
+	"errors"
+	"io"
 	"net/http"
+	"strconv"
 
 	portfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // EncodeGetPortfolioSummaryResponse returns an encoder for responses returned
@@ -27,3 +31,5483 @@ func EncodeGetPortfolioSummaryResponse(encoder func(context.Context, http.Respon
 		return enc.Encode(body)
 	}
 }
+
+// DecodeGetPortfolioSummaryRequest returns a decoder for requests sent to the
+// portfolio getPortfolioSummary endpoint.
+func DecodeGetPortfolioSummaryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetPortfolioSummaryPayload, error) {
+	return func(r *http.Request) (*portfolio.GetPortfolioSummaryPayload, error) {
+		var (
+			portfolioID string
+			account     *string
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		accountRaw := qp.Get("account")
+		if accountRaw != "" {
+			account = &accountRaw
+		}
+		payload := NewGetPortfolioSummaryPayload(portfolioID, account)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetPortfolioSummaryError returns an encoder for errors returned by the
+// getPortfolioSummary portfolio endpoint.
+func EncodeGetPortfolioSummaryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetReturnsResponse returns an encoder for responses returned by the
+// portfolio getReturns endpoint.
+func EncodeGetReturnsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.PortfolioReturns)
+		enc := encoder(ctx, w)
+		body := NewGetReturnsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetReturnsRequest returns a decoder for requests sent to the portfolio
+// getReturns endpoint.
+func DecodeGetReturnsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetReturnsPayload, error) {
+	return func(r *http.Request) (*portfolio.GetReturnsPayload, error) {
+		var (
+			portfolioID string
+			period      string
+			start       *string
+			end         *string
+			account     *string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		periodRaw := qp.Get("period")
+		if periodRaw != "" {
+			period = periodRaw
+		} else {
+			period = "inception"
+		}
+		if !(period == "1D" || period == "MTD" || period == "QTD" || period == "YTD" || period == "1Y" || period == "inception" || period == "custom") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("period", period, []any{"1D", "MTD", "QTD", "YTD", "1Y", "inception", "custom"}))
+		}
+		startRaw := qp.Get("start")
+		if startRaw != "" {
+			start = &startRaw
+		}
+		if start != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("start", *start, goa.FormatDate))
+		}
+		endRaw := qp.Get("end")
+		if endRaw != "" {
+			end = &endRaw
+		}
+		if end != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("end", *end, goa.FormatDate))
+		}
+		accountRaw := qp.Get("account")
+		if accountRaw != "" {
+			account = &accountRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetReturnsPayload(portfolioID, period, start, end, account)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetReturnsError returns an encoder for errors returned by the
+// getReturns portfolio endpoint.
+func EncodeGetReturnsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAllocationResponse returns an encoder for responses returned by the
+// portfolio getAllocation endpoint.
+func EncodeGetAllocationResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.Allocation)
+		enc := encoder(ctx, w)
+		body := NewGetAllocationResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAllocationRequest returns a decoder for requests sent to the
+// portfolio getAllocation endpoint.
+func DecodeGetAllocationRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetAllocationPayload, error) {
+	return func(r *http.Request) (*portfolio.GetAllocationPayload, error) {
+		var (
+			portfolioID string
+			dimension   string
+			tag         *string
+			account     *string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		dimensionRaw := qp.Get("dimension")
+		if dimensionRaw != "" {
+			dimension = dimensionRaw
+		} else {
+			dimension = "asset_class"
+		}
+		if !(dimension == "asset_class" || dimension == "sector" || dimension == "country" || dimension == "region" || dimension == "currency" || dimension == "account" || dimension == "tag") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("dimension", dimension, []any{"asset_class", "sector", "country", "region", "currency", "account", "tag"}))
+		}
+		tagRaw := qp.Get("tag")
+		if tagRaw != "" {
+			tag = &tagRaw
+		}
+		accountRaw := qp.Get("account")
+		if accountRaw != "" {
+			account = &accountRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAllocationPayload(portfolioID, dimension, tag, account)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAllocationError returns an encoder for errors returned by the
+// getAllocation portfolio endpoint.
+func EncodeGetAllocationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetTargetAllocationResponse returns an encoder for responses returned
+// by the portfolio getTargetAllocation endpoint.
+func EncodeGetTargetAllocationResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.TargetAllocation)
+		enc := encoder(ctx, w)
+		body := NewGetTargetAllocationResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetTargetAllocationRequest returns a decoder for requests sent to the
+// portfolio getTargetAllocation endpoint.
+func DecodeGetTargetAllocationRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetTargetAllocationPayload, error) {
+	return func(r *http.Request) (*portfolio.GetTargetAllocationPayload, error) {
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewGetTargetAllocationPayload(portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetTargetAllocationError returns an encoder for errors returned by the
+// getTargetAllocation portfolio endpoint.
+func EncodeGetTargetAllocationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSetTargetAllocationResponse returns an encoder for responses returned
+// by the portfolio setTargetAllocation endpoint.
+func EncodeSetTargetAllocationResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.TargetAllocation)
+		enc := encoder(ctx, w)
+		body := NewSetTargetAllocationResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSetTargetAllocationRequest returns a decoder for requests sent to the
+// portfolio setTargetAllocation endpoint.
+func DecodeSetTargetAllocationRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.SetTargetAllocationPayload, error) {
+	return func(r *http.Request) (*portfolio.SetTargetAllocationPayload, error) {
+		var (
+			body []*TargetWeightRequestBodyRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		for _, e := range body {
+			if e != nil {
+				if err2 := ValidateTargetWeightRequestBodyRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewSetTargetAllocationPayload(body, portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeSetTargetAllocationError returns an encoder for errors returned by the
+// setTargetAllocation portfolio endpoint.
+func EncodeSetTargetAllocationError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeProposeRebalanceResponse returns an encoder for responses returned by
+// the portfolio proposeRebalance endpoint.
+func EncodeProposeRebalanceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.RebalanceProposal)
+		enc := encoder(ctx, w)
+		body := NewProposeRebalanceResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeProposeRebalanceRequest returns a decoder for requests sent to the
+// portfolio proposeRebalance endpoint.
+func DecodeProposeRebalanceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ProposeRebalancePayload, error) {
+	return func(r *http.Request) (*portfolio.ProposeRebalancePayload, error) {
+		var (
+			body struct {
+				// Drop trades worth less than this amount
+				MinTradeValue *float64 `form:"min_trade_value" json:"min_trade_value" xml:"min_trade_value"`
+				// Only sell lots that are long-term or at a loss
+				AvoidShortTermGains *bool `form:"avoid_short_term_gains" json:"avoid_short_term_gains" xml:"avoid_short_term_gains"`
+			}
+			err error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		if body.MinTradeValue != nil {
+			if *body.MinTradeValue < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_trade_value", *body.MinTradeValue, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewProposeRebalancePayload(body, portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeProposeRebalanceError returns an encoder for errors returned by the
+// proposeRebalance portfolio endpoint.
+func EncodeProposeRebalanceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSetBenchmarkResponse returns an encoder for responses returned by the
+// portfolio setBenchmark endpoint.
+func EncodeSetBenchmarkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.BenchmarkDefinition)
+		enc := encoder(ctx, w)
+		body := NewSetBenchmarkResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSetBenchmarkRequest returns a decoder for requests sent to the
+// portfolio setBenchmark endpoint.
+func DecodeSetBenchmarkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.SetBenchmarkPayload, error) {
+	return func(r *http.Request) (*portfolio.SetBenchmarkPayload, error) {
+		var (
+			body SetBenchmarkRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateSetBenchmarkRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewSetBenchmarkPayload(&body, portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeSetBenchmarkError returns an encoder for errors returned by the
+// setBenchmark portfolio endpoint.
+func EncodeSetBenchmarkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetBenchmarkComparisonResponse returns an encoder for responses
+// returned by the portfolio getBenchmarkComparison endpoint.
+func EncodeGetBenchmarkComparisonResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.BenchmarkComparison)
+		enc := encoder(ctx, w)
+		body := NewGetBenchmarkComparisonResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetBenchmarkComparisonRequest returns a decoder for requests sent to
+// the portfolio getBenchmarkComparison endpoint.
+func DecodeGetBenchmarkComparisonRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetBenchmarkComparisonPayload, error) {
+	return func(r *http.Request) (*portfolio.GetBenchmarkComparisonPayload, error) {
+		var (
+			portfolioID string
+			period      string
+			start       *string
+			end         *string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		periodRaw := qp.Get("period")
+		if periodRaw != "" {
+			period = periodRaw
+		} else {
+			period = "inception"
+		}
+		if !(period == "1D" || period == "MTD" || period == "QTD" || period == "YTD" || period == "1Y" || period == "inception" || period == "custom") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("period", period, []any{"1D", "MTD", "QTD", "YTD", "1Y", "inception", "custom"}))
+		}
+		startRaw := qp.Get("start")
+		if startRaw != "" {
+			start = &startRaw
+		}
+		if start != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("start", *start, goa.FormatDate))
+		}
+		endRaw := qp.Get("end")
+		if endRaw != "" {
+			end = &endRaw
+		}
+		if end != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("end", *end, goa.FormatDate))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetBenchmarkComparisonPayload(portfolioID, period, start, end)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetBenchmarkComparisonError returns an encoder for errors returned by
+// the getBenchmarkComparison portfolio endpoint.
+func EncodeGetBenchmarkComparisonError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetRiskMetricsResponse returns an encoder for responses returned by
+// the portfolio getRiskMetrics endpoint.
+func EncodeGetRiskMetricsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.RiskMetrics)
+		enc := encoder(ctx, w)
+		body := NewGetRiskMetricsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetRiskMetricsRequest returns a decoder for requests sent to the
+// portfolio getRiskMetrics endpoint.
+func DecodeGetRiskMetricsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetRiskMetricsPayload, error) {
+	return func(r *http.Request) (*portfolio.GetRiskMetricsPayload, error) {
+		var (
+			portfolioID  string
+			period       string
+			start        *string
+			end          *string
+			riskFreeRate *float64
+			window       *int
+			err          error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		periodRaw := qp.Get("period")
+		if periodRaw != "" {
+			period = periodRaw
+		} else {
+			period = "inception"
+		}
+		if !(period == "1D" || period == "MTD" || period == "QTD" || period == "YTD" || period == "1Y" || period == "inception" || period == "custom") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("period", period, []any{"1D", "MTD", "QTD", "YTD", "1Y", "inception", "custom"}))
+		}
+		startRaw := qp.Get("start")
+		if startRaw != "" {
+			start = &startRaw
+		}
+		if start != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("start", *start, goa.FormatDate))
+		}
+		endRaw := qp.Get("end")
+		if endRaw != "" {
+			end = &endRaw
+		}
+		if end != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("end", *end, goa.FormatDate))
+		}
+		{
+			riskFreeRateRaw := qp.Get("risk_free_rate")
+			if riskFreeRateRaw != "" {
+				v, err2 := strconv.ParseFloat(riskFreeRateRaw, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("risk_free_rate", riskFreeRateRaw, "float"))
+				}
+				riskFreeRate = &v
+			}
+		}
+		{
+			windowRaw := qp.Get("window")
+			if windowRaw != "" {
+				v, err2 := strconv.ParseInt(windowRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("window", windowRaw, "integer"))
+				}
+				pv := int(v)
+				window = &pv
+			}
+		}
+		if window != nil {
+			if *window < 2 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("window", *window, 2, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetRiskMetricsPayload(portfolioID, period, start, end, riskFreeRate, window)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetRiskMetricsError returns an encoder for errors returned by the
+// getRiskMetrics portfolio endpoint.
+func EncodeGetRiskMetricsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetValueAtRiskResponse returns an encoder for responses returned by
+// the portfolio getValueAtRisk endpoint.
+func EncodeGetValueAtRiskResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.ValueAtRisk)
+		enc := encoder(ctx, w)
+		body := NewGetValueAtRiskResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetValueAtRiskRequest returns a decoder for requests sent to the
+// portfolio getValueAtRisk endpoint.
+func DecodeGetValueAtRiskRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetValueAtRiskPayload, error) {
+	return func(r *http.Request) (*portfolio.GetValueAtRiskPayload, error) {
+		var (
+			portfolioID string
+			method      string
+			confidence  float64
+			horizon     int
+			lookback    int
+			simulations int
+			seed        *int64
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		methodRaw := qp.Get("method")
+		if methodRaw != "" {
+			method = methodRaw
+		} else {
+			method = "historical"
+		}
+		if !(method == "historical" || method == "parametric" || method == "monte_carlo") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("method", method, []any{"historical", "parametric", "monte_carlo"}))
+		}
+		{
+			confidenceRaw := qp.Get("confidence")
+			if confidenceRaw == "" {
+				confidence = 0.95
+			} else {
+				v, err2 := strconv.ParseFloat(confidenceRaw, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("confidence", confidenceRaw, "float"))
+				}
+				confidence = v
+			}
+		}
+		if confidence < 0.5 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("confidence", confidence, 0.5, true))
+		}
+		if confidence > 0.9999 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("confidence", confidence, 0.9999, false))
+		}
+		{
+			horizonRaw := qp.Get("horizon")
+			if horizonRaw == "" {
+				horizon = 1
+			} else {
+				v, err2 := strconv.ParseInt(horizonRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("horizon", horizonRaw, "integer"))
+				}
+				horizon = int(v)
+			}
+		}
+		if horizon < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("horizon", horizon, 1, true))
+		}
+		{
+			lookbackRaw := qp.Get("lookback")
+			if lookbackRaw == "" {
+				lookback = 252
+			} else {
+				v, err2 := strconv.ParseInt(lookbackRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("lookback", lookbackRaw, "integer"))
+				}
+				lookback = int(v)
+			}
+		}
+		if lookback < 20 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("lookback", lookback, 20, true))
+		}
+		{
+			simulationsRaw := qp.Get("simulations")
+			if simulationsRaw == "" {
+				simulations = 10000
+			} else {
+				v, err2 := strconv.ParseInt(simulationsRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("simulations", simulationsRaw, "integer"))
+				}
+				simulations = int(v)
+			}
+		}
+		if simulations < 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("simulations", simulations, 100, true))
+		}
+		if simulations > 1e+06 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("simulations", simulations, 1e+06, false))
+		}
+		{
+			seedRaw := qp.Get("seed")
+			if seedRaw != "" {
+				v, err2 := strconv.ParseInt(seedRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("seed", seedRaw, "integer"))
+				}
+				seed = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetValueAtRiskPayload(portfolioID, method, confidence, horizon, lookback, simulations, seed)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetValueAtRiskError returns an encoder for errors returned by the
+// getValueAtRisk portfolio endpoint.
+func EncodeGetValueAtRiskError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListStressScenariosResponse returns an encoder for responses returned
+// by the portfolio listStressScenarios endpoint.
+func EncodeListStressScenariosResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*portfolio.StressScenario)
+		enc := encoder(ctx, w)
+		body := NewListStressScenariosResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeListStressScenariosError returns an encoder for errors returned by the
+// listStressScenarios portfolio endpoint.
+func EncodeListStressScenariosError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRunStressTestResponse returns an encoder for responses returned by the
+// portfolio runStressTest endpoint.
+func EncodeRunStressTestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.StressTestResult)
+		enc := encoder(ctx, w)
+		body := NewRunStressTestResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRunStressTestRequest returns a decoder for requests sent to the
+// portfolio runStressTest endpoint.
+func DecodeRunStressTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.RunStressTestPayload, error) {
+	return func(r *http.Request) (*portfolio.RunStressTestPayload, error) {
+		var (
+			portfolioID string
+			scenario    string
+			top         int
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		scenario = qp.Get("scenario")
+		if scenario == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("scenario", "query string"))
+		}
+		{
+			topRaw := qp.Get("top")
+			if topRaw == "" {
+				top = 5
+			} else {
+				v, err2 := strconv.ParseInt(topRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("top", topRaw, "integer"))
+				}
+				top = int(v)
+			}
+		}
+		if top < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("top", top, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRunStressTestPayload(portfolioID, scenario, top)
+
+		return payload, nil
+	}
+}
+
+// EncodeRunStressTestError returns an encoder for errors returned by the
+// runStressTest portfolio endpoint.
+func EncodeRunStressTestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeProjectPortfolioResponse returns an encoder for responses returned by
+// the portfolio projectPortfolio endpoint.
+func EncodeProjectPortfolioResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.Projection)
+		enc := encoder(ctx, w)
+		body := NewProjectPortfolioResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeProjectPortfolioRequest returns a decoder for requests sent to the
+// portfolio projectPortfolio endpoint.
+func DecodeProjectPortfolioRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ProjectPortfolioPayload, error) {
+	return func(r *http.Request) (*portfolio.ProjectPortfolioPayload, error) {
+		var (
+			body ProjectPortfolioRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateProjectPortfolioRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewProjectPortfolioPayload(&body, portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeProjectPortfolioError returns an encoder for errors returned by the
+// projectPortfolio portfolio endpoint.
+func EncodeProjectPortfolioError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListCorporateActionsResponse returns an encoder for responses returned
+// by the portfolio listCorporateActions endpoint.
+func EncodeListCorporateActionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*portfolio.CorporateAction)
+		enc := encoder(ctx, w)
+		body := NewListCorporateActionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListCorporateActionsRequest returns a decoder for requests sent to the
+// portfolio listCorporateActions endpoint.
+func DecodeListCorporateActionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ListCorporateActionsPayload, error) {
+	return func(r *http.Request) (*portfolio.ListCorporateActionsPayload, error) {
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewListCorporateActionsPayload(portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeListCorporateActionsError returns an encoder for errors returned by
+// the listCorporateActions portfolio endpoint.
+func EncodeListCorporateActionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeApplyCorporateActionsResponse returns an encoder for responses
+// returned by the portfolio applyCorporateActions endpoint.
+func EncodeApplyCorporateActionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*portfolio.CorporateAction)
+		enc := encoder(ctx, w)
+		body := NewApplyCorporateActionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeApplyCorporateActionsRequest returns a decoder for requests sent to
+// the portfolio applyCorporateActions endpoint.
+func DecodeApplyCorporateActionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ApplyCorporateActionsPayload, error) {
+	return func(r *http.Request) (*portfolio.ApplyCorporateActionsPayload, error) {
+		var (
+			body ApplyCorporateActionsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateApplyCorporateActionsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewApplyCorporateActionsPayload(&body, portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeApplyCorporateActionsError returns an encoder for errors returned by
+// the applyCorporateActions portfolio endpoint.
+func EncodeApplyCorporateActionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRecordIncomeResponse returns an encoder for responses returned by the
+// portfolio recordIncome endpoint.
+func EncodeRecordIncomeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.IncomeRecord)
+		enc := encoder(ctx, w)
+		body := NewRecordIncomeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRecordIncomeRequest returns a decoder for requests sent to the
+// portfolio recordIncome endpoint.
+func DecodeRecordIncomeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.RecordIncomePayload, error) {
+	return func(r *http.Request) (*portfolio.RecordIncomePayload, error) {
+		var (
+			body RecordIncomeRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateRecordIncomeRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewRecordIncomePayload(&body, portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeRecordIncomeError returns an encoder for errors returned by the
+// recordIncome portfolio endpoint.
+func EncodeRecordIncomeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetIncomeResponse returns an encoder for responses returned by the
+// portfolio getIncome endpoint.
+func EncodeGetIncomeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.IncomeSummary)
+		enc := encoder(ctx, w)
+		body := NewGetIncomeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetIncomeRequest returns a decoder for requests sent to the portfolio
+// getIncome endpoint.
+func DecodeGetIncomeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetIncomePayload, error) {
+	return func(r *http.Request) (*portfolio.GetIncomePayload, error) {
+		var (
+			portfolioID string
+			period      string
+			start       *string
+			end         *string
+			interval    string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		periodRaw := qp.Get("period")
+		if periodRaw != "" {
+			period = periodRaw
+		} else {
+			period = "inception"
+		}
+		if !(period == "1D" || period == "MTD" || period == "QTD" || period == "YTD" || period == "1Y" || period == "inception" || period == "custom") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("period", period, []any{"1D", "MTD", "QTD", "YTD", "1Y", "inception", "custom"}))
+		}
+		startRaw := qp.Get("start")
+		if startRaw != "" {
+			start = &startRaw
+		}
+		if start != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("start", *start, goa.FormatDate))
+		}
+		endRaw := qp.Get("end")
+		if endRaw != "" {
+			end = &endRaw
+		}
+		if end != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("end", *end, goa.FormatDate))
+		}
+		intervalRaw := qp.Get("interval")
+		if intervalRaw != "" {
+			interval = intervalRaw
+		} else {
+			interval = "month"
+		}
+		if !(interval == "month" || interval == "quarter" || interval == "year") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("interval", interval, []any{"month", "quarter", "year"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetIncomePayload(portfolioID, period, start, end, interval)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetIncomeError returns an encoder for errors returned by the getIncome
+// portfolio endpoint.
+func EncodeGetIncomeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePriceBondResponse returns an encoder for responses returned by the
+// portfolio priceBond endpoint.
+func EncodePriceBondResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.BondAnalytics)
+		enc := encoder(ctx, w)
+		body := NewPriceBondResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePriceBondRequest returns a decoder for requests sent to the portfolio
+// priceBond endpoint.
+func DecodePriceBondRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.PriceBondPayload, error) {
+	return func(r *http.Request) (*portfolio.PriceBondPayload, error) {
+		var (
+			body PriceBondRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePriceBondRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPriceBondPayload(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodePriceBondError returns an encoder for errors returned by the priceBond
+// portfolio endpoint.
+func EncodePriceBondError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListBondPositionsResponse returns an encoder for responses returned by
+// the portfolio listBondPositions endpoint.
+func EncodeListBondPositionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*portfolio.BondPosition)
+		enc := encoder(ctx, w)
+		body := NewListBondPositionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListBondPositionsRequest returns a decoder for requests sent to the
+// portfolio listBondPositions endpoint.
+func DecodeListBondPositionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ListBondPositionsPayload, error) {
+	return func(r *http.Request) (*portfolio.ListBondPositionsPayload, error) {
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewListBondPositionsPayload(portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeListBondPositionsError returns an encoder for errors returned by the
+// listBondPositions portfolio endpoint.
+func EncodeListBondPositionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetCashFlowLadderResponse returns an encoder for responses returned by
+// the portfolio getCashFlowLadder endpoint.
+func EncodeGetCashFlowLadderResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.CashFlowLadder)
+		enc := encoder(ctx, w)
+		body := NewGetCashFlowLadderResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetCashFlowLadderRequest returns a decoder for requests sent to the
+// portfolio getCashFlowLadder endpoint.
+func DecodeGetCashFlowLadderRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetCashFlowLadderPayload, error) {
+	return func(r *http.Request) (*portfolio.GetCashFlowLadderPayload, error) {
+		var (
+			portfolioID string
+			years       int
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		{
+			yearsRaw := qp.Get("years")
+			if yearsRaw == "" {
+				years = 10
+			} else {
+				v, err2 := strconv.ParseInt(yearsRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("years", yearsRaw, "integer"))
+				}
+				years = int(v)
+			}
+		}
+		if years < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("years", years, 1, true))
+		}
+		if years > 50 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("years", years, 50, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetCashFlowLadderPayload(portfolioID, years)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetCashFlowLadderError returns an encoder for errors returned by the
+// getCashFlowLadder portfolio endpoint.
+func EncodeGetCashFlowLadderError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListOptionPositionsResponse returns an encoder for responses returned
+// by the portfolio listOptionPositions endpoint.
+func EncodeListOptionPositionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.OptionPositions)
+		enc := encoder(ctx, w)
+		body := NewListOptionPositionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListOptionPositionsRequest returns a decoder for requests sent to the
+// portfolio listOptionPositions endpoint.
+func DecodeListOptionPositionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ListOptionPositionsPayload, error) {
+	return func(r *http.Request) (*portfolio.ListOptionPositionsPayload, error) {
+		var (
+			portfolioID string
+			volatility  *float64
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		{
+			volatilityRaw := qp.Get("volatility")
+			if volatilityRaw != "" {
+				v, err2 := strconv.ParseFloat(volatilityRaw, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("volatility", volatilityRaw, "float"))
+				}
+				volatility = &v
+			}
+		}
+		if volatility != nil {
+			if *volatility < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("volatility", *volatility, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListOptionPositionsPayload(portfolioID, volatility)
+
+		return payload, nil
+	}
+}
+
+// EncodeListOptionPositionsError returns an encoder for errors returned by the
+// listOptionPositions portfolio endpoint.
+func EncodeListOptionPositionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSettleOptionExpiriesResponse returns an encoder for responses returned
+// by the portfolio settleOptionExpiries endpoint.
+func EncodeSettleOptionExpiriesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*portfolio.OptionSettlement)
+		enc := encoder(ctx, w)
+		body := NewSettleOptionExpiriesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSettleOptionExpiriesRequest returns a decoder for requests sent to the
+// portfolio settleOptionExpiries endpoint.
+func DecodeSettleOptionExpiriesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.SettleOptionExpiriesPayload, error) {
+	return func(r *http.Request) (*portfolio.SettleOptionExpiriesPayload, error) {
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewSettleOptionExpiriesPayload(portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeSettleOptionExpiriesError returns an encoder for errors returned by
+// the settleOptionExpiries portfolio endpoint.
+func EncodeSettleOptionExpiriesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSetMarginAccountResponse returns an encoder for responses returned by
+// the portfolio setMarginAccount endpoint.
+func EncodeSetMarginAccountResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.MarginTerms)
+		enc := encoder(ctx, w)
+		body := NewSetMarginAccountResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSetMarginAccountRequest returns a decoder for requests sent to the
+// portfolio setMarginAccount endpoint.
+func DecodeSetMarginAccountRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.SetMarginAccountPayload, error) {
+	return func(r *http.Request) (*portfolio.SetMarginAccountPayload, error) {
+		var (
+			body SetMarginAccountRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateSetMarginAccountRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewSetMarginAccountPayload(&body, portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeSetMarginAccountError returns an encoder for errors returned by the
+// setMarginAccount portfolio endpoint.
+func EncodeSetMarginAccountError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetMarginStatusResponse returns an encoder for responses returned by
+// the portfolio getMarginStatus endpoint.
+func EncodeGetMarginStatusResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.MarginStatus)
+		enc := encoder(ctx, w)
+		body := NewGetMarginStatusResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetMarginStatusRequest returns a decoder for requests sent to the
+// portfolio getMarginStatus endpoint.
+func DecodeGetMarginStatusRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetMarginStatusPayload, error) {
+	return func(r *http.Request) (*portfolio.GetMarginStatusPayload, error) {
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewGetMarginStatusPayload(portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetMarginStatusError returns an encoder for errors returned by the
+// getMarginStatus portfolio endpoint.
+func EncodeGetMarginStatusError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeChargeMarginResponse returns an encoder for responses returned by the
+// portfolio chargeMargin endpoint.
+func EncodeChargeMarginResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.MarginStatus)
+		enc := encoder(ctx, w)
+		body := NewChargeMarginResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeChargeMarginRequest returns a decoder for requests sent to the
+// portfolio chargeMargin endpoint.
+func DecodeChargeMarginRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ChargeMarginPayload, error) {
+	return func(r *http.Request) (*portfolio.ChargeMarginPayload, error) {
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewChargeMarginPayload(portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeChargeMarginError returns an encoder for errors returned by the
+// chargeMargin portfolio endpoint.
+func EncodeChargeMarginError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListEventsResponse returns an encoder for responses returned by the
+// portfolio listEvents endpoint.
+func EncodeListEventsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*portfolio.PortfolioEvent)
+		enc := encoder(ctx, w)
+		body := NewListEventsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListEventsRequest returns a decoder for requests sent to the portfolio
+// listEvents endpoint.
+func DecodeListEventsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ListEventsPayload, error) {
+	return func(r *http.Request) (*portfolio.ListEventsPayload, error) {
+		var (
+			portfolioID string
+			since       *string
+			type_       *string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		sinceRaw := qp.Get("since")
+		if sinceRaw != "" {
+			since = &sinceRaw
+		}
+		if since != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+		}
+		type_Raw := qp.Get("type")
+		if type_Raw != "" {
+			type_ = &type_Raw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListEventsPayload(portfolioID, since, type_)
+
+		return payload, nil
+	}
+}
+
+// EncodeListEventsError returns an encoder for errors returned by the
+// listEvents portfolio endpoint.
+func EncodeListEventsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePlaceOrderResponse returns an encoder for responses returned by the
+// portfolio placeOrder endpoint.
+func EncodePlaceOrderResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.Order)
+		enc := encoder(ctx, w)
+		body := NewPlaceOrderResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePlaceOrderRequest returns a decoder for requests sent to the portfolio
+// placeOrder endpoint.
+func DecodePlaceOrderRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.PlaceOrderPayload, error) {
+	return func(r *http.Request) (*portfolio.PlaceOrderPayload, error) {
+		var (
+			body PlaceOrderRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePlaceOrderRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewPlaceOrderPayload(&body, portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodePlaceOrderError returns an encoder for errors returned by the
+// placeOrder portfolio endpoint.
+func EncodePlaceOrderError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListOrdersResponse returns an encoder for responses returned by the
+// portfolio listOrders endpoint.
+func EncodeListOrdersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*portfolio.Order)
+		enc := encoder(ctx, w)
+		body := NewListOrdersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListOrdersRequest returns a decoder for requests sent to the portfolio
+// listOrders endpoint.
+func DecodeListOrdersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ListOrdersPayload, error) {
+	return func(r *http.Request) (*portfolio.ListOrdersPayload, error) {
+		var (
+			portfolioID string
+			status      *string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		statusRaw := qp.Get("status")
+		if statusRaw != "" {
+			status = &statusRaw
+		}
+		if status != nil {
+			if !(*status == "open" || *status == "filled" || *status == "cancelled" || *status == "expired" || *status == "rejected") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"open", "filled", "cancelled", "expired", "rejected"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListOrdersPayload(portfolioID, status)
+
+		return payload, nil
+	}
+}
+
+// EncodeListOrdersError returns an encoder for errors returned by the
+// listOrders portfolio endpoint.
+func EncodeListOrdersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCancelOrderResponse returns an encoder for responses returned by the
+// portfolio cancelOrder endpoint.
+func EncodeCancelOrderResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.Order)
+		enc := encoder(ctx, w)
+		body := NewCancelOrderResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCancelOrderRequest returns a decoder for requests sent to the
+// portfolio cancelOrder endpoint.
+func DecodeCancelOrderRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.CancelOrderPayload, error) {
+	return func(r *http.Request) (*portfolio.CancelOrderPayload, error) {
+		var (
+			portfolioID string
+			id          string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		id = qp.Get("id")
+		if id == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("id", "query string"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCancelOrderPayload(portfolioID, id)
+
+		return payload, nil
+	}
+}
+
+// EncodeCancelOrderError returns an encoder for errors returned by the
+// cancelOrder portfolio endpoint.
+func EncodeCancelOrderError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePreviewTradesResponse returns an encoder for responses returned by the
+// portfolio previewTrades endpoint.
+func EncodePreviewTradesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.TradePreview)
+		enc := encoder(ctx, w)
+		body := NewPreviewTradesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePreviewTradesRequest returns a decoder for requests sent to the
+// portfolio previewTrades endpoint.
+func DecodePreviewTradesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.PreviewTradesPayload, error) {
+	return func(r *http.Request) (*portfolio.PreviewTradesPayload, error) {
+		var (
+			body PreviewTradesRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePreviewTradesRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewPreviewTradesPayload(&body, portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodePreviewTradesError returns an encoder for errors returned by the
+// previewTrades portfolio endpoint.
+func EncodePreviewTradesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRunBacktestResponse returns an encoder for responses returned by the
+// portfolio runBacktest endpoint.
+func EncodeRunBacktestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.BacktestResult)
+		enc := encoder(ctx, w)
+		body := NewRunBacktestResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRunBacktestRequest returns a decoder for requests sent to the
+// portfolio runBacktest endpoint.
+func DecodeRunBacktestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.RunBacktestPayload, error) {
+	return func(r *http.Request) (*portfolio.RunBacktestPayload, error) {
+		var (
+			body RunBacktestRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateRunBacktestRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRunBacktestPayload(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeRunBacktestError returns an encoder for errors returned by the
+// runBacktest portfolio endpoint.
+func EncodeRunBacktestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeImportCsvResponse returns an encoder for responses returned by the
+// portfolio importCsv endpoint.
+func EncodeImportCsvResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.ImportReport)
+		enc := encoder(ctx, w)
+		body := NewImportCsvResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeImportCsvRequest returns a decoder for requests sent to the portfolio
+// importCsv endpoint.
+func DecodeImportCsvRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ImportCsvPayload, error) {
+	return func(r *http.Request) (*portfolio.ImportCsvPayload, error) {
+		var (
+			body ImportCsvRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateImportCsvRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+			profile     string
+			account     string
+			dryRun      bool
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		profileRaw := qp.Get("profile")
+		if profileRaw != "" {
+			profile = profileRaw
+		} else {
+			profile = "generic"
+		}
+		accountRaw := qp.Get("account")
+		if accountRaw != "" {
+			account = accountRaw
+		}
+		{
+			dryRunRaw := qp.Get("dry_run")
+			if dryRunRaw != "" {
+				v, err2 := strconv.ParseBool(dryRunRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("dry_run", dryRunRaw, "boolean"))
+				}
+				dryRun = v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewImportCsvPayload(&body, portfolioID, profile, account, dryRun)
+
+		return payload, nil
+	}
+}
+
+// EncodeImportCsvError returns an encoder for errors returned by the importCsv
+// portfolio endpoint.
+func EncodeImportCsvError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeImportOfxResponse returns an encoder for responses returned by the
+// portfolio importOfx endpoint.
+func EncodeImportOfxResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.ImportReport)
+		enc := encoder(ctx, w)
+		body := NewImportOfxResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeImportOfxRequest returns a decoder for requests sent to the portfolio
+// importOfx endpoint.
+func DecodeImportOfxRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ImportOfxPayload, error) {
+	return func(r *http.Request) (*portfolio.ImportOfxPayload, error) {
+		var (
+			body ImportOfxRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateImportOfxRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+			account     string
+			dryRun      bool
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		accountRaw := qp.Get("account")
+		if accountRaw != "" {
+			account = accountRaw
+		}
+		{
+			dryRunRaw := qp.Get("dry_run")
+			if dryRunRaw != "" {
+				v, err2 := strconv.ParseBool(dryRunRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("dry_run", dryRunRaw, "boolean"))
+				}
+				dryRun = v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewImportOfxPayload(&body, portfolioID, account, dryRun)
+
+		return payload, nil
+	}
+}
+
+// EncodeImportOfxError returns an encoder for errors returned by the importOfx
+// portfolio endpoint.
+func EncodeImportOfxError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeExportJournalResponse returns an encoder for responses returned by the
+// portfolio exportJournal endpoint.
+func EncodeExportJournalResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.ExportFile)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain")
+		enc := encoder(ctx, w)
+		body := res.Content
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeExportJournalRequest returns a decoder for requests sent to the
+// portfolio exportJournal endpoint.
+func DecodeExportJournalRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ExportJournalPayload, error) {
+	return func(r *http.Request) (*portfolio.ExportJournalPayload, error) {
+		var (
+			portfolioID string
+			format      string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		formatRaw := qp.Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "beancount"
+		}
+		if !(format == "beancount" || format == "hledger") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"beancount", "hledger"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewExportJournalPayload(portfolioID, format)
+
+		return payload, nil
+	}
+}
+
+// EncodeExportJournalError returns an encoder for errors returned by the
+// exportJournal portfolio endpoint.
+func EncodeExportJournalError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeImportJournalResponse returns an encoder for responses returned by the
+// portfolio importJournal endpoint.
+func EncodeImportJournalResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.ImportReport)
+		enc := encoder(ctx, w)
+		body := NewImportJournalResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeImportJournalRequest returns a decoder for requests sent to the
+// portfolio importJournal endpoint.
+func DecodeImportJournalRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ImportJournalPayload, error) {
+	return func(r *http.Request) (*portfolio.ImportJournalPayload, error) {
+		var (
+			body ImportJournalRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateImportJournalRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+			account     string
+			root        string
+			dryRun      bool
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		accountRaw := qp.Get("account")
+		if accountRaw != "" {
+			account = accountRaw
+		}
+		rootRaw := qp.Get("root")
+		if rootRaw != "" {
+			root = rootRaw
+		} else {
+			root = "Assets:Portfolio"
+		}
+		{
+			dryRunRaw := qp.Get("dry_run")
+			if dryRunRaw != "" {
+				v, err2 := strconv.ParseBool(dryRunRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("dry_run", dryRunRaw, "boolean"))
+				}
+				dryRun = v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewImportJournalPayload(&body, portfolioID, account, root, dryRun)
+
+		return payload, nil
+	}
+}
+
+// EncodeImportJournalError returns an encoder for errors returned by the
+// importJournal portfolio endpoint.
+func EncodeImportJournalError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeExportArchiveResponse returns an encoder for responses returned by the
+// portfolio exportArchive endpoint.
+func EncodeExportArchiveResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.ExportFile)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain")
+		enc := encoder(ctx, w)
+		body := res.Content
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeExportArchiveRequest returns a decoder for requests sent to the
+// portfolio exportArchive endpoint.
+func DecodeExportArchiveRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ExportArchivePayload, error) {
+	return func(r *http.Request) (*portfolio.ExportArchivePayload, error) {
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewExportArchivePayload(portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeExportArchiveError returns an encoder for errors returned by the
+// exportArchive portfolio endpoint.
+func EncodeExportArchiveError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeImportArchiveResponse returns an encoder for responses returned by the
+// portfolio importArchive endpoint.
+func EncodeImportArchiveResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.ArchiveImport)
+		enc := encoder(ctx, w)
+		body := NewImportArchiveResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeImportArchiveRequest returns a decoder for requests sent to the
+// portfolio importArchive endpoint.
+func DecodeImportArchiveRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ImportArchivePayload, error) {
+	return func(r *http.Request) (*portfolio.ImportArchivePayload, error) {
+		var (
+			body ImportArchiveRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateImportArchiveRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID *string
+			replace     bool
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = &portfolioIDRaw
+		}
+		{
+			replaceRaw := qp.Get("replace")
+			if replaceRaw != "" {
+				v, err2 := strconv.ParseBool(replaceRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("replace", replaceRaw, "boolean"))
+				}
+				replace = v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewImportArchivePayload(&body, portfolioID, replace)
+
+		return payload, nil
+	}
+}
+
+// EncodeImportArchiveError returns an encoder for errors returned by the
+// importArchive portfolio endpoint.
+func EncodeImportArchiveError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeReconcilePositionsResponse returns an encoder for responses returned
+// by the portfolio reconcilePositions endpoint.
+func EncodeReconcilePositionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.Reconciliation)
+		enc := encoder(ctx, w)
+		body := NewReconcilePositionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeReconcilePositionsRequest returns a decoder for requests sent to the
+// portfolio reconcilePositions endpoint.
+func DecodeReconcilePositionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ReconcilePositionsPayload, error) {
+	return func(r *http.Request) (*portfolio.ReconcilePositionsPayload, error) {
+		var (
+			body ReconcilePositionsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateReconcilePositionsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+			format      string
+			profile     string
+			account     string
+			asOf        *string
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		formatRaw := qp.Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "csv"
+		}
+		if !(format == "csv" || format == "ofx") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "ofx"}))
+		}
+		profileRaw := qp.Get("profile")
+		if profileRaw != "" {
+			profile = profileRaw
+		} else {
+			profile = "generic"
+		}
+		accountRaw := qp.Get("account")
+		if accountRaw != "" {
+			account = accountRaw
+		}
+		asOfRaw := qp.Get("as_of")
+		if asOfRaw != "" {
+			asOf = &asOfRaw
+		}
+		if asOf != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("as_of", *asOf, goa.FormatDate))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewReconcilePositionsPayload(&body, portfolioID, format, profile, account, asOf)
+
+		return payload, nil
+	}
+}
+
+// EncodeReconcilePositionsError returns an encoder for errors returned by the
+// reconcilePositions portfolio endpoint.
+func EncodeReconcilePositionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListReconciliationBreaksResponse returns an encoder for responses
+// returned by the portfolio listReconciliationBreaks endpoint.
+func EncodeListReconciliationBreaksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*portfolio.ReconciliationBreak)
+		enc := encoder(ctx, w)
+		body := NewListReconciliationBreaksResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListReconciliationBreaksRequest returns a decoder for requests sent to
+// the portfolio listReconciliationBreaks endpoint.
+func DecodeListReconciliationBreaksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ListReconciliationBreaksPayload, error) {
+	return func(r *http.Request) (*portfolio.ListReconciliationBreaksPayload, error) {
+		var (
+			portfolioID string
+			status      *string
+			account     *string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		statusRaw := qp.Get("status")
+		if statusRaw != "" {
+			status = &statusRaw
+		}
+		if status != nil {
+			if !(*status == "open" || *status == "resolved" || *status == "ignored") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"open", "resolved", "ignored"}))
+			}
+		}
+		accountRaw := qp.Get("account")
+		if accountRaw != "" {
+			account = &accountRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListReconciliationBreaksPayload(portfolioID, status, account)
+
+		return payload, nil
+	}
+}
+
+// EncodeListReconciliationBreaksError returns an encoder for errors returned
+// by the listReconciliationBreaks portfolio endpoint.
+func EncodeListReconciliationBreaksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateReconciliationBreakResponse returns an encoder for responses
+// returned by the portfolio updateReconciliationBreak endpoint.
+func EncodeUpdateReconciliationBreakResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.ReconciliationBreak)
+		enc := encoder(ctx, w)
+		body := NewUpdateReconciliationBreakResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateReconciliationBreakRequest returns a decoder for requests sent
+// to the portfolio updateReconciliationBreak endpoint.
+func DecodeUpdateReconciliationBreakRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.UpdateReconciliationBreakPayload, error) {
+	return func(r *http.Request) (*portfolio.UpdateReconciliationBreakPayload, error) {
+		var (
+			portfolioID string
+			id          string
+			status      string
+			note        *string
+			apply       bool
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		id = qp.Get("id")
+		if id == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("id", "query string"))
+		}
+		status = qp.Get("status")
+		if status == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("status", "query string"))
+		}
+		if !(status == "open" || status == "resolved" || status == "ignored") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", status, []any{"open", "resolved", "ignored"}))
+		}
+		noteRaw := qp.Get("note")
+		if noteRaw != "" {
+			note = &noteRaw
+		}
+		{
+			applyRaw := qp.Get("apply")
+			if applyRaw != "" {
+				v, err2 := strconv.ParseBool(applyRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("apply", applyRaw, "boolean"))
+				}
+				apply = v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateReconciliationBreakPayload(portfolioID, id, status, note, apply)
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateReconciliationBreakError returns an encoder for errors returned
+// by the updateReconciliationBreak portfolio endpoint.
+func EncodeUpdateReconciliationBreakError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetTaxReportResponse returns an encoder for responses returned by the
+// portfolio getTaxReport endpoint.
+func EncodeGetTaxReportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.TaxReport)
+		enc := encoder(ctx, w)
+		body := NewGetTaxReportResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetTaxReportRequest returns a decoder for requests sent to the
+// portfolio getTaxReport endpoint.
+func DecodeGetTaxReportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.GetTaxReportPayload, error) {
+	return func(r *http.Request) (*portfolio.GetTaxReportPayload, error) {
+		var (
+			portfolioID string
+			year        int
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		{
+			yearRaw := qp.Get("year")
+			if yearRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("year", "query string"))
+			}
+			v, err2 := strconv.ParseInt(yearRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("year", yearRaw, "integer"))
+			}
+			year = int(v)
+		}
+		if year < 1900 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("year", year, 1900, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetTaxReportPayload(portfolioID, year)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetTaxReportError returns an encoder for errors returned by the
+// getTaxReport portfolio endpoint.
+func EncodeGetTaxReportError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeExportTaxReportResponse returns an encoder for responses returned by
+// the portfolio exportTaxReport endpoint.
+func EncodeExportTaxReportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.ExportFile)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain")
+		enc := encoder(ctx, w)
+		body := res.Content
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeExportTaxReportRequest returns a decoder for requests sent to the
+// portfolio exportTaxReport endpoint.
+func DecodeExportTaxReportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ExportTaxReportPayload, error) {
+	return func(r *http.Request) (*portfolio.ExportTaxReportPayload, error) {
+		var (
+			portfolioID string
+			year        int
+			format      string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		{
+			yearRaw := qp.Get("year")
+			if yearRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("year", "query string"))
+			}
+			v, err2 := strconv.ParseInt(yearRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("year", yearRaw, "integer"))
+			}
+			year = int(v)
+		}
+		if year < 1900 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("year", year, 1900, true))
+		}
+		formatRaw := qp.Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "csv"
+		}
+		if !(format == "csv" || format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "json"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewExportTaxReportPayload(portfolioID, year, format)
+
+		return payload, nil
+	}
+}
+
+// EncodeExportTaxReportError returns an encoder for errors returned by the
+// exportTaxReport portfolio endpoint.
+func EncodeExportTaxReportError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListLotsResponse returns an encoder for responses returned by the
+// portfolio listLots endpoint.
+func EncodeListLotsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*portfolio.TaxLot)
+		enc := encoder(ctx, w)
+		body := NewListLotsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListLotsRequest returns a decoder for requests sent to the portfolio
+// listLots endpoint.
+func DecodeListLotsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ListLotsPayload, error) {
+	return func(r *http.Request) (*portfolio.ListLotsPayload, error) {
+		var (
+			portfolioID string
+			account     *string
+			symbol      *string
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		accountRaw := qp.Get("account")
+		if accountRaw != "" {
+			account = &accountRaw
+		}
+		symbolRaw := qp.Get("symbol")
+		if symbolRaw != "" {
+			symbol = &symbolRaw
+		}
+		payload := NewListLotsPayload(portfolioID, account, symbol)
+
+		return payload, nil
+	}
+}
+
+// EncodeListLotsError returns an encoder for errors returned by the listLots
+// portfolio endpoint.
+func EncodeListLotsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeFindTaxLossHarvestsResponse returns an encoder for responses returned
+// by the portfolio findTaxLossHarvests endpoint.
+func EncodeFindTaxLossHarvestsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.TaxLossHarvest)
+		enc := encoder(ctx, w)
+		body := NewFindTaxLossHarvestsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeFindTaxLossHarvestsRequest returns a decoder for requests sent to the
+// portfolio findTaxLossHarvests endpoint.
+func DecodeFindTaxLossHarvestsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.FindTaxLossHarvestsPayload, error) {
+	return func(r *http.Request) (*portfolio.FindTaxLossHarvestsPayload, error) {
+		var (
+			portfolioID   string
+			account       *string
+			minLoss       float64
+			shortTermRate float64
+			longTermRate  float64
+			err           error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		accountRaw := qp.Get("account")
+		if accountRaw != "" {
+			account = &accountRaw
+		}
+		{
+			minLossRaw := qp.Get("min_loss")
+			if minLossRaw != "" {
+				v, err2 := strconv.ParseFloat(minLossRaw, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("min_loss", minLossRaw, "float"))
+				}
+				minLoss = v
+			}
+		}
+		if minLoss < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("min_loss", minLoss, 0, true))
+		}
+		{
+			shortTermRateRaw := qp.Get("short_term_rate")
+			if shortTermRateRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("short_term_rate", "query string"))
+			}
+			v, err2 := strconv.ParseFloat(shortTermRateRaw, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("short_term_rate", shortTermRateRaw, "float"))
+			}
+			shortTermRate = v
+		}
+		if shortTermRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("short_term_rate", shortTermRate, 0, true))
+		}
+		if shortTermRate > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("short_term_rate", shortTermRate, 1, false))
+		}
+		{
+			longTermRateRaw := qp.Get("long_term_rate")
+			if longTermRateRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("long_term_rate", "query string"))
+			}
+			v, err2 := strconv.ParseFloat(longTermRateRaw, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("long_term_rate", longTermRateRaw, "float"))
+			}
+			longTermRate = v
+		}
+		if longTermRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("long_term_rate", longTermRate, 0, true))
+		}
+		if longTermRate > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("long_term_rate", longTermRate, 1, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewFindTaxLossHarvestsPayload(portfolioID, account, minLoss, shortTermRate, longTermRate)
+
+		return payload, nil
+	}
+}
+
+// EncodeFindTaxLossHarvestsError returns an encoder for errors returned by the
+// findTaxLossHarvests portfolio endpoint.
+func EncodeFindTaxLossHarvestsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListAccountsResponse returns an encoder for responses returned by the
+// portfolio listAccounts endpoint.
+func EncodeListAccountsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*portfolio.Account)
+		enc := encoder(ctx, w)
+		body := NewListAccountsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListAccountsRequest returns a decoder for requests sent to the
+// portfolio listAccounts endpoint.
+func DecodeListAccountsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.ListAccountsPayload, error) {
+	return func(r *http.Request) (*portfolio.ListAccountsPayload, error) {
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewListAccountsPayload(portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeListAccountsError returns an encoder for errors returned by the
+// listAccounts portfolio endpoint.
+func EncodeListAccountsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSetAccountResponse returns an encoder for responses returned by the
+// portfolio setAccount endpoint.
+func EncodeSetAccountResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.Account)
+		enc := encoder(ctx, w)
+		body := NewSetAccountResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSetAccountRequest returns a decoder for requests sent to the portfolio
+// setAccount endpoint.
+func DecodeSetAccountRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.SetAccountPayload, error) {
+	return func(r *http.Request) (*portfolio.SetAccountPayload, error) {
+		var (
+			body SetAccountRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateSetAccountRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			portfolioID string
+		)
+		portfolioIDRaw := r.URL.Query().Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		payload := NewSetAccountPayload(&body, portfolioID)
+
+		return payload, nil
+	}
+}
+
+// EncodeSetAccountError returns an encoder for errors returned by the
+// setAccount portfolio endpoint.
+func EncodeSetAccountError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRemoveAccountResponse returns an encoder for responses returned by the
+// portfolio removeAccount endpoint.
+func EncodeRemoveAccountResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*portfolio.Account)
+		enc := encoder(ctx, w)
+		body := NewRemoveAccountResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRemoveAccountRequest returns a decoder for requests sent to the
+// portfolio removeAccount endpoint.
+func DecodeRemoveAccountRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*portfolio.RemoveAccountPayload, error) {
+	return func(r *http.Request) (*portfolio.RemoveAccountPayload, error) {
+		var (
+			portfolioID string
+			id          string
+			err         error
+		)
+		qp := r.URL.Query()
+		portfolioIDRaw := qp.Get("portfolio_id")
+		if portfolioIDRaw != "" {
+			portfolioID = portfolioIDRaw
+		} else {
+			portfolioID = "default"
+		}
+		id = qp.Get("id")
+		if id == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("id", "query string"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRemoveAccountPayload(portfolioID, id)
+
+		return payload, nil
+	}
+}
+
+// EncodeRemoveAccountError returns an encoder for errors returned by the
+// removeAccount portfolio endpoint.
+func EncodeRemoveAccountError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res portfolio.BadRequest
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res portfolio.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res portfolio.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalPortfolioAllocationBucketToAllocationBucketResponseBody builds a
+// value of type *AllocationBucketResponseBody from a value of type
+// *portfolio.AllocationBucket.
+func marshalPortfolioAllocationBucketToAllocationBucketResponseBody(v *portfolio.AllocationBucket) *AllocationBucketResponseBody {
+	res := &AllocationBucketResponseBody{
+		Key:    v.Key,
+		Value:  v.Value,
+		Weight: v.Weight,
+	}
+	if v.Symbols != nil {
+		res.Symbols = make([]string, len(v.Symbols))
+		for i, val := range v.Symbols {
+			res.Symbols[i] = val
+		}
+	} else {
+		res.Symbols = []string{}
+	}
+
+	return res
+}
+
+// marshalPortfolioTargetWeightToTargetWeightResponseBody builds a value of
+// type *TargetWeightResponseBody from a value of type *portfolio.TargetWeight.
+func marshalPortfolioTargetWeightToTargetWeightResponseBody(v *portfolio.TargetWeight) *TargetWeightResponseBody {
+	res := &TargetWeightResponseBody{
+		Symbol:    v.Symbol,
+		Weight:    v.Weight,
+		Tolerance: v.Tolerance,
+	}
+	{
+		var zero float64
+		if res.Tolerance == zero {
+			res.Tolerance = 0.05
+		}
+	}
+
+	return res
+}
+
+// unmarshalTargetWeightRequestBodyRequestBodyToPortfolioTargetWeight builds a
+// value of type *portfolio.TargetWeight from a value of type
+// *TargetWeightRequestBodyRequestBody.
+func unmarshalTargetWeightRequestBodyRequestBodyToPortfolioTargetWeight(v *TargetWeightRequestBodyRequestBody) *portfolio.TargetWeight {
+	res := &portfolio.TargetWeight{
+		Symbol: *v.Symbol,
+		Weight: *v.Weight,
+	}
+	if v.Tolerance != nil {
+		res.Tolerance = *v.Tolerance
+	}
+	if v.Tolerance == nil {
+		res.Tolerance = 0.05
+	}
+
+	return res
+}
+
+// marshalPortfolioProposedTradeToProposedTradeResponseBody builds a value of
+// type *ProposedTradeResponseBody from a value of type
+// *portfolio.ProposedTrade.
+func marshalPortfolioProposedTradeToProposedTradeResponseBody(v *portfolio.ProposedTrade) *ProposedTradeResponseBody {
+	res := &ProposedTradeResponseBody{
+		Symbol:          v.Symbol,
+		Side:            v.Side,
+		Quantity:        v.Quantity,
+		Price:           v.Price,
+		Value:           v.Value,
+		CurrentWeight:   v.CurrentWeight,
+		TargetWeight:    v.TargetWeight,
+		ProjectedWeight: v.ProjectedWeight,
+	}
+
+	return res
+}
+
+// unmarshalBenchmarkComponentRequestBodyRequestBodyToPortfolioBenchmarkComponent
+// builds a value of type *portfolio.BenchmarkComponent from a value of type
+// *BenchmarkComponentRequestBodyRequestBody.
+func unmarshalBenchmarkComponentRequestBodyRequestBodyToPortfolioBenchmarkComponent(v *BenchmarkComponentRequestBodyRequestBody) *portfolio.BenchmarkComponent {
+	if v == nil {
+		return nil
+	}
+	res := &portfolio.BenchmarkComponent{
+		Symbol: *v.Symbol,
+		Weight: *v.Weight,
+	}
+
+	return res
+}
+
+// marshalPortfolioBenchmarkComponentToBenchmarkComponentResponseBody builds a
+// value of type *BenchmarkComponentResponseBody from a value of type
+// *portfolio.BenchmarkComponent.
+func marshalPortfolioBenchmarkComponentToBenchmarkComponentResponseBody(v *portfolio.BenchmarkComponent) *BenchmarkComponentResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &BenchmarkComponentResponseBody{
+		Symbol: v.Symbol,
+		Weight: v.Weight,
+	}
+
+	return res
+}
+
+// marshalPortfolioBenchmarkDefinitionToBenchmarkDefinitionResponseBody builds
+// a value of type *BenchmarkDefinitionResponseBody from a value of type
+// *portfolio.BenchmarkDefinition.
+func marshalPortfolioBenchmarkDefinitionToBenchmarkDefinitionResponseBody(v *portfolio.BenchmarkDefinition) *BenchmarkDefinitionResponseBody {
+	res := &BenchmarkDefinitionResponseBody{
+		Name:      v.Name,
+		Blend:     v.Blend,
+		Rebalance: v.Rebalance,
+	}
+	if v.Components != nil {
+		res.Components = make([]*BenchmarkComponentResponseBody, len(v.Components))
+		for i, val := range v.Components {
+			if val == nil {
+				res.Components[i] = nil
+				continue
+			}
+			res.Components[i] = marshalPortfolioBenchmarkComponentToBenchmarkComponentResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalPortfolioBenchmarkComparisonPointToBenchmarkComparisonPointResponseBody
+// builds a value of type *BenchmarkComparisonPointResponseBody from a value of
+// type *portfolio.BenchmarkComparisonPoint.
+func marshalPortfolioBenchmarkComparisonPointToBenchmarkComparisonPointResponseBody(v *portfolio.BenchmarkComparisonPoint) *BenchmarkComparisonPointResponseBody {
+	res := &BenchmarkComparisonPointResponseBody{
+		Date:      v.Date,
+		Portfolio: v.Portfolio,
+		Benchmark: v.Benchmark,
+	}
+
+	return res
+}
+
+// marshalPortfolioRiskWindowToRiskWindowResponseBody builds a value of type
+// *RiskWindowResponseBody from a value of type *portfolio.RiskWindow.
+func marshalPortfolioRiskWindowToRiskWindowResponseBody(v *portfolio.RiskWindow) *RiskWindowResponseBody {
+	res := &RiskWindowResponseBody{
+		Start:        v.Start,
+		End:          v.End,
+		Volatility:   v.Volatility,
+		SharpeRatio:  v.SharpeRatio,
+		SortinoRatio: v.SortinoRatio,
+		Beta:         v.Beta,
+		Correlation:  v.Correlation,
+		MaxDrawdown:  v.MaxDrawdown,
+	}
+
+	return res
+}
+
+// marshalPortfolioVaRContributionToVaRContributionResponseBody builds a value
+// of type *VaRContributionResponseBody from a value of type
+// *portfolio.VaRContribution.
+func marshalPortfolioVaRContributionToVaRContributionResponseBody(v *portfolio.VaRContribution) *VaRContributionResponseBody {
+	res := &VaRContributionResponseBody{
+		Symbol:       v.Symbol,
+		Value:        v.Value,
+		MarginalVar:  v.MarginalVar,
+		ComponentVar: v.ComponentVar,
+	}
+
+	return res
+}
+
+// marshalPortfolioStressScenarioToStressScenarioResponse builds a value of
+// type *StressScenarioResponse from a value of type *portfolio.StressScenario.
+func marshalPortfolioStressScenarioToStressScenarioResponse(v *portfolio.StressScenario) *StressScenarioResponse {
+	res := &StressScenarioResponse{
+		Name:        v.Name,
+		Description: v.Description,
+		RateShift:   v.RateShift,
+		ReplayStart: v.ReplayStart,
+		ReplayEnd:   v.ReplayEnd,
+	}
+	if v.AssetClasses != nil {
+		res.AssetClasses = make(map[string]float64, len(v.AssetClasses))
+		for key, val := range v.AssetClasses {
+			tk := key
+			tv := val
+			res.AssetClasses[tk] = tv
+		}
+	}
+	if v.Symbols != nil {
+		res.Symbols = make(map[string]float64, len(v.Symbols))
+		for key, val := range v.Symbols {
+			tk := key
+			tv := val
+			res.Symbols[tk] = tv
+		}
+	}
+	if v.Currencies != nil {
+		res.Currencies = make(map[string]float64, len(v.Currencies))
+		for key, val := range v.Currencies {
+			tk := key
+			tv := val
+			res.Currencies[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalPortfolioStressScenarioToStressScenarioResponseBody builds a value of
+// type *StressScenarioResponseBody from a value of type
+// *portfolio.StressScenario.
+func marshalPortfolioStressScenarioToStressScenarioResponseBody(v *portfolio.StressScenario) *StressScenarioResponseBody {
+	res := &StressScenarioResponseBody{
+		Name:        v.Name,
+		Description: v.Description,
+		RateShift:   v.RateShift,
+		ReplayStart: v.ReplayStart,
+		ReplayEnd:   v.ReplayEnd,
+	}
+	if v.AssetClasses != nil {
+		res.AssetClasses = make(map[string]float64, len(v.AssetClasses))
+		for key, val := range v.AssetClasses {
+			tk := key
+			tv := val
+			res.AssetClasses[tk] = tv
+		}
+	}
+	if v.Symbols != nil {
+		res.Symbols = make(map[string]float64, len(v.Symbols))
+		for key, val := range v.Symbols {
+			tk := key
+			tv := val
+			res.Symbols[tk] = tv
+		}
+	}
+	if v.Currencies != nil {
+		res.Currencies = make(map[string]float64, len(v.Currencies))
+		for key, val := range v.Currencies {
+			tk := key
+			tv := val
+			res.Currencies[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalPortfolioStressImpactToStressImpactResponseBody builds a value of
+// type *StressImpactResponseBody from a value of type *portfolio.StressImpact.
+func marshalPortfolioStressImpactToStressImpactResponseBody(v *portfolio.StressImpact) *StressImpactResponseBody {
+	res := &StressImpactResponseBody{
+		Symbol:         v.Symbol,
+		AssetClass:     v.AssetClass,
+		Value:          v.Value,
+		ProjectedValue: v.ProjectedValue,
+		Pnl:            v.Pnl,
+		Shock:          v.Shock,
+	}
+
+	return res
+}
+
+// unmarshalAssetClassAssumptionRequestBodyToPortfolioAssetClassAssumption
+// builds a value of type *portfolio.AssetClassAssumption from a value of type
+// *AssetClassAssumptionRequestBody.
+func unmarshalAssetClassAssumptionRequestBodyToPortfolioAssetClassAssumption(v *AssetClassAssumptionRequestBody) *portfolio.AssetClassAssumption {
+	if v == nil {
+		return nil
+	}
+	res := &portfolio.AssetClassAssumption{
+		AssetClass:     *v.AssetClass,
+		Weight:         v.Weight,
+		ExpectedReturn: v.ExpectedReturn,
+		Volatility:     v.Volatility,
+	}
+
+	return res
+}
+
+// marshalPortfolioAssetClassAssumptionToAssetClassAssumptionResponseBody
+// builds a value of type *AssetClassAssumptionResponseBody from a value of
+// type *portfolio.AssetClassAssumption.
+func marshalPortfolioAssetClassAssumptionToAssetClassAssumptionResponseBody(v *portfolio.AssetClassAssumption) *AssetClassAssumptionResponseBody {
+	res := &AssetClassAssumptionResponseBody{
+		AssetClass:     v.AssetClass,
+		Weight:         v.Weight,
+		ExpectedReturn: v.ExpectedReturn,
+		Volatility:     v.Volatility,
+	}
+
+	return res
+}
+
+// marshalPortfolioProjectionBandToProjectionBandResponseBody builds a value of
+// type *ProjectionBandResponseBody from a value of type
+// *portfolio.ProjectionBand.
+func marshalPortfolioProjectionBandToProjectionBandResponseBody(v *portfolio.ProjectionBand) *ProjectionBandResponseBody {
+	res := &ProjectionBandResponseBody{
+		Date: v.Date,
+		P5:   v.P5,
+		P25:  v.P25,
+		P50:  v.P50,
+		P75:  v.P75,
+		P95:  v.P95,
+	}
+
+	return res
+}
+
+// marshalPortfolioCorporateActionToCorporateActionResponse builds a value of
+// type *CorporateActionResponse from a value of type
+// *portfolio.CorporateAction.
+func marshalPortfolioCorporateActionToCorporateActionResponse(v *portfolio.CorporateAction) *CorporateActionResponse {
+	res := &CorporateActionResponse{
+		ID:            v.ID,
+		Date:          v.Date,
+		Type:          v.Type,
+		Symbol:        v.Symbol,
+		NewSymbol:     v.NewSymbol,
+		Ratio:         v.Ratio,
+		CashPerShare:  v.CashPerShare,
+		BasisFraction: v.BasisFraction,
+		Price:         v.Price,
+		CashInLieu:    v.CashInLieu,
+		Note:          v.Note,
+	}
+	{
+		var zero float64
+		if res.Ratio == zero {
+			res.Ratio = 0
+		}
+	}
+	{
+		var zero float64
+		if res.CashPerShare == zero {
+			res.CashPerShare = 0
+		}
+	}
+	{
+		var zero float64
+		if res.BasisFraction == zero {
+			res.BasisFraction = 0
+		}
+	}
+	{
+		var zero float64
+		if res.Price == zero {
+			res.Price = 0
+		}
+	}
+	{
+		var zero bool
+		if res.CashInLieu == zero {
+			res.CashInLieu = false
+		}
+	}
+
+	return res
+}
+
+// unmarshalCorporateActionInputRequestBodyToPortfolioCorporateActionInput
+// builds a value of type *portfolio.CorporateActionInput from a value of type
+// *CorporateActionInputRequestBody.
+func unmarshalCorporateActionInputRequestBodyToPortfolioCorporateActionInput(v *CorporateActionInputRequestBody) *portfolio.CorporateActionInput {
+	res := &portfolio.CorporateActionInput{
+		Date:      *v.Date,
+		Type:      *v.Type,
+		Symbol:    *v.Symbol,
+		NewSymbol: v.NewSymbol,
+		Note:      v.Note,
+	}
+	if v.Ratio != nil {
+		res.Ratio = *v.Ratio
+	}
+	if v.CashPerShare != nil {
+		res.CashPerShare = *v.CashPerShare
+	}
+	if v.BasisFraction != nil {
+		res.BasisFraction = *v.BasisFraction
+	}
+	if v.Price != nil {
+		res.Price = *v.Price
+	}
+	if v.CashInLieu != nil {
+		res.CashInLieu = *v.CashInLieu
+	}
+	if v.Ratio == nil {
+		res.Ratio = 0
+	}
+	if v.CashPerShare == nil {
+		res.CashPerShare = 0
+	}
+	if v.BasisFraction == nil {
+		res.BasisFraction = 0
+	}
+	if v.Price == nil {
+		res.Price = 0
+	}
+	if v.CashInLieu == nil {
+		res.CashInLieu = false
+	}
+
+	return res
+}
+
+// marshalPortfolioIncomeBucketToIncomeBucketResponseBody builds a value of
+// type *IncomeBucketResponseBody from a value of type *portfolio.IncomeBucket.
+func marshalPortfolioIncomeBucketToIncomeBucketResponseBody(v *portfolio.IncomeBucket) *IncomeBucketResponseBody {
+	res := &IncomeBucketResponseBody{
+		Key:         v.Key,
+		Gross:       v.Gross,
+		Withholding: v.Withholding,
+		Net:         v.Net,
+	}
+
+	return res
+}
+
+// marshalPortfolioIncomeForecastToIncomeForecastResponseBody builds a value of
+// type *IncomeForecastResponseBody from a value of type
+// *portfolio.IncomeForecast.
+func marshalPortfolioIncomeForecastToIncomeForecastResponseBody(v *portfolio.IncomeForecast) *IncomeForecastResponseBody {
+	res := &IncomeForecastResponseBody{
+		Month:  v.Month,
+		Amount: v.Amount,
+	}
+
+	return res
+}
+
+// marshalPortfolioBondPositionToBondPositionResponse builds a value of type
+// *BondPositionResponse from a value of type *portfolio.BondPosition.
+func marshalPortfolioBondPositionToBondPositionResponse(v *portfolio.BondPosition) *BondPositionResponse {
+	res := &BondPositionResponse{
+		Symbol:          v.Symbol,
+		Quantity:        v.Quantity,
+		Coupon:          v.Coupon,
+		CouponFrequency: v.CouponFrequency,
+		DayCount:        v.DayCount,
+		Maturity:        v.Maturity,
+		FaceValue:       v.FaceValue,
+		MarketValue:     v.MarketValue,
+		AccruedValue:    v.AccruedValue,
+	}
+	if v.Analytics != nil {
+		res.Analytics = marshalPortfolioBondAnalyticsToBondAnalyticsResponse(v.Analytics)
+	}
+
+	return res
+}
+
+// marshalPortfolioBondAnalyticsToBondAnalyticsResponse builds a value of type
+// *BondAnalyticsResponse from a value of type *portfolio.BondAnalytics.
+func marshalPortfolioBondAnalyticsToBondAnalyticsResponse(v *portfolio.BondAnalytics) *BondAnalyticsResponse {
+	res := &BondAnalyticsResponse{
+		Settlement:       v.Settlement,
+		CleanPrice:       v.CleanPrice,
+		DirtyPrice:       v.DirtyPrice,
+		AccruedInterest:  v.AccruedInterest,
+		YieldToMaturity:  v.YieldToMaturity,
+		MacaulayDuration: v.MacaulayDuration,
+		ModifiedDuration: v.ModifiedDuration,
+	}
+
+	return res
+}
+
+// marshalPortfolioBondCashFlowToBondCashFlowResponseBody builds a value of
+// type *BondCashFlowResponseBody from a value of type *portfolio.BondCashFlow.
+func marshalPortfolioBondCashFlowToBondCashFlowResponseBody(v *portfolio.BondCashFlow) *BondCashFlowResponseBody {
+	res := &BondCashFlowResponseBody{
+		Date:      v.Date,
+		Symbol:    v.Symbol,
+		Coupon:    v.Coupon,
+		Principal: v.Principal,
+		Total:     v.Total,
+	}
+
+	return res
+}
+
+// marshalPortfolioCashFlowLadderYearToCashFlowLadderYearResponseBody builds a
+// value of type *CashFlowLadderYearResponseBody from a value of type
+// *portfolio.CashFlowLadderYear.
+func marshalPortfolioCashFlowLadderYearToCashFlowLadderYearResponseBody(v *portfolio.CashFlowLadderYear) *CashFlowLadderYearResponseBody {
+	res := &CashFlowLadderYearResponseBody{
+		Year:      v.Year,
+		Coupon:    v.Coupon,
+		Principal: v.Principal,
+		Total:     v.Total,
+	}
+
+	return res
+}
+
+// marshalPortfolioOptionPositionToOptionPositionResponseBody builds a value of
+// type *OptionPositionResponseBody from a value of type
+// *portfolio.OptionPosition.
+func marshalPortfolioOptionPositionToOptionPositionResponseBody(v *portfolio.OptionPosition) *OptionPositionResponseBody {
+	res := &OptionPositionResponseBody{
+		Account:         v.Account,
+		Symbol:          v.Symbol,
+		Underlying:      v.Underlying,
+		OptionType:      v.OptionType,
+		Strike:          v.Strike,
+		Expiry:          v.Expiry,
+		Multiplier:      v.Multiplier,
+		FuturesOption:   v.FuturesOption,
+		Quantity:        v.Quantity,
+		UnderlyingPrice: v.UnderlyingPrice,
+		Volatility:      v.Volatility,
+		Price:           v.Price,
+		MarketValue:     v.MarketValue,
+		Delta:           v.Delta,
+		Gamma:           v.Gamma,
+		Theta:           v.Theta,
+		Vega:            v.Vega,
+	}
+
+	return res
+}
+
+// marshalPortfolioUnderlyingExposureToUnderlyingExposureResponseBody builds a
+// value of type *UnderlyingExposureResponseBody from a value of type
+// *portfolio.UnderlyingExposure.
+func marshalPortfolioUnderlyingExposureToUnderlyingExposureResponseBody(v *portfolio.UnderlyingExposure) *UnderlyingExposureResponseBody {
+	res := &UnderlyingExposureResponseBody{
+		Underlying:            v.Underlying,
+		Price:                 v.Price,
+		Shares:                v.Shares,
+		OptionDelta:           v.OptionDelta,
+		DeltaAdjustedShares:   v.DeltaAdjustedShares,
+		DeltaAdjustedExposure: v.DeltaAdjustedExposure,
+	}
+
+	return res
+}
+
+// marshalPortfolioOptionSettlementToOptionSettlementResponse builds a value of
+// type *OptionSettlementResponse from a value of type
+// *portfolio.OptionSettlement.
+func marshalPortfolioOptionSettlementToOptionSettlementResponse(v *portfolio.OptionSettlement) *OptionSettlementResponse {
+	res := &OptionSettlementResponse{
+		ID:       v.ID,
+		Date:     v.Date,
+		Account:  v.Account,
+		Symbol:   v.Symbol,
+		Type:     v.Type,
+		Quantity: v.Quantity,
+		Price:    v.Price,
+		Note:     v.Note,
+	}
+
+	return res
+}
+
+// marshalPortfolioMarginAccountStatusToMarginAccountStatusResponseBody builds
+// a value of type *MarginAccountStatusResponseBody from a value of type
+// *portfolio.MarginAccountStatus.
+func marshalPortfolioMarginAccountStatusToMarginAccountStatusResponseBody(v *portfolio.MarginAccountStatus) *MarginAccountStatusResponseBody {
+	res := &MarginAccountStatusResponseBody{
+		Account:                v.Account,
+		Cash:                   v.Cash,
+		LongValue:              v.LongValue,
+		ShortValue:             v.ShortValue,
+		ShortProceeds:          v.ShortProceeds,
+		Equity:                 v.Equity,
+		LoanBalance:            v.LoanBalance,
+		InitialRequirement:     v.InitialRequirement,
+		MaintenanceRequirement: v.MaintenanceRequirement,
+		MaintenanceExcess:      v.MaintenanceExcess,
+		BuyingPower:            v.BuyingPower,
+		MarginCall:             v.MarginCall,
+		InterestCharged:        v.InterestCharged,
+		BorrowFeesCharged:      v.BorrowFeesCharged,
+		AccruedInterest:        v.AccruedInterest,
+		AccruedBorrowFees:      v.AccruedBorrowFees,
+	}
+
+	return res
+}
+
+// marshalPortfolioPortfolioEventToPortfolioEventResponse builds a value of
+// type *PortfolioEventResponse from a value of type *portfolio.PortfolioEvent.
+func marshalPortfolioPortfolioEventToPortfolioEventResponse(v *portfolio.PortfolioEvent) *PortfolioEventResponse {
+	res := &PortfolioEventResponse{
+		ID:          v.ID,
+		Time:        v.Time,
+		PortfolioID: v.PortfolioID,
+		Type:        v.Type,
+		Account:     v.Account,
+		Message:     v.Message,
+	}
+
+	return res
+}
+
+// marshalPortfolioOrderToOrderResponse builds a value of type *OrderResponse
+// from a value of type *portfolio.Order.
+func marshalPortfolioOrderToOrderResponse(v *portfolio.Order) *OrderResponse {
+	res := &OrderResponse{
+		ID:          v.ID,
+		Account:     v.Account,
+		Symbol:      v.Symbol,
+		Side:        v.Side,
+		Type:        v.Type,
+		Quantity:    v.Quantity,
+		LimitPrice:  v.LimitPrice,
+		StopPrice:   v.StopPrice,
+		TimeInForce: v.TimeInForce,
+		Status:      v.Status,
+		Triggered:   v.Triggered,
+		Created:     v.Created,
+		Updated:     v.Updated,
+		FillPrice:   v.FillPrice,
+		Commission:  v.Commission,
+		Reason:      v.Reason,
+	}
+
+	return res
+}
+
+// unmarshalTradeInputRequestBodyToPortfolioTradeInput builds a value of type
+// *portfolio.TradeInput from a value of type *TradeInputRequestBody.
+func unmarshalTradeInputRequestBodyToPortfolioTradeInput(v *TradeInputRequestBody) *portfolio.TradeInput {
+	res := &portfolio.TradeInput{
+		Account:  v.Account,
+		Symbol:   *v.Symbol,
+		Side:     *v.Side,
+		Quantity: *v.Quantity,
+		Price:    v.Price,
+	}
+	if v.Fee != nil {
+		res.Fee = *v.Fee
+	}
+	if v.Fee == nil {
+		res.Fee = 0
+	}
+
+	return res
+}
+
+// marshalPortfolioPortfolioSummaryToPortfolioSummaryResponseBody builds a
+// value of type *PortfolioSummaryResponseBody from a value of type
+// *portfolio.PortfolioSummary.
+func marshalPortfolioPortfolioSummaryToPortfolioSummaryResponseBody(v *portfolio.PortfolioSummary) *PortfolioSummaryResponseBody {
+	res := &PortfolioSummaryResponseBody{
+		Balance:       v.Balance,
+		Currency:      v.Currency,
+		ChangePercent: v.ChangePercent,
+	}
+
+	return res
+}
+
+// marshalPortfolioAllocationChangeToAllocationChangeResponseBody builds a
+// value of type *AllocationChangeResponseBody from a value of type
+// *portfolio.AllocationChange.
+func marshalPortfolioAllocationChangeToAllocationChangeResponseBody(v *portfolio.AllocationChange) *AllocationChangeResponseBody {
+	res := &AllocationChangeResponseBody{
+		Key:          v.Key,
+		ValueBefore:  v.ValueBefore,
+		WeightBefore: v.WeightBefore,
+		ValueAfter:   v.ValueAfter,
+		WeightAfter:  v.WeightAfter,
+	}
+
+	return res
+}
+
+// marshalPortfolioRealizedLotToRealizedLotResponseBody builds a value of type
+// *RealizedLotResponseBody from a value of type *portfolio.RealizedLot.
+func marshalPortfolioRealizedLotToRealizedLotResponseBody(v *portfolio.RealizedLot) *RealizedLotResponseBody {
+	res := &RealizedLotResponseBody{
+		Account:   v.Account,
+		Symbol:    v.Symbol,
+		LotID:     v.LotID,
+		Acquired:  v.Acquired,
+		Quantity:  v.Quantity,
+		Proceeds:  v.Proceeds,
+		CostBasis: v.CostBasis,
+		Gain:      v.Gain,
+		LongTerm:  v.LongTerm,
+	}
+
+	return res
+}
+
+// marshalPortfolioHoldingsRiskToHoldingsRiskResponseBody builds a value of
+// type *HoldingsRiskResponseBody from a value of type *portfolio.HoldingsRisk.
+func marshalPortfolioHoldingsRiskToHoldingsRiskResponseBody(v *portfolio.HoldingsRisk) *HoldingsRiskResponseBody {
+	res := &HoldingsRiskResponseBody{
+		Volatility:        v.Volatility,
+		ValueAtRisk:       v.ValueAtRisk,
+		ExpectedShortfall: v.ExpectedShortfall,
+	}
+
+	return res
+}
+
+// unmarshalBacktestTargetRequestBodyToPortfolioBacktestTarget builds a value
+// of type *portfolio.BacktestTarget from a value of type
+// *BacktestTargetRequestBody.
+func unmarshalBacktestTargetRequestBodyToPortfolioBacktestTarget(v *BacktestTargetRequestBody) *portfolio.BacktestTarget {
+	res := &portfolio.BacktestTarget{
+		Symbol: *v.Symbol,
+		Weight: *v.Weight,
+	}
+
+	return res
+}
+
+// marshalPortfolioBacktestPointToBacktestPointResponseBody builds a value of
+// type *BacktestPointResponseBody from a value of type
+// *portfolio.BacktestPoint.
+func marshalPortfolioBacktestPointToBacktestPointResponseBody(v *portfolio.BacktestPoint) *BacktestPointResponseBody {
+	res := &BacktestPointResponseBody{
+		Date:     v.Date,
+		Value:    v.Value,
+		Flow:     v.Flow,
+		Drawdown: v.Drawdown,
+	}
+
+	return res
+}
+
+// marshalPortfolioImportRowToImportRowResponseBody builds a value of type
+// *ImportRowResponseBody from a value of type *portfolio.ImportRow.
+func marshalPortfolioImportRowToImportRowResponseBody(v *portfolio.ImportRow) *ImportRowResponseBody {
+	res := &ImportRowResponseBody{
+		Line:        v.Line,
+		Status:      v.Status,
+		Fingerprint: v.Fingerprint,
+		Error:       v.Error,
+	}
+	if v.Transaction != nil {
+		res.Transaction = marshalPortfolioLedgerTransactionToLedgerTransactionResponseBody(v.Transaction)
+	}
+
+	return res
+}
+
+// marshalPortfolioLedgerTransactionToLedgerTransactionResponseBody builds a
+// value of type *LedgerTransactionResponseBody from a value of type
+// *portfolio.LedgerTransaction.
+func marshalPortfolioLedgerTransactionToLedgerTransactionResponseBody(v *portfolio.LedgerTransaction) *LedgerTransactionResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &LedgerTransactionResponseBody{
+		ID:       v.ID,
+		Date:     v.Date,
+		Type:     v.Type,
+		Account:  v.Account,
+		Symbol:   v.Symbol,
+		Quantity: v.Quantity,
+		Price:    v.Price,
+		Amount:   v.Amount,
+		Fee:      v.Fee,
+		Note:     v.Note,
+	}
+
+	return res
+}
+
+// marshalPortfolioAccountReconciliationToAccountReconciliationResponseBody
+// builds a value of type *AccountReconciliationResponseBody from a value of
+// type *portfolio.AccountReconciliation.
+func marshalPortfolioAccountReconciliationToAccountReconciliationResponseBody(v *portfolio.AccountReconciliation) *AccountReconciliationResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &AccountReconciliationResponseBody{
+		Account:       v.Account,
+		AsOf:          v.AsOf,
+		StatementCash: v.StatementCash,
+		LedgerCash:    v.LedgerCash,
+		Matched:       v.Matched,
+	}
+	if v.Positions != nil {
+		res.Positions = make([]*PositionCheckResponseBody, len(v.Positions))
+		for i, val := range v.Positions {
+			if val == nil {
+				res.Positions[i] = nil
+				continue
+			}
+			res.Positions[i] = marshalPortfolioPositionCheckToPositionCheckResponseBody(val)
+		}
+	} else {
+		res.Positions = []*PositionCheckResponseBody{}
+	}
+
+	return res
+}
+
+// marshalPortfolioPositionCheckToPositionCheckResponseBody builds a value of
+// type *PositionCheckResponseBody from a value of type
+// *portfolio.PositionCheck.
+func marshalPortfolioPositionCheckToPositionCheckResponseBody(v *portfolio.PositionCheck) *PositionCheckResponseBody {
+	res := &PositionCheckResponseBody{
+		Symbol:            v.Symbol,
+		StatementQuantity: v.StatementQuantity,
+		LedgerQuantity:    v.LedgerQuantity,
+		Difference:        v.Difference,
+		Matched:           v.Matched,
+	}
+
+	return res
+}
+
+// marshalPortfolioReconciledAccountToReconciledAccountResponseBody builds a
+// value of type *ReconciledAccountResponseBody from a value of type
+// *portfolio.ReconciledAccount.
+func marshalPortfolioReconciledAccountToReconciledAccountResponseBody(v *portfolio.ReconciledAccount) *ReconciledAccountResponseBody {
+	res := &ReconciledAccountResponseBody{
+		Account:   v.Account,
+		AsOf:      v.AsOf,
+		Positions: v.Positions,
+		Cash:      v.Cash,
+		Breaks:    v.Breaks,
+	}
+
+	return res
+}
+
+// marshalPortfolioReconciliationBreakToReconciliationBreakResponseBody builds
+// a value of type *ReconciliationBreakResponseBody from a value of type
+// *portfolio.ReconciliationBreak.
+func marshalPortfolioReconciliationBreakToReconciliationBreakResponseBody(v *portfolio.ReconciliationBreak) *ReconciliationBreakResponseBody {
+	res := &ReconciliationBreakResponseBody{
+		ID:         v.ID,
+		Account:    v.Account,
+		AsOf:       v.AsOf,
+		Kind:       v.Kind,
+		Symbol:     v.Symbol,
+		Statement:  v.Statement,
+		Ledger:     v.Ledger,
+		Difference: v.Difference,
+		Price:      v.Price,
+		Status:     v.Status,
+		Note:       v.Note,
+		Detected:   v.Detected,
+		Updated:    v.Updated,
+	}
+	if v.Adjustments != nil {
+		res.Adjustments = make([]*LedgerTransactionResponseBody, len(v.Adjustments))
+		for i, val := range v.Adjustments {
+			if val == nil {
+				res.Adjustments[i] = nil
+				continue
+			}
+			res.Adjustments[i] = marshalPortfolioLedgerTransactionToLedgerTransactionResponseBody(val)
+		}
+	} else {
+		res.Adjustments = []*LedgerTransactionResponseBody{}
+	}
+	if v.Posted != nil {
+		res.Posted = make([]string, len(v.Posted))
+		for i, val := range v.Posted {
+			res.Posted[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalPortfolioReconciliationBreakToReconciliationBreakResponse builds a
+// value of type *ReconciliationBreakResponse from a value of type
+// *portfolio.ReconciliationBreak.
+func marshalPortfolioReconciliationBreakToReconciliationBreakResponse(v *portfolio.ReconciliationBreak) *ReconciliationBreakResponse {
+	res := &ReconciliationBreakResponse{
+		ID:         v.ID,
+		Account:    v.Account,
+		AsOf:       v.AsOf,
+		Kind:       v.Kind,
+		Symbol:     v.Symbol,
+		Statement:  v.Statement,
+		Ledger:     v.Ledger,
+		Difference: v.Difference,
+		Price:      v.Price,
+		Status:     v.Status,
+		Note:       v.Note,
+		Detected:   v.Detected,
+		Updated:    v.Updated,
+	}
+	if v.Adjustments != nil {
+		res.Adjustments = make([]*LedgerTransactionResponse, len(v.Adjustments))
+		for i, val := range v.Adjustments {
+			if val == nil {
+				res.Adjustments[i] = nil
+				continue
+			}
+			res.Adjustments[i] = marshalPortfolioLedgerTransactionToLedgerTransactionResponse(val)
+		}
+	} else {
+		res.Adjustments = []*LedgerTransactionResponse{}
+	}
+	if v.Posted != nil {
+		res.Posted = make([]string, len(v.Posted))
+		for i, val := range v.Posted {
+			res.Posted[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalPortfolioLedgerTransactionToLedgerTransactionResponse builds a value
+// of type *LedgerTransactionResponse from a value of type
+// *portfolio.LedgerTransaction.
+func marshalPortfolioLedgerTransactionToLedgerTransactionResponse(v *portfolio.LedgerTransaction) *LedgerTransactionResponse {
+	res := &LedgerTransactionResponse{
+		ID:       v.ID,
+		Date:     v.Date,
+		Type:     v.Type,
+		Account:  v.Account,
+		Symbol:   v.Symbol,
+		Quantity: v.Quantity,
+		Price:    v.Price,
+		Amount:   v.Amount,
+		Fee:      v.Fee,
+		Note:     v.Note,
+	}
+
+	return res
+}
+
+// marshalPortfolioTaxAccountToTaxAccountResponseBody builds a value of type
+// *TaxAccountResponseBody from a value of type *portfolio.TaxAccount.
+func marshalPortfolioTaxAccountToTaxAccountResponseBody(v *portfolio.TaxAccount) *TaxAccountResponseBody {
+	res := &TaxAccountResponseBody{
+		Account: v.Account,
+	}
+	if v.Lots != nil {
+		res.Lots = make([]*TaxLotGainResponseBody, len(v.Lots))
+		for i, val := range v.Lots {
+			if val == nil {
+				res.Lots[i] = nil
+				continue
+			}
+			res.Lots[i] = marshalPortfolioTaxLotGainToTaxLotGainResponseBody(val)
+		}
+	} else {
+		res.Lots = []*TaxLotGainResponseBody{}
+	}
+	if v.ShortTerm != nil {
+		res.ShortTerm = marshalPortfolioTaxTotalsToTaxTotalsResponseBody(v.ShortTerm)
+	}
+	if v.LongTerm != nil {
+		res.LongTerm = marshalPortfolioTaxTotalsToTaxTotalsResponseBody(v.LongTerm)
+	}
+	if v.Total != nil {
+		res.Total = marshalPortfolioTaxTotalsToTaxTotalsResponseBody(v.Total)
+	}
+
+	return res
+}
+
+// marshalPortfolioTaxLotGainToTaxLotGainResponseBody builds a value of type
+// *TaxLotGainResponseBody from a value of type *portfolio.TaxLotGain.
+func marshalPortfolioTaxLotGainToTaxLotGainResponseBody(v *portfolio.TaxLotGain) *TaxLotGainResponseBody {
+	res := &TaxLotGainResponseBody{
+		Account:            v.Account,
+		Symbol:             v.Symbol,
+		LotID:              v.LotID,
+		SaleID:             v.SaleID,
+		Acquired:           v.Acquired,
+		Disposed:           v.Disposed,
+		Quantity:           v.Quantity,
+		Proceeds:           v.Proceeds,
+		CostBasis:          v.CostBasis,
+		BasisAdjustment:    v.BasisAdjustment,
+		WashSaleAdjustment: v.WashSaleAdjustment,
+		Gain:               v.Gain,
+		Term:               v.Term,
+		ShortSale:          v.ShortSale,
+	}
+
+	return res
+}
+
+// marshalPortfolioTaxTotalsToTaxTotalsResponseBody builds a value of type
+// *TaxTotalsResponseBody from a value of type *portfolio.TaxTotals.
+func marshalPortfolioTaxTotalsToTaxTotalsResponseBody(v *portfolio.TaxTotals) *TaxTotalsResponseBody {
+	res := &TaxTotalsResponseBody{
+		Proceeds:           v.Proceeds,
+		CostBasis:          v.CostBasis,
+		WashSaleAdjustment: v.WashSaleAdjustment,
+		Gain:               v.Gain,
+	}
+
+	return res
+}
+
+// marshalPortfolioWashSaleToWashSaleResponseBody builds a value of type
+// *WashSaleResponseBody from a value of type *portfolio.WashSale.
+func marshalPortfolioWashSaleToWashSaleResponseBody(v *portfolio.WashSale) *WashSaleResponseBody {
+	res := &WashSaleResponseBody{
+		SaleID:              v.SaleID,
+		LotID:               v.LotID,
+		Account:             v.Account,
+		Symbol:              v.Symbol,
+		Disposed:            v.Disposed,
+		Quantity:            v.Quantity,
+		Disallowed:          v.Disallowed,
+		ReplacementLotID:    v.ReplacementLotID,
+		ReplacementAccount:  v.ReplacementAccount,
+		ReplacementSymbol:   v.ReplacementSymbol,
+		ReplacementAcquired: v.ReplacementAcquired,
+		Permanent:           v.Permanent,
+	}
+
+	return res
+}
+
+// marshalPortfolioTaxLotToTaxLotResponse builds a value of type
+// *TaxLotResponse from a value of type *portfolio.TaxLot.
+func marshalPortfolioTaxLotToTaxLotResponse(v *portfolio.TaxLot) *TaxLotResponse {
+	res := &TaxLotResponse{
+		ID:              v.ID,
+		Account:         v.Account,
+		Symbol:          v.Symbol,
+		Acquired:        v.Acquired,
+		HoldingSince:    v.HoldingSince,
+		Quantity:        v.Quantity,
+		CostBasis:       v.CostBasis,
+		BasisAdjustment: v.BasisAdjustment,
+		UnitCost:        v.UnitCost,
+		Term:            v.Term,
+		Price:           v.Price,
+		MarketValue:     v.MarketValue,
+		UnrealizedGain:  v.UnrealizedGain,
+	}
+
+	return res
+}
+
+// marshalPortfolioHarvestOpportunityToHarvestOpportunityResponseBody builds a
+// value of type *HarvestOpportunityResponseBody from a value of type
+// *portfolio.HarvestOpportunity.
+func marshalPortfolioHarvestOpportunityToHarvestOpportunityResponseBody(v *portfolio.HarvestOpportunity) *HarvestOpportunityResponseBody {
+	res := &HarvestOpportunityResponseBody{
+		Account:             v.Account,
+		Symbol:              v.Symbol,
+		Quantity:            v.Quantity,
+		Price:               v.Price,
+		CostBasis:           v.CostBasis,
+		MarketValue:         v.MarketValue,
+		Loss:                v.Loss,
+		ShortTermLoss:       v.ShortTermLoss,
+		LongTermLoss:        v.LongTermLoss,
+		EstimatedSavings:    v.EstimatedSavings,
+		Replacement:         v.Replacement,
+		ReplacementPrice:    v.ReplacementPrice,
+		ReplacementQuantity: v.ReplacementQuantity,
+		RepurchaseAfter:     v.RepurchaseAfter,
+	}
+	if v.Lots != nil {
+		res.Lots = make([]string, len(v.Lots))
+		for i, val := range v.Lots {
+			res.Lots[i] = val
+		}
+	} else {
+		res.Lots = []string{}
+	}
+
+	return res
+}
+
+// marshalPortfolioSkippedHarvestToSkippedHarvestResponseBody builds a value of
+// type *SkippedHarvestResponseBody from a value of type
+// *portfolio.SkippedHarvest.
+func marshalPortfolioSkippedHarvestToSkippedHarvestResponseBody(v *portfolio.SkippedHarvest) *SkippedHarvestResponseBody {
+	res := &SkippedHarvestResponseBody{
+		Account: v.Account,
+		Symbol:  v.Symbol,
+		Loss:    v.Loss,
+		Reason:  v.Reason,
+	}
+
+	return res
+}
+
+// marshalPortfolioAccountToAccountResponse builds a value of type
+// *AccountResponse from a value of type *portfolio.Account.
+func marshalPortfolioAccountToAccountResponse(v *portfolio.Account) *AccountResponse {
+	res := &AccountResponse{
+		ID:            v.ID,
+		Name:          v.Name,
+		Type:          v.Type,
+		TaxTreatment:  v.TaxTreatment,
+		Custodian:     v.Custodian,
+		Currency:      v.Currency,
+		Registered:    v.Registered,
+		Cash:          v.Cash,
+		HoldingsValue: v.HoldingsValue,
+		TotalValue:    v.TotalValue,
+	}
+
+	return res
+}
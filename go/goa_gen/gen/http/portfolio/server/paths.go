@@ -12,3 +12,233 @@ package server
 func GetPortfolioSummaryPortfolioPath() string {
 	return "/portfolio/summary"
 }
+
+// GetReturnsPortfolioPath returns the URL path to the portfolio service getReturns HTTP endpoint.
+func GetReturnsPortfolioPath() string {
+	return "/portfolio/returns"
+}
+
+// GetAllocationPortfolioPath returns the URL path to the portfolio service getAllocation HTTP endpoint.
+func GetAllocationPortfolioPath() string {
+	return "/portfolio/allocation"
+}
+
+// GetTargetAllocationPortfolioPath returns the URL path to the portfolio service getTargetAllocation HTTP endpoint.
+func GetTargetAllocationPortfolioPath() string {
+	return "/portfolio/targets"
+}
+
+// SetTargetAllocationPortfolioPath returns the URL path to the portfolio service setTargetAllocation HTTP endpoint.
+func SetTargetAllocationPortfolioPath() string {
+	return "/portfolio/targets"
+}
+
+// ProposeRebalancePortfolioPath returns the URL path to the portfolio service proposeRebalance HTTP endpoint.
+func ProposeRebalancePortfolioPath() string {
+	return "/portfolio/rebalance"
+}
+
+// SetBenchmarkPortfolioPath returns the URL path to the portfolio service setBenchmark HTTP endpoint.
+func SetBenchmarkPortfolioPath() string {
+	return "/portfolio/benchmark"
+}
+
+// GetBenchmarkComparisonPortfolioPath returns the URL path to the portfolio service getBenchmarkComparison HTTP endpoint.
+func GetBenchmarkComparisonPortfolioPath() string {
+	return "/portfolio/benchmark/comparison"
+}
+
+// GetRiskMetricsPortfolioPath returns the URL path to the portfolio service getRiskMetrics HTTP endpoint.
+func GetRiskMetricsPortfolioPath() string {
+	return "/portfolio/risk"
+}
+
+// GetValueAtRiskPortfolioPath returns the URL path to the portfolio service getValueAtRisk HTTP endpoint.
+func GetValueAtRiskPortfolioPath() string {
+	return "/portfolio/var"
+}
+
+// ListStressScenariosPortfolioPath returns the URL path to the portfolio service listStressScenarios HTTP endpoint.
+func ListStressScenariosPortfolioPath() string {
+	return "/portfolio/stress/scenarios"
+}
+
+// RunStressTestPortfolioPath returns the URL path to the portfolio service runStressTest HTTP endpoint.
+func RunStressTestPortfolioPath() string {
+	return "/portfolio/stress"
+}
+
+// ProjectPortfolioPortfolioPath returns the URL path to the portfolio service projectPortfolio HTTP endpoint.
+func ProjectPortfolioPortfolioPath() string {
+	return "/portfolio/projection"
+}
+
+// ListCorporateActionsPortfolioPath returns the URL path to the portfolio service listCorporateActions HTTP endpoint.
+func ListCorporateActionsPortfolioPath() string {
+	return "/portfolio/corporate-actions"
+}
+
+// ApplyCorporateActionsPortfolioPath returns the URL path to the portfolio service applyCorporateActions HTTP endpoint.
+func ApplyCorporateActionsPortfolioPath() string {
+	return "/portfolio/corporate-actions"
+}
+
+// RecordIncomePortfolioPath returns the URL path to the portfolio service recordIncome HTTP endpoint.
+func RecordIncomePortfolioPath() string {
+	return "/portfolio/income"
+}
+
+// GetIncomePortfolioPath returns the URL path to the portfolio service getIncome HTTP endpoint.
+func GetIncomePortfolioPath() string {
+	return "/portfolio/income"
+}
+
+// PriceBondPortfolioPath returns the URL path to the portfolio service priceBond HTTP endpoint.
+func PriceBondPortfolioPath() string {
+	return "/portfolio/bonds/price"
+}
+
+// ListBondPositionsPortfolioPath returns the URL path to the portfolio service listBondPositions HTTP endpoint.
+func ListBondPositionsPortfolioPath() string {
+	return "/portfolio/bonds"
+}
+
+// GetCashFlowLadderPortfolioPath returns the URL path to the portfolio service getCashFlowLadder HTTP endpoint.
+func GetCashFlowLadderPortfolioPath() string {
+	return "/portfolio/bonds/ladder"
+}
+
+// ListOptionPositionsPortfolioPath returns the URL path to the portfolio service listOptionPositions HTTP endpoint.
+func ListOptionPositionsPortfolioPath() string {
+	return "/portfolio/options"
+}
+
+// SettleOptionExpiriesPortfolioPath returns the URL path to the portfolio service settleOptionExpiries HTTP endpoint.
+func SettleOptionExpiriesPortfolioPath() string {
+	return "/portfolio/options/settle"
+}
+
+// SetMarginAccountPortfolioPath returns the URL path to the portfolio service setMarginAccount HTTP endpoint.
+func SetMarginAccountPortfolioPath() string {
+	return "/portfolio/margin/accounts"
+}
+
+// GetMarginStatusPortfolioPath returns the URL path to the portfolio service getMarginStatus HTTP endpoint.
+func GetMarginStatusPortfolioPath() string {
+	return "/portfolio/margin"
+}
+
+// ChargeMarginPortfolioPath returns the URL path to the portfolio service chargeMargin HTTP endpoint.
+func ChargeMarginPortfolioPath() string {
+	return "/portfolio/margin/charge"
+}
+
+// ListEventsPortfolioPath returns the URL path to the portfolio service listEvents HTTP endpoint.
+func ListEventsPortfolioPath() string {
+	return "/portfolio/events"
+}
+
+// PlaceOrderPortfolioPath returns the URL path to the portfolio service placeOrder HTTP endpoint.
+func PlaceOrderPortfolioPath() string {
+	return "/portfolio/orders"
+}
+
+// ListOrdersPortfolioPath returns the URL path to the portfolio service listOrders HTTP endpoint.
+func ListOrdersPortfolioPath() string {
+	return "/portfolio/orders"
+}
+
+// CancelOrderPortfolioPath returns the URL path to the portfolio service cancelOrder HTTP endpoint.
+func CancelOrderPortfolioPath() string {
+	return "/portfolio/orders/cancel"
+}
+
+// PreviewTradesPortfolioPath returns the URL path to the portfolio service previewTrades HTTP endpoint.
+func PreviewTradesPortfolioPath() string {
+	return "/portfolio/trades/preview"
+}
+
+// RunBacktestPortfolioPath returns the URL path to the portfolio service runBacktest HTTP endpoint.
+func RunBacktestPortfolioPath() string {
+	return "/portfolio/backtest"
+}
+
+// ImportCsvPortfolioPath returns the URL path to the portfolio service importCsv HTTP endpoint.
+func ImportCsvPortfolioPath() string {
+	return "/portfolio/import/csv"
+}
+
+// ImportOfxPortfolioPath returns the URL path to the portfolio service importOfx HTTP endpoint.
+func ImportOfxPortfolioPath() string {
+	return "/portfolio/import/ofx"
+}
+
+// ExportJournalPortfolioPath returns the URL path to the portfolio service exportJournal HTTP endpoint.
+func ExportJournalPortfolioPath() string {
+	return "/portfolio/export/journal"
+}
+
+// ImportJournalPortfolioPath returns the URL path to the portfolio service importJournal HTTP endpoint.
+func ImportJournalPortfolioPath() string {
+	return "/portfolio/import/journal"
+}
+
+// ExportArchivePortfolioPath returns the URL path to the portfolio service exportArchive HTTP endpoint.
+func ExportArchivePortfolioPath() string {
+	return "/portfolio/export/archive"
+}
+
+// ImportArchivePortfolioPath returns the URL path to the portfolio service importArchive HTTP endpoint.
+func ImportArchivePortfolioPath() string {
+	return "/portfolio/import/archive"
+}
+
+// ReconcilePositionsPortfolioPath returns the URL path to the portfolio service reconcilePositions HTTP endpoint.
+func ReconcilePositionsPortfolioPath() string {
+	return "/portfolio/reconcile"
+}
+
+// ListReconciliationBreaksPortfolioPath returns the URL path to the portfolio service listReconciliationBreaks HTTP endpoint.
+func ListReconciliationBreaksPortfolioPath() string {
+	return "/portfolio/reconcile/breaks"
+}
+
+// UpdateReconciliationBreakPortfolioPath returns the URL path to the portfolio service updateReconciliationBreak HTTP endpoint.
+func UpdateReconciliationBreakPortfolioPath() string {
+	return "/portfolio/reconcile/breaks/status"
+}
+
+// GetTaxReportPortfolioPath returns the URL path to the portfolio service getTaxReport HTTP endpoint.
+func GetTaxReportPortfolioPath() string {
+	return "/portfolio/tax"
+}
+
+// ExportTaxReportPortfolioPath returns the URL path to the portfolio service exportTaxReport HTTP endpoint.
+func ExportTaxReportPortfolioPath() string {
+	return "/portfolio/export/tax"
+}
+
+// ListLotsPortfolioPath returns the URL path to the portfolio service listLots HTTP endpoint.
+func ListLotsPortfolioPath() string {
+	return "/portfolio/lots"
+}
+
+// FindTaxLossHarvestsPortfolioPath returns the URL path to the portfolio service findTaxLossHarvests HTTP endpoint.
+func FindTaxLossHarvestsPortfolioPath() string {
+	return "/portfolio/tax/harvest"
+}
+
+// ListAccountsPortfolioPath returns the URL path to the portfolio service listAccounts HTTP endpoint.
+func ListAccountsPortfolioPath() string {
+	return "/portfolio/accounts"
+}
+
+// SetAccountPortfolioPath returns the URL path to the portfolio service setAccount HTTP endpoint.
+func SetAccountPortfolioPath() string {
+	return "/portfolio/accounts"
+}
+
+// RemoveAccountPortfolioPath returns the URL path to the portfolio service removeAccount HTTP endpoint.
+func RemoveAccountPortfolioPath() string {
+	return "/portfolio/accounts/remove"
+}
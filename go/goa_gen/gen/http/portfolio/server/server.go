@@ -19,8 +19,54 @@ import (
 
 // Server lists the portfolio service endpoint HTTP handlers.
 type Server struct {
-	Mounts              []*MountPoint
-	GetPortfolioSummary http.Handler
+	Mounts                    []*MountPoint
+	GetPortfolioSummary       http.Handler
+	GetReturns                http.Handler
+	GetAllocation             http.Handler
+	GetTargetAllocation       http.Handler
+	SetTargetAllocation       http.Handler
+	ProposeRebalance          http.Handler
+	SetBenchmark              http.Handler
+	GetBenchmarkComparison    http.Handler
+	GetRiskMetrics            http.Handler
+	GetValueAtRisk            http.Handler
+	ListStressScenarios       http.Handler
+	RunStressTest             http.Handler
+	ProjectPortfolio          http.Handler
+	ListCorporateActions      http.Handler
+	ApplyCorporateActions     http.Handler
+	RecordIncome              http.Handler
+	GetIncome                 http.Handler
+	PriceBond                 http.Handler
+	ListBondPositions         http.Handler
+	GetCashFlowLadder         http.Handler
+	ListOptionPositions       http.Handler
+	SettleOptionExpiries      http.Handler
+	SetMarginAccount          http.Handler
+	GetMarginStatus           http.Handler
+	ChargeMargin              http.Handler
+	ListEvents                http.Handler
+	PlaceOrder                http.Handler
+	ListOrders                http.Handler
+	CancelOrder               http.Handler
+	PreviewTrades             http.Handler
+	RunBacktest               http.Handler
+	ImportCsv                 http.Handler
+	ImportOfx                 http.Handler
+	ExportJournal             http.Handler
+	ImportJournal             http.Handler
+	ExportArchive             http.Handler
+	ImportArchive             http.Handler
+	ReconcilePositions        http.Handler
+	ListReconciliationBreaks  http.Handler
+	UpdateReconciliationBreak http.Handler
+	GetTaxReport              http.Handler
+	ExportTaxReport           http.Handler
+	ListLots                  http.Handler
+	FindTaxLossHarvests       http.Handler
+	ListAccounts              http.Handler
+	SetAccount                http.Handler
+	RemoveAccount             http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -51,8 +97,100 @@ func New(
 	return &Server{
 		Mounts: []*MountPoint{
 			{"GetPortfolioSummary", "GET", "/portfolio/summary"},
+			{"GetReturns", "GET", "/portfolio/returns"},
+			{"GetAllocation", "GET", "/portfolio/allocation"},
+			{"GetTargetAllocation", "GET", "/portfolio/targets"},
+			{"SetTargetAllocation", "PUT", "/portfolio/targets"},
+			{"ProposeRebalance", "POST", "/portfolio/rebalance"},
+			{"SetBenchmark", "PUT", "/portfolio/benchmark"},
+			{"GetBenchmarkComparison", "GET", "/portfolio/benchmark/comparison"},
+			{"GetRiskMetrics", "GET", "/portfolio/risk"},
+			{"GetValueAtRisk", "GET", "/portfolio/var"},
+			{"ListStressScenarios", "GET", "/portfolio/stress/scenarios"},
+			{"RunStressTest", "GET", "/portfolio/stress"},
+			{"ProjectPortfolio", "POST", "/portfolio/projection"},
+			{"ListCorporateActions", "GET", "/portfolio/corporate-actions"},
+			{"ApplyCorporateActions", "POST", "/portfolio/corporate-actions"},
+			{"RecordIncome", "POST", "/portfolio/income"},
+			{"GetIncome", "GET", "/portfolio/income"},
+			{"PriceBond", "POST", "/portfolio/bonds/price"},
+			{"ListBondPositions", "GET", "/portfolio/bonds"},
+			{"GetCashFlowLadder", "GET", "/portfolio/bonds/ladder"},
+			{"ListOptionPositions", "GET", "/portfolio/options"},
+			{"SettleOptionExpiries", "POST", "/portfolio/options/settle"},
+			{"SetMarginAccount", "PUT", "/portfolio/margin/accounts"},
+			{"GetMarginStatus", "GET", "/portfolio/margin"},
+			{"ChargeMargin", "POST", "/portfolio/margin/charge"},
+			{"ListEvents", "GET", "/portfolio/events"},
+			{"PlaceOrder", "POST", "/portfolio/orders"},
+			{"ListOrders", "GET", "/portfolio/orders"},
+			{"CancelOrder", "POST", "/portfolio/orders/cancel"},
+			{"PreviewTrades", "POST", "/portfolio/trades/preview"},
+			{"RunBacktest", "POST", "/portfolio/backtest"},
+			{"ImportCsv", "POST", "/portfolio/import/csv"},
+			{"ImportOfx", "POST", "/portfolio/import/ofx"},
+			{"ExportJournal", "GET", "/portfolio/export/journal"},
+			{"ImportJournal", "POST", "/portfolio/import/journal"},
+			{"ExportArchive", "GET", "/portfolio/export/archive"},
+			{"ImportArchive", "POST", "/portfolio/import/archive"},
+			{"ReconcilePositions", "POST", "/portfolio/reconcile"},
+			{"ListReconciliationBreaks", "GET", "/portfolio/reconcile/breaks"},
+			{"UpdateReconciliationBreak", "POST", "/portfolio/reconcile/breaks/status"},
+			{"GetTaxReport", "GET", "/portfolio/tax"},
+			{"ExportTaxReport", "GET", "/portfolio/export/tax"},
+			{"ListLots", "GET", "/portfolio/lots"},
+			{"FindTaxLossHarvests", "GET", "/portfolio/tax/harvest"},
+			{"ListAccounts", "GET", "/portfolio/accounts"},
+			{"SetAccount", "PUT", "/portfolio/accounts"},
+			{"RemoveAccount", "POST", "/portfolio/accounts/remove"},
 		},
-		GetPortfolioSummary: NewGetPortfolioSummaryHandler(e.GetPortfolioSummary, mux, decoder, encoder, errhandler, formatter),
+		GetPortfolioSummary:       NewGetPortfolioSummaryHandler(e.GetPortfolioSummary, mux, decoder, encoder, errhandler, formatter),
+		GetReturns:                NewGetReturnsHandler(e.GetReturns, mux, decoder, encoder, errhandler, formatter),
+		GetAllocation:             NewGetAllocationHandler(e.GetAllocation, mux, decoder, encoder, errhandler, formatter),
+		GetTargetAllocation:       NewGetTargetAllocationHandler(e.GetTargetAllocation, mux, decoder, encoder, errhandler, formatter),
+		SetTargetAllocation:       NewSetTargetAllocationHandler(e.SetTargetAllocation, mux, decoder, encoder, errhandler, formatter),
+		ProposeRebalance:          NewProposeRebalanceHandler(e.ProposeRebalance, mux, decoder, encoder, errhandler, formatter),
+		SetBenchmark:              NewSetBenchmarkHandler(e.SetBenchmark, mux, decoder, encoder, errhandler, formatter),
+		GetBenchmarkComparison:    NewGetBenchmarkComparisonHandler(e.GetBenchmarkComparison, mux, decoder, encoder, errhandler, formatter),
+		GetRiskMetrics:            NewGetRiskMetricsHandler(e.GetRiskMetrics, mux, decoder, encoder, errhandler, formatter),
+		GetValueAtRisk:            NewGetValueAtRiskHandler(e.GetValueAtRisk, mux, decoder, encoder, errhandler, formatter),
+		ListStressScenarios:       NewListStressScenariosHandler(e.ListStressScenarios, mux, decoder, encoder, errhandler, formatter),
+		RunStressTest:             NewRunStressTestHandler(e.RunStressTest, mux, decoder, encoder, errhandler, formatter),
+		ProjectPortfolio:          NewProjectPortfolioHandler(e.ProjectPortfolio, mux, decoder, encoder, errhandler, formatter),
+		ListCorporateActions:      NewListCorporateActionsHandler(e.ListCorporateActions, mux, decoder, encoder, errhandler, formatter),
+		ApplyCorporateActions:     NewApplyCorporateActionsHandler(e.ApplyCorporateActions, mux, decoder, encoder, errhandler, formatter),
+		RecordIncome:              NewRecordIncomeHandler(e.RecordIncome, mux, decoder, encoder, errhandler, formatter),
+		GetIncome:                 NewGetIncomeHandler(e.GetIncome, mux, decoder, encoder, errhandler, formatter),
+		PriceBond:                 NewPriceBondHandler(e.PriceBond, mux, decoder, encoder, errhandler, formatter),
+		ListBondPositions:         NewListBondPositionsHandler(e.ListBondPositions, mux, decoder, encoder, errhandler, formatter),
+		GetCashFlowLadder:         NewGetCashFlowLadderHandler(e.GetCashFlowLadder, mux, decoder, encoder, errhandler, formatter),
+		ListOptionPositions:       NewListOptionPositionsHandler(e.ListOptionPositions, mux, decoder, encoder, errhandler, formatter),
+		SettleOptionExpiries:      NewSettleOptionExpiriesHandler(e.SettleOptionExpiries, mux, decoder, encoder, errhandler, formatter),
+		SetMarginAccount:          NewSetMarginAccountHandler(e.SetMarginAccount, mux, decoder, encoder, errhandler, formatter),
+		GetMarginStatus:           NewGetMarginStatusHandler(e.GetMarginStatus, mux, decoder, encoder, errhandler, formatter),
+		ChargeMargin:              NewChargeMarginHandler(e.ChargeMargin, mux, decoder, encoder, errhandler, formatter),
+		ListEvents:                NewListEventsHandler(e.ListEvents, mux, decoder, encoder, errhandler, formatter),
+		PlaceOrder:                NewPlaceOrderHandler(e.PlaceOrder, mux, decoder, encoder, errhandler, formatter),
+		ListOrders:                NewListOrdersHandler(e.ListOrders, mux, decoder, encoder, errhandler, formatter),
+		CancelOrder:               NewCancelOrderHandler(e.CancelOrder, mux, decoder, encoder, errhandler, formatter),
+		PreviewTrades:             NewPreviewTradesHandler(e.PreviewTrades, mux, decoder, encoder, errhandler, formatter),
+		RunBacktest:               NewRunBacktestHandler(e.RunBacktest, mux, decoder, encoder, errhandler, formatter),
+		ImportCsv:                 NewImportCsvHandler(e.ImportCsv, mux, decoder, encoder, errhandler, formatter),
+		ImportOfx:                 NewImportOfxHandler(e.ImportOfx, mux, decoder, encoder, errhandler, formatter),
+		ExportJournal:             NewExportJournalHandler(e.ExportJournal, mux, decoder, encoder, errhandler, formatter),
+		ImportJournal:             NewImportJournalHandler(e.ImportJournal, mux, decoder, encoder, errhandler, formatter),
+		ExportArchive:             NewExportArchiveHandler(e.ExportArchive, mux, decoder, encoder, errhandler, formatter),
+		ImportArchive:             NewImportArchiveHandler(e.ImportArchive, mux, decoder, encoder, errhandler, formatter),
+		ReconcilePositions:        NewReconcilePositionsHandler(e.ReconcilePositions, mux, decoder, encoder, errhandler, formatter),
+		ListReconciliationBreaks:  NewListReconciliationBreaksHandler(e.ListReconciliationBreaks, mux, decoder, encoder, errhandler, formatter),
+		UpdateReconciliationBreak: NewUpdateReconciliationBreakHandler(e.UpdateReconciliationBreak, mux, decoder, encoder, errhandler, formatter),
+		GetTaxReport:              NewGetTaxReportHandler(e.GetTaxReport, mux, decoder, encoder, errhandler, formatter),
+		ExportTaxReport:           NewExportTaxReportHandler(e.ExportTaxReport, mux, decoder, encoder, errhandler, formatter),
+		ListLots:                  NewListLotsHandler(e.ListLots, mux, decoder, encoder, errhandler, formatter),
+		FindTaxLossHarvests:       NewFindTaxLossHarvestsHandler(e.FindTaxLossHarvests, mux, decoder, encoder, errhandler, formatter),
+		ListAccounts:              NewListAccountsHandler(e.ListAccounts, mux, decoder, encoder, errhandler, formatter),
+		SetAccount:                NewSetAccountHandler(e.SetAccount, mux, decoder, encoder, errhandler, formatter),
+		RemoveAccount:             NewRemoveAccountHandler(e.RemoveAccount, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -62,6 +200,52 @@ func (s *Server) Service() string { return "portfolio" }
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetPortfolioSummary = m(s.GetPortfolioSummary)
+	s.GetReturns = m(s.GetReturns)
+	s.GetAllocation = m(s.GetAllocation)
+	s.GetTargetAllocation = m(s.GetTargetAllocation)
+	s.SetTargetAllocation = m(s.SetTargetAllocation)
+	s.ProposeRebalance = m(s.ProposeRebalance)
+	s.SetBenchmark = m(s.SetBenchmark)
+	s.GetBenchmarkComparison = m(s.GetBenchmarkComparison)
+	s.GetRiskMetrics = m(s.GetRiskMetrics)
+	s.GetValueAtRisk = m(s.GetValueAtRisk)
+	s.ListStressScenarios = m(s.ListStressScenarios)
+	s.RunStressTest = m(s.RunStressTest)
+	s.ProjectPortfolio = m(s.ProjectPortfolio)
+	s.ListCorporateActions = m(s.ListCorporateActions)
+	s.ApplyCorporateActions = m(s.ApplyCorporateActions)
+	s.RecordIncome = m(s.RecordIncome)
+	s.GetIncome = m(s.GetIncome)
+	s.PriceBond = m(s.PriceBond)
+	s.ListBondPositions = m(s.ListBondPositions)
+	s.GetCashFlowLadder = m(s.GetCashFlowLadder)
+	s.ListOptionPositions = m(s.ListOptionPositions)
+	s.SettleOptionExpiries = m(s.SettleOptionExpiries)
+	s.SetMarginAccount = m(s.SetMarginAccount)
+	s.GetMarginStatus = m(s.GetMarginStatus)
+	s.ChargeMargin = m(s.ChargeMargin)
+	s.ListEvents = m(s.ListEvents)
+	s.PlaceOrder = m(s.PlaceOrder)
+	s.ListOrders = m(s.ListOrders)
+	s.CancelOrder = m(s.CancelOrder)
+	s.PreviewTrades = m(s.PreviewTrades)
+	s.RunBacktest = m(s.RunBacktest)
+	s.ImportCsv = m(s.ImportCsv)
+	s.ImportOfx = m(s.ImportOfx)
+	s.ExportJournal = m(s.ExportJournal)
+	s.ImportJournal = m(s.ImportJournal)
+	s.ExportArchive = m(s.ExportArchive)
+	s.ImportArchive = m(s.ImportArchive)
+	s.ReconcilePositions = m(s.ReconcilePositions)
+	s.ListReconciliationBreaks = m(s.ListReconciliationBreaks)
+	s.UpdateReconciliationBreak = m(s.UpdateReconciliationBreak)
+	s.GetTaxReport = m(s.GetTaxReport)
+	s.ExportTaxReport = m(s.ExportTaxReport)
+	s.ListLots = m(s.ListLots)
+	s.FindTaxLossHarvests = m(s.FindTaxLossHarvests)
+	s.ListAccounts = m(s.ListAccounts)
+	s.SetAccount = m(s.SetAccount)
+	s.RemoveAccount = m(s.RemoveAccount)
 }
 
 // MethodNames returns the methods served.
@@ -70,6 +254,52 @@ func (s *Server) MethodNames() []string { return portfolio.MethodNames[:] }
 // Mount configures the mux to serve the portfolio endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetPortfolioSummaryHandler(mux, h.GetPortfolioSummary)
+	MountGetReturnsHandler(mux, h.GetReturns)
+	MountGetAllocationHandler(mux, h.GetAllocation)
+	MountGetTargetAllocationHandler(mux, h.GetTargetAllocation)
+	MountSetTargetAllocationHandler(mux, h.SetTargetAllocation)
+	MountProposeRebalanceHandler(mux, h.ProposeRebalance)
+	MountSetBenchmarkHandler(mux, h.SetBenchmark)
+	MountGetBenchmarkComparisonHandler(mux, h.GetBenchmarkComparison)
+	MountGetRiskMetricsHandler(mux, h.GetRiskMetrics)
+	MountGetValueAtRiskHandler(mux, h.GetValueAtRisk)
+	MountListStressScenariosHandler(mux, h.ListStressScenarios)
+	MountRunStressTestHandler(mux, h.RunStressTest)
+	MountProjectPortfolioHandler(mux, h.ProjectPortfolio)
+	MountListCorporateActionsHandler(mux, h.ListCorporateActions)
+	MountApplyCorporateActionsHandler(mux, h.ApplyCorporateActions)
+	MountRecordIncomeHandler(mux, h.RecordIncome)
+	MountGetIncomeHandler(mux, h.GetIncome)
+	MountPriceBondHandler(mux, h.PriceBond)
+	MountListBondPositionsHandler(mux, h.ListBondPositions)
+	MountGetCashFlowLadderHandler(mux, h.GetCashFlowLadder)
+	MountListOptionPositionsHandler(mux, h.ListOptionPositions)
+	MountSettleOptionExpiriesHandler(mux, h.SettleOptionExpiries)
+	MountSetMarginAccountHandler(mux, h.SetMarginAccount)
+	MountGetMarginStatusHandler(mux, h.GetMarginStatus)
+	MountChargeMarginHandler(mux, h.ChargeMargin)
+	MountListEventsHandler(mux, h.ListEvents)
+	MountPlaceOrderHandler(mux, h.PlaceOrder)
+	MountListOrdersHandler(mux, h.ListOrders)
+	MountCancelOrderHandler(mux, h.CancelOrder)
+	MountPreviewTradesHandler(mux, h.PreviewTrades)
+	MountRunBacktestHandler(mux, h.RunBacktest)
+	MountImportCsvHandler(mux, h.ImportCsv)
+	MountImportOfxHandler(mux, h.ImportOfx)
+	MountExportJournalHandler(mux, h.ExportJournal)
+	MountImportJournalHandler(mux, h.ImportJournal)
+	MountExportArchiveHandler(mux, h.ExportArchive)
+	MountImportArchiveHandler(mux, h.ImportArchive)
+	MountReconcilePositionsHandler(mux, h.ReconcilePositions)
+	MountListReconciliationBreaksHandler(mux, h.ListReconciliationBreaks)
+	MountUpdateReconciliationBreakHandler(mux, h.UpdateReconciliationBreak)
+	MountGetTaxReportHandler(mux, h.GetTaxReport)
+	MountExportTaxReportHandler(mux, h.ExportTaxReport)
+	MountListLotsHandler(mux, h.ListLots)
+	MountFindTaxLossHarvestsHandler(mux, h.FindTaxLossHarvests)
+	MountListAccountsHandler(mux, h.ListAccounts)
+	MountSetAccountHandler(mux, h.SetAccount)
+	MountRemoveAccountHandler(mux, h.RemoveAccount)
 }
 
 // Mount configures the mux to serve the portfolio endpoints.
@@ -100,13 +330,543 @@ func NewGetPortfolioSummaryHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
+		decodeRequest  = DecodeGetPortfolioSummaryRequest(mux, decoder)
 		encodeResponse = EncodeGetPortfolioSummaryResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeGetPortfolioSummaryError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
 		ctx = context.WithValue(ctx, goa.MethodKey, "getPortfolioSummary")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetReturnsHandler configures the mux to serve the "portfolio" service
+// "getReturns" endpoint.
+func MountGetReturnsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/returns", f)
+}
+
+// NewGetReturnsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "portfolio" service "getReturns" endpoint.
+func NewGetReturnsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetReturnsRequest(mux, decoder)
+		encodeResponse = EncodeGetReturnsResponse(encoder)
+		encodeError    = EncodeGetReturnsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getReturns")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetAllocationHandler configures the mux to serve the "portfolio"
+// service "getAllocation" endpoint.
+func MountGetAllocationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/allocation", f)
+}
+
+// NewGetAllocationHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "getAllocation" endpoint.
+func NewGetAllocationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAllocationRequest(mux, decoder)
+		encodeResponse = EncodeGetAllocationResponse(encoder)
+		encodeError    = EncodeGetAllocationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getAllocation")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetTargetAllocationHandler configures the mux to serve the "portfolio"
+// service "getTargetAllocation" endpoint.
+func MountGetTargetAllocationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/targets", f)
+}
+
+// NewGetTargetAllocationHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "getTargetAllocation" endpoint.
+func NewGetTargetAllocationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetTargetAllocationRequest(mux, decoder)
+		encodeResponse = EncodeGetTargetAllocationResponse(encoder)
+		encodeError    = EncodeGetTargetAllocationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getTargetAllocation")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSetTargetAllocationHandler configures the mux to serve the "portfolio"
+// service "setTargetAllocation" endpoint.
+func MountSetTargetAllocationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/portfolio/targets", f)
+}
+
+// NewSetTargetAllocationHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "setTargetAllocation" endpoint.
+func NewSetTargetAllocationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetTargetAllocationRequest(mux, decoder)
+		encodeResponse = EncodeSetTargetAllocationResponse(encoder)
+		encodeError    = EncodeSetTargetAllocationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "setTargetAllocation")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountProposeRebalanceHandler configures the mux to serve the "portfolio"
+// service "proposeRebalance" endpoint.
+func MountProposeRebalanceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/rebalance", f)
+}
+
+// NewProposeRebalanceHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "proposeRebalance" endpoint.
+func NewProposeRebalanceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeProposeRebalanceRequest(mux, decoder)
+		encodeResponse = EncodeProposeRebalanceResponse(encoder)
+		encodeError    = EncodeProposeRebalanceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "proposeRebalance")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSetBenchmarkHandler configures the mux to serve the "portfolio" service
+// "setBenchmark" endpoint.
+func MountSetBenchmarkHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/portfolio/benchmark", f)
+}
+
+// NewSetBenchmarkHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "setBenchmark" endpoint.
+func NewSetBenchmarkHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetBenchmarkRequest(mux, decoder)
+		encodeResponse = EncodeSetBenchmarkResponse(encoder)
+		encodeError    = EncodeSetBenchmarkError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "setBenchmark")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetBenchmarkComparisonHandler configures the mux to serve the
+// "portfolio" service "getBenchmarkComparison" endpoint.
+func MountGetBenchmarkComparisonHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/benchmark/comparison", f)
+}
+
+// NewGetBenchmarkComparisonHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "getBenchmarkComparison" endpoint.
+func NewGetBenchmarkComparisonHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetBenchmarkComparisonRequest(mux, decoder)
+		encodeResponse = EncodeGetBenchmarkComparisonResponse(encoder)
+		encodeError    = EncodeGetBenchmarkComparisonError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getBenchmarkComparison")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetRiskMetricsHandler configures the mux to serve the "portfolio"
+// service "getRiskMetrics" endpoint.
+func MountGetRiskMetricsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/risk", f)
+}
+
+// NewGetRiskMetricsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "getRiskMetrics" endpoint.
+func NewGetRiskMetricsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetRiskMetricsRequest(mux, decoder)
+		encodeResponse = EncodeGetRiskMetricsResponse(encoder)
+		encodeError    = EncodeGetRiskMetricsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getRiskMetrics")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetValueAtRiskHandler configures the mux to serve the "portfolio"
+// service "getValueAtRisk" endpoint.
+func MountGetValueAtRiskHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/var", f)
+}
+
+// NewGetValueAtRiskHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "getValueAtRisk" endpoint.
+func NewGetValueAtRiskHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetValueAtRiskRequest(mux, decoder)
+		encodeResponse = EncodeGetValueAtRiskResponse(encoder)
+		encodeError    = EncodeGetValueAtRiskError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getValueAtRisk")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListStressScenariosHandler configures the mux to serve the "portfolio"
+// service "listStressScenarios" endpoint.
+func MountListStressScenariosHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/stress/scenarios", f)
+}
+
+// NewListStressScenariosHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "listStressScenarios" endpoint.
+func NewListStressScenariosHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeListStressScenariosResponse(encoder)
+		encodeError    = EncodeListStressScenariosError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listStressScenarios")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
 		var err error
 		res, err := endpoint(ctx, nil)
 		if err != nil {
@@ -122,3 +882,1913 @@ func NewGetPortfolioSummaryHandler(
 		}
 	})
 }
+
+// MountRunStressTestHandler configures the mux to serve the "portfolio"
+// service "runStressTest" endpoint.
+func MountRunStressTestHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/stress", f)
+}
+
+// NewRunStressTestHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "runStressTest" endpoint.
+func NewRunStressTestHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRunStressTestRequest(mux, decoder)
+		encodeResponse = EncodeRunStressTestResponse(encoder)
+		encodeError    = EncodeRunStressTestError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "runStressTest")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountProjectPortfolioHandler configures the mux to serve the "portfolio"
+// service "projectPortfolio" endpoint.
+func MountProjectPortfolioHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/projection", f)
+}
+
+// NewProjectPortfolioHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "projectPortfolio" endpoint.
+func NewProjectPortfolioHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeProjectPortfolioRequest(mux, decoder)
+		encodeResponse = EncodeProjectPortfolioResponse(encoder)
+		encodeError    = EncodeProjectPortfolioError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "projectPortfolio")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListCorporateActionsHandler configures the mux to serve the "portfolio"
+// service "listCorporateActions" endpoint.
+func MountListCorporateActionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/corporate-actions", f)
+}
+
+// NewListCorporateActionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "listCorporateActions" endpoint.
+func NewListCorporateActionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListCorporateActionsRequest(mux, decoder)
+		encodeResponse = EncodeListCorporateActionsResponse(encoder)
+		encodeError    = EncodeListCorporateActionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listCorporateActions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountApplyCorporateActionsHandler configures the mux to serve the
+// "portfolio" service "applyCorporateActions" endpoint.
+func MountApplyCorporateActionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/corporate-actions", f)
+}
+
+// NewApplyCorporateActionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "applyCorporateActions" endpoint.
+func NewApplyCorporateActionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeApplyCorporateActionsRequest(mux, decoder)
+		encodeResponse = EncodeApplyCorporateActionsResponse(encoder)
+		encodeError    = EncodeApplyCorporateActionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "applyCorporateActions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRecordIncomeHandler configures the mux to serve the "portfolio" service
+// "recordIncome" endpoint.
+func MountRecordIncomeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/income", f)
+}
+
+// NewRecordIncomeHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "recordIncome" endpoint.
+func NewRecordIncomeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRecordIncomeRequest(mux, decoder)
+		encodeResponse = EncodeRecordIncomeResponse(encoder)
+		encodeError    = EncodeRecordIncomeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "recordIncome")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetIncomeHandler configures the mux to serve the "portfolio" service
+// "getIncome" endpoint.
+func MountGetIncomeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/income", f)
+}
+
+// NewGetIncomeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "portfolio" service "getIncome" endpoint.
+func NewGetIncomeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetIncomeRequest(mux, decoder)
+		encodeResponse = EncodeGetIncomeResponse(encoder)
+		encodeError    = EncodeGetIncomeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getIncome")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPriceBondHandler configures the mux to serve the "portfolio" service
+// "priceBond" endpoint.
+func MountPriceBondHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/bonds/price", f)
+}
+
+// NewPriceBondHandler creates a HTTP handler which loads the HTTP request and
+// calls the "portfolio" service "priceBond" endpoint.
+func NewPriceBondHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePriceBondRequest(mux, decoder)
+		encodeResponse = EncodePriceBondResponse(encoder)
+		encodeError    = EncodePriceBondError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "priceBond")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListBondPositionsHandler configures the mux to serve the "portfolio"
+// service "listBondPositions" endpoint.
+func MountListBondPositionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/bonds", f)
+}
+
+// NewListBondPositionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "listBondPositions" endpoint.
+func NewListBondPositionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListBondPositionsRequest(mux, decoder)
+		encodeResponse = EncodeListBondPositionsResponse(encoder)
+		encodeError    = EncodeListBondPositionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listBondPositions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetCashFlowLadderHandler configures the mux to serve the "portfolio"
+// service "getCashFlowLadder" endpoint.
+func MountGetCashFlowLadderHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/bonds/ladder", f)
+}
+
+// NewGetCashFlowLadderHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "getCashFlowLadder" endpoint.
+func NewGetCashFlowLadderHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetCashFlowLadderRequest(mux, decoder)
+		encodeResponse = EncodeGetCashFlowLadderResponse(encoder)
+		encodeError    = EncodeGetCashFlowLadderError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getCashFlowLadder")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListOptionPositionsHandler configures the mux to serve the "portfolio"
+// service "listOptionPositions" endpoint.
+func MountListOptionPositionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/options", f)
+}
+
+// NewListOptionPositionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "listOptionPositions" endpoint.
+func NewListOptionPositionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListOptionPositionsRequest(mux, decoder)
+		encodeResponse = EncodeListOptionPositionsResponse(encoder)
+		encodeError    = EncodeListOptionPositionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listOptionPositions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSettleOptionExpiriesHandler configures the mux to serve the "portfolio"
+// service "settleOptionExpiries" endpoint.
+func MountSettleOptionExpiriesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/options/settle", f)
+}
+
+// NewSettleOptionExpiriesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "settleOptionExpiries" endpoint.
+func NewSettleOptionExpiriesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSettleOptionExpiriesRequest(mux, decoder)
+		encodeResponse = EncodeSettleOptionExpiriesResponse(encoder)
+		encodeError    = EncodeSettleOptionExpiriesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "settleOptionExpiries")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSetMarginAccountHandler configures the mux to serve the "portfolio"
+// service "setMarginAccount" endpoint.
+func MountSetMarginAccountHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/portfolio/margin/accounts", f)
+}
+
+// NewSetMarginAccountHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "setMarginAccount" endpoint.
+func NewSetMarginAccountHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetMarginAccountRequest(mux, decoder)
+		encodeResponse = EncodeSetMarginAccountResponse(encoder)
+		encodeError    = EncodeSetMarginAccountError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "setMarginAccount")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetMarginStatusHandler configures the mux to serve the "portfolio"
+// service "getMarginStatus" endpoint.
+func MountGetMarginStatusHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/margin", f)
+}
+
+// NewGetMarginStatusHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "getMarginStatus" endpoint.
+func NewGetMarginStatusHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetMarginStatusRequest(mux, decoder)
+		encodeResponse = EncodeGetMarginStatusResponse(encoder)
+		encodeError    = EncodeGetMarginStatusError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getMarginStatus")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountChargeMarginHandler configures the mux to serve the "portfolio" service
+// "chargeMargin" endpoint.
+func MountChargeMarginHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/margin/charge", f)
+}
+
+// NewChargeMarginHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "chargeMargin" endpoint.
+func NewChargeMarginHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeChargeMarginRequest(mux, decoder)
+		encodeResponse = EncodeChargeMarginResponse(encoder)
+		encodeError    = EncodeChargeMarginError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "chargeMargin")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListEventsHandler configures the mux to serve the "portfolio" service
+// "listEvents" endpoint.
+func MountListEventsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/events", f)
+}
+
+// NewListEventsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "portfolio" service "listEvents" endpoint.
+func NewListEventsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListEventsRequest(mux, decoder)
+		encodeResponse = EncodeListEventsResponse(encoder)
+		encodeError    = EncodeListEventsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listEvents")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPlaceOrderHandler configures the mux to serve the "portfolio" service
+// "placeOrder" endpoint.
+func MountPlaceOrderHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/orders", f)
+}
+
+// NewPlaceOrderHandler creates a HTTP handler which loads the HTTP request and
+// calls the "portfolio" service "placeOrder" endpoint.
+func NewPlaceOrderHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePlaceOrderRequest(mux, decoder)
+		encodeResponse = EncodePlaceOrderResponse(encoder)
+		encodeError    = EncodePlaceOrderError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "placeOrder")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListOrdersHandler configures the mux to serve the "portfolio" service
+// "listOrders" endpoint.
+func MountListOrdersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/orders", f)
+}
+
+// NewListOrdersHandler creates a HTTP handler which loads the HTTP request and
+// calls the "portfolio" service "listOrders" endpoint.
+func NewListOrdersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListOrdersRequest(mux, decoder)
+		encodeResponse = EncodeListOrdersResponse(encoder)
+		encodeError    = EncodeListOrdersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listOrders")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCancelOrderHandler configures the mux to serve the "portfolio" service
+// "cancelOrder" endpoint.
+func MountCancelOrderHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/orders/cancel", f)
+}
+
+// NewCancelOrderHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "cancelOrder" endpoint.
+func NewCancelOrderHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCancelOrderRequest(mux, decoder)
+		encodeResponse = EncodeCancelOrderResponse(encoder)
+		encodeError    = EncodeCancelOrderError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "cancelOrder")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPreviewTradesHandler configures the mux to serve the "portfolio"
+// service "previewTrades" endpoint.
+func MountPreviewTradesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/trades/preview", f)
+}
+
+// NewPreviewTradesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "previewTrades" endpoint.
+func NewPreviewTradesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePreviewTradesRequest(mux, decoder)
+		encodeResponse = EncodePreviewTradesResponse(encoder)
+		encodeError    = EncodePreviewTradesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "previewTrades")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRunBacktestHandler configures the mux to serve the "portfolio" service
+// "runBacktest" endpoint.
+func MountRunBacktestHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/backtest", f)
+}
+
+// NewRunBacktestHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "runBacktest" endpoint.
+func NewRunBacktestHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRunBacktestRequest(mux, decoder)
+		encodeResponse = EncodeRunBacktestResponse(encoder)
+		encodeError    = EncodeRunBacktestError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "runBacktest")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountImportCsvHandler configures the mux to serve the "portfolio" service
+// "importCsv" endpoint.
+func MountImportCsvHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/import/csv", f)
+}
+
+// NewImportCsvHandler creates a HTTP handler which loads the HTTP request and
+// calls the "portfolio" service "importCsv" endpoint.
+func NewImportCsvHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeImportCsvRequest(mux, decoder)
+		encodeResponse = EncodeImportCsvResponse(encoder)
+		encodeError    = EncodeImportCsvError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "importCsv")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountImportOfxHandler configures the mux to serve the "portfolio" service
+// "importOfx" endpoint.
+func MountImportOfxHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/import/ofx", f)
+}
+
+// NewImportOfxHandler creates a HTTP handler which loads the HTTP request and
+// calls the "portfolio" service "importOfx" endpoint.
+func NewImportOfxHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeImportOfxRequest(mux, decoder)
+		encodeResponse = EncodeImportOfxResponse(encoder)
+		encodeError    = EncodeImportOfxError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "importOfx")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountExportJournalHandler configures the mux to serve the "portfolio"
+// service "exportJournal" endpoint.
+func MountExportJournalHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/export/journal", f)
+}
+
+// NewExportJournalHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "exportJournal" endpoint.
+func NewExportJournalHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExportJournalRequest(mux, decoder)
+		encodeResponse = EncodeExportJournalResponse(encoder)
+		encodeError    = EncodeExportJournalError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "exportJournal")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountImportJournalHandler configures the mux to serve the "portfolio"
+// service "importJournal" endpoint.
+func MountImportJournalHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/import/journal", f)
+}
+
+// NewImportJournalHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "importJournal" endpoint.
+func NewImportJournalHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeImportJournalRequest(mux, decoder)
+		encodeResponse = EncodeImportJournalResponse(encoder)
+		encodeError    = EncodeImportJournalError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "importJournal")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountExportArchiveHandler configures the mux to serve the "portfolio"
+// service "exportArchive" endpoint.
+func MountExportArchiveHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/export/archive", f)
+}
+
+// NewExportArchiveHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "exportArchive" endpoint.
+func NewExportArchiveHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExportArchiveRequest(mux, decoder)
+		encodeResponse = EncodeExportArchiveResponse(encoder)
+		encodeError    = EncodeExportArchiveError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "exportArchive")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountImportArchiveHandler configures the mux to serve the "portfolio"
+// service "importArchive" endpoint.
+func MountImportArchiveHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/import/archive", f)
+}
+
+// NewImportArchiveHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "importArchive" endpoint.
+func NewImportArchiveHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeImportArchiveRequest(mux, decoder)
+		encodeResponse = EncodeImportArchiveResponse(encoder)
+		encodeError    = EncodeImportArchiveError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "importArchive")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountReconcilePositionsHandler configures the mux to serve the "portfolio"
+// service "reconcilePositions" endpoint.
+func MountReconcilePositionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/reconcile", f)
+}
+
+// NewReconcilePositionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "reconcilePositions" endpoint.
+func NewReconcilePositionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReconcilePositionsRequest(mux, decoder)
+		encodeResponse = EncodeReconcilePositionsResponse(encoder)
+		encodeError    = EncodeReconcilePositionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "reconcilePositions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListReconciliationBreaksHandler configures the mux to serve the
+// "portfolio" service "listReconciliationBreaks" endpoint.
+func MountListReconciliationBreaksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/reconcile/breaks", f)
+}
+
+// NewListReconciliationBreaksHandler creates a HTTP handler which loads the
+// HTTP request and calls the "portfolio" service "listReconciliationBreaks"
+// endpoint.
+func NewListReconciliationBreaksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListReconciliationBreaksRequest(mux, decoder)
+		encodeResponse = EncodeListReconciliationBreaksResponse(encoder)
+		encodeError    = EncodeListReconciliationBreaksError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listReconciliationBreaks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateReconciliationBreakHandler configures the mux to serve the
+// "portfolio" service "updateReconciliationBreak" endpoint.
+func MountUpdateReconciliationBreakHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/reconcile/breaks/status", f)
+}
+
+// NewUpdateReconciliationBreakHandler creates a HTTP handler which loads the
+// HTTP request and calls the "portfolio" service "updateReconciliationBreak"
+// endpoint.
+func NewUpdateReconciliationBreakHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateReconciliationBreakRequest(mux, decoder)
+		encodeResponse = EncodeUpdateReconciliationBreakResponse(encoder)
+		encodeError    = EncodeUpdateReconciliationBreakError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "updateReconciliationBreak")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetTaxReportHandler configures the mux to serve the "portfolio" service
+// "getTaxReport" endpoint.
+func MountGetTaxReportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/tax", f)
+}
+
+// NewGetTaxReportHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "getTaxReport" endpoint.
+func NewGetTaxReportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetTaxReportRequest(mux, decoder)
+		encodeResponse = EncodeGetTaxReportResponse(encoder)
+		encodeError    = EncodeGetTaxReportError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getTaxReport")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountExportTaxReportHandler configures the mux to serve the "portfolio"
+// service "exportTaxReport" endpoint.
+func MountExportTaxReportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/export/tax", f)
+}
+
+// NewExportTaxReportHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "exportTaxReport" endpoint.
+func NewExportTaxReportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExportTaxReportRequest(mux, decoder)
+		encodeResponse = EncodeExportTaxReportResponse(encoder)
+		encodeError    = EncodeExportTaxReportError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "exportTaxReport")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListLotsHandler configures the mux to serve the "portfolio" service
+// "listLots" endpoint.
+func MountListLotsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/lots", f)
+}
+
+// NewListLotsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "portfolio" service "listLots" endpoint.
+func NewListLotsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListLotsRequest(mux, decoder)
+		encodeResponse = EncodeListLotsResponse(encoder)
+		encodeError    = EncodeListLotsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listLots")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountFindTaxLossHarvestsHandler configures the mux to serve the "portfolio"
+// service "findTaxLossHarvests" endpoint.
+func MountFindTaxLossHarvestsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/tax/harvest", f)
+}
+
+// NewFindTaxLossHarvestsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "portfolio" service "findTaxLossHarvests" endpoint.
+func NewFindTaxLossHarvestsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeFindTaxLossHarvestsRequest(mux, decoder)
+		encodeResponse = EncodeFindTaxLossHarvestsResponse(encoder)
+		encodeError    = EncodeFindTaxLossHarvestsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "findTaxLossHarvests")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListAccountsHandler configures the mux to serve the "portfolio" service
+// "listAccounts" endpoint.
+func MountListAccountsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/portfolio/accounts", f)
+}
+
+// NewListAccountsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "listAccounts" endpoint.
+func NewListAccountsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListAccountsRequest(mux, decoder)
+		encodeResponse = EncodeListAccountsResponse(encoder)
+		encodeError    = EncodeListAccountsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listAccounts")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSetAccountHandler configures the mux to serve the "portfolio" service
+// "setAccount" endpoint.
+func MountSetAccountHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/portfolio/accounts", f)
+}
+
+// NewSetAccountHandler creates a HTTP handler which loads the HTTP request and
+// calls the "portfolio" service "setAccount" endpoint.
+func NewSetAccountHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetAccountRequest(mux, decoder)
+		encodeResponse = EncodeSetAccountResponse(encoder)
+		encodeError    = EncodeSetAccountError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "setAccount")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRemoveAccountHandler configures the mux to serve the "portfolio"
+// service "removeAccount" endpoint.
+func MountRemoveAccountHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/portfolio/accounts/remove", f)
+}
+
+// NewRemoveAccountHandler creates a HTTP handler which loads the HTTP request
+// and calls the "portfolio" service "removeAccount" endpoint.
+func NewRemoveAccountHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRemoveAccountRequest(mux, decoder)
+		encodeResponse = EncodeRemoveAccountResponse(encoder)
+		encodeError    = EncodeRemoveAccountError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "removeAccount")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "portfolio")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -9,9 +9,242 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	portfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// SetBenchmarkRequestBody is the type of the "portfolio" service
+// "setBenchmark" endpoint HTTP request body.
+type SetBenchmarkRequestBody struct {
+	// Display name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Blend components; weights must sum to 1
+	Components []*BenchmarkComponentRequestBodyRequestBody `form:"components,omitempty" json:"components,omitempty" xml:"components,omitempty"`
+	// Blend in the form 60% SPY / 40% AGG; set either components or blend
+	Blend *string `form:"blend,omitempty" json:"blend,omitempty" xml:"blend,omitempty"`
+	// How often the blend is reset to its weights; none keeps static initial
+	// weights
+	Rebalance *string `form:"rebalance,omitempty" json:"rebalance,omitempty" xml:"rebalance,omitempty"`
+}
+
+// ProjectPortfolioRequestBody is the type of the "portfolio" service
+// "projectPortfolio" endpoint HTTP request body.
+type ProjectPortfolioRequestBody struct {
+	// Last day of the projection
+	End *string `form:"end,omitempty" json:"end,omitempty" xml:"end,omitempty"`
+	// Starting value; defaults to the current portfolio value
+	StartValue *float64 `form:"start_value,omitempty" json:"start_value,omitempty" xml:"start_value,omitempty"`
+	// Monthly contribution in today's money
+	MonthlyContribution *float64 `form:"monthly_contribution,omitempty" json:"monthly_contribution,omitempty" xml:"monthly_contribution,omitempty"`
+	// Monthly withdrawal in today's money
+	MonthlyWithdrawal *float64 `form:"monthly_withdrawal,omitempty" json:"monthly_withdrawal,omitempty" xml:"monthly_withdrawal,omitempty"`
+	// Date withdrawals begin, e.g. retirement; defaults to today
+	WithdrawalStart *string `form:"withdrawal_start,omitempty" json:"withdrawal_start,omitempty" xml:"withdrawal_start,omitempty"`
+	// Annual inflation rate
+	Inflation *float64 `form:"inflation,omitempty" json:"inflation,omitempty" xml:"inflation,omitempty"`
+	// Goal amount in today's money
+	Goal *float64 `form:"goal,omitempty" json:"goal,omitempty" xml:"goal,omitempty"`
+	// Date the goal should be reached by; defaults to the end
+	GoalDate *string `form:"goal_date,omitempty" json:"goal_date,omitempty" xml:"goal_date,omitempty"`
+	// Assumptions overriding the defaults per asset class
+	Assumptions []*AssetClassAssumptionRequestBody `form:"assumptions,omitempty" json:"assumptions,omitempty" xml:"assumptions,omitempty"`
+	// Number of simulated paths
+	Paths *int `form:"paths,omitempty" json:"paths,omitempty" xml:"paths,omitempty"`
+	// Seed for reproducible results
+	Seed *int64 `form:"seed,omitempty" json:"seed,omitempty" xml:"seed,omitempty"`
+}
+
+// ApplyCorporateActionsRequestBody is the type of the "portfolio" service
+// "applyCorporateActions" endpoint HTTP request body.
+type ApplyCorporateActionsRequestBody struct {
+	// Actions to apply
+	Actions []*CorporateActionInputRequestBody `form:"actions,omitempty" json:"actions,omitempty" xml:"actions,omitempty"`
+}
+
+// RecordIncomeRequestBody is the type of the "portfolio" service
+// "recordIncome" endpoint HTTP request body.
+type RecordIncomeRequestBody struct {
+	// Income type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Paying instrument; required for dividends
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Account credited
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Pay date
+	PayDate *string `form:"pay_date,omitempty" json:"pay_date,omitempty" xml:"pay_date,omitempty"`
+	// Ex-dividend date
+	ExDate *string `form:"ex_date,omitempty" json:"ex_date,omitempty" xml:"ex_date,omitempty"`
+	// Gross amount
+	Amount *float64 `form:"amount,omitempty" json:"amount,omitempty" xml:"amount,omitempty"`
+	// Tax withheld at source
+	Withholding *float64 `form:"withholding,omitempty" json:"withholding,omitempty" xml:"withholding,omitempty"`
+	// Dividend taxed at qualified rates
+	Qualified *bool `form:"qualified,omitempty" json:"qualified,omitempty" xml:"qualified,omitempty"`
+	// Reinvest the net dividend in the paying instrument
+	Reinvest *bool `form:"reinvest,omitempty" json:"reinvest,omitempty" xml:"reinvest,omitempty"`
+	// Reinvestment price; defaults to the pay-date close
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Free-form note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// PriceBondRequestBody is the type of the "portfolio" service "priceBond"
+// endpoint HTTP request body.
+type PriceBondRequestBody struct {
+	// Annual coupon rate as a decimal fraction
+	Coupon *float64 `form:"coupon,omitempty" json:"coupon,omitempty" xml:"coupon,omitempty"`
+	// Coupons per year; 0 for zero-coupon bonds
+	CouponFrequency *int `form:"coupon_frequency,omitempty" json:"coupon_frequency,omitempty" xml:"coupon_frequency,omitempty"`
+	// Day count convention
+	DayCount *string `form:"day_count,omitempty" json:"day_count,omitempty" xml:"day_count,omitempty"`
+	// Maturity date
+	Maturity *string `form:"maturity,omitempty" json:"maturity,omitempty" xml:"maturity,omitempty"`
+	// Face value per bond
+	FaceValue *float64 `form:"face_value,omitempty" json:"face_value,omitempty" xml:"face_value,omitempty"`
+	// Settlement date; defaults to today
+	Settlement *string `form:"settlement,omitempty" json:"settlement,omitempty" xml:"settlement,omitempty"`
+	// Clean price per bond
+	CleanPrice *float64 `form:"clean_price,omitempty" json:"clean_price,omitempty" xml:"clean_price,omitempty"`
+	// Yield to maturity
+	Yield *float64 `form:"yield,omitempty" json:"yield,omitempty" xml:"yield,omitempty"`
+}
+
+// SetMarginAccountRequestBody is the type of the "portfolio" service
+// "setMarginAccount" endpoint HTTP request body.
+type SetMarginAccountRequestBody struct {
+	// Account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Equity required to open positions, as a share of their value
+	InitialRequirement *float64 `form:"initial_requirement,omitempty" json:"initial_requirement,omitempty" xml:"initial_requirement,omitempty"`
+	// Equity required to keep long positions
+	MaintenanceRequirement *float64 `form:"maintenance_requirement,omitempty" json:"maintenance_requirement,omitempty" xml:"maintenance_requirement,omitempty"`
+	// Equity required to keep short positions
+	ShortMaintenanceRequirement *float64 `form:"short_maintenance_requirement,omitempty" json:"short_maintenance_requirement,omitempty" xml:"short_maintenance_requirement,omitempty"`
+	// Annual interest rate on the margin loan
+	LoanRate *float64 `form:"loan_rate,omitempty" json:"loan_rate,omitempty" xml:"loan_rate,omitempty"`
+	// Annual fee for borrowing shares held short
+	BorrowRate *float64 `form:"borrow_rate,omitempty" json:"borrow_rate,omitempty" xml:"borrow_rate,omitempty"`
+	// Borrow fee rates by symbol overriding borrow_rate
+	BorrowRates map[string]float64 `form:"borrow_rates,omitempty" json:"borrow_rates,omitempty" xml:"borrow_rates,omitempty"`
+}
+
+// PlaceOrderRequestBody is the type of the "portfolio" service "placeOrder"
+// endpoint HTTP request body.
+type PlaceOrderRequestBody struct {
+	// Account trading; defaults to the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Order side
+	Side *string `form:"side,omitempty" json:"side,omitempty" xml:"side,omitempty"`
+	// Order type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Quantity
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Limit price of limit and stop-limit orders
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// Stop price of stop and stop-limit orders
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// How long the order works
+	TimeInForce *string `form:"time_in_force,omitempty" json:"time_in_force,omitempty" xml:"time_in_force,omitempty"`
+}
+
+// PreviewTradesRequestBody is the type of the "portfolio" service
+// "previewTrades" endpoint HTTP request body.
+type PreviewTradesRequestBody struct {
+	// Hypothetical trades
+	Trades []*TradeInputRequestBody `form:"trades,omitempty" json:"trades,omitempty" xml:"trades,omitempty"`
+	// Allocation dimension
+	Dimension *string `form:"dimension,omitempty" json:"dimension,omitempty" xml:"dimension,omitempty"`
+	// Tax rate on net short-term gains
+	ShortTermTaxRate *float64 `form:"short_term_tax_rate,omitempty" json:"short_term_tax_rate,omitempty" xml:"short_term_tax_rate,omitempty"`
+	// Tax rate on net long-term gains
+	LongTermTaxRate *float64 `form:"long_term_tax_rate,omitempty" json:"long_term_tax_rate,omitempty" xml:"long_term_tax_rate,omitempty"`
+	// Trading days of returns used for risk
+	Lookback *int `form:"lookback,omitempty" json:"lookback,omitempty" xml:"lookback,omitempty"`
+}
+
+// RunBacktestRequestBody is the type of the "portfolio" service "runBacktest"
+// endpoint HTTP request body.
+type RunBacktestRequestBody struct {
+	// Strategy name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Strategy kind
+	Strategy *string `form:"strategy,omitempty" json:"strategy,omitempty" xml:"strategy,omitempty"`
+	// First day
+	Start *string `form:"start,omitempty" json:"start,omitempty" xml:"start,omitempty"`
+	// Last day
+	End *string `form:"end,omitempty" json:"end,omitempty" xml:"end,omitempty"`
+	// Currency of the simulated portfolio
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// Cash invested on the first day
+	InitialCash *float64 `form:"initial_cash,omitempty" json:"initial_cash,omitempty" xml:"initial_cash,omitempty"`
+	// Target weights
+	Targets []*BacktestTargetRequestBody `form:"targets,omitempty" json:"targets,omitempty" xml:"targets,omitempty"`
+	// Rebalancing or contribution frequency
+	Frequency *string `form:"frequency,omitempty" json:"frequency,omitempty" xml:"frequency,omitempty"`
+	// Drift from target weight that triggers a threshold rebalance
+	Threshold *float64 `form:"threshold,omitempty" json:"threshold,omitempty" xml:"threshold,omitempty"`
+	// Amount invested every period by dca
+	Contribution *float64 `form:"contribution,omitempty" json:"contribution,omitempty" xml:"contribution,omitempty"`
+	// Commission as a share of traded value
+	FeeRate *float64 `form:"fee_rate,omitempty" json:"fee_rate,omitempty" xml:"fee_rate,omitempty"`
+}
+
+// ImportCsvRequestBody is the type of the "portfolio" service "importCsv"
+// endpoint HTTP request body.
+type ImportCsvRequestBody struct {
+	// CSV statement
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+}
+
+// ImportOfxRequestBody is the type of the "portfolio" service "importOfx"
+// endpoint HTTP request body.
+type ImportOfxRequestBody struct {
+	// OFX or QFX statement
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+}
+
+// ImportJournalRequestBody is the type of the "portfolio" service
+// "importJournal" endpoint HTTP request body.
+type ImportJournalRequestBody struct {
+	// Beancount or hledger journal
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+}
+
+// ImportArchiveRequestBody is the type of the "portfolio" service
+// "importArchive" endpoint HTTP request body.
+type ImportArchiveRequestBody struct {
+	// Portfolio archive
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+}
+
+// ReconcilePositionsRequestBody is the type of the "portfolio" service
+// "reconcilePositions" endpoint HTTP request body.
+type ReconcilePositionsRequestBody struct {
+	// Position snapshot
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+}
+
+// SetAccountRequestBody is the type of the "portfolio" service "setAccount"
+// endpoint HTTP request body.
+type SetAccountRequestBody struct {
+	// Account identifier transactions refer to; empty for the default account
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Display name; defaults to the identifier
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Account type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// How gains and income are taxed; defaults to the treatment of the type
+	TaxTreatment *string `form:"tax_treatment,omitempty" json:"tax_treatment,omitempty" xml:"tax_treatment,omitempty"`
+	// Broker or institution holding the account
+	Custodian *string `form:"custodian,omitempty" json:"custodian,omitempty" xml:"custodian,omitempty"`
+	// Base currency; must be the portfolio currency, which it defaults to
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+}
+
 // GetPortfolioSummaryResponseBody is the type of the "portfolio" service
 // "getPortfolioSummary" endpoint HTTP response body.
 type GetPortfolioSummaryResponseBody struct {
@@ -23,6 +256,1843 @@ type GetPortfolioSummaryResponseBody struct {
 	ChangePercent float64 `form:"change_percent" json:"change_percent" xml:"change_percent"`
 }
 
+// GetReturnsResponseBody is the type of the "portfolio" service "getReturns"
+// endpoint HTTP response body.
+type GetReturnsResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Requested period
+	Period string `form:"period" json:"period" xml:"period"`
+	// Account the returns were limited to
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// First day of the period
+	Start string `form:"start" json:"start" xml:"start"`
+	// Last day of the period
+	End string `form:"end" json:"end" xml:"end"`
+	// Portfolio value at the close before the period
+	StartValue float64 `form:"start_value" json:"start_value" xml:"start_value"`
+	// Portfolio value at the close of the last day
+	EndValue float64 `form:"end_value" json:"end_value" xml:"end_value"`
+	// Deposits less withdrawals during the period
+	NetContributions float64 `form:"net_contributions" json:"net_contributions" xml:"net_contributions"`
+	// Change in value not explained by contributions
+	Gain float64 `form:"gain" json:"gain" xml:"gain"`
+	// Chain-linked time-weighted return over the period
+	TimeWeightedReturn float64 `form:"time_weighted_return" json:"time_weighted_return" xml:"time_weighted_return"`
+	// Annualized time-weighted return, only for periods of at least one year
+	AnnualizedTimeWeightedReturn *float64 `form:"annualized_time_weighted_return,omitempty" json:"annualized_time_weighted_return,omitempty" xml:"annualized_time_weighted_return,omitempty"`
+	// Money-weighted return (XIRR), annualized only for periods of at least one
+	// year
+	MoneyWeightedReturn *float64 `form:"money_weighted_return,omitempty" json:"money_weighted_return,omitempty" xml:"money_weighted_return,omitempty"`
+}
+
+// GetAllocationResponseBody is the type of the "portfolio" service
+// "getAllocation" endpoint HTTP response body.
+type GetAllocationResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Dimension holdings are grouped by
+	Dimension string `form:"dimension" json:"dimension" xml:"dimension"`
+	// Custom tag key when grouping by tag
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty" xml:"tag,omitempty"`
+	// Account the holdings were limited to
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Valuation date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Currency of the values
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Total portfolio value including cash
+	TotalValue float64 `form:"total_value" json:"total_value" xml:"total_value"`
+	// Buckets ordered by value, largest first
+	Buckets []*AllocationBucketResponseBody `form:"buckets" json:"buckets" xml:"buckets"`
+}
+
+// GetTargetAllocationResponseBody is the type of the "portfolio" service
+// "getTargetAllocation" endpoint HTTP response body.
+type GetTargetAllocationResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Target weights per symbol
+	Targets []*TargetWeightResponseBody `form:"targets" json:"targets" xml:"targets"`
+	// Implied target cash weight
+	CashWeight float64 `form:"cash_weight" json:"cash_weight" xml:"cash_weight"`
+}
+
+// SetTargetAllocationResponseBody is the type of the "portfolio" service
+// "setTargetAllocation" endpoint HTTP response body.
+type SetTargetAllocationResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Target weights per symbol
+	Targets []*TargetWeightResponseBody `form:"targets" json:"targets" xml:"targets"`
+	// Implied target cash weight
+	CashWeight float64 `form:"cash_weight" json:"cash_weight" xml:"cash_weight"`
+}
+
+// ProposeRebalanceResponseBody is the type of the "portfolio" service
+// "proposeRebalance" endpoint HTTP response body.
+type ProposeRebalanceResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Pricing date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Cash on hand before the trades
+	CashBefore float64 `form:"cash_before" json:"cash_before" xml:"cash_before"`
+	// Projected cash after the trades
+	CashAfter float64 `form:"cash_after" json:"cash_after" xml:"cash_after"`
+	// Proposed trades, sells first
+	Trades []*ProposedTradeResponseBody `form:"trades" json:"trades" xml:"trades"`
+	// Constraints that prevented a full rebalance
+	Warnings []string `form:"warnings" json:"warnings" xml:"warnings"`
+}
+
+// SetBenchmarkResponseBody is the type of the "portfolio" service
+// "setBenchmark" endpoint HTTP response body.
+type SetBenchmarkResponseBody struct {
+	// Display name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Blend components; weights must sum to 1
+	Components []*BenchmarkComponentResponseBody `form:"components,omitempty" json:"components,omitempty" xml:"components,omitempty"`
+	// Blend in the form 60% SPY / 40% AGG; set either components or blend
+	Blend *string `form:"blend,omitempty" json:"blend,omitempty" xml:"blend,omitempty"`
+	// How often the blend is reset to its weights; none keeps static initial
+	// weights
+	Rebalance string `form:"rebalance" json:"rebalance" xml:"rebalance"`
+}
+
+// GetBenchmarkComparisonResponseBody is the type of the "portfolio" service
+// "getBenchmarkComparison" endpoint HTTP response body.
+type GetBenchmarkComparisonResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Benchmark measured against
+	Benchmark *BenchmarkDefinitionResponseBody `form:"benchmark" json:"benchmark" xml:"benchmark"`
+	// First day of the period
+	Start string `form:"start" json:"start" xml:"start"`
+	// Last day of the period
+	End string `form:"end" json:"end" xml:"end"`
+	// Portfolio time-weighted return
+	PortfolioReturn float64 `form:"portfolio_return" json:"portfolio_return" xml:"portfolio_return"`
+	// Benchmark cumulative return
+	BenchmarkReturn float64 `form:"benchmark_return" json:"benchmark_return" xml:"benchmark_return"`
+	// Portfolio return less benchmark return
+	ExcessReturn float64 `form:"excess_return" json:"excess_return" xml:"excess_return"`
+	// Annualized standard deviation of daily excess returns
+	TrackingError float64 `form:"tracking_error" json:"tracking_error" xml:"tracking_error"`
+	// Cumulative returns per trading day
+	Series []*BenchmarkComparisonPointResponseBody `form:"series" json:"series" xml:"series"`
+}
+
+// GetRiskMetricsResponseBody is the type of the "portfolio" service
+// "getRiskMetrics" endpoint HTTP response body.
+type GetRiskMetricsResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Benchmark beta and correlation are measured against
+	Benchmark *BenchmarkDefinitionResponseBody `form:"benchmark,omitempty" json:"benchmark,omitempty" xml:"benchmark,omitempty"`
+	// First day of the period
+	Start string `form:"start" json:"start" xml:"start"`
+	// Last day of the period
+	End string `form:"end" json:"end" xml:"end"`
+	// Annual risk-free rate used for Sharpe and Sortino
+	RiskFreeRate float64 `form:"risk_free_rate" json:"risk_free_rate" xml:"risk_free_rate"`
+	// Annualized volatility of daily returns
+	Volatility float64 `form:"volatility" json:"volatility" xml:"volatility"`
+	// Annualized excess return over volatility
+	SharpeRatio float64 `form:"sharpe_ratio" json:"sharpe_ratio" xml:"sharpe_ratio"`
+	// Annualized excess return over downside deviation
+	SortinoRatio float64 `form:"sortino_ratio" json:"sortino_ratio" xml:"sortino_ratio"`
+	// Beta against the benchmark
+	Beta *float64 `form:"beta,omitempty" json:"beta,omitempty" xml:"beta,omitempty"`
+	// Correlation of daily returns with the benchmark
+	Correlation *float64 `form:"correlation,omitempty" json:"correlation,omitempty" xml:"correlation,omitempty"`
+	// Largest peak-to-trough decline as a positive fraction
+	MaxDrawdown float64 `form:"max_drawdown" json:"max_drawdown" xml:"max_drawdown"`
+	// Day of the peak before the largest decline
+	MaxDrawdownPeak *string `form:"max_drawdown_peak,omitempty" json:"max_drawdown_peak,omitempty" xml:"max_drawdown_peak,omitempty"`
+	// Day of the trough of the largest decline
+	MaxDrawdownTrough *string `form:"max_drawdown_trough,omitempty" json:"max_drawdown_trough,omitempty" xml:"max_drawdown_trough,omitempty"`
+	// Metrics per rolling window when a window is requested
+	Rolling []*RiskWindowResponseBody `form:"rolling" json:"rolling" xml:"rolling"`
+}
+
+// GetValueAtRiskResponseBody is the type of the "portfolio" service
+// "getValueAtRisk" endpoint HTTP response body.
+type GetValueAtRiskResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Valuation date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Estimation method
+	Method string `form:"method" json:"method" xml:"method"`
+	// Confidence level
+	Confidence float64 `form:"confidence" json:"confidence" xml:"confidence"`
+	// Holding period in trading days
+	Horizon int `form:"horizon" json:"horizon" xml:"horizon"`
+	// Trading days of price history used
+	Lookback int `form:"lookback" json:"lookback" xml:"lookback"`
+	// Market value of the risky positions; cash is treated as riskless
+	PortfolioValue float64 `form:"portfolio_value" json:"portfolio_value" xml:"portfolio_value"`
+	// Value-at-Risk
+	ValueAtRisk float64 `form:"value_at_risk" json:"value_at_risk" xml:"value_at_risk"`
+	// Conditional VaR: the average loss beyond the VaR
+	ExpectedShortfall float64 `form:"expected_shortfall" json:"expected_shortfall" xml:"expected_shortfall"`
+	// Per-position VaR contributions
+	Contributions []*VaRContributionResponseBody `form:"contributions" json:"contributions" xml:"contributions"`
+}
+
+// ListStressScenariosResponseBody is the type of the "portfolio" service
+// "listStressScenarios" endpoint HTTP response body.
+type ListStressScenariosResponseBody []*StressScenarioResponse
+
+// RunStressTestResponseBody is the type of the "portfolio" service
+// "runStressTest" endpoint HTTP response body.
+type RunStressTestResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Valuation date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Scenario applied
+	Scenario *StressScenarioResponseBody `form:"scenario" json:"scenario" xml:"scenario"`
+	// Currency of the values
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Portfolio value including cash
+	CurrentValue float64 `form:"current_value" json:"current_value" xml:"current_value"`
+	// Portfolio value under the scenario
+	ProjectedValue float64 `form:"projected_value" json:"projected_value" xml:"projected_value"`
+	// Current value less projected value
+	Loss float64 `form:"loss" json:"loss" xml:"loss"`
+	// Loss as a decimal fraction of current value
+	LossPercent float64 `form:"loss_percent" json:"loss_percent" xml:"loss_percent"`
+	// Positions ordered from the largest loss
+	WorstContributors []*StressImpactResponseBody `form:"worst_contributors" json:"worst_contributors" xml:"worst_contributors"`
+	// Approximations made while applying the scenario
+	Warnings []string `form:"warnings" json:"warnings" xml:"warnings"`
+}
+
+// ProjectPortfolioResponseBody is the type of the "portfolio" service
+// "projectPortfolio" endpoint HTTP response body.
+type ProjectPortfolioResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// First day of the projection
+	Start string `form:"start" json:"start" xml:"start"`
+	// Last day of the projection
+	End string `form:"end" json:"end" xml:"end"`
+	// Value the projection starts from
+	StartValue float64 `form:"start_value" json:"start_value" xml:"start_value"`
+	// Number of simulated paths
+	Paths int `form:"paths" json:"paths" xml:"paths"`
+	// Assumptions used per asset class
+	Assumptions []*AssetClassAssumptionResponseBody `form:"assumptions" json:"assumptions" xml:"assumptions"`
+	// Percentile bands at each anniversary and at the end
+	Bands []*ProjectionBandResponseBody `form:"bands" json:"bands" xml:"bands"`
+	// Goal amount
+	Goal *float64 `form:"goal,omitempty" json:"goal,omitempty" xml:"goal,omitempty"`
+	// Date the goal should be reached by
+	GoalDate *string `form:"goal_date,omitempty" json:"goal_date,omitempty" xml:"goal_date,omitempty"`
+	// Share of paths reaching the goal by the goal date
+	GoalProbability *float64 `form:"goal_probability,omitempty" json:"goal_probability,omitempty" xml:"goal_probability,omitempty"`
+	// Share of paths that ran out of money
+	DepletionProbability float64 `form:"depletion_probability" json:"depletion_probability" xml:"depletion_probability"`
+}
+
+// ListCorporateActionsResponseBody is the type of the "portfolio" service
+// "listCorporateActions" endpoint HTTP response body.
+type ListCorporateActionsResponseBody []*CorporateActionResponse
+
+// ApplyCorporateActionsResponseBody is the type of the "portfolio" service
+// "applyCorporateActions" endpoint HTTP response body.
+type ApplyCorporateActionsResponseBody []*CorporateActionResponse
+
+// RecordIncomeResponseBody is the type of the "portfolio" service
+// "recordIncome" endpoint HTTP response body.
+type RecordIncomeResponseBody struct {
+	// Ledger transaction ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Income type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Paying instrument; optional for interest
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Account credited
+	Account string `form:"account" json:"account" xml:"account"`
+	// Pay date
+	PayDate string `form:"pay_date" json:"pay_date" xml:"pay_date"`
+	// Ex-dividend date
+	ExDate *string `form:"ex_date,omitempty" json:"ex_date,omitempty" xml:"ex_date,omitempty"`
+	// Gross amount
+	Amount float64 `form:"amount" json:"amount" xml:"amount"`
+	// Tax withheld at source
+	Withholding float64 `form:"withholding" json:"withholding" xml:"withholding"`
+	// Cash received after withholding
+	Net float64 `form:"net" json:"net" xml:"net"`
+	// Dividend taxed at qualified rates
+	Qualified bool `form:"qualified" json:"qualified" xml:"qualified"`
+	// Ledger ID of the purchase reinvesting the dividend
+	ReinvestmentID *string `form:"reinvestment_id,omitempty" json:"reinvestment_id,omitempty" xml:"reinvestment_id,omitempty"`
+	// Shares bought by reinvestment
+	ReinvestedQuantity *float64 `form:"reinvested_quantity,omitempty" json:"reinvested_quantity,omitempty" xml:"reinvested_quantity,omitempty"`
+}
+
+// GetIncomeResponseBody is the type of the "portfolio" service "getIncome"
+// endpoint HTTP response body.
+type GetIncomeResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// First day of the period
+	Start string `form:"start" json:"start" xml:"start"`
+	// Last day of the period
+	End string `form:"end" json:"end" xml:"end"`
+	// Currency of the amounts
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Length of the periods in by_period
+	Interval string `form:"interval" json:"interval" xml:"interval"`
+	// Gross income
+	Gross float64 `form:"gross" json:"gross" xml:"gross"`
+	// Tax withheld at source
+	Withholding float64 `form:"withholding" json:"withholding" xml:"withholding"`
+	// Income after withholding
+	Net float64 `form:"net" json:"net" xml:"net"`
+	// Gross qualified dividends
+	Qualified float64 `form:"qualified" json:"qualified" xml:"qualified"`
+	// Gross ordinary dividends and interest
+	Ordinary float64 `form:"ordinary" json:"ordinary" xml:"ordinary"`
+	// Income per period
+	ByPeriod []*IncomeBucketResponseBody `form:"by_period" json:"by_period" xml:"by_period"`
+	// Income per symbol; interest without a symbol is keyed cash
+	ByHolding []*IncomeBucketResponseBody `form:"by_holding" json:"by_holding" xml:"by_holding"`
+	// Income per instrument currency
+	ByCurrency []*IncomeBucketResponseBody `form:"by_currency" json:"by_currency" xml:"by_currency"`
+	// Expected income per month over the next 12 months
+	Forecast []*IncomeForecastResponseBody `form:"forecast" json:"forecast" xml:"forecast"`
+	// Expected income over the next 12 months
+	ForecastTotal float64 `form:"forecast_total" json:"forecast_total" xml:"forecast_total"`
+}
+
+// PriceBondResponseBody is the type of the "portfolio" service "priceBond"
+// endpoint HTTP response body.
+type PriceBondResponseBody struct {
+	// Settlement date
+	Settlement string `form:"settlement" json:"settlement" xml:"settlement"`
+	// Price excluding accrued interest
+	CleanPrice float64 `form:"clean_price" json:"clean_price" xml:"clean_price"`
+	// Price including accrued interest
+	DirtyPrice float64 `form:"dirty_price" json:"dirty_price" xml:"dirty_price"`
+	// Coupon earned since the last coupon date
+	AccruedInterest float64 `form:"accrued_interest" json:"accrued_interest" xml:"accrued_interest"`
+	// Annual yield compounded at the coupon frequency
+	YieldToMaturity float64 `form:"yield_to_maturity" json:"yield_to_maturity" xml:"yield_to_maturity"`
+	// Macaulay duration in years
+	MacaulayDuration float64 `form:"macaulay_duration" json:"macaulay_duration" xml:"macaulay_duration"`
+	// Modified duration in years
+	ModifiedDuration float64 `form:"modified_duration" json:"modified_duration" xml:"modified_duration"`
+}
+
+// ListBondPositionsResponseBody is the type of the "portfolio" service
+// "listBondPositions" endpoint HTTP response body.
+type ListBondPositionsResponseBody []*BondPositionResponse
+
+// GetCashFlowLadderResponseBody is the type of the "portfolio" service
+// "getCashFlowLadder" endpoint HTTP response body.
+type GetCashFlowLadderResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// First day of the ladder
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Last day of the ladder
+	End string `form:"end" json:"end" xml:"end"`
+	// Currency of the portfolio
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Cash flows in date order
+	Flows []*BondCashFlowResponseBody `form:"flows" json:"flows" xml:"flows"`
+	// Cash flows per calendar year
+	ByYear []*CashFlowLadderYearResponseBody `form:"by_year" json:"by_year" xml:"by_year"`
+	// Sum of all cash flows
+	Total float64 `form:"total" json:"total" xml:"total"`
+}
+
+// ListOptionPositionsResponseBody is the type of the "portfolio" service
+// "listOptionPositions" endpoint HTTP response body.
+type ListOptionPositionsResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Valuation date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Currency of the values
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Option positions by account and symbol
+	Positions []*OptionPositionResponseBody `form:"positions" json:"positions" xml:"positions"`
+	// Exposure per underlying of the options held
+	Exposures []*UnderlyingExposureResponseBody `form:"exposures" json:"exposures" xml:"exposures"`
+}
+
+// SettleOptionExpiriesResponseBody is the type of the "portfolio" service
+// "settleOptionExpiries" endpoint HTTP response body.
+type SettleOptionExpiriesResponseBody []*OptionSettlementResponse
+
+// SetMarginAccountResponseBody is the type of the "portfolio" service
+// "setMarginAccount" endpoint HTTP response body.
+type SetMarginAccountResponseBody struct {
+	// Account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Equity required to open positions, as a share of their value
+	InitialRequirement float64 `form:"initial_requirement" json:"initial_requirement" xml:"initial_requirement"`
+	// Equity required to keep long positions
+	MaintenanceRequirement float64 `form:"maintenance_requirement" json:"maintenance_requirement" xml:"maintenance_requirement"`
+	// Equity required to keep short positions
+	ShortMaintenanceRequirement float64 `form:"short_maintenance_requirement" json:"short_maintenance_requirement" xml:"short_maintenance_requirement"`
+	// Annual interest rate on the margin loan
+	LoanRate float64 `form:"loan_rate" json:"loan_rate" xml:"loan_rate"`
+	// Annual fee for borrowing shares held short
+	BorrowRate float64 `form:"borrow_rate" json:"borrow_rate" xml:"borrow_rate"`
+	// Borrow fee rates by symbol overriding borrow_rate
+	BorrowRates map[string]float64 `form:"borrow_rates,omitempty" json:"borrow_rates,omitempty" xml:"borrow_rates,omitempty"`
+}
+
+// GetMarginStatusResponseBody is the type of the "portfolio" service
+// "getMarginStatus" endpoint HTTP response body.
+type GetMarginStatusResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Valuation date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Currency of the values
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Margin accounts by name
+	Accounts []*MarginAccountStatusResponseBody `form:"accounts" json:"accounts" xml:"accounts"`
+}
+
+// ChargeMarginResponseBody is the type of the "portfolio" service
+// "chargeMargin" endpoint HTTP response body.
+type ChargeMarginResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Valuation date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Currency of the values
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Margin accounts by name
+	Accounts []*MarginAccountStatusResponseBody `form:"accounts" json:"accounts" xml:"accounts"`
+}
+
+// ListEventsResponseBody is the type of the "portfolio" service "listEvents"
+// endpoint HTTP response body.
+type ListEventsResponseBody []*PortfolioEventResponse
+
+// PlaceOrderResponseBody is the type of the "portfolio" service "placeOrder"
+// endpoint HTTP response body.
+type PlaceOrderResponseBody struct {
+	// Order ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Account trading; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Order side
+	Side string `form:"side" json:"side" xml:"side"`
+	// Order type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Quantity
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Limit price of limit and stop-limit orders
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// Stop price of stop and stop-limit orders
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// How long the order works
+	TimeInForce string `form:"time_in_force" json:"time_in_force" xml:"time_in_force"`
+	// Order status
+	Status string `form:"status" json:"status" xml:"status"`
+	// The stop price of a stop or stop-limit order traded
+	Triggered bool `form:"triggered" json:"triggered" xml:"triggered"`
+	// When the order was placed
+	Created string `form:"created" json:"created" xml:"created"`
+	// When the status last changed
+	Updated string `form:"updated" json:"updated" xml:"updated"`
+	// Fill price including slippage; 0 until filled
+	FillPrice float64 `form:"fill_price" json:"fill_price" xml:"fill_price"`
+	// Commission charged; 0 until filled
+	Commission float64 `form:"commission" json:"commission" xml:"commission"`
+	// Why the order was rejected
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// ListOrdersResponseBody is the type of the "portfolio" service "listOrders"
+// endpoint HTTP response body.
+type ListOrdersResponseBody []*OrderResponse
+
+// CancelOrderResponseBody is the type of the "portfolio" service "cancelOrder"
+// endpoint HTTP response body.
+type CancelOrderResponseBody struct {
+	// Order ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Account trading; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Order side
+	Side string `form:"side" json:"side" xml:"side"`
+	// Order type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Quantity
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Limit price of limit and stop-limit orders
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// Stop price of stop and stop-limit orders
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// How long the order works
+	TimeInForce string `form:"time_in_force" json:"time_in_force" xml:"time_in_force"`
+	// Order status
+	Status string `form:"status" json:"status" xml:"status"`
+	// The stop price of a stop or stop-limit order traded
+	Triggered bool `form:"triggered" json:"triggered" xml:"triggered"`
+	// When the order was placed
+	Created string `form:"created" json:"created" xml:"created"`
+	// When the status last changed
+	Updated string `form:"updated" json:"updated" xml:"updated"`
+	// Fill price including slippage; 0 until filled
+	FillPrice float64 `form:"fill_price" json:"fill_price" xml:"fill_price"`
+	// Commission charged; 0 until filled
+	Commission float64 `form:"commission" json:"commission" xml:"commission"`
+	// Why the order was rejected
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// PreviewTradesResponseBody is the type of the "portfolio" service
+// "previewTrades" endpoint HTTP response body.
+type PreviewTradesResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Valuation date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Currency of the values
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Summary before the trades
+	Before *PortfolioSummaryResponseBody `form:"before" json:"before" xml:"before"`
+	// Summary after the trades
+	After *PortfolioSummaryResponseBody `form:"after" json:"after" xml:"after"`
+	// Cash before the trades
+	CashBefore float64 `form:"cash_before" json:"cash_before" xml:"cash_before"`
+	// Cash after the trades
+	CashAfter float64 `form:"cash_after" json:"cash_after" xml:"cash_after"`
+	// Allocation dimension
+	Dimension string `form:"dimension" json:"dimension" xml:"dimension"`
+	// Allocation buckets before and after, by key
+	Allocation []*AllocationChangeResponseBody `form:"allocation" json:"allocation" xml:"allocation"`
+	// Lots the sales would relieve
+	Realized []*RealizedLotResponseBody `form:"realized" json:"realized" xml:"realized"`
+	// Net short-term realized gain
+	ShortTermGain float64 `form:"short_term_gain" json:"short_term_gain" xml:"short_term_gain"`
+	// Net long-term realized gain
+	LongTermGain float64 `form:"long_term_gain" json:"long_term_gain" xml:"long_term_gain"`
+	// Tax on net realized gains at the given rates; 0 for net losses
+	EstimatedTax float64 `form:"estimated_tax" json:"estimated_tax" xml:"estimated_tax"`
+	// Risk before the trades
+	RiskBefore *HoldingsRiskResponseBody `form:"risk_before" json:"risk_before" xml:"risk_before"`
+	// Risk after the trades
+	RiskAfter *HoldingsRiskResponseBody `form:"risk_after" json:"risk_after" xml:"risk_after"`
+	// Issues such as negative cash
+	Warnings []string `form:"warnings" json:"warnings" xml:"warnings"`
+}
+
+// RunBacktestResponseBody is the type of the "portfolio" service "runBacktest"
+// endpoint HTTP response body.
+type RunBacktestResponseBody struct {
+	// Strategy name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Strategy kind
+	Strategy string `form:"strategy" json:"strategy" xml:"strategy"`
+	// First trading day
+	Start string `form:"start" json:"start" xml:"start"`
+	// Last trading day
+	End string `form:"end" json:"end" xml:"end"`
+	// Currency of the values
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Value at the end
+	EndValue float64 `form:"end_value" json:"end_value" xml:"end_value"`
+	// Initial cash plus contributions
+	NetContributions float64 `form:"net_contributions" json:"net_contributions" xml:"net_contributions"`
+	// Change in value not explained by contributions
+	Gain float64 `form:"gain" json:"gain" xml:"gain"`
+	// Cumulative time-weighted return
+	TimeWeightedReturn float64 `form:"time_weighted_return" json:"time_weighted_return" xml:"time_weighted_return"`
+	// Annualized time-weighted return, for periods of at least one year
+	AnnualizedReturn *float64 `form:"annualized_return,omitempty" json:"annualized_return,omitempty" xml:"annualized_return,omitempty"`
+	// Money-weighted return (XIRR)
+	MoneyWeightedReturn *float64 `form:"money_weighted_return,omitempty" json:"money_weighted_return,omitempty" xml:"money_weighted_return,omitempty"`
+	// Annualized volatility of daily returns
+	Volatility float64 `form:"volatility" json:"volatility" xml:"volatility"`
+	// Annualized Sharpe ratio
+	SharpeRatio float64 `form:"sharpe_ratio" json:"sharpe_ratio" xml:"sharpe_ratio"`
+	// Largest peak-to-trough decline as a positive fraction
+	MaxDrawdown float64 `form:"max_drawdown" json:"max_drawdown" xml:"max_drawdown"`
+	// Date of the peak before the largest decline
+	MaxDrawdownPeak *string `form:"max_drawdown_peak,omitempty" json:"max_drawdown_peak,omitempty" xml:"max_drawdown_peak,omitempty"`
+	// Date of the trough of the largest decline
+	MaxDrawdownTrough *string `form:"max_drawdown_trough,omitempty" json:"max_drawdown_trough,omitempty" xml:"max_drawdown_trough,omitempty"`
+	// Number of trades
+	Trades int `form:"trades" json:"trades" xml:"trades"`
+	// Value bought plus sold
+	TradedValue float64 `form:"traded_value" json:"traded_value" xml:"traded_value"`
+	// Commission paid
+	Fees float64 `form:"fees" json:"fees" xml:"fees"`
+	// Lesser of the value bought and sold divided by the average value; investing
+	// deposited cash is not counted
+	Turnover float64 `form:"turnover" json:"turnover" xml:"turnover"`
+	// Daily values, starting with the base day before start
+	EquityCurve []*BacktestPointResponseBody `form:"equity_curve" json:"equity_curve" xml:"equity_curve"`
+}
+
+// ImportCsvResponseBody is the type of the "portfolio" service "importCsv"
+// endpoint HTTP response body.
+type ImportCsvResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Statement format
+	Format string `form:"format" json:"format" xml:"format"`
+	// Mapping profile used
+	Profile *string `form:"profile,omitempty" json:"profile,omitempty" xml:"profile,omitempty"`
+	// Whether the import was only previewed
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Rows posted, or that would be posted in a dry run
+	Imported int `form:"imported" json:"imported" xml:"imported"`
+	// Rows already in the ledger
+	Duplicates int `form:"duplicates" json:"duplicates" xml:"duplicates"`
+	// Rows ignored by the profile
+	Skipped int `form:"skipped" json:"skipped" xml:"skipped"`
+	// Rows that could not be read or posted
+	Errors int `form:"errors" json:"errors" xml:"errors"`
+	// Outcome of every row in file order
+	Rows []*ImportRowResponseBody `form:"rows" json:"rows" xml:"rows"`
+	// Statement positions and cash compared with the ledger after the import
+	Reconciliation []*AccountReconciliationResponseBody `form:"reconciliation,omitempty" json:"reconciliation,omitempty" xml:"reconciliation,omitempty"`
+}
+
+// ImportOfxResponseBody is the type of the "portfolio" service "importOfx"
+// endpoint HTTP response body.
+type ImportOfxResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Statement format
+	Format string `form:"format" json:"format" xml:"format"`
+	// Mapping profile used
+	Profile *string `form:"profile,omitempty" json:"profile,omitempty" xml:"profile,omitempty"`
+	// Whether the import was only previewed
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Rows posted, or that would be posted in a dry run
+	Imported int `form:"imported" json:"imported" xml:"imported"`
+	// Rows already in the ledger
+	Duplicates int `form:"duplicates" json:"duplicates" xml:"duplicates"`
+	// Rows ignored by the profile
+	Skipped int `form:"skipped" json:"skipped" xml:"skipped"`
+	// Rows that could not be read or posted
+	Errors int `form:"errors" json:"errors" xml:"errors"`
+	// Outcome of every row in file order
+	Rows []*ImportRowResponseBody `form:"rows" json:"rows" xml:"rows"`
+	// Statement positions and cash compared with the ledger after the import
+	Reconciliation []*AccountReconciliationResponseBody `form:"reconciliation,omitempty" json:"reconciliation,omitempty" xml:"reconciliation,omitempty"`
+}
+
+// ImportJournalResponseBody is the type of the "portfolio" service
+// "importJournal" endpoint HTTP response body.
+type ImportJournalResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Statement format
+	Format string `form:"format" json:"format" xml:"format"`
+	// Mapping profile used
+	Profile *string `form:"profile,omitempty" json:"profile,omitempty" xml:"profile,omitempty"`
+	// Whether the import was only previewed
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Rows posted, or that would be posted in a dry run
+	Imported int `form:"imported" json:"imported" xml:"imported"`
+	// Rows already in the ledger
+	Duplicates int `form:"duplicates" json:"duplicates" xml:"duplicates"`
+	// Rows ignored by the profile
+	Skipped int `form:"skipped" json:"skipped" xml:"skipped"`
+	// Rows that could not be read or posted
+	Errors int `form:"errors" json:"errors" xml:"errors"`
+	// Outcome of every row in file order
+	Rows []*ImportRowResponseBody `form:"rows" json:"rows" xml:"rows"`
+	// Statement positions and cash compared with the ledger after the import
+	Reconciliation []*AccountReconciliationResponseBody `form:"reconciliation,omitempty" json:"reconciliation,omitempty" xml:"reconciliation,omitempty"`
+}
+
+// ImportArchiveResponseBody is the type of the "portfolio" service
+// "importArchive" endpoint HTTP response body.
+type ImportArchiveResponseBody struct {
+	// Portfolio created or replaced
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Schema version the archive was written in
+	SchemaVersion int `form:"schema_version" json:"schema_version" xml:"schema_version"`
+	// Whether the archive was migrated from an older schema version
+	Migrated bool `form:"migrated" json:"migrated" xml:"migrated"`
+	// Whether an existing portfolio was replaced
+	Replaced bool `form:"replaced" json:"replaced" xml:"replaced"`
+	// When the archive was created
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Ledger transactions imported
+	Transactions int `form:"transactions" json:"transactions" xml:"transactions"`
+	// Instruments added or updated
+	Instruments int `form:"instruments" json:"instruments" xml:"instruments"`
+	// Target weights imported
+	Targets int `form:"targets" json:"targets" xml:"targets"`
+	// Registered accounts imported
+	Accounts int `form:"accounts" json:"accounts" xml:"accounts"`
+	// Margin accounts imported
+	MarginAccounts int `form:"margin_accounts" json:"margin_accounts" xml:"margin_accounts"`
+}
+
+// ReconcilePositionsResponseBody is the type of the "portfolio" service
+// "reconcilePositions" endpoint HTTP response body.
+type ReconcilePositionsResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Snapshot format
+	Format string `form:"format" json:"format" xml:"format"`
+	// Accounts and dates checked
+	Accounts []*ReconciledAccountResponseBody `form:"accounts" json:"accounts" xml:"accounts"`
+	// Breaks found for the first time, or again after being resolved
+	Opened int `form:"opened" json:"opened" xml:"opened"`
+	// Open or ignored breaks found again
+	Updated int `form:"updated" json:"updated" xml:"updated"`
+	// Open breaks of the checked accounts and dates that now match
+	Resolved int `form:"resolved" json:"resolved" xml:"resolved"`
+	// Breaks found by this run
+	Breaks []*ReconciliationBreakResponseBody `form:"breaks" json:"breaks" xml:"breaks"`
+}
+
+// ListReconciliationBreaksResponseBody is the type of the "portfolio" service
+// "listReconciliationBreaks" endpoint HTTP response body.
+type ListReconciliationBreaksResponseBody []*ReconciliationBreakResponse
+
+// UpdateReconciliationBreakResponseBody is the type of the "portfolio" service
+// "updateReconciliationBreak" endpoint HTTP response body.
+type UpdateReconciliationBreakResponseBody struct {
+	// Break ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Ledger account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Snapshot date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Break kind
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Instrument symbol; absent for cash differences
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Quantity, or cash balance, in the snapshot
+	Statement float64 `form:"statement" json:"statement" xml:"statement"`
+	// Quantity, or cash balance, in the ledger
+	Ledger float64 `form:"ledger" json:"ledger" xml:"ledger"`
+	// Snapshot less ledger
+	Difference float64 `form:"difference" json:"difference" xml:"difference"`
+	// Price valuing the suggested position adjustments
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Suggested adjusting entries; empty when no price is known for a position
+	Adjustments []*LedgerTransactionResponseBody `form:"adjustments" json:"adjustments" xml:"adjustments"`
+	// Review status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Why the status was set
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// When the break was first found
+	Detected string `form:"detected" json:"detected" xml:"detected"`
+	// When the break last changed
+	Updated string `form:"updated" json:"updated" xml:"updated"`
+	// IDs of the adjustments posted to resolve the break
+	Posted []string `form:"posted,omitempty" json:"posted,omitempty" xml:"posted,omitempty"`
+}
+
+// GetTaxReportResponseBody is the type of the "portfolio" service
+// "getTaxReport" endpoint HTTP response body.
+type GetTaxReportResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Tax year
+	Year int `form:"year" json:"year" xml:"year"`
+	// Currency of the amounts
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Accounts with disposals in the year
+	Accounts []*TaxAccountResponseBody `form:"accounts" json:"accounts" xml:"accounts"`
+	// Short-term totals
+	ShortTerm *TaxTotalsResponseBody `form:"short_term" json:"short_term" xml:"short_term"`
+	// Long-term totals
+	LongTerm *TaxTotalsResponseBody `form:"long_term" json:"long_term" xml:"long_term"`
+	// Totals
+	Total *TaxTotalsResponseBody `form:"total" json:"total" xml:"total"`
+	// Wash sales of the losses realized in the year
+	WashSales []*WashSaleResponseBody `form:"wash_sales" json:"wash_sales" xml:"wash_sales"`
+}
+
+// ListLotsResponseBody is the type of the "portfolio" service "listLots"
+// endpoint HTTP response body.
+type ListLotsResponseBody []*TaxLotResponse
+
+// FindTaxLossHarvestsResponseBody is the type of the "portfolio" service
+// "findTaxLossHarvests" endpoint HTTP response body.
+type FindTaxLossHarvestsResponseBody struct {
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Date of the prices
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Suggested harvests, largest saving first
+	Opportunities []*HarvestOpportunityResponseBody `form:"opportunities" json:"opportunities" xml:"opportunities"`
+	// Losses skipped to avoid wash sales
+	Skipped []*SkippedHarvestResponseBody `form:"skipped" json:"skipped" xml:"skipped"`
+	// Losses realized by all suggested harvests
+	TotalLoss float64 `form:"total_loss" json:"total_loss" xml:"total_loss"`
+	// Tax saved by all suggested harvests
+	EstimatedSavings float64 `form:"estimated_savings" json:"estimated_savings" xml:"estimated_savings"`
+}
+
+// ListAccountsResponseBody is the type of the "portfolio" service
+// "listAccounts" endpoint HTTP response body.
+type ListAccountsResponseBody []*AccountResponse
+
+// SetAccountResponseBody is the type of the "portfolio" service "setAccount"
+// endpoint HTTP response body.
+type SetAccountResponseBody struct {
+	// Account identifier transactions refer to; empty for the default account
+	ID string `form:"id" json:"id" xml:"id"`
+	// Display name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Account type
+	Type string `form:"type" json:"type" xml:"type"`
+	// How gains and income of the account are taxed
+	TaxTreatment string `form:"tax_treatment" json:"tax_treatment" xml:"tax_treatment"`
+	// Broker or institution holding the account
+	Custodian *string `form:"custodian,omitempty" json:"custodian,omitempty" xml:"custodian,omitempty"`
+	// Base currency of the account
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Set explicitly; otherwise implied by transactions as a taxable account
+	Registered bool `form:"registered" json:"registered" xml:"registered"`
+	// Cash balance
+	Cash float64 `form:"cash" json:"cash" xml:"cash"`
+	// Market value of the positions
+	HoldingsValue float64 `form:"holdings_value" json:"holdings_value" xml:"holdings_value"`
+	// Cash plus holdings value
+	TotalValue float64 `form:"total_value" json:"total_value" xml:"total_value"`
+}
+
+// RemoveAccountResponseBody is the type of the "portfolio" service
+// "removeAccount" endpoint HTTP response body.
+type RemoveAccountResponseBody struct {
+	// Account identifier transactions refer to; empty for the default account
+	ID string `form:"id" json:"id" xml:"id"`
+	// Display name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Account type
+	Type string `form:"type" json:"type" xml:"type"`
+	// How gains and income of the account are taxed
+	TaxTreatment string `form:"tax_treatment" json:"tax_treatment" xml:"tax_treatment"`
+	// Broker or institution holding the account
+	Custodian *string `form:"custodian,omitempty" json:"custodian,omitempty" xml:"custodian,omitempty"`
+	// Base currency of the account
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Set explicitly; otherwise implied by transactions as a taxable account
+	Registered bool `form:"registered" json:"registered" xml:"registered"`
+	// Cash balance
+	Cash float64 `form:"cash" json:"cash" xml:"cash"`
+	// Market value of the positions
+	HoldingsValue float64 `form:"holdings_value" json:"holdings_value" xml:"holdings_value"`
+	// Cash plus holdings value
+	TotalValue float64 `form:"total_value" json:"total_value" xml:"total_value"`
+}
+
+// AllocationBucketResponseBody is used to define fields on response body types.
+type AllocationBucketResponseBody struct {
+	// Bucket name, e.g. an asset class or sector
+	Key string `form:"key" json:"key" xml:"key"`
+	// Market value of the bucket
+	Value float64 `form:"value" json:"value" xml:"value"`
+	// Share of total portfolio value as a decimal fraction
+	Weight float64 `form:"weight" json:"weight" xml:"weight"`
+	// Symbols held in the bucket
+	Symbols []string `form:"symbols" json:"symbols" xml:"symbols"`
+}
+
+// TargetWeightResponseBody is used to define fields on response body types.
+type TargetWeightResponseBody struct {
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Target weight as a decimal fraction
+	Weight float64 `form:"weight" json:"weight" xml:"weight"`
+	// Absolute drift allowed either side of the target weight
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+}
+
+// ProposedTradeResponseBody is used to define fields on response body types.
+type ProposedTradeResponseBody struct {
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Trade direction
+	Side string `form:"side" json:"side" xml:"side"`
+	// Quantity, rounded to the instrument lot size
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Assumed execution price
+	Price float64 `form:"price" json:"price" xml:"price"`
+	// Trade value
+	Value float64 `form:"value" json:"value" xml:"value"`
+	// Weight before the trade
+	CurrentWeight float64 `form:"current_weight" json:"current_weight" xml:"current_weight"`
+	// Target weight
+	TargetWeight float64 `form:"target_weight" json:"target_weight" xml:"target_weight"`
+	// Weight after the trade
+	ProjectedWeight float64 `form:"projected_weight" json:"projected_weight" xml:"projected_weight"`
+}
+
+// BenchmarkComponentResponseBody is used to define fields on response body
+// types.
+type BenchmarkComponentResponseBody struct {
+	// Instrument symbol priced through the market data provider
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Target weight as a decimal fraction
+	Weight float64 `form:"weight" json:"weight" xml:"weight"`
+}
+
+// BenchmarkDefinitionResponseBody is used to define fields on response body
+// types.
+type BenchmarkDefinitionResponseBody struct {
+	// Display name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Blend components; weights must sum to 1
+	Components []*BenchmarkComponentResponseBody `form:"components,omitempty" json:"components,omitempty" xml:"components,omitempty"`
+	// Blend in the form 60% SPY / 40% AGG; set either components or blend
+	Blend *string `form:"blend,omitempty" json:"blend,omitempty" xml:"blend,omitempty"`
+	// How often the blend is reset to its weights; none keeps static initial
+	// weights
+	Rebalance string `form:"rebalance" json:"rebalance" xml:"rebalance"`
+}
+
+// BenchmarkComparisonPointResponseBody is used to define fields on response
+// body types.
+type BenchmarkComparisonPointResponseBody struct {
+	// Trading day
+	Date string `form:"date" json:"date" xml:"date"`
+	// Portfolio cumulative return
+	Portfolio float64 `form:"portfolio" json:"portfolio" xml:"portfolio"`
+	// Benchmark cumulative return
+	Benchmark float64 `form:"benchmark" json:"benchmark" xml:"benchmark"`
+}
+
+// RiskWindowResponseBody is used to define fields on response body types.
+type RiskWindowResponseBody struct {
+	// Base day of the window
+	Start string `form:"start" json:"start" xml:"start"`
+	// Last day of the window
+	End string `form:"end" json:"end" xml:"end"`
+	// Annualized volatility of daily returns
+	Volatility float64 `form:"volatility" json:"volatility" xml:"volatility"`
+	// Annualized excess return over volatility
+	SharpeRatio float64 `form:"sharpe_ratio" json:"sharpe_ratio" xml:"sharpe_ratio"`
+	// Annualized excess return over downside deviation
+	SortinoRatio float64 `form:"sortino_ratio" json:"sortino_ratio" xml:"sortino_ratio"`
+	// Beta against the benchmark
+	Beta *float64 `form:"beta,omitempty" json:"beta,omitempty" xml:"beta,omitempty"`
+	// Correlation of daily returns with the benchmark
+	Correlation *float64 `form:"correlation,omitempty" json:"correlation,omitempty" xml:"correlation,omitempty"`
+	// Largest peak-to-trough decline as a positive fraction
+	MaxDrawdown float64 `form:"max_drawdown" json:"max_drawdown" xml:"max_drawdown"`
+}
+
+// VaRContributionResponseBody is used to define fields on response body types.
+type VaRContributionResponseBody struct {
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Current market value of the position
+	Value float64 `form:"value" json:"value" xml:"value"`
+	// Change in VaR per unit of value added to the position
+	MarginalVar float64 `form:"marginal_var" json:"marginal_var" xml:"marginal_var"`
+	// Share of portfolio VaR attributed to the position; components sum to the VaR
+	ComponentVar float64 `form:"component_var" json:"component_var" xml:"component_var"`
+}
+
+// StressScenarioResponse is used to define fields on response body types.
+type StressScenarioResponse struct {
+	// Scenario name
+	Name string `form:"name" json:"name" xml:"name"`
+	// What the scenario represents
+	Description string `form:"description" json:"description" xml:"description"`
+	// Shock per asset class
+	AssetClasses map[string]float64 `form:"asset_classes,omitempty" json:"asset_classes,omitempty" xml:"asset_classes,omitempty"`
+	// Shock per symbol, overriding asset class shocks
+	Symbols map[string]float64 `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Parallel change in yields, e.g. 0.02 for +200bp, applied through instrument
+	// duration
+	RateShift float64 `form:"rate_shift" json:"rate_shift" xml:"rate_shift"`
+	// Move of each currency against all others
+	Currencies map[string]float64 `form:"currencies,omitempty" json:"currencies,omitempty" xml:"currencies,omitempty"`
+	// Start of a replayed historical window
+	ReplayStart *string `form:"replay_start,omitempty" json:"replay_start,omitempty" xml:"replay_start,omitempty"`
+	// End of a replayed historical window
+	ReplayEnd *string `form:"replay_end,omitempty" json:"replay_end,omitempty" xml:"replay_end,omitempty"`
+}
+
+// StressScenarioResponseBody is used to define fields on response body types.
+type StressScenarioResponseBody struct {
+	// Scenario name
+	Name string `form:"name" json:"name" xml:"name"`
+	// What the scenario represents
+	Description string `form:"description" json:"description" xml:"description"`
+	// Shock per asset class
+	AssetClasses map[string]float64 `form:"asset_classes,omitempty" json:"asset_classes,omitempty" xml:"asset_classes,omitempty"`
+	// Shock per symbol, overriding asset class shocks
+	Symbols map[string]float64 `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Parallel change in yields, e.g. 0.02 for +200bp, applied through instrument
+	// duration
+	RateShift float64 `form:"rate_shift" json:"rate_shift" xml:"rate_shift"`
+	// Move of each currency against all others
+	Currencies map[string]float64 `form:"currencies,omitempty" json:"currencies,omitempty" xml:"currencies,omitempty"`
+	// Start of a replayed historical window
+	ReplayStart *string `form:"replay_start,omitempty" json:"replay_start,omitempty" xml:"replay_start,omitempty"`
+	// End of a replayed historical window
+	ReplayEnd *string `form:"replay_end,omitempty" json:"replay_end,omitempty" xml:"replay_end,omitempty"`
+}
+
+// StressImpactResponseBody is used to define fields on response body types.
+type StressImpactResponseBody struct {
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Asset class of the instrument
+	AssetClass string `form:"asset_class" json:"asset_class" xml:"asset_class"`
+	// Current market value
+	Value float64 `form:"value" json:"value" xml:"value"`
+	// Market value under the scenario
+	ProjectedValue float64 `form:"projected_value" json:"projected_value" xml:"projected_value"`
+	// Projected profit or loss
+	Pnl float64 `form:"pnl" json:"pnl" xml:"pnl"`
+	// Total price change applied, including currency effects
+	Shock float64 `form:"shock" json:"shock" xml:"shock"`
+}
+
+// AssetClassAssumptionResponseBody is used to define fields on response body
+// types.
+type AssetClassAssumptionResponseBody struct {
+	// Asset class, e.g. equity
+	AssetClass string `form:"asset_class" json:"asset_class" xml:"asset_class"`
+	// Share of the portfolio; defaults to the current allocation
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Expected annual return
+	ExpectedReturn *float64 `form:"expected_return,omitempty" json:"expected_return,omitempty" xml:"expected_return,omitempty"`
+	// Annual volatility
+	Volatility *float64 `form:"volatility,omitempty" json:"volatility,omitempty" xml:"volatility,omitempty"`
+}
+
+// ProjectionBandResponseBody is used to define fields on response body types.
+type ProjectionBandResponseBody struct {
+	// Date
+	Date string `form:"date" json:"date" xml:"date"`
+	// 5th percentile
+	P5 float64 `form:"p5" json:"p5" xml:"p5"`
+	// 25th percentile
+	P25 float64 `form:"p25" json:"p25" xml:"p25"`
+	// Median
+	P50 float64 `form:"p50" json:"p50" xml:"p50"`
+	// 75th percentile
+	P75 float64 `form:"p75" json:"p75" xml:"p75"`
+	// 95th percentile
+	P95 float64 `form:"p95" json:"p95" xml:"p95"`
+}
+
+// CorporateActionResponse is used to define fields on response body types.
+type CorporateActionResponse struct {
+	// Ledger transaction ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Effective date
+	Date string `form:"date" json:"date" xml:"date"`
+	// Action type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Symbol affected
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Renamed, spun-off or acquiring symbol
+	NewSymbol *string `form:"new_symbol,omitempty" json:"new_symbol,omitempty" xml:"new_symbol,omitempty"`
+	// Resulting shares per share held, e.g. 1.5 for a 3-for-2 split or 0.1 for a
+	// 1-for-10 reverse split
+	Ratio float64 `form:"ratio" json:"ratio" xml:"ratio"`
+	// Cash paid per share held in a merger
+	CashPerShare float64 `form:"cash_per_share" json:"cash_per_share" xml:"cash_per_share"`
+	// Share of cost basis moved to spun-off shares
+	BasisFraction float64 `form:"basis_fraction" json:"basis_fraction" xml:"basis_fraction"`
+	// Price of the resulting shares on the effective date, for cash in lieu and
+	// merger basis allocation
+	Price float64 `form:"price" json:"price" xml:"price"`
+	// Pay fractional resulting shares in cash
+	CashInLieu bool `form:"cash_in_lieu" json:"cash_in_lieu" xml:"cash_in_lieu"`
+	// Free-form note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// IncomeBucketResponseBody is used to define fields on response body types.
+type IncomeBucketResponseBody struct {
+	// Period label, symbol or currency
+	Key string `form:"key" json:"key" xml:"key"`
+	// Gross income
+	Gross float64 `form:"gross" json:"gross" xml:"gross"`
+	// Tax withheld at source
+	Withholding float64 `form:"withholding" json:"withholding" xml:"withholding"`
+	// Income after withholding
+	Net float64 `form:"net" json:"net" xml:"net"`
+}
+
+// IncomeForecastResponseBody is used to define fields on response body types.
+type IncomeForecastResponseBody struct {
+	// Month as YYYY-MM
+	Month string `form:"month" json:"month" xml:"month"`
+	// Expected gross income
+	Amount float64 `form:"amount" json:"amount" xml:"amount"`
+}
+
+// BondPositionResponse is used to define fields on response body types.
+type BondPositionResponse struct {
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Number of bonds held
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Annual coupon rate as a decimal fraction
+	Coupon float64 `form:"coupon" json:"coupon" xml:"coupon"`
+	// Coupons per year; 0 for zero-coupon bonds
+	CouponFrequency int `form:"coupon_frequency" json:"coupon_frequency" xml:"coupon_frequency"`
+	// Day count convention
+	DayCount string `form:"day_count" json:"day_count" xml:"day_count"`
+	// Maturity date
+	Maturity string `form:"maturity" json:"maturity" xml:"maturity"`
+	// Face value per bond
+	FaceValue float64 `form:"face_value" json:"face_value" xml:"face_value"`
+	// Price measures per bond
+	Analytics *BondAnalyticsResponse `form:"analytics" json:"analytics" xml:"analytics"`
+	// Quantity times dirty price
+	MarketValue float64 `form:"market_value" json:"market_value" xml:"market_value"`
+	// Quantity times accrued interest
+	AccruedValue float64 `form:"accrued_value" json:"accrued_value" xml:"accrued_value"`
+}
+
+// BondAnalyticsResponse is used to define fields on response body types.
+type BondAnalyticsResponse struct {
+	// Settlement date
+	Settlement string `form:"settlement" json:"settlement" xml:"settlement"`
+	// Price excluding accrued interest
+	CleanPrice float64 `form:"clean_price" json:"clean_price" xml:"clean_price"`
+	// Price including accrued interest
+	DirtyPrice float64 `form:"dirty_price" json:"dirty_price" xml:"dirty_price"`
+	// Coupon earned since the last coupon date
+	AccruedInterest float64 `form:"accrued_interest" json:"accrued_interest" xml:"accrued_interest"`
+	// Annual yield compounded at the coupon frequency
+	YieldToMaturity float64 `form:"yield_to_maturity" json:"yield_to_maturity" xml:"yield_to_maturity"`
+	// Macaulay duration in years
+	MacaulayDuration float64 `form:"macaulay_duration" json:"macaulay_duration" xml:"macaulay_duration"`
+	// Modified duration in years
+	ModifiedDuration float64 `form:"modified_duration" json:"modified_duration" xml:"modified_duration"`
+}
+
+// BondCashFlowResponseBody is used to define fields on response body types.
+type BondCashFlowResponseBody struct {
+	// Payment date
+	Date string `form:"date" json:"date" xml:"date"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Coupon income
+	Coupon float64 `form:"coupon" json:"coupon" xml:"coupon"`
+	// Principal repaid at maturity
+	Principal float64 `form:"principal" json:"principal" xml:"principal"`
+	// Coupon plus principal
+	Total float64 `form:"total" json:"total" xml:"total"`
+}
+
+// CashFlowLadderYearResponseBody is used to define fields on response body
+// types.
+type CashFlowLadderYearResponseBody struct {
+	// Calendar year
+	Year int `form:"year" json:"year" xml:"year"`
+	// Coupon income
+	Coupon float64 `form:"coupon" json:"coupon" xml:"coupon"`
+	// Principal repaid
+	Principal float64 `form:"principal" json:"principal" xml:"principal"`
+	// Coupon plus principal
+	Total float64 `form:"total" json:"total" xml:"total"`
+}
+
+// OptionPositionResponseBody is used to define fields on response body types.
+type OptionPositionResponseBody struct {
+	// Account holding the contracts
+	Account string `form:"account" json:"account" xml:"account"`
+	// Option symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Underlying symbol
+	Underlying string `form:"underlying" json:"underlying" xml:"underlying"`
+	// Option type
+	OptionType string `form:"option_type" json:"option_type" xml:"option_type"`
+	// Strike price
+	Strike float64 `form:"strike" json:"strike" xml:"strike"`
+	// Expiry date
+	Expiry string `form:"expiry" json:"expiry" xml:"expiry"`
+	// Units of the underlying per contract
+	Multiplier float64 `form:"multiplier" json:"multiplier" xml:"multiplier"`
+	// Option on a futures contract
+	FuturesOption bool `form:"futures_option" json:"futures_option" xml:"futures_option"`
+	// Contracts held
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Latest underlying price
+	UnderlyingPrice float64 `form:"underlying_price" json:"underlying_price" xml:"underlying_price"`
+	// Annual volatility input
+	Volatility float64 `form:"volatility" json:"volatility" xml:"volatility"`
+	// Model price per contract
+	Price float64 `form:"price" json:"price" xml:"price"`
+	// Model value of the position
+	MarketValue float64 `form:"market_value" json:"market_value" xml:"market_value"`
+	// Change in value per unit move of the underlying, in underlying units
+	Delta float64 `form:"delta" json:"delta" xml:"delta"`
+	// Change in delta per unit move of the underlying
+	Gamma float64 `form:"gamma" json:"gamma" xml:"gamma"`
+	// Change in value per calendar day
+	Theta float64 `form:"theta" json:"theta" xml:"theta"`
+	// Change in value per volatility point
+	Vega float64 `form:"vega" json:"vega" xml:"vega"`
+}
+
+// UnderlyingExposureResponseBody is used to define fields on response body
+// types.
+type UnderlyingExposureResponseBody struct {
+	// Underlying symbol
+	Underlying string `form:"underlying" json:"underlying" xml:"underlying"`
+	// Latest underlying price
+	Price float64 `form:"price" json:"price" xml:"price"`
+	// Units of the underlying held directly
+	Shares float64 `form:"shares" json:"shares" xml:"shares"`
+	// Delta of the options on the underlying, in underlying units
+	OptionDelta float64 `form:"option_delta" json:"option_delta" xml:"option_delta"`
+	// Shares plus option delta
+	DeltaAdjustedShares float64 `form:"delta_adjusted_shares" json:"delta_adjusted_shares" xml:"delta_adjusted_shares"`
+	// Delta-adjusted shares times price
+	DeltaAdjustedExposure float64 `form:"delta_adjusted_exposure" json:"delta_adjusted_exposure" xml:"delta_adjusted_exposure"`
+}
+
+// OptionSettlementResponse is used to define fields on response body types.
+type OptionSettlementResponse struct {
+	// Ledger transaction ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Expiry date
+	Date string `form:"date" json:"date" xml:"date"`
+	// Account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Option or underlying symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Transaction type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Quantity
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Price
+	Price float64 `form:"price" json:"price" xml:"price"`
+	// What happened
+	Note string `form:"note" json:"note" xml:"note"`
+}
+
+// MarginAccountStatusResponseBody is used to define fields on response body
+// types.
+type MarginAccountStatusResponseBody struct {
+	// Account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Cash balance; negative when borrowing
+	Cash float64 `form:"cash" json:"cash" xml:"cash"`
+	// Market value of long positions
+	LongValue float64 `form:"long_value" json:"long_value" xml:"long_value"`
+	// Market value of short positions
+	ShortValue float64 `form:"short_value" json:"short_value" xml:"short_value"`
+	// Proceeds of the open short sales
+	ShortProceeds float64 `form:"short_proceeds" json:"short_proceeds" xml:"short_proceeds"`
+	// Cash plus long value less short value
+	Equity float64 `form:"equity" json:"equity" xml:"equity"`
+	// Margin loan balance
+	LoanBalance float64 `form:"loan_balance" json:"loan_balance" xml:"loan_balance"`
+	// Equity required by the initial requirement
+	InitialRequirement float64 `form:"initial_requirement" json:"initial_requirement" xml:"initial_requirement"`
+	// Equity required by the maintenance requirements
+	MaintenanceRequirement float64 `form:"maintenance_requirement" json:"maintenance_requirement" xml:"maintenance_requirement"`
+	// Equity above the maintenance requirement; negative under a margin call
+	MaintenanceExcess float64 `form:"maintenance_excess" json:"maintenance_excess" xml:"maintenance_excess"`
+	// Value of new positions the equity supports
+	BuyingPower float64 `form:"buying_power" json:"buying_power" xml:"buying_power"`
+	// Equity is below the maintenance requirement
+	MarginCall bool `form:"margin_call" json:"margin_call" xml:"margin_call"`
+	// Margin interest charged to date
+	InterestCharged float64 `form:"interest_charged" json:"interest_charged" xml:"interest_charged"`
+	// Stock borrow fees charged to date
+	BorrowFeesCharged float64 `form:"borrow_fees_charged" json:"borrow_fees_charged" xml:"borrow_fees_charged"`
+	// Margin interest accrued this month, not yet charged
+	AccruedInterest float64 `form:"accrued_interest" json:"accrued_interest" xml:"accrued_interest"`
+	// Borrow fees accrued this month, not yet charged
+	AccruedBorrowFees float64 `form:"accrued_borrow_fees" json:"accrued_borrow_fees" xml:"accrued_borrow_fees"`
+}
+
+// PortfolioEventResponse is used to define fields on response body types.
+type PortfolioEventResponse struct {
+	// Event sequence number
+	ID int `form:"id" json:"id" xml:"id"`
+	// When the event occurred
+	Time string `form:"time" json:"time" xml:"time"`
+	// Portfolio identifier
+	PortfolioID string `form:"portfolio_id" json:"portfolio_id" xml:"portfolio_id"`
+	// Event type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Account concerned, if any
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Description
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// OrderResponse is used to define fields on response body types.
+type OrderResponse struct {
+	// Order ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Account trading; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Order side
+	Side string `form:"side" json:"side" xml:"side"`
+	// Order type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Quantity
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Limit price of limit and stop-limit orders
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// Stop price of stop and stop-limit orders
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// How long the order works
+	TimeInForce string `form:"time_in_force" json:"time_in_force" xml:"time_in_force"`
+	// Order status
+	Status string `form:"status" json:"status" xml:"status"`
+	// The stop price of a stop or stop-limit order traded
+	Triggered bool `form:"triggered" json:"triggered" xml:"triggered"`
+	// When the order was placed
+	Created string `form:"created" json:"created" xml:"created"`
+	// When the status last changed
+	Updated string `form:"updated" json:"updated" xml:"updated"`
+	// Fill price including slippage; 0 until filled
+	FillPrice float64 `form:"fill_price" json:"fill_price" xml:"fill_price"`
+	// Commission charged; 0 until filled
+	Commission float64 `form:"commission" json:"commission" xml:"commission"`
+	// Why the order was rejected
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// PortfolioSummaryResponseBody is used to define fields on response body types.
+type PortfolioSummaryResponseBody struct {
+	// Total Balance
+	Balance float64 `form:"balance" json:"balance" xml:"balance"`
+	// Currency Code
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Change Percentage
+	ChangePercent float64 `form:"change_percent" json:"change_percent" xml:"change_percent"`
+}
+
+// AllocationChangeResponseBody is used to define fields on response body types.
+type AllocationChangeResponseBody struct {
+	// Bucket key
+	Key string `form:"key" json:"key" xml:"key"`
+	// Market value before
+	ValueBefore float64 `form:"value_before" json:"value_before" xml:"value_before"`
+	// Share of portfolio value before
+	WeightBefore float64 `form:"weight_before" json:"weight_before" xml:"weight_before"`
+	// Market value after
+	ValueAfter float64 `form:"value_after" json:"value_after" xml:"value_after"`
+	// Share of portfolio value after
+	WeightAfter float64 `form:"weight_after" json:"weight_after" xml:"weight_after"`
+}
+
+// RealizedLotResponseBody is used to define fields on response body types.
+type RealizedLotResponseBody struct {
+	// Account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Ledger transaction that opened the lot
+	LotID string `form:"lot_id" json:"lot_id" xml:"lot_id"`
+	// Acquisition date
+	Acquired string `form:"acquired" json:"acquired" xml:"acquired"`
+	// Quantity relieved
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Net proceeds
+	Proceeds float64 `form:"proceeds" json:"proceeds" xml:"proceeds"`
+	// Cost basis relieved
+	CostBasis float64 `form:"cost_basis" json:"cost_basis" xml:"cost_basis"`
+	// Realized gain; negative for losses
+	Gain float64 `form:"gain" json:"gain" xml:"gain"`
+	// Held long enough for long-term treatment
+	LongTerm bool `form:"long_term" json:"long_term" xml:"long_term"`
+}
+
+// HoldingsRiskResponseBody is used to define fields on response body types.
+type HoldingsRiskResponseBody struct {
+	// Annualized volatility as a share of portfolio value
+	Volatility float64 `form:"volatility" json:"volatility" xml:"volatility"`
+	// One-day parametric value at risk
+	ValueAtRisk float64 `form:"value_at_risk" json:"value_at_risk" xml:"value_at_risk"`
+	// One-day expected shortfall beyond the value at risk
+	ExpectedShortfall float64 `form:"expected_shortfall" json:"expected_shortfall" xml:"expected_shortfall"`
+}
+
+// BacktestPointResponseBody is used to define fields on response body types.
+type BacktestPointResponseBody struct {
+	// Trading day
+	Date string `form:"date" json:"date" xml:"date"`
+	// Cash plus market value
+	Value float64 `form:"value" json:"value" xml:"value"`
+	// External cash flow on the day
+	Flow float64 `form:"flow" json:"flow" xml:"flow"`
+	// Decline of the time-weighted index from its running peak, as a positive
+	// fraction
+	Drawdown float64 `form:"drawdown" json:"drawdown" xml:"drawdown"`
+}
+
+// ImportRowResponseBody is used to define fields on response body types.
+type ImportRowResponseBody struct {
+	// Line of the row in the file
+	Line int `form:"line" json:"line" xml:"line"`
+	// Row outcome
+	Status string `form:"status" json:"status" xml:"status"`
+	// Stable fingerprint of the transaction used to detect duplicates
+	Fingerprint *string `form:"fingerprint,omitempty" json:"fingerprint,omitempty" xml:"fingerprint,omitempty"`
+	// Transaction read from the row
+	Transaction *LedgerTransactionResponseBody `form:"transaction,omitempty" json:"transaction,omitempty" xml:"transaction,omitempty"`
+	// Why the row could not be read or posted, or was skipped
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// LedgerTransactionResponseBody is used to define fields on response body
+// types.
+type LedgerTransactionResponseBody struct {
+	// Transaction identifier, once posted
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Transaction date
+	Date string `form:"date" json:"date" xml:"date"`
+	// Transaction type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Quantity traded
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Price per unit
+	Price float64 `form:"price" json:"price" xml:"price"`
+	// Cash amount of deposits, withdrawals, income and fees
+	Amount float64 `form:"amount" json:"amount" xml:"amount"`
+	// Commission
+	Fee float64 `form:"fee" json:"fee" xml:"fee"`
+	// Description
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// AccountReconciliationResponseBody is used to define fields on response body
+// types.
+type AccountReconciliationResponseBody struct {
+	// Ledger account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Statement date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Positions by symbol, including ledger holdings the statement does not list
+	Positions []*PositionCheckResponseBody `form:"positions" json:"positions" xml:"positions"`
+	// Cash balance in the statement
+	StatementCash *float64 `form:"statement_cash,omitempty" json:"statement_cash,omitempty" xml:"statement_cash,omitempty"`
+	// Cash in the ledger account
+	LedgerCash float64 `form:"ledger_cash" json:"ledger_cash" xml:"ledger_cash"`
+	// Whether every position and the cash agree
+	Matched bool `form:"matched" json:"matched" xml:"matched"`
+}
+
+// PositionCheckResponseBody is used to define fields on response body types.
+type PositionCheckResponseBody struct {
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Quantity in the statement
+	StatementQuantity float64 `form:"statement_quantity" json:"statement_quantity" xml:"statement_quantity"`
+	// Quantity in the ledger
+	LedgerQuantity float64 `form:"ledger_quantity" json:"ledger_quantity" xml:"ledger_quantity"`
+	// Statement less ledger quantity
+	Difference float64 `form:"difference" json:"difference" xml:"difference"`
+	// Whether the quantities agree
+	Matched bool `form:"matched" json:"matched" xml:"matched"`
+}
+
+// ReconciledAccountResponseBody is used to define fields on response body
+// types.
+type ReconciledAccountResponseBody struct {
+	// Ledger account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Snapshot date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Positions in the snapshot
+	Positions int `form:"positions" json:"positions" xml:"positions"`
+	// Whether the snapshot has a cash balance
+	Cash bool `form:"cash" json:"cash" xml:"cash"`
+	// Breaks found
+	Breaks int `form:"breaks" json:"breaks" xml:"breaks"`
+}
+
+// ReconciliationBreakResponseBody is used to define fields on response body
+// types.
+type ReconciliationBreakResponseBody struct {
+	// Break ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Ledger account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Snapshot date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Break kind
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Instrument symbol; absent for cash differences
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Quantity, or cash balance, in the snapshot
+	Statement float64 `form:"statement" json:"statement" xml:"statement"`
+	// Quantity, or cash balance, in the ledger
+	Ledger float64 `form:"ledger" json:"ledger" xml:"ledger"`
+	// Snapshot less ledger
+	Difference float64 `form:"difference" json:"difference" xml:"difference"`
+	// Price valuing the suggested position adjustments
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Suggested adjusting entries; empty when no price is known for a position
+	Adjustments []*LedgerTransactionResponseBody `form:"adjustments" json:"adjustments" xml:"adjustments"`
+	// Review status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Why the status was set
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// When the break was first found
+	Detected string `form:"detected" json:"detected" xml:"detected"`
+	// When the break last changed
+	Updated string `form:"updated" json:"updated" xml:"updated"`
+	// IDs of the adjustments posted to resolve the break
+	Posted []string `form:"posted,omitempty" json:"posted,omitempty" xml:"posted,omitempty"`
+}
+
+// ReconciliationBreakResponse is used to define fields on response body types.
+type ReconciliationBreakResponse struct {
+	// Break ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Ledger account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Snapshot date
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Break kind
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Instrument symbol; absent for cash differences
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Quantity, or cash balance, in the snapshot
+	Statement float64 `form:"statement" json:"statement" xml:"statement"`
+	// Quantity, or cash balance, in the ledger
+	Ledger float64 `form:"ledger" json:"ledger" xml:"ledger"`
+	// Snapshot less ledger
+	Difference float64 `form:"difference" json:"difference" xml:"difference"`
+	// Price valuing the suggested position adjustments
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Suggested adjusting entries; empty when no price is known for a position
+	Adjustments []*LedgerTransactionResponse `form:"adjustments" json:"adjustments" xml:"adjustments"`
+	// Review status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Why the status was set
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// When the break was first found
+	Detected string `form:"detected" json:"detected" xml:"detected"`
+	// When the break last changed
+	Updated string `form:"updated" json:"updated" xml:"updated"`
+	// IDs of the adjustments posted to resolve the break
+	Posted []string `form:"posted,omitempty" json:"posted,omitempty" xml:"posted,omitempty"`
+}
+
+// LedgerTransactionResponse is used to define fields on response body types.
+type LedgerTransactionResponse struct {
+	// Transaction identifier, once posted
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Transaction date
+	Date string `form:"date" json:"date" xml:"date"`
+	// Transaction type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Quantity traded
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Price per unit
+	Price float64 `form:"price" json:"price" xml:"price"`
+	// Cash amount of deposits, withdrawals, income and fees
+	Amount float64 `form:"amount" json:"amount" xml:"amount"`
+	// Commission
+	Fee float64 `form:"fee" json:"fee" xml:"fee"`
+	// Description
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// TaxAccountResponseBody is used to define fields on response body types.
+type TaxAccountResponseBody struct {
+	// Account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Lots disposed, by disposal date
+	Lots []*TaxLotGainResponseBody `form:"lots" json:"lots" xml:"lots"`
+	// Short-term totals
+	ShortTerm *TaxTotalsResponseBody `form:"short_term" json:"short_term" xml:"short_term"`
+	// Long-term totals
+	LongTerm *TaxTotalsResponseBody `form:"long_term" json:"long_term" xml:"long_term"`
+	// Totals
+	Total *TaxTotalsResponseBody `form:"total" json:"total" xml:"total"`
+}
+
+// TaxLotGainResponseBody is used to define fields on response body types.
+type TaxLotGainResponseBody struct {
+	// Account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Ledger transaction that opened the lot
+	LotID string `form:"lot_id" json:"lot_id" xml:"lot_id"`
+	// Ledger transaction that closed the lot
+	SaleID string `form:"sale_id" json:"sale_id" xml:"sale_id"`
+	// Acquisition date; the short sale date for covered shorts
+	Acquired string `form:"acquired" json:"acquired" xml:"acquired"`
+	// Disposal date
+	Disposed string `form:"disposed" json:"disposed" xml:"disposed"`
+	// Quantity disposed
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Net proceeds
+	Proceeds float64 `form:"proceeds" json:"proceeds" xml:"proceeds"`
+	// Cost basis relieved, including basis_adjustment
+	CostBasis float64 `form:"cost_basis" json:"cost_basis" xml:"cost_basis"`
+	// Loss deferred into these shares by earlier wash sales
+	BasisAdjustment float64 `form:"basis_adjustment" json:"basis_adjustment" xml:"basis_adjustment"`
+	// Loss disallowed by the wash-sale rule and added back
+	WashSaleAdjustment float64 `form:"wash_sale_adjustment" json:"wash_sale_adjustment" xml:"wash_sale_adjustment"`
+	// Reportable gain; negative for losses
+	Gain float64 `form:"gain" json:"gain" xml:"gain"`
+	// Holding period, counted from the sold shares' holding period for wash sale
+	// replacements
+	Term string `form:"term" json:"term" xml:"term"`
+	// Whether the disposal covered a short sale
+	ShortSale bool `form:"short_sale" json:"short_sale" xml:"short_sale"`
+}
+
+// TaxTotalsResponseBody is used to define fields on response body types.
+type TaxTotalsResponseBody struct {
+	// Net proceeds
+	Proceeds float64 `form:"proceeds" json:"proceeds" xml:"proceeds"`
+	// Cost basis relieved
+	CostBasis float64 `form:"cost_basis" json:"cost_basis" xml:"cost_basis"`
+	// Losses disallowed by the wash-sale rule
+	WashSaleAdjustment float64 `form:"wash_sale_adjustment" json:"wash_sale_adjustment" xml:"wash_sale_adjustment"`
+	// Reportable gain
+	Gain float64 `form:"gain" json:"gain" xml:"gain"`
+}
+
+// WashSaleResponseBody is used to define fields on response body types.
+type WashSaleResponseBody struct {
+	// Ledger transaction of the loss sale
+	SaleID string `form:"sale_id" json:"sale_id" xml:"sale_id"`
+	// Lot sold at a loss
+	LotID string `form:"lot_id" json:"lot_id" xml:"lot_id"`
+	// Account of the sale; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument sold
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Sale date
+	Disposed string `form:"disposed" json:"disposed" xml:"disposed"`
+	// Shares sold matched with replacement shares
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Loss disallowed
+	Disallowed float64 `form:"disallowed" json:"disallowed" xml:"disallowed"`
+	// Lot of the replacement shares
+	ReplacementLotID string `form:"replacement_lot_id" json:"replacement_lot_id" xml:"replacement_lot_id"`
+	// Account of the replacement shares
+	ReplacementAccount string `form:"replacement_account" json:"replacement_account" xml:"replacement_account"`
+	// Instrument of the replacement shares
+	ReplacementSymbol string `form:"replacement_symbol" json:"replacement_symbol" xml:"replacement_symbol"`
+	// Purchase date of the replacement shares
+	ReplacementAcquired string `form:"replacement_acquired" json:"replacement_acquired" xml:"replacement_acquired"`
+	// The replacement shares are in a tax-advantaged account, so the loss is lost
+	// rather than deferred
+	Permanent bool `form:"permanent" json:"permanent" xml:"permanent"`
+}
+
+// TaxLotResponse is used to define fields on response body types.
+type TaxLotResponse struct {
+	// Ledger transaction that opened the lot
+	ID string `form:"id" json:"id" xml:"id"`
+	// Account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Acquisition date; the short sale date for short lots
+	Acquired string `form:"acquired" json:"acquired" xml:"acquired"`
+	// Start of the holding period of the oldest shares, earlier than acquired for
+	// wash sale replacement shares
+	HoldingSince string `form:"holding_since" json:"holding_since" xml:"holding_since"`
+	// Quantity held; negative for short lots
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Cost basis, including basis_adjustment; minus the net proceeds for short lots
+	CostBasis float64 `form:"cost_basis" json:"cost_basis" xml:"cost_basis"`
+	// Losses deferred into the lot by wash sales
+	BasisAdjustment float64 `form:"basis_adjustment" json:"basis_adjustment" xml:"basis_adjustment"`
+	// Cost basis per unit
+	UnitCost float64 `form:"unit_cost" json:"unit_cost" xml:"unit_cost"`
+	// Holding period of the oldest shares today
+	Term string `form:"term" json:"term" xml:"term"`
+	// Latest close, when known
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Quantity at the latest close, when known
+	MarketValue *float64 `form:"market_value,omitempty" json:"market_value,omitempty" xml:"market_value,omitempty"`
+	// Market value less cost basis, when known
+	UnrealizedGain *float64 `form:"unrealized_gain,omitempty" json:"unrealized_gain,omitempty" xml:"unrealized_gain,omitempty"`
+}
+
+// HarvestOpportunityResponseBody is used to define fields on response body
+// types.
+type HarvestOpportunityResponseBody struct {
+	// Account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument to sell
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Lots the sale relieves, first in, first out
+	Lots []string `form:"lots" json:"lots" xml:"lots"`
+	// Quantity to sell
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// Latest close
+	Price float64 `form:"price" json:"price" xml:"price"`
+	// Cost basis of the lots, including wash sale adjustments
+	CostBasis float64 `form:"cost_basis" json:"cost_basis" xml:"cost_basis"`
+	// Value of the lots at the latest close
+	MarketValue float64 `form:"market_value" json:"market_value" xml:"market_value"`
+	// Net loss realized by the sale
+	Loss float64 `form:"loss" json:"loss" xml:"loss"`
+	// Net short-term loss; negative for a net gain
+	ShortTermLoss float64 `form:"short_term_loss" json:"short_term_loss" xml:"short_term_loss"`
+	// Net long-term loss; negative for a net gain
+	LongTermLoss float64 `form:"long_term_loss" json:"long_term_loss" xml:"long_term_loss"`
+	// Tax saved on the losses at the marginal rates
+	EstimatedSavings float64 `form:"estimated_savings" json:"estimated_savings" xml:"estimated_savings"`
+	// First eligible replacement from the configured mapping, if any
+	Replacement *string `form:"replacement,omitempty" json:"replacement,omitempty" xml:"replacement,omitempty"`
+	// Latest close of the replacement, when known
+	ReplacementPrice *float64 `form:"replacement_price,omitempty" json:"replacement_price,omitempty" xml:"replacement_price,omitempty"`
+	// Quantity of the replacement the sale proceeds buy, when its price is known
+	ReplacementQuantity *float64 `form:"replacement_quantity,omitempty" json:"replacement_quantity,omitempty" xml:"replacement_quantity,omitempty"`
+	// First day the sold security can be bought back without a wash sale
+	RepurchaseAfter string `form:"repurchase_after" json:"repurchase_after" xml:"repurchase_after"`
+}
+
+// SkippedHarvestResponseBody is used to define fields on response body types.
+type SkippedHarvestResponseBody struct {
+	// Account; empty for the default account
+	Account string `form:"account" json:"account" xml:"account"`
+	// Instrument symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Net loss a sale would realize
+	Loss float64 `form:"loss" json:"loss" xml:"loss"`
+	// Why the loss is not harvested
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+}
+
+// AccountResponse is used to define fields on response body types.
+type AccountResponse struct {
+	// Account identifier transactions refer to; empty for the default account
+	ID string `form:"id" json:"id" xml:"id"`
+	// Display name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Account type
+	Type string `form:"type" json:"type" xml:"type"`
+	// How gains and income of the account are taxed
+	TaxTreatment string `form:"tax_treatment" json:"tax_treatment" xml:"tax_treatment"`
+	// Broker or institution holding the account
+	Custodian *string `form:"custodian,omitempty" json:"custodian,omitempty" xml:"custodian,omitempty"`
+	// Base currency of the account
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// Set explicitly; otherwise implied by transactions as a taxable account
+	Registered bool `form:"registered" json:"registered" xml:"registered"`
+	// Cash balance
+	Cash float64 `form:"cash" json:"cash" xml:"cash"`
+	// Market value of the positions
+	HoldingsValue float64 `form:"holdings_value" json:"holdings_value" xml:"holdings_value"`
+	// Cash plus holdings value
+	TotalValue float64 `form:"total_value" json:"total_value" xml:"total_value"`
+}
+
+// TargetWeightRequestBodyRequestBody is used to define fields on request body
+// types.
+type TargetWeightRequestBodyRequestBody struct {
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Target weight as a decimal fraction
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Absolute drift allowed either side of the target weight
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+}
+
+// BenchmarkComponentRequestBodyRequestBody is used to define fields on request
+// body types.
+type BenchmarkComponentRequestBodyRequestBody struct {
+	// Instrument symbol priced through the market data provider
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Target weight as a decimal fraction
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+}
+
+// AssetClassAssumptionRequestBody is used to define fields on request body
+// types.
+type AssetClassAssumptionRequestBody struct {
+	// Asset class, e.g. equity
+	AssetClass *string `form:"asset_class,omitempty" json:"asset_class,omitempty" xml:"asset_class,omitempty"`
+	// Share of the portfolio; defaults to the current allocation
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// Expected annual return
+	ExpectedReturn *float64 `form:"expected_return,omitempty" json:"expected_return,omitempty" xml:"expected_return,omitempty"`
+	// Annual volatility
+	Volatility *float64 `form:"volatility,omitempty" json:"volatility,omitempty" xml:"volatility,omitempty"`
+}
+
+// CorporateActionInputRequestBody is used to define fields on request body
+// types.
+type CorporateActionInputRequestBody struct {
+	// Effective date
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Action type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Symbol affected
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Renamed, spun-off or acquiring symbol
+	NewSymbol *string `form:"new_symbol,omitempty" json:"new_symbol,omitempty" xml:"new_symbol,omitempty"`
+	// Resulting shares per share held, e.g. 1.5 for a 3-for-2 split or 0.1 for a
+	// 1-for-10 reverse split
+	Ratio *float64 `form:"ratio,omitempty" json:"ratio,omitempty" xml:"ratio,omitempty"`
+	// Cash paid per share held in a merger
+	CashPerShare *float64 `form:"cash_per_share,omitempty" json:"cash_per_share,omitempty" xml:"cash_per_share,omitempty"`
+	// Share of cost basis moved to spun-off shares
+	BasisFraction *float64 `form:"basis_fraction,omitempty" json:"basis_fraction,omitempty" xml:"basis_fraction,omitempty"`
+	// Price of the resulting shares on the effective date, for cash in lieu and
+	// merger basis allocation
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Pay fractional resulting shares in cash
+	CashInLieu *bool `form:"cash_in_lieu,omitempty" json:"cash_in_lieu,omitempty" xml:"cash_in_lieu,omitempty"`
+	// Free-form note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+}
+
+// TradeInputRequestBody is used to define fields on request body types.
+type TradeInputRequestBody struct {
+	// Account trading; empty for the default account
+	Account *string `form:"account,omitempty" json:"account,omitempty" xml:"account,omitempty"`
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Trade side
+	Side *string `form:"side,omitempty" json:"side,omitempty" xml:"side,omitempty"`
+	// Quantity
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// Trade price; defaults to the latest price
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// Commission
+	Fee *float64 `form:"fee,omitempty" json:"fee,omitempty" xml:"fee,omitempty"`
+}
+
+// BacktestTargetRequestBody is used to define fields on request body types.
+type BacktestTargetRequestBody struct {
+	// Instrument symbol
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Target weight
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+}
+
 // NewGetPortfolioSummaryResponseBody builds the HTTP response body from the
 // result of the "getPortfolioSummary" endpoint of the "portfolio" service.
 func NewGetPortfolioSummaryResponseBody(res *portfolio.PortfolioSummary) *GetPortfolioSummaryResponseBody {
@@ -33,3 +2103,2611 @@ func NewGetPortfolioSummaryResponseBody(res *portfolio.PortfolioSummary) *GetPor
 	}
 	return body
 }
+
+// NewGetReturnsResponseBody builds the HTTP response body from the result of
+// the "getReturns" endpoint of the "portfolio" service.
+func NewGetReturnsResponseBody(res *portfolio.PortfolioReturns) *GetReturnsResponseBody {
+	body := &GetReturnsResponseBody{
+		PortfolioID:                  res.PortfolioID,
+		Period:                       res.Period,
+		Account:                      res.Account,
+		Start:                        res.Start,
+		End:                          res.End,
+		StartValue:                   res.StartValue,
+		EndValue:                     res.EndValue,
+		NetContributions:             res.NetContributions,
+		Gain:                         res.Gain,
+		TimeWeightedReturn:           res.TimeWeightedReturn,
+		AnnualizedTimeWeightedReturn: res.AnnualizedTimeWeightedReturn,
+		MoneyWeightedReturn:          res.MoneyWeightedReturn,
+	}
+	return body
+}
+
+// NewGetAllocationResponseBody builds the HTTP response body from the result
+// of the "getAllocation" endpoint of the "portfolio" service.
+func NewGetAllocationResponseBody(res *portfolio.Allocation) *GetAllocationResponseBody {
+	body := &GetAllocationResponseBody{
+		PortfolioID: res.PortfolioID,
+		Dimension:   res.Dimension,
+		Tag:         res.Tag,
+		Account:     res.Account,
+		AsOf:        res.AsOf,
+		Currency:    res.Currency,
+		TotalValue:  res.TotalValue,
+	}
+	if res.Buckets != nil {
+		body.Buckets = make([]*AllocationBucketResponseBody, len(res.Buckets))
+		for i, val := range res.Buckets {
+			if val == nil {
+				body.Buckets[i] = nil
+				continue
+			}
+			body.Buckets[i] = marshalPortfolioAllocationBucketToAllocationBucketResponseBody(val)
+		}
+	} else {
+		body.Buckets = []*AllocationBucketResponseBody{}
+	}
+	return body
+}
+
+// NewGetTargetAllocationResponseBody builds the HTTP response body from the
+// result of the "getTargetAllocation" endpoint of the "portfolio" service.
+func NewGetTargetAllocationResponseBody(res *portfolio.TargetAllocation) *GetTargetAllocationResponseBody {
+	body := &GetTargetAllocationResponseBody{
+		PortfolioID: res.PortfolioID,
+		CashWeight:  res.CashWeight,
+	}
+	if res.Targets != nil {
+		body.Targets = make([]*TargetWeightResponseBody, len(res.Targets))
+		for i, val := range res.Targets {
+			if val == nil {
+				body.Targets[i] = nil
+				continue
+			}
+			body.Targets[i] = marshalPortfolioTargetWeightToTargetWeightResponseBody(val)
+		}
+	} else {
+		body.Targets = []*TargetWeightResponseBody{}
+	}
+	return body
+}
+
+// NewSetTargetAllocationResponseBody builds the HTTP response body from the
+// result of the "setTargetAllocation" endpoint of the "portfolio" service.
+func NewSetTargetAllocationResponseBody(res *portfolio.TargetAllocation) *SetTargetAllocationResponseBody {
+	body := &SetTargetAllocationResponseBody{
+		PortfolioID: res.PortfolioID,
+		CashWeight:  res.CashWeight,
+	}
+	if res.Targets != nil {
+		body.Targets = make([]*TargetWeightResponseBody, len(res.Targets))
+		for i, val := range res.Targets {
+			if val == nil {
+				body.Targets[i] = nil
+				continue
+			}
+			body.Targets[i] = marshalPortfolioTargetWeightToTargetWeightResponseBody(val)
+		}
+	} else {
+		body.Targets = []*TargetWeightResponseBody{}
+	}
+	return body
+}
+
+// NewProposeRebalanceResponseBody builds the HTTP response body from the
+// result of the "proposeRebalance" endpoint of the "portfolio" service.
+func NewProposeRebalanceResponseBody(res *portfolio.RebalanceProposal) *ProposeRebalanceResponseBody {
+	body := &ProposeRebalanceResponseBody{
+		PortfolioID: res.PortfolioID,
+		AsOf:        res.AsOf,
+		CashBefore:  res.CashBefore,
+		CashAfter:   res.CashAfter,
+	}
+	if res.Trades != nil {
+		body.Trades = make([]*ProposedTradeResponseBody, len(res.Trades))
+		for i, val := range res.Trades {
+			if val == nil {
+				body.Trades[i] = nil
+				continue
+			}
+			body.Trades[i] = marshalPortfolioProposedTradeToProposedTradeResponseBody(val)
+		}
+	} else {
+		body.Trades = []*ProposedTradeResponseBody{}
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	} else {
+		body.Warnings = []string{}
+	}
+	return body
+}
+
+// NewSetBenchmarkResponseBody builds the HTTP response body from the result of
+// the "setBenchmark" endpoint of the "portfolio" service.
+func NewSetBenchmarkResponseBody(res *portfolio.BenchmarkDefinition) *SetBenchmarkResponseBody {
+	body := &SetBenchmarkResponseBody{
+		Name:      res.Name,
+		Blend:     res.Blend,
+		Rebalance: res.Rebalance,
+	}
+	if res.Components != nil {
+		body.Components = make([]*BenchmarkComponentResponseBody, len(res.Components))
+		for i, val := range res.Components {
+			if val == nil {
+				body.Components[i] = nil
+				continue
+			}
+			body.Components[i] = marshalPortfolioBenchmarkComponentToBenchmarkComponentResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetBenchmarkComparisonResponseBody builds the HTTP response body from the
+// result of the "getBenchmarkComparison" endpoint of the "portfolio" service.
+func NewGetBenchmarkComparisonResponseBody(res *portfolio.BenchmarkComparison) *GetBenchmarkComparisonResponseBody {
+	body := &GetBenchmarkComparisonResponseBody{
+		PortfolioID:     res.PortfolioID,
+		Start:           res.Start,
+		End:             res.End,
+		PortfolioReturn: res.PortfolioReturn,
+		BenchmarkReturn: res.BenchmarkReturn,
+		ExcessReturn:    res.ExcessReturn,
+		TrackingError:   res.TrackingError,
+	}
+	if res.Benchmark != nil {
+		body.Benchmark = marshalPortfolioBenchmarkDefinitionToBenchmarkDefinitionResponseBody(res.Benchmark)
+	}
+	if res.Series != nil {
+		body.Series = make([]*BenchmarkComparisonPointResponseBody, len(res.Series))
+		for i, val := range res.Series {
+			if val == nil {
+				body.Series[i] = nil
+				continue
+			}
+			body.Series[i] = marshalPortfolioBenchmarkComparisonPointToBenchmarkComparisonPointResponseBody(val)
+		}
+	} else {
+		body.Series = []*BenchmarkComparisonPointResponseBody{}
+	}
+	return body
+}
+
+// NewGetRiskMetricsResponseBody builds the HTTP response body from the result
+// of the "getRiskMetrics" endpoint of the "portfolio" service.
+func NewGetRiskMetricsResponseBody(res *portfolio.RiskMetrics) *GetRiskMetricsResponseBody {
+	body := &GetRiskMetricsResponseBody{
+		PortfolioID:       res.PortfolioID,
+		Start:             res.Start,
+		End:               res.End,
+		RiskFreeRate:      res.RiskFreeRate,
+		Volatility:        res.Volatility,
+		SharpeRatio:       res.SharpeRatio,
+		SortinoRatio:      res.SortinoRatio,
+		Beta:              res.Beta,
+		Correlation:       res.Correlation,
+		MaxDrawdown:       res.MaxDrawdown,
+		MaxDrawdownPeak:   res.MaxDrawdownPeak,
+		MaxDrawdownTrough: res.MaxDrawdownTrough,
+	}
+	if res.Benchmark != nil {
+		body.Benchmark = marshalPortfolioBenchmarkDefinitionToBenchmarkDefinitionResponseBody(res.Benchmark)
+	}
+	if res.Rolling != nil {
+		body.Rolling = make([]*RiskWindowResponseBody, len(res.Rolling))
+		for i, val := range res.Rolling {
+			if val == nil {
+				body.Rolling[i] = nil
+				continue
+			}
+			body.Rolling[i] = marshalPortfolioRiskWindowToRiskWindowResponseBody(val)
+		}
+	} else {
+		body.Rolling = []*RiskWindowResponseBody{}
+	}
+	return body
+}
+
+// NewGetValueAtRiskResponseBody builds the HTTP response body from the result
+// of the "getValueAtRisk" endpoint of the "portfolio" service.
+func NewGetValueAtRiskResponseBody(res *portfolio.ValueAtRisk) *GetValueAtRiskResponseBody {
+	body := &GetValueAtRiskResponseBody{
+		PortfolioID:       res.PortfolioID,
+		AsOf:              res.AsOf,
+		Method:            res.Method,
+		Confidence:        res.Confidence,
+		Horizon:           res.Horizon,
+		Lookback:          res.Lookback,
+		PortfolioValue:    res.PortfolioValue,
+		ValueAtRisk:       res.ValueAtRisk,
+		ExpectedShortfall: res.ExpectedShortfall,
+	}
+	if res.Contributions != nil {
+		body.Contributions = make([]*VaRContributionResponseBody, len(res.Contributions))
+		for i, val := range res.Contributions {
+			if val == nil {
+				body.Contributions[i] = nil
+				continue
+			}
+			body.Contributions[i] = marshalPortfolioVaRContributionToVaRContributionResponseBody(val)
+		}
+	} else {
+		body.Contributions = []*VaRContributionResponseBody{}
+	}
+	return body
+}
+
+// NewListStressScenariosResponseBody builds the HTTP response body from the
+// result of the "listStressScenarios" endpoint of the "portfolio" service.
+func NewListStressScenariosResponseBody(res []*portfolio.StressScenario) ListStressScenariosResponseBody {
+	body := make([]*StressScenarioResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioStressScenarioToStressScenarioResponse(val)
+	}
+	return body
+}
+
+// NewRunStressTestResponseBody builds the HTTP response body from the result
+// of the "runStressTest" endpoint of the "portfolio" service.
+func NewRunStressTestResponseBody(res *portfolio.StressTestResult) *RunStressTestResponseBody {
+	body := &RunStressTestResponseBody{
+		PortfolioID:    res.PortfolioID,
+		AsOf:           res.AsOf,
+		Currency:       res.Currency,
+		CurrentValue:   res.CurrentValue,
+		ProjectedValue: res.ProjectedValue,
+		Loss:           res.Loss,
+		LossPercent:    res.LossPercent,
+	}
+	if res.Scenario != nil {
+		body.Scenario = marshalPortfolioStressScenarioToStressScenarioResponseBody(res.Scenario)
+	}
+	if res.WorstContributors != nil {
+		body.WorstContributors = make([]*StressImpactResponseBody, len(res.WorstContributors))
+		for i, val := range res.WorstContributors {
+			if val == nil {
+				body.WorstContributors[i] = nil
+				continue
+			}
+			body.WorstContributors[i] = marshalPortfolioStressImpactToStressImpactResponseBody(val)
+		}
+	} else {
+		body.WorstContributors = []*StressImpactResponseBody{}
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	} else {
+		body.Warnings = []string{}
+	}
+	return body
+}
+
+// NewProjectPortfolioResponseBody builds the HTTP response body from the
+// result of the "projectPortfolio" endpoint of the "portfolio" service.
+func NewProjectPortfolioResponseBody(res *portfolio.Projection) *ProjectPortfolioResponseBody {
+	body := &ProjectPortfolioResponseBody{
+		PortfolioID:          res.PortfolioID,
+		Start:                res.Start,
+		End:                  res.End,
+		StartValue:           res.StartValue,
+		Paths:                res.Paths,
+		Goal:                 res.Goal,
+		GoalDate:             res.GoalDate,
+		GoalProbability:      res.GoalProbability,
+		DepletionProbability: res.DepletionProbability,
+	}
+	if res.Assumptions != nil {
+		body.Assumptions = make([]*AssetClassAssumptionResponseBody, len(res.Assumptions))
+		for i, val := range res.Assumptions {
+			if val == nil {
+				body.Assumptions[i] = nil
+				continue
+			}
+			body.Assumptions[i] = marshalPortfolioAssetClassAssumptionToAssetClassAssumptionResponseBody(val)
+		}
+	} else {
+		body.Assumptions = []*AssetClassAssumptionResponseBody{}
+	}
+	if res.Bands != nil {
+		body.Bands = make([]*ProjectionBandResponseBody, len(res.Bands))
+		for i, val := range res.Bands {
+			if val == nil {
+				body.Bands[i] = nil
+				continue
+			}
+			body.Bands[i] = marshalPortfolioProjectionBandToProjectionBandResponseBody(val)
+		}
+	} else {
+		body.Bands = []*ProjectionBandResponseBody{}
+	}
+	return body
+}
+
+// NewListCorporateActionsResponseBody builds the HTTP response body from the
+// result of the "listCorporateActions" endpoint of the "portfolio" service.
+func NewListCorporateActionsResponseBody(res []*portfolio.CorporateAction) ListCorporateActionsResponseBody {
+	body := make([]*CorporateActionResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioCorporateActionToCorporateActionResponse(val)
+	}
+	return body
+}
+
+// NewApplyCorporateActionsResponseBody builds the HTTP response body from the
+// result of the "applyCorporateActions" endpoint of the "portfolio" service.
+func NewApplyCorporateActionsResponseBody(res []*portfolio.CorporateAction) ApplyCorporateActionsResponseBody {
+	body := make([]*CorporateActionResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioCorporateActionToCorporateActionResponse(val)
+	}
+	return body
+}
+
+// NewRecordIncomeResponseBody builds the HTTP response body from the result of
+// the "recordIncome" endpoint of the "portfolio" service.
+func NewRecordIncomeResponseBody(res *portfolio.IncomeRecord) *RecordIncomeResponseBody {
+	body := &RecordIncomeResponseBody{
+		ID:                 res.ID,
+		Type:               res.Type,
+		Symbol:             res.Symbol,
+		Account:            res.Account,
+		PayDate:            res.PayDate,
+		ExDate:             res.ExDate,
+		Amount:             res.Amount,
+		Withholding:        res.Withholding,
+		Net:                res.Net,
+		Qualified:          res.Qualified,
+		ReinvestmentID:     res.ReinvestmentID,
+		ReinvestedQuantity: res.ReinvestedQuantity,
+	}
+	return body
+}
+
+// NewGetIncomeResponseBody builds the HTTP response body from the result of
+// the "getIncome" endpoint of the "portfolio" service.
+func NewGetIncomeResponseBody(res *portfolio.IncomeSummary) *GetIncomeResponseBody {
+	body := &GetIncomeResponseBody{
+		PortfolioID:   res.PortfolioID,
+		Start:         res.Start,
+		End:           res.End,
+		Currency:      res.Currency,
+		Interval:      res.Interval,
+		Gross:         res.Gross,
+		Withholding:   res.Withholding,
+		Net:           res.Net,
+		Qualified:     res.Qualified,
+		Ordinary:      res.Ordinary,
+		ForecastTotal: res.ForecastTotal,
+	}
+	if res.ByPeriod != nil {
+		body.ByPeriod = make([]*IncomeBucketResponseBody, len(res.ByPeriod))
+		for i, val := range res.ByPeriod {
+			if val == nil {
+				body.ByPeriod[i] = nil
+				continue
+			}
+			body.ByPeriod[i] = marshalPortfolioIncomeBucketToIncomeBucketResponseBody(val)
+		}
+	} else {
+		body.ByPeriod = []*IncomeBucketResponseBody{}
+	}
+	if res.ByHolding != nil {
+		body.ByHolding = make([]*IncomeBucketResponseBody, len(res.ByHolding))
+		for i, val := range res.ByHolding {
+			if val == nil {
+				body.ByHolding[i] = nil
+				continue
+			}
+			body.ByHolding[i] = marshalPortfolioIncomeBucketToIncomeBucketResponseBody(val)
+		}
+	} else {
+		body.ByHolding = []*IncomeBucketResponseBody{}
+	}
+	if res.ByCurrency != nil {
+		body.ByCurrency = make([]*IncomeBucketResponseBody, len(res.ByCurrency))
+		for i, val := range res.ByCurrency {
+			if val == nil {
+				body.ByCurrency[i] = nil
+				continue
+			}
+			body.ByCurrency[i] = marshalPortfolioIncomeBucketToIncomeBucketResponseBody(val)
+		}
+	} else {
+		body.ByCurrency = []*IncomeBucketResponseBody{}
+	}
+	if res.Forecast != nil {
+		body.Forecast = make([]*IncomeForecastResponseBody, len(res.Forecast))
+		for i, val := range res.Forecast {
+			if val == nil {
+				body.Forecast[i] = nil
+				continue
+			}
+			body.Forecast[i] = marshalPortfolioIncomeForecastToIncomeForecastResponseBody(val)
+		}
+	} else {
+		body.Forecast = []*IncomeForecastResponseBody{}
+	}
+	return body
+}
+
+// NewPriceBondResponseBody builds the HTTP response body from the result of
+// the "priceBond" endpoint of the "portfolio" service.
+func NewPriceBondResponseBody(res *portfolio.BondAnalytics) *PriceBondResponseBody {
+	body := &PriceBondResponseBody{
+		Settlement:       res.Settlement,
+		CleanPrice:       res.CleanPrice,
+		DirtyPrice:       res.DirtyPrice,
+		AccruedInterest:  res.AccruedInterest,
+		YieldToMaturity:  res.YieldToMaturity,
+		MacaulayDuration: res.MacaulayDuration,
+		ModifiedDuration: res.ModifiedDuration,
+	}
+	return body
+}
+
+// NewListBondPositionsResponseBody builds the HTTP response body from the
+// result of the "listBondPositions" endpoint of the "portfolio" service.
+func NewListBondPositionsResponseBody(res []*portfolio.BondPosition) ListBondPositionsResponseBody {
+	body := make([]*BondPositionResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioBondPositionToBondPositionResponse(val)
+	}
+	return body
+}
+
+// NewGetCashFlowLadderResponseBody builds the HTTP response body from the
+// result of the "getCashFlowLadder" endpoint of the "portfolio" service.
+func NewGetCashFlowLadderResponseBody(res *portfolio.CashFlowLadder) *GetCashFlowLadderResponseBody {
+	body := &GetCashFlowLadderResponseBody{
+		PortfolioID: res.PortfolioID,
+		AsOf:        res.AsOf,
+		End:         res.End,
+		Currency:    res.Currency,
+		Total:       res.Total,
+	}
+	if res.Flows != nil {
+		body.Flows = make([]*BondCashFlowResponseBody, len(res.Flows))
+		for i, val := range res.Flows {
+			if val == nil {
+				body.Flows[i] = nil
+				continue
+			}
+			body.Flows[i] = marshalPortfolioBondCashFlowToBondCashFlowResponseBody(val)
+		}
+	} else {
+		body.Flows = []*BondCashFlowResponseBody{}
+	}
+	if res.ByYear != nil {
+		body.ByYear = make([]*CashFlowLadderYearResponseBody, len(res.ByYear))
+		for i, val := range res.ByYear {
+			if val == nil {
+				body.ByYear[i] = nil
+				continue
+			}
+			body.ByYear[i] = marshalPortfolioCashFlowLadderYearToCashFlowLadderYearResponseBody(val)
+		}
+	} else {
+		body.ByYear = []*CashFlowLadderYearResponseBody{}
+	}
+	return body
+}
+
+// NewListOptionPositionsResponseBody builds the HTTP response body from the
+// result of the "listOptionPositions" endpoint of the "portfolio" service.
+func NewListOptionPositionsResponseBody(res *portfolio.OptionPositions) *ListOptionPositionsResponseBody {
+	body := &ListOptionPositionsResponseBody{
+		PortfolioID: res.PortfolioID,
+		AsOf:        res.AsOf,
+		Currency:    res.Currency,
+	}
+	if res.Positions != nil {
+		body.Positions = make([]*OptionPositionResponseBody, len(res.Positions))
+		for i, val := range res.Positions {
+			if val == nil {
+				body.Positions[i] = nil
+				continue
+			}
+			body.Positions[i] = marshalPortfolioOptionPositionToOptionPositionResponseBody(val)
+		}
+	} else {
+		body.Positions = []*OptionPositionResponseBody{}
+	}
+	if res.Exposures != nil {
+		body.Exposures = make([]*UnderlyingExposureResponseBody, len(res.Exposures))
+		for i, val := range res.Exposures {
+			if val == nil {
+				body.Exposures[i] = nil
+				continue
+			}
+			body.Exposures[i] = marshalPortfolioUnderlyingExposureToUnderlyingExposureResponseBody(val)
+		}
+	} else {
+		body.Exposures = []*UnderlyingExposureResponseBody{}
+	}
+	return body
+}
+
+// NewSettleOptionExpiriesResponseBody builds the HTTP response body from the
+// result of the "settleOptionExpiries" endpoint of the "portfolio" service.
+func NewSettleOptionExpiriesResponseBody(res []*portfolio.OptionSettlement) SettleOptionExpiriesResponseBody {
+	body := make([]*OptionSettlementResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioOptionSettlementToOptionSettlementResponse(val)
+	}
+	return body
+}
+
+// NewSetMarginAccountResponseBody builds the HTTP response body from the
+// result of the "setMarginAccount" endpoint of the "portfolio" service.
+func NewSetMarginAccountResponseBody(res *portfolio.MarginTerms) *SetMarginAccountResponseBody {
+	body := &SetMarginAccountResponseBody{
+		Account:                     res.Account,
+		InitialRequirement:          res.InitialRequirement,
+		MaintenanceRequirement:      res.MaintenanceRequirement,
+		ShortMaintenanceRequirement: res.ShortMaintenanceRequirement,
+		LoanRate:                    res.LoanRate,
+		BorrowRate:                  res.BorrowRate,
+	}
+	if res.BorrowRates != nil {
+		body.BorrowRates = make(map[string]float64, len(res.BorrowRates))
+		for key, val := range res.BorrowRates {
+			tk := key
+			tv := val
+			body.BorrowRates[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetMarginStatusResponseBody builds the HTTP response body from the result
+// of the "getMarginStatus" endpoint of the "portfolio" service.
+func NewGetMarginStatusResponseBody(res *portfolio.MarginStatus) *GetMarginStatusResponseBody {
+	body := &GetMarginStatusResponseBody{
+		PortfolioID: res.PortfolioID,
+		AsOf:        res.AsOf,
+		Currency:    res.Currency,
+	}
+	if res.Accounts != nil {
+		body.Accounts = make([]*MarginAccountStatusResponseBody, len(res.Accounts))
+		for i, val := range res.Accounts {
+			if val == nil {
+				body.Accounts[i] = nil
+				continue
+			}
+			body.Accounts[i] = marshalPortfolioMarginAccountStatusToMarginAccountStatusResponseBody(val)
+		}
+	} else {
+		body.Accounts = []*MarginAccountStatusResponseBody{}
+	}
+	return body
+}
+
+// NewChargeMarginResponseBody builds the HTTP response body from the result of
+// the "chargeMargin" endpoint of the "portfolio" service.
+func NewChargeMarginResponseBody(res *portfolio.MarginStatus) *ChargeMarginResponseBody {
+	body := &ChargeMarginResponseBody{
+		PortfolioID: res.PortfolioID,
+		AsOf:        res.AsOf,
+		Currency:    res.Currency,
+	}
+	if res.Accounts != nil {
+		body.Accounts = make([]*MarginAccountStatusResponseBody, len(res.Accounts))
+		for i, val := range res.Accounts {
+			if val == nil {
+				body.Accounts[i] = nil
+				continue
+			}
+			body.Accounts[i] = marshalPortfolioMarginAccountStatusToMarginAccountStatusResponseBody(val)
+		}
+	} else {
+		body.Accounts = []*MarginAccountStatusResponseBody{}
+	}
+	return body
+}
+
+// NewListEventsResponseBody builds the HTTP response body from the result of
+// the "listEvents" endpoint of the "portfolio" service.
+func NewListEventsResponseBody(res []*portfolio.PortfolioEvent) ListEventsResponseBody {
+	body := make([]*PortfolioEventResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioPortfolioEventToPortfolioEventResponse(val)
+	}
+	return body
+}
+
+// NewPlaceOrderResponseBody builds the HTTP response body from the result of
+// the "placeOrder" endpoint of the "portfolio" service.
+func NewPlaceOrderResponseBody(res *portfolio.Order) *PlaceOrderResponseBody {
+	body := &PlaceOrderResponseBody{
+		ID:          res.ID,
+		Account:     res.Account,
+		Symbol:      res.Symbol,
+		Side:        res.Side,
+		Type:        res.Type,
+		Quantity:    res.Quantity,
+		LimitPrice:  res.LimitPrice,
+		StopPrice:   res.StopPrice,
+		TimeInForce: res.TimeInForce,
+		Status:      res.Status,
+		Triggered:   res.Triggered,
+		Created:     res.Created,
+		Updated:     res.Updated,
+		FillPrice:   res.FillPrice,
+		Commission:  res.Commission,
+		Reason:      res.Reason,
+	}
+	return body
+}
+
+// NewListOrdersResponseBody builds the HTTP response body from the result of
+// the "listOrders" endpoint of the "portfolio" service.
+func NewListOrdersResponseBody(res []*portfolio.Order) ListOrdersResponseBody {
+	body := make([]*OrderResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioOrderToOrderResponse(val)
+	}
+	return body
+}
+
+// NewCancelOrderResponseBody builds the HTTP response body from the result of
+// the "cancelOrder" endpoint of the "portfolio" service.
+func NewCancelOrderResponseBody(res *portfolio.Order) *CancelOrderResponseBody {
+	body := &CancelOrderResponseBody{
+		ID:          res.ID,
+		Account:     res.Account,
+		Symbol:      res.Symbol,
+		Side:        res.Side,
+		Type:        res.Type,
+		Quantity:    res.Quantity,
+		LimitPrice:  res.LimitPrice,
+		StopPrice:   res.StopPrice,
+		TimeInForce: res.TimeInForce,
+		Status:      res.Status,
+		Triggered:   res.Triggered,
+		Created:     res.Created,
+		Updated:     res.Updated,
+		FillPrice:   res.FillPrice,
+		Commission:  res.Commission,
+		Reason:      res.Reason,
+	}
+	return body
+}
+
+// NewPreviewTradesResponseBody builds the HTTP response body from the result
+// of the "previewTrades" endpoint of the "portfolio" service.
+func NewPreviewTradesResponseBody(res *portfolio.TradePreview) *PreviewTradesResponseBody {
+	body := &PreviewTradesResponseBody{
+		PortfolioID:   res.PortfolioID,
+		AsOf:          res.AsOf,
+		Currency:      res.Currency,
+		CashBefore:    res.CashBefore,
+		CashAfter:     res.CashAfter,
+		Dimension:     res.Dimension,
+		ShortTermGain: res.ShortTermGain,
+		LongTermGain:  res.LongTermGain,
+		EstimatedTax:  res.EstimatedTax,
+	}
+	if res.Before != nil {
+		body.Before = marshalPortfolioPortfolioSummaryToPortfolioSummaryResponseBody(res.Before)
+	}
+	if res.After != nil {
+		body.After = marshalPortfolioPortfolioSummaryToPortfolioSummaryResponseBody(res.After)
+	}
+	if res.Allocation != nil {
+		body.Allocation = make([]*AllocationChangeResponseBody, len(res.Allocation))
+		for i, val := range res.Allocation {
+			if val == nil {
+				body.Allocation[i] = nil
+				continue
+			}
+			body.Allocation[i] = marshalPortfolioAllocationChangeToAllocationChangeResponseBody(val)
+		}
+	} else {
+		body.Allocation = []*AllocationChangeResponseBody{}
+	}
+	if res.Realized != nil {
+		body.Realized = make([]*RealizedLotResponseBody, len(res.Realized))
+		for i, val := range res.Realized {
+			if val == nil {
+				body.Realized[i] = nil
+				continue
+			}
+			body.Realized[i] = marshalPortfolioRealizedLotToRealizedLotResponseBody(val)
+		}
+	} else {
+		body.Realized = []*RealizedLotResponseBody{}
+	}
+	if res.RiskBefore != nil {
+		body.RiskBefore = marshalPortfolioHoldingsRiskToHoldingsRiskResponseBody(res.RiskBefore)
+	}
+	if res.RiskAfter != nil {
+		body.RiskAfter = marshalPortfolioHoldingsRiskToHoldingsRiskResponseBody(res.RiskAfter)
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	} else {
+		body.Warnings = []string{}
+	}
+	return body
+}
+
+// NewRunBacktestResponseBody builds the HTTP response body from the result of
+// the "runBacktest" endpoint of the "portfolio" service.
+func NewRunBacktestResponseBody(res *portfolio.BacktestResult) *RunBacktestResponseBody {
+	body := &RunBacktestResponseBody{
+		Name:                res.Name,
+		Strategy:            res.Strategy,
+		Start:               res.Start,
+		End:                 res.End,
+		Currency:            res.Currency,
+		EndValue:            res.EndValue,
+		NetContributions:    res.NetContributions,
+		Gain:                res.Gain,
+		TimeWeightedReturn:  res.TimeWeightedReturn,
+		AnnualizedReturn:    res.AnnualizedReturn,
+		MoneyWeightedReturn: res.MoneyWeightedReturn,
+		Volatility:          res.Volatility,
+		SharpeRatio:         res.SharpeRatio,
+		MaxDrawdown:         res.MaxDrawdown,
+		MaxDrawdownPeak:     res.MaxDrawdownPeak,
+		MaxDrawdownTrough:   res.MaxDrawdownTrough,
+		Trades:              res.Trades,
+		TradedValue:         res.TradedValue,
+		Fees:                res.Fees,
+		Turnover:            res.Turnover,
+	}
+	if res.EquityCurve != nil {
+		body.EquityCurve = make([]*BacktestPointResponseBody, len(res.EquityCurve))
+		for i, val := range res.EquityCurve {
+			if val == nil {
+				body.EquityCurve[i] = nil
+				continue
+			}
+			body.EquityCurve[i] = marshalPortfolioBacktestPointToBacktestPointResponseBody(val)
+		}
+	} else {
+		body.EquityCurve = []*BacktestPointResponseBody{}
+	}
+	return body
+}
+
+// NewImportCsvResponseBody builds the HTTP response body from the result of
+// the "importCsv" endpoint of the "portfolio" service.
+func NewImportCsvResponseBody(res *portfolio.ImportReport) *ImportCsvResponseBody {
+	body := &ImportCsvResponseBody{
+		PortfolioID: res.PortfolioID,
+		Format:      res.Format,
+		Profile:     res.Profile,
+		DryRun:      res.DryRun,
+		Imported:    res.Imported,
+		Duplicates:  res.Duplicates,
+		Skipped:     res.Skipped,
+		Errors:      res.Errors,
+	}
+	if res.Rows != nil {
+		body.Rows = make([]*ImportRowResponseBody, len(res.Rows))
+		for i, val := range res.Rows {
+			if val == nil {
+				body.Rows[i] = nil
+				continue
+			}
+			body.Rows[i] = marshalPortfolioImportRowToImportRowResponseBody(val)
+		}
+	} else {
+		body.Rows = []*ImportRowResponseBody{}
+	}
+	if res.Reconciliation != nil {
+		body.Reconciliation = make([]*AccountReconciliationResponseBody, len(res.Reconciliation))
+		for i, val := range res.Reconciliation {
+			if val == nil {
+				body.Reconciliation[i] = nil
+				continue
+			}
+			body.Reconciliation[i] = marshalPortfolioAccountReconciliationToAccountReconciliationResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewImportOfxResponseBody builds the HTTP response body from the result of
+// the "importOfx" endpoint of the "portfolio" service.
+func NewImportOfxResponseBody(res *portfolio.ImportReport) *ImportOfxResponseBody {
+	body := &ImportOfxResponseBody{
+		PortfolioID: res.PortfolioID,
+		Format:      res.Format,
+		Profile:     res.Profile,
+		DryRun:      res.DryRun,
+		Imported:    res.Imported,
+		Duplicates:  res.Duplicates,
+		Skipped:     res.Skipped,
+		Errors:      res.Errors,
+	}
+	if res.Rows != nil {
+		body.Rows = make([]*ImportRowResponseBody, len(res.Rows))
+		for i, val := range res.Rows {
+			if val == nil {
+				body.Rows[i] = nil
+				continue
+			}
+			body.Rows[i] = marshalPortfolioImportRowToImportRowResponseBody(val)
+		}
+	} else {
+		body.Rows = []*ImportRowResponseBody{}
+	}
+	if res.Reconciliation != nil {
+		body.Reconciliation = make([]*AccountReconciliationResponseBody, len(res.Reconciliation))
+		for i, val := range res.Reconciliation {
+			if val == nil {
+				body.Reconciliation[i] = nil
+				continue
+			}
+			body.Reconciliation[i] = marshalPortfolioAccountReconciliationToAccountReconciliationResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewImportJournalResponseBody builds the HTTP response body from the result
+// of the "importJournal" endpoint of the "portfolio" service.
+func NewImportJournalResponseBody(res *portfolio.ImportReport) *ImportJournalResponseBody {
+	body := &ImportJournalResponseBody{
+		PortfolioID: res.PortfolioID,
+		Format:      res.Format,
+		Profile:     res.Profile,
+		DryRun:      res.DryRun,
+		Imported:    res.Imported,
+		Duplicates:  res.Duplicates,
+		Skipped:     res.Skipped,
+		Errors:      res.Errors,
+	}
+	if res.Rows != nil {
+		body.Rows = make([]*ImportRowResponseBody, len(res.Rows))
+		for i, val := range res.Rows {
+			if val == nil {
+				body.Rows[i] = nil
+				continue
+			}
+			body.Rows[i] = marshalPortfolioImportRowToImportRowResponseBody(val)
+		}
+	} else {
+		body.Rows = []*ImportRowResponseBody{}
+	}
+	if res.Reconciliation != nil {
+		body.Reconciliation = make([]*AccountReconciliationResponseBody, len(res.Reconciliation))
+		for i, val := range res.Reconciliation {
+			if val == nil {
+				body.Reconciliation[i] = nil
+				continue
+			}
+			body.Reconciliation[i] = marshalPortfolioAccountReconciliationToAccountReconciliationResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewImportArchiveResponseBody builds the HTTP response body from the result
+// of the "importArchive" endpoint of the "portfolio" service.
+func NewImportArchiveResponseBody(res *portfolio.ArchiveImport) *ImportArchiveResponseBody {
+	body := &ImportArchiveResponseBody{
+		PortfolioID:    res.PortfolioID,
+		SchemaVersion:  res.SchemaVersion,
+		Migrated:       res.Migrated,
+		Replaced:       res.Replaced,
+		CreatedAt:      res.CreatedAt,
+		Transactions:   res.Transactions,
+		Instruments:    res.Instruments,
+		Targets:        res.Targets,
+		Accounts:       res.Accounts,
+		MarginAccounts: res.MarginAccounts,
+	}
+	return body
+}
+
+// NewReconcilePositionsResponseBody builds the HTTP response body from the
+// result of the "reconcilePositions" endpoint of the "portfolio" service.
+func NewReconcilePositionsResponseBody(res *portfolio.Reconciliation) *ReconcilePositionsResponseBody {
+	body := &ReconcilePositionsResponseBody{
+		PortfolioID: res.PortfolioID,
+		Format:      res.Format,
+		Opened:      res.Opened,
+		Updated:     res.Updated,
+		Resolved:    res.Resolved,
+	}
+	if res.Accounts != nil {
+		body.Accounts = make([]*ReconciledAccountResponseBody, len(res.Accounts))
+		for i, val := range res.Accounts {
+			if val == nil {
+				body.Accounts[i] = nil
+				continue
+			}
+			body.Accounts[i] = marshalPortfolioReconciledAccountToReconciledAccountResponseBody(val)
+		}
+	} else {
+		body.Accounts = []*ReconciledAccountResponseBody{}
+	}
+	if res.Breaks != nil {
+		body.Breaks = make([]*ReconciliationBreakResponseBody, len(res.Breaks))
+		for i, val := range res.Breaks {
+			if val == nil {
+				body.Breaks[i] = nil
+				continue
+			}
+			body.Breaks[i] = marshalPortfolioReconciliationBreakToReconciliationBreakResponseBody(val)
+		}
+	} else {
+		body.Breaks = []*ReconciliationBreakResponseBody{}
+	}
+	return body
+}
+
+// NewListReconciliationBreaksResponseBody builds the HTTP response body from
+// the result of the "listReconciliationBreaks" endpoint of the "portfolio"
+// service.
+func NewListReconciliationBreaksResponseBody(res []*portfolio.ReconciliationBreak) ListReconciliationBreaksResponseBody {
+	body := make([]*ReconciliationBreakResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioReconciliationBreakToReconciliationBreakResponse(val)
+	}
+	return body
+}
+
+// NewUpdateReconciliationBreakResponseBody builds the HTTP response body from
+// the result of the "updateReconciliationBreak" endpoint of the "portfolio"
+// service.
+func NewUpdateReconciliationBreakResponseBody(res *portfolio.ReconciliationBreak) *UpdateReconciliationBreakResponseBody {
+	body := &UpdateReconciliationBreakResponseBody{
+		ID:         res.ID,
+		Account:    res.Account,
+		AsOf:       res.AsOf,
+		Kind:       res.Kind,
+		Symbol:     res.Symbol,
+		Statement:  res.Statement,
+		Ledger:     res.Ledger,
+		Difference: res.Difference,
+		Price:      res.Price,
+		Status:     res.Status,
+		Note:       res.Note,
+		Detected:   res.Detected,
+		Updated:    res.Updated,
+	}
+	if res.Adjustments != nil {
+		body.Adjustments = make([]*LedgerTransactionResponseBody, len(res.Adjustments))
+		for i, val := range res.Adjustments {
+			if val == nil {
+				body.Adjustments[i] = nil
+				continue
+			}
+			body.Adjustments[i] = marshalPortfolioLedgerTransactionToLedgerTransactionResponseBody(val)
+		}
+	} else {
+		body.Adjustments = []*LedgerTransactionResponseBody{}
+	}
+	if res.Posted != nil {
+		body.Posted = make([]string, len(res.Posted))
+		for i, val := range res.Posted {
+			body.Posted[i] = val
+		}
+	}
+	return body
+}
+
+// NewGetTaxReportResponseBody builds the HTTP response body from the result of
+// the "getTaxReport" endpoint of the "portfolio" service.
+func NewGetTaxReportResponseBody(res *portfolio.TaxReport) *GetTaxReportResponseBody {
+	body := &GetTaxReportResponseBody{
+		PortfolioID: res.PortfolioID,
+		Year:        res.Year,
+		Currency:    res.Currency,
+	}
+	if res.Accounts != nil {
+		body.Accounts = make([]*TaxAccountResponseBody, len(res.Accounts))
+		for i, val := range res.Accounts {
+			if val == nil {
+				body.Accounts[i] = nil
+				continue
+			}
+			body.Accounts[i] = marshalPortfolioTaxAccountToTaxAccountResponseBody(val)
+		}
+	} else {
+		body.Accounts = []*TaxAccountResponseBody{}
+	}
+	if res.ShortTerm != nil {
+		body.ShortTerm = marshalPortfolioTaxTotalsToTaxTotalsResponseBody(res.ShortTerm)
+	}
+	if res.LongTerm != nil {
+		body.LongTerm = marshalPortfolioTaxTotalsToTaxTotalsResponseBody(res.LongTerm)
+	}
+	if res.Total != nil {
+		body.Total = marshalPortfolioTaxTotalsToTaxTotalsResponseBody(res.Total)
+	}
+	if res.WashSales != nil {
+		body.WashSales = make([]*WashSaleResponseBody, len(res.WashSales))
+		for i, val := range res.WashSales {
+			if val == nil {
+				body.WashSales[i] = nil
+				continue
+			}
+			body.WashSales[i] = marshalPortfolioWashSaleToWashSaleResponseBody(val)
+		}
+	} else {
+		body.WashSales = []*WashSaleResponseBody{}
+	}
+	return body
+}
+
+// NewListLotsResponseBody builds the HTTP response body from the result of the
+// "listLots" endpoint of the "portfolio" service.
+func NewListLotsResponseBody(res []*portfolio.TaxLot) ListLotsResponseBody {
+	body := make([]*TaxLotResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioTaxLotToTaxLotResponse(val)
+	}
+	return body
+}
+
+// NewFindTaxLossHarvestsResponseBody builds the HTTP response body from the
+// result of the "findTaxLossHarvests" endpoint of the "portfolio" service.
+func NewFindTaxLossHarvestsResponseBody(res *portfolio.TaxLossHarvest) *FindTaxLossHarvestsResponseBody {
+	body := &FindTaxLossHarvestsResponseBody{
+		PortfolioID:      res.PortfolioID,
+		AsOf:             res.AsOf,
+		TotalLoss:        res.TotalLoss,
+		EstimatedSavings: res.EstimatedSavings,
+	}
+	if res.Opportunities != nil {
+		body.Opportunities = make([]*HarvestOpportunityResponseBody, len(res.Opportunities))
+		for i, val := range res.Opportunities {
+			if val == nil {
+				body.Opportunities[i] = nil
+				continue
+			}
+			body.Opportunities[i] = marshalPortfolioHarvestOpportunityToHarvestOpportunityResponseBody(val)
+		}
+	} else {
+		body.Opportunities = []*HarvestOpportunityResponseBody{}
+	}
+	if res.Skipped != nil {
+		body.Skipped = make([]*SkippedHarvestResponseBody, len(res.Skipped))
+		for i, val := range res.Skipped {
+			if val == nil {
+				body.Skipped[i] = nil
+				continue
+			}
+			body.Skipped[i] = marshalPortfolioSkippedHarvestToSkippedHarvestResponseBody(val)
+		}
+	} else {
+		body.Skipped = []*SkippedHarvestResponseBody{}
+	}
+	return body
+}
+
+// NewListAccountsResponseBody builds the HTTP response body from the result of
+// the "listAccounts" endpoint of the "portfolio" service.
+func NewListAccountsResponseBody(res []*portfolio.Account) ListAccountsResponseBody {
+	body := make([]*AccountResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalPortfolioAccountToAccountResponse(val)
+	}
+	return body
+}
+
+// NewSetAccountResponseBody builds the HTTP response body from the result of
+// the "setAccount" endpoint of the "portfolio" service.
+func NewSetAccountResponseBody(res *portfolio.Account) *SetAccountResponseBody {
+	body := &SetAccountResponseBody{
+		ID:            res.ID,
+		Name:          res.Name,
+		Type:          res.Type,
+		TaxTreatment:  res.TaxTreatment,
+		Custodian:     res.Custodian,
+		Currency:      res.Currency,
+		Registered:    res.Registered,
+		Cash:          res.Cash,
+		HoldingsValue: res.HoldingsValue,
+		TotalValue:    res.TotalValue,
+	}
+	return body
+}
+
+// NewRemoveAccountResponseBody builds the HTTP response body from the result
+// of the "removeAccount" endpoint of the "portfolio" service.
+func NewRemoveAccountResponseBody(res *portfolio.Account) *RemoveAccountResponseBody {
+	body := &RemoveAccountResponseBody{
+		ID:            res.ID,
+		Name:          res.Name,
+		Type:          res.Type,
+		TaxTreatment:  res.TaxTreatment,
+		Custodian:     res.Custodian,
+		Currency:      res.Currency,
+		Registered:    res.Registered,
+		Cash:          res.Cash,
+		HoldingsValue: res.HoldingsValue,
+		TotalValue:    res.TotalValue,
+	}
+	return body
+}
+
+// NewGetPortfolioSummaryPayload builds a portfolio service getPortfolioSummary
+// endpoint payload.
+func NewGetPortfolioSummaryPayload(portfolioID string, account *string) *portfolio.GetPortfolioSummaryPayload {
+	v := &portfolio.GetPortfolioSummaryPayload{}
+	v.PortfolioID = portfolioID
+	v.Account = account
+
+	return v
+}
+
+// NewGetReturnsPayload builds a portfolio service getReturns endpoint payload.
+func NewGetReturnsPayload(portfolioID string, period string, start *string, end *string, account *string) *portfolio.GetReturnsPayload {
+	v := &portfolio.GetReturnsPayload{}
+	v.PortfolioID = portfolioID
+	v.Period = period
+	v.Start = start
+	v.End = end
+	v.Account = account
+
+	return v
+}
+
+// NewGetAllocationPayload builds a portfolio service getAllocation endpoint
+// payload.
+func NewGetAllocationPayload(portfolioID string, dimension string, tag *string, account *string) *portfolio.GetAllocationPayload {
+	v := &portfolio.GetAllocationPayload{}
+	v.PortfolioID = portfolioID
+	v.Dimension = dimension
+	v.Tag = tag
+	v.Account = account
+
+	return v
+}
+
+// NewGetTargetAllocationPayload builds a portfolio service getTargetAllocation
+// endpoint payload.
+func NewGetTargetAllocationPayload(portfolioID string) *portfolio.GetTargetAllocationPayload {
+	v := &portfolio.GetTargetAllocationPayload{}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewSetTargetAllocationPayload builds a portfolio service setTargetAllocation
+// endpoint payload.
+func NewSetTargetAllocationPayload(body []*TargetWeightRequestBodyRequestBody, portfolioID string) *portfolio.SetTargetAllocationPayload {
+	v := make([]*portfolio.TargetWeight, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalTargetWeightRequestBodyRequestBodyToPortfolioTargetWeight(val)
+	}
+	res := &portfolio.SetTargetAllocationPayload{
+		Targets: v,
+	}
+	res.PortfolioID = portfolioID
+
+	return res
+}
+
+// NewProposeRebalancePayload builds a portfolio service proposeRebalance
+// endpoint payload.
+func NewProposeRebalancePayload(body struct {
+	// Drop trades worth less than this amount
+	MinTradeValue *float64 `form:"min_trade_value" json:"min_trade_value" xml:"min_trade_value"`
+	// Only sell lots that are long-term or at a loss
+	AvoidShortTermGains *bool `form:"avoid_short_term_gains" json:"avoid_short_term_gains" xml:"avoid_short_term_gains"`
+}, portfolioID string) *portfolio.ProposeRebalancePayload {
+	v := &portfolio.ProposeRebalancePayload{}
+	if body.MinTradeValue != nil {
+		v.MinTradeValue = *body.MinTradeValue
+	}
+	if body.AvoidShortTermGains != nil {
+		v.AvoidShortTermGains = *body.AvoidShortTermGains
+	}
+	if body.MinTradeValue == nil {
+		v.MinTradeValue = 0
+	}
+	if body.AvoidShortTermGains == nil {
+		v.AvoidShortTermGains = false
+	}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewSetBenchmarkPayload builds a portfolio service setBenchmark endpoint
+// payload.
+func NewSetBenchmarkPayload(body *SetBenchmarkRequestBody, portfolioID string) *portfolio.SetBenchmarkPayload {
+	v := &portfolio.BenchmarkDefinition{
+		Name:      *body.Name,
+		Blend:     body.Blend,
+		Rebalance: *body.Rebalance,
+	}
+	if body.Components != nil {
+		v.Components = make([]*portfolio.BenchmarkComponent, len(body.Components))
+		for i, val := range body.Components {
+			if val == nil {
+				v.Components[i] = nil
+				continue
+			}
+			v.Components[i] = unmarshalBenchmarkComponentRequestBodyRequestBodyToPortfolioBenchmarkComponent(val)
+		}
+	}
+	res := &portfolio.SetBenchmarkPayload{
+		Benchmark: v,
+	}
+	res.PortfolioID = portfolioID
+
+	return res
+}
+
+// NewGetBenchmarkComparisonPayload builds a portfolio service
+// getBenchmarkComparison endpoint payload.
+func NewGetBenchmarkComparisonPayload(portfolioID string, period string, start *string, end *string) *portfolio.GetBenchmarkComparisonPayload {
+	v := &portfolio.GetBenchmarkComparisonPayload{}
+	v.PortfolioID = portfolioID
+	v.Period = period
+	v.Start = start
+	v.End = end
+
+	return v
+}
+
+// NewGetRiskMetricsPayload builds a portfolio service getRiskMetrics endpoint
+// payload.
+func NewGetRiskMetricsPayload(portfolioID string, period string, start *string, end *string, riskFreeRate *float64, window *int) *portfolio.GetRiskMetricsPayload {
+	v := &portfolio.GetRiskMetricsPayload{}
+	v.PortfolioID = portfolioID
+	v.Period = period
+	v.Start = start
+	v.End = end
+	v.RiskFreeRate = riskFreeRate
+	v.Window = window
+
+	return v
+}
+
+// NewGetValueAtRiskPayload builds a portfolio service getValueAtRisk endpoint
+// payload.
+func NewGetValueAtRiskPayload(portfolioID string, method string, confidence float64, horizon int, lookback int, simulations int, seed *int64) *portfolio.GetValueAtRiskPayload {
+	v := &portfolio.GetValueAtRiskPayload{}
+	v.PortfolioID = portfolioID
+	v.Method = method
+	v.Confidence = confidence
+	v.Horizon = horizon
+	v.Lookback = lookback
+	v.Simulations = simulations
+	v.Seed = seed
+
+	return v
+}
+
+// NewRunStressTestPayload builds a portfolio service runStressTest endpoint
+// payload.
+func NewRunStressTestPayload(portfolioID string, scenario string, top int) *portfolio.RunStressTestPayload {
+	v := &portfolio.RunStressTestPayload{}
+	v.PortfolioID = portfolioID
+	v.Scenario = scenario
+	v.Top = top
+
+	return v
+}
+
+// NewProjectPortfolioPayload builds a portfolio service projectPortfolio
+// endpoint payload.
+func NewProjectPortfolioPayload(body *ProjectPortfolioRequestBody, portfolioID string) *portfolio.ProjectPortfolioPayload {
+	v := &portfolio.ProjectPortfolioPayload{
+		End:             *body.End,
+		StartValue:      body.StartValue,
+		WithdrawalStart: body.WithdrawalStart,
+		Goal:            body.Goal,
+		GoalDate:        body.GoalDate,
+		Seed:            body.Seed,
+	}
+	if body.MonthlyContribution != nil {
+		v.MonthlyContribution = *body.MonthlyContribution
+	}
+	if body.MonthlyWithdrawal != nil {
+		v.MonthlyWithdrawal = *body.MonthlyWithdrawal
+	}
+	if body.Inflation != nil {
+		v.Inflation = *body.Inflation
+	}
+	if body.Paths != nil {
+		v.Paths = *body.Paths
+	}
+	if body.MonthlyContribution == nil {
+		v.MonthlyContribution = 0
+	}
+	if body.MonthlyWithdrawal == nil {
+		v.MonthlyWithdrawal = 0
+	}
+	if body.Inflation == nil {
+		v.Inflation = 0.02
+	}
+	if body.Assumptions != nil {
+		v.Assumptions = make([]*portfolio.AssetClassAssumption, len(body.Assumptions))
+		for i, val := range body.Assumptions {
+			if val == nil {
+				v.Assumptions[i] = nil
+				continue
+			}
+			v.Assumptions[i] = unmarshalAssetClassAssumptionRequestBodyToPortfolioAssetClassAssumption(val)
+		}
+	}
+	if body.Paths == nil {
+		v.Paths = 5000
+	}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewListCorporateActionsPayload builds a portfolio service
+// listCorporateActions endpoint payload.
+func NewListCorporateActionsPayload(portfolioID string) *portfolio.ListCorporateActionsPayload {
+	v := &portfolio.ListCorporateActionsPayload{}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewApplyCorporateActionsPayload builds a portfolio service
+// applyCorporateActions endpoint payload.
+func NewApplyCorporateActionsPayload(body *ApplyCorporateActionsRequestBody, portfolioID string) *portfolio.ApplyCorporateActionsPayload {
+	v := &portfolio.ApplyCorporateActionsPayload{}
+	v.Actions = make([]*portfolio.CorporateActionInput, len(body.Actions))
+	for i, val := range body.Actions {
+		if val == nil {
+			v.Actions[i] = nil
+			continue
+		}
+		v.Actions[i] = unmarshalCorporateActionInputRequestBodyToPortfolioCorporateActionInput(val)
+	}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewRecordIncomePayload builds a portfolio service recordIncome endpoint
+// payload.
+func NewRecordIncomePayload(body *RecordIncomeRequestBody, portfolioID string) *portfolio.RecordIncomePayload {
+	v := &portfolio.RecordIncomePayload{
+		Symbol:  body.Symbol,
+		PayDate: *body.PayDate,
+		ExDate:  body.ExDate,
+		Amount:  *body.Amount,
+		Price:   body.Price,
+		Note:    body.Note,
+	}
+	if body.Type != nil {
+		v.Type = *body.Type
+	}
+	if body.Account != nil {
+		v.Account = *body.Account
+	}
+	if body.Withholding != nil {
+		v.Withholding = *body.Withholding
+	}
+	if body.Qualified != nil {
+		v.Qualified = *body.Qualified
+	}
+	if body.Reinvest != nil {
+		v.Reinvest = *body.Reinvest
+	}
+	if body.Type == nil {
+		v.Type = "dividend"
+	}
+	if body.Account == nil {
+		v.Account = ""
+	}
+	if body.Withholding == nil {
+		v.Withholding = 0
+	}
+	if body.Qualified == nil {
+		v.Qualified = false
+	}
+	if body.Reinvest == nil {
+		v.Reinvest = false
+	}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewGetIncomePayload builds a portfolio service getIncome endpoint payload.
+func NewGetIncomePayload(portfolioID string, period string, start *string, end *string, interval string) *portfolio.GetIncomePayload {
+	v := &portfolio.GetIncomePayload{}
+	v.PortfolioID = portfolioID
+	v.Period = period
+	v.Start = start
+	v.End = end
+	v.Interval = interval
+
+	return v
+}
+
+// NewPriceBondPayload builds a portfolio service priceBond endpoint payload.
+func NewPriceBondPayload(body *PriceBondRequestBody) *portfolio.PriceBondPayload {
+	v := &portfolio.PriceBondPayload{
+		Maturity:   *body.Maturity,
+		Settlement: body.Settlement,
+		CleanPrice: body.CleanPrice,
+		Yield:      body.Yield,
+	}
+	if body.Coupon != nil {
+		v.Coupon = *body.Coupon
+	}
+	if body.CouponFrequency != nil {
+		v.CouponFrequency = *body.CouponFrequency
+	}
+	if body.DayCount != nil {
+		v.DayCount = *body.DayCount
+	}
+	if body.FaceValue != nil {
+		v.FaceValue = *body.FaceValue
+	}
+	if body.Coupon == nil {
+		v.Coupon = 0
+	}
+	if body.CouponFrequency == nil {
+		v.CouponFrequency = 2
+	}
+	if body.DayCount == nil {
+		v.DayCount = "30/360"
+	}
+	if body.FaceValue == nil {
+		v.FaceValue = 100
+	}
+
+	return v
+}
+
+// NewListBondPositionsPayload builds a portfolio service listBondPositions
+// endpoint payload.
+func NewListBondPositionsPayload(portfolioID string) *portfolio.ListBondPositionsPayload {
+	v := &portfolio.ListBondPositionsPayload{}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewGetCashFlowLadderPayload builds a portfolio service getCashFlowLadder
+// endpoint payload.
+func NewGetCashFlowLadderPayload(portfolioID string, years int) *portfolio.GetCashFlowLadderPayload {
+	v := &portfolio.GetCashFlowLadderPayload{}
+	v.PortfolioID = portfolioID
+	v.Years = years
+
+	return v
+}
+
+// NewListOptionPositionsPayload builds a portfolio service listOptionPositions
+// endpoint payload.
+func NewListOptionPositionsPayload(portfolioID string, volatility *float64) *portfolio.ListOptionPositionsPayload {
+	v := &portfolio.ListOptionPositionsPayload{}
+	v.PortfolioID = portfolioID
+	v.Volatility = volatility
+
+	return v
+}
+
+// NewSettleOptionExpiriesPayload builds a portfolio service
+// settleOptionExpiries endpoint payload.
+func NewSettleOptionExpiriesPayload(portfolioID string) *portfolio.SettleOptionExpiriesPayload {
+	v := &portfolio.SettleOptionExpiriesPayload{}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewSetMarginAccountPayload builds a portfolio service setMarginAccount
+// endpoint payload.
+func NewSetMarginAccountPayload(body *SetMarginAccountRequestBody, portfolioID string) *portfolio.SetMarginAccountPayload {
+	v := &portfolio.SetMarginAccountPayload{
+		Account: *body.Account,
+	}
+	if body.InitialRequirement != nil {
+		v.InitialRequirement = *body.InitialRequirement
+	}
+	if body.MaintenanceRequirement != nil {
+		v.MaintenanceRequirement = *body.MaintenanceRequirement
+	}
+	if body.ShortMaintenanceRequirement != nil {
+		v.ShortMaintenanceRequirement = *body.ShortMaintenanceRequirement
+	}
+	if body.LoanRate != nil {
+		v.LoanRate = *body.LoanRate
+	}
+	if body.BorrowRate != nil {
+		v.BorrowRate = *body.BorrowRate
+	}
+	if body.InitialRequirement == nil {
+		v.InitialRequirement = 0.5
+	}
+	if body.MaintenanceRequirement == nil {
+		v.MaintenanceRequirement = 0.25
+	}
+	if body.ShortMaintenanceRequirement == nil {
+		v.ShortMaintenanceRequirement = 0.3
+	}
+	if body.LoanRate == nil {
+		v.LoanRate = 0
+	}
+	if body.BorrowRate == nil {
+		v.BorrowRate = 0
+	}
+	if body.BorrowRates != nil {
+		v.BorrowRates = make(map[string]float64, len(body.BorrowRates))
+		for key, val := range body.BorrowRates {
+			tk := key
+			tv := val
+			v.BorrowRates[tk] = tv
+		}
+	}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewGetMarginStatusPayload builds a portfolio service getMarginStatus
+// endpoint payload.
+func NewGetMarginStatusPayload(portfolioID string) *portfolio.GetMarginStatusPayload {
+	v := &portfolio.GetMarginStatusPayload{}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewChargeMarginPayload builds a portfolio service chargeMargin endpoint
+// payload.
+func NewChargeMarginPayload(portfolioID string) *portfolio.ChargeMarginPayload {
+	v := &portfolio.ChargeMarginPayload{}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewListEventsPayload builds a portfolio service listEvents endpoint payload.
+func NewListEventsPayload(portfolioID string, since *string, type_ *string) *portfolio.ListEventsPayload {
+	v := &portfolio.ListEventsPayload{}
+	v.PortfolioID = portfolioID
+	v.Since = since
+	v.Type = type_
+
+	return v
+}
+
+// NewPlaceOrderPayload builds a portfolio service placeOrder endpoint payload.
+func NewPlaceOrderPayload(body *PlaceOrderRequestBody, portfolioID string) *portfolio.PlaceOrderPayload {
+	v := &portfolio.PlaceOrderPayload{
+		Account:    body.Account,
+		Symbol:     *body.Symbol,
+		Side:       *body.Side,
+		Quantity:   *body.Quantity,
+		LimitPrice: body.LimitPrice,
+		StopPrice:  body.StopPrice,
+	}
+	if body.Type != nil {
+		v.Type = *body.Type
+	}
+	if body.TimeInForce != nil {
+		v.TimeInForce = *body.TimeInForce
+	}
+	if body.Type == nil {
+		v.Type = "market"
+	}
+	if body.TimeInForce == nil {
+		v.TimeInForce = "day"
+	}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewListOrdersPayload builds a portfolio service listOrders endpoint payload.
+func NewListOrdersPayload(portfolioID string, status *string) *portfolio.ListOrdersPayload {
+	v := &portfolio.ListOrdersPayload{}
+	v.PortfolioID = portfolioID
+	v.Status = status
+
+	return v
+}
+
+// NewCancelOrderPayload builds a portfolio service cancelOrder endpoint
+// payload.
+func NewCancelOrderPayload(portfolioID string, id string) *portfolio.CancelOrderPayload {
+	v := &portfolio.CancelOrderPayload{}
+	v.PortfolioID = portfolioID
+	v.ID = id
+
+	return v
+}
+
+// NewPreviewTradesPayload builds a portfolio service previewTrades endpoint
+// payload.
+func NewPreviewTradesPayload(body *PreviewTradesRequestBody, portfolioID string) *portfolio.PreviewTradesPayload {
+	v := &portfolio.PreviewTradesPayload{}
+	if body.Dimension != nil {
+		v.Dimension = *body.Dimension
+	}
+	if body.ShortTermTaxRate != nil {
+		v.ShortTermTaxRate = *body.ShortTermTaxRate
+	}
+	if body.LongTermTaxRate != nil {
+		v.LongTermTaxRate = *body.LongTermTaxRate
+	}
+	if body.Lookback != nil {
+		v.Lookback = *body.Lookback
+	}
+	v.Trades = make([]*portfolio.TradeInput, len(body.Trades))
+	for i, val := range body.Trades {
+		if val == nil {
+			v.Trades[i] = nil
+			continue
+		}
+		v.Trades[i] = unmarshalTradeInputRequestBodyToPortfolioTradeInput(val)
+	}
+	if body.Dimension == nil {
+		v.Dimension = "asset_class"
+	}
+	if body.ShortTermTaxRate == nil {
+		v.ShortTermTaxRate = 0.24
+	}
+	if body.LongTermTaxRate == nil {
+		v.LongTermTaxRate = 0.15
+	}
+	if body.Lookback == nil {
+		v.Lookback = 252
+	}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewRunBacktestPayload builds a portfolio service runBacktest endpoint
+// payload.
+func NewRunBacktestPayload(body *RunBacktestRequestBody) *portfolio.RunBacktestPayload {
+	v := &portfolio.RunBacktestPayload{
+		Strategy: *body.Strategy,
+		Start:    *body.Start,
+		End:      *body.End,
+	}
+	if body.Name != nil {
+		v.Name = *body.Name
+	}
+	if body.Currency != nil {
+		v.Currency = *body.Currency
+	}
+	if body.InitialCash != nil {
+		v.InitialCash = *body.InitialCash
+	}
+	if body.Frequency != nil {
+		v.Frequency = *body.Frequency
+	}
+	if body.Threshold != nil {
+		v.Threshold = *body.Threshold
+	}
+	if body.Contribution != nil {
+		v.Contribution = *body.Contribution
+	}
+	if body.FeeRate != nil {
+		v.FeeRate = *body.FeeRate
+	}
+	if body.Name == nil {
+		v.Name = ""
+	}
+	if body.Currency == nil {
+		v.Currency = "USD"
+	}
+	if body.InitialCash == nil {
+		v.InitialCash = 0
+	}
+	v.Targets = make([]*portfolio.BacktestTarget, len(body.Targets))
+	for i, val := range body.Targets {
+		if val == nil {
+			v.Targets[i] = nil
+			continue
+		}
+		v.Targets[i] = unmarshalBacktestTargetRequestBodyToPortfolioBacktestTarget(val)
+	}
+	if body.Frequency == nil {
+		v.Frequency = "monthly"
+	}
+	if body.Threshold == nil {
+		v.Threshold = 0
+	}
+	if body.Contribution == nil {
+		v.Contribution = 0
+	}
+	if body.FeeRate == nil {
+		v.FeeRate = 0
+	}
+
+	return v
+}
+
+// NewImportCsvPayload builds a portfolio service importCsv endpoint payload.
+func NewImportCsvPayload(body *ImportCsvRequestBody, portfolioID string, profile string, account string, dryRun bool) *portfolio.ImportCsvPayload {
+	v := &portfolio.ImportCsvPayload{
+		Content: *body.Content,
+	}
+	v.PortfolioID = portfolioID
+	v.Profile = profile
+	v.Account = account
+	v.DryRun = dryRun
+
+	return v
+}
+
+// NewImportOfxPayload builds a portfolio service importOfx endpoint payload.
+func NewImportOfxPayload(body *ImportOfxRequestBody, portfolioID string, account string, dryRun bool) *portfolio.ImportOfxPayload {
+	v := &portfolio.ImportOfxPayload{
+		Content: *body.Content,
+	}
+	v.PortfolioID = portfolioID
+	v.Account = account
+	v.DryRun = dryRun
+
+	return v
+}
+
+// NewExportJournalPayload builds a portfolio service exportJournal endpoint
+// payload.
+func NewExportJournalPayload(portfolioID string, format string) *portfolio.ExportJournalPayload {
+	v := &portfolio.ExportJournalPayload{}
+	v.PortfolioID = portfolioID
+	v.Format = format
+
+	return v
+}
+
+// NewImportJournalPayload builds a portfolio service importJournal endpoint
+// payload.
+func NewImportJournalPayload(body *ImportJournalRequestBody, portfolioID string, account string, root string, dryRun bool) *portfolio.ImportJournalPayload {
+	v := &portfolio.ImportJournalPayload{
+		Content: *body.Content,
+	}
+	v.PortfolioID = portfolioID
+	v.Account = account
+	v.Root = root
+	v.DryRun = dryRun
+
+	return v
+}
+
+// NewExportArchivePayload builds a portfolio service exportArchive endpoint
+// payload.
+func NewExportArchivePayload(portfolioID string) *portfolio.ExportArchivePayload {
+	v := &portfolio.ExportArchivePayload{}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewImportArchivePayload builds a portfolio service importArchive endpoint
+// payload.
+func NewImportArchivePayload(body *ImportArchiveRequestBody, portfolioID *string, replace bool) *portfolio.ImportArchivePayload {
+	v := &portfolio.ImportArchivePayload{
+		Content: *body.Content,
+	}
+	v.PortfolioID = portfolioID
+	v.Replace = replace
+
+	return v
+}
+
+// NewReconcilePositionsPayload builds a portfolio service reconcilePositions
+// endpoint payload.
+func NewReconcilePositionsPayload(body *ReconcilePositionsRequestBody, portfolioID string, format string, profile string, account string, asOf *string) *portfolio.ReconcilePositionsPayload {
+	v := &portfolio.ReconcilePositionsPayload{
+		Content: *body.Content,
+	}
+	v.PortfolioID = portfolioID
+	v.Format = format
+	v.Profile = profile
+	v.Account = account
+	v.AsOf = asOf
+
+	return v
+}
+
+// NewListReconciliationBreaksPayload builds a portfolio service
+// listReconciliationBreaks endpoint payload.
+func NewListReconciliationBreaksPayload(portfolioID string, status *string, account *string) *portfolio.ListReconciliationBreaksPayload {
+	v := &portfolio.ListReconciliationBreaksPayload{}
+	v.PortfolioID = portfolioID
+	v.Status = status
+	v.Account = account
+
+	return v
+}
+
+// NewUpdateReconciliationBreakPayload builds a portfolio service
+// updateReconciliationBreak endpoint payload.
+func NewUpdateReconciliationBreakPayload(portfolioID string, id string, status string, note *string, apply bool) *portfolio.UpdateReconciliationBreakPayload {
+	v := &portfolio.UpdateReconciliationBreakPayload{}
+	v.PortfolioID = portfolioID
+	v.ID = id
+	v.Status = status
+	v.Note = note
+	v.Apply = apply
+
+	return v
+}
+
+// NewGetTaxReportPayload builds a portfolio service getTaxReport endpoint
+// payload.
+func NewGetTaxReportPayload(portfolioID string, year int) *portfolio.GetTaxReportPayload {
+	v := &portfolio.GetTaxReportPayload{}
+	v.PortfolioID = portfolioID
+	v.Year = year
+
+	return v
+}
+
+// NewExportTaxReportPayload builds a portfolio service exportTaxReport
+// endpoint payload.
+func NewExportTaxReportPayload(portfolioID string, year int, format string) *portfolio.ExportTaxReportPayload {
+	v := &portfolio.ExportTaxReportPayload{}
+	v.PortfolioID = portfolioID
+	v.Year = year
+	v.Format = format
+
+	return v
+}
+
+// NewListLotsPayload builds a portfolio service listLots endpoint payload.
+func NewListLotsPayload(portfolioID string, account *string, symbol *string) *portfolio.ListLotsPayload {
+	v := &portfolio.ListLotsPayload{}
+	v.PortfolioID = portfolioID
+	v.Account = account
+	v.Symbol = symbol
+
+	return v
+}
+
+// NewFindTaxLossHarvestsPayload builds a portfolio service findTaxLossHarvests
+// endpoint payload.
+func NewFindTaxLossHarvestsPayload(portfolioID string, account *string, minLoss float64, shortTermRate float64, longTermRate float64) *portfolio.FindTaxLossHarvestsPayload {
+	v := &portfolio.FindTaxLossHarvestsPayload{}
+	v.PortfolioID = portfolioID
+	v.Account = account
+	v.MinLoss = minLoss
+	v.ShortTermRate = shortTermRate
+	v.LongTermRate = longTermRate
+
+	return v
+}
+
+// NewListAccountsPayload builds a portfolio service listAccounts endpoint
+// payload.
+func NewListAccountsPayload(portfolioID string) *portfolio.ListAccountsPayload {
+	v := &portfolio.ListAccountsPayload{}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewSetAccountPayload builds a portfolio service setAccount endpoint payload.
+func NewSetAccountPayload(body *SetAccountRequestBody, portfolioID string) *portfolio.SetAccountPayload {
+	v := &portfolio.SetAccountPayload{
+		ID:           *body.ID,
+		Name:         body.Name,
+		TaxTreatment: body.TaxTreatment,
+		Custodian:    body.Custodian,
+		Currency:     body.Currency,
+	}
+	if body.Type != nil {
+		v.Type = *body.Type
+	}
+	if body.Type == nil {
+		v.Type = "taxable"
+	}
+	v.PortfolioID = portfolioID
+
+	return v
+}
+
+// NewRemoveAccountPayload builds a portfolio service removeAccount endpoint
+// payload.
+func NewRemoveAccountPayload(portfolioID string, id string) *portfolio.RemoveAccountPayload {
+	v := &portfolio.RemoveAccountPayload{}
+	v.PortfolioID = portfolioID
+	v.ID = id
+
+	return v
+}
+
+// ValidateSetBenchmarkRequestBody runs the validations defined on
+// SetBenchmarkRequestBody
+func ValidateSetBenchmarkRequestBody(body *SetBenchmarkRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Rebalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rebalance", "body"))
+	}
+	for _, e := range body.Components {
+		if e != nil {
+			if err2 := ValidateBenchmarkComponentRequestBodyRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Rebalance != nil {
+		if !(*body.Rebalance == "none" || *body.Rebalance == "daily" || *body.Rebalance == "monthly" || *body.Rebalance == "quarterly" || *body.Rebalance == "annual") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.rebalance", *body.Rebalance, []any{"none", "daily", "monthly", "quarterly", "annual"}))
+		}
+	}
+	return
+}
+
+// ValidateProjectPortfolioRequestBody runs the validations defined on
+// ProjectPortfolioRequestBody
+func ValidateProjectPortfolioRequestBody(body *ProjectPortfolioRequestBody) (err error) {
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", *body.End, goa.FormatDate))
+	}
+	if body.StartValue != nil {
+		if *body.StartValue < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.start_value", *body.StartValue, 0, true))
+		}
+	}
+	if body.MonthlyContribution != nil {
+		if *body.MonthlyContribution < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.monthly_contribution", *body.MonthlyContribution, 0, true))
+		}
+	}
+	if body.MonthlyWithdrawal != nil {
+		if *body.MonthlyWithdrawal < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.monthly_withdrawal", *body.MonthlyWithdrawal, 0, true))
+		}
+	}
+	if body.WithdrawalStart != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.withdrawal_start", *body.WithdrawalStart, goa.FormatDate))
+	}
+	if body.Goal != nil {
+		if *body.Goal < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.goal", *body.Goal, 0, true))
+		}
+	}
+	if body.GoalDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.goal_date", *body.GoalDate, goa.FormatDate))
+	}
+	for _, e := range body.Assumptions {
+		if e != nil {
+			if err2 := ValidateAssetClassAssumptionRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Paths != nil {
+		if *body.Paths < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.paths", *body.Paths, 1, true))
+		}
+	}
+	if body.Paths != nil {
+		if *body.Paths > 100000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.paths", *body.Paths, 100000, false))
+		}
+	}
+	return
+}
+
+// ValidateApplyCorporateActionsRequestBody runs the validations defined on
+// ApplyCorporateActionsRequestBody
+func ValidateApplyCorporateActionsRequestBody(body *ApplyCorporateActionsRequestBody) (err error) {
+	if body.Actions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actions", "body"))
+	}
+	for _, e := range body.Actions {
+		if e != nil {
+			if err2 := ValidateCorporateActionInputRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRecordIncomeRequestBody runs the validations defined on
+// RecordIncomeRequestBody
+func ValidateRecordIncomeRequestBody(body *RecordIncomeRequestBody) (err error) {
+	if body.PayDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pay_date", "body"))
+	}
+	if body.Amount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("amount", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "dividend" || *body.Type == "interest") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"dividend", "interest"}))
+		}
+	}
+	if body.PayDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.pay_date", *body.PayDate, goa.FormatDate))
+	}
+	if body.ExDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.ex_date", *body.ExDate, goa.FormatDate))
+	}
+	if body.Amount != nil {
+		if *body.Amount < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.amount", *body.Amount, 0, true))
+		}
+	}
+	if body.Withholding != nil {
+		if *body.Withholding < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.withholding", *body.Withholding, 0, true))
+		}
+	}
+	if body.Price != nil {
+		if *body.Price < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.price", *body.Price, 0, true))
+		}
+	}
+	return
+}
+
+// ValidatePriceBondRequestBody runs the validations defined on
+// PriceBondRequestBody
+func ValidatePriceBondRequestBody(body *PriceBondRequestBody) (err error) {
+	if body.Maturity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("maturity", "body"))
+	}
+	if body.Coupon != nil {
+		if *body.Coupon < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.coupon", *body.Coupon, 0, true))
+		}
+	}
+	if body.CouponFrequency != nil {
+		if !(*body.CouponFrequency == 0 || *body.CouponFrequency == 1 || *body.CouponFrequency == 2 || *body.CouponFrequency == 4 || *body.CouponFrequency == 12) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.coupon_frequency", *body.CouponFrequency, []any{0, 1, 2, 4, 12}))
+		}
+	}
+	if body.DayCount != nil {
+		if !(*body.DayCount == "30/360" || *body.DayCount == "ACT/360" || *body.DayCount == "ACT/365" || *body.DayCount == "ACT/ACT") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.day_count", *body.DayCount, []any{"30/360", "ACT/360", "ACT/365", "ACT/ACT"}))
+		}
+	}
+	if body.Maturity != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.maturity", *body.Maturity, goa.FormatDate))
+	}
+	if body.FaceValue != nil {
+		if *body.FaceValue < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.face_value", *body.FaceValue, 0, true))
+		}
+	}
+	if body.Settlement != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.settlement", *body.Settlement, goa.FormatDate))
+	}
+	if body.CleanPrice != nil {
+		if *body.CleanPrice < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.clean_price", *body.CleanPrice, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateSetMarginAccountRequestBody runs the validations defined on
+// SetMarginAccountRequestBody
+func ValidateSetMarginAccountRequestBody(body *SetMarginAccountRequestBody) (err error) {
+	if body.Account == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account", "body"))
+	}
+	if body.InitialRequirement != nil {
+		if *body.InitialRequirement < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_requirement", *body.InitialRequirement, 0, true))
+		}
+	}
+	if body.InitialRequirement != nil {
+		if *body.InitialRequirement > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_requirement", *body.InitialRequirement, 1, false))
+		}
+	}
+	if body.MaintenanceRequirement != nil {
+		if *body.MaintenanceRequirement < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maintenance_requirement", *body.MaintenanceRequirement, 0, true))
+		}
+	}
+	if body.MaintenanceRequirement != nil {
+		if *body.MaintenanceRequirement > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.maintenance_requirement", *body.MaintenanceRequirement, 1, false))
+		}
+	}
+	if body.ShortMaintenanceRequirement != nil {
+		if *body.ShortMaintenanceRequirement < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.short_maintenance_requirement", *body.ShortMaintenanceRequirement, 0, true))
+		}
+	}
+	if body.ShortMaintenanceRequirement != nil {
+		if *body.ShortMaintenanceRequirement > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.short_maintenance_requirement", *body.ShortMaintenanceRequirement, 1, false))
+		}
+	}
+	if body.LoanRate != nil {
+		if *body.LoanRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.loan_rate", *body.LoanRate, 0, true))
+		}
+	}
+	if body.BorrowRate != nil {
+		if *body.BorrowRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.borrow_rate", *body.BorrowRate, 0, true))
+		}
+	}
+	return
+}
+
+// ValidatePlaceOrderRequestBody runs the validations defined on
+// PlaceOrderRequestBody
+func ValidatePlaceOrderRequestBody(body *PlaceOrderRequestBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Side != nil {
+		if !(*body.Side == "buy" || *body.Side == "sell") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", *body.Side, []any{"buy", "sell"}))
+		}
+	}
+	if body.Type != nil {
+		if !(*body.Type == "market" || *body.Type == "limit" || *body.Type == "stop" || *body.Type == "stop_limit") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"market", "limit", "stop", "stop_limit"}))
+		}
+	}
+	if body.Quantity != nil {
+		if *body.Quantity < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.quantity", *body.Quantity, 0, true))
+		}
+	}
+	if body.LimitPrice != nil {
+		if *body.LimitPrice < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit_price", *body.LimitPrice, 0, true))
+		}
+	}
+	if body.StopPrice != nil {
+		if *body.StopPrice < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.stop_price", *body.StopPrice, 0, true))
+		}
+	}
+	if body.TimeInForce != nil {
+		if !(*body.TimeInForce == "day" || *body.TimeInForce == "gtc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.time_in_force", *body.TimeInForce, []any{"day", "gtc"}))
+		}
+	}
+	return
+}
+
+// ValidatePreviewTradesRequestBody runs the validations defined on
+// PreviewTradesRequestBody
+func ValidatePreviewTradesRequestBody(body *PreviewTradesRequestBody) (err error) {
+	if body.Trades == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trades", "body"))
+	}
+	if len(body.Trades) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.trades", body.Trades, len(body.Trades), 1, true))
+	}
+	for _, e := range body.Trades {
+		if e != nil {
+			if err2 := ValidateTradeInputRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Dimension != nil {
+		if !(*body.Dimension == "asset_class" || *body.Dimension == "sector" || *body.Dimension == "country" || *body.Dimension == "region" || *body.Dimension == "currency" || *body.Dimension == "account") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.dimension", *body.Dimension, []any{"asset_class", "sector", "country", "region", "currency", "account"}))
+		}
+	}
+	if body.ShortTermTaxRate != nil {
+		if *body.ShortTermTaxRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.short_term_tax_rate", *body.ShortTermTaxRate, 0, true))
+		}
+	}
+	if body.ShortTermTaxRate != nil {
+		if *body.ShortTermTaxRate > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.short_term_tax_rate", *body.ShortTermTaxRate, 1, false))
+		}
+	}
+	if body.LongTermTaxRate != nil {
+		if *body.LongTermTaxRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.long_term_tax_rate", *body.LongTermTaxRate, 0, true))
+		}
+	}
+	if body.LongTermTaxRate != nil {
+		if *body.LongTermTaxRate > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.long_term_tax_rate", *body.LongTermTaxRate, 1, false))
+		}
+	}
+	if body.Lookback != nil {
+		if *body.Lookback < 2 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.lookback", *body.Lookback, 2, true))
+		}
+	}
+	return
+}
+
+// ValidateRunBacktestRequestBody runs the validations defined on
+// RunBacktestRequestBody
+func ValidateRunBacktestRequestBody(body *RunBacktestRequestBody) (err error) {
+	if body.Strategy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("strategy", "body"))
+	}
+	if body.Start == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start", "body"))
+	}
+	if body.End == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end", "body"))
+	}
+	if body.Targets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("targets", "body"))
+	}
+	if body.Strategy != nil {
+		if !(*body.Strategy == "buy_and_hold" || *body.Strategy == "periodic_rebalance" || *body.Strategy == "threshold_rebalance" || *body.Strategy == "dca") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.strategy", *body.Strategy, []any{"buy_and_hold", "periodic_rebalance", "threshold_rebalance", "dca"}))
+		}
+	}
+	if body.Start != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.start", *body.Start, goa.FormatDate))
+	}
+	if body.End != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.end", *body.End, goa.FormatDate))
+	}
+	if body.InitialCash != nil {
+		if *body.InitialCash < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.initial_cash", *body.InitialCash, 0, true))
+		}
+	}
+	if len(body.Targets) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.targets", body.Targets, len(body.Targets), 1, true))
+	}
+	for _, e := range body.Targets {
+		if e != nil {
+			if err2 := ValidateBacktestTargetRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Frequency != nil {
+		if !(*body.Frequency == "monthly" || *body.Frequency == "quarterly" || *body.Frequency == "annual") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.frequency", *body.Frequency, []any{"monthly", "quarterly", "annual"}))
+		}
+	}
+	if body.Threshold != nil {
+		if *body.Threshold < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", *body.Threshold, 0, true))
+		}
+	}
+	if body.Contribution != nil {
+		if *body.Contribution < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.contribution", *body.Contribution, 0, true))
+		}
+	}
+	if body.FeeRate != nil {
+		if *body.FeeRate < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.fee_rate", *body.FeeRate, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateImportCsvRequestBody runs the validations defined on
+// ImportCsvRequestBody
+func ValidateImportCsvRequestBody(body *ImportCsvRequestBody) (err error) {
+	if body.Content == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+	}
+	if body.Content != nil {
+		if utf8.RuneCountInString(*body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", *body.Content, utf8.RuneCountInString(*body.Content), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateImportOfxRequestBody runs the validations defined on
+// ImportOfxRequestBody
+func ValidateImportOfxRequestBody(body *ImportOfxRequestBody) (err error) {
+	if body.Content == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+	}
+	if body.Content != nil {
+		if utf8.RuneCountInString(*body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", *body.Content, utf8.RuneCountInString(*body.Content), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateImportJournalRequestBody runs the validations defined on
+// ImportJournalRequestBody
+func ValidateImportJournalRequestBody(body *ImportJournalRequestBody) (err error) {
+	if body.Content == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+	}
+	if body.Content != nil {
+		if utf8.RuneCountInString(*body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", *body.Content, utf8.RuneCountInString(*body.Content), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateImportArchiveRequestBody runs the validations defined on
+// ImportArchiveRequestBody
+func ValidateImportArchiveRequestBody(body *ImportArchiveRequestBody) (err error) {
+	if body.Content == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+	}
+	if body.Content != nil {
+		if utf8.RuneCountInString(*body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", *body.Content, utf8.RuneCountInString(*body.Content), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateReconcilePositionsRequestBody runs the validations defined on
+// ReconcilePositionsRequestBody
+func ValidateReconcilePositionsRequestBody(body *ReconcilePositionsRequestBody) (err error) {
+	if body.Content == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+	}
+	if body.Content != nil {
+		if utf8.RuneCountInString(*body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", *body.Content, utf8.RuneCountInString(*body.Content), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateSetAccountRequestBody runs the validations defined on
+// SetAccountRequestBody
+func ValidateSetAccountRequestBody(body *SetAccountRequestBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "taxable" || *body.Type == "ira" || *body.Type == "roth_ira" || *body.Type == "401k" || *body.Type == "roth_401k" || *body.Type == "hsa" || *body.Type == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"taxable", "ira", "roth_ira", "401k", "roth_401k", "hsa", "other"}))
+		}
+	}
+	if body.TaxTreatment != nil {
+		if !(*body.TaxTreatment == "taxable" || *body.TaxTreatment == "tax_deferred" || *body.TaxTreatment == "tax_exempt") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.tax_treatment", *body.TaxTreatment, []any{"taxable", "tax_deferred", "tax_exempt"}))
+		}
+	}
+	return
+}
+
+// ValidateTargetWeightRequestBodyRequestBody runs the validations defined on
+// TargetWeightRequestBodyRequestBody
+func ValidateTargetWeightRequestBodyRequestBody(body *TargetWeightRequestBodyRequestBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, false))
+		}
+	}
+	if body.Tolerance != nil {
+		if *body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", *body.Tolerance, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateBenchmarkComponentRequestBodyRequestBody runs the validations
+// defined on BenchmarkComponentRequestBodyRequestBody
+func ValidateBenchmarkComponentRequestBodyRequestBody(body *BenchmarkComponentRequestBodyRequestBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateAssetClassAssumptionRequestBody runs the validations defined on
+// AssetClassAssumptionRequestBody
+func ValidateAssetClassAssumptionRequestBody(body *AssetClassAssumptionRequestBody) (err error) {
+	if body.AssetClass == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset_class", "body"))
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, false))
+		}
+	}
+	if body.Volatility != nil {
+		if *body.Volatility < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.volatility", *body.Volatility, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateCorporateActionInputRequestBody runs the validations defined on
+// CorporateActionInputRequestBody
+func ValidateCorporateActionInputRequestBody(body *CorporateActionInputRequestBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "split" || *body.Type == "symbol_change" || *body.Type == "spin_off" || *body.Type == "merger") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"split", "symbol_change", "spin_off", "merger"}))
+		}
+	}
+	if body.Ratio != nil {
+		if *body.Ratio < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.ratio", *body.Ratio, 0, true))
+		}
+	}
+	if body.CashPerShare != nil {
+		if *body.CashPerShare < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cash_per_share", *body.CashPerShare, 0, true))
+		}
+	}
+	if body.BasisFraction != nil {
+		if *body.BasisFraction < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.basis_fraction", *body.BasisFraction, 0, true))
+		}
+	}
+	if body.BasisFraction != nil {
+		if *body.BasisFraction > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.basis_fraction", *body.BasisFraction, 1, false))
+		}
+	}
+	if body.Price != nil {
+		if *body.Price < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.price", *body.Price, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateTradeInputRequestBody runs the validations defined on
+// TradeInputRequestBody
+func ValidateTradeInputRequestBody(body *TradeInputRequestBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.Side != nil {
+		if !(*body.Side == "buy" || *body.Side == "sell") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", *body.Side, []any{"buy", "sell"}))
+		}
+	}
+	if body.Quantity != nil {
+		if *body.Quantity < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.quantity", *body.Quantity, 0, true))
+		}
+	}
+	if body.Price != nil {
+		if *body.Price < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.price", *body.Price, 0, true))
+		}
+	}
+	if body.Fee != nil {
+		if *body.Fee < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.fee", *body.Fee, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateBacktestTargetRequestBody runs the validations defined on
+// BacktestTargetRequestBody
+func ValidateBacktestTargetRequestBody(body *BacktestTargetRequestBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, false))
+		}
+	}
+	return
+}
@@ -16,13 +16,105 @@ import (
 
 // Client is the "portfolio" service client.
 type Client struct {
-	GetPortfolioSummaryEndpoint goa.Endpoint
+	GetPortfolioSummaryEndpoint       goa.Endpoint
+	GetReturnsEndpoint                goa.Endpoint
+	GetAllocationEndpoint             goa.Endpoint
+	GetTargetAllocationEndpoint       goa.Endpoint
+	SetTargetAllocationEndpoint       goa.Endpoint
+	ProposeRebalanceEndpoint          goa.Endpoint
+	SetBenchmarkEndpoint              goa.Endpoint
+	GetBenchmarkComparisonEndpoint    goa.Endpoint
+	GetRiskMetricsEndpoint            goa.Endpoint
+	GetValueAtRiskEndpoint            goa.Endpoint
+	ListStressScenariosEndpoint       goa.Endpoint
+	RunStressTestEndpoint             goa.Endpoint
+	ProjectPortfolioEndpoint          goa.Endpoint
+	ListCorporateActionsEndpoint      goa.Endpoint
+	ApplyCorporateActionsEndpoint     goa.Endpoint
+	RecordIncomeEndpoint              goa.Endpoint
+	GetIncomeEndpoint                 goa.Endpoint
+	PriceBondEndpoint                 goa.Endpoint
+	ListBondPositionsEndpoint         goa.Endpoint
+	GetCashFlowLadderEndpoint         goa.Endpoint
+	ListOptionPositionsEndpoint       goa.Endpoint
+	SettleOptionExpiriesEndpoint      goa.Endpoint
+	SetMarginAccountEndpoint          goa.Endpoint
+	GetMarginStatusEndpoint           goa.Endpoint
+	ChargeMarginEndpoint              goa.Endpoint
+	ListEventsEndpoint                goa.Endpoint
+	PlaceOrderEndpoint                goa.Endpoint
+	ListOrdersEndpoint                goa.Endpoint
+	CancelOrderEndpoint               goa.Endpoint
+	PreviewTradesEndpoint             goa.Endpoint
+	RunBacktestEndpoint               goa.Endpoint
+	ImportCsvEndpoint                 goa.Endpoint
+	ImportOfxEndpoint                 goa.Endpoint
+	ExportJournalEndpoint             goa.Endpoint
+	ImportJournalEndpoint             goa.Endpoint
+	ExportArchiveEndpoint             goa.Endpoint
+	ImportArchiveEndpoint             goa.Endpoint
+	ReconcilePositionsEndpoint        goa.Endpoint
+	ListReconciliationBreaksEndpoint  goa.Endpoint
+	UpdateReconciliationBreakEndpoint goa.Endpoint
+	GetTaxReportEndpoint              goa.Endpoint
+	ExportTaxReportEndpoint           goa.Endpoint
+	ListLotsEndpoint                  goa.Endpoint
+	FindTaxLossHarvestsEndpoint       goa.Endpoint
+	ListAccountsEndpoint              goa.Endpoint
+	SetAccountEndpoint                goa.Endpoint
+	RemoveAccountEndpoint             goa.Endpoint
 }
 
 // NewClient initializes a "portfolio" service client given the endpoints.
-func NewClient(getPortfolioSummary goa.Endpoint) *Client {
+func NewClient(getPortfolioSummary, getReturns, getAllocation, getTargetAllocation, setTargetAllocation, proposeRebalance, setBenchmark, getBenchmarkComparison, getRiskMetrics, getValueAtRisk, listStressScenarios, runStressTest, projectPortfolio, listCorporateActions, applyCorporateActions, recordIncome, getIncome, priceBond, listBondPositions, getCashFlowLadder, listOptionPositions, settleOptionExpiries, setMarginAccount, getMarginStatus, chargeMargin, listEvents, placeOrder, listOrders, cancelOrder, previewTrades, runBacktest, importCsv, importOfx, exportJournal, importJournal, exportArchive, importArchive, reconcilePositions, listReconciliationBreaks, updateReconciliationBreak, getTaxReport, exportTaxReport, listLots, findTaxLossHarvests, listAccounts, setAccount, removeAccount goa.Endpoint) *Client {
 	return &Client{
-		GetPortfolioSummaryEndpoint: getPortfolioSummary,
+		GetPortfolioSummaryEndpoint:       getPortfolioSummary,
+		GetReturnsEndpoint:                getReturns,
+		GetAllocationEndpoint:             getAllocation,
+		GetTargetAllocationEndpoint:       getTargetAllocation,
+		SetTargetAllocationEndpoint:       setTargetAllocation,
+		ProposeRebalanceEndpoint:          proposeRebalance,
+		SetBenchmarkEndpoint:              setBenchmark,
+		GetBenchmarkComparisonEndpoint:    getBenchmarkComparison,
+		GetRiskMetricsEndpoint:            getRiskMetrics,
+		GetValueAtRiskEndpoint:            getValueAtRisk,
+		ListStressScenariosEndpoint:       listStressScenarios,
+		RunStressTestEndpoint:             runStressTest,
+		ProjectPortfolioEndpoint:          projectPortfolio,
+		ListCorporateActionsEndpoint:      listCorporateActions,
+		ApplyCorporateActionsEndpoint:     applyCorporateActions,
+		RecordIncomeEndpoint:              recordIncome,
+		GetIncomeEndpoint:                 getIncome,
+		PriceBondEndpoint:                 priceBond,
+		ListBondPositionsEndpoint:         listBondPositions,
+		GetCashFlowLadderEndpoint:         getCashFlowLadder,
+		ListOptionPositionsEndpoint:       listOptionPositions,
+		SettleOptionExpiriesEndpoint:      settleOptionExpiries,
+		SetMarginAccountEndpoint:          setMarginAccount,
+		GetMarginStatusEndpoint:           getMarginStatus,
+		ChargeMarginEndpoint:              chargeMargin,
+		ListEventsEndpoint:                listEvents,
+		PlaceOrderEndpoint:                placeOrder,
+		ListOrdersEndpoint:                listOrders,
+		CancelOrderEndpoint:               cancelOrder,
+		PreviewTradesEndpoint:             previewTrades,
+		RunBacktestEndpoint:               runBacktest,
+		ImportCsvEndpoint:                 importCsv,
+		ImportOfxEndpoint:                 importOfx,
+		ExportJournalEndpoint:             exportJournal,
+		ImportJournalEndpoint:             importJournal,
+		ExportArchiveEndpoint:             exportArchive,
+		ImportArchiveEndpoint:             importArchive,
+		ReconcilePositionsEndpoint:        reconcilePositions,
+		ListReconciliationBreaksEndpoint:  listReconciliationBreaks,
+		UpdateReconciliationBreakEndpoint: updateReconciliationBreak,
+		GetTaxReportEndpoint:              getTaxReport,
+		ExportTaxReportEndpoint:           exportTaxReport,
+		ListLotsEndpoint:                  listLots,
+		FindTaxLossHarvestsEndpoint:       findTaxLossHarvests,
+		ListAccountsEndpoint:              listAccounts,
+		SetAccountEndpoint:                setAccount,
+		RemoveAccountEndpoint:             removeAccount,
 	}
 }
 
@@ -31,12 +123,724 @@ func NewClient(getPortfolioSummary goa.Endpoint) *Client {
 // GetPortfolioSummary may return the following errors:
 //   - "unauthorized" (type Unauthorized)
 //   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
 //   - error: internal error
-func (c *Client) GetPortfolioSummary(ctx context.Context) (res *PortfolioSummary, err error) {
+func (c *Client) GetPortfolioSummary(ctx context.Context, p *GetPortfolioSummaryPayload) (res *PortfolioSummary, err error) {
 	var ires any
-	ires, err = c.GetPortfolioSummaryEndpoint(ctx, nil)
+	ires, err = c.GetPortfolioSummaryEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
 	return ires.(*PortfolioSummary), nil
 }
+
+// GetReturns calls the "getReturns" endpoint of the "portfolio" service.
+// GetReturns may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetReturns(ctx context.Context, p *GetReturnsPayload) (res *PortfolioReturns, err error) {
+	var ires any
+	ires, err = c.GetReturnsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PortfolioReturns), nil
+}
+
+// GetAllocation calls the "getAllocation" endpoint of the "portfolio" service.
+// GetAllocation may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetAllocation(ctx context.Context, p *GetAllocationPayload) (res *Allocation, err error) {
+	var ires any
+	ires, err = c.GetAllocationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Allocation), nil
+}
+
+// GetTargetAllocation calls the "getTargetAllocation" endpoint of the
+// "portfolio" service.
+// GetTargetAllocation may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetTargetAllocation(ctx context.Context, p *GetTargetAllocationPayload) (res *TargetAllocation, err error) {
+	var ires any
+	ires, err = c.GetTargetAllocationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TargetAllocation), nil
+}
+
+// SetTargetAllocation calls the "setTargetAllocation" endpoint of the
+// "portfolio" service.
+// SetTargetAllocation may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) SetTargetAllocation(ctx context.Context, p *SetTargetAllocationPayload) (res *TargetAllocation, err error) {
+	var ires any
+	ires, err = c.SetTargetAllocationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TargetAllocation), nil
+}
+
+// ProposeRebalance calls the "proposeRebalance" endpoint of the "portfolio"
+// service.
+// ProposeRebalance may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ProposeRebalance(ctx context.Context, p *ProposeRebalancePayload) (res *RebalanceProposal, err error) {
+	var ires any
+	ires, err = c.ProposeRebalanceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RebalanceProposal), nil
+}
+
+// SetBenchmark calls the "setBenchmark" endpoint of the "portfolio" service.
+// SetBenchmark may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) SetBenchmark(ctx context.Context, p *SetBenchmarkPayload) (res *BenchmarkDefinition, err error) {
+	var ires any
+	ires, err = c.SetBenchmarkEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BenchmarkDefinition), nil
+}
+
+// GetBenchmarkComparison calls the "getBenchmarkComparison" endpoint of the
+// "portfolio" service.
+// GetBenchmarkComparison may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetBenchmarkComparison(ctx context.Context, p *GetBenchmarkComparisonPayload) (res *BenchmarkComparison, err error) {
+	var ires any
+	ires, err = c.GetBenchmarkComparisonEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BenchmarkComparison), nil
+}
+
+// GetRiskMetrics calls the "getRiskMetrics" endpoint of the "portfolio"
+// service.
+// GetRiskMetrics may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetRiskMetrics(ctx context.Context, p *GetRiskMetricsPayload) (res *RiskMetrics, err error) {
+	var ires any
+	ires, err = c.GetRiskMetricsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RiskMetrics), nil
+}
+
+// GetValueAtRisk calls the "getValueAtRisk" endpoint of the "portfolio"
+// service.
+// GetValueAtRisk may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetValueAtRisk(ctx context.Context, p *GetValueAtRiskPayload) (res *ValueAtRisk, err error) {
+	var ires any
+	ires, err = c.GetValueAtRiskEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ValueAtRisk), nil
+}
+
+// ListStressScenarios calls the "listStressScenarios" endpoint of the
+// "portfolio" service.
+// ListStressScenarios may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ListStressScenarios(ctx context.Context) (res []*StressScenario, err error) {
+	var ires any
+	ires, err = c.ListStressScenariosEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]*StressScenario), nil
+}
+
+// RunStressTest calls the "runStressTest" endpoint of the "portfolio" service.
+// RunStressTest may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) RunStressTest(ctx context.Context, p *RunStressTestPayload) (res *StressTestResult, err error) {
+	var ires any
+	ires, err = c.RunStressTestEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StressTestResult), nil
+}
+
+// ProjectPortfolio calls the "projectPortfolio" endpoint of the "portfolio"
+// service.
+// ProjectPortfolio may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ProjectPortfolio(ctx context.Context, p *ProjectPortfolioPayload) (res *Projection, err error) {
+	var ires any
+	ires, err = c.ProjectPortfolioEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Projection), nil
+}
+
+// ListCorporateActions calls the "listCorporateActions" endpoint of the
+// "portfolio" service.
+// ListCorporateActions may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ListCorporateActions(ctx context.Context, p *ListCorporateActionsPayload) (res []*CorporateAction, err error) {
+	var ires any
+	ires, err = c.ListCorporateActionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*CorporateAction), nil
+}
+
+// ApplyCorporateActions calls the "applyCorporateActions" endpoint of the
+// "portfolio" service.
+// ApplyCorporateActions may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ApplyCorporateActions(ctx context.Context, p *ApplyCorporateActionsPayload) (res []*CorporateAction, err error) {
+	var ires any
+	ires, err = c.ApplyCorporateActionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*CorporateAction), nil
+}
+
+// RecordIncome calls the "recordIncome" endpoint of the "portfolio" service.
+// RecordIncome may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) RecordIncome(ctx context.Context, p *RecordIncomePayload) (res *IncomeRecord, err error) {
+	var ires any
+	ires, err = c.RecordIncomeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*IncomeRecord), nil
+}
+
+// GetIncome calls the "getIncome" endpoint of the "portfolio" service.
+// GetIncome may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetIncome(ctx context.Context, p *GetIncomePayload) (res *IncomeSummary, err error) {
+	var ires any
+	ires, err = c.GetIncomeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*IncomeSummary), nil
+}
+
+// PriceBond calls the "priceBond" endpoint of the "portfolio" service.
+// PriceBond may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) PriceBond(ctx context.Context, p *PriceBondPayload) (res *BondAnalytics, err error) {
+	var ires any
+	ires, err = c.PriceBondEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BondAnalytics), nil
+}
+
+// ListBondPositions calls the "listBondPositions" endpoint of the "portfolio"
+// service.
+// ListBondPositions may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ListBondPositions(ctx context.Context, p *ListBondPositionsPayload) (res []*BondPosition, err error) {
+	var ires any
+	ires, err = c.ListBondPositionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*BondPosition), nil
+}
+
+// GetCashFlowLadder calls the "getCashFlowLadder" endpoint of the "portfolio"
+// service.
+// GetCashFlowLadder may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetCashFlowLadder(ctx context.Context, p *GetCashFlowLadderPayload) (res *CashFlowLadder, err error) {
+	var ires any
+	ires, err = c.GetCashFlowLadderEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CashFlowLadder), nil
+}
+
+// ListOptionPositions calls the "listOptionPositions" endpoint of the
+// "portfolio" service.
+// ListOptionPositions may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ListOptionPositions(ctx context.Context, p *ListOptionPositionsPayload) (res *OptionPositions, err error) {
+	var ires any
+	ires, err = c.ListOptionPositionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*OptionPositions), nil
+}
+
+// SettleOptionExpiries calls the "settleOptionExpiries" endpoint of the
+// "portfolio" service.
+// SettleOptionExpiries may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) SettleOptionExpiries(ctx context.Context, p *SettleOptionExpiriesPayload) (res []*OptionSettlement, err error) {
+	var ires any
+	ires, err = c.SettleOptionExpiriesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*OptionSettlement), nil
+}
+
+// SetMarginAccount calls the "setMarginAccount" endpoint of the "portfolio"
+// service.
+// SetMarginAccount may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) SetMarginAccount(ctx context.Context, p *SetMarginAccountPayload) (res *MarginTerms, err error) {
+	var ires any
+	ires, err = c.SetMarginAccountEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*MarginTerms), nil
+}
+
+// GetMarginStatus calls the "getMarginStatus" endpoint of the "portfolio"
+// service.
+// GetMarginStatus may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetMarginStatus(ctx context.Context, p *GetMarginStatusPayload) (res *MarginStatus, err error) {
+	var ires any
+	ires, err = c.GetMarginStatusEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*MarginStatus), nil
+}
+
+// ChargeMargin calls the "chargeMargin" endpoint of the "portfolio" service.
+// ChargeMargin may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ChargeMargin(ctx context.Context, p *ChargeMarginPayload) (res *MarginStatus, err error) {
+	var ires any
+	ires, err = c.ChargeMarginEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*MarginStatus), nil
+}
+
+// ListEvents calls the "listEvents" endpoint of the "portfolio" service.
+// ListEvents may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ListEvents(ctx context.Context, p *ListEventsPayload) (res []*PortfolioEvent, err error) {
+	var ires any
+	ires, err = c.ListEventsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*PortfolioEvent), nil
+}
+
+// PlaceOrder calls the "placeOrder" endpoint of the "portfolio" service.
+// PlaceOrder may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) PlaceOrder(ctx context.Context, p *PlaceOrderPayload) (res *Order, err error) {
+	var ires any
+	ires, err = c.PlaceOrderEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Order), nil
+}
+
+// ListOrders calls the "listOrders" endpoint of the "portfolio" service.
+// ListOrders may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ListOrders(ctx context.Context, p *ListOrdersPayload) (res []*Order, err error) {
+	var ires any
+	ires, err = c.ListOrdersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Order), nil
+}
+
+// CancelOrder calls the "cancelOrder" endpoint of the "portfolio" service.
+// CancelOrder may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) CancelOrder(ctx context.Context, p *CancelOrderPayload) (res *Order, err error) {
+	var ires any
+	ires, err = c.CancelOrderEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Order), nil
+}
+
+// PreviewTrades calls the "previewTrades" endpoint of the "portfolio" service.
+// PreviewTrades may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) PreviewTrades(ctx context.Context, p *PreviewTradesPayload) (res *TradePreview, err error) {
+	var ires any
+	ires, err = c.PreviewTradesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TradePreview), nil
+}
+
+// RunBacktest calls the "runBacktest" endpoint of the "portfolio" service.
+// RunBacktest may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) RunBacktest(ctx context.Context, p *RunBacktestPayload) (res *BacktestResult, err error) {
+	var ires any
+	ires, err = c.RunBacktestEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BacktestResult), nil
+}
+
+// ImportCsv calls the "importCsv" endpoint of the "portfolio" service.
+// ImportCsv may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ImportCsv(ctx context.Context, p *ImportCsvPayload) (res *ImportReport, err error) {
+	var ires any
+	ires, err = c.ImportCsvEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ImportReport), nil
+}
+
+// ImportOfx calls the "importOfx" endpoint of the "portfolio" service.
+// ImportOfx may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ImportOfx(ctx context.Context, p *ImportOfxPayload) (res *ImportReport, err error) {
+	var ires any
+	ires, err = c.ImportOfxEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ImportReport), nil
+}
+
+// ExportJournal calls the "exportJournal" endpoint of the "portfolio" service.
+// ExportJournal may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ExportJournal(ctx context.Context, p *ExportJournalPayload) (res *ExportFile, err error) {
+	var ires any
+	ires, err = c.ExportJournalEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExportFile), nil
+}
+
+// ImportJournal calls the "importJournal" endpoint of the "portfolio" service.
+// ImportJournal may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ImportJournal(ctx context.Context, p *ImportJournalPayload) (res *ImportReport, err error) {
+	var ires any
+	ires, err = c.ImportJournalEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ImportReport), nil
+}
+
+// ExportArchive calls the "exportArchive" endpoint of the "portfolio" service.
+// ExportArchive may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ExportArchive(ctx context.Context, p *ExportArchivePayload) (res *ExportFile, err error) {
+	var ires any
+	ires, err = c.ExportArchiveEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExportFile), nil
+}
+
+// ImportArchive calls the "importArchive" endpoint of the "portfolio" service.
+// ImportArchive may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ImportArchive(ctx context.Context, p *ImportArchivePayload) (res *ArchiveImport, err error) {
+	var ires any
+	ires, err = c.ImportArchiveEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ArchiveImport), nil
+}
+
+// ReconcilePositions calls the "reconcilePositions" endpoint of the
+// "portfolio" service.
+// ReconcilePositions may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ReconcilePositions(ctx context.Context, p *ReconcilePositionsPayload) (res *Reconciliation, err error) {
+	var ires any
+	ires, err = c.ReconcilePositionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Reconciliation), nil
+}
+
+// ListReconciliationBreaks calls the "listReconciliationBreaks" endpoint of
+// the "portfolio" service.
+// ListReconciliationBreaks may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ListReconciliationBreaks(ctx context.Context, p *ListReconciliationBreaksPayload) (res []*ReconciliationBreak, err error) {
+	var ires any
+	ires, err = c.ListReconciliationBreaksEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*ReconciliationBreak), nil
+}
+
+// UpdateReconciliationBreak calls the "updateReconciliationBreak" endpoint of
+// the "portfolio" service.
+// UpdateReconciliationBreak may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) UpdateReconciliationBreak(ctx context.Context, p *UpdateReconciliationBreakPayload) (res *ReconciliationBreak, err error) {
+	var ires any
+	ires, err = c.UpdateReconciliationBreakEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ReconciliationBreak), nil
+}
+
+// GetTaxReport calls the "getTaxReport" endpoint of the "portfolio" service.
+// GetTaxReport may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) GetTaxReport(ctx context.Context, p *GetTaxReportPayload) (res *TaxReport, err error) {
+	var ires any
+	ires, err = c.GetTaxReportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TaxReport), nil
+}
+
+// ExportTaxReport calls the "exportTaxReport" endpoint of the "portfolio"
+// service.
+// ExportTaxReport may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ExportTaxReport(ctx context.Context, p *ExportTaxReportPayload) (res *ExportFile, err error) {
+	var ires any
+	ires, err = c.ExportTaxReportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExportFile), nil
+}
+
+// ListLots calls the "listLots" endpoint of the "portfolio" service.
+// ListLots may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ListLots(ctx context.Context, p *ListLotsPayload) (res []*TaxLot, err error) {
+	var ires any
+	ires, err = c.ListLotsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*TaxLot), nil
+}
+
+// FindTaxLossHarvests calls the "findTaxLossHarvests" endpoint of the
+// "portfolio" service.
+// FindTaxLossHarvests may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) FindTaxLossHarvests(ctx context.Context, p *FindTaxLossHarvestsPayload) (res *TaxLossHarvest, err error) {
+	var ires any
+	ires, err = c.FindTaxLossHarvestsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TaxLossHarvest), nil
+}
+
+// ListAccounts calls the "listAccounts" endpoint of the "portfolio" service.
+// ListAccounts may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) ListAccounts(ctx context.Context, p *ListAccountsPayload) (res []*Account, err error) {
+	var ires any
+	ires, err = c.ListAccountsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Account), nil
+}
+
+// SetAccount calls the "setAccount" endpoint of the "portfolio" service.
+// SetAccount may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) SetAccount(ctx context.Context, p *SetAccountPayload) (res *Account, err error) {
+	var ires any
+	ires, err = c.SetAccountEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Account), nil
+}
+
+// RemoveAccount calls the "removeAccount" endpoint of the "portfolio" service.
+// RemoveAccount may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "bad_request" (type BadRequest)
+//   - error: internal error
+func (c *Client) RemoveAccount(ctx context.Context, p *RemoveAccountPayload) (res *Account, err error) {
+	var ires any
+	ires, err = c.RemoveAccountEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Account), nil
+}
@@ -16,25 +16,577 @@ import (
 
 // Endpoints wraps the "portfolio" service endpoints.
 type Endpoints struct {
-	GetPortfolioSummary goa.Endpoint
+	GetPortfolioSummary       goa.Endpoint
+	GetReturns                goa.Endpoint
+	GetAllocation             goa.Endpoint
+	GetTargetAllocation       goa.Endpoint
+	SetTargetAllocation       goa.Endpoint
+	ProposeRebalance          goa.Endpoint
+	SetBenchmark              goa.Endpoint
+	GetBenchmarkComparison    goa.Endpoint
+	GetRiskMetrics            goa.Endpoint
+	GetValueAtRisk            goa.Endpoint
+	ListStressScenarios       goa.Endpoint
+	RunStressTest             goa.Endpoint
+	ProjectPortfolio          goa.Endpoint
+	ListCorporateActions      goa.Endpoint
+	ApplyCorporateActions     goa.Endpoint
+	RecordIncome              goa.Endpoint
+	GetIncome                 goa.Endpoint
+	PriceBond                 goa.Endpoint
+	ListBondPositions         goa.Endpoint
+	GetCashFlowLadder         goa.Endpoint
+	ListOptionPositions       goa.Endpoint
+	SettleOptionExpiries      goa.Endpoint
+	SetMarginAccount          goa.Endpoint
+	GetMarginStatus           goa.Endpoint
+	ChargeMargin              goa.Endpoint
+	ListEvents                goa.Endpoint
+	PlaceOrder                goa.Endpoint
+	ListOrders                goa.Endpoint
+	CancelOrder               goa.Endpoint
+	PreviewTrades             goa.Endpoint
+	RunBacktest               goa.Endpoint
+	ImportCsv                 goa.Endpoint
+	ImportOfx                 goa.Endpoint
+	ExportJournal             goa.Endpoint
+	ImportJournal             goa.Endpoint
+	ExportArchive             goa.Endpoint
+	ImportArchive             goa.Endpoint
+	ReconcilePositions        goa.Endpoint
+	ListReconciliationBreaks  goa.Endpoint
+	UpdateReconciliationBreak goa.Endpoint
+	GetTaxReport              goa.Endpoint
+	ExportTaxReport           goa.Endpoint
+	ListLots                  goa.Endpoint
+	FindTaxLossHarvests       goa.Endpoint
+	ListAccounts              goa.Endpoint
+	SetAccount                goa.Endpoint
+	RemoveAccount             goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "portfolio" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		GetPortfolioSummary: NewGetPortfolioSummaryEndpoint(s),
+		GetPortfolioSummary:       NewGetPortfolioSummaryEndpoint(s),
+		GetReturns:                NewGetReturnsEndpoint(s),
+		GetAllocation:             NewGetAllocationEndpoint(s),
+		GetTargetAllocation:       NewGetTargetAllocationEndpoint(s),
+		SetTargetAllocation:       NewSetTargetAllocationEndpoint(s),
+		ProposeRebalance:          NewProposeRebalanceEndpoint(s),
+		SetBenchmark:              NewSetBenchmarkEndpoint(s),
+		GetBenchmarkComparison:    NewGetBenchmarkComparisonEndpoint(s),
+		GetRiskMetrics:            NewGetRiskMetricsEndpoint(s),
+		GetValueAtRisk:            NewGetValueAtRiskEndpoint(s),
+		ListStressScenarios:       NewListStressScenariosEndpoint(s),
+		RunStressTest:             NewRunStressTestEndpoint(s),
+		ProjectPortfolio:          NewProjectPortfolioEndpoint(s),
+		ListCorporateActions:      NewListCorporateActionsEndpoint(s),
+		ApplyCorporateActions:     NewApplyCorporateActionsEndpoint(s),
+		RecordIncome:              NewRecordIncomeEndpoint(s),
+		GetIncome:                 NewGetIncomeEndpoint(s),
+		PriceBond:                 NewPriceBondEndpoint(s),
+		ListBondPositions:         NewListBondPositionsEndpoint(s),
+		GetCashFlowLadder:         NewGetCashFlowLadderEndpoint(s),
+		ListOptionPositions:       NewListOptionPositionsEndpoint(s),
+		SettleOptionExpiries:      NewSettleOptionExpiriesEndpoint(s),
+		SetMarginAccount:          NewSetMarginAccountEndpoint(s),
+		GetMarginStatus:           NewGetMarginStatusEndpoint(s),
+		ChargeMargin:              NewChargeMarginEndpoint(s),
+		ListEvents:                NewListEventsEndpoint(s),
+		PlaceOrder:                NewPlaceOrderEndpoint(s),
+		ListOrders:                NewListOrdersEndpoint(s),
+		CancelOrder:               NewCancelOrderEndpoint(s),
+		PreviewTrades:             NewPreviewTradesEndpoint(s),
+		RunBacktest:               NewRunBacktestEndpoint(s),
+		ImportCsv:                 NewImportCsvEndpoint(s),
+		ImportOfx:                 NewImportOfxEndpoint(s),
+		ExportJournal:             NewExportJournalEndpoint(s),
+		ImportJournal:             NewImportJournalEndpoint(s),
+		ExportArchive:             NewExportArchiveEndpoint(s),
+		ImportArchive:             NewImportArchiveEndpoint(s),
+		ReconcilePositions:        NewReconcilePositionsEndpoint(s),
+		ListReconciliationBreaks:  NewListReconciliationBreaksEndpoint(s),
+		UpdateReconciliationBreak: NewUpdateReconciliationBreakEndpoint(s),
+		GetTaxReport:              NewGetTaxReportEndpoint(s),
+		ExportTaxReport:           NewExportTaxReportEndpoint(s),
+		ListLots:                  NewListLotsEndpoint(s),
+		FindTaxLossHarvests:       NewFindTaxLossHarvestsEndpoint(s),
+		ListAccounts:              NewListAccountsEndpoint(s),
+		SetAccount:                NewSetAccountEndpoint(s),
+		RemoveAccount:             NewRemoveAccountEndpoint(s),
 	}
 }
 
 // Use applies the given middleware to all the "portfolio" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetPortfolioSummary = m(e.GetPortfolioSummary)
+	e.GetReturns = m(e.GetReturns)
+	e.GetAllocation = m(e.GetAllocation)
+	e.GetTargetAllocation = m(e.GetTargetAllocation)
+	e.SetTargetAllocation = m(e.SetTargetAllocation)
+	e.ProposeRebalance = m(e.ProposeRebalance)
+	e.SetBenchmark = m(e.SetBenchmark)
+	e.GetBenchmarkComparison = m(e.GetBenchmarkComparison)
+	e.GetRiskMetrics = m(e.GetRiskMetrics)
+	e.GetValueAtRisk = m(e.GetValueAtRisk)
+	e.ListStressScenarios = m(e.ListStressScenarios)
+	e.RunStressTest = m(e.RunStressTest)
+	e.ProjectPortfolio = m(e.ProjectPortfolio)
+	e.ListCorporateActions = m(e.ListCorporateActions)
+	e.ApplyCorporateActions = m(e.ApplyCorporateActions)
+	e.RecordIncome = m(e.RecordIncome)
+	e.GetIncome = m(e.GetIncome)
+	e.PriceBond = m(e.PriceBond)
+	e.ListBondPositions = m(e.ListBondPositions)
+	e.GetCashFlowLadder = m(e.GetCashFlowLadder)
+	e.ListOptionPositions = m(e.ListOptionPositions)
+	e.SettleOptionExpiries = m(e.SettleOptionExpiries)
+	e.SetMarginAccount = m(e.SetMarginAccount)
+	e.GetMarginStatus = m(e.GetMarginStatus)
+	e.ChargeMargin = m(e.ChargeMargin)
+	e.ListEvents = m(e.ListEvents)
+	e.PlaceOrder = m(e.PlaceOrder)
+	e.ListOrders = m(e.ListOrders)
+	e.CancelOrder = m(e.CancelOrder)
+	e.PreviewTrades = m(e.PreviewTrades)
+	e.RunBacktest = m(e.RunBacktest)
+	e.ImportCsv = m(e.ImportCsv)
+	e.ImportOfx = m(e.ImportOfx)
+	e.ExportJournal = m(e.ExportJournal)
+	e.ImportJournal = m(e.ImportJournal)
+	e.ExportArchive = m(e.ExportArchive)
+	e.ImportArchive = m(e.ImportArchive)
+	e.ReconcilePositions = m(e.ReconcilePositions)
+	e.ListReconciliationBreaks = m(e.ListReconciliationBreaks)
+	e.UpdateReconciliationBreak = m(e.UpdateReconciliationBreak)
+	e.GetTaxReport = m(e.GetTaxReport)
+	e.ExportTaxReport = m(e.ExportTaxReport)
+	e.ListLots = m(e.ListLots)
+	e.FindTaxLossHarvests = m(e.FindTaxLossHarvests)
+	e.ListAccounts = m(e.ListAccounts)
+	e.SetAccount = m(e.SetAccount)
+	e.RemoveAccount = m(e.RemoveAccount)
 }
 
 // NewGetPortfolioSummaryEndpoint returns an endpoint function that calls the
 // method "getPortfolioSummary" of service "portfolio".
 func NewGetPortfolioSummaryEndpoint(s Service) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		return s.GetPortfolioSummary(ctx)
+		p := req.(*GetPortfolioSummaryPayload)
+		return s.GetPortfolioSummary(ctx, p)
+	}
+}
+
+// NewGetReturnsEndpoint returns an endpoint function that calls the method
+// "getReturns" of service "portfolio".
+func NewGetReturnsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetReturnsPayload)
+		return s.GetReturns(ctx, p)
+	}
+}
+
+// NewGetAllocationEndpoint returns an endpoint function that calls the method
+// "getAllocation" of service "portfolio".
+func NewGetAllocationEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetAllocationPayload)
+		return s.GetAllocation(ctx, p)
+	}
+}
+
+// NewGetTargetAllocationEndpoint returns an endpoint function that calls the
+// method "getTargetAllocation" of service "portfolio".
+func NewGetTargetAllocationEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetTargetAllocationPayload)
+		return s.GetTargetAllocation(ctx, p)
+	}
+}
+
+// NewSetTargetAllocationEndpoint returns an endpoint function that calls the
+// method "setTargetAllocation" of service "portfolio".
+func NewSetTargetAllocationEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetTargetAllocationPayload)
+		return s.SetTargetAllocation(ctx, p)
+	}
+}
+
+// NewProposeRebalanceEndpoint returns an endpoint function that calls the
+// method "proposeRebalance" of service "portfolio".
+func NewProposeRebalanceEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ProposeRebalancePayload)
+		return s.ProposeRebalance(ctx, p)
+	}
+}
+
+// NewSetBenchmarkEndpoint returns an endpoint function that calls the method
+// "setBenchmark" of service "portfolio".
+func NewSetBenchmarkEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetBenchmarkPayload)
+		return s.SetBenchmark(ctx, p)
+	}
+}
+
+// NewGetBenchmarkComparisonEndpoint returns an endpoint function that calls
+// the method "getBenchmarkComparison" of service "portfolio".
+func NewGetBenchmarkComparisonEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetBenchmarkComparisonPayload)
+		return s.GetBenchmarkComparison(ctx, p)
+	}
+}
+
+// NewGetRiskMetricsEndpoint returns an endpoint function that calls the method
+// "getRiskMetrics" of service "portfolio".
+func NewGetRiskMetricsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetRiskMetricsPayload)
+		return s.GetRiskMetrics(ctx, p)
+	}
+}
+
+// NewGetValueAtRiskEndpoint returns an endpoint function that calls the method
+// "getValueAtRisk" of service "portfolio".
+func NewGetValueAtRiskEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetValueAtRiskPayload)
+		return s.GetValueAtRisk(ctx, p)
+	}
+}
+
+// NewListStressScenariosEndpoint returns an endpoint function that calls the
+// method "listStressScenarios" of service "portfolio".
+func NewListStressScenariosEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ListStressScenarios(ctx)
+	}
+}
+
+// NewRunStressTestEndpoint returns an endpoint function that calls the method
+// "runStressTest" of service "portfolio".
+func NewRunStressTestEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RunStressTestPayload)
+		return s.RunStressTest(ctx, p)
+	}
+}
+
+// NewProjectPortfolioEndpoint returns an endpoint function that calls the
+// method "projectPortfolio" of service "portfolio".
+func NewProjectPortfolioEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ProjectPortfolioPayload)
+		return s.ProjectPortfolio(ctx, p)
+	}
+}
+
+// NewListCorporateActionsEndpoint returns an endpoint function that calls the
+// method "listCorporateActions" of service "portfolio".
+func NewListCorporateActionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListCorporateActionsPayload)
+		return s.ListCorporateActions(ctx, p)
+	}
+}
+
+// NewApplyCorporateActionsEndpoint returns an endpoint function that calls the
+// method "applyCorporateActions" of service "portfolio".
+func NewApplyCorporateActionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ApplyCorporateActionsPayload)
+		return s.ApplyCorporateActions(ctx, p)
+	}
+}
+
+// NewRecordIncomeEndpoint returns an endpoint function that calls the method
+// "recordIncome" of service "portfolio".
+func NewRecordIncomeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RecordIncomePayload)
+		return s.RecordIncome(ctx, p)
+	}
+}
+
+// NewGetIncomeEndpoint returns an endpoint function that calls the method
+// "getIncome" of service "portfolio".
+func NewGetIncomeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetIncomePayload)
+		return s.GetIncome(ctx, p)
+	}
+}
+
+// NewPriceBondEndpoint returns an endpoint function that calls the method
+// "priceBond" of service "portfolio".
+func NewPriceBondEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PriceBondPayload)
+		return s.PriceBond(ctx, p)
+	}
+}
+
+// NewListBondPositionsEndpoint returns an endpoint function that calls the
+// method "listBondPositions" of service "portfolio".
+func NewListBondPositionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListBondPositionsPayload)
+		return s.ListBondPositions(ctx, p)
+	}
+}
+
+// NewGetCashFlowLadderEndpoint returns an endpoint function that calls the
+// method "getCashFlowLadder" of service "portfolio".
+func NewGetCashFlowLadderEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetCashFlowLadderPayload)
+		return s.GetCashFlowLadder(ctx, p)
+	}
+}
+
+// NewListOptionPositionsEndpoint returns an endpoint function that calls the
+// method "listOptionPositions" of service "portfolio".
+func NewListOptionPositionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListOptionPositionsPayload)
+		return s.ListOptionPositions(ctx, p)
+	}
+}
+
+// NewSettleOptionExpiriesEndpoint returns an endpoint function that calls the
+// method "settleOptionExpiries" of service "portfolio".
+func NewSettleOptionExpiriesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SettleOptionExpiriesPayload)
+		return s.SettleOptionExpiries(ctx, p)
+	}
+}
+
+// NewSetMarginAccountEndpoint returns an endpoint function that calls the
+// method "setMarginAccount" of service "portfolio".
+func NewSetMarginAccountEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetMarginAccountPayload)
+		return s.SetMarginAccount(ctx, p)
+	}
+}
+
+// NewGetMarginStatusEndpoint returns an endpoint function that calls the
+// method "getMarginStatus" of service "portfolio".
+func NewGetMarginStatusEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetMarginStatusPayload)
+		return s.GetMarginStatus(ctx, p)
+	}
+}
+
+// NewChargeMarginEndpoint returns an endpoint function that calls the method
+// "chargeMargin" of service "portfolio".
+func NewChargeMarginEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ChargeMarginPayload)
+		return s.ChargeMargin(ctx, p)
+	}
+}
+
+// NewListEventsEndpoint returns an endpoint function that calls the method
+// "listEvents" of service "portfolio".
+func NewListEventsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListEventsPayload)
+		return s.ListEvents(ctx, p)
+	}
+}
+
+// NewPlaceOrderEndpoint returns an endpoint function that calls the method
+// "placeOrder" of service "portfolio".
+func NewPlaceOrderEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PlaceOrderPayload)
+		return s.PlaceOrder(ctx, p)
+	}
+}
+
+// NewListOrdersEndpoint returns an endpoint function that calls the method
+// "listOrders" of service "portfolio".
+func NewListOrdersEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListOrdersPayload)
+		return s.ListOrders(ctx, p)
+	}
+}
+
+// NewCancelOrderEndpoint returns an endpoint function that calls the method
+// "cancelOrder" of service "portfolio".
+func NewCancelOrderEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CancelOrderPayload)
+		return s.CancelOrder(ctx, p)
+	}
+}
+
+// NewPreviewTradesEndpoint returns an endpoint function that calls the method
+// "previewTrades" of service "portfolio".
+func NewPreviewTradesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PreviewTradesPayload)
+		return s.PreviewTrades(ctx, p)
+	}
+}
+
+// NewRunBacktestEndpoint returns an endpoint function that calls the method
+// "runBacktest" of service "portfolio".
+func NewRunBacktestEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RunBacktestPayload)
+		return s.RunBacktest(ctx, p)
+	}
+}
+
+// NewImportCsvEndpoint returns an endpoint function that calls the method
+// "importCsv" of service "portfolio".
+func NewImportCsvEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ImportCsvPayload)
+		return s.ImportCsv(ctx, p)
+	}
+}
+
+// NewImportOfxEndpoint returns an endpoint function that calls the method
+// "importOfx" of service "portfolio".
+func NewImportOfxEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ImportOfxPayload)
+		return s.ImportOfx(ctx, p)
+	}
+}
+
+// NewExportJournalEndpoint returns an endpoint function that calls the method
+// "exportJournal" of service "portfolio".
+func NewExportJournalEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportJournalPayload)
+		return s.ExportJournal(ctx, p)
+	}
+}
+
+// NewImportJournalEndpoint returns an endpoint function that calls the method
+// "importJournal" of service "portfolio".
+func NewImportJournalEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ImportJournalPayload)
+		return s.ImportJournal(ctx, p)
+	}
+}
+
+// NewExportArchiveEndpoint returns an endpoint function that calls the method
+// "exportArchive" of service "portfolio".
+func NewExportArchiveEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportArchivePayload)
+		return s.ExportArchive(ctx, p)
+	}
+}
+
+// NewImportArchiveEndpoint returns an endpoint function that calls the method
+// "importArchive" of service "portfolio".
+func NewImportArchiveEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ImportArchivePayload)
+		return s.ImportArchive(ctx, p)
+	}
+}
+
+// NewReconcilePositionsEndpoint returns an endpoint function that calls the
+// method "reconcilePositions" of service "portfolio".
+func NewReconcilePositionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ReconcilePositionsPayload)
+		return s.ReconcilePositions(ctx, p)
+	}
+}
+
+// NewListReconciliationBreaksEndpoint returns an endpoint function that calls
+// the method "listReconciliationBreaks" of service "portfolio".
+func NewListReconciliationBreaksEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListReconciliationBreaksPayload)
+		return s.ListReconciliationBreaks(ctx, p)
+	}
+}
+
+// NewUpdateReconciliationBreakEndpoint returns an endpoint function that calls
+// the method "updateReconciliationBreak" of service "portfolio".
+func NewUpdateReconciliationBreakEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateReconciliationBreakPayload)
+		return s.UpdateReconciliationBreak(ctx, p)
+	}
+}
+
+// NewGetTaxReportEndpoint returns an endpoint function that calls the method
+// "getTaxReport" of service "portfolio".
+func NewGetTaxReportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetTaxReportPayload)
+		return s.GetTaxReport(ctx, p)
+	}
+}
+
+// NewExportTaxReportEndpoint returns an endpoint function that calls the
+// method "exportTaxReport" of service "portfolio".
+func NewExportTaxReportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportTaxReportPayload)
+		return s.ExportTaxReport(ctx, p)
+	}
+}
+
+// NewListLotsEndpoint returns an endpoint function that calls the method
+// "listLots" of service "portfolio".
+func NewListLotsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListLotsPayload)
+		return s.ListLots(ctx, p)
+	}
+}
+
+// NewFindTaxLossHarvestsEndpoint returns an endpoint function that calls the
+// method "findTaxLossHarvests" of service "portfolio".
+func NewFindTaxLossHarvestsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*FindTaxLossHarvestsPayload)
+		return s.FindTaxLossHarvests(ctx, p)
+	}
+}
+
+// NewListAccountsEndpoint returns an endpoint function that calls the method
+// "listAccounts" of service "portfolio".
+func NewListAccountsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListAccountsPayload)
+		return s.ListAccounts(ctx, p)
+	}
+}
+
+// NewSetAccountEndpoint returns an endpoint function that calls the method
+// "setAccount" of service "portfolio".
+func NewSetAccountEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetAccountPayload)
+		return s.SetAccount(ctx, p)
+	}
+}
+
+// NewRemoveAccountEndpoint returns an endpoint function that calls the method
+// "removeAccount" of service "portfolio".
+func NewRemoveAccountEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RemoveAccountPayload)
+		return s.RemoveAccount(ctx, p)
 	}
 }
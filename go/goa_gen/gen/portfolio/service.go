@@ -15,7 +15,160 @@ import (
 // Portfolio API
 type Service interface {
 	// GetPortfolioSummary implements getPortfolioSummary.
-	GetPortfolioSummary(context.Context) (res *PortfolioSummary, err error)
+	GetPortfolioSummary(context.Context, *GetPortfolioSummaryPayload) (res *PortfolioSummary, err error)
+	// Compute time-weighted and money-weighted returns so deposits and withdrawals
+	// do not look like performance.
+	GetReturns(context.Context, *GetReturnsPayload) (res *PortfolioReturns, err error)
+	// Break holdings down by asset class, sector, country, region, currency,
+	// account or custom tag.
+	GetAllocation(context.Context, *GetAllocationPayload) (res *Allocation, err error)
+	// Return the target allocation model of the portfolio.
+	GetTargetAllocation(context.Context, *GetTargetAllocationPayload) (res *TargetAllocation, err error)
+	// Replace the target weights and tolerance bands of the portfolio.
+	SetTargetAllocation(context.Context, *SetTargetAllocationPayload) (res *TargetAllocation, err error)
+	// Propose the trades needed to bring positions back within their tolerance
+	// bands.
+	ProposeRebalance(context.Context, *ProposeRebalancePayload) (res *RebalanceProposal, err error)
+	// Define the benchmark the portfolio is measured against.
+	SetBenchmark(context.Context, *SetBenchmarkPayload) (res *BenchmarkDefinition, err error)
+	// Compare portfolio cumulative returns against its benchmark over a period.
+	GetBenchmarkComparison(context.Context, *GetBenchmarkComparisonPayload) (res *BenchmarkComparison, err error)
+	// Measure volatility, Sharpe and Sortino ratios, beta and correlation against
+	// the benchmark and maximum drawdown, optionally over rolling windows.
+	GetRiskMetrics(context.Context, *GetRiskMetricsPayload) (res *RiskMetrics, err error)
+	// Estimate Value-at-Risk and expected shortfall by historical simulation,
+	// variance-covariance or Monte Carlo from locally stored price history.
+	GetValueAtRisk(context.Context, *GetValueAtRiskPayload) (res *ValueAtRisk, err error)
+	// List the built-in and configured stress scenarios.
+	ListStressScenarios(context.Context) (res []*StressScenario, err error)
+	// Apply a stress scenario to current holdings and report the projected value,
+	// loss and worst contributors.
+	RunStressTest(context.Context, *RunStressTestPayload) (res *StressTestResult, err error)
+	// Simulate future paths of the portfolio with contributions, withdrawals and
+	// inflation, and estimate the probability of reaching a goal.
+	ProjectPortfolio(context.Context, *ProjectPortfolioPayload) (res *Projection, err error)
+	// List the corporate actions recorded in the portfolio ledger.
+	ListCorporateActions(context.Context, *ListCorporateActionsPayload) (res []*CorporateAction, err error)
+	// Record splits, reverse splits, spin-offs, mergers and symbol changes in the
+	// ledger. Holdings and lots are adjusted from the effective date.
+	ApplyCorporateActions(context.Context, *ApplyCorporateActionsPayload) (res []*CorporateAction, err error)
+	// Record a dividend or interest payment. Dividends can be reinvested at the
+	// pay-date price, creating a new lot.
+	RecordIncome(context.Context, *RecordIncomePayload) (res *IncomeRecord, err error)
+	// Summarize dividend and interest income by period, holding and currency, with
+	// the expected income of the next 12 months.
+	GetIncome(context.Context, *GetIncomePayload) (res *IncomeSummary, err error)
+	// Compute clean and dirty price, accrued interest, yield to maturity and
+	// duration of a bond from a clean price or a yield.
+	PriceBond(context.Context, *PriceBondPayload) (res *BondAnalytics, err error)
+	// Value the bonds held before maturity at their latest clean price with
+	// accrued interest, yield and duration.
+	ListBondPositions(context.Context, *ListBondPositionsPayload) (res []*BondPosition, err error)
+	// List the upcoming coupon and principal payments of the bonds held, by date
+	// and by year.
+	GetCashFlowLadder(context.Context, *GetCashFlowLadderPayload) (res *CashFlowLadder, err error)
+	// Value option holdings with Black-Scholes or Black-76 and return their greeks
+	// and the delta-adjusted exposure per underlying. Expired options not yet
+	// settled are valued at their intrinsic value.
+	ListOptionPositions(context.Context, *ListOptionPositionsPayload) (res *OptionPositions, err error)
+	// Close expired options: out-of-the-money options expire worthless and
+	// in-the-money options are exercised or assigned.
+	SettleOptionExpiries(context.Context, *SettleOptionExpiriesPayload) (res []*OptionSettlement, err error)
+	// Make an account a margin account, allowing a negative cash balance and short
+	// positions, with its requirements and financing rates.
+	SetMarginAccount(context.Context, *SetMarginAccountPayload) (res *MarginTerms, err error)
+	// Return the equity, loan balance, maintenance excess and buying power of each
+	// margin account, with interest and borrow fees not yet charged as accrued.
+	GetMarginStatus(context.Context, *GetMarginStatusPayload) (res *MarginStatus, err error)
+	// Charge the margin interest and borrow fees of completed months to the margin
+	// accounts, then return their status.
+	ChargeMargin(context.Context, *ChargeMarginPayload) (res *MarginStatus, err error)
+	// List recent portfolio events, oldest first.
+	ListEvents(context.Context, *ListEventsPayload) (res []*PortfolioEvent, err error)
+	// Place a paper trading order. Orders are filled by the matching simulator
+	// against market ticks, with the configured slippage and commission, and fills
+	// post to the ledger. Status changes are listed as order_status events.
+	PlaceOrder(context.Context, *PlaceOrderPayload) (res *Order, err error)
+	// List the paper trading orders of a portfolio, oldest first.
+	ListOrders(context.Context, *ListOrdersPayload) (res []*Order, err error)
+	// Cancel an open paper trading order.
+	CancelOrder(context.Context, *CancelOrderPayload) (res *Order, err error)
+	// Show the effect of hypothetical buys and sells on the summary, allocation,
+	// realized gains and tax, and risk of a portfolio without persisting anything.
+	PreviewTrades(context.Context, *PreviewTradesPayload) (res *TradePreview, err error)
+	// Replay stored historical prices through an allocation strategy
+	// (buy-and-hold, periodic or threshold rebalancing, or dollar-cost averaging)
+	// and report the equity curve, returns, drawdown and turnover.
+	RunBacktest(context.Context, *RunBacktestPayload) (res *BacktestResult, err error)
+	// Import a broker CSV statement into a portfolio ledger with a named mapping
+	// profile. Rows already in the ledger are skipped as duplicates and rows that
+	// cannot be read are reported rather than failing the import; a dry run
+	// reports the outcome without posting anything.
+	ImportCsv(context.Context, *ImportCsvPayload) (res *ImportReport, err error)
+	// Import an OFX 1.x (SGML) or 2.x (XML) bank or investment statement,
+	// including Quicken QFX files, into a portfolio ledger and reconcile the
+	// positions and cash of the statement against the ledger afterwards. Rows
+	// already in the ledger are skipped as duplicates; a dry run reports the
+	// outcome without posting anything.
+	ImportOfx(context.Context, *ImportOfxPayload) (res *ImportReport, err error)
+	// Export the ledger of a portfolio as a Beancount or hledger journal for
+	// download: lots at cost with their acquisition dates, sales relieving the
+	// lots they disposed of, commodity declarations and month-end prices of the
+	// instruments held.
+	ExportJournal(context.Context, *ExportJournalPayload) (res *ExportFile, err error)
+	// Import the transactions of a Beancount or hledger journal into a portfolio
+	// ledger. Journals exported by exportJournal read back exactly; other
+	// transactions are read from their postings under the root account.
+	// Transactions already in the ledger are skipped as duplicates; a dry run
+	// reports the outcome without posting anything.
+	ImportJournal(context.Context, *ImportJournalPayload) (res *ImportReport, err error)
+	// Export a portfolio as a versioned JSON archive for download, to move it to
+	// another environment: its metadata, ledger, the instruments it refers to,
+	// target weights and settings, with the schema version and a checksum.
+	ExportArchive(context.Context, *ExportArchivePayload) (res *ExportFile, err error)
+	// Import a portfolio archive written by exportArchive, migrating archives of
+	// older schema versions forward. Archives that fail their checksum or come
+	// from a newer schema version are refused. An existing portfolio is only
+	// overwritten when replace is set.
+	ImportArchive(context.Context, *ImportArchivePayload) (res *ArchiveImport, err error)
+	// Reconcile the holdings derived from a portfolio ledger against a broker
+	// position snapshot, a CSV position file read with a mapping profile or an OFX
+	// statement, per account and date. Breaks (quantity mismatches, symbols
+	// missing on either side and cash differences) are tracked with suggested
+	// adjusting entries: a break found again is updated rather than duplicated,
+	// and open breaks of the checked accounts and dates that now match are
+	// resolved.
+	ReconcilePositions(context.Context, *ReconcilePositionsPayload) (res *Reconciliation, err error)
+	// List the reconciliation breaks of a portfolio in the order they were found.
+	ListReconciliationBreaks(context.Context, *ListReconciliationBreaksPayload) (res []*ReconciliationBreak, err error)
+	// Set the status of a reconciliation break. Resolving an open break with apply
+	// posts its suggested adjustments to the ledger. Resolved breaks can only be
+	// reopened.
+	UpdateReconciliationBreak(context.Context, *UpdateReconciliationBreakPayload) (res *ReconciliationBreak, err error)
+	// Report the capital gains realized in a calendar year, one line per lot
+	// disposed with its acquisition and disposal dates, proceeds, cost basis,
+	// short-term or long-term classification and wash-sale adjustment, grouped by
+	// account.
+	GetTaxReport(context.Context, *GetTaxReportPayload) (res *TaxReport, err error)
+	// Export the capital gains report of a calendar year for download, as CSV with
+	// one row per lot disposed or as JSON shaped like getTaxReport.
+	ExportTaxReport(context.Context, *ExportTaxReportPayload) (res *ExportFile, err error)
+	// List the open tax lots of a portfolio in acquisition order, with the losses
+	// deferred into them by wash sales and their unrealized gains.
+	ListLots(context.Context, *ListLotsPayload) (res []*TaxLot, err error)
+	// Scan the open lots of a portfolio for unrealized losses above a threshold
+	// and suggest sales paired with replacements that are not substantially
+	// identical, avoiding wash-sale windows, with the tax saved at the given
+	// marginal rates.
+	FindTaxLossHarvests(context.Context, *FindTaxLossHarvestsPayload) (res *TaxLossHarvest, err error)
+	// List the accounts of a portfolio, registered or referred to by transactions,
+	// with their tax treatment and balances.
+	ListAccounts(context.Context, *ListAccountsPayload) (res []*Account, err error)
+	// Register or update an account of a portfolio with its type, tax treatment,
+	// custodian and base currency. The tax treatment defaults to that of the type.
+	SetAccount(context.Context, *SetAccountPayload) (res *Account, err error)
+	// Remove a registered account that no transaction refers to and return it.
+	RemoveAccount(context.Context, *RemoveAccountPayload) (res *Account, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -32,7 +185,1164 @@ const ServiceName = "portfolio"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [1]string{"getPortfolioSummary"}
+var MethodNames = [47]string{"getPortfolioSummary", "getReturns", "getAllocation", "getTargetAllocation", "setTargetAllocation", "proposeRebalance", "setBenchmark", "getBenchmarkComparison", "getRiskMetrics", "getValueAtRisk", "listStressScenarios", "runStressTest", "projectPortfolio", "listCorporateActions", "applyCorporateActions", "recordIncome", "getIncome", "priceBond", "listBondPositions", "getCashFlowLadder", "listOptionPositions", "settleOptionExpiries", "setMarginAccount", "getMarginStatus", "chargeMargin", "listEvents", "placeOrder", "listOrders", "cancelOrder", "previewTrades", "runBacktest", "importCsv", "importOfx", "exportJournal", "importJournal", "exportArchive", "importArchive", "reconcilePositions", "listReconciliationBreaks", "updateReconciliationBreak", "getTaxReport", "exportTaxReport", "listLots", "findTaxLossHarvests", "listAccounts", "setAccount", "removeAccount"}
+
+// Account is the result type of the portfolio service setAccount method.
+type Account struct {
+	// Account identifier transactions refer to; empty for the default account
+	ID string
+	// Display name
+	Name string
+	// Account type
+	Type string
+	// How gains and income of the account are taxed
+	TaxTreatment string
+	// Broker or institution holding the account
+	Custodian *string
+	// Base currency of the account
+	Currency string
+	// Set explicitly; otherwise implied by transactions as a taxable account
+	Registered bool
+	// Cash balance
+	Cash float64
+	// Market value of the positions
+	HoldingsValue float64
+	// Cash plus holdings value
+	TotalValue float64
+}
+
+// Positions and cash of a statement account compared with the ledger after an
+// import.
+type AccountReconciliation struct {
+	// Ledger account
+	Account string
+	// Statement date
+	AsOf string
+	// Positions by symbol, including ledger holdings the statement does not list
+	Positions []*PositionCheck
+	// Cash balance in the statement
+	StatementCash *float64
+	// Cash in the ledger account
+	LedgerCash float64
+	// Whether every position and the cash agree
+	Matched bool
+}
+
+// Allocation is the result type of the portfolio service getAllocation method.
+type Allocation struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Dimension holdings are grouped by
+	Dimension string
+	// Custom tag key when grouping by tag
+	Tag *string
+	// Account the holdings were limited to
+	Account *string
+	// Valuation date
+	AsOf string
+	// Currency of the values
+	Currency string
+	// Total portfolio value including cash
+	TotalValue float64
+	// Buckets ordered by value, largest first
+	Buckets []*AllocationBucket
+}
+
+type AllocationBucket struct {
+	// Bucket name, e.g. an asset class or sector
+	Key string
+	// Market value of the bucket
+	Value float64
+	// Share of total portfolio value as a decimal fraction
+	Weight float64
+	// Symbols held in the bucket
+	Symbols []string
+}
+
+// Allocation bucket before and after hypothetical trades.
+type AllocationChange struct {
+	// Bucket key
+	Key string
+	// Market value before
+	ValueBefore float64
+	// Share of portfolio value before
+	WeightBefore float64
+	// Market value after
+	ValueAfter float64
+	// Share of portfolio value after
+	WeightAfter float64
+}
+
+// ApplyCorporateActionsPayload is the payload type of the portfolio service
+// applyCorporateActions method.
+type ApplyCorporateActionsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Actions to apply
+	Actions []*CorporateActionInput
+}
+
+// ArchiveImport is the result type of the portfolio service importArchive
+// method.
+type ArchiveImport struct {
+	// Portfolio created or replaced
+	PortfolioID string
+	// Schema version the archive was written in
+	SchemaVersion int
+	// Whether the archive was migrated from an older schema version
+	Migrated bool
+	// Whether an existing portfolio was replaced
+	Replaced bool
+	// When the archive was created
+	CreatedAt string
+	// Ledger transactions imported
+	Transactions int
+	// Instruments added or updated
+	Instruments int
+	// Target weights imported
+	Targets int
+	// Registered accounts imported
+	Accounts int
+	// Margin accounts imported
+	MarginAccounts int
+}
+
+// Capital market assumption for an asset class. Rates are annual decimal
+// fractions.
+type AssetClassAssumption struct {
+	// Asset class, e.g. equity
+	AssetClass string
+	// Share of the portfolio; defaults to the current allocation
+	Weight *float64
+	// Expected annual return
+	ExpectedReturn *float64
+	// Annual volatility
+	Volatility *float64
+}
+
+// Value of a backtested strategy at the close of a trading day.
+type BacktestPoint struct {
+	// Trading day
+	Date string
+	// Cash plus market value
+	Value float64
+	// External cash flow on the day
+	Flow float64
+	// Decline of the time-weighted index from its running peak, as a positive
+	// fraction
+	Drawdown float64
+}
+
+// BacktestResult is the result type of the portfolio service runBacktest
+// method.
+type BacktestResult struct {
+	// Strategy name
+	Name string
+	// Strategy kind
+	Strategy string
+	// First trading day
+	Start string
+	// Last trading day
+	End string
+	// Currency of the values
+	Currency string
+	// Value at the end
+	EndValue float64
+	// Initial cash plus contributions
+	NetContributions float64
+	// Change in value not explained by contributions
+	Gain float64
+	// Cumulative time-weighted return
+	TimeWeightedReturn float64
+	// Annualized time-weighted return, for periods of at least one year
+	AnnualizedReturn *float64
+	// Money-weighted return (XIRR)
+	MoneyWeightedReturn *float64
+	// Annualized volatility of daily returns
+	Volatility float64
+	// Annualized Sharpe ratio
+	SharpeRatio float64
+	// Largest peak-to-trough decline as a positive fraction
+	MaxDrawdown float64
+	// Date of the peak before the largest decline
+	MaxDrawdownPeak *string
+	// Date of the trough of the largest decline
+	MaxDrawdownTrough *string
+	// Number of trades
+	Trades int
+	// Value bought plus sold
+	TradedValue float64
+	// Commission paid
+	Fees float64
+	// Lesser of the value bought and sold divided by the average value; investing
+	// deposited cash is not counted
+	Turnover float64
+	// Daily values, starting with the base day before start
+	EquityCurve []*BacktestPoint
+}
+
+// Target weight of a symbol in a backtested strategy.
+type BacktestTarget struct {
+	// Instrument symbol
+	Symbol string
+	// Target weight
+	Weight float64
+}
+
+// BenchmarkComparison is the result type of the portfolio service
+// getBenchmarkComparison method.
+type BenchmarkComparison struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Benchmark measured against
+	Benchmark *BenchmarkDefinition
+	// First day of the period
+	Start string
+	// Last day of the period
+	End string
+	// Portfolio time-weighted return
+	PortfolioReturn float64
+	// Benchmark cumulative return
+	BenchmarkReturn float64
+	// Portfolio return less benchmark return
+	ExcessReturn float64
+	// Annualized standard deviation of daily excess returns
+	TrackingError float64
+	// Cumulative returns per trading day
+	Series []*BenchmarkComparisonPoint
+}
+
+type BenchmarkComparisonPoint struct {
+	// Trading day
+	Date string
+	// Portfolio cumulative return
+	Portfolio float64
+	// Benchmark cumulative return
+	Benchmark float64
+}
+
+type BenchmarkComponent struct {
+	// Instrument symbol priced through the market data provider
+	Symbol string
+	// Target weight as a decimal fraction
+	Weight float64
+}
+
+// BenchmarkDefinition is the result type of the portfolio service setBenchmark
+// method.
+type BenchmarkDefinition struct {
+	// Display name
+	Name string
+	// Blend components; weights must sum to 1
+	Components []*BenchmarkComponent
+	// Blend in the form 60% SPY / 40% AGG; set either components or blend
+	Blend *string
+	// How often the blend is reset to its weights; none keeps static initial
+	// weights
+	Rebalance string
+}
+
+// BondAnalytics is the result type of the portfolio service priceBond method.
+type BondAnalytics struct {
+	// Settlement date
+	Settlement string
+	// Price excluding accrued interest
+	CleanPrice float64
+	// Price including accrued interest
+	DirtyPrice float64
+	// Coupon earned since the last coupon date
+	AccruedInterest float64
+	// Annual yield compounded at the coupon frequency
+	YieldToMaturity float64
+	// Macaulay duration in years
+	MacaulayDuration float64
+	// Modified duration in years
+	ModifiedDuration float64
+}
+
+// Coupon and principal expected from a holding on a date.
+type BondCashFlow struct {
+	// Payment date
+	Date string
+	// Instrument symbol
+	Symbol string
+	// Coupon income
+	Coupon float64
+	// Principal repaid at maturity
+	Principal float64
+	// Coupon plus principal
+	Total float64
+}
+
+// Bond holding valued at its latest clean price.
+type BondPosition struct {
+	// Instrument symbol
+	Symbol string
+	// Number of bonds held
+	Quantity float64
+	// Annual coupon rate as a decimal fraction
+	Coupon float64
+	// Coupons per year; 0 for zero-coupon bonds
+	CouponFrequency int
+	// Day count convention
+	DayCount string
+	// Maturity date
+	Maturity string
+	// Face value per bond
+	FaceValue float64
+	// Price measures per bond
+	Analytics *BondAnalytics
+	// Quantity times dirty price
+	MarketValue float64
+	// Quantity times accrued interest
+	AccruedValue float64
+}
+
+// CancelOrderPayload is the payload type of the portfolio service cancelOrder
+// method.
+type CancelOrderPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Order ID
+	ID string
+}
+
+// CashFlowLadder is the result type of the portfolio service getCashFlowLadder
+// method.
+type CashFlowLadder struct {
+	// Portfolio identifier
+	PortfolioID string
+	// First day of the ladder
+	AsOf string
+	// Last day of the ladder
+	End string
+	// Currency of the portfolio
+	Currency string
+	// Cash flows in date order
+	Flows []*BondCashFlow
+	// Cash flows per calendar year
+	ByYear []*CashFlowLadderYear
+	// Sum of all cash flows
+	Total float64
+}
+
+// Bond cash flows expected in a calendar year.
+type CashFlowLadderYear struct {
+	// Calendar year
+	Year int
+	// Coupon income
+	Coupon float64
+	// Principal repaid
+	Principal float64
+	// Coupon plus principal
+	Total float64
+}
+
+// ChargeMarginPayload is the payload type of the portfolio service
+// chargeMargin method.
+type ChargeMarginPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+}
+
+// Corporate action recorded in the ledger.
+type CorporateAction struct {
+	// Ledger transaction ID
+	ID string
+	// Effective date
+	Date string
+	// Action type
+	Type string
+	// Symbol affected
+	Symbol string
+	// Renamed, spun-off or acquiring symbol
+	NewSymbol *string
+	// Resulting shares per share held, e.g. 1.5 for a 3-for-2 split or 0.1 for a
+	// 1-for-10 reverse split
+	Ratio float64
+	// Cash paid per share held in a merger
+	CashPerShare float64
+	// Share of cost basis moved to spun-off shares
+	BasisFraction float64
+	// Price of the resulting shares on the effective date, for cash in lieu and
+	// merger basis allocation
+	Price float64
+	// Pay fractional resulting shares in cash
+	CashInLieu bool
+	// Free-form note
+	Note *string
+}
+
+// Corporate action to apply to every account holding the symbol on the
+// effective date.
+type CorporateActionInput struct {
+	// Effective date
+	Date string
+	// Action type
+	Type string
+	// Symbol affected
+	Symbol string
+	// Renamed, spun-off or acquiring symbol
+	NewSymbol *string
+	// Resulting shares per share held, e.g. 1.5 for a 3-for-2 split or 0.1 for a
+	// 1-for-10 reverse split
+	Ratio float64
+	// Cash paid per share held in a merger
+	CashPerShare float64
+	// Share of cost basis moved to spun-off shares
+	BasisFraction float64
+	// Price of the resulting shares on the effective date, for cash in lieu and
+	// merger basis allocation
+	Price float64
+	// Pay fractional resulting shares in cash
+	CashInLieu bool
+	// Free-form note
+	Note *string
+}
+
+// ExportArchivePayload is the payload type of the portfolio service
+// exportArchive method.
+type ExportArchivePayload struct {
+	// Portfolio identifier
+	PortfolioID string
+}
+
+// ExportFile is the result type of the portfolio service exportJournal method.
+type ExportFile struct {
+	// Attachment header naming the file
+	ContentDisposition string
+	// File content
+	Content string
+}
+
+// ExportJournalPayload is the payload type of the portfolio service
+// exportJournal method.
+type ExportJournalPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Journal format
+	Format string
+}
+
+// ExportTaxReportPayload is the payload type of the portfolio service
+// exportTaxReport method.
+type ExportTaxReportPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Tax year
+	Year int
+	// File format
+	Format string
+}
+
+// FindTaxLossHarvestsPayload is the payload type of the portfolio service
+// findTaxLossHarvests method.
+type FindTaxLossHarvestsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Only lots of this account
+	Account *string
+	// Smallest net loss worth harvesting in an account and symbol
+	MinLoss float64
+	// Marginal tax rate on short-term gains as a decimal fraction
+	ShortTermRate float64
+	// Marginal tax rate on long-term gains as a decimal fraction
+	LongTermRate float64
+}
+
+// GetAllocationPayload is the payload type of the portfolio service
+// getAllocation method.
+type GetAllocationPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Grouping dimension
+	Dimension string
+	// Custom tag key, required when dimension is tag
+	Tag *string
+	// Only holdings of this account
+	Account *string
+}
+
+// GetBenchmarkComparisonPayload is the payload type of the portfolio service
+// getBenchmarkComparison method.
+type GetBenchmarkComparisonPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Reporting period
+	Period string
+	// Start date for custom periods
+	Start *string
+	// End date for custom periods (defaults to today)
+	End *string
+}
+
+// GetCashFlowLadderPayload is the payload type of the portfolio service
+// getCashFlowLadder method.
+type GetCashFlowLadderPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Number of years ahead to include
+	Years int
+}
+
+// GetIncomePayload is the payload type of the portfolio service getIncome
+// method.
+type GetIncomePayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Reporting period
+	Period string
+	// Start date for custom periods
+	Start *string
+	// End date for custom periods (defaults to today)
+	End *string
+	// Length of the periods income is grouped by
+	Interval string
+}
+
+// GetMarginStatusPayload is the payload type of the portfolio service
+// getMarginStatus method.
+type GetMarginStatusPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+}
+
+// GetPortfolioSummaryPayload is the payload type of the portfolio service
+// getPortfolioSummary method.
+type GetPortfolioSummaryPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Only this account
+	Account *string
+}
+
+// GetReturnsPayload is the payload type of the portfolio service getReturns
+// method.
+type GetReturnsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Reporting period
+	Period string
+	// Start date for custom periods
+	Start *string
+	// End date for custom periods (defaults to today)
+	End *string
+	// Only this account
+	Account *string
+}
+
+// GetRiskMetricsPayload is the payload type of the portfolio service
+// getRiskMetrics method.
+type GetRiskMetricsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Reporting period
+	Period string
+	// Start date for custom periods
+	Start *string
+	// End date for custom periods (defaults to today)
+	End *string
+	// Annual risk-free rate; defaults to the configured rate
+	RiskFreeRate *float64
+	// Rolling window length in trading days
+	Window *int
+}
+
+// GetTargetAllocationPayload is the payload type of the portfolio service
+// getTargetAllocation method.
+type GetTargetAllocationPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+}
+
+// GetTaxReportPayload is the payload type of the portfolio service
+// getTaxReport method.
+type GetTaxReportPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Tax year
+	Year int
+}
+
+// GetValueAtRiskPayload is the payload type of the portfolio service
+// getValueAtRisk method.
+type GetValueAtRiskPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Estimation method
+	Method string
+	// Confidence level
+	Confidence float64
+	// Holding period in trading days
+	Horizon int
+	// Trading days of price history to use
+	Lookback int
+	// Number of Monte Carlo paths
+	Simulations int
+	// Monte Carlo seed for reproducible results
+	Seed *int64
+}
+
+// Suggested sale of lots at a loss, paired with a replacement that is not
+// substantially identical.
+type HarvestOpportunity struct {
+	// Account; empty for the default account
+	Account string
+	// Instrument to sell
+	Symbol string
+	// Lots the sale relieves, first in, first out
+	Lots []string
+	// Quantity to sell
+	Quantity float64
+	// Latest close
+	Price float64
+	// Cost basis of the lots, including wash sale adjustments
+	CostBasis float64
+	// Value of the lots at the latest close
+	MarketValue float64
+	// Net loss realized by the sale
+	Loss float64
+	// Net short-term loss; negative for a net gain
+	ShortTermLoss float64
+	// Net long-term loss; negative for a net gain
+	LongTermLoss float64
+	// Tax saved on the losses at the marginal rates
+	EstimatedSavings float64
+	// First eligible replacement from the configured mapping, if any
+	Replacement *string
+	// Latest close of the replacement, when known
+	ReplacementPrice *float64
+	// Quantity of the replacement the sale proceeds buy, when its price is known
+	ReplacementQuantity *float64
+	// First day the sold security can be bought back without a wash sale
+	RepurchaseAfter string
+}
+
+// Ex-ante risk of holdings from the daily returns of their instruments.
+type HoldingsRisk struct {
+	// Annualized volatility as a share of portfolio value
+	Volatility float64
+	// One-day parametric value at risk
+	ValueAtRisk float64
+	// One-day expected shortfall beyond the value at risk
+	ExpectedShortfall float64
+}
+
+// ImportArchivePayload is the payload type of the portfolio service
+// importArchive method.
+type ImportArchivePayload struct {
+	// Portfolio to import as; defaults to the portfolio in the archive
+	PortfolioID *string
+	// Replace an existing portfolio of the same ID
+	Replace bool
+	// Portfolio archive
+	Content string
+}
+
+// ImportCsvPayload is the payload type of the portfolio service importCsv
+// method.
+type ImportCsvPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Mapping profile name
+	Profile string
+	// Account for rows without one
+	Account string
+	// Preview the import without posting
+	DryRun bool
+	// CSV statement
+	Content string
+}
+
+// ImportJournalPayload is the payload type of the portfolio service
+// importJournal method.
+type ImportJournalPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Account for transactions booked to the root account itself
+	Account string
+	// Parent account of the portfolio cash and positions
+	Root string
+	// Preview the import without posting
+	DryRun bool
+	// Beancount or hledger journal
+	Content string
+}
+
+// ImportOfxPayload is the payload type of the portfolio service importOfx
+// method.
+type ImportOfxPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Account to book to; defaults to the account number in the statement
+	Account string
+	// Preview the import without posting
+	DryRun bool
+	// OFX or QFX statement
+	Content string
+}
+
+// ImportReport is the result type of the portfolio service importCsv method.
+type ImportReport struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Statement format
+	Format string
+	// Mapping profile used
+	Profile *string
+	// Whether the import was only previewed
+	DryRun bool
+	// Rows posted, or that would be posted in a dry run
+	Imported int
+	// Rows already in the ledger
+	Duplicates int
+	// Rows ignored by the profile
+	Skipped int
+	// Rows that could not be read or posted
+	Errors int
+	// Outcome of every row in file order
+	Rows []*ImportRow
+	// Statement positions and cash compared with the ledger after the import
+	Reconciliation []*AccountReconciliation
+}
+
+// Outcome of importing a statement row.
+type ImportRow struct {
+	// Line of the row in the file
+	Line int
+	// Row outcome
+	Status string
+	// Stable fingerprint of the transaction used to detect duplicates
+	Fingerprint *string
+	// Transaction read from the row
+	Transaction *LedgerTransaction
+	// Why the row could not be read or posted, or was skipped
+	Error *string
+}
+
+// Income totals for a period, holding or currency.
+type IncomeBucket struct {
+	// Period label, symbol or currency
+	Key string
+	// Gross income
+	Gross float64
+	// Tax withheld at source
+	Withholding float64
+	// Income after withholding
+	Net float64
+}
+
+// Expected gross income for a month.
+type IncomeForecast struct {
+	// Month as YYYY-MM
+	Month string
+	// Expected gross income
+	Amount float64
+}
+
+// IncomeRecord is the result type of the portfolio service recordIncome method.
+type IncomeRecord struct {
+	// Ledger transaction ID
+	ID string
+	// Income type
+	Type string
+	// Paying instrument; optional for interest
+	Symbol *string
+	// Account credited
+	Account string
+	// Pay date
+	PayDate string
+	// Ex-dividend date
+	ExDate *string
+	// Gross amount
+	Amount float64
+	// Tax withheld at source
+	Withholding float64
+	// Cash received after withholding
+	Net float64
+	// Dividend taxed at qualified rates
+	Qualified bool
+	// Ledger ID of the purchase reinvesting the dividend
+	ReinvestmentID *string
+	// Shares bought by reinvestment
+	ReinvestedQuantity *float64
+}
+
+// IncomeSummary is the result type of the portfolio service getIncome method.
+type IncomeSummary struct {
+	// Portfolio identifier
+	PortfolioID string
+	// First day of the period
+	Start string
+	// Last day of the period
+	End string
+	// Currency of the amounts
+	Currency string
+	// Length of the periods in by_period
+	Interval string
+	// Gross income
+	Gross float64
+	// Tax withheld at source
+	Withholding float64
+	// Income after withholding
+	Net float64
+	// Gross qualified dividends
+	Qualified float64
+	// Gross ordinary dividends and interest
+	Ordinary float64
+	// Income per period
+	ByPeriod []*IncomeBucket
+	// Income per symbol; interest without a symbol is keyed cash
+	ByHolding []*IncomeBucket
+	// Income per instrument currency
+	ByCurrency []*IncomeBucket
+	// Expected income per month over the next 12 months
+	Forecast []*IncomeForecast
+	// Expected income over the next 12 months
+	ForecastTotal float64
+}
+
+// Cash or trade transaction in a portfolio ledger.
+type LedgerTransaction struct {
+	// Transaction identifier, once posted
+	ID *string
+	// Transaction date
+	Date string
+	// Transaction type
+	Type string
+	// Account; empty for the default account
+	Account string
+	// Instrument symbol
+	Symbol string
+	// Quantity traded
+	Quantity float64
+	// Price per unit
+	Price float64
+	// Cash amount of deposits, withdrawals, income and fees
+	Amount float64
+	// Commission
+	Fee float64
+	// Description
+	Note *string
+}
+
+// ListAccountsPayload is the payload type of the portfolio service
+// listAccounts method.
+type ListAccountsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+}
+
+// ListBondPositionsPayload is the payload type of the portfolio service
+// listBondPositions method.
+type ListBondPositionsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+}
+
+// ListCorporateActionsPayload is the payload type of the portfolio service
+// listCorporateActions method.
+type ListCorporateActionsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+}
+
+// ListEventsPayload is the payload type of the portfolio service listEvents
+// method.
+type ListEventsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Only events after this time
+	Since *string
+	// Only events of this type
+	Type *string
+}
+
+// ListLotsPayload is the payload type of the portfolio service listLots method.
+type ListLotsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Only lots of this account
+	Account *string
+	// Only lots of this instrument
+	Symbol *string
+}
+
+// ListOptionPositionsPayload is the payload type of the portfolio service
+// listOptionPositions method.
+type ListOptionPositionsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Annual volatility for every underlying; defaults to the configured inputs
+	Volatility *float64
+}
+
+// ListOrdersPayload is the payload type of the portfolio service listOrders
+// method.
+type ListOrdersPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Only orders with this status
+	Status *string
+}
+
+// ListReconciliationBreaksPayload is the payload type of the portfolio service
+// listReconciliationBreaks method.
+type ListReconciliationBreaksPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Only breaks with this status
+	Status *string
+	// Only breaks of this account
+	Account *string
+}
+
+// Margin position of an account.
+type MarginAccountStatus struct {
+	// Account
+	Account string
+	// Cash balance; negative when borrowing
+	Cash float64
+	// Market value of long positions
+	LongValue float64
+	// Market value of short positions
+	ShortValue float64
+	// Proceeds of the open short sales
+	ShortProceeds float64
+	// Cash plus long value less short value
+	Equity float64
+	// Margin loan balance
+	LoanBalance float64
+	// Equity required by the initial requirement
+	InitialRequirement float64
+	// Equity required by the maintenance requirements
+	MaintenanceRequirement float64
+	// Equity above the maintenance requirement; negative under a margin call
+	MaintenanceExcess float64
+	// Value of new positions the equity supports
+	BuyingPower float64
+	// Equity is below the maintenance requirement
+	MarginCall bool
+	// Margin interest charged to date
+	InterestCharged float64
+	// Stock borrow fees charged to date
+	BorrowFeesCharged float64
+	// Margin interest accrued this month, not yet charged
+	AccruedInterest float64
+	// Borrow fees accrued this month, not yet charged
+	AccruedBorrowFees float64
+}
+
+// MarginStatus is the result type of the portfolio service getMarginStatus
+// method.
+type MarginStatus struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Valuation date
+	AsOf string
+	// Currency of the values
+	Currency string
+	// Margin accounts by name
+	Accounts []*MarginAccountStatus
+}
+
+// MarginTerms is the result type of the portfolio service setMarginAccount
+// method.
+type MarginTerms struct {
+	// Account
+	Account string
+	// Equity required to open positions, as a share of their value
+	InitialRequirement float64
+	// Equity required to keep long positions
+	MaintenanceRequirement float64
+	// Equity required to keep short positions
+	ShortMaintenanceRequirement float64
+	// Annual interest rate on the margin loan
+	LoanRate float64
+	// Annual fee for borrowing shares held short
+	BorrowRate float64
+	// Borrow fee rates by symbol overriding borrow_rate
+	BorrowRates map[string]float64
+}
+
+// Option holding valued with Black-Scholes (Black-76 for options on futures).
+// Greeks are for the whole position.
+type OptionPosition struct {
+	// Account holding the contracts
+	Account string
+	// Option symbol
+	Symbol string
+	// Underlying symbol
+	Underlying string
+	// Option type
+	OptionType string
+	// Strike price
+	Strike float64
+	// Expiry date
+	Expiry string
+	// Units of the underlying per contract
+	Multiplier float64
+	// Option on a futures contract
+	FuturesOption bool
+	// Contracts held
+	Quantity float64
+	// Latest underlying price
+	UnderlyingPrice float64
+	// Annual volatility input
+	Volatility float64
+	// Model price per contract
+	Price float64
+	// Model value of the position
+	MarketValue float64
+	// Change in value per unit move of the underlying, in underlying units
+	Delta float64
+	// Change in delta per unit move of the underlying
+	Gamma float64
+	// Change in value per calendar day
+	Theta float64
+	// Change in value per volatility point
+	Vega float64
+}
+
+// OptionPositions is the result type of the portfolio service
+// listOptionPositions method.
+type OptionPositions struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Valuation date
+	AsOf string
+	// Currency of the values
+	Currency string
+	// Option positions by account and symbol
+	Positions []*OptionPosition
+	// Exposure per underlying of the options held
+	Exposures []*UnderlyingExposure
+}
+
+// Ledger transaction posted when an option expired, was exercised or assigned.
+type OptionSettlement struct {
+	// Ledger transaction ID
+	ID string
+	// Expiry date
+	Date string
+	// Account
+	Account string
+	// Option or underlying symbol
+	Symbol string
+	// Transaction type
+	Type string
+	// Quantity
+	Quantity float64
+	// Price
+	Price float64
+	// What happened
+	Note string
+}
+
+// Order is the result type of the portfolio service placeOrder method.
+type Order struct {
+	// Order ID
+	ID string
+	// Account trading; empty for the default account
+	Account string
+	// Instrument symbol
+	Symbol string
+	// Order side
+	Side string
+	// Order type
+	Type string
+	// Quantity
+	Quantity float64
+	// Limit price of limit and stop-limit orders
+	LimitPrice *float64
+	// Stop price of stop and stop-limit orders
+	StopPrice *float64
+	// How long the order works
+	TimeInForce string
+	// Order status
+	Status string
+	// The stop price of a stop or stop-limit order traded
+	Triggered bool
+	// When the order was placed
+	Created string
+	// When the status last changed
+	Updated string
+	// Fill price including slippage; 0 until filled
+	FillPrice float64
+	// Commission charged; 0 until filled
+	Commission float64
+	// Why the order was rejected
+	Reason *string
+}
+
+// PlaceOrderPayload is the payload type of the portfolio service placeOrder
+// method.
+type PlaceOrderPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Account trading; defaults to the default account
+	Account *string
+	// Instrument symbol
+	Symbol string
+	// Order side
+	Side string
+	// Order type
+	Type string
+	// Quantity
+	Quantity float64
+	// Limit price of limit and stop-limit orders
+	LimitPrice *float64
+	// Stop price of stop and stop-limit orders
+	StopPrice *float64
+	// How long the order works
+	TimeInForce string
+}
+
+// Notable portfolio event such as a margin call.
+type PortfolioEvent struct {
+	// Event sequence number
+	ID int
+	// When the event occurred
+	Time string
+	// Portfolio identifier
+	PortfolioID string
+	// Event type
+	Type string
+	// Account concerned, if any
+	Account *string
+	// Description
+	Message string
+}
+
+// PortfolioReturns is the result type of the portfolio service getReturns
+// method.
+type PortfolioReturns struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Requested period
+	Period string
+	// Account the returns were limited to
+	Account *string
+	// First day of the period
+	Start string
+	// Last day of the period
+	End string
+	// Portfolio value at the close before the period
+	StartValue float64
+	// Portfolio value at the close of the last day
+	EndValue float64
+	// Deposits less withdrawals during the period
+	NetContributions float64
+	// Change in value not explained by contributions
+	Gain float64
+	// Chain-linked time-weighted return over the period
+	TimeWeightedReturn float64
+	// Annualized time-weighted return, only for periods of at least one year
+	AnnualizedTimeWeightedReturn *float64
+	// Money-weighted return (XIRR), annualized only for periods of at least one
+	// year
+	MoneyWeightedReturn *float64
+}
 
 // PortfolioSummary is the result type of the portfolio service
 // getPortfolioSummary method.
@@ -45,12 +1355,878 @@ type PortfolioSummary struct {
 	ChangePercent float64
 }
 
+// Quantity of a symbol in a statement compared with the ledger.
+type PositionCheck struct {
+	// Instrument symbol
+	Symbol string
+	// Quantity in the statement
+	StatementQuantity float64
+	// Quantity in the ledger
+	LedgerQuantity float64
+	// Statement less ledger quantity
+	Difference float64
+	// Whether the quantities agree
+	Matched bool
+}
+
+// PreviewTradesPayload is the payload type of the portfolio service
+// previewTrades method.
+type PreviewTradesPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Hypothetical trades
+	Trades []*TradeInput
+	// Allocation dimension
+	Dimension string
+	// Tax rate on net short-term gains
+	ShortTermTaxRate float64
+	// Tax rate on net long-term gains
+	LongTermTaxRate float64
+	// Trading days of returns used for risk
+	Lookback int
+}
+
+// PriceBondPayload is the payload type of the portfolio service priceBond
+// method.
+type PriceBondPayload struct {
+	// Annual coupon rate as a decimal fraction
+	Coupon float64
+	// Coupons per year; 0 for zero-coupon bonds
+	CouponFrequency int
+	// Day count convention
+	DayCount string
+	// Maturity date
+	Maturity string
+	// Face value per bond
+	FaceValue float64
+	// Settlement date; defaults to today
+	Settlement *string
+	// Clean price per bond
+	CleanPrice *float64
+	// Yield to maturity
+	Yield *float64
+}
+
+// ProjectPortfolioPayload is the payload type of the portfolio service
+// projectPortfolio method.
+type ProjectPortfolioPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Last day of the projection
+	End string
+	// Starting value; defaults to the current portfolio value
+	StartValue *float64
+	// Monthly contribution in today's money
+	MonthlyContribution float64
+	// Monthly withdrawal in today's money
+	MonthlyWithdrawal float64
+	// Date withdrawals begin, e.g. retirement; defaults to today
+	WithdrawalStart *string
+	// Annual inflation rate
+	Inflation float64
+	// Goal amount in today's money
+	Goal *float64
+	// Date the goal should be reached by; defaults to the end
+	GoalDate *string
+	// Assumptions overriding the defaults per asset class
+	Assumptions []*AssetClassAssumption
+	// Number of simulated paths
+	Paths int
+	// Seed for reproducible results
+	Seed *int64
+}
+
+// Projection is the result type of the portfolio service projectPortfolio
+// method.
+type Projection struct {
+	// Portfolio identifier
+	PortfolioID string
+	// First day of the projection
+	Start string
+	// Last day of the projection
+	End string
+	// Value the projection starts from
+	StartValue float64
+	// Number of simulated paths
+	Paths int
+	// Assumptions used per asset class
+	Assumptions []*AssetClassAssumption
+	// Percentile bands at each anniversary and at the end
+	Bands []*ProjectionBand
+	// Goal amount
+	Goal *float64
+	// Date the goal should be reached by
+	GoalDate *string
+	// Share of paths reaching the goal by the goal date
+	GoalProbability *float64
+	// Share of paths that ran out of money
+	DepletionProbability float64
+}
+
+// Percentiles of the simulated portfolio value on a date, in today's money.
+type ProjectionBand struct {
+	// Date
+	Date string
+	// 5th percentile
+	P5 float64
+	// 25th percentile
+	P25 float64
+	// Median
+	P50 float64
+	// 75th percentile
+	P75 float64
+	// 95th percentile
+	P95 float64
+}
+
+// ProposeRebalancePayload is the payload type of the portfolio service
+// proposeRebalance method.
+type ProposeRebalancePayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Drop trades worth less than this amount
+	MinTradeValue float64
+	// Only sell lots that are long-term or at a loss
+	AvoidShortTermGains bool
+}
+
+type ProposedTrade struct {
+	// Instrument symbol
+	Symbol string
+	// Trade direction
+	Side string
+	// Quantity, rounded to the instrument lot size
+	Quantity float64
+	// Assumed execution price
+	Price float64
+	// Trade value
+	Value float64
+	// Weight before the trade
+	CurrentWeight float64
+	// Target weight
+	TargetWeight float64
+	// Weight after the trade
+	ProjectedWeight float64
+}
+
+// Part of a tax lot a hypothetical sale would relieve.
+type RealizedLot struct {
+	// Account
+	Account string
+	// Instrument symbol
+	Symbol string
+	// Ledger transaction that opened the lot
+	LotID string
+	// Acquisition date
+	Acquired string
+	// Quantity relieved
+	Quantity float64
+	// Net proceeds
+	Proceeds float64
+	// Cost basis relieved
+	CostBasis float64
+	// Realized gain; negative for losses
+	Gain float64
+	// Held long enough for long-term treatment
+	LongTerm bool
+}
+
+// RebalanceProposal is the result type of the portfolio service
+// proposeRebalance method.
+type RebalanceProposal struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Pricing date
+	AsOf string
+	// Cash on hand before the trades
+	CashBefore float64
+	// Projected cash after the trades
+	CashAfter float64
+	// Proposed trades, sells first
+	Trades []*ProposedTrade
+	// Constraints that prevented a full rebalance
+	Warnings []string
+}
+
+// ReconcilePositionsPayload is the payload type of the portfolio service
+// reconcilePositions method.
+type ReconcilePositionsPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Snapshot format
+	Format string
+	// Mapping profile naming the CSV columns
+	Profile string
+	// Account for CSV rows without one; for OFX, instead of the statement account
+	// number
+	Account string
+	// Date of CSV rows without one, and of OFX statements without a date; defaults
+	// to today
+	AsOf *string
+	// Position snapshot
+	Content string
+}
+
+// Account and date checked by a reconciliation run.
+type ReconciledAccount struct {
+	// Ledger account; empty for the default account
+	Account string
+	// Snapshot date
+	AsOf string
+	// Positions in the snapshot
+	Positions int
+	// Whether the snapshot has a cash balance
+	Cash bool
+	// Breaks found
+	Breaks int
+}
+
+// Reconciliation is the result type of the portfolio service
+// reconcilePositions method.
+type Reconciliation struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Snapshot format
+	Format string
+	// Accounts and dates checked
+	Accounts []*ReconciledAccount
+	// Breaks found for the first time, or again after being resolved
+	Opened int
+	// Open or ignored breaks found again
+	Updated int
+	// Open breaks of the checked accounts and dates that now match
+	Resolved int
+	// Breaks found by this run
+	Breaks []*ReconciliationBreak
+}
+
+// ReconciliationBreak is the result type of the portfolio service
+// updateReconciliationBreak method.
+type ReconciliationBreak struct {
+	// Break ID
+	ID string
+	// Ledger account; empty for the default account
+	Account string
+	// Snapshot date
+	AsOf string
+	// Break kind
+	Kind string
+	// Instrument symbol; absent for cash differences
+	Symbol *string
+	// Quantity, or cash balance, in the snapshot
+	Statement float64
+	// Quantity, or cash balance, in the ledger
+	Ledger float64
+	// Snapshot less ledger
+	Difference float64
+	// Price valuing the suggested position adjustments
+	Price *float64
+	// Suggested adjusting entries; empty when no price is known for a position
+	Adjustments []*LedgerTransaction
+	// Review status
+	Status string
+	// Why the status was set
+	Note *string
+	// When the break was first found
+	Detected string
+	// When the break last changed
+	Updated string
+	// IDs of the adjustments posted to resolve the break
+	Posted []string
+}
+
+// RecordIncomePayload is the payload type of the portfolio service
+// recordIncome method.
+type RecordIncomePayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Income type
+	Type string
+	// Paying instrument; required for dividends
+	Symbol *string
+	// Account credited
+	Account string
+	// Pay date
+	PayDate string
+	// Ex-dividend date
+	ExDate *string
+	// Gross amount
+	Amount float64
+	// Tax withheld at source
+	Withholding float64
+	// Dividend taxed at qualified rates
+	Qualified bool
+	// Reinvest the net dividend in the paying instrument
+	Reinvest bool
+	// Reinvestment price; defaults to the pay-date close
+	Price *float64
+	// Free-form note
+	Note *string
+}
+
+// RemoveAccountPayload is the payload type of the portfolio service
+// removeAccount method.
+type RemoveAccountPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Account identifier
+	ID string
+}
+
+// RiskMetrics is the result type of the portfolio service getRiskMetrics
+// method.
+type RiskMetrics struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Benchmark beta and correlation are measured against
+	Benchmark *BenchmarkDefinition
+	// First day of the period
+	Start string
+	// Last day of the period
+	End string
+	// Annual risk-free rate used for Sharpe and Sortino
+	RiskFreeRate float64
+	// Annualized volatility of daily returns
+	Volatility float64
+	// Annualized excess return over volatility
+	SharpeRatio float64
+	// Annualized excess return over downside deviation
+	SortinoRatio float64
+	// Beta against the benchmark
+	Beta *float64
+	// Correlation of daily returns with the benchmark
+	Correlation *float64
+	// Largest peak-to-trough decline as a positive fraction
+	MaxDrawdown float64
+	// Day of the peak before the largest decline
+	MaxDrawdownPeak *string
+	// Day of the trough of the largest decline
+	MaxDrawdownTrough *string
+	// Metrics per rolling window when a window is requested
+	Rolling []*RiskWindow
+}
+
+// Risk metrics over one rolling window.
+type RiskWindow struct {
+	// Base day of the window
+	Start string
+	// Last day of the window
+	End string
+	// Annualized volatility of daily returns
+	Volatility float64
+	// Annualized excess return over volatility
+	SharpeRatio float64
+	// Annualized excess return over downside deviation
+	SortinoRatio float64
+	// Beta against the benchmark
+	Beta *float64
+	// Correlation of daily returns with the benchmark
+	Correlation *float64
+	// Largest peak-to-trough decline as a positive fraction
+	MaxDrawdown float64
+}
+
+// RunBacktestPayload is the payload type of the portfolio service runBacktest
+// method.
+type RunBacktestPayload struct {
+	// Strategy name
+	Name string
+	// Strategy kind
+	Strategy string
+	// First day
+	Start string
+	// Last day
+	End string
+	// Currency of the simulated portfolio
+	Currency string
+	// Cash invested on the first day
+	InitialCash float64
+	// Target weights
+	Targets []*BacktestTarget
+	// Rebalancing or contribution frequency
+	Frequency string
+	// Drift from target weight that triggers a threshold rebalance
+	Threshold float64
+	// Amount invested every period by dca
+	Contribution float64
+	// Commission as a share of traded value
+	FeeRate float64
+}
+
+// RunStressTestPayload is the payload type of the portfolio service
+// runStressTest method.
+type RunStressTestPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Scenario name
+	Scenario string
+	// Number of worst contributors to return
+	Top int
+}
+
+// SetAccountPayload is the payload type of the portfolio service setAccount
+// method.
+type SetAccountPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Account identifier transactions refer to; empty for the default account
+	ID string
+	// Display name; defaults to the identifier
+	Name *string
+	// Account type
+	Type string
+	// How gains and income are taxed; defaults to the treatment of the type
+	TaxTreatment *string
+	// Broker or institution holding the account
+	Custodian *string
+	// Base currency; must be the portfolio currency, which it defaults to
+	Currency *string
+}
+
+// SetBenchmarkPayload is the payload type of the portfolio service
+// setBenchmark method.
+type SetBenchmarkPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Benchmark definition
+	Benchmark *BenchmarkDefinition
+}
+
+// SetMarginAccountPayload is the payload type of the portfolio service
+// setMarginAccount method.
+type SetMarginAccountPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Account
+	Account string
+	// Equity required to open positions, as a share of their value
+	InitialRequirement float64
+	// Equity required to keep long positions
+	MaintenanceRequirement float64
+	// Equity required to keep short positions
+	ShortMaintenanceRequirement float64
+	// Annual interest rate on the margin loan
+	LoanRate float64
+	// Annual fee for borrowing shares held short
+	BorrowRate float64
+	// Borrow fee rates by symbol overriding borrow_rate
+	BorrowRates map[string]float64
+}
+
+// SetTargetAllocationPayload is the payload type of the portfolio service
+// setTargetAllocation method.
+type SetTargetAllocationPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Target weights per symbol
+	Targets []*TargetWeight
+}
+
+// SettleOptionExpiriesPayload is the payload type of the portfolio service
+// settleOptionExpiries method.
+type SettleOptionExpiriesPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+}
+
+// Unrealized loss above the threshold that is not suggested for harvest.
+type SkippedHarvest struct {
+	// Account; empty for the default account
+	Account string
+	// Instrument symbol
+	Symbol string
+	// Net loss a sale would realize
+	Loss float64
+	// Why the loss is not harvested
+	Reason string
+}
+
+type StressImpact struct {
+	// Instrument symbol
+	Symbol string
+	// Asset class of the instrument
+	AssetClass string
+	// Current market value
+	Value float64
+	// Market value under the scenario
+	ProjectedValue float64
+	// Projected profit or loss
+	Pnl float64
+	// Total price change applied, including currency effects
+	Shock float64
+}
+
+// Named set of shocks. Shocks are decimal price changes, e.g. -0.4 for a 40%
+// fall.
+type StressScenario struct {
+	// Scenario name
+	Name string
+	// What the scenario represents
+	Description string
+	// Shock per asset class
+	AssetClasses map[string]float64
+	// Shock per symbol, overriding asset class shocks
+	Symbols map[string]float64
+	// Parallel change in yields, e.g. 0.02 for +200bp, applied through instrument
+	// duration
+	RateShift float64
+	// Move of each currency against all others
+	Currencies map[string]float64
+	// Start of a replayed historical window
+	ReplayStart *string
+	// End of a replayed historical window
+	ReplayEnd *string
+}
+
+// StressTestResult is the result type of the portfolio service runStressTest
+// method.
+type StressTestResult struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Valuation date
+	AsOf string
+	// Scenario applied
+	Scenario *StressScenario
+	// Currency of the values
+	Currency string
+	// Portfolio value including cash
+	CurrentValue float64
+	// Portfolio value under the scenario
+	ProjectedValue float64
+	// Current value less projected value
+	Loss float64
+	// Loss as a decimal fraction of current value
+	LossPercent float64
+	// Positions ordered from the largest loss
+	WorstContributors []*StressImpact
+	// Approximations made while applying the scenario
+	Warnings []string
+}
+
+// TargetAllocation is the result type of the portfolio service
+// getTargetAllocation method.
+type TargetAllocation struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Target weights per symbol
+	Targets []*TargetWeight
+	// Implied target cash weight
+	CashWeight float64
+}
+
+type TargetWeight struct {
+	// Instrument symbol
+	Symbol string
+	// Target weight as a decimal fraction
+	Weight float64
+	// Absolute drift allowed either side of the target weight
+	Tolerance float64
+}
+
+// Realized gains of one account.
+type TaxAccount struct {
+	// Account; empty for the default account
+	Account string
+	// Lots disposed, by disposal date
+	Lots []*TaxLotGain
+	// Short-term totals
+	ShortTerm *TaxTotals
+	// Long-term totals
+	LongTerm *TaxTotals
+	// Totals
+	Total *TaxTotals
+}
+
+// TaxLossHarvest is the result type of the portfolio service
+// findTaxLossHarvests method.
+type TaxLossHarvest struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Date of the prices
+	AsOf string
+	// Suggested harvests, largest saving first
+	Opportunities []*HarvestOpportunity
+	// Losses skipped to avoid wash sales
+	Skipped []*SkippedHarvest
+	// Losses realized by all suggested harvests
+	TotalLoss float64
+	// Tax saved by all suggested harvests
+	EstimatedSavings float64
+}
+
+// Open tax lot with the losses deferred into it by wash sales.
+type TaxLot struct {
+	// Ledger transaction that opened the lot
+	ID string
+	// Account; empty for the default account
+	Account string
+	// Instrument symbol
+	Symbol string
+	// Acquisition date; the short sale date for short lots
+	Acquired string
+	// Start of the holding period of the oldest shares, earlier than acquired for
+	// wash sale replacement shares
+	HoldingSince string
+	// Quantity held; negative for short lots
+	Quantity float64
+	// Cost basis, including basis_adjustment; minus the net proceeds for short lots
+	CostBasis float64
+	// Losses deferred into the lot by wash sales
+	BasisAdjustment float64
+	// Cost basis per unit
+	UnitCost float64
+	// Holding period of the oldest shares today
+	Term string
+	// Latest close, when known
+	Price *float64
+	// Quantity at the latest close, when known
+	MarketValue *float64
+	// Market value less cost basis, when known
+	UnrealizedGain *float64
+}
+
+// Realized gain or loss on part of a tax lot.
+type TaxLotGain struct {
+	// Account; empty for the default account
+	Account string
+	// Instrument symbol
+	Symbol string
+	// Ledger transaction that opened the lot
+	LotID string
+	// Ledger transaction that closed the lot
+	SaleID string
+	// Acquisition date; the short sale date for covered shorts
+	Acquired string
+	// Disposal date
+	Disposed string
+	// Quantity disposed
+	Quantity float64
+	// Net proceeds
+	Proceeds float64
+	// Cost basis relieved, including basis_adjustment
+	CostBasis float64
+	// Loss deferred into these shares by earlier wash sales
+	BasisAdjustment float64
+	// Loss disallowed by the wash-sale rule and added back
+	WashSaleAdjustment float64
+	// Reportable gain; negative for losses
+	Gain float64
+	// Holding period, counted from the sold shares' holding period for wash sale
+	// replacements
+	Term string
+	// Whether the disposal covered a short sale
+	ShortSale bool
+}
+
+// TaxReport is the result type of the portfolio service getTaxReport method.
+type TaxReport struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Tax year
+	Year int
+	// Currency of the amounts
+	Currency string
+	// Accounts with disposals in the year
+	Accounts []*TaxAccount
+	// Short-term totals
+	ShortTerm *TaxTotals
+	// Long-term totals
+	LongTerm *TaxTotals
+	// Totals
+	Total *TaxTotals
+	// Wash sales of the losses realized in the year
+	WashSales []*WashSale
+}
+
+// Totals of realized gains.
+type TaxTotals struct {
+	// Net proceeds
+	Proceeds float64
+	// Cost basis relieved
+	CostBasis float64
+	// Losses disallowed by the wash-sale rule
+	WashSaleAdjustment float64
+	// Reportable gain
+	Gain float64
+}
+
+// Hypothetical trade.
+type TradeInput struct {
+	// Account trading; empty for the default account
+	Account *string
+	// Instrument symbol
+	Symbol string
+	// Trade side
+	Side string
+	// Quantity
+	Quantity float64
+	// Trade price; defaults to the latest price
+	Price *float64
+	// Commission
+	Fee float64
+}
+
+// TradePreview is the result type of the portfolio service previewTrades
+// method.
+type TradePreview struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Valuation date
+	AsOf string
+	// Currency of the values
+	Currency string
+	// Summary before the trades
+	Before *PortfolioSummary
+	// Summary after the trades
+	After *PortfolioSummary
+	// Cash before the trades
+	CashBefore float64
+	// Cash after the trades
+	CashAfter float64
+	// Allocation dimension
+	Dimension string
+	// Allocation buckets before and after, by key
+	Allocation []*AllocationChange
+	// Lots the sales would relieve
+	Realized []*RealizedLot
+	// Net short-term realized gain
+	ShortTermGain float64
+	// Net long-term realized gain
+	LongTermGain float64
+	// Tax on net realized gains at the given rates; 0 for net losses
+	EstimatedTax float64
+	// Risk before the trades
+	RiskBefore *HoldingsRisk
+	// Risk after the trades
+	RiskAfter *HoldingsRisk
+	// Issues such as negative cash
+	Warnings []string
+}
+
+// Exposure to an underlying through direct holdings and option deltas.
+type UnderlyingExposure struct {
+	// Underlying symbol
+	Underlying string
+	// Latest underlying price
+	Price float64
+	// Units of the underlying held directly
+	Shares float64
+	// Delta of the options on the underlying, in underlying units
+	OptionDelta float64
+	// Shares plus option delta
+	DeltaAdjustedShares float64
+	// Delta-adjusted shares times price
+	DeltaAdjustedExposure float64
+}
+
+// UpdateReconciliationBreakPayload is the payload type of the portfolio
+// service updateReconciliationBreak method.
+type UpdateReconciliationBreakPayload struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Break ID
+	ID string
+	// New status
+	Status string
+	// Why the status is set
+	Note *string
+	// Post the suggested adjustments when resolving
+	Apply bool
+}
+
+type VaRContribution struct {
+	// Instrument symbol
+	Symbol string
+	// Current market value of the position
+	Value float64
+	// Change in VaR per unit of value added to the position
+	MarginalVar float64
+	// Share of portfolio VaR attributed to the position; components sum to the VaR
+	ComponentVar float64
+}
+
+// ValueAtRisk is the result type of the portfolio service getValueAtRisk
+// method.
+type ValueAtRisk struct {
+	// Portfolio identifier
+	PortfolioID string
+	// Valuation date
+	AsOf string
+	// Estimation method
+	Method string
+	// Confidence level
+	Confidence float64
+	// Holding period in trading days
+	Horizon int
+	// Trading days of price history used
+	Lookback int
+	// Market value of the risky positions; cash is treated as riskless
+	PortfolioValue float64
+	// Value-at-Risk
+	ValueAtRisk float64
+	// Conditional VaR: the average loss beyond the VaR
+	ExpectedShortfall float64
+	// Per-position VaR contributions
+	Contributions []*VaRContribution
+}
+
+// Loss disallowed because substantially identical shares were bought within 30
+// days before or after the sale, and deferred into the replacement shares
+// unless they are held in a tax-advantaged account.
+type WashSale struct {
+	// Ledger transaction of the loss sale
+	SaleID string
+	// Lot sold at a loss
+	LotID string
+	// Account of the sale; empty for the default account
+	Account string
+	// Instrument sold
+	Symbol string
+	// Sale date
+	Disposed string
+	// Shares sold matched with replacement shares
+	Quantity float64
+	// Loss disallowed
+	Disallowed float64
+	// Lot of the replacement shares
+	ReplacementLotID string
+	// Account of the replacement shares
+	ReplacementAccount string
+	// Instrument of the replacement shares
+	ReplacementSymbol string
+	// Purchase date of the replacement shares
+	ReplacementAcquired string
+	// The replacement shares are in a tax-advantaged account, so the loss is lost
+	// rather than deferred
+	Permanent bool
+}
+
+// Invalid request parameters
+type BadRequest string
+
 // Portfolio not found for user
 type NotFound string
 
 // Missing or invalid token
 type Unauthorized string
 
+// Error returns an error description.
+func (e BadRequest) Error() string {
+	return "Invalid request parameters"
+}
+
+// ErrorName returns "bad_request".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e BadRequest) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "bad_request".
+func (e BadRequest) GoaErrorName() string {
+	return "bad_request"
+}
+
 // Error returns an error description.
 func (e NotFound) Error() string {
 	return "Portfolio not found for user"
@@ -13,7 +13,12 @@ import (
 
 	portfolioSvr "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/http/portfolio/server"
 	portfolioGen "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/accounts"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/margin"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/orders"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/risk"
 	portfolioPkg "github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/service"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/statement"
 	goahttp "goa.design/goa/v3/http"
 	httpmdlwr "goa.design/goa/v3/http/middleware"
 	"goa.design/goa/v3/middleware"
@@ -32,6 +37,35 @@ type Config struct {
 	WriteTimeout      time.Duration
 	IdleTimeout       time.Duration
 	MaxHeaderBytes    int
+	// InstrumentsFile is an optional YAML or CSV instrument reference file.
+	InstrumentsFile string
+	// RiskFreeRate is the default annual risk-free rate for risk metrics.
+	RiskFreeRate float64
+	// CorporateActionsFile is an optional YAML or CSV file of corporate
+	// actions applied to every portfolio at startup.
+	CorporateActionsFile string
+	// StressScenarios are custom stress scenarios added to the built-in ones.
+	StressScenarios []risk.Scenario
+	// OptionVolatility is the default annual volatility for option valuation
+	// and OptionVolatilities overrides it per underlying.
+	OptionVolatility   float64
+	OptionVolatilities map[string]float64
+	// Accounts are the accounts registered in every portfolio.
+	Accounts []accounts.Account
+	// MarginAccounts are the accounts allowed to borrow cash and sell short.
+	MarginAccounts []margin.Terms
+	// PaperTrading holds the slippage and commission models of simulated
+	// order fills.
+	PaperTrading orders.Simulator
+	// ImportProfiles are the CSV statement mapping profiles added to the
+	// built-in generic one.
+	ImportProfiles []statement.Profile
+	// IdenticalSecurities are groups of symbols the wash-sale rule treats as
+	// substantially identical.
+	IdenticalSecurities [][]string
+	// HarvestReplacements are the candidate replacements of symbols sold to
+	// harvest a loss, in order of preference.
+	HarvestReplacements map[string][]string
 }
 
 // adapter implements middleware.Logger interface by writing to slog
@@ -57,6 +91,42 @@ func Run(cfg *Config) error {
 
 	// Initialize the service
 	portfolioSvc := portfolioPkg.NewPortfolioService(logger)
+	portfolioSvc.SetRiskFreeRate(cfg.RiskFreeRate)
+	portfolioSvc.SetOptionVolatility(cfg.OptionVolatility, cfg.OptionVolatilities)
+	if err := portfolioSvc.AddStressScenarios(cfg.StressScenarios...); err != nil {
+		return err
+	}
+	if cfg.InstrumentsFile != "" {
+		if err := portfolioSvc.LoadInstruments(cfg.InstrumentsFile); err != nil {
+			return err
+		}
+	}
+	if cfg.CorporateActionsFile != "" {
+		if err := portfolioSvc.LoadCorporateActions(cfg.CorporateActionsFile); err != nil {
+			return err
+		}
+	}
+	if err := portfolioSvc.SettleExpiredOptions(); err != nil {
+		return err
+	}
+	if err := portfolioSvc.AddAccounts(cfg.Accounts...); err != nil {
+		return err
+	}
+	if err := portfolioSvc.AddMarginAccounts(cfg.MarginAccounts...); err != nil {
+		return err
+	}
+	if err := portfolioSvc.SetPaperTrading(cfg.PaperTrading); err != nil {
+		return err
+	}
+	if err := portfolioSvc.AddImportProfiles(cfg.ImportProfiles...); err != nil {
+		return err
+	}
+	if err := portfolioSvc.SetIdenticalSecurities(cfg.IdenticalSecurities); err != nil {
+		return err
+	}
+	if err := portfolioSvc.SetHarvestReplacements(cfg.HarvestReplacements); err != nil {
+		return err
+	}
 
 	// Wrap the service with Goa endpoints
 	endpoints := portfolioGen.NewEndpoints(portfolioSvc)
@@ -0,0 +1,123 @@
+// Package accounts defines the accounts a portfolio's holdings and
+// transactions belong to, such as a brokerage account, an IRA or an HSA, and
+// how their gains are taxed.
+package accounts
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidAccount is returned for accounts that cannot be registered.
+var ErrInvalidAccount = errors.New("accounts: invalid account")
+
+// Type is the kind of account.
+type Type string
+
+// Account types.
+const (
+	Taxable  Type = "taxable"
+	IRA      Type = "ira"
+	RothIRA  Type = "roth_ira"
+	K401     Type = "401k"
+	Roth401K Type = "roth_401k"
+	HSA      Type = "hsa"
+	Other    Type = "other"
+)
+
+// Types lists the account types.
+var Types = []Type{Taxable, IRA, RothIRA, K401, Roth401K, HSA, Other}
+
+// TaxTreatment is how the gains and income of an account are taxed.
+type TaxTreatment string
+
+// Tax treatments.
+const (
+	// TaxableTreatment accounts are taxed on realized gains and income as
+	// they occur.
+	TaxableTreatment TaxTreatment = "taxable"
+	// TaxDeferred accounts are taxed on withdrawals only.
+	TaxDeferred TaxTreatment = "tax_deferred"
+	// TaxExempt accounts are not taxed on qualified withdrawals.
+	TaxExempt TaxTreatment = "tax_exempt"
+)
+
+// Treatment returns the usual tax treatment of accounts of type t.
+func (t Type) Treatment() TaxTreatment {
+	switch t {
+	case IRA, K401:
+		return TaxDeferred
+	case RothIRA, Roth401K, HSA:
+		return TaxExempt
+	default:
+		return TaxableTreatment
+	}
+}
+
+// Account is an account of a portfolio. The ID is the account name
+// transactions refer to; "" is the default account. Accounts are loaded from
+// the application config, hence the mapstructure tags.
+type Account struct {
+	ID           string       `mapstructure:"id"`
+	Name         string       `mapstructure:"name"`
+	Type         Type         `mapstructure:"type"`
+	TaxTreatment TaxTreatment `mapstructure:"tax_treatment"`
+	Custodian    string       `mapstructure:"custodian"`
+	// Currency is the base currency of the account.
+	Currency string `mapstructure:"currency"`
+}
+
+// Implicit returns the account of transactions referring to an account that
+// was never registered: a taxable account in currency.
+func Implicit(id, currency string) Account {
+	return Account{ID: id}.WithDefaults(currency)
+}
+
+// WithDefaults fills unset fields: a taxable type, the tax treatment of the
+// type, the ID as name and currency as base currency.
+func (a Account) WithDefaults(currency string) Account {
+	if a.Type == "" {
+		a.Type = Taxable
+	}
+	if a.TaxTreatment == "" {
+		a.TaxTreatment = a.Type.Treatment()
+	}
+	if a.Name == "" {
+		a.Name = a.ID
+	}
+	if a.Currency == "" {
+		a.Currency = currency
+	}
+	a.Currency = strings.ToUpper(a.Currency)
+	return a
+}
+
+// Validate checks the account.
+func (a Account) Validate() error {
+	if strings.TrimSpace(a.ID) != a.ID {
+		return fmt.Errorf("%w: id %q has surrounding spaces", ErrInvalidAccount, a.ID)
+	}
+	known := false
+	for _, t := range Types {
+		known = known || a.Type == t
+	}
+	if !known {
+		return fmt.Errorf("%w: %s: unknown type %q", ErrInvalidAccount, a.ID, a.Type)
+	}
+	switch a.TaxTreatment {
+	case TaxableTreatment, TaxDeferred, TaxExempt:
+	default:
+		return fmt.Errorf("%w: %s: unknown tax treatment %q", ErrInvalidAccount, a.ID, a.TaxTreatment)
+	}
+	if len(a.Currency) != 3 {
+		return fmt.Errorf("%w: %s: currency %q is not an ISO 4217 code", ErrInvalidAccount, a.ID, a.Currency)
+	}
+	return nil
+}
+
+// TaxAdvantaged reports whether gains in the account are not taxed as they
+// are realized.
+func (a Account) TaxAdvantaged() bool {
+	return a.TaxTreatment != TaxableTreatment
+}
@@ -0,0 +1,53 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDefaults(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Account
+		want Account
+	}{
+		{"implicit", Account{ID: "brokerage"}, Account{ID: "brokerage", Name: "brokerage", Type: Taxable, TaxTreatment: TaxableTreatment, Currency: "USD"}},
+		{"ira", Account{ID: "ira", Type: IRA}, Account{ID: "ira", Name: "ira", Type: IRA, TaxTreatment: TaxDeferred, Currency: "USD"}},
+		{"roth", Account{ID: "roth", Type: RothIRA}, Account{ID: "roth", Name: "roth", Type: RothIRA, TaxTreatment: TaxExempt, Currency: "USD"}},
+		{"hsa", Account{ID: "hsa", Name: "Health savings", Type: HSA, Currency: "cad"}, Account{ID: "hsa", Name: "Health savings", Type: HSA, TaxTreatment: TaxExempt, Currency: "CAD"}},
+		{"explicit treatment", Account{ID: "pension", Type: Other, TaxTreatment: TaxDeferred}, Account{ID: "pension", Name: "pension", Type: Other, TaxTreatment: TaxDeferred, Currency: "USD"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := tt.in.WithDefaults("USD")
+
+			// Assert
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, got.Validate())
+			assert.Equal(t, tt.want.TaxTreatment != TaxableTreatment, got.TaxAdvantaged())
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Account
+	}{
+		{"unknown type", Account{ID: "x", Type: "savings"}},
+		{"unknown treatment", Account{ID: "x", TaxTreatment: "exempt"}},
+		{"bad currency", Account{ID: "x", Currency: "dollars"}},
+		{"spaces", Account{ID: " x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.in.WithDefaults("USD").Validate()
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidAccount)
+		})
+	}
+}
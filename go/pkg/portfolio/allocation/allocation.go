@@ -0,0 +1,149 @@
+package allocation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reference"
+)
+
+// ErrInvalidDimension is returned for an unknown grouping dimension.
+var ErrInvalidDimension = errors.New("allocation: invalid dimension")
+
+// Dimension is the attribute holdings are grouped by.
+type Dimension string
+
+const (
+	DimensionAssetClass Dimension = "asset_class"
+	DimensionSector     Dimension = "sector"
+	DimensionCountry    Dimension = "country"
+	DimensionRegion     Dimension = "region"
+	DimensionCurrency   Dimension = "currency"
+	DimensionAccount    Dimension = "account"
+	DimensionTag        Dimension = "tag"
+)
+
+const (
+	// Unclassified collects holdings without reference data for the dimension.
+	Unclassified = "Unclassified"
+	// Cash is the bucket for cash balances on classification dimensions,
+	// and the asset class of cash.
+	Cash = "cash"
+	// DefaultAccount names the account of transactions booked without one.
+	DefaultAccount = "default"
+)
+
+// Bucket is the value and weight of one group of holdings.
+type Bucket struct {
+	Key     string
+	Value   float64
+	Weight  float64
+	Symbols []string
+}
+
+// Group buckets the valuation by dim. For DimensionTag, tag names the custom
+// tag key to group by.
+func Group(v *holdings.Valuation, ref *reference.Store, dim Dimension, tag string) ([]Bucket, error) {
+	if dim == DimensionTag && tag == "" {
+		return nil, fmt.Errorf("%w: tag dimension requires a tag key", ErrInvalidDimension)
+	}
+	switch dim {
+	case DimensionAssetClass, DimensionSector, DimensionCountry, DimensionRegion, DimensionCurrency, DimensionAccount, DimensionTag:
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidDimension, dim)
+	}
+
+	buckets := map[string]*Bucket{}
+	add := func(key, symbol string, value float64) {
+		if key == "" {
+			key = Unclassified
+		}
+		b, ok := buckets[key]
+		if !ok {
+			b = &Bucket{Key: key}
+			buckets[key] = b
+		}
+		b.Value += value
+		if symbol != "" {
+			b.Symbols = append(b.Symbols, symbol)
+		}
+	}
+
+	for _, h := range v.Holdings {
+		inst, _ := ref.Get(h.Symbol)
+		add(Key(dim, tag, inst, h.Account), h.Symbol, h.Value)
+	}
+	for account, cash := range v.Cash {
+		if cash == 0 {
+			continue
+		}
+		add(cashKey(dim, v.Currency, account), "", cash)
+	}
+
+	res := make([]Bucket, 0, len(buckets))
+	for _, b := range buckets {
+		if v.Total != 0 {
+			b.Weight = b.Value / v.Total
+		}
+		sort.Strings(b.Symbols)
+		b.Symbols = dedupe(b.Symbols)
+		res = append(res, *b)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Value != res[j].Value {
+			return res[i].Value > res[j].Value
+		}
+		return res[i].Key < res[j].Key
+	})
+	return res, nil
+}
+
+// Key returns the bucket an instrument held in account belongs to.
+func Key(dim Dimension, tag string, inst reference.Instrument, account string) string {
+	switch dim {
+	case DimensionAssetClass:
+		return inst.AssetClass
+	case DimensionSector:
+		return inst.Sector
+	case DimensionCountry:
+		return inst.Country
+	case DimensionRegion:
+		return inst.Region
+	case DimensionCurrency:
+		return inst.Currency
+	case DimensionAccount:
+		return accountName(account)
+	case DimensionTag:
+		return inst.Tags[tag]
+	}
+	return ""
+}
+
+func cashKey(dim Dimension, currency, account string) string {
+	switch dim {
+	case DimensionCurrency:
+		return currency
+	case DimensionAccount:
+		return accountName(account)
+	}
+	return Cash
+}
+
+func accountName(account string) string {
+	if account == "" {
+		return DefaultAccount
+	}
+	return account
+}
+
+func dedupe(sorted []string) []string {
+	out := sorted[:0]
+	for i, s := range sorted {
+		if i == 0 || s != sorted[i-1] {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,74 @@
+package allocation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reference"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const referenceCSV = `symbol,name,asset_class,sector,country,region,currency,tag:style
+AAPL,Apple,equity,Technology,US,North America,USD,growth
+SAP,SAP SE,equity,Technology,DE,Europe,EUR,value
+AGG,US Aggregate Bond,fixed_income,,US,North America,USD,
+`
+
+func testValuation(t *testing.T) (*holdings.Valuation, *reference.Store) {
+	instruments, err := reference.ParseCSV(strings.NewReader(referenceCSV))
+	require.NoError(t, err)
+	ref := reference.NewStore()
+	ref.Put(instruments...)
+
+	v := &holdings.Valuation{
+		Currency: "USD",
+		Holdings: []holdings.Holding{
+			{Account: "", Symbol: "AAPL", Value: 400},
+			{Account: "ira", Symbol: "AGG", Value: 300},
+			{Account: "ira", Symbol: "SAP", Value: 200},
+			{Account: "ira", Symbol: "XYZ", Value: 50},
+		},
+		Cash:  map[string]float64{"": 50},
+		Total: 1000,
+	}
+	return v, ref
+}
+
+func TestGroupByAssetClass(t *testing.T) {
+	v, ref := testValuation(t)
+
+	buckets, err := Group(v, ref, DimensionAssetClass, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []Bucket{
+		{Key: "equity", Value: 600, Weight: 0.6, Symbols: []string{"AAPL", "SAP"}},
+		{Key: "fixed_income", Value: 300, Weight: 0.3, Symbols: []string{"AGG"}},
+		{Key: "Unclassified", Value: 50, Weight: 0.05, Symbols: []string{"XYZ"}},
+		{Key: "cash", Value: 50, Weight: 0.05},
+	}, buckets)
+}
+
+func TestGroupByOtherDimensions(t *testing.T) {
+	v, ref := testValuation(t)
+
+	weights := func(dim Dimension, tag string) map[string]float64 {
+		buckets, err := Group(v, ref, dim, tag)
+		require.NoError(t, err)
+		res := map[string]float64{}
+		for _, b := range buckets {
+			res[b.Key] = b.Weight
+		}
+		return res
+	}
+
+	assert.Equal(t, map[string]float64{"USD": 0.75, "EUR": 0.2, "Unclassified": 0.05}, weights(DimensionCurrency, ""))
+	assert.Equal(t, map[string]float64{"default": 0.45, "ira": 0.55}, weights(DimensionAccount, ""))
+	assert.Equal(t, map[string]float64{"growth": 0.4, "value": 0.2, "Unclassified": 0.35, "cash": 0.05}, weights(DimensionTag, "style"))
+
+	_, err := Group(v, ref, DimensionTag, "")
+	assert.ErrorIs(t, err, ErrInvalidDimension)
+	_, err = Group(v, ref, "colour", "")
+	assert.ErrorIs(t, err, ErrInvalidDimension)
+}
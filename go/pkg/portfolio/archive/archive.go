@@ -0,0 +1,316 @@
+// Package archive defines the versioned JSON archive used to move a portfolio
+// between environments: its metadata, accounts, ledger, instruments, targets,
+// settings, paper orders and reconciliation breaks.
+//
+// An archive is an envelope naming the format, the schema version and a
+// checksum of the portfolio it carries. Archives of an older schema version
+// are migrated forward on read, one version at a time; archives of a newer
+// version than this build supports are refused.
+package archive
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Format identifies portfolio archives.
+const Format = "portfolio-archive"
+
+var (
+	// ErrInvalidArchive is returned when an archive cannot be read.
+	ErrInvalidArchive = errors.New("archive: invalid archive")
+	// ErrChecksum is returned when the portfolio does not match the checksum,
+	// e.g. after the file was edited or truncated.
+	ErrChecksum = errors.New("archive: checksum mismatch")
+	// ErrUnsupportedVersion is returned for archives written by a newer build.
+	ErrUnsupportedVersion = errors.New("archive: unsupported schema version")
+)
+
+// Migration upgrades the portfolio of an archive from one schema version to
+// the next, in its decoded JSON form.
+type Migration func(portfolio map[string]any) error
+
+// migrations[i] upgrades schema version i+1 to i+2. A schema change appends a
+// migration, which also raises SchemaVersion.
+var migrations = []Migration{
+	// Version 2 adds the accounts of the portfolio.
+	func(p map[string]any) error {
+		if _, ok := p["accounts"]; !ok {
+			p["accounts"] = []any{}
+		}
+		return nil
+	},
+	// Version 3 adds the paper orders and reconciliation breaks.
+	func(p map[string]any) error {
+		for _, key := range []string{"orders", "breaks"} {
+			if _, ok := p[key]; !ok {
+				p[key] = []any{}
+			}
+		}
+		return nil
+	},
+}
+
+// SchemaVersion returns the schema version written by this build.
+func SchemaVersion() int {
+	return len(migrations) + 1
+}
+
+// Archive is a portfolio archive.
+type Archive struct {
+	Format        string    `json:"format"`
+	SchemaVersion int       `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	// Checksum is the SHA-256 of the compact JSON encoding of Portfolio.
+	Checksum  string    `json:"checksum"`
+	Portfolio Portfolio `json:"portfolio"`
+}
+
+// Portfolio is the archived state of a portfolio.
+type Portfolio struct {
+	ID       string `json:"id"`
+	Currency string `json:"currency"`
+	// Paper portfolios accept simulated orders.
+	Paper        bool          `json:"paper"`
+	Accounts     []Account     `json:"accounts"`
+	Transactions []Transaction `json:"transactions"`
+	Instruments  []Instrument  `json:"instruments"`
+	Targets      []Target      `json:"targets"`
+	Settings     Settings      `json:"settings"`
+	Orders       []Order       `json:"orders"`
+	Breaks       []Break       `json:"breaks"`
+}
+
+// Account is a registered account.
+type Account struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	TaxTreatment string `json:"tax_treatment"`
+	Custodian    string `json:"custodian,omitempty"`
+	Currency     string `json:"currency"`
+}
+
+// Transaction is a ledger transaction.
+type Transaction struct {
+	ID            string    `json:"id"`
+	Date          time.Time `json:"date"`
+	Type          string    `json:"type"`
+	Account       string    `json:"account,omitempty"`
+	Symbol        string    `json:"symbol,omitempty"`
+	Quantity      float64   `json:"quantity,omitempty"`
+	Price         float64   `json:"price,omitempty"`
+	Amount        float64   `json:"amount,omitempty"`
+	Fee           float64   `json:"fee,omitempty"`
+	Note          string    `json:"note,omitempty"`
+	ExDate        time.Time `json:"ex_date,omitzero"`
+	Withholding   float64   `json:"withholding,omitempty"`
+	Qualified     bool      `json:"qualified,omitempty"`
+	NewSymbol     string    `json:"new_symbol,omitempty"`
+	Ratio         float64   `json:"ratio,omitempty"`
+	BasisFraction float64   `json:"basis_fraction,omitempty"`
+	CashInLieu    bool      `json:"cash_in_lieu,omitempty"`
+}
+
+// Instrument is the reference data of an instrument.
+type Instrument struct {
+	Symbol          string            `json:"symbol"`
+	Name            string            `json:"name,omitempty"`
+	AssetClass      string            `json:"asset_class,omitempty"`
+	Sector          string            `json:"sector,omitempty"`
+	Country         string            `json:"country,omitempty"`
+	Region          string            `json:"region,omitempty"`
+	Currency        string            `json:"currency,omitempty"`
+	LotSize         float64           `json:"lot_size,omitempty"`
+	Duration        float64           `json:"duration,omitempty"`
+	Tags            map[string]string `json:"tags,omitempty"`
+	Coupon          float64           `json:"coupon,omitempty"`
+	CouponFrequency int               `json:"coupon_frequency,omitempty"`
+	DayCount        string            `json:"day_count,omitempty"`
+	Maturity        string            `json:"maturity,omitempty"`
+	FaceValue       float64           `json:"face_value,omitempty"`
+	Underlying      string            `json:"underlying,omitempty"`
+	OptionType      string            `json:"option_type,omitempty"`
+	Strike          float64           `json:"strike,omitempty"`
+	Expiry          string            `json:"expiry,omitempty"`
+	Multiplier      float64           `json:"multiplier,omitempty"`
+	FuturesOption   bool              `json:"futures_option,omitempty"`
+}
+
+// Target is the target weight of a symbol.
+type Target struct {
+	Symbol    string  `json:"symbol"`
+	Weight    float64 `json:"weight"`
+	Tolerance float64 `json:"tolerance"`
+}
+
+// Settings are the portfolio settings.
+type Settings struct {
+	Benchmark      *Benchmark      `json:"benchmark,omitempty"`
+	MarginAccounts []MarginAccount `json:"margin_accounts"`
+}
+
+// Benchmark is a weighted blend of instruments.
+type Benchmark struct {
+	Name       string      `json:"name"`
+	Components []Component `json:"components"`
+	Rebalance  string      `json:"rebalance"`
+}
+
+// Component is one instrument of a benchmark blend.
+type Component struct {
+	Symbol string  `json:"symbol"`
+	Weight float64 `json:"weight"`
+}
+
+// MarginAccount holds the terms of a margin account.
+type MarginAccount struct {
+	Account                     string             `json:"account"`
+	InitialRequirement          float64            `json:"initial_requirement"`
+	MaintenanceRequirement      float64            `json:"maintenance_requirement"`
+	ShortMaintenanceRequirement float64            `json:"short_maintenance_requirement"`
+	LoanRate                    float64            `json:"loan_rate"`
+	BorrowRate                  float64            `json:"borrow_rate"`
+	BorrowRates                 map[string]float64 `json:"borrow_rates,omitempty"`
+	// ChargedThrough is the day through which interest and borrow fees have
+	// been charged, so that they are not charged again after a move.
+	ChargedThrough time.Time `json:"charged_through,omitzero"`
+}
+
+// Order is a paper order.
+type Order struct {
+	ID          string    `json:"id"`
+	Account     string    `json:"account,omitempty"`
+	Symbol      string    `json:"symbol"`
+	Side        string    `json:"side"`
+	Type        string    `json:"type"`
+	Quantity    float64   `json:"quantity"`
+	LimitPrice  float64   `json:"limit_price,omitempty"`
+	StopPrice   float64   `json:"stop_price,omitempty"`
+	TimeInForce string    `json:"time_in_force"`
+	Created     time.Time `json:"created"`
+	Status      string    `json:"status"`
+	Triggered   bool      `json:"triggered,omitempty"`
+	Updated     time.Time `json:"updated"`
+	FillPrice   float64   `json:"fill_price,omitempty"`
+	Commission  float64   `json:"commission,omitempty"`
+	Reason      string    `json:"reason,omitempty"`
+}
+
+// Break is a reconciliation break.
+type Break struct {
+	ID          string        `json:"id"`
+	Account     string        `json:"account,omitempty"`
+	AsOf        time.Time     `json:"as_of"`
+	Kind        string        `json:"kind"`
+	Symbol      string        `json:"symbol,omitempty"`
+	Statement   float64       `json:"statement"`
+	Ledger      float64       `json:"ledger"`
+	Price       float64       `json:"price,omitempty"`
+	Adjustments []Transaction `json:"adjustments"`
+	Status      string        `json:"status"`
+	Note        string        `json:"note,omitempty"`
+	Detected    time.Time     `json:"detected"`
+	Updated     time.Time     `json:"updated"`
+	// Posted holds the IDs of the adjustments posted to resolve the break.
+	Posted []string `json:"posted,omitempty"`
+}
+
+// envelope is an archive with the portfolio left encoded, as read before
+// migration.
+type envelope struct {
+	Format        string          `json:"format"`
+	SchemaVersion int             `json:"schema_version"`
+	CreatedAt     time.Time       `json:"created_at"`
+	Checksum      string          `json:"checksum"`
+	Portfolio     json.RawMessage `json:"portfolio"`
+}
+
+// checksum returns the checksum of an encoded portfolio.
+func checksum(portfolio []byte) (string, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, portfolio); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// Write writes p as an archive of the current schema version created at
+// createdAt.
+func Write(w io.Writer, p Portfolio, createdAt time.Time) error {
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	sum, err := checksum(raw)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(envelope{
+		Format:        Format,
+		SchemaVersion: SchemaVersion(),
+		CreatedAt:     createdAt.UTC(),
+		Checksum:      sum,
+		Portfolio:     raw,
+	})
+}
+
+// Read reads an archive, verifies its checksum and migrates it to the current
+// schema version. It returns the archive as migrated, with the checksum of the
+// original, and the schema version it was written in.
+func Read(r io.Reader) (*Archive, int, error) {
+	var env envelope
+	if err := json.NewDecoder(r).Decode(&env); err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	switch {
+	case env.Format != Format:
+		return nil, 0, fmt.Errorf("%w: format %q is not %q", ErrInvalidArchive, env.Format, Format)
+	case env.SchemaVersion < 1:
+		return nil, 0, fmt.Errorf("%w: missing schema version", ErrInvalidArchive)
+	case env.SchemaVersion > SchemaVersion():
+		return nil, 0, fmt.Errorf("%w: version %d is newer than %d; upgrade portfolio-server", ErrUnsupportedVersion, env.SchemaVersion, SchemaVersion())
+	case len(env.Portfolio) == 0:
+		return nil, 0, fmt.Errorf("%w: missing portfolio", ErrInvalidArchive)
+	}
+	sum, err := checksum(env.Portfolio)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	if sum != env.Checksum {
+		return nil, 0, fmt.Errorf("%w: archive has %q, portfolio hashes to %q", ErrChecksum, env.Checksum, sum)
+	}
+
+	raw := []byte(env.Portfolio)
+	if env.SchemaVersion < SchemaVersion() {
+		var doc map[string]any
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			return nil, 0, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+		}
+		for v := env.SchemaVersion; v < SchemaVersion(); v++ {
+			if err := migrations[v-1](doc); err != nil {
+				return nil, 0, fmt.Errorf("%w: migrating version %d to %d: %v", ErrInvalidArchive, v, v+1, err)
+			}
+		}
+		if raw, err = json.Marshal(doc); err != nil {
+			return nil, 0, err
+		}
+	}
+	a := &Archive{Format: env.Format, SchemaVersion: SchemaVersion(), CreatedAt: env.CreatedAt, Checksum: env.Checksum}
+	if err := json.Unmarshal(raw, &a.Portfolio); err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	if a.Portfolio.ID == "" || a.Portfolio.Currency == "" {
+		return nil, 0, fmt.Errorf("%w: missing portfolio id or currency", ErrInvalidArchive)
+	}
+	return a, env.SchemaVersion, nil
+}
@@ -0,0 +1,151 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+var sample = Portfolio{
+	ID:       "retirement",
+	Currency: "USD",
+	Accounts: []Account{{ID: "ira", Name: "Rollover IRA", Type: "ira", TaxTreatment: "tax_deferred", Custodian: "Vanguard", Currency: "USD"}},
+	Transactions: []Transaction{
+		{ID: "tx-000001", Date: date("2024-01-02"), Type: "deposit", Amount: 10000, Note: "funding"},
+		{ID: "tx-000002", Date: date("2024-01-03"), Type: "buy", Account: "ira", Symbol: "AAPL", Quantity: 10, Price: 150, Fee: 1},
+		{ID: "tx-000003", Date: date("2024-03-15"), Type: "dividend", Account: "ira", Symbol: "AAPL", Amount: 3, ExDate: date("2024-03-08"), Qualified: true},
+	},
+	Instruments: []Instrument{{Symbol: "AAPL", Name: "Apple Inc.", AssetClass: "equity", Tags: map[string]string{"style": "growth"}}},
+	Targets:     []Target{{Symbol: "AAPL", Weight: 0.6, Tolerance: 0.05}},
+	Settings: Settings{
+		Benchmark:      &Benchmark{Name: "SPY", Components: []Component{{Symbol: "SPY", Weight: 1}}, Rebalance: "none"},
+		MarginAccounts: []MarginAccount{{Account: "ira", InitialRequirement: 0.5, MaintenanceRequirement: 0.25, ShortMaintenanceRequirement: 0.3, LoanRate: 0.08, ChargedThrough: date("2024-02-29")}},
+	},
+	Orders: []Order{{ID: "ord-000001", Account: "ira", Symbol: "AAPL", Side: "buy", Type: "limit", Quantity: 5, LimitPrice: 140, TimeInForce: "gtc", Created: date("2024-03-20"), Status: "open", Updated: date("2024-03-20")}},
+	Breaks: []Break{{
+		ID: "brk-000001", Account: "ira", AsOf: date("2024-03-29"), Kind: "quantity_mismatch", Symbol: "AAPL", Statement: 11, Ledger: 10, Price: 170,
+		Adjustments: []Transaction{{Date: date("2024-03-29"), Type: "buy", Account: "ira", Symbol: "AAPL", Quantity: 1, Price: 170, Note: "reconciliation adjustment"}},
+		Status:      "open", Detected: date("2024-03-29"), Updated: date("2024-03-29"),
+	}},
+}
+
+func TestWriteRead(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, sample, date("2024-04-01")))
+	out := buf.String()
+
+	// Act
+	a, from, err := Read(strings.NewReader(out))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, SchemaVersion(), from)
+	assert.Equal(t, SchemaVersion(), a.SchemaVersion)
+	assert.Equal(t, date("2024-04-01"), a.CreatedAt)
+	assert.Equal(t, sample, a.Portfolio)
+	assert.Contains(t, out, `"format": "portfolio-archive"`)
+	assert.Contains(t, out, `"checksum": "sha256:`)
+	assert.NotContains(t, out, `"ex_date": "0001-01-01`, "zero dates are left out")
+
+	// Reformatting does not change the checksum.
+	var compact bytes.Buffer
+	require.NoError(t, json.Compact(&compact, buf.Bytes()))
+	_, _, err = Read(&compact)
+	assert.NoError(t, err)
+}
+
+func TestReadRejects(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, sample, date("2024-04-01")))
+	valid := buf.String()
+
+	tests := []struct {
+		name string
+		in   string
+		want error
+	}{
+		{"edited", strings.Replace(valid, `"amount": 10000`, `"amount": 20000`, 1), ErrChecksum},
+		{"newer version", strings.Replace(valid, fmt.Sprintf(`"schema_version": %d`, SchemaVersion()), `"schema_version": 99`, 1), ErrUnsupportedVersion},
+		{"other format", strings.Replace(valid, Format, "something-else", 1), ErrInvalidArchive},
+		{"not json", "ledger.csv", ErrInvalidArchive},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, _, err := Read(strings.NewReader(tt.in))
+
+			// Assert
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestReadMigratesOlderVersions(t *testing.T) {
+	// Arrange: a schema version 2 that renamed "ledger" to "transactions".
+	defer func(m []Migration) { migrations = m }(migrations)
+	migrations = []Migration{func(p map[string]any) error {
+		p["transactions"] = p["ledger"]
+		delete(p, "ledger")
+		return nil
+	}}
+	portfolio := `{"id":"old","currency":"USD","ledger":[{"id":"tx-000001","date":"2024-01-02T00:00:00Z","type":"deposit","amount":100}]}`
+	sum, err := checksum([]byte(portfolio))
+	require.NoError(t, err)
+	in := fmt.Sprintf(`{"format":%q,"schema_version":1,"created_at":"2024-01-03T00:00:00Z","checksum":%q,"portfolio":%s}`, Format, sum, portfolio)
+
+	// Act
+	a, from, err := Read(strings.NewReader(in))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, from)
+	assert.Equal(t, 2, a.SchemaVersion)
+	assert.Equal(t, []Transaction{{ID: "tx-000001", Date: date("2024-01-02"), Type: "deposit", Amount: 100}}, a.Portfolio.Transactions)
+}
+
+func TestReadMigratesVersion1Accounts(t *testing.T) {
+	// Arrange: version 1 archives have no accounts.
+	portfolio := `{"id":"old","currency":"USD","paper":false,"transactions":[],"instruments":[],"targets":[],"settings":{"margin_accounts":[]}}`
+	sum, err := checksum([]byte(portfolio))
+	require.NoError(t, err)
+	in := fmt.Sprintf(`{"format":%q,"schema_version":1,"created_at":"2024-01-03T00:00:00Z","checksum":%q,"portfolio":%s}`, Format, sum, portfolio)
+
+	// Act
+	a, from, err := Read(strings.NewReader(in))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, from)
+	assert.Equal(t, []Account{}, a.Portfolio.Accounts)
+}
+
+func TestReadMigratesVersion2OrdersAndBreaks(t *testing.T) {
+	// Arrange: version 2 archives have no paper orders or breaks.
+	portfolio := `{"id":"old","currency":"USD","paper":true,"accounts":[],"transactions":[],"instruments":[],"targets":[],"settings":{"margin_accounts":[]}}`
+	sum, err := checksum([]byte(portfolio))
+	require.NoError(t, err)
+	in := fmt.Sprintf(`{"format":%q,"schema_version":2,"created_at":"2024-01-03T00:00:00Z","checksum":%q,"portfolio":%s}`, Format, sum, portfolio)
+
+	// Act
+	a, from, err := Read(strings.NewReader(in))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, from)
+	assert.Equal(t, 3, SchemaVersion())
+	assert.Equal(t, []Order{}, a.Portfolio.Orders)
+	assert.Equal(t, []Break{}, a.Portfolio.Breaks)
+}
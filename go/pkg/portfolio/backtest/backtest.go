@@ -0,0 +1,336 @@
+package backtest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/rebalance"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/risk"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInvalidStrategy is returned for strategies that cannot be run.
+var ErrInvalidStrategy = errors.New("backtest: invalid strategy")
+
+// Kind selects how a strategy trades.
+type Kind string
+
+const (
+	// BuyAndHold invests at target weights on the first day and lets them
+	// drift.
+	BuyAndHold Kind = "buy_and_hold"
+	// PeriodicRebalance trades back to target weights every period.
+	PeriodicRebalance Kind = "periodic_rebalance"
+	// ThresholdRebalance trades positions back to target once they drift
+	// further than the threshold.
+	ThresholdRebalance Kind = "threshold_rebalance"
+	// DCA contributes a fixed amount every period and invests it at target
+	// weights without selling.
+	DCA Kind = "dca"
+)
+
+// Frequency is how often periodic strategies act.
+type Frequency string
+
+const (
+	Monthly   Frequency = "monthly"
+	Quarterly Frequency = "quarterly"
+	Annual    Frequency = "annual"
+)
+
+// due reports whether day starts a new period after prev.
+func (f Frequency) due(prev, day time.Time) bool {
+	switch f {
+	case Monthly:
+		return prev.Month() != day.Month() || prev.Year() != day.Year()
+	case Quarterly:
+		return (prev.Month()-1)/3 != (day.Month()-1)/3 || prev.Year() != day.Year()
+	case Annual:
+		return prev.Year() != day.Year()
+	}
+	return false
+}
+
+// Target is the weight of a symbol in the strategy.
+type Target struct {
+	Symbol string  `yaml:"symbol"`
+	Weight float64 `yaml:"weight"`
+}
+
+// Strategy describes a backtest, typically loaded from a YAML file. Dates are
+// YYYY-MM-DD.
+type Strategy struct {
+	Name        string    `yaml:"name"`
+	Kind        Kind      `yaml:"strategy"`
+	Start       string    `yaml:"start"`
+	End         string    `yaml:"end"`
+	Currency    string    `yaml:"currency"`
+	InitialCash float64   `yaml:"initial_cash"`
+	Targets     []Target  `yaml:"targets"`
+	Frequency   Frequency `yaml:"frequency"`
+	// Threshold is the absolute drift from target weight that triggers a
+	// threshold rebalance.
+	Threshold float64 `yaml:"threshold"`
+	// Contribution is deposited every period by DCA strategies.
+	Contribution float64 `yaml:"contribution"`
+	// FeeRate is the commission charged on traded value.
+	FeeRate float64 `yaml:"fee_rate"`
+}
+
+// LoadFile reads a strategy from a YAML file.
+func LoadFile(path string) (Strategy, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return Strategy{}, err
+	}
+	var s Strategy
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return Strategy{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// WithDefaults fills an unset currency (USD) and frequency (monthly).
+func (s Strategy) WithDefaults() Strategy {
+	if s.Currency == "" {
+		s.Currency = "USD"
+	}
+	if s.Frequency == "" {
+		s.Frequency = Monthly
+	}
+	return s
+}
+
+// Validate checks the strategy and returns its date range.
+func (s Strategy) Validate() (time.Time, time.Time, error) {
+	start, err := time.Parse(time.DateOnly, s.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: start: %v", ErrInvalidStrategy, err)
+	}
+	end, err := time.Parse(time.DateOnly, s.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: end: %v", ErrInvalidStrategy, err)
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: end must be after start", ErrInvalidStrategy)
+	}
+	switch s.Kind {
+	case BuyAndHold, PeriodicRebalance, ThresholdRebalance, DCA:
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: unknown strategy %q", ErrInvalidStrategy, s.Kind)
+	}
+	switch s.Frequency {
+	case Monthly, Quarterly, Annual:
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: unknown frequency %q", ErrInvalidStrategy, s.Frequency)
+	}
+	if len(s.Targets) == 0 {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: no targets", ErrInvalidStrategy)
+	}
+	if err := rebalance.ValidateTargets(s.targets(0)); err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %v", ErrInvalidStrategy, err)
+	}
+	if s.InitialCash < 0 || s.Contribution < 0 || s.FeeRate < 0 || s.Threshold < 0 {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: amounts and rates must not be negative", ErrInvalidStrategy)
+	}
+	if s.InitialCash == 0 && (s.Kind != DCA || s.Contribution == 0) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: nothing to invest", ErrInvalidStrategy)
+	}
+	if s.Kind == ThresholdRebalance && s.Threshold == 0 {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: threshold rebalancing needs a threshold", ErrInvalidStrategy)
+	}
+	return start, end, nil
+}
+
+// targets returns the strategy targets with a tolerance band. Weights are
+// scaled down by the fee rate so that cash is left to pay the fees.
+func (s Strategy) targets(tolerance float64) []rebalance.Target {
+	res := make([]rebalance.Target, len(s.Targets))
+	for i, t := range s.Targets {
+		res[i] = rebalance.Target{Symbol: t.Symbol, Weight: t.Weight / (1 + s.FeeRate), Tolerance: tolerance}
+	}
+	return res
+}
+
+// Result is the outcome of a backtest.
+type Result struct {
+	Strategy Strategy
+	// Points is the daily equity curve with external flows.
+	Points  []performance.Point
+	Returns *performance.Returns
+	// Metrics holds volatility, Sharpe ratio and drawdown; nil when the
+	// period is too short.
+	Metrics *risk.Metrics
+	Trades  int
+	// TradedValue is the value bought plus sold.
+	TradedValue float64
+	Fees        float64
+	// Turnover is the lesser of the value bought and the value sold divided
+	// by the average portfolio value, so investing cash deposited is not
+	// counted.
+	Turnover float64
+	// Ledger holds the simulated transactions.
+	Ledger *ledger.Ledger
+}
+
+// Options tune a backtest.
+type Options struct {
+	// LotSize returns the trading increment of a symbol; whole units by
+	// default.
+	LotSize func(symbol string) float64
+	// RiskFreeRate is used for the Sharpe ratio.
+	RiskFreeRate float64
+}
+
+// Run replays the trading days of the strategy through a fresh ledger at
+// closing prices.
+func Run(s Strategy, prices marketdata.Provider, opts Options) (*Result, error) {
+	s = s.WithDefaults()
+	start, end, err := s.Validate()
+	if err != nil {
+		return nil, err
+	}
+	days := marketdata.TradingDays(start, end)
+	if len(days) < 2 {
+		return nil, fmt.Errorf("%w: fewer than two trading days", ErrInvalidStrategy)
+	}
+	lotSize := opts.LotSize
+	if lotSize == nil {
+		lotSize = func(string) float64 { return 1 }
+	}
+
+	res := &Result{Strategy: s, Ledger: ledger.New(s.Currency)}
+	l := res.Ledger
+	bought, sold := 0.0, 0.0
+	trade := func(tx ledger.Transaction) error {
+		tx.Fee = s.FeeRate * tx.Quantity * tx.Price
+		tx.Note = "backtest " + string(s.Kind)
+		if _, err := l.Post(tx); err != nil {
+			return err
+		}
+		res.Trades++
+		res.TradedValue += tx.Quantity * tx.Price
+		if tx.Type == ledger.TxSell {
+			sold += tx.Quantity * tx.Price
+		} else {
+			bought += tx.Quantity * tx.Price
+		}
+		res.Fees += tx.Fee
+		return nil
+	}
+
+	if s.InitialCash > 0 {
+		if _, err := l.Post(ledger.Transaction{Date: days[0], Type: ledger.TxDeposit, Amount: s.InitialCash, Note: "initial cash"}); err != nil {
+			return nil, err
+		}
+	}
+	for i, day := range days {
+		first := i == 0
+		periodStart := !first && s.Frequency.due(days[i-1], day)
+		var rebalanceTo []rebalance.Target
+		switch s.Kind {
+		case BuyAndHold:
+			if first {
+				rebalanceTo = s.targets(0)
+			}
+		case PeriodicRebalance:
+			if first || periodStart {
+				rebalanceTo = s.targets(0)
+			}
+		case ThresholdRebalance:
+			if first {
+				rebalanceTo = s.targets(0)
+			} else {
+				rebalanceTo = s.targets(s.Threshold)
+			}
+		case DCA:
+			if periodStart || (first && s.InitialCash == 0) {
+				if _, err := l.Post(ledger.Transaction{Date: day, Type: ledger.TxDeposit, Amount: s.Contribution, Note: "contribution"}); err != nil {
+					return nil, err
+				}
+			}
+			if first || periodStart {
+				if err := invest(l, s, prices, day, lotSize, trade); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if rebalanceTo == nil {
+			continue
+		}
+		v, err := holdings.Value(l, prices, day)
+		if err != nil {
+			return nil, err
+		}
+		prop, err := rebalance.Propose(v, nil, rebalanceTo, rebalance.Options{
+			AsOf:    day,
+			Prices:  func(symbol string) (float64, error) { return prices.Close(symbol, day) },
+			LotSize: lotSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Sells come first so their proceeds fund the buys.
+		sort.SliceStable(prop.Trades, func(i, j int) bool {
+			return prop.Trades[i].Side == rebalance.SideSell && prop.Trades[j].Side != rebalance.SideSell
+		})
+		for _, t := range prop.Trades {
+			tx := ledger.Transaction{Date: day, Type: ledger.TxBuy, Symbol: t.Symbol, Quantity: t.Quantity, Price: t.Price}
+			if t.Side == rebalance.SideSell {
+				tx.Type = ledger.TxSell
+			}
+			if err := trade(tx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if res.Points, err = performance.Series(l, prices, days[0], days[len(days)-1]); err != nil {
+		return nil, err
+	}
+	if res.Returns, err = performance.Compute(l, prices, performance.PeriodCustom, days[0], days[len(days)-1]); err != nil {
+		return nil, err
+	}
+	if m, err := risk.Compute(risk.FromPoints(res.Points, nil), opts.RiskFreeRate); err == nil {
+		res.Metrics = m
+	} else if !errors.Is(err, risk.ErrInsufficientData) {
+		return nil, err
+	}
+	total := 0.0
+	for _, p := range res.Points[1:] {
+		total += p.Value
+	}
+	if avg := total / float64(len(res.Points)-1); avg > 0 {
+		res.Turnover = math.Min(bought, sold) / avg
+	}
+	return res, nil
+}
+
+// invest spends the cash on hand at target weights without selling.
+func invest(l *ledger.Ledger, s Strategy, prices marketdata.Provider, day time.Time, lotSize func(string) float64, trade func(ledger.Transaction) error) error {
+	cash := l.Snapshot(day).Cash
+	for _, t := range s.Targets {
+		px, err := prices.Close(t.Symbol, day)
+		if err != nil {
+			return err
+		}
+		lot := lotSize(t.Symbol)
+		qty := math.Floor(cash*t.Weight/(px*(1+s.FeeRate))/lot) * lot
+		if qty <= 0 {
+			continue
+		}
+		if err := trade(ledger.Transaction{Date: day, Type: ledger.TxBuy, Symbol: t.Symbol, Quantity: qty, Price: px}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package backtest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+// testPrices grows UP by 0.5% and holds FLAT at 100 every trading day of
+// the first half of 2025.
+func testPrices() *marketdata.Store {
+	store := marketdata.NewStore()
+	px := 100.0
+	for _, day := range marketdata.TradingDays(date("2024-12-01"), date("2025-06-30")) {
+		store.Put("UP", marketdata.Bar{Date: day, Close: px})
+		store.Put("FLAT", marketdata.Bar{Date: day, Close: 100})
+		px *= 1.005
+	}
+	return store
+}
+
+func testStrategy(kind Kind) Strategy {
+	return Strategy{
+		Kind:        kind,
+		Start:       "2025-01-01",
+		End:         "2025-06-30",
+		InitialCash: 10000,
+		Targets:     []Target{{Symbol: "UP", Weight: 0.5}, {Symbol: "FLAT", Weight: 0.5}},
+	}
+}
+
+func TestRunBuyAndHold(t *testing.T) {
+	// Arrange
+	prices := testPrices()
+
+	// Act
+	res, err := Run(testStrategy(BuyAndHold), prices, Options{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Trades)
+	snap := res.Ledger.Snapshot(date("2025-06-30"))
+	up, _ := prices.Close("UP", date("2025-06-30"))
+	assert.InDelta(t, snap.Cash+snap.Quantities["UP"]*up+snap.Quantities["FLAT"]*100, res.Returns.EndValue, 1e-6)
+	assert.InDelta(t, 10000, res.Returns.NetContributions, 1e-9)
+	assert.Greater(t, res.Returns.TimeWeighted, 0.0)
+	require.NotNil(t, res.Metrics)
+	assert.InDelta(t, 0, res.Metrics.MaxDrawdown, 1e-9, "prices never fall")
+	assert.InDelta(t, 0, res.Turnover, 1e-12, "the initial investment is not turnover")
+}
+
+func TestRunPeriodicRebalance(t *testing.T) {
+	// Arrange
+	prices := testPrices()
+	s := testStrategy(PeriodicRebalance)
+	s.FeeRate = 0.001
+
+	// Act
+	res, err := Run(s, prices, Options{LotSize: func(string) float64 { return 0.0001 }})
+
+	// Assert: an initial purchase and five monthly rebalances of two trades.
+	require.NoError(t, err)
+	assert.Equal(t, 12, res.Trades)
+	assert.Greater(t, res.Fees, 0.0)
+	assert.GreaterOrEqual(t, res.Ledger.Snapshot(date("2025-06-30")).Cash, 0.0, "fees are funded")
+	bought, sold := 0.0, 0.0
+	for _, tx := range res.Ledger.Transactions() {
+		switch tx.Type {
+		case ledger.TxBuy:
+			bought += tx.Quantity * tx.Price
+		case ledger.TxSell:
+			sold += tx.Quantity * tx.Price
+		}
+	}
+	assert.InDelta(t, sold/average(res), res.Turnover, 1e-12, "rebalances sell less than was first bought")
+	assert.Greater(t, bought, sold)
+	hold, err := Run(testStrategy(BuyAndHold), prices, Options{})
+	require.NoError(t, err)
+	assert.Greater(t, res.Turnover, hold.Turnover)
+}
+
+func TestRunThresholdRebalance(t *testing.T) {
+	s := testStrategy(ThresholdRebalance)
+	s.Threshold = 0.05
+
+	res, err := Run(s, testPrices(), Options{})
+
+	require.NoError(t, err)
+	sells := 0
+	for _, tx := range res.Ledger.Transactions() {
+		if tx.Type == ledger.TxSell {
+			sells++
+			assert.Equal(t, "UP", tx.Symbol, "only the drifting position is trimmed")
+		}
+	}
+	assert.Positive(t, sells)
+	assert.Less(t, sells, 6)
+}
+
+func TestRunDCA(t *testing.T) {
+	s := testStrategy(DCA)
+	s.InitialCash, s.Contribution = 0, 1000
+
+	res, err := Run(s, testPrices(), Options{})
+
+	require.NoError(t, err)
+	assert.InDelta(t, 6000, res.Returns.NetContributions, 1e-9, "one contribution a month")
+	assert.InDelta(t, 0, res.Turnover, 1e-12, "investing contributions is not turnover")
+	for _, tx := range res.Ledger.Transactions() {
+		assert.NotEqual(t, ledger.TxSell, tx.Type)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for name, mutate := range map[string]func(*Strategy){
+		"unknown kind":   func(s *Strategy) { s.Kind = "momentum" },
+		"bad dates":      func(s *Strategy) { s.End = "2024-01-01" },
+		"overweight":     func(s *Strategy) { s.Targets[0].Weight = 0.9 },
+		"no threshold":   func(s *Strategy) { s.Kind = ThresholdRebalance },
+		"nothing to buy": func(s *Strategy) { s.InitialCash = 0 },
+	} {
+		s := testStrategy(PeriodicRebalance).WithDefaults()
+		mutate(&s)
+		_, _, err := s.Validate()
+		assert.ErrorIs(t, err, ErrInvalidStrategy, name)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "strategy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+name: 60/40
+strategy: threshold_rebalance
+start: 2025-01-01
+end: 2025-06-30
+initial_cash: 10000
+threshold: 0.05
+targets:
+  - symbol: UP
+    weight: 0.6
+  - symbol: FLAT
+    weight: 0.4
+`), 0o600))
+
+	s, err := LoadFile(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, ThresholdRebalance, s.Kind)
+	assert.Equal(t, "2025-01-01", s.Start)
+	assert.Len(t, s.Targets, 2)
+}
+
+func average(res *Result) float64 {
+	total := 0.0
+	for _, p := range res.Points[1:] {
+		total += p.Value
+	}
+	return total / float64(len(res.Points)-1)
+}
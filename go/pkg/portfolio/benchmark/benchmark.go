@@ -0,0 +1,212 @@
+package benchmark
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+)
+
+// ErrInvalidDefinition is returned when a benchmark definition is malformed.
+var ErrInvalidDefinition = errors.New("benchmark: invalid definition")
+
+// Rebalance is how often a blended benchmark is reset to its target weights.
+type Rebalance string
+
+const (
+	// RebalanceNone holds the initial weights and lets them drift.
+	RebalanceNone      Rebalance = "none"
+	RebalanceDaily     Rebalance = "daily"
+	RebalanceMonthly   Rebalance = "monthly"
+	RebalanceQuarterly Rebalance = "quarterly"
+	RebalanceAnnual    Rebalance = "annual"
+)
+
+// tradingDaysPerYear annualizes daily statistics.
+const tradingDaysPerYear = 252
+
+// Component is one instrument of a benchmark blend.
+type Component struct {
+	Symbol string
+	Weight float64
+}
+
+// Definition describes a benchmark as a weighted blend of instruments.
+type Definition struct {
+	Name       string
+	Components []Component
+	Rebalance  Rebalance
+}
+
+// Parse reads a blend such as "60% SPY / 40% AGG" into components, with
+// symbols in upper case.
+func Parse(blend string) ([]Component, error) {
+	var components []Component
+	for _, part := range strings.Split(blend, "/") {
+		fields := strings.Fields(part)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%w: cannot parse %q", ErrInvalidDefinition, strings.TrimSpace(part))
+		}
+		pct, err := strconv.ParseFloat(strings.TrimSuffix(fields[0], "%"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bad weight %q", ErrInvalidDefinition, fields[0])
+		}
+		components = append(components, Component{Symbol: strings.ToUpper(strings.TrimSpace(fields[1])), Weight: pct / 100})
+	}
+	return components, nil
+}
+
+// String formats the blend in the form accepted by Parse.
+func (d Definition) String() string {
+	parts := make([]string, len(d.Components))
+	for i, c := range d.Components {
+		parts[i] = strconv.FormatFloat(c.Weight*100, 'f', -1, 64) + "% " + c.Symbol
+	}
+	return strings.Join(parts, " / ")
+}
+
+// Validate checks that weights are positive and sum to one.
+func (d Definition) Validate() error {
+	if len(d.Components) == 0 {
+		return fmt.Errorf("%w: no components", ErrInvalidDefinition)
+	}
+	total := 0.0
+	for _, c := range d.Components {
+		if c.Symbol == "" || c.Weight <= 0 {
+			return fmt.Errorf("%w: component %q needs a symbol and a positive weight", ErrInvalidDefinition, c.Symbol)
+		}
+		total += c.Weight
+	}
+	if math.Abs(total-1) > 1e-6 {
+		return fmt.Errorf("%w: weights sum to %g, not 1", ErrInvalidDefinition, total)
+	}
+	switch d.Rebalance {
+	case RebalanceNone, RebalanceDaily, RebalanceMonthly, RebalanceQuarterly, RebalanceAnnual:
+	default:
+		return fmt.Errorf("%w: unknown rebalance frequency %q", ErrInvalidDefinition, d.Rebalance)
+	}
+	return nil
+}
+
+// Levels prices the blend at the close of each day, starting from a level of
+// 1 on the first day.
+func (d Definition) Levels(prices marketdata.Provider, days []time.Time) ([]float64, error) {
+	units := make([]float64, len(d.Components))
+	levels := make([]float64, len(days))
+	closes := make([]float64, len(d.Components))
+	for i, day := range days {
+		for j, c := range d.Components {
+			px, err := prices.Close(c.Symbol, day)
+			if err != nil {
+				return nil, err
+			}
+			closes[j] = px
+		}
+
+		level := 1.0
+		if i > 0 {
+			level = 0
+			for j := range units {
+				level += units[j] * closes[j]
+			}
+		}
+		if i == 0 || d.rebalanceDue(days[i-1], day) {
+			for j, c := range d.Components {
+				units[j] = c.Weight * level / closes[j]
+			}
+		}
+		levels[i] = level
+	}
+	return levels, nil
+}
+
+func (d Definition) rebalanceDue(prev, day time.Time) bool {
+	switch d.Rebalance {
+	case RebalanceDaily:
+		return true
+	case RebalanceMonthly:
+		return prev.Month() != day.Month() || prev.Year() != day.Year()
+	case RebalanceQuarterly:
+		return (prev.Month()-1)/3 != (day.Month()-1)/3 || prev.Year() != day.Year()
+	case RebalanceAnnual:
+		return prev.Year() != day.Year()
+	}
+	return false
+}
+
+// ComparisonPoint is the cumulative return of both series at a date.
+type ComparisonPoint struct {
+	Date      time.Time
+	Portfolio float64
+	Benchmark float64
+}
+
+// Comparison is portfolio performance measured against a benchmark.
+type Comparison struct {
+	PortfolioReturn float64
+	BenchmarkReturn float64
+	// ExcessReturn is the portfolio return less the benchmark return.
+	ExcessReturn float64
+	// TrackingError is the annualized standard deviation of daily excess returns.
+	TrackingError float64
+	Points        []ComparisonPoint
+}
+
+// Compare measures a portfolio value series against the benchmark over the
+// same days. Days before the portfolio was funded are excluded from both sides.
+func Compare(d Definition, prices marketdata.Provider, points []performance.Point) (*Comparison, error) {
+	days := make([]time.Time, len(points))
+	for i, p := range points {
+		days[i] = p.Date
+	}
+	levels, err := d.Levels(prices, days)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio := performance.DailyReturns(points)
+	var excess []float64
+	cmp := &Comparison{}
+	pCum, bCum := 1.0, 1.0
+	if len(points) > 0 {
+		cmp.Points = append(cmp.Points, ComparisonPoint{Date: days[0]})
+	}
+	for i, rp := range portfolio {
+		rb := 0.0
+		if points[i].Value > 0 {
+			rb = levels[i+1]/levels[i] - 1
+			excess = append(excess, rp-rb)
+		}
+		pCum *= 1 + rp
+		bCum *= 1 + rb
+		cmp.Points = append(cmp.Points, ComparisonPoint{Date: days[i+1], Portfolio: pCum - 1, Benchmark: bCum - 1})
+	}
+	cmp.PortfolioReturn = pCum - 1
+	cmp.BenchmarkReturn = bCum - 1
+	cmp.ExcessReturn = cmp.PortfolioReturn - cmp.BenchmarkReturn
+	cmp.TrackingError = stddev(excess) * math.Sqrt(tradingDaysPerYear)
+	return cmp, nil
+}
+
+// stddev returns the sample standard deviation, or zero for fewer than two
+// observations.
+func stddev(xs []float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	mean := 0.0
+	for _, x := range xs {
+		mean += x
+	}
+	mean /= float64(len(xs))
+	ss := 0.0
+	for _, x := range xs {
+		ss += (x - mean) * (x - mean)
+	}
+	return math.Sqrt(ss / float64(len(xs)-1))
+}
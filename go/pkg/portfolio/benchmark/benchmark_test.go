@@ -0,0 +1,84 @@
+package benchmark
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func testPrices() (*marketdata.Store, []time.Time) {
+	days := []time.Time{date("2026-01-30"), date("2026-02-02"), date("2026-02-03")}
+	prices := marketdata.NewStore()
+	prices.Put("SPY",
+		marketdata.Bar{Date: days[0], Close: 100},
+		marketdata.Bar{Date: days[1], Close: 200},
+		marketdata.Bar{Date: days[2], Close: 100},
+	)
+	prices.Put("AGG",
+		marketdata.Bar{Date: days[0], Close: 100},
+		marketdata.Bar{Date: days[1], Close: 100},
+		marketdata.Bar{Date: days[2], Close: 100},
+	)
+	return prices, days
+}
+
+func TestParse(t *testing.T) {
+	components, err := Parse("60% SPY / 40% AGG")
+	require.NoError(t, err)
+	assert.Equal(t, []Component{{"SPY", 0.6}, {"AGG", 0.4}}, components)
+
+	d := Definition{Components: components, Rebalance: RebalanceMonthly}
+	assert.NoError(t, d.Validate())
+	assert.Equal(t, "60% SPY / 40% AGG", d.String())
+
+	components, err = Parse("60% spy / 40% Agg")
+	require.NoError(t, err)
+	assert.Equal(t, []Component{{"SPY", 0.6}, {"AGG", 0.4}}, components)
+
+	_, err = Parse("SPY")
+	assert.ErrorIs(t, err, ErrInvalidDefinition)
+	assert.ErrorIs(t, Definition{Components: []Component{{"SPY", 0.5}}, Rebalance: RebalanceNone}.Validate(), ErrInvalidDefinition)
+}
+
+func TestLevelsRebalance(t *testing.T) {
+	prices, days := testPrices()
+	components := []Component{{"SPY", 0.5}, {"AGG", 0.5}}
+
+	// Static weights drift: SPY doubles then halves, ending where it started.
+	static, err := Definition{Components: components, Rebalance: RebalanceNone}.Levels(prices, days)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1, 1.5, 1}, static, 1e-9)
+
+	// A monthly rebalance on the first February close resets to 50/50.
+	monthly, err := Definition{Components: components, Rebalance: RebalanceMonthly}.Levels(prices, days)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1, 1.5, 1.125}, monthly, 1e-9)
+}
+
+func TestCompare(t *testing.T) {
+	prices, days := testPrices()
+	d := Definition{Components: []Component{{"SPY", 1}}, Rebalance: RebalanceNone}
+	points := []performance.Point{
+		{Date: days[0], Value: 1000},
+		{Date: days[1], Value: 2000},
+		{Date: days[2], Value: 1100},
+	}
+
+	cmp, err := Compare(d, prices, points)
+
+	require.NoError(t, err)
+	assert.InDelta(t, 0.1, cmp.PortfolioReturn, 1e-9)
+	assert.InDelta(t, 0, cmp.BenchmarkReturn, 1e-9)
+	assert.InDelta(t, 0.1, cmp.ExcessReturn, 1e-9)
+	assert.Greater(t, cmp.TrackingError, 0.0)
+	assert.Len(t, cmp.Points, 3)
+}
@@ -0,0 +1,180 @@
+package corpactions
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnsupportedFormat is returned for files that are neither YAML nor CSV.
+var ErrUnsupportedFormat = errors.New("corpactions: unsupported file format")
+
+// Action is a corporate action as written in import files and API requests.
+type Action struct {
+	// Date is the effective date, YYYY-MM-DD.
+	Date   string `yaml:"date"`
+	Type   string `yaml:"type"`
+	Symbol string `yaml:"symbol"`
+	// NewSymbol is the renamed, spun-off or acquiring symbol.
+	NewSymbol string `yaml:"new_symbol"`
+	// Ratio is the number of resulting shares per share held, e.g. 1.5 for a
+	// 3-for-2 split or 0.1 for a 1-for-10 reverse split.
+	Ratio        float64 `yaml:"ratio"`
+	CashPerShare float64 `yaml:"cash_per_share"`
+	// BasisFraction is the share of cost basis moved to spun-off shares.
+	BasisFraction float64 `yaml:"basis_fraction"`
+	// Price of the resulting shares on the effective date.
+	Price      float64 `yaml:"price"`
+	CashInLieu bool    `yaml:"cash_in_lieu"`
+	Note       string  `yaml:"note"`
+}
+
+// Transaction converts the action to a ledger transaction.
+func (a Action) Transaction() (ledger.Transaction, error) {
+	date, err := time.Parse(time.DateOnly, a.Date)
+	if err != nil {
+		return ledger.Transaction{}, fmt.Errorf("%s %s: bad date %q", a.Type, a.Symbol, a.Date)
+	}
+	tx := ledger.Transaction{
+		Date:          date,
+		Type:          ledger.TxType(a.Type),
+		Symbol:        a.Symbol,
+		NewSymbol:     a.NewSymbol,
+		Ratio:         a.Ratio,
+		Amount:        a.CashPerShare,
+		BasisFraction: a.BasisFraction,
+		Price:         a.Price,
+		CashInLieu:    a.CashInLieu,
+		Note:          a.Note,
+	}
+	if !tx.IsCorporateAction() {
+		return ledger.Transaction{}, fmt.Errorf("%w: %q is not a corporate action", ledger.ErrInvalidTransaction, a.Type)
+	}
+	return tx, nil
+}
+
+// FromTransaction converts a corporate action transaction back to an Action.
+func FromTransaction(tx ledger.Transaction) Action {
+	return Action{
+		Date:          tx.Date.Format(time.DateOnly),
+		Type:          string(tx.Type),
+		Symbol:        tx.Symbol,
+		NewSymbol:     tx.NewSymbol,
+		Ratio:         tx.Ratio,
+		CashPerShare:  tx.Amount,
+		BasisFraction: tx.BasisFraction,
+		Price:         tx.Price,
+		CashInLieu:    tx.CashInLieu,
+		Note:          tx.Note,
+	}
+}
+
+// LoadFile reads the actions of a local YAML (.yaml, .yml) or CSV (.csv) file.
+func LoadFile(path string) ([]Action, error) {
+	var parse func(io.Reader) ([]Action, error)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		parse = ParseYAML
+	case ".csv":
+		parse = ParseCSV
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, path)
+	}
+
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	actions, err := parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("corpactions: %s: %w", path, err)
+	}
+	return actions, nil
+}
+
+// ParseYAML reads a document with a top-level "actions" list.
+func ParseYAML(r io.Reader) ([]Action, error) {
+	var doc struct {
+		Actions []Action `yaml:"actions"`
+	}
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc.Actions, nil
+}
+
+// ParseCSV reads a CSV file with a header row using the YAML field names as
+// columns. Only date, type and symbol are required.
+func ParseCSV(r io.Reader) ([]Action, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	col := map[string]int{}
+	for i, h := range rows[0] {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, name := range []string{"date", "type", "symbol"} {
+		if _, ok := col[name]; !ok {
+			return nil, fmt.Errorf("missing %s column", name)
+		}
+	}
+
+	var actions []Action
+	for n, row := range rows[1:] {
+		field := func(name string) string {
+			if i, ok := col[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		number := func(name string) (float64, error) {
+			v := field(name)
+			if v == "" {
+				return 0, nil
+			}
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return 0, fmt.Errorf("row %d: bad %s %q", n+2, name, v)
+			}
+			return f, nil
+		}
+		a := Action{
+			Date:      field("date"),
+			Type:      field("type"),
+			Symbol:    field("symbol"),
+			NewSymbol: field("new_symbol"),
+			Note:      field("note"),
+		}
+		for name, dst := range map[string]*float64{
+			"ratio":          &a.Ratio,
+			"cash_per_share": &a.CashPerShare,
+			"basis_fraction": &a.BasisFraction,
+			"price":          &a.Price,
+		} {
+			if *dst, err = number(name); err != nil {
+				return nil, err
+			}
+		}
+		if v := field("cash_in_lieu"); v != "" {
+			if a.CashInLieu, err = strconv.ParseBool(v); err != nil {
+				return nil, fmt.Errorf("row %d: bad cash_in_lieu %q", n+2, v)
+			}
+		}
+		actions = append(actions, a)
+	}
+	return actions, nil
+}
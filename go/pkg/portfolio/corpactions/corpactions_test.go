@@ -0,0 +1,63 @@
+package corpactions
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseYAML(t *testing.T) {
+	// Arrange
+	doc := `
+actions:
+  - date: "2024-06-10"
+    type: split
+    symbol: NVDA
+    ratio: 10
+  - date: "2024-04-02"
+    type: spin_off
+    symbol: GE
+    new_symbol: GEV
+    ratio: 0.25
+    basis_fraction: 0.18
+    price: 140
+    cash_in_lieu: true
+`
+
+	// Act
+	actions, err := ParseYAML(strings.NewReader(doc))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	tx, err := actions[1].Transaction()
+	require.NoError(t, err)
+	assert.Equal(t, ledger.TxSpinOff, tx.Type)
+	assert.Equal(t, time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC), tx.Date)
+	assert.True(t, tx.CashInLieu)
+	assert.Equal(t, actions[1], FromTransaction(tx))
+}
+
+func TestParseCSV(t *testing.T) {
+	csv := "date,type,symbol,new_symbol,ratio,cash_per_share,price\n" +
+		"2024-01-02,merger,OLD,NEW,0.5,12.5,40\n" +
+		"2024-02-01,symbol_change,FB,META,,,\n"
+
+	actions, err := ParseCSV(strings.NewReader(csv))
+
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	assert.InDelta(t, 12.5, actions[0].CashPerShare, 1e-12)
+	assert.Equal(t, "META", actions[1].NewSymbol)
+
+	_, err = ParseCSV(strings.NewReader("date,type\n"))
+	assert.Error(t, err)
+	_, err = Action{Date: "2024-01-02", Type: "buy", Symbol: "X"}.Transaction()
+	assert.ErrorIs(t, err, ledger.ErrInvalidTransaction)
+	_, err = LoadFile("actions.json")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
@@ -0,0 +1,299 @@
+package fixedincome
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var (
+	// ErrInvalidBond is returned for bonds with missing or inconsistent terms.
+	ErrInvalidBond = errors.New("fixedincome: invalid bond")
+	// ErrMatured is returned when pricing a bond on or after its maturity.
+	ErrMatured = errors.New("fixedincome: bond has matured")
+	// ErrNoYield is returned when no yield reproduces a price.
+	ErrNoYield = errors.New("fixedincome: yield does not converge")
+)
+
+// DayCount is the convention for counting accrual days.
+type DayCount string
+
+const (
+	// Thirty360 is the US (bond basis) 30/360 convention.
+	Thirty360 DayCount = "30/360"
+	Actual360 DayCount = "ACT/360"
+	Actual365 DayCount = "ACT/365"
+	// ActualActual is the ICMA convention: actual days over actual days in
+	// the coupon period.
+	ActualActual DayCount = "ACT/ACT"
+)
+
+// Bond holds the terms of a fixed-rate bond. Amounts are per bond.
+type Bond struct {
+	// Coupon is the annual coupon rate as a decimal fraction.
+	Coupon float64
+	// Frequency is the number of coupons a year; zero for zero-coupon bonds.
+	Frequency int
+	DayCount  DayCount
+	Maturity  time.Time
+	Face      float64
+}
+
+// Validate checks the bond terms.
+func (b Bond) Validate() error {
+	switch b.Frequency {
+	case 0, 1, 2, 4, 12:
+	default:
+		return fmt.Errorf("%w: coupon frequency must be 0, 1, 2, 4 or 12", ErrInvalidBond)
+	}
+	switch b.DayCount {
+	case Thirty360, Actual360, Actual365, ActualActual:
+	default:
+		return fmt.Errorf("%w: unknown day count %q", ErrInvalidBond, b.DayCount)
+	}
+	if b.Face <= 0 {
+		return fmt.Errorf("%w: face value must be positive", ErrInvalidBond)
+	}
+	if b.Coupon < 0 || (b.Frequency == 0 && b.Coupon != 0) {
+		return fmt.Errorf("%w: coupon must not be negative and zero-coupon bonds pay none", ErrInvalidBond)
+	}
+	if b.Maturity.IsZero() {
+		return fmt.Errorf("%w: missing maturity", ErrInvalidBond)
+	}
+	return nil
+}
+
+// CouponAmount is the cash paid per bond on each coupon date.
+func (b Bond) CouponAmount() float64 {
+	if b.Frequency == 0 {
+		return 0
+	}
+	return b.Face * b.Coupon / float64(b.Frequency)
+}
+
+// couponDate returns the date k coupon periods before maturity. Dates falling
+// past the end of a month are moved to its last day.
+func (b Bond) couponDate(k int) time.Time {
+	months := k * 12 / b.Frequency
+	first := time.Date(b.Maturity.Year(), b.Maturity.Month()-time.Month(months), 1, 0, 0, 0, 0, b.Maturity.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(b.Maturity.Day(), last)-1)
+}
+
+// period returns the coupon dates before (or on) and after settle, and the
+// number of coupons left.
+func (b Bond) period(settle time.Time) (prev, next time.Time, remaining int) {
+	k := 1
+	for b.couponDate(k).After(settle) {
+		k++
+	}
+	return b.couponDate(k), b.couponDate(k - 1), k
+}
+
+// yearFraction measures the time from start to end in the bond's day count.
+// ACT/ACT needs the enclosing coupon period.
+func (b Bond) yearFraction(start, end, periodStart, periodEnd time.Time) float64 {
+	days := end.Sub(start).Hours() / 24
+	switch b.DayCount {
+	case Thirty360:
+		return days360(start, end) / 360
+	case Actual360:
+		return days / 360
+	case Actual365:
+		return days / 365
+	}
+	periodDays := periodEnd.Sub(periodStart).Hours() / 24
+	return days / periodDays / float64(b.Frequency)
+}
+
+// days360 counts days under the US 30/360 convention.
+func days360(start, end time.Time) float64 {
+	d1, d2 := start.Day(), end.Day()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+	return float64(360*(end.Year()-start.Year()) + 30*(int(end.Month())-int(start.Month())) + d2 - d1)
+}
+
+// AccruedInterest is the coupon earned since the last coupon date.
+func (b Bond) AccruedInterest(settle time.Time) float64 {
+	if b.Frequency == 0 || !settle.Before(b.Maturity) {
+		return 0
+	}
+	prev, next, _ := b.period(settle)
+	return b.Face * b.Coupon * b.yearFraction(prev, settle, prev, next)
+}
+
+// CashFlow is a coupon and/or principal payment per bond.
+type CashFlow struct {
+	Date      time.Time
+	Coupon    float64
+	Principal float64
+}
+
+// CashFlows returns the payments after from up to and including to.
+func (b Bond) CashFlows(from, to time.Time) []CashFlow {
+	var res []CashFlow
+	if b.Frequency == 0 {
+		if b.Maturity.After(from) && !b.Maturity.After(to) {
+			res = append(res, CashFlow{Date: b.Maturity, Principal: b.Face})
+		}
+		return res
+	}
+	for k := 0; ; k++ {
+		d := b.couponDate(k)
+		if !d.After(from) {
+			break
+		}
+		if !d.After(to) {
+			cf := CashFlow{Date: d, Coupon: b.CouponAmount()}
+			if k == 0 {
+				cf.Principal = b.Face
+			}
+			res = append([]CashFlow{cf}, res...)
+		}
+	}
+	return res
+}
+
+// flows returns the remaining payments with their time to settlement in
+// coupon periods (years for zero-coupon bonds).
+func (b Bond) flows(settle time.Time) ([]float64, []float64, error) {
+	if !settle.Before(b.Maturity) {
+		return nil, nil, ErrMatured
+	}
+	if b.Frequency == 0 {
+		return []float64{b.Face}, []float64{b.Maturity.Sub(settle).Hours() / 24 / 365}, nil
+	}
+	prev, next, n := b.period(settle)
+	// w is the fraction of the current period left until the next coupon.
+	w := 1 - b.yearFraction(prev, settle, prev, next)*float64(b.Frequency)
+	amounts, times := make([]float64, n), make([]float64, n)
+	for i := range n {
+		amounts[i] = b.CouponAmount()
+		times[i] = float64(i) + w
+	}
+	amounts[n-1] += b.Face
+	return amounts, times, nil
+}
+
+// periodsPerYear is the compounding frequency of the yield.
+func (b Bond) periodsPerYear() float64 {
+	return float64(max(b.Frequency, 1))
+}
+
+// DirtyPrice discounts the remaining payments at an annual yield compounded
+// at the coupon frequency (annually for zero-coupon bonds).
+func (b Bond) DirtyPrice(settle time.Time, yield float64) (float64, error) {
+	amounts, times, err := b.flows(settle)
+	if err != nil {
+		return 0, err
+	}
+	f := b.periodsPerYear()
+	price := 0.0
+	for i, cf := range amounts {
+		price += cf / math.Pow(1+yield/f, times[i])
+	}
+	return price, nil
+}
+
+// CleanPrice is the dirty price less accrued interest.
+func (b Bond) CleanPrice(settle time.Time, yield float64) (float64, error) {
+	dirty, err := b.DirtyPrice(settle, yield)
+	if err != nil {
+		return 0, err
+	}
+	return dirty - b.AccruedInterest(settle), nil
+}
+
+// YieldToMaturity solves for the yield that discounts the remaining payments
+// to a clean price.
+func (b Bond) YieldToMaturity(settle time.Time, clean float64) (float64, error) {
+	if clean <= 0 {
+		return 0, fmt.Errorf("%w: price must be positive", ErrInvalidBond)
+	}
+	dirty := clean + b.AccruedInterest(settle)
+	// Bisection: price falls as yield rises.
+	lo, hi := -0.99*b.periodsPerYear(), 10.0
+	for range 200 {
+		mid := (lo + hi) / 2
+		p, err := b.DirtyPrice(settle, mid)
+		if err != nil {
+			return 0, err
+		}
+		if math.Abs(p-dirty) < 1e-10*b.Face {
+			return mid, nil
+		}
+		if p > dirty {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return 0, ErrNoYield
+}
+
+// Duration returns the Macaulay and modified durations in years.
+func (b Bond) Duration(settle time.Time, yield float64) (macaulay, modified float64, err error) {
+	amounts, times, err := b.flows(settle)
+	if err != nil {
+		return 0, 0, err
+	}
+	f := b.periodsPerYear()
+	price, weighted := 0.0, 0.0
+	for i, cf := range amounts {
+		pv := cf / math.Pow(1+yield/f, times[i])
+		price += pv
+		weighted += pv * times[i] / f
+	}
+	macaulay = weighted / price
+	return macaulay, macaulay / (1 + yield/f), nil
+}
+
+// Analytics are the price measures of a bond on a settlement date. Prices
+// and accrued interest are per bond.
+type Analytics struct {
+	Clean    float64
+	Dirty    float64
+	Accrued  float64
+	Yield    float64
+	Macaulay float64
+	Modified float64
+}
+
+// FromPrice computes analytics from a clean price.
+func (b Bond) FromPrice(settle time.Time, clean float64) (Analytics, error) {
+	if err := b.Validate(); err != nil {
+		return Analytics{}, err
+	}
+	y, err := b.YieldToMaturity(settle, clean)
+	if err != nil {
+		return Analytics{}, err
+	}
+	return b.analytics(settle, y)
+}
+
+// FromYield computes analytics from a yield to maturity.
+func (b Bond) FromYield(settle time.Time, yield float64) (Analytics, error) {
+	return b.analytics(settle, yield)
+}
+
+func (b Bond) analytics(settle time.Time, yield float64) (Analytics, error) {
+	if err := b.Validate(); err != nil {
+		return Analytics{}, err
+	}
+	dirty, err := b.DirtyPrice(settle, yield)
+	if err != nil {
+		return Analytics{}, err
+	}
+	mac, mod, err := b.Duration(settle, yield)
+	if err != nil {
+		return Analytics{}, err
+	}
+	accrued := b.AccruedInterest(settle)
+	return Analytics{Clean: dirty - accrued, Dirty: dirty, Accrued: accrued, Yield: yield, Macaulay: mac, Modified: mod}, nil
+}
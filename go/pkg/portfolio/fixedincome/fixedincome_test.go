@@ -0,0 +1,79 @@
+package fixedincome
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func testBond() Bond {
+	return Bond{Coupon: 0.05, Frequency: 2, DayCount: Thirty360, Maturity: date("2030-11-15"), Face: 100}
+}
+
+func TestAccruedInterest(t *testing.T) {
+	b := testBond()
+
+	assert.InDelta(t, 0, b.AccruedInterest(date("2025-11-15")), 1e-12)
+	assert.InDelta(t, 1.25, b.AccruedInterest(date("2026-02-15")), 1e-12)
+
+	b.DayCount = ActualActual
+	assert.InDelta(t, 100*0.05/2*92/181, b.AccruedInterest(date("2026-02-15")), 1e-12)
+}
+
+func TestPriceYieldAndDuration(t *testing.T) {
+	// Arrange
+	b := testBond()
+
+	// Act
+	par, err := b.FromYield(date("2025-11-15"), 0.05)
+	require.NoError(t, err)
+	mid, err := b.FromYield(date("2026-02-15"), 0.06)
+	require.NoError(t, err)
+	ytm, err := b.YieldToMaturity(date("2026-02-15"), mid.Clean)
+	require.NoError(t, err)
+
+	// Assert
+	assert.InDelta(t, 100, par.Clean, 1e-9)
+	assert.InDelta(t, 4.485432764622604, par.Macaulay, 1e-9)
+	assert.InDelta(t, 4.376031965485468, par.Modified, 1e-9)
+	assert.Less(t, mid.Clean, 100.0)
+	assert.InDelta(t, mid.Clean+1.25, mid.Dirty, 1e-9)
+	assert.InDelta(t, 0.06, ytm, 1e-8)
+
+	_, err = b.FromYield(date("2030-11-15"), 0.05)
+	assert.ErrorIs(t, err, ErrMatured)
+	_, err = Bond{Frequency: 3, DayCount: Thirty360, Maturity: date("2030-11-15"), Face: 100}.FromYield(date("2025-11-15"), 0.05)
+	assert.ErrorIs(t, err, ErrInvalidBond)
+}
+
+func TestZeroCoupon(t *testing.T) {
+	b := Bond{DayCount: Actual365, Maturity: date("2027-11-15"), Face: 100}
+
+	a, err := b.FromYield(date("2025-11-15"), 0.04)
+
+	require.NoError(t, err)
+	assert.InDelta(t, 100/(1.04*1.04), a.Clean, 1e-9)
+	assert.InDelta(t, 0, a.Accrued, 1e-12)
+	assert.InDelta(t, 2, a.Macaulay, 1e-9)
+}
+
+func TestCashFlows(t *testing.T) {
+	b := Bond{Coupon: 0.04, Frequency: 2, DayCount: Thirty360, Maturity: date("2027-08-31"), Face: 1000}
+
+	flows := b.CashFlows(date("2026-01-01"), date("2030-01-01"))
+
+	assert.Equal(t, []CashFlow{
+		{Date: date("2026-02-28"), Coupon: 20},
+		{Date: date("2026-08-31"), Coupon: 20},
+		{Date: date("2027-02-28"), Coupon: 20},
+		{Date: date("2027-08-31"), Coupon: 20, Principal: 1000},
+	}, flows)
+	assert.Len(t, b.CashFlows(date("2026-01-01"), date("2026-12-31")), 2)
+}
@@ -0,0 +1,73 @@
+package holdings
+
+import (
+	"sort"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+)
+
+// Holding is a priced position in one account.
+type Holding struct {
+	Account  string
+	Symbol   string
+	Quantity float64
+	Price    float64
+	Value    float64
+}
+
+// Valuation is the priced state of a ledger at a point in time.
+type Valuation struct {
+	AsOf     time.Time
+	Currency string
+	Holdings []Holding
+	// Cash is the cash balance per account.
+	Cash  map[string]float64
+	Total float64
+}
+
+// Value prices every position of the ledger at asOf. Holdings are sorted by
+// account and symbol.
+func Value(l *ledger.Ledger, prices marketdata.Provider, asOf time.Time) (*Valuation, error) {
+	snap := l.Snapshot(asOf)
+	v := &Valuation{AsOf: asOf, Currency: l.Currency(), Cash: map[string]float64{}}
+	for account, acct := range snap.Accounts {
+		v.Cash[account] = acct.Cash
+		v.Total += acct.Cash
+		for sym, qty := range acct.Quantities {
+			px, err := prices.Close(sym, asOf)
+			if err != nil {
+				return nil, err
+			}
+			h := Holding{Account: account, Symbol: sym, Quantity: qty, Price: px, Value: qty * px}
+			v.Holdings = append(v.Holdings, h)
+			v.Total += h.Value
+		}
+	}
+	sort.Slice(v.Holdings, func(i, j int) bool {
+		if v.Holdings[i].Account != v.Holdings[j].Account {
+			return v.Holdings[i].Account < v.Holdings[j].Account
+		}
+		return v.Holdings[i].Symbol < v.Holdings[j].Symbol
+	})
+	return v, nil
+}
+
+// TotalCash returns the cash balance across all accounts.
+func (v *Valuation) TotalCash() float64 {
+	total := 0.0
+	for _, c := range v.Cash {
+		total += c
+	}
+	return total
+}
+
+// BySymbol aggregates holding values across accounts.
+func (v *Valuation) BySymbol() map[string]float64 {
+	values := map[string]float64{}
+	for _, h := range v.Holdings {
+		values[h.Symbol] += h.Value
+	}
+	return values
+}
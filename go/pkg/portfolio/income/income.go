@@ -0,0 +1,175 @@
+package income
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+)
+
+// ErrInvalidInterval is returned for unknown period intervals.
+var ErrInvalidInterval = errors.New("income: invalid interval")
+
+// Tax classes of income.
+const (
+	ClassQualified = "qualified"
+	ClassOrdinary  = "ordinary"
+)
+
+// Interval is the length of the periods income is summarized by.
+type Interval string
+
+const (
+	IntervalMonth   Interval = "month"
+	IntervalQuarter Interval = "quarter"
+	IntervalYear    Interval = "year"
+)
+
+// Key returns the label of the period containing t, e.g. 2026-03, 2026-Q1
+// or 2026.
+func (i Interval) Key(t time.Time) (string, error) {
+	switch i {
+	case IntervalMonth:
+		return t.Format("2006-01"), nil
+	case IntervalQuarter:
+		return fmt.Sprintf("%d-Q%d", t.Year(), (int(t.Month())+2)/3), nil
+	case IntervalYear:
+		return fmt.Sprintf("%d", t.Year()), nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidInterval, i)
+}
+
+// Reinvestment returns the purchase that reinvests the net amount of a
+// dividend at price on its pay date.
+func Reinvestment(div ledger.Transaction, price float64) ledger.Transaction {
+	return ledger.Transaction{
+		Date:     div.Date,
+		Type:     ledger.TxBuy,
+		Account:  div.Account,
+		Symbol:   div.Symbol,
+		Quantity: div.CashEffect() / price,
+		Price:    price,
+		Note:     "dividend reinvestment",
+	}
+}
+
+// Entry is one income payment.
+type Entry struct {
+	ID       string
+	PayDate  time.Time
+	ExDate   time.Time
+	Type     ledger.TxType
+	Account  string
+	Symbol   string
+	Currency string
+	Class    string
+	Gross    float64
+	// Withholding is tax withheld at source.
+	Withholding float64
+	Net         float64
+}
+
+// Entries returns the dividends and interest paid between from and to
+// inclusive. currency returns the denomination of a symbol; interest without
+// a symbol is in the ledger currency.
+func Entries(l *ledger.Ledger, from, to time.Time, currency func(symbol string) string) []Entry {
+	var res []Entry
+	for _, tx := range l.Transactions() {
+		if tx.Date.Before(from) || tx.Date.After(to) {
+			continue
+		}
+		if tx.Type != ledger.TxDividend && tx.Type != ledger.TxInterest {
+			continue
+		}
+		e := Entry{
+			ID:          tx.ID,
+			PayDate:     tx.Date,
+			ExDate:      tx.ExDate,
+			Type:        tx.Type,
+			Account:     tx.Account,
+			Symbol:      tx.Symbol,
+			Currency:    l.Currency(),
+			Class:       ClassOrdinary,
+			Gross:       tx.Amount,
+			Withholding: tx.Withholding,
+			Net:         tx.CashEffect(),
+		}
+		if tx.Symbol != "" {
+			if c := currency(tx.Symbol); c != "" {
+				e.Currency = c
+			}
+		}
+		if tx.Type == ledger.TxDividend && tx.Qualified {
+			e.Class = ClassQualified
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// Bucket totals income sharing a key.
+type Bucket struct {
+	Key         string
+	Gross       float64
+	Withholding float64
+	Net         float64
+}
+
+// Group totals entries by the key returned for each, sorted by key.
+func Group(entries []Entry, key func(Entry) string) []Bucket {
+	byKey := map[string]*Bucket{}
+	for _, e := range entries {
+		k := key(e)
+		b, ok := byKey[k]
+		if !ok {
+			b = &Bucket{Key: k}
+			byKey[k] = b
+		}
+		b.Gross += e.Gross
+		b.Withholding += e.Withholding
+		b.Net += e.Net
+	}
+	res := make([]Bucket, 0, len(byKey))
+	for _, b := range byKey {
+		res = append(res, *b)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+	return res
+}
+
+// Forecast projects gross income over the twelve months after asOf by
+// repeating each payment of the previous twelve months one year later.
+// Dividends are scaled to the quantity held at asOf using the amount per
+// share held the day before the ex-date (or on the pay date when no ex-date
+// was recorded). The result is keyed by month.
+func Forecast(l *ledger.Ledger, asOf time.Time) []Bucket {
+	held := l.Snapshot(asOf).Quantities
+	var projected []Entry
+	for _, tx := range l.Transactions() {
+		if !tx.Date.After(asOf.AddDate(-1, 0, 0)) || tx.Date.After(asOf) {
+			continue
+		}
+		amount := tx.Amount
+		switch tx.Type {
+		case ledger.TxInterest:
+		case ledger.TxDividend:
+			recordDate := tx.Date
+			if !tx.ExDate.IsZero() {
+				recordDate = tx.ExDate.AddDate(0, 0, -1)
+			}
+			shares := l.Snapshot(recordDate).Quantities[tx.Symbol]
+			if shares <= 0 {
+				continue
+			}
+			amount = tx.Amount / shares * held[tx.Symbol]
+		default:
+			continue
+		}
+		if amount > 0 {
+			projected = append(projected, Entry{PayDate: tx.Date.AddDate(1, 0, 0), Gross: amount})
+		}
+	}
+	return Group(projected, func(e Entry) string { return e.PayDate.Format("2006-01") })
+}
@@ -0,0 +1,97 @@
+package income
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func testLedger(t *testing.T) *ledger.Ledger {
+	l := ledger.New("USD")
+	for _, tx := range []ledger.Transaction{
+		{Date: date("2025-01-02"), Type: ledger.TxDeposit, Amount: 10000},
+		{Date: date("2025-01-03"), Type: ledger.TxBuy, Symbol: "ABC", Quantity: 100, Price: 50},
+		{Date: date("2025-01-03"), Type: ledger.TxBuy, Symbol: "XYZ", Quantity: 10, Price: 100},
+		{Date: date("2025-03-20"), ExDate: date("2025-03-10"), Type: ledger.TxDividend, Symbol: "ABC", Amount: 50, Qualified: true},
+		{Date: date("2025-05-15"), Type: ledger.TxDividend, Symbol: "XYZ", Amount: 20, Withholding: 3},
+		{Date: date("2025-06-30"), Type: ledger.TxInterest, Amount: 12},
+		{Date: date("2025-08-01"), Type: ledger.TxBuy, Symbol: "ABC", Quantity: 100, Price: 55},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	return l
+}
+
+func TestInterval(t *testing.T) {
+	d := date("2025-08-14")
+	for interval, want := range map[Interval]string{IntervalMonth: "2025-08", IntervalQuarter: "2025-Q3", IntervalYear: "2025"} {
+		got, err := interval.Key(d)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	_, err := Interval("week").Key(d)
+	assert.ErrorIs(t, err, ErrInvalidInterval)
+}
+
+func TestEntriesAndGroup(t *testing.T) {
+	// Arrange
+	l := testLedger(t)
+	currency := func(symbol string) string {
+		if symbol == "XYZ" {
+			return "CAD"
+		}
+		return "USD"
+	}
+
+	// Act
+	entries := Entries(l, date("2025-01-01"), date("2025-12-31"), currency)
+	byCurrency := Group(entries, func(e Entry) string { return e.Currency })
+	byClass := Group(entries, func(e Entry) string { return e.Class })
+
+	// Assert
+	require.Len(t, entries, 3)
+	assert.Equal(t, date("2025-03-10"), entries[0].ExDate)
+	assert.InDelta(t, 17, entries[1].Net, 1e-9)
+	assert.Equal(t, []Bucket{{Key: "CAD", Gross: 20, Withholding: 3, Net: 17}, {Key: "USD", Gross: 62, Net: 62}}, byCurrency)
+	assert.Equal(t, "ordinary", byClass[0].Key)
+	assert.InDelta(t, 32, byClass[0].Gross, 1e-9)
+	assert.InDelta(t, 50, byClass[1].Gross, 1e-9)
+}
+
+func TestForecast(t *testing.T) {
+	// Arrange
+	l := testLedger(t)
+
+	// Act
+	forecast := Forecast(l, date("2025-12-31"))
+
+	// Assert: ABC is scaled from 100 to 200 shares held.
+	assert.Equal(t, []Bucket{{Key: "2026-03", Gross: 100}, {Key: "2026-05", Gross: 20}, {Key: "2026-06", Gross: 12}}, forecast)
+}
+
+func TestReinvestment(t *testing.T) {
+	// Arrange
+	l := testLedger(t)
+	div := ledger.Transaction{Date: date("2025-09-15"), Type: ledger.TxDividend, Symbol: "XYZ", Amount: 40, Withholding: 6}
+
+	// Act
+	buy := Reinvestment(div, 85)
+
+	// Assert
+	assert.Equal(t, ledger.TxBuy, buy.Type)
+	assert.InDelta(t, 0.4, buy.Quantity, 1e-9)
+	_, err := l.Post(div)
+	require.NoError(t, err)
+	_, err = l.Post(buy)
+	require.NoError(t, err)
+	assert.InDelta(t, 10.4, l.Snapshot(date("2025-09-15")).Quantities["XYZ"], 1e-9)
+}
@@ -0,0 +1,219 @@
+// Package journal writes portfolio ledgers as Beancount and hledger
+// plain-text accounting journals and reads investment postings back.
+//
+// Cash and positions are booked under a root account, one sub-account per
+// ledger account: Assets:Portfolio:Brokerage:Cash holds the cash of account
+// "brokerage" and Assets:Portfolio:Brokerage:AAPL its AAPL lots. Income,
+// expenses and transfers go to fixed accounts such as Income:Dividends:AAPL
+// and Equity:Transfers. Every transaction also carries the ledger fields as
+// metadata (Beancount) or tags (hledger), so exported journals read back
+// exactly, corporate actions included.
+package journal
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+)
+
+// Format is a plain-text accounting journal format.
+type Format string
+
+const (
+	// FormatBeancount journals book lots at cost with their acquisition date
+	// and a label naming the acquiring transaction.
+	FormatBeancount Format = "beancount"
+	// FormatHledger journals have no lot booking: positions are priced at cost
+	// and lots are named in posting tags.
+	FormatHledger Format = "hledger"
+)
+
+// DefaultRoot is the parent account of the portfolio cash and positions.
+const DefaultRoot = "Assets:Portfolio"
+
+// defaultAccount names the default ledger account, whose name is empty.
+const defaultAccount = "Default"
+
+// Accounts of the other side of portfolio transactions.
+const (
+	accountTransfers      = "Equity:Transfers"
+	accountGains          = "Income:CapitalGains"
+	accountDividends      = "Income:Dividends"
+	accountInterest       = "Income:Interest"
+	accountFees           = "Expenses:Fees"
+	accountWithholding    = "Expenses:Taxes:Withholding"
+	accountMarginInterest = "Expenses:MarginInterest"
+	accountBorrowFees     = "Expenses:BorrowFees"
+)
+
+var (
+	// ErrUnknownFormat is returned for a format other than beancount or hledger.
+	ErrUnknownFormat = errors.New("journal: unknown format")
+	// ErrInvalidJournal is returned when a journal cannot be read at all.
+	ErrInvalidJournal = errors.New("journal: invalid journal")
+)
+
+// ParseFormat returns the format named s.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatBeancount, FormatHledger:
+		return f, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownFormat, s)
+}
+
+// component returns name as an account name component: ASCII letters, digits
+// and dashes, starting with a capital letter or digit. The default account is
+// written as "Default".
+func component(name string) string {
+	if name == "" {
+		return defaultAccount
+	}
+	b := []byte(name)
+	for i, c := range b {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			b[i] = '-'
+		}
+	}
+	switch c := b[0]; {
+	case c >= 'a' && c <= 'z':
+		b[0] = c - 'a' + 'A'
+	case c == '-':
+		b = append([]byte("X"), b...)
+	}
+	return string(b)
+}
+
+// accountName returns the name of a ledger account in journals: the component
+// after the root, with the default account read back as empty.
+func accountName(c string) string {
+	if c == defaultAccount {
+		return ""
+	}
+	return c
+}
+
+// number formats v with at most decimals decimal places.
+func number(v float64, decimals int) string {
+	s := strconv.FormatFloat(v, 'f', decimals, 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// money formats a cash amount in cents.
+func money(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	if s == "-0.00" {
+		s = "0.00"
+	}
+	return s
+}
+
+// describe returns the narration of a ledger transaction.
+func describe(tx ledger.Transaction) string {
+	switch tx.Type {
+	case ledger.TxBuy:
+		return fmt.Sprintf("Buy %s %s", number(tx.Quantity, 8), tx.Symbol)
+	case ledger.TxSell:
+		return fmt.Sprintf("Sell %s %s", number(tx.Quantity, 8), tx.Symbol)
+	case ledger.TxDividend:
+		return "Dividend " + tx.Symbol
+	case ledger.TxBorrowFee:
+		return "Borrow fee " + tx.Symbol
+	case ledger.TxSplit:
+		return fmt.Sprintf("Split %s by %s", tx.Symbol, number(tx.Ratio, 8))
+	case ledger.TxSymbolChange:
+		return fmt.Sprintf("Symbol change %s to %s", tx.Symbol, tx.NewSymbol)
+	case ledger.TxSpinOff:
+		return fmt.Sprintf("Spin-off of %s from %s", tx.NewSymbol, tx.Symbol)
+	case ledger.TxMerger:
+		return fmt.Sprintf("Merger of %s into %s", tx.Symbol, tx.NewSymbol)
+	}
+	s := strings.ReplaceAll(string(tx.Type), "_", " ")
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// field is a ledger transaction field written as metadata.
+type field struct {
+	key   string
+	value any
+}
+
+// fields returns the non-zero fields of tx, which together with the type
+// rebuild the transaction.
+func fields(tx ledger.Transaction) []field {
+	fs := []field{{"type", string(tx.Type)}}
+	add := func(key string, v any, ok bool) {
+		if ok {
+			fs = append(fs, field{key, v})
+		}
+	}
+	add("account", tx.Account, tx.Account != "")
+	add("symbol", tx.Symbol, tx.Symbol != "")
+	add("quantity", tx.Quantity, tx.Quantity != 0)
+	add("price", tx.Price, tx.Price != 0)
+	add("amount", tx.Amount, tx.Amount != 0)
+	add("fee", tx.Fee, tx.Fee != 0)
+	add("withholding", tx.Withholding, tx.Withholding != 0)
+	add("qualified", true, tx.Qualified)
+	add("ex-date", tx.ExDate, !tx.ExDate.IsZero())
+	add("new-symbol", tx.NewSymbol, tx.NewSymbol != "")
+	add("ratio", tx.Ratio, tx.Ratio != 0)
+	add("basis-fraction", tx.BasisFraction, tx.BasisFraction != 0)
+	add("cash-in-lieu", true, tx.CashInLieu)
+	add("note", tx.Note, tx.Note != "")
+	return fs
+}
+
+// transaction rebuilds a ledger transaction dated date from its metadata.
+func transaction(date time.Time, meta map[string]string) (ledger.Transaction, error) {
+	tx := ledger.Transaction{Date: date, Type: ledger.TxType(meta["type"])}
+	var err error
+	num := func(key string) float64 {
+		v, ok := meta[key]
+		if !ok || err != nil {
+			return 0
+		}
+		f, perr := strconv.ParseFloat(v, 64)
+		if perr != nil {
+			err = fmt.Errorf("invalid %s %q", key, v)
+		}
+		return f
+	}
+	tx.Account = meta["account"]
+	tx.Symbol = meta["symbol"]
+	tx.Quantity = num("quantity")
+	tx.Price = num("price")
+	tx.Amount = num("amount")
+	tx.Fee = num("fee")
+	tx.Withholding = num("withholding")
+	tx.Qualified = strings.EqualFold(meta["qualified"], "true")
+	tx.NewSymbol = meta["new-symbol"]
+	tx.Ratio = num("ratio")
+	tx.BasisFraction = num("basis-fraction")
+	tx.CashInLieu = strings.EqualFold(meta["cash-in-lieu"], "true")
+	tx.Note = meta["note"]
+	if v, ok := meta["ex-date"]; ok && err == nil {
+		if tx.ExDate, err = parseDate(v); err != nil {
+			err = fmt.Errorf("invalid ex-date %q", v)
+		}
+	}
+	if err != nil {
+		return ledger.Transaction{}, err
+	}
+	return tx, tx.Validate()
+}
+
+// parseDate reads a journal date, with dashes or slashes.
+func parseDate(s string) (time.Time, error) {
+	return time.Parse(time.DateOnly, strings.ReplaceAll(s, "/", "-"))
+}
@@ -0,0 +1,194 @@
+package journal
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/statement"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+// sample returns a ledger with trades over two lots, a sale across both, a
+// dividend with withholding, a split and a second account.
+func sample(t *testing.T) *ledger.Ledger {
+	t.Helper()
+	l := ledger.New("USD")
+	for _, tx := range []ledger.Transaction{
+		{Date: date("2024-01-02"), Type: ledger.TxDeposit, Amount: 10000, Note: "funding, initial"},
+		{Date: date("2024-01-03"), Type: ledger.TxBuy, Symbol: "AAPL", Quantity: 10, Price: 150, Fee: 1},
+		{Date: date("2024-02-01"), Type: ledger.TxBuy, Symbol: "AAPL", Quantity: 5, Price: 160},
+		{Date: date("2024-02-05"), Type: ledger.TxDeposit, Account: "ira", Amount: 1000},
+		{Date: date("2024-02-06"), Type: ledger.TxBuy, Account: "ira", Symbol: "BRK.B", Quantity: 2, Price: 400},
+		{Date: date("2024-03-01"), Type: ledger.TxSell, Symbol: "AAPL", Quantity: 12, Price: 170, Fee: 1},
+		{Date: date("2024-03-15"), Type: ledger.TxDividend, Symbol: "AAPL", Amount: 3, Withholding: 0.45, ExDate: date("2024-03-08"), Qualified: true},
+		{Date: date("2024-06-10"), Type: ledger.TxSplit, Symbol: "AAPL", Ratio: 2},
+		{Date: date("2024-07-01"), Type: ledger.TxFee, Amount: 5},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	return l
+}
+
+var sampleOptions = Options{
+	Title:  "default",
+	Names:  map[string]string{"AAPL": "Apple Inc."},
+	Prices: []Price{{Date: date("2024-06-28"), Symbol: "AAPL", Close: 105.5}},
+}
+
+// write returns the sample journal in format with runs of spaces collapsed.
+func write(t *testing.T, format Format) string {
+	t.Helper()
+	opt := sampleOptions
+	opt.Format = format
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, sample(t), opt))
+	return regexp.MustCompile(` {2,}`).ReplaceAllString(buf.String(), " ")
+}
+
+func TestWriteBeancount(t *testing.T) {
+	// Act
+	out := write(t, FormatBeancount)
+
+	// Assert
+	assert.Contains(t, out, `option "operating_currency" "USD"`)
+	assert.Contains(t, out, "2024-01-03 commodity AAPL\n name: \"Apple Inc.\"\n")
+	assert.Contains(t, out, "2024-01-03 open Assets:Portfolio:Default:AAPL AAPL\n")
+	assert.Contains(t, out, "2024-02-05 open Assets:Portfolio:Ira:Cash USD\n")
+	assert.Contains(t, out, "2024-01-03 * \"Buy 10 AAPL\"\n type: \"buy\"\n symbol: \"AAPL\"\n quantity: 10\n price: 150\n fee: 1\n"+
+		" Assets:Portfolio:Default:AAPL 10 AAPL {150.1 USD, 2024-01-03, \"tx-000002\"}\n"+
+		" Assets:Portfolio:Default:Cash -1501.00 USD\n")
+	// The sale relieves the first lot and part of the second.
+	assert.Contains(t, out, " Assets:Portfolio:Default:AAPL -10 AAPL {150.1 USD, 2024-01-03, \"tx-000002\"}\n"+
+		" Assets:Portfolio:Default:AAPL -2 AAPL {160 USD, 2024-02-01, \"tx-000003\"}\n"+
+		" Assets:Portfolio:Default:Cash 2039.00 USD\n"+
+		" Expenses:Fees 1.00 USD\n"+
+		" Income:CapitalGains -219.00 USD\n")
+	assert.Contains(t, out, " Assets:Portfolio:Default:Cash 2.55 USD\n Expenses:Taxes:Withholding 0.45 USD\n Income:Dividends:AAPL -3.00 USD\n")
+	// The split rebooks the remaining lot at half the cost.
+	assert.Contains(t, out, " Assets:Portfolio:Default:AAPL -3 AAPL {160 USD, 2024-02-01, \"tx-000003\"}\n"+
+		" Assets:Portfolio:Default:AAPL 6 AAPL {80 USD, 2024-02-01, \"tx-000003\"}\n\n")
+	assert.Contains(t, out, " Assets:Portfolio:Ira:BRK-B 2 BRK.B {400 USD, 2024-02-06, \"tx-000005\"}\n")
+	assert.Contains(t, out, " note: \"funding, initial\"\n")
+	assert.Contains(t, out, "2024-06-28 price AAPL 105.5 USD\n")
+}
+
+func TestWriteHledger(t *testing.T) {
+	// Act
+	out := write(t, FormatHledger)
+
+	// Assert
+	assert.Contains(t, out, "commodity 1000.00 USD\n")
+	assert.Contains(t, out, "commodity AAPL ; Apple Inc.\n")
+	assert.Contains(t, out, "account Assets:Portfolio:Default:AAPL\n")
+	assert.Contains(t, out, "2024-01-02 * Deposit ; type:deposit, amount:10000, note:funding; initial\n")
+	assert.Contains(t, out, " Assets:Portfolio:Default:AAPL -10 AAPL @ 150.1 USD ; lot:tx-000002, acquired:2024-01-03\n")
+	assert.Contains(t, out, " Assets:Portfolio:Ira:BRK-B 2 \"BRK.B\" @ 400 USD ; lot:tx-000005, acquired:2024-02-06\n")
+	assert.Contains(t, out, "P 2024-06-28 AAPL 105.5 USD\n")
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	// Act
+	err := Write(&bytes.Buffer{}, sample(t), Options{Format: "ledger"})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
+
+func TestReadRoundTrip(t *testing.T) {
+	for _, format := range []Format{FormatBeancount, FormatHledger} {
+		t.Run(string(format), func(t *testing.T) {
+			// Arrange
+			l := sample(t)
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, l, Options{Format: format}))
+
+			// Act
+			rows, err := Read(&buf, ReadOptions{Currency: "USD"})
+
+			// Assert
+			require.NoError(t, err)
+			want := l.Transactions()
+			require.Len(t, rows, len(want))
+			for i, r := range rows {
+				require.NoError(t, r.Err)
+				want[i].ID = ""
+				if format == FormatHledger {
+					want[i].Note = strings.ReplaceAll(want[i].Note, ",", ";")
+				}
+				assert.Equal(t, want[i], r.Tx)
+			}
+		})
+	}
+}
+
+func TestReadInfersPostings(t *testing.T) {
+	// Arrange
+	in := `; broker journal
+account Assets:Broker:Cash
+
+2024-01-02 Opening deposit
+    Assets:Broker:Cash          $5,000.00
+    Assets:Checking
+
+2024-01-03 * Buy Apple
+    Assets:Broker:AAPL          10 AAPL @ $150
+    Expenses:Fees:Commission    $1
+    Assets:Broker:Cash          $-1,501
+
+2024-02-01 * (42) Sell Apple  ; memo
+    Assets:Broker:AAPL          -4 AAPL @@ $680
+    Expenses:Fees               1 USD
+    Assets:Broker:Cash          679 USD
+    Income:CapitalGains
+
+2024-03-01 Apple dividend
+    Assets:Broker:Cash          1.70 USD
+    Expenses:Taxes              0.30 USD
+    Income:Dividends:AAPL      -2.00 USD
+
+2024-03-02 Groceries
+    Expenses:Food   20 USD
+    Assets:Checking
+
+2024-03-05 * "Broker" "Buy Microsoft"
+  Assets:Broker:Ira:MSFT 2 MSFT {{800.00 USD}}
+  Assets:Broker:Ira:Cash
+`
+
+	// Act
+	rows, err := Read(strings.NewReader(in), ReadOptions{Root: "Assets:Broker", Currency: "USD"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, rows, 6)
+	assert.Equal(t, 4, rows[0].Line)
+	assert.Equal(t, ledger.Transaction{Date: date("2024-01-02"), Type: ledger.TxDeposit, Amount: 5000, Note: "Opening deposit"}, rows[0].Tx)
+	assert.Equal(t, ledger.Transaction{Date: date("2024-01-03"), Type: ledger.TxBuy, Symbol: "AAPL", Quantity: 10, Price: 150, Fee: 1, Note: "Buy Apple"}, rows[1].Tx)
+	assert.Equal(t, ledger.Transaction{Date: date("2024-02-01"), Type: ledger.TxSell, Symbol: "AAPL", Quantity: 4, Price: 170, Fee: 1, Note: "Sell Apple"}, rows[2].Tx)
+	assert.Equal(t, ledger.Transaction{Date: date("2024-03-01"), Type: ledger.TxDividend, Symbol: "AAPL", Amount: 2, Withholding: 0.3, Note: "Apple dividend"}, rows[3].Tx)
+	assert.Equal(t, statement.StatusSkipped, rows[4].Status)
+	assert.Equal(t, ledger.Transaction{Date: date("2024-03-05"), Type: ledger.TxBuy, Account: "Ira", Symbol: "MSFT", Quantity: 2, Price: 400, Note: "Buy Microsoft"}, rows[5].Tx)
+}
+
+func TestReadInvalid(t *testing.T) {
+	// Act
+	_, err := Read(strings.NewReader("option \"title\" \"empty\"\n"), ReadOptions{Currency: "USD"})
+	rows, rowErr := Read(strings.NewReader("2024-01-02 * \"Deposit\"\n  Assets:Portfolio:Cash 10 USD {\n"), ReadOptions{Currency: "USD"})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrInvalidJournal)
+	require.NoError(t, rowErr)
+	require.Len(t, rows, 1)
+	assert.Equal(t, statement.StatusError, rows[0].Status)
+}
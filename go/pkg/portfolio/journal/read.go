@@ -0,0 +1,517 @@
+package journal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/statement"
+)
+
+// ReadOptions control how journal postings are read into ledger transactions.
+type ReadOptions struct {
+	// Root is the parent account of the portfolio cash and positions;
+	// DefaultRoot when empty.
+	Root string
+	// Currency is the ledger currency; other commodities are securities.
+	Currency string
+}
+
+// beancountDirectives are the dated Beancount entries other than transactions.
+var beancountDirectives = map[string]bool{
+	"open": true, "close": true, "commodity": true, "price": true, "balance": true, "pad": true,
+	"note": true, "document": true, "event": true, "query": true, "custom": true,
+}
+
+var (
+	// metaPattern matches Beancount metadata; unlike hledger account names,
+	// the key is followed by a space.
+	metaPattern   = regexp.MustCompile(`^([a-z][A-Za-z0-9_-]*):(?:\s+(.*))?$`)
+	numberPattern = regexp.MustCompile(`[-+]?(\d[\d,]*(\.\d*)?|\.\d+)`)
+	stringPattern = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+)
+
+// rawPosting is a posting as written. Cost and price are per unit.
+type rawPosting struct {
+	account   string
+	quantity  float64
+	commodity string
+	hasAmount bool
+	cost      float64
+	price     float64
+}
+
+// rawTx is a journal transaction as written.
+type rawTx struct {
+	line      int
+	date      time.Time
+	narration string
+	meta      map[string]string
+	postings  []rawPosting
+	err       error
+}
+
+// Read reads the transactions of a Beancount or hledger journal into
+// statement rows; other directives are ignored. Transactions carrying the
+// metadata or tags written by Write are rebuilt from them. Others are
+// inferred from their postings under the root account: a position posting
+// makes a buy or sell of the symbol, and cash postings alone make income,
+// charges or transfers depending on the other accounts, e.g. Income:Dividends:AAPL.
+// Transactions without postings under the root are skipped.
+func Read(r io.Reader, opt ReadOptions) ([]statement.Row, error) {
+	if opt.Root == "" {
+		opt.Root = DefaultRoot
+	}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var txs []*rawTx
+	var cur *rawTx
+	dated := false
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimRight(sc.Text(), " \t\r")
+		switch {
+		case strings.TrimSpace(line) == "":
+			cur = nil
+		case line[0] == ' ' || line[0] == '\t':
+			if cur != nil && cur.err == nil {
+				cur.indented(strings.TrimSpace(line), n, opt.Currency)
+			}
+		case line[0] >= '0' && line[0] <= '9':
+			dated = true
+			cur = parseHeader(line, n)
+			if cur != nil {
+				txs = append(txs, cur)
+			}
+		default:
+			// Comments and undated directives such as option, account and P.
+			cur = nil
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJournal, err)
+	}
+	if !dated {
+		return nil, fmt.Errorf("%w: no dated entries", ErrInvalidJournal)
+	}
+
+	rows := make([]statement.Row, len(txs))
+	for i, t := range txs {
+		rows[i] = t.row(opt)
+	}
+	return rows, nil
+}
+
+// parseHeader reads the first line of a dated entry, returning nil for
+// directives other than transactions.
+func parseHeader(line string, n int) *rawTx {
+	text, comment := splitComment(line)
+	t := &rawTx{line: n, meta: map[string]string{}}
+	head, rest, _ := strings.Cut(strings.TrimSpace(text), " ")
+	// hledger secondary dates follow an equals sign.
+	head, _, _ = strings.Cut(head, "=")
+	date, err := parseDate(head)
+	if err != nil {
+		t.err = fmt.Errorf("invalid date %q", head)
+		return t
+	}
+	t.date = date
+	rest = strings.TrimSpace(rest)
+	word, after, _ := strings.Cut(rest, " ")
+	if beancountDirectives[word] || word == "P" {
+		return nil
+	}
+	if word == "*" || word == "!" || word == "txn" {
+		rest = strings.TrimSpace(after)
+	}
+	if strings.HasPrefix(rest, "(") {
+		// hledger transaction code.
+		if i := strings.IndexByte(rest, ')'); i >= 0 {
+			rest = strings.TrimSpace(rest[i+1:])
+		}
+	}
+	if strings.HasPrefix(rest, `"`) {
+		// Beancount payee and narration strings; the narration comes last.
+		for _, s := range stringPattern.FindAllString(rest, -1) {
+			if u, err := strconv.Unquote(s); err == nil {
+				t.narration = u
+			}
+		}
+	} else {
+		t.narration = rest
+	}
+	parseTags(comment, t.meta)
+	return t
+}
+
+// indented reads a metadata, comment or posting line of the transaction.
+func (t *rawTx) indented(s string, n int, currency string) {
+	if s[0] == ';' || s[0] == '#' {
+		if len(t.postings) == 0 {
+			parseTags(s[1:], t.meta)
+		}
+		return
+	}
+	if m := metaPattern.FindStringSubmatch(s); m != nil {
+		if len(t.postings) == 0 {
+			v, _ := splitComment(m[2])
+			v = strings.TrimSpace(v)
+			if u, err := strconv.Unquote(v); err == nil {
+				v = u
+			}
+			t.meta[m[1]] = v
+		}
+		return
+	}
+	p, err := parsePosting(s, currency)
+	if err != nil {
+		t.err = fmt.Errorf("line %d: %w", n, err)
+		return
+	}
+	t.postings = append(t.postings, p)
+}
+
+// splitComment splits a line at the first semicolon outside double quotes.
+func splitComment(s string) (string, string) {
+	quoted := false
+	for i, c := range s {
+		switch c {
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return s[:i], s[i+1:]
+			}
+		}
+	}
+	return s, ""
+}
+
+// parseTags adds the hledger tags of a comment, name:value separated by
+// commas, to tags.
+func parseTags(comment string, tags map[string]string) {
+	for _, part := range strings.Split(comment, ",") {
+		name, value, ok := strings.Cut(part, ":")
+		if !ok {
+			continue
+		}
+		words := strings.Fields(name)
+		if len(words) == 0 {
+			continue
+		}
+		tags[words[len(words)-1]] = strings.TrimSpace(value)
+	}
+}
+
+// parsePosting reads a posting: an account, then an optional amount with a
+// cost in braces and a price after @ or @@. The account ends at two spaces or
+// a tab, or at a space when there is neither, as in Beancount.
+func parsePosting(s, currency string) (rawPosting, error) {
+	text, _ := splitComment(s)
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "* ") || strings.HasPrefix(text, "! ") {
+		text = strings.TrimSpace(text[2:])
+	}
+	var p rawPosting
+	i := strings.Index(text, "  ")
+	if j := strings.IndexByte(text, '\t'); j >= 0 && (i < 0 || j < i) {
+		i = j
+	}
+	if i < 0 {
+		i = strings.IndexByte(text, ' ')
+	}
+	if i < 0 {
+		i = len(text)
+	}
+	p.account = strings.Trim(text[:i], "()[]")
+	rest := strings.TrimSpace(text[i:])
+	// hledger balance assertions.
+	if j := strings.IndexByte(rest, '='); j >= 0 {
+		rest = strings.TrimSpace(rest[:j])
+	}
+
+	var costText, priceText string
+	totalCost, totalPrice := false, false
+	if j := strings.IndexByte(rest, '{'); j >= 0 {
+		k := strings.LastIndexByte(rest, '}')
+		if k < j {
+			return p, fmt.Errorf("unterminated cost in %q", s)
+		}
+		costText = rest[j+1 : k]
+		if strings.HasPrefix(costText, "{") {
+			totalCost, costText = true, strings.Trim(costText, "{}")
+		}
+		rest = rest[:j] + rest[k+1:]
+	}
+	if j := strings.IndexByte(rest, '@'); j >= 0 {
+		priceText = rest[j+1:]
+		if strings.HasPrefix(priceText, "@") {
+			totalPrice, priceText = true, priceText[1:]
+		}
+		rest = rest[:j]
+	}
+	if rest = strings.TrimSpace(rest); rest == "" {
+		return p, nil
+	}
+	var err error
+	if p.quantity, p.commodity, err = parseAmount(rest, currency); err != nil {
+		return p, err
+	}
+	p.hasAmount = true
+	for _, part := range strings.Split(costText, ",") {
+		if v, c, err := parseAmount(part, currency); err == nil && c == currency {
+			p.cost = v
+			if totalCost && p.quantity != 0 {
+				p.cost = v / math.Abs(p.quantity)
+			}
+			break
+		}
+	}
+	if priceText != "" {
+		v, _, err := parseAmount(priceText, currency)
+		if err != nil {
+			return p, err
+		}
+		p.price = v
+		if totalPrice && p.quantity != 0 {
+			p.price = v / math.Abs(p.quantity)
+		}
+	}
+	return p, nil
+}
+
+// parseAmount reads a number with its commodity before or after it, quoted
+// or not. An amount without a commodity, or in dollars, is in currency.
+func parseAmount(s, currency string) (float64, string, error) {
+	s = strings.TrimSpace(s)
+	commodity := ""
+	if i := strings.IndexByte(s, '"'); i >= 0 {
+		j := strings.IndexByte(s[i+1:], '"')
+		if j < 0 {
+			return 0, "", fmt.Errorf("invalid amount %q", s)
+		}
+		commodity = s[i+1 : i+1+j]
+		s = s[:i] + s[i+2+j:]
+	}
+	loc := numberPattern.FindStringIndex(s)
+	if loc == nil {
+		return 0, "", fmt.Errorf("invalid amount %q", s)
+	}
+	v, err := strconv.ParseFloat(strings.ReplaceAll(s[loc[0]:loc[1]], ",", ""), 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid amount %q", s)
+	}
+	rest := strings.TrimSpace(s[:loc[0]] + " " + s[loc[1]:])
+	if strings.HasPrefix(rest, "-") {
+		v, rest = -v, strings.TrimSpace(rest[1:])
+	}
+	if commodity == "" {
+		commodity = rest
+	}
+	switch commodity {
+	case "":
+		commodity = currency
+	case "$":
+		commodity = "USD"
+	}
+	return v, commodity, nil
+}
+
+// row converts the transaction into a statement row.
+func (t *rawTx) row(opt ReadOptions) statement.Row {
+	r := statement.Row{Line: t.line}
+	if t.err != nil {
+		r.Status, r.Err = statement.StatusError, t.err
+		return r
+	}
+	var tx ledger.Transaction
+	var err error
+	if _, ok := t.meta["type"]; ok {
+		tx, err = transaction(t.date, t.meta)
+	} else {
+		tx, err = t.infer(opt)
+	}
+	switch {
+	case err == errNotPortfolio:
+		r.Status, r.Err = statement.StatusSkipped, fmt.Errorf("no postings under %s", opt.Root)
+	case err != nil:
+		r.Status, r.Err = statement.StatusError, err
+	default:
+		r.Tx = tx
+	}
+	return r
+}
+
+// errNotPortfolio marks transactions without postings under the root.
+var errNotPortfolio = fmt.Errorf("%w: no portfolio postings", ErrInvalidJournal)
+
+// infer builds a ledger transaction from the postings.
+func (t *rawTx) infer(opt ReadOptions) (ledger.Transaction, error) {
+	if err := t.fillElided(opt.Currency); err != nil {
+		return ledger.Transaction{}, err
+	}
+	root := opt.Root + ":"
+	var (
+		others             []rawPosting
+		cash, qty          float64
+		price, cost        float64
+		symbol             string
+		account            string
+		accounts, holdings = map[string]bool{}, map[string]bool{}
+	)
+	for _, p := range t.postings {
+		if !strings.HasPrefix(p.account, root) {
+			others = append(others, p)
+			continue
+		}
+		parts := strings.Split(strings.TrimPrefix(p.account, root), ":")
+		acct := ""
+		if len(parts) > 1 {
+			acct = accountName(strings.Join(parts[:len(parts)-1], ":"))
+		}
+		if p.commodity == opt.Currency {
+			cash += p.quantity
+			accounts[acct] = true
+			continue
+		}
+		holdings[acct+"\x00"+p.commodity] = true
+		symbol, account = p.commodity, acct
+		qty += p.quantity
+		if p.price != 0 {
+			price = p.price
+		}
+		if p.cost != 0 {
+			cost = p.cost
+		}
+	}
+	switch {
+	case len(holdings) > 1:
+		return ledger.Transaction{}, fmt.Errorf("postings to more than one position")
+	case len(holdings) == 0 && len(accounts) > 1:
+		return ledger.Transaction{}, fmt.Errorf("transfers between portfolio accounts are not imported")
+	case len(holdings) == 0 && len(accounts) == 0:
+		return ledger.Transaction{}, errNotPortfolio
+	case len(holdings) == 0:
+		for a := range accounts {
+			account = a
+		}
+	}
+
+	has := func(account string, words ...string) bool {
+		lower := strings.ToLower(account)
+		for _, w := range words {
+			if strings.Contains(lower, w) {
+				return true
+			}
+		}
+		return false
+	}
+	sum := func(prefix string, words ...string) (float64, string) {
+		total, name := 0.0, ""
+		for _, p := range others {
+			if strings.HasPrefix(p.account, prefix) && p.commodity == opt.Currency && has(p.account, words...) {
+				total += p.quantity
+				name = p.account
+			}
+		}
+		return total, name
+	}
+	last := func(account string) string {
+		return account[strings.LastIndexByte(account, ':')+1:]
+	}
+	fee, _ := sum("Expenses", "fee", "commission")
+	withholding, _ := sum("Expenses", "tax", "withholding")
+	dividends, dividendAccount := sum("Income", "dividend")
+	interest, _ := sum("Income", "interest")
+	marginInterest, _ := sum("Expenses", "interest")
+	borrow, borrowAccount := sum("Expenses", "borrow")
+
+	tx := ledger.Transaction{Date: t.date, Account: account, Note: t.narration}
+	switch {
+	case symbol != "":
+		tx.Symbol, tx.Quantity, tx.Fee = symbol, math.Abs(qty), fee
+		switch {
+		case qty > 1e-9:
+			tx.Type = ledger.TxBuy
+		case qty < -1e-9:
+			tx.Type = ledger.TxSell
+		default:
+			return ledger.Transaction{}, fmt.Errorf("postings to %s net to zero", symbol)
+		}
+		switch {
+		case price != 0:
+			tx.Price = price
+		case cost != 0 && tx.Type == ledger.TxBuy:
+			tx.Price = cost
+		case tx.Type == ledger.TxBuy:
+			tx.Price = (-cash - fee) / tx.Quantity
+		default:
+			tx.Price = (cash + fee) / tx.Quantity
+		}
+	case dividends != 0:
+		tx.Type, tx.Amount, tx.Withholding = ledger.TxDividend, -dividends, withholding
+		if s := last(dividendAccount); !has(s, "dividend") {
+			tx.Symbol = s
+		}
+	case interest != 0:
+		tx.Type, tx.Amount, tx.Withholding = ledger.TxInterest, -interest, withholding
+	case borrow != 0:
+		tx.Type, tx.Amount, tx.Symbol = ledger.TxBorrowFee, -cash, last(borrowAccount)
+	case marginInterest != 0:
+		tx.Type, tx.Amount = ledger.TxMarginInterest, -cash
+	case fee != 0:
+		tx.Type, tx.Amount = ledger.TxFee, -cash
+	case cash > 0:
+		tx.Type, tx.Amount = ledger.TxDeposit, cash
+	case cash < 0:
+		tx.Type, tx.Amount = ledger.TxWithdrawal, -cash
+	default:
+		return ledger.Transaction{}, fmt.Errorf("portfolio postings net to zero")
+	}
+	return tx, tx.Validate()
+}
+
+// fillElided sets the amount of the one posting written without one to
+// balance the transaction.
+func (t *rawTx) fillElided(currency string) error {
+	elided := -1
+	residual := map[string]float64{}
+	for i, p := range t.postings {
+		switch {
+		case !p.hasAmount && elided >= 0:
+			return fmt.Errorf("more than one posting without an amount")
+		case !p.hasAmount:
+			elided = i
+		case p.cost != 0:
+			residual[currency] += p.quantity * p.cost
+		case p.price != 0:
+			residual[currency] += p.quantity * p.price
+		default:
+			residual[p.commodity] += p.quantity
+		}
+	}
+	if elided < 0 {
+		return nil
+	}
+	var commodity string
+	for c, v := range residual {
+		if math.Abs(v) < 1e-9 {
+			continue
+		}
+		if commodity != "" {
+			return fmt.Errorf("cannot infer the posting without an amount")
+		}
+		commodity = c
+	}
+	p := &t.postings[elided]
+	p.quantity, p.commodity, p.hasAmount = -residual[commodity], commodity, true
+	if commodity == "" {
+		p.commodity = currency
+	}
+	return nil
+}
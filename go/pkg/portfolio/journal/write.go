@@ -0,0 +1,436 @@
+package journal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+)
+
+// Options control how a ledger is written.
+type Options struct {
+	Format Format
+	// Title names the journal, e.g. after the portfolio.
+	Title string
+	// Root is the parent account of the portfolio cash and positions;
+	// DefaultRoot when empty.
+	Root string
+	// Names are instrument names by symbol, written on commodity declarations.
+	Names map[string]string
+	// Prices are written as price directives.
+	Prices []Price
+}
+
+// Price is the market price of a symbol on a date.
+type Price struct {
+	Date   time.Time
+	Symbol string
+	Close  float64
+}
+
+// posting is a leg of a journal transaction. Positions carry the unit cost
+// text, acquisition date and label of their lot.
+type posting struct {
+	account   string
+	quantity  string
+	commodity string
+	cost      string
+	acquired  time.Time
+	lot       string
+}
+
+// weight returns the posting amount in the ledger currency.
+func (p posting) weight() float64 {
+	q, _ := strconv.ParseFloat(p.quantity, 64)
+	if p.cost == "" {
+		return q
+	}
+	c, _ := strconv.ParseFloat(p.cost, 64)
+	return q * c
+}
+
+// entry is a journal transaction for a ledger transaction.
+type entry struct {
+	tx       ledger.Transaction
+	postings []posting
+}
+
+// openLot is a lot as last written, with the cost text used to book it.
+type openLot struct {
+	lot  ledger.Lot
+	cost string
+}
+
+// Write writes the ledger as a journal. Lots are followed through the ledger:
+// a sale relieves the lots it disposed of, at the cost they were booked with,
+// and corporate actions convert the lots of every account holding the symbol.
+func Write(w io.Writer, l *ledger.Ledger, opt Options) error {
+	if _, err := ParseFormat(string(opt.Format)); err != nil {
+		return err
+	}
+	if opt.Root == "" {
+		opt.Root = DefaultRoot
+	}
+	currency := l.Currency()
+	txns := l.Transactions()
+
+	// Replaying the transactions one at a time gives the lots and cash before
+	// and after each of them.
+	replay := ledger.New(currency)
+	for _, tx := range txns {
+		if l.IsMarginAccount(tx.Account) {
+			replay.SetMarginAccount(tx.Account, true)
+		}
+	}
+	type lotKey struct{ id, account, symbol string }
+	open := map[lotKey]openLot{}
+	written := map[string]float64{}
+	entries := make([]entry, 0, len(txns))
+	for _, tx := range txns {
+		if _, err := replay.Post(tx); err != nil {
+			return fmt.Errorf("journal: replaying %s: %w", tx.ID, err)
+		}
+		e := entry{tx: tx}
+		var positions []posting
+		position := func(lot ledger.Lot, qty float64, cost string) {
+			positions = append(positions, posting{
+				account:   opt.Root + ":" + component(lot.Account) + ":" + component(lot.Symbol),
+				quantity:  number(qty, 8),
+				commodity: lot.Symbol,
+				cost:      cost,
+				acquired:  lot.Acquired,
+				lot:       lot.ID,
+			})
+		}
+
+		lots, _ := replay.Lots(tx.Date)
+		seen := map[lotKey]bool{}
+		for _, lot := range lots {
+			if math.Abs(lot.Quantity) < 1e-9 {
+				continue
+			}
+			k := lotKey{lot.ID, lot.Account, lot.Symbol}
+			seen[k] = true
+			cost := number(lot.UnitCost(), 8)
+			prev, had := open[k]
+			switch {
+			case !had:
+				position(lot, lot.Quantity, cost)
+			case prev.cost != cost:
+				// Lots cannot be repriced in place: relieve and rebook.
+				position(prev.lot, -prev.lot.Quantity, prev.cost)
+				position(lot, lot.Quantity, cost)
+			case math.Abs(lot.Quantity-prev.lot.Quantity) > 1e-9:
+				position(lot, lot.Quantity-prev.lot.Quantity, cost)
+			default:
+				cost = prev.cost
+			}
+			open[k] = openLot{lot, cost}
+		}
+		var closed []lotKey
+		for k := range open {
+			if !seen[k] {
+				closed = append(closed, k)
+			}
+		}
+		sort.Slice(closed, func(i, j int) bool { return closed[i].id < closed[j].id })
+		for _, k := range closed {
+			prev := open[k]
+			position(prev.lot, -prev.lot.Quantity, prev.cost)
+			delete(open, k)
+		}
+		sort.SliceStable(positions, func(i, j int) bool {
+			if positions[i].account != positions[j].account {
+				return positions[i].account < positions[j].account
+			}
+			return positions[i].acquired.Before(positions[j].acquired)
+		})
+		e.postings = positions
+
+		// Cash is written in cents against the running written balance, so
+		// rounding does not accumulate.
+		snap := replay.Snapshot(tx.Date)
+		accounts := make([]string, 0, len(snap.Accounts))
+		for account := range snap.Accounts {
+			accounts = append(accounts, account)
+		}
+		sort.Strings(accounts)
+		for _, account := range accounts {
+			d := math.Round((snap.Accounts[account].Cash-written[account])*100) / 100
+			if d == 0 {
+				continue
+			}
+			written[account] += d
+			e.postings = append(e.postings, posting{
+				account:   opt.Root + ":" + component(account) + ":Cash",
+				quantity:  money(d),
+				commodity: currency,
+			})
+		}
+
+		counter := accountGains
+		cash := func(account string, amount float64) {
+			e.postings = append(e.postings, posting{account: account, quantity: money(amount), commodity: currency})
+		}
+		switch tx.Type {
+		case ledger.TxDeposit, ledger.TxWithdrawal:
+			counter = accountTransfers
+		case ledger.TxDividend, ledger.TxInterest:
+			if tx.Withholding != 0 {
+				cash(accountWithholding, tx.Withholding)
+			}
+			counter = accountInterest
+			if tx.Type == ledger.TxDividend {
+				counter = accountDividends + ":" + component(tx.Symbol)
+			}
+		case ledger.TxFee:
+			counter = accountFees
+		case ledger.TxMarginInterest:
+			counter = accountMarginInterest
+		case ledger.TxBorrowFee:
+			counter = accountBorrowFees + ":" + component(tx.Symbol)
+		case ledger.TxSell:
+			if tx.Fee != 0 {
+				cash(accountFees, tx.Fee)
+			}
+		}
+		sum := 0.0
+		for _, p := range e.postings {
+			sum += p.weight()
+		}
+		if r := math.Round(-sum*100) / 100; r != 0 {
+			cash(counter, r)
+		}
+		entries = append(entries, e)
+	}
+
+	// Accounts are opened and commodities declared on first use.
+	opened := map[string]time.Time{}
+	constraint := map[string]string{}
+	declared := map[string]time.Time{}
+	use := func(m map[string]time.Time, k string, date time.Time) {
+		if d, ok := m[k]; !ok || date.Before(d) {
+			m[k] = date
+		}
+	}
+	for _, e := range entries {
+		for _, p := range e.postings {
+			use(opened, p.account, e.tx.Date)
+			if strings.HasPrefix(p.account, opt.Root+":") {
+				constraint[p.account] = p.commodity
+			}
+			use(declared, p.commodity, e.tx.Date)
+		}
+	}
+	for _, p := range opt.Prices {
+		use(declared, p.Symbol, p.Date)
+	}
+	if _, ok := declared[currency]; !ok && len(entries) > 0 {
+		declared[currency] = entries[0].tx.Date
+	}
+
+	jw := &journalWriter{w: bufio.NewWriter(w), format: opt.Format, currency: currency}
+	jw.header(opt.Title)
+	jw.commodities(declared, opt.Names)
+	jw.accounts(opened, constraint)
+	for _, e := range entries {
+		jw.entry(e)
+	}
+	prices := append([]Price(nil), opt.Prices...)
+	sort.SliceStable(prices, func(i, j int) bool {
+		if !prices[i].Date.Equal(prices[j].Date) {
+			return prices[i].Date.Before(prices[j].Date)
+		}
+		return prices[i].Symbol < prices[j].Symbol
+	})
+	jw.prices(prices)
+	return jw.w.Flush()
+}
+
+// journalWriter writes the directives of one format.
+type journalWriter struct {
+	w        *bufio.Writer
+	format   Format
+	currency string
+}
+
+func (jw *journalWriter) printf(format string, args ...any) {
+	fmt.Fprintf(jw.w, format, args...)
+}
+
+// commodity returns symbol as a commodity of the format. Beancount
+// commodities are upper case letters, digits and ' . _ - starting with a
+// letter; hledger quotes symbols with other than letters.
+func (jw *journalWriter) commodity(symbol string) string {
+	if jw.format == FormatHledger {
+		for _, c := range symbol {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+				return strconv.Quote(symbol)
+			}
+		}
+		return symbol
+	}
+	return beancountCommodity(symbol)
+}
+
+// beancountCommodity maps symbol to a valid Beancount commodity.
+func beancountCommodity(symbol string) string {
+	b := []byte(strings.ToUpper(symbol))
+	for i, c := range b {
+		if !(c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '\'' || c == '.' || c == '_' || c == '-') {
+			b[i] = '-'
+		}
+	}
+	if len(b) == 0 || b[0] < 'A' || b[0] > 'Z' {
+		b = append([]byte("X"), b...)
+	}
+	if c := b[len(b)-1]; !(c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+		b = append(b, 'X')
+	}
+	return string(b)
+}
+
+func (jw *journalWriter) header(title string) {
+	if title == "" {
+		title = "Portfolio"
+	}
+	if jw.format == FormatHledger {
+		jw.printf("; %s\n\n", title)
+		// Declaring the currency precision makes hledger balance transactions
+		// in cents rather than at the precision of lot costs.
+		jw.printf("commodity 1000.00 %s\n", jw.commodity(jw.currency))
+		return
+	}
+	jw.printf("option \"title\" %s\n", strconv.Quote(title))
+	jw.printf("option \"operating_currency\" %s\n", strconv.Quote(jw.currency))
+	jw.printf("option \"inferred_tolerance_default\" \"*:0.005\"\n\n")
+}
+
+func (jw *journalWriter) commodities(declared map[string]time.Time, names map[string]string) {
+	symbols := make([]string, 0, len(declared))
+	for symbol := range declared {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	for _, symbol := range symbols {
+		name := names[symbol]
+		if jw.format == FormatHledger {
+			if symbol == jw.currency {
+				continue
+			}
+			if name != "" {
+				jw.printf("commodity %s  ; %s\n", jw.commodity(symbol), name)
+			} else {
+				jw.printf("commodity %s\n", jw.commodity(symbol))
+			}
+			continue
+		}
+		jw.printf("%s commodity %s\n", declared[symbol].Format(time.DateOnly), jw.commodity(symbol))
+		if name != "" {
+			jw.printf("  name: %s\n", strconv.Quote(name))
+		}
+		if c := jw.commodity(symbol); c != symbol {
+			jw.printf("  symbol: %s\n", strconv.Quote(symbol))
+		}
+	}
+	jw.printf("\n")
+}
+
+func (jw *journalWriter) accounts(opened map[string]time.Time, constraint map[string]string) {
+	names := make([]string, 0, len(opened))
+	for name := range opened {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if jw.format == FormatHledger {
+			jw.printf("account %s\n", name)
+			continue
+		}
+		jw.printf("%s open %s", opened[name].Format(time.DateOnly), name)
+		if c, ok := constraint[name]; ok {
+			jw.printf(" %s", jw.commodity(c))
+		}
+		jw.printf("\n")
+	}
+	jw.printf("\n")
+}
+
+func (jw *journalWriter) entry(e entry) {
+	date := e.tx.Date.Format(time.DateOnly)
+	fs := fields(e.tx)
+	if jw.format == FormatHledger {
+		tags := make([]string, len(fs))
+		for i, f := range fs {
+			tags[i] = f.key + ":" + hledgerValue(f.value)
+		}
+		jw.printf("%s * %s  ; %s\n", date, describe(e.tx), strings.Join(tags, ", "))
+		for _, p := range e.postings {
+			line := fmt.Sprintf("    %-48s  %s %s", p.account, p.quantity, jw.commodity(p.commodity))
+			if p.cost != "" {
+				line += fmt.Sprintf(" @ %s %s  ; lot:%s, acquired:%s", p.cost, jw.commodity(jw.currency), p.lot, p.acquired.Format(time.DateOnly))
+			}
+			jw.printf("%s\n", line)
+		}
+		jw.printf("\n")
+		return
+	}
+
+	jw.printf("%s * %s\n", date, strconv.Quote(describe(e.tx)))
+	for _, f := range fs {
+		jw.printf("  %s: %s\n", f.key, beancountValue(f.value))
+	}
+	for _, p := range e.postings {
+		line := fmt.Sprintf("  %-48s  %s %s", p.account, p.quantity, jw.commodity(p.commodity))
+		if p.cost != "" {
+			line += fmt.Sprintf(" {%s %s, %s, %s}", p.cost, jw.currency, p.acquired.Format(time.DateOnly), strconv.Quote(p.lot))
+		}
+		jw.printf("%s\n", line)
+	}
+	jw.printf("\n")
+}
+
+func (jw *journalWriter) prices(prices []Price) {
+	for _, p := range prices {
+		date := p.Date.Format(time.DateOnly)
+		if jw.format == FormatHledger {
+			jw.printf("P %s %s %s %s\n", date, jw.commodity(p.Symbol), number(p.Close, 6), jw.commodity(jw.currency))
+		} else {
+			jw.printf("%s price %s %s %s\n", date, jw.commodity(p.Symbol), number(p.Close, 6), jw.currency)
+		}
+	}
+}
+
+// beancountValue formats a metadata value: strings quoted, dates and numbers
+// bare and booleans as TRUE.
+func beancountValue(v any) string {
+	switch v := v.(type) {
+	case float64:
+		return number(v, 8)
+	case bool:
+		return strings.ToUpper(strconv.FormatBool(v))
+	case time.Time:
+		return v.Format(time.DateOnly)
+	}
+	return strconv.Quote(fmt.Sprint(v))
+}
+
+// hledgerValue formats a tag value. Tag values end at a comma, so commas in
+// notes become semicolons.
+func hledgerValue(v any) string {
+	switch v := v.(type) {
+	case float64:
+		return number(v, 8)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.Format(time.DateOnly)
+	}
+	return strings.NewReplacer(",", ";", "\n", " ").Replace(fmt.Sprint(v))
+}
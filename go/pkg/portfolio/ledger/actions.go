@@ -0,0 +1,182 @@
+package ledger
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+func (t Transaction) validateAction() error {
+	if t.Symbol == "" {
+		return fmt.Errorf("%w: %s requires a symbol", ErrInvalidTransaction, t.Type)
+	}
+	if t.CashInLieu && t.Price <= 0 {
+		return fmt.Errorf("%w: cash in lieu requires the price of the resulting shares", ErrInvalidTransaction)
+	}
+	if t.Price < 0 || t.Amount < 0 || t.Ratio < 0 {
+		return fmt.Errorf("%w: %s price, cash amount and ratio must not be negative", ErrInvalidTransaction, t.Type)
+	}
+	needsNewSymbol := t.Type != TxSplit && !(t.Type == TxMerger && t.Ratio == 0)
+	if needsNewSymbol && (t.NewSymbol == "" || t.NewSymbol == t.Symbol) {
+		return fmt.Errorf("%w: %s requires a new symbol different from %s", ErrInvalidTransaction, t.Type, t.Symbol)
+	}
+	switch t.Type {
+	case TxSplit:
+		if t.Ratio <= 0 {
+			return fmt.Errorf("%w: split requires a positive ratio", ErrInvalidTransaction)
+		}
+	case TxSpinOff:
+		if t.Ratio <= 0 || t.BasisFraction < 0 || t.BasisFraction >= 1 {
+			return fmt.Errorf("%w: spin-off requires a positive ratio and a basis fraction within [0, 1)", ErrInvalidTransaction)
+		}
+	case TxMerger:
+		if t.Ratio == 0 && t.Amount == 0 {
+			return fmt.Errorf("%w: merger requires a share ratio, cash per share or both", ErrInvalidTransaction)
+		}
+		if t.Ratio > 0 && t.Amount > 0 && t.Price <= 0 {
+			return fmt.Errorf("%w: cash and stock merger requires the price of the new shares to allocate basis", ErrInvalidTransaction)
+		}
+	}
+	return nil
+}
+
+// resulting returns the symbol and quantity an action leaves in place of qty
+// shares of the action's symbol.
+func (t Transaction) resulting(qty float64) (string, float64) {
+	switch t.Type {
+	case TxSplit:
+		return t.Symbol, qty * t.Ratio
+	case TxSymbolChange:
+		return t.NewSymbol, qty
+	default:
+		return t.NewSymbol, qty * t.Ratio
+	}
+}
+
+// fraction returns the part of qty paid out as cash in lieu, with the sign
+// of qty.
+func (t Transaction) fraction(qty float64) float64 {
+	if !t.CashInLieu {
+		return 0
+	}
+	abs := math.Abs(qty)
+	frac := abs - math.Floor(abs+1e-9)
+	if frac < 1e-9 {
+		return 0
+	}
+	return math.Copysign(frac, qty)
+}
+
+// applyAction applies a corporate action to the quantities of one account
+// and returns the cash it pays. A short position is adjusted alike: it owes
+// the resulting shares and pays the cash a holder would receive.
+func applyAction(t Transaction, quantities map[string]float64) float64 {
+	held := quantities[t.Symbol]
+	if math.Abs(held) <= 1e-9 {
+		return 0
+	}
+	symbol, qty := t.resulting(held)
+	if t.Type != TxSpinOff {
+		delete(quantities, t.Symbol)
+	}
+	cash := 0.0
+	if t.Type == TxMerger {
+		cash += held * t.Amount
+	}
+	if math.Abs(qty) > 1e-9 {
+		frac := t.fraction(qty)
+		addQuantity(quantities, symbol, qty-frac)
+		cash += frac * t.Price
+	}
+	return cash
+}
+
+// applyActionToLots adjusts the open lots of one account for a corporate
+// action. It returns the lots created, in acquisition order, and the
+// disposals for cash paid. Lots are modified in place. Short lots are
+// adjusted alike, with negative quantities and basis; cash they pay covers
+// part of the short sale.
+func applyActionToLots(t Transaction, held []*Lot) ([]*Lot, []Disposal) {
+	var created []*Lot
+	var disposals []Disposal
+	// dispose records the cash paid for qty shares of lot, worth basis. For
+	// short lots all three are negative, and the disposal is a cover: the
+	// short sale proceeds are relieved at the cost of the cash paid.
+	dispose := func(lot *Lot, qty, proceeds, basis float64) {
+		d := Disposal{
+			LotID:     lot.ID,
+			SaleID:    t.ID,
+			Account:   lot.Account,
+			Symbol:    lot.Symbol,
+			Acquired:  lot.Acquired,
+			Disposed:  t.Date,
+			Quantity:  qty,
+			Proceeds:  proceeds,
+			CostBasis: basis,
+		}
+		if qty < 0 {
+			d.Quantity, d.Proceeds, d.CostBasis, d.Short = -qty, -basis, -proceeds, true
+		}
+		disposals = append(disposals, d)
+	}
+
+	total := 0.0
+	for _, lot := range held {
+		if math.Abs(lot.Quantity) <= 1e-9 {
+			continue
+		}
+		symbol, qty := t.resulting(lot.Quantity)
+		switch t.Type {
+		case TxSplit, TxSymbolChange:
+			lot.Symbol, lot.Quantity = symbol, qty
+			created = append(created, lot)
+		case TxSpinOff:
+			basis := lot.CostBasis * t.BasisFraction
+			lot.CostBasis -= basis
+			created = append(created, &Lot{
+				ID:        lot.ID + "/" + t.ID,
+				Account:   lot.Account,
+				Symbol:    symbol,
+				Acquired:  lot.Acquired,
+				Quantity:  qty,
+				CostBasis: basis,
+			})
+		case TxMerger:
+			cash := lot.Quantity * t.Amount
+			cashBasis := lot.CostBasis
+			if math.Abs(qty) > 1e-9 {
+				cashBasis *= cash / (cash + qty*t.Price)
+			}
+			if math.Abs(cash) > 1e-9 {
+				dispose(lot, lot.Quantity, cash, cashBasis)
+			}
+			lot.CostBasis -= cashBasis
+			if math.Abs(qty) > 1e-9 {
+				created = append(created, &Lot{
+					ID:        lot.ID,
+					Account:   lot.Account,
+					Symbol:    symbol,
+					Acquired:  lot.Acquired,
+					Quantity:  qty,
+					CostBasis: lot.CostBasis,
+				})
+			}
+			lot.Quantity, lot.CostBasis = 0, 0
+		}
+		total += qty
+	}
+
+	// Fractional shares are paid out of the most recently acquired lots.
+	frac := t.fraction(total)
+	for i := len(created) - 1; i >= 0 && math.Abs(frac) > 1e-9; i-- {
+		lot := created[i]
+		qty := math.Copysign(math.Min(math.Abs(frac), math.Abs(lot.Quantity)), frac)
+		basis := lot.UnitCost() * qty
+		dispose(lot, qty, qty*t.Price, basis)
+		lot.Quantity -= qty
+		lot.CostBasis -= basis
+		frac -= qty
+	}
+	sort.SliceStable(created, func(i, j int) bool { return created[i].Acquired.Before(created[j].Acquired) })
+	return created, disposals
+}
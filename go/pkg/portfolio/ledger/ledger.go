@@ -0,0 +1,364 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TxType identifies the kind of a ledger transaction.
+type TxType string
+
+const (
+	TxDeposit    TxType = "deposit"
+	TxWithdrawal TxType = "withdrawal"
+	TxBuy        TxType = "buy"
+	TxSell       TxType = "sell"
+	TxDividend   TxType = "dividend"
+	TxInterest   TxType = "interest"
+	TxFee        TxType = "fee"
+
+	// Margin account charges.
+
+	// TxMarginInterest is interest charged on a margin loan.
+	TxMarginInterest TxType = "margin_interest"
+	// TxBorrowFee is the fee for borrowing Symbol to hold it short.
+	TxBorrowFee TxType = "borrow_fee"
+
+	// Corporate actions apply to every account holding Symbol.
+
+	// TxSplit multiplies holdings by Ratio; a ratio below one is a reverse split.
+	TxSplit TxType = "split"
+	// TxSymbolChange renames Symbol to NewSymbol.
+	TxSymbolChange TxType = "symbol_change"
+	// TxSpinOff distributes Ratio shares of NewSymbol per share held and moves
+	// BasisFraction of the cost basis to them.
+	TxSpinOff TxType = "spin_off"
+	// TxMerger exchanges each share for Ratio shares of NewSymbol plus Amount
+	// in cash.
+	TxMerger TxType = "merger"
+)
+
+var (
+	// ErrInvalidTransaction is returned when a transaction fails validation.
+	ErrInvalidTransaction = errors.New("ledger: invalid transaction")
+	// ErrInsufficientQuantity is returned when a sell exceeds the held quantity
+	// outside a margin account.
+	ErrInsufficientQuantity = errors.New("ledger: insufficient quantity")
+)
+
+// Transaction is a single entry in the portfolio ledger.
+type Transaction struct {
+	ID   string
+	Date time.Time
+	Type TxType
+	// Account holding the cash and position; empty means the default account.
+	Account  string
+	Symbol   string
+	Quantity float64
+	Price    float64
+	// Amount is the cash amount for deposits, withdrawals, income and fees.
+	// For income it is the gross amount before withholding.
+	Amount float64
+	Fee    float64
+	Note   string
+
+	// Income details. Date is the pay date of dividends and interest.
+	ExDate      time.Time
+	Withholding float64
+	// Qualified marks dividends taxed at qualified rates.
+	Qualified bool
+
+	// Corporate action terms. Price is the market price of the resulting
+	// shares on the effective date, used for cash in lieu and to split basis
+	// between stock and cash in mergers.
+	NewSymbol     string
+	Ratio         float64
+	BasisFraction float64
+	// CashInLieu pays out fractional resulting shares at Price.
+	CashInLieu bool
+}
+
+// IsCorporateAction reports whether the transaction is a corporate action.
+func (t Transaction) IsCorporateAction() bool {
+	switch t.Type {
+	case TxSplit, TxSymbolChange, TxSpinOff, TxMerger:
+		return true
+	}
+	return false
+}
+
+// CashEffect returns the signed change in cash caused by the transaction.
+// The cash of corporate actions depends on holdings and is applied on replay.
+func (t Transaction) CashEffect() float64 {
+	switch t.Type {
+	case TxDeposit:
+		return t.Amount
+	case TxDividend, TxInterest:
+		return t.Amount - t.Withholding
+	case TxWithdrawal, TxFee, TxMarginInterest, TxBorrowFee:
+		return -t.Amount
+	case TxBuy:
+		return -(t.Quantity*t.Price + t.Fee)
+	case TxSell:
+		return t.Quantity*t.Price - t.Fee
+	}
+	return 0
+}
+
+// ExternalFlow returns the money moved into (positive) or out of (negative)
+// the portfolio by the transaction. Trades and income are internal.
+func (t Transaction) ExternalFlow() float64 {
+	switch t.Type {
+	case TxDeposit:
+		return t.Amount
+	case TxWithdrawal:
+		return -t.Amount
+	}
+	return 0
+}
+
+// Validate checks the transaction on its own, without regard to holdings.
+func (t Transaction) Validate() error {
+	if t.Date.IsZero() {
+		return fmt.Errorf("%w: missing date", ErrInvalidTransaction)
+	}
+	switch t.Type {
+	case TxDeposit, TxWithdrawal, TxDividend, TxInterest, TxFee, TxMarginInterest, TxBorrowFee:
+		if t.Amount <= 0 {
+			return fmt.Errorf("%w: %s amount must be positive", ErrInvalidTransaction, t.Type)
+		}
+		if t.Type == TxBorrowFee && t.Symbol == "" {
+			return fmt.Errorf("%w: borrow fee requires a symbol", ErrInvalidTransaction)
+		}
+		if t.Withholding < 0 || t.Withholding > t.Amount {
+			return fmt.Errorf("%w: withholding must be between zero and the gross amount", ErrInvalidTransaction)
+		}
+		if t.Type == TxDividend && t.Symbol == "" {
+			return fmt.Errorf("%w: dividend requires a symbol", ErrInvalidTransaction)
+		}
+		if !t.ExDate.IsZero() && t.ExDate.After(t.Date) {
+			return fmt.Errorf("%w: ex-date must not be after the pay date", ErrInvalidTransaction)
+		}
+	case TxBuy, TxSell:
+		if t.Symbol == "" {
+			return fmt.Errorf("%w: %s requires a symbol", ErrInvalidTransaction, t.Type)
+		}
+		if t.Quantity <= 0 || t.Price < 0 || t.Fee < 0 {
+			return fmt.Errorf("%w: %s requires positive quantity and non-negative price and fee", ErrInvalidTransaction, t.Type)
+		}
+	case TxSplit, TxSymbolChange, TxSpinOff, TxMerger:
+		return t.validateAction()
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidTransaction, t.Type)
+	}
+	return nil
+}
+
+// Snapshot is the state of the ledger at a point in time.
+type Snapshot struct {
+	Cash       float64
+	Quantities map[string]float64
+	// Accounts breaks cash and quantities down by account.
+	Accounts map[string]*AccountSnapshot
+}
+
+// AccountSnapshot is the state of a single account at a point in time.
+type AccountSnapshot struct {
+	Cash       float64
+	Quantities map[string]float64
+}
+
+// Ledger is an append-only, date-ordered record of portfolio transactions.
+type Ledger struct {
+	mu       sync.RWMutex
+	currency string
+	txns     []Transaction
+	seq      int
+	// margin holds the accounts allowed to sell short.
+	margin map[string]bool
+}
+
+// New returns an empty ledger denominated in currency.
+func New(currency string) *Ledger {
+	return &Ledger{currency: currency, margin: map[string]bool{}}
+}
+
+// SetMarginAccount allows or disallows short sales in account.
+func (l *Ledger) SetMarginAccount(account string, enabled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if enabled {
+		l.margin[account] = true
+	} else {
+		delete(l.margin, account)
+	}
+}
+
+// IsMarginAccount reports whether account may hold negative quantities.
+func (l *Ledger) IsMarginAccount(account string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.margin[account]
+}
+
+// Clone returns an independent copy of the ledger, e.g. to try out
+// hypothetical transactions.
+func (l *Ledger) Clone() *Ledger {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	c := &Ledger{currency: l.currency, txns: append([]Transaction(nil), l.txns...), seq: l.seq, margin: map[string]bool{}}
+	for account := range l.margin {
+		c.margin[account] = true
+	}
+	return c
+}
+
+// Account returns a copy of the ledger holding only the transactions of
+// account, with the corporate actions that apply to its positions, e.g. to
+// value or measure the returns of one account.
+func (l *Ledger) Account(account string) *Ledger {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	c := &Ledger{currency: l.currency, seq: l.seq, margin: map[string]bool{}}
+	for _, tx := range l.txns {
+		if tx.IsCorporateAction() || tx.Account == account {
+			c.txns = append(c.txns, tx)
+		}
+	}
+	if l.margin[account] {
+		c.margin[account] = true
+	}
+	return c
+}
+
+// Currency returns the base currency of the ledger.
+func (l *Ledger) Currency() string {
+	return l.currency
+}
+
+// Post validates tx and records it in date order, assigning an ID when the
+// caller did not provide one.
+func (l *Ledger) Post(tx Transaction) (Transaction, error) {
+	posted, err := l.PostAll(tx)
+	if err != nil {
+		return Transaction{}, err
+	}
+	return posted[0], nil
+}
+
+// PostAll posts txs as one: either every transaction is recorded or, when one
+// is invalid or sells more than is held, none is. Sales are checked against
+// the transactions before them, including earlier ones of txs.
+func (l *Ledger) PostAll(txs ...Transaction) ([]Transaction, error) {
+	for _, tx := range txs {
+		if err := tx.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// A single transaction fails before it changes anything, so only
+	// batches work on a copy.
+	txns, seq := l.txns, l.seq
+	if len(txs) > 1 {
+		txns = append([]Transaction(nil), l.txns...)
+	}
+	posted := make([]Transaction, len(txs))
+	for i, tx := range txs {
+		if tx.Type == TxSell && !l.margin[tx.Account] {
+			held := 0.0
+			if acct, ok := snapshot(txns, tx.Date).Accounts[tx.Account]; ok {
+				held = acct.Quantities[tx.Symbol]
+			}
+			if tx.Quantity > held+1e-9 {
+				return nil, fmt.Errorf("%w: selling %g %s with %g held", ErrInsufficientQuantity, tx.Quantity, tx.Symbol, held)
+			}
+		}
+
+		seq++
+		if tx.ID == "" {
+			tx.ID = fmt.Sprintf("tx-%06d", seq)
+		}
+		j := sort.Search(len(txns), func(j int) bool { return txns[j].Date.After(tx.Date) })
+		txns = append(txns, Transaction{})
+		copy(txns[j+1:], txns[j:])
+		txns[j] = tx
+		posted[i] = tx
+	}
+	l.txns, l.seq = txns, seq
+	return posted, nil
+}
+
+// Transactions returns a copy of all transactions in date order.
+func (l *Ledger) Transactions() []Transaction {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return append([]Transaction(nil), l.txns...)
+}
+
+// Inception returns the date of the first transaction, or the zero time for an
+// empty ledger.
+func (l *Ledger) Inception() time.Time {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if len(l.txns) == 0 {
+		return time.Time{}
+	}
+	return l.txns[0].Date
+}
+
+// Snapshot returns cash and position quantities after all transactions dated
+// on or before asOf.
+func (l *Ledger) Snapshot(asOf time.Time) Snapshot {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return snapshot(l.txns, asOf)
+}
+
+func snapshot(txns []Transaction, asOf time.Time) Snapshot {
+	s := Snapshot{Quantities: map[string]float64{}, Accounts: map[string]*AccountSnapshot{}}
+	for _, tx := range txns {
+		if tx.Date.After(asOf) {
+			break
+		}
+		if tx.IsCorporateAction() {
+			for _, acct := range s.Accounts {
+				cash := applyAction(tx, acct.Quantities)
+				acct.Cash += cash
+				s.Cash += cash
+			}
+			continue
+		}
+		acct, ok := s.Accounts[tx.Account]
+		if !ok {
+			acct = &AccountSnapshot{Quantities: map[string]float64{}}
+			s.Accounts[tx.Account] = acct
+		}
+		s.Cash += tx.CashEffect()
+		acct.Cash += tx.CashEffect()
+		switch tx.Type {
+		case TxBuy:
+			addQuantity(acct.Quantities, tx.Symbol, tx.Quantity)
+		case TxSell:
+			addQuantity(acct.Quantities, tx.Symbol, -tx.Quantity)
+		}
+	}
+	for _, acct := range s.Accounts {
+		for symbol, qty := range acct.Quantities {
+			addQuantity(s.Quantities, symbol, qty)
+		}
+	}
+	return s
+}
+
+func addQuantity(quantities map[string]float64, symbol string, qty float64) {
+	quantities[symbol] += qty
+	if math.Abs(quantities[symbol]) < 1e-9 {
+		delete(quantities, symbol)
+	}
+}
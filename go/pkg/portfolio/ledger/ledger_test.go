@@ -0,0 +1,305 @@
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func testLedger(t *testing.T) *Ledger {
+	l := New("USD")
+	for _, tx := range []Transaction{
+		{Date: date("2025-01-02"), Type: TxDeposit, Amount: 5000},
+		{Date: date("2025-01-03"), Type: TxBuy, Symbol: "ABC", Quantity: 10, Price: 100, Fee: 10},
+		{Date: date("2025-06-03"), Type: TxBuy, Symbol: "ABC", Quantity: 10, Price: 150},
+		{Date: date("2026-02-01"), Type: TxSell, Symbol: "ABC", Quantity: 15, Price: 200, Fee: 15},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	return l
+}
+
+func TestPostValidation(t *testing.T) {
+	l := testLedger(t)
+
+	_, err := l.Post(Transaction{Date: date("2026-02-02"), Type: TxBuy, Quantity: 1, Price: 1})
+	assert.ErrorIs(t, err, ErrInvalidTransaction)
+	_, err = l.Post(Transaction{Date: date("2026-02-02"), Type: TxDeposit})
+	assert.ErrorIs(t, err, ErrInvalidTransaction)
+	_, err = l.Post(Transaction{Date: date("2026-02-02"), Type: TxSell, Symbol: "ABC", Quantity: 6, Price: 1})
+	assert.ErrorIs(t, err, ErrInsufficientQuantity)
+	_, err = l.Post(Transaction{Date: date("2026-02-02"), Type: TxSell, Account: "ira", Symbol: "ABC", Quantity: 1, Price: 1})
+	assert.ErrorIs(t, err, ErrInsufficientQuantity)
+}
+
+func TestSnapshot(t *testing.T) {
+	l := testLedger(t)
+
+	snap := l.Snapshot(date("2025-12-31"))
+	assert.InDelta(t, 5000-1010-1500, snap.Cash, 1e-9)
+	assert.Equal(t, map[string]float64{"ABC": 20}, snap.Quantities)
+
+	snap = l.Snapshot(date("2026-02-01"))
+	assert.InDelta(t, 2490+2985, snap.Cash, 1e-9)
+	assert.Equal(t, map[string]float64{"ABC": 5}, snap.Quantities)
+	assert.Equal(t, date("2025-01-02"), l.Inception())
+}
+
+func TestClone(t *testing.T) {
+	l := testLedger(t)
+	l.SetMarginAccount("margin", true)
+
+	c := l.Clone()
+	_, err := c.Post(Transaction{Date: date("2026-02-02"), Type: TxWithdrawal, Amount: 100})
+	require.NoError(t, err)
+
+	assert.Len(t, l.Transactions(), 4, "the original is unchanged")
+	assert.Len(t, c.Transactions(), 5)
+	assert.True(t, c.IsMarginAccount("margin"))
+}
+
+func TestAccount(t *testing.T) {
+	// Arrange
+	l := New("USD")
+	l.SetMarginAccount("margin", true)
+	for _, tx := range []Transaction{
+		{Date: date("2025-01-02"), Type: TxDeposit, Amount: 5000},
+		{Date: date("2025-01-02"), Type: TxDeposit, Account: "ira", Amount: 3000},
+		{Date: date("2025-01-03"), Type: TxBuy, Symbol: "ABC", Quantity: 10, Price: 100},
+		{Date: date("2025-01-03"), Type: TxBuy, Account: "ira", Symbol: "ABC", Quantity: 20, Price: 100},
+		{Date: date("2025-06-02"), Type: TxSplit, Symbol: "ABC", Ratio: 2},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+
+	// Act
+	ira := l.Account("ira")
+	snap := ira.Snapshot(date("2025-12-31"))
+
+	// Assert
+	assert.Len(t, ira.Transactions(), 3, "the account's transactions and the split")
+	assert.InDelta(t, 1000, snap.Cash, 1e-9)
+	assert.Equal(t, map[string]float64{"ABC": 40}, snap.Quantities)
+	assert.Equal(t, "USD", ira.Currency())
+	assert.False(t, ira.IsMarginAccount("margin"))
+	assert.True(t, l.Account("margin").IsMarginAccount("margin"))
+	assert.Len(t, l.Transactions(), 5, "the original is unchanged")
+}
+
+func TestLotsFIFO(t *testing.T) {
+	l := testLedger(t)
+
+	lots, disposals := l.Lots(date("2026-02-01"))
+
+	require.Len(t, disposals, 2)
+	assert.InDelta(t, 10, disposals[0].Quantity, 1e-9)
+	assert.InDelta(t, 1010, disposals[0].CostBasis, 1e-9)
+	assert.InDelta(t, 1990, disposals[0].Proceeds, 1e-9)
+	assert.True(t, disposals[0].LongTerm())
+	assert.InDelta(t, 5, disposals[1].Quantity, 1e-9)
+	assert.InDelta(t, 750, disposals[1].CostBasis, 1e-9)
+	assert.False(t, disposals[1].LongTerm())
+
+	require.Len(t, lots, 1)
+	assert.Equal(t, date("2025-06-03"), lots[0].Acquired)
+	assert.InDelta(t, 5, lots[0].Quantity, 1e-9)
+	assert.InDelta(t, 150, lots[0].UnitCost(), 1e-9)
+}
+
+func TestCorporateActions(t *testing.T) {
+	// Arrange
+	l := New("USD")
+	for _, tx := range []Transaction{
+		{Date: date("2025-01-02"), Type: TxDeposit, Amount: 10000},
+		{Date: date("2025-01-03"), Type: TxBuy, Symbol: "ABC", Quantity: 10, Price: 100},
+		{Date: date("2025-03-03"), Type: TxBuy, Symbol: "ABC", Quantity: 5, Price: 120},
+		{Date: date("2025-06-02"), Type: TxSplit, Symbol: "ABC", Ratio: 1.5, Price: 80, CashInLieu: true},
+		{Date: date("2025-07-01"), Type: TxSymbolChange, Symbol: "ABC", NewSymbol: "ABD"},
+		{Date: date("2025-08-01"), Type: TxSpinOff, Symbol: "ABD", NewSymbol: "SPN", Ratio: 0.5, BasisFraction: 0.2},
+		{Date: date("2025-09-01"), Type: TxMerger, Symbol: "SPN", NewSymbol: "BIG", Ratio: 0.5, Amount: 10, Price: 40},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+
+	// Act
+	snap := l.Snapshot(date("2025-12-31"))
+	lots, disposals := l.Lots(date("2025-12-31"))
+
+	// Assert
+	assert.Equal(t, map[string]float64{"ABD": 22, "BIG": 5.5}, snap.Quantities)
+	assert.InDelta(t, 10000-1600+40+75+35, snap.Cash, 1e-9)
+
+	require.Len(t, lots, 4)
+	assert.Equal(t, "ABD", lots[0].Symbol)
+	assert.InDelta(t, 15, lots[0].Quantity, 1e-9)
+	assert.InDelta(t, 800, lots[0].CostBasis, 1e-9)
+	assert.InDelta(t, 7, lots[1].Quantity, 1e-9)
+	assert.InDelta(t, 448, lots[1].CostBasis, 1e-9)
+	assert.Equal(t, "BIG", lots[2].Symbol)
+	assert.Equal(t, date("2025-01-03"), lots[2].Acquired)
+	assert.InDelta(t, 3.75, lots[2].Quantity, 1e-9)
+	assert.InDelta(t, 200-200.0/3, lots[2].CostBasis, 1e-9)
+
+	// Cash in lieu for half a share, then the cash part of the merger.
+	require.Len(t, disposals, 3)
+	assert.InDelta(t, 0.5, disposals[0].Quantity, 1e-9)
+	assert.InDelta(t, 40, disposals[0].Proceeds, 1e-9)
+	assert.InDelta(t, 40, disposals[0].CostBasis, 1e-9)
+	assert.Equal(t, "SPN", disposals[1].Symbol)
+	assert.InDelta(t, 75, disposals[1].Proceeds, 1e-9)
+	assert.InDelta(t, 200.0/3, disposals[1].CostBasis, 1e-9)
+
+	// Reverse splits shrink holdings; invalid terms are rejected.
+	_, err := l.Post(Transaction{Date: date("2025-10-01"), Type: TxSplit, Symbol: "ABD", Ratio: 0.1})
+	require.NoError(t, err)
+	assert.InDelta(t, 2.2, l.Snapshot(date("2025-10-01")).Quantities["ABD"], 1e-9)
+	_, err = l.Post(Transaction{Date: date("2025-10-01"), Type: TxSplit, Symbol: "ABD"})
+	assert.ErrorIs(t, err, ErrInvalidTransaction)
+	_, err = l.Post(Transaction{Date: date("2025-10-01"), Type: TxSpinOff, Symbol: "ABD", Ratio: 1})
+	assert.ErrorIs(t, err, ErrInvalidTransaction)
+}
+
+func TestIncomeWithholding(t *testing.T) {
+	l := testLedger(t)
+
+	// Withholding reduces the cash received.
+	_, err := l.Post(Transaction{Date: date("2025-03-15"), ExDate: date("2025-03-01"), Type: TxDividend, Symbol: "ABC", Amount: 100, Withholding: 15})
+	require.NoError(t, err)
+	assert.InDelta(t, 5000-1010+85, l.Snapshot(date("2025-03-31")).Cash, 1e-9)
+
+	_, err = l.Post(Transaction{Date: date("2025-03-15"), Type: TxDividend, Symbol: "ABC", Amount: 10, Withholding: 11})
+	assert.ErrorIs(t, err, ErrInvalidTransaction)
+	_, err = l.Post(Transaction{Date: date("2025-03-15"), Type: TxDividend, Amount: 10})
+	assert.ErrorIs(t, err, ErrInvalidTransaction)
+	_, err = l.Post(Transaction{Date: date("2025-03-15"), ExDate: date("2025-03-16"), Type: TxDividend, Symbol: "ABC", Amount: 10})
+	assert.ErrorIs(t, err, ErrInvalidTransaction)
+}
+
+func TestShortSales(t *testing.T) {
+	// Arrange
+	l := New("USD")
+	l.SetMarginAccount("margin", true)
+	for _, tx := range []Transaction{
+		{Date: date("2025-01-02"), Type: TxDeposit, Account: "margin", Amount: 5000},
+		{Date: date("2025-01-03"), Type: TxSell, Account: "margin", Symbol: "XYZ", Quantity: 10, Price: 100, Fee: 10},
+		{Date: date("2025-02-03"), Type: TxBorrowFee, Account: "margin", Symbol: "XYZ", Amount: 4},
+		{Date: date("2026-03-03"), Type: TxBuy, Account: "margin", Symbol: "XYZ", Quantity: 15, Price: 80},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+
+	// Act
+	short := l.Snapshot(date("2025-06-30"))
+	lots, _ := l.Lots(date("2025-06-30"))
+	after, disposals := l.Lots(date("2026-03-31"))
+
+	// Assert
+	assert.InDelta(t, 5000+990-4, short.Cash, 1e-9)
+	assert.Equal(t, map[string]float64{"XYZ": -10}, short.Quantities)
+	require.Len(t, lots, 1)
+	assert.True(t, lots[0].Short())
+	assert.InDelta(t, -990, lots[0].CostBasis, 1e-9)
+
+	require.Len(t, disposals, 1)
+	d := disposals[0]
+	assert.True(t, d.Short)
+	assert.InDelta(t, 990-800, d.Gain(), 1e-9)
+	assert.False(t, d.LongTerm(), "short sale gains are short-term")
+	require.Len(t, after, 1)
+	assert.InDelta(t, 5, after[0].Quantity, 1e-9)
+	assert.InDelta(t, 400, after[0].CostBasis, 1e-9)
+
+	_, err := l.Post(Transaction{Date: date("2026-04-01"), Type: TxSell, Symbol: "XYZ", Quantity: 1, Price: 80})
+	assert.ErrorIs(t, err, ErrInsufficientQuantity, "only margin accounts may sell short")
+}
+
+func TestCorporateActionsOnShorts(t *testing.T) {
+	// Arrange
+	l := New("USD")
+	l.SetMarginAccount("margin", true)
+	for _, tx := range []Transaction{
+		{Date: date("2025-01-02"), Type: TxDeposit, Account: "margin", Amount: 5000},
+		{Date: date("2025-01-03"), Type: TxSell, Account: "margin", Symbol: "XYZ", Quantity: 100, Price: 50},
+		{Date: date("2025-03-03"), Type: TxSplit, Symbol: "XYZ", Ratio: 2},
+		{Date: date("2025-04-01"), Type: TxBuy, Account: "margin", Symbol: "XYZ", Quantity: 200, Price: 25},
+		{Date: date("2025-05-01"), Type: TxSell, Account: "margin", Symbol: "ABC", Quantity: 10, Price: 100},
+		{Date: date("2025-06-02"), Type: TxMerger, Symbol: "ABC", NewSymbol: "BIG", Ratio: 0.55, Amount: 20, Price: 160, CashInLieu: true},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+
+	// Act
+	split := l.Snapshot(date("2025-03-31"))
+	splitLots, _ := l.Lots(date("2025-03-31"))
+	snap := l.Snapshot(date("2025-06-30"))
+	lots, disposals := l.Lots(date("2025-06-30"))
+
+	// Assert: the short doubles as the price halves, so covering at the
+	// unchanged value books no gain.
+	assert.Equal(t, map[string]float64{"XYZ": -200}, split.Quantities)
+	require.Len(t, splitLots, 1)
+	assert.InDelta(t, -200, splitLots[0].Quantity, 1e-9)
+	assert.InDelta(t, -5000, splitLots[0].CostBasis, 1e-9)
+	require.Len(t, disposals, 3)
+	assert.True(t, disposals[0].Short)
+	assert.InDelta(t, 0, disposals[0].Gain(), 1e-9)
+
+	// The merger turns 10 short ABC into 5.5 short BIG: 5 owed and 0.5 paid
+	// in lieu, with 20 a share paid in cash.
+	assert.Equal(t, map[string]float64{"BIG": -5}, snap.Quantities)
+	assert.InDelta(t, 5000+5000-5000+1000-10*20-0.5*160, snap.Cash, 1e-9)
+	require.Len(t, lots, 1)
+	assert.Equal(t, "BIG", lots[0].Symbol)
+	assert.InDelta(t, -5, lots[0].Quantity, 1e-9)
+	cash, stock := disposals[1], disposals[2]
+	assert.True(t, cash.Short)
+	assert.InDelta(t, 10, cash.Quantity, 1e-9)
+	assert.InDelta(t, 200, cash.CostBasis, 1e-9)
+	assert.InDelta(t, 1000*200/(200+5.5*160), cash.Proceeds, 1e-9)
+	assert.True(t, stock.Short)
+	assert.InDelta(t, 0.5, stock.Quantity, 1e-9)
+	assert.InDelta(t, 80, stock.CostBasis, 1e-9)
+	assert.InDelta(t, -lots[0].CostBasis+stock.Proceeds+cash.Proceeds, 1000, 1e-9, "the short sale proceeds are all accounted for")
+}
+
+func TestPostAll(t *testing.T) {
+	// Arrange
+	l := New("USD")
+	_, err := l.Post(Transaction{Date: date("2025-01-02"), Type: TxDeposit, Amount: 1000})
+	require.NoError(t, err)
+
+	// Act
+	_, oversold := l.PostAll(
+		Transaction{Date: date("2025-01-03"), Type: TxBuy, Symbol: "XYZ", Quantity: 5, Price: 10},
+		Transaction{Date: date("2025-01-04"), Type: TxSell, Symbol: "XYZ", Quantity: 6, Price: 10},
+	)
+	_, invalid := l.PostAll(
+		Transaction{Date: date("2025-01-03"), Type: TxBuy, Symbol: "XYZ", Quantity: 5, Price: 10},
+		Transaction{Date: date("2025-01-04"), Type: TxBuy, Symbol: "XYZ", Quantity: -1, Price: 10},
+	)
+	posted, err := l.PostAll(
+		Transaction{Date: date("2025-01-03"), Type: TxBuy, Symbol: "XYZ", Quantity: 5, Price: 10},
+		Transaction{Date: date("2025-01-04"), Type: TxSell, Symbol: "XYZ", Quantity: 5, Price: 12},
+	)
+
+	// Assert: failed batches post nothing.
+	assert.ErrorIs(t, oversold, ErrInsufficientQuantity)
+	assert.Error(t, invalid)
+	require.NoError(t, err)
+	require.Len(t, posted, 2)
+	assert.Equal(t, []string{"tx-000002", "tx-000003"}, []string{posted[0].ID, posted[1].ID})
+	assert.Len(t, l.Transactions(), 3)
+	assert.InDelta(t, 1010, l.Snapshot(date("2025-01-31")).Cash, 1e-9)
+}
@@ -0,0 +1,221 @@
+package ledger
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// HeldLongTerm reports whether a holding acquired on acquired has been held
+// for more than one year on asOf, counting calendar years so that a holding
+// bought on 2023-03-01 is still short-term on 2024-03-01.
+func HeldLongTerm(acquired, asOf time.Time) bool {
+	return asOf.After(acquired.AddDate(1, 0, 0))
+}
+
+// Lot is an open tax lot created by a purchase, or by a short sale in a
+// margin account.
+type Lot struct {
+	// ID is the ID of the acquiring transaction.
+	ID       string
+	Account  string
+	Symbol   string
+	Acquired time.Time
+	// Quantity is negative for short lots.
+	Quantity float64
+	// CostBasis is the total cost of the remaining quantity, including fees.
+	// For short lots it is minus the net proceeds of the short sale.
+	CostBasis float64
+}
+
+// Short reports whether the lot is a short position.
+func (l Lot) Short() bool {
+	return l.Quantity < 0
+}
+
+// UnitCost returns the cost basis per unit.
+func (l Lot) UnitCost() float64 {
+	if l.Quantity == 0 {
+		return 0
+	}
+	return l.CostBasis / l.Quantity
+}
+
+// LongTerm reports whether the lot has been held long enough at asOf for
+// gains to be long-term.
+func (l Lot) LongTerm(asOf time.Time) bool {
+	return HeldLongTerm(l.Acquired, asOf)
+}
+
+// Disposal is the part of a lot relieved by a sale.
+type Disposal struct {
+	LotID    string
+	SaleID   string
+	Account  string
+	Symbol   string
+	Acquired time.Time
+	Disposed time.Time
+	Quantity float64
+	Proceeds float64
+	// CostBasis is the basis relieved from the lot.
+	CostBasis float64
+	// Short marks the covering of a short lot: Acquired is the short sale
+	// date, Proceeds come from the short sale and CostBasis is the cost to
+	// cover.
+	Short bool
+}
+
+// Gain returns the realized gain or loss.
+func (d Disposal) Gain() float64 {
+	return d.Proceeds - d.CostBasis
+}
+
+// LongTerm reports whether the disposal is a long-term gain or loss. Gains on
+// short sales are always short-term.
+func (d Disposal) LongTerm() bool {
+	return !d.Short && HeldLongTerm(d.Acquired, d.Disposed)
+}
+
+// Lots replays transactions dated on or before asOf and returns the open lots
+// and the disposals made so far. Sales relieve lots first-in, first-out within
+// the selling account; in margin accounts the rest opens a short lot, which
+// later purchases cover first. Corporate actions carry the acquisition date of the
+// original lots over to the resulting shares.
+func (l *Ledger) Lots(asOf time.Time) ([]Lot, []Disposal) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return lots(l.txns, asOf)
+}
+
+func lots(txns []Transaction, asOf time.Time) ([]Lot, []Disposal) {
+	type key struct{ account, symbol string }
+	open := map[key][]*Lot{}
+	var order []*Lot
+	var disposals []Disposal
+
+	for _, tx := range txns {
+		if tx.Date.After(asOf) {
+			break
+		}
+		if tx.IsCorporateAction() {
+			var keys []key
+			for k := range open {
+				if k.symbol == tx.Symbol {
+					keys = append(keys, k)
+				}
+			}
+			sort.Slice(keys, func(i, j int) bool { return keys[i].account < keys[j].account })
+			for _, k := range keys {
+				held := open[k]
+				created, relieved := applyActionToLots(tx, held)
+				disposals = append(disposals, relieved...)
+				existing := map[*Lot]bool{}
+				for _, lot := range held {
+					existing[lot] = true
+				}
+				if tx.Type != TxSpinOff {
+					delete(open, k)
+				}
+				for _, lot := range created {
+					if !existing[lot] {
+						order = append(order, lot)
+					}
+					nk := key{k.account, lot.Symbol}
+					open[nk] = append(open[nk], lot)
+					sort.SliceStable(open[nk], func(i, j int) bool { return open[nk][i].Acquired.Before(open[nk][j].Acquired) })
+				}
+			}
+			continue
+		}
+		k := key{tx.Account, tx.Symbol}
+		switch tx.Type {
+		case TxBuy:
+			remaining := tx.Quantity
+			costPerUnit := (tx.Quantity*tx.Price + tx.Fee) / tx.Quantity
+			for _, lot := range open[k] {
+				if remaining <= 1e-9 {
+					break
+				}
+				if lot.Quantity >= -1e-9 {
+					continue
+				}
+				qty := math.Min(-lot.Quantity, remaining)
+				proceeds := lot.UnitCost() * qty
+				disposals = append(disposals, Disposal{
+					LotID:     lot.ID,
+					SaleID:    tx.ID,
+					Account:   tx.Account,
+					Symbol:    tx.Symbol,
+					Acquired:  lot.Acquired,
+					Disposed:  tx.Date,
+					Quantity:  qty,
+					Proceeds:  proceeds,
+					CostBasis: costPerUnit * qty,
+					Short:     true,
+				})
+				lot.CostBasis += proceeds
+				lot.Quantity += qty
+				remaining -= qty
+			}
+			if remaining > 1e-9 {
+				lot := &Lot{
+					ID:        tx.ID,
+					Account:   tx.Account,
+					Symbol:    tx.Symbol,
+					Acquired:  tx.Date,
+					Quantity:  remaining,
+					CostBasis: costPerUnit * remaining,
+				}
+				open[k] = append(open[k], lot)
+				order = append(order, lot)
+			}
+		case TxSell:
+			remaining := tx.Quantity
+			netPerUnit := (tx.Quantity*tx.Price - tx.Fee) / tx.Quantity
+			for _, lot := range open[k] {
+				if remaining <= 1e-9 {
+					break
+				}
+				if lot.Quantity <= 1e-9 {
+					continue
+				}
+				qty := math.Min(lot.Quantity, remaining)
+				basis := lot.UnitCost() * qty
+				disposals = append(disposals, Disposal{
+					LotID:     lot.ID,
+					SaleID:    tx.ID,
+					Account:   tx.Account,
+					Symbol:    tx.Symbol,
+					Acquired:  lot.Acquired,
+					Disposed:  tx.Date,
+					Quantity:  qty,
+					Proceeds:  netPerUnit * qty,
+					CostBasis: basis,
+				})
+				lot.CostBasis -= basis
+				lot.Quantity -= qty
+				remaining -= qty
+			}
+			if remaining > 1e-9 {
+				lot := &Lot{
+					ID:        tx.ID,
+					Account:   tx.Account,
+					Symbol:    tx.Symbol,
+					Acquired:  tx.Date,
+					Quantity:  -remaining,
+					CostBasis: -netPerUnit * remaining,
+				}
+				open[k] = append(open[k], lot)
+				order = append(order, lot)
+			}
+		}
+	}
+
+	var res []Lot
+	for _, lot := range order {
+		if math.Abs(lot.Quantity) > 1e-9 {
+			res = append(res, *lot)
+		}
+	}
+	return res, disposals
+}
@@ -0,0 +1,190 @@
+package margin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+)
+
+// ErrInvalidTerms is returned for margin terms that cannot be applied.
+var ErrInvalidTerms = errors.New("margin: invalid terms")
+
+// DayCountBasis is the number of days a year interest and borrow fees accrue
+// over, as brokers conventionally charge.
+const DayCountBasis = 360
+
+// Terms are the margin requirements and financing rates of an account. Rates
+// and requirements are decimal fractions. Terms are loaded from the
+// application config, hence the mapstructure tags.
+type Terms struct {
+	Account string `mapstructure:"account"`
+	// InitialRequirement is the equity required to open positions, as a
+	// share of their value (Reg T: 0.5).
+	InitialRequirement float64 `mapstructure:"initial_requirement"`
+	// MaintenanceRequirement is the equity required to keep long positions.
+	MaintenanceRequirement float64 `mapstructure:"maintenance_requirement"`
+	// ShortMaintenanceRequirement is the equity required to keep short
+	// positions.
+	ShortMaintenanceRequirement float64 `mapstructure:"short_maintenance_requirement"`
+	// LoanRate is the annual interest rate on a negative cash balance.
+	LoanRate float64 `mapstructure:"loan_rate"`
+	// BorrowRate is the annual fee for borrowing shares held short, unless
+	// BorrowRates has a rate for the symbol.
+	BorrowRate  float64            `mapstructure:"borrow_rate"`
+	BorrowRates map[string]float64 `mapstructure:"borrow_rates"`
+}
+
+// WithDefaults fills unset requirements with common broker defaults: 50%
+// initial, 25% long maintenance and 30% short maintenance. Symbols of
+// BorrowRates are upper-cased, as configuration keys may not keep their case.
+func (t Terms) WithDefaults() Terms {
+	if len(t.BorrowRates) > 0 {
+		rates := make(map[string]float64, len(t.BorrowRates))
+		for sym, r := range t.BorrowRates {
+			rates[strings.ToUpper(strings.TrimSpace(sym))] = r
+		}
+		t.BorrowRates = rates
+	}
+	if t.InitialRequirement == 0 {
+		t.InitialRequirement = 0.5
+	}
+	if t.MaintenanceRequirement == 0 {
+		t.MaintenanceRequirement = 0.25
+	}
+	if t.ShortMaintenanceRequirement == 0 {
+		t.ShortMaintenanceRequirement = 0.30
+	}
+	return t
+}
+
+// Validate checks the terms.
+func (t Terms) Validate() error {
+	for name, v := range map[string]float64{
+		"initial requirement":           t.InitialRequirement,
+		"maintenance requirement":       t.MaintenanceRequirement,
+		"short maintenance requirement": t.ShortMaintenanceRequirement,
+	} {
+		if v <= 0 || v > 1 {
+			return fmt.Errorf("%w: %s: %s must be within (0, 1]", ErrInvalidTerms, t.Account, name)
+		}
+	}
+	if t.LoanRate < 0 || t.BorrowRate < 0 {
+		return fmt.Errorf("%w: %s: rates must not be negative", ErrInvalidTerms, t.Account)
+	}
+	for sym, r := range t.BorrowRates {
+		if r < 0 {
+			return fmt.Errorf("%w: %s: borrow rate of %s must not be negative", ErrInvalidTerms, t.Account, sym)
+		}
+	}
+	return nil
+}
+
+// borrowRate returns the borrow fee rate of symbol.
+func (t Terms) borrowRate(symbol string) float64 {
+	if r, ok := t.BorrowRates[symbol]; ok {
+		return r
+	}
+	return t.BorrowRate
+}
+
+// Status is the margin position of an account.
+type Status struct {
+	Cash float64
+	// LongValue and ShortValue are the market values of long and short
+	// positions; ShortValue is positive.
+	LongValue  float64
+	ShortValue float64
+	// Equity is cash plus long value less short value.
+	Equity float64
+	// LoanBalance is the margin loan, i.e. the negative part of cash.
+	LoanBalance            float64
+	InitialRequirement     float64
+	MaintenanceRequirement float64
+	// MaintenanceExcess is equity above the maintenance requirement; negative
+	// means a margin call.
+	MaintenanceExcess float64
+	// BuyingPower is the value of new positions equity above the initial
+	// requirement supports.
+	BuyingPower float64
+	MarginCall  bool
+}
+
+// Evaluate computes the margin status of an account with cash and position
+// values keyed by symbol (negative for short positions).
+func Evaluate(t Terms, cash float64, values map[string]float64) Status {
+	st := Status{Cash: cash}
+	for _, v := range values {
+		if v >= 0 {
+			st.LongValue += v
+		} else {
+			st.ShortValue -= v
+		}
+	}
+	st.Equity = cash + st.LongValue - st.ShortValue
+	if cash < 0 {
+		st.LoanBalance = -cash
+	}
+	st.InitialRequirement = t.InitialRequirement * (st.LongValue + st.ShortValue)
+	st.MaintenanceRequirement = t.MaintenanceRequirement*st.LongValue + t.ShortMaintenanceRequirement*st.ShortValue
+	st.MaintenanceExcess = st.Equity - st.MaintenanceRequirement
+	st.BuyingPower = max((st.Equity-st.InitialRequirement)/t.InitialRequirement, 0)
+	st.MarginCall = st.Equity < st.MaintenanceRequirement
+	return st
+}
+
+// Accrual is interest and borrow fees accrued but not yet charged.
+type Accrual struct {
+	Interest   float64
+	BorrowFees map[string]float64
+}
+
+// Charges accrues margin interest on the loan balance and borrow fees on short
+// positions of an account for each calendar day after from up to and
+// including to. Completed months are returned as charges dated on the last day
+// of the month; the current month is returned as pending.
+func Charges(l *ledger.Ledger, t Terms, prices marketdata.Provider, from, to time.Time) ([]ledger.Transaction, Accrual, error) {
+	var charges []ledger.Transaction
+	pending := Accrual{BorrowFees: map[string]float64{}}
+	for day := marketdata.Day(from).AddDate(0, 0, 1); !day.After(to); day = day.AddDate(0, 0, 1) {
+		acct, ok := l.Snapshot(day).Accounts[t.Account]
+		if ok {
+			if acct.Cash < 0 {
+				pending.Interest += -acct.Cash * t.LoanRate / DayCountBasis
+			}
+			for sym, qty := range acct.Quantities {
+				if qty >= 0 || t.borrowRate(sym) == 0 {
+					continue
+				}
+				px, err := prices.Close(sym, day)
+				if err != nil {
+					return nil, Accrual{}, err
+				}
+				pending.BorrowFees[sym] += -qty * px * t.borrowRate(sym) / DayCountBasis
+			}
+		}
+		if day.AddDate(0, 0, 1).Day() != 1 {
+			continue
+		}
+		// Month end: charge what accrued.
+		if pending.Interest > 0 {
+			charges = append(charges, ledger.Transaction{Date: day, Type: ledger.TxMarginInterest, Account: t.Account, Amount: pending.Interest, Note: "margin interest"})
+		}
+		symbols := make([]string, 0, len(pending.BorrowFees))
+		for sym := range pending.BorrowFees {
+			symbols = append(symbols, sym)
+		}
+		sort.Strings(symbols)
+		for _, sym := range symbols {
+			if fee := pending.BorrowFees[sym]; fee > 0 {
+				charges = append(charges, ledger.Transaction{Date: day, Type: ledger.TxBorrowFee, Account: t.Account, Symbol: sym, Amount: fee, Note: "stock borrow fee"})
+			}
+		}
+		pending = Accrual{BorrowFees: map[string]float64{}}
+	}
+	return charges, pending, nil
+}
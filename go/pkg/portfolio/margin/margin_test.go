@@ -0,0 +1,100 @@
+package margin
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func TestEvaluate(t *testing.T) {
+	// Arrange
+	terms := Terms{Account: "margin"}.WithDefaults()
+
+	// Act: 10,000 long bought with 4,000 borrowed, and 2,000 short.
+	st := Evaluate(terms, -4000+2000, map[string]float64{"ABC": 10000, "XYZ": -2000})
+
+	// Assert
+	assert.InDelta(t, 6000, st.Equity, 1e-9)
+	assert.InDelta(t, 2000, st.LoanBalance, 1e-9)
+	assert.InDelta(t, 0.25*10000+0.30*2000, st.MaintenanceRequirement, 1e-9)
+	assert.InDelta(t, 6000-3100, st.MaintenanceExcess, 1e-9)
+	assert.InDelta(t, (6000-6000)/0.5, st.BuyingPower, 1e-9)
+	assert.False(t, st.MarginCall)
+
+	st = Evaluate(terms, -8000, map[string]float64{"ABC": 10000})
+	assert.True(t, st.MarginCall)
+	assert.InDelta(t, 0, st.BuyingPower, 1e-9)
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Terms{Account: "m"}.WithDefaults().Validate())
+	assert.ErrorIs(t, Terms{Account: "m", InitialRequirement: 1.5}.WithDefaults().Validate(), ErrInvalidTerms)
+	assert.ErrorIs(t, Terms{Account: "m", LoanRate: -0.01}.WithDefaults().Validate(), ErrInvalidTerms)
+}
+
+func TestTermsFromConfig(t *testing.T) {
+	// Arrange: viper lowercases the keys of maps it loads.
+	v := viper.New()
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+portfolio:
+  margin-accounts:
+    - account: margin
+      borrow_rate: 0.01
+      borrow_rates:
+        GME: 0.25
+`)))
+	var terms []Terms
+	require.NoError(t, v.UnmarshalKey("portfolio.margin-accounts", &terms))
+	require.Len(t, terms, 1)
+
+	// Act
+	got := terms[0].WithDefaults()
+
+	// Assert
+	assert.Equal(t, map[string]float64{"GME": 0.25}, got.BorrowRates)
+	assert.InDelta(t, 0.25, got.borrowRate("GME"), 1e-9)
+	assert.InDelta(t, 0.01, got.borrowRate("AAPL"), 1e-9)
+}
+
+func TestCharges(t *testing.T) {
+	// Arrange
+	l := ledger.New("USD")
+	l.SetMarginAccount("margin", true)
+	for _, tx := range []ledger.Transaction{
+		{Date: date("2025-01-01"), Type: ledger.TxDeposit, Account: "margin", Amount: 1000},
+		{Date: date("2025-01-01"), Type: ledger.TxBuy, Account: "margin", Symbol: "ABC", Quantity: 36, Price: 100},
+		{Date: date("2025-01-01"), Type: ledger.TxSell, Account: "margin", Symbol: "XYZ", Quantity: 10, Price: 100},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	prices := marketdata.NewStore()
+	prices.Put("XYZ", marketdata.Bar{Date: date("2025-01-01"), Close: 100})
+	terms := Terms{Account: "margin", LoanRate: 0.09, BorrowRate: 0.036}.WithDefaults()
+
+	// Act
+	charges, pending, err := Charges(l, terms, prices, date("2025-01-01"), date("2025-02-10"))
+
+	// Assert: cash is 1000 - 3600 + 1000 = -1600 and 1000 is held short.
+	require.NoError(t, err)
+	require.Len(t, charges, 2)
+	assert.Equal(t, ledger.TxMarginInterest, charges[0].Type)
+	assert.Equal(t, date("2025-01-31"), charges[0].Date)
+	assert.InDelta(t, 30*1600*0.09/360, charges[0].Amount, 1e-9)
+	assert.Equal(t, ledger.TxBorrowFee, charges[1].Type)
+	assert.InDelta(t, 30*1000*0.036/360, charges[1].Amount, 1e-9)
+	assert.InDelta(t, 10*1600*0.09/360, pending.Interest, 1e-9)
+	assert.InDelta(t, 10*1000*0.036/360, pending.BorrowFees["XYZ"], 1e-9)
+}
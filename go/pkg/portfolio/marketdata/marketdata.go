@@ -0,0 +1,164 @@
+package marketdata
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrNoData is returned when no price is available for a symbol and date.
+var ErrNoData = errors.New("marketdata: no price data")
+
+// Bar is a daily OHLCV price bar.
+type Bar struct {
+	Date   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// Provider supplies historical prices to the valuation and analytics code.
+type Provider interface {
+	// Bars returns the daily bars for symbol dated within [from, to].
+	Bars(symbol string, from, to time.Time) ([]Bar, error)
+	// Close returns the last closing price on or before date.
+	Close(symbol string, date time.Time) (float64, error)
+}
+
+// Day truncates t to midnight UTC, the key used for daily bars.
+func Day(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// IsTradingDay reports whether t falls on a weekday.
+func IsTradingDay(t time.Time) bool {
+	wd := t.Weekday()
+	return wd != time.Saturday && wd != time.Sunday
+}
+
+// TradingDays returns every trading day within [from, to].
+func TradingDays(from, to time.Time) []time.Time {
+	var days []time.Time
+	for d := Day(from); !d.After(Day(to)); d = d.AddDate(0, 0, 1) {
+		if IsTradingDay(d) {
+			days = append(days, d)
+		}
+	}
+	return days
+}
+
+// Store is an in-memory Provider holding locally stored price history.
+type Store struct {
+	mu   sync.RWMutex
+	bars map[string][]Bar
+}
+
+// NewStore returns an empty price store.
+func NewStore() *Store {
+	return &Store{bars: map[string][]Bar{}}
+}
+
+// Put merges bars into the history of symbol, replacing bars with the same date.
+func (s *Store) Put(symbol string, bars ...Bar) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	byDate := make(map[time.Time]Bar, len(s.bars[symbol])+len(bars))
+	for _, b := range s.bars[symbol] {
+		byDate[b.Date] = b
+	}
+	for _, b := range bars {
+		b.Date = Day(b.Date)
+		byDate[b.Date] = b
+	}
+	merged := make([]Bar, 0, len(byDate))
+	for _, b := range byDate {
+		merged = append(merged, b)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Date.Before(merged[j].Date) })
+	s.bars[symbol] = merged
+}
+
+// Tick applies a live trade price to the bar of the trading day containing at.
+func (s *Store) Tick(symbol string, price float64, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	day := Day(at)
+	hist := s.bars[symbol]
+	if n := len(hist); n > 0 && hist[n-1].Date.Equal(day) {
+		b := &hist[n-1]
+		b.Close = price
+		b.High = math.Max(b.High, price)
+		b.Low = math.Min(b.Low, price)
+		return
+	}
+	s.bars[symbol] = append(hist, Bar{Date: day, Open: price, High: price, Low: price, Close: price})
+}
+
+// Symbols returns the symbols with stored history, sorted.
+func (s *Store) Symbols() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	symbols := make([]string, 0, len(s.bars))
+	for sym := range s.bars {
+		symbols = append(symbols, sym)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// Bars implements Provider.
+func (s *Store) Bars(symbol string, from, to time.Time) ([]Bar, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	hist, ok := s.bars[symbol]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoData, symbol)
+	}
+	from, to = Day(from), Day(to)
+	lo := sort.Search(len(hist), func(i int) bool { return !hist[i].Date.Before(from) })
+	hi := sort.Search(len(hist), func(i int) bool { return hist[i].Date.After(to) })
+	return append([]Bar(nil), hist[lo:hi]...), nil
+}
+
+// Close implements Provider.
+func (s *Store) Close(symbol string, date time.Time) (float64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	hist := s.bars[symbol]
+	day := Day(date)
+	i := sort.Search(len(hist), func(i int) bool { return hist[i].Date.After(day) })
+	if i == 0 {
+		return 0, fmt.Errorf("%w: %s on %s", ErrNoData, symbol, day.Format(time.DateOnly))
+	}
+	return hist[i-1].Close, nil
+}
+
+// RandomWalk generates deterministic daily bars over the trading days in
+// [from, to] following a geometric random walk with the given annual drift and
+// volatility. It is used to seed demo and test price history.
+func RandomWalk(seed int64, from, to time.Time, start, drift, vol float64) []Bar {
+	rng := rand.New(rand.NewSource(seed))
+	dt := 1.0 / 252
+	price := start
+	var bars []Bar
+	for _, day := range TradingDays(from, to) {
+		open := price
+		price *= math.Exp((drift-vol*vol/2)*dt + vol*math.Sqrt(dt)*rng.NormFloat64())
+		bars = append(bars, Bar{
+			Date:   day,
+			Open:   open,
+			High:   math.Max(open, price),
+			Low:    math.Min(open, price),
+			Close:  price,
+			Volume: float64(1e6 + rng.Intn(1e6)),
+		})
+	}
+	return bars
+}
@@ -0,0 +1,200 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+)
+
+// ErrInvalidContract is returned for option contracts with missing or
+// inconsistent terms.
+var ErrInvalidContract = errors.New("options: invalid contract")
+
+// Kind is the right an option grants.
+type Kind string
+
+const (
+	Call Kind = "call"
+	Put  Kind = "put"
+)
+
+// Contract holds the terms of an option. Ledger quantities are contracts and
+// ledger prices are per contract, i.e. the premium times the multiplier.
+type Contract struct {
+	Underlying string
+	Kind       Kind
+	Strike     float64
+	Expiry     time.Time
+	Multiplier float64
+	// Future marks options on futures, valued with Black-76 and cash settled.
+	Future bool
+}
+
+// Validate checks the contract terms.
+func (c Contract) Validate() error {
+	if c.Underlying == "" {
+		return fmt.Errorf("%w: missing underlying", ErrInvalidContract)
+	}
+	if c.Kind != Call && c.Kind != Put {
+		return fmt.Errorf("%w: type must be call or put", ErrInvalidContract)
+	}
+	if c.Strike <= 0 || c.Multiplier <= 0 {
+		return fmt.Errorf("%w: strike and multiplier must be positive", ErrInvalidContract)
+	}
+	if c.Expiry.IsZero() {
+		return fmt.Errorf("%w: missing expiry", ErrInvalidContract)
+	}
+	return nil
+}
+
+// Intrinsic is the exercise value per unit of the underlying.
+func (c Contract) Intrinsic(spot float64) float64 {
+	if c.Kind == Call {
+		return math.Max(spot-c.Strike, 0)
+	}
+	return math.Max(c.Strike-spot, 0)
+}
+
+// Greeks are the model price and sensitivities per unit of the underlying.
+// Theta is per calendar day and vega per volatility point (0.01).
+type Greeks struct {
+	Price float64
+	Delta float64
+	Gamma float64
+	Theta float64
+	Vega  float64
+}
+
+// Value prices the option with Black-Scholes, or Black-76 for options on
+// futures where spot is the futures price. vol and rate are annual decimal
+// fractions; time to expiry counts calendar days from asOf.
+func Value(c Contract, spot, vol, rate float64, asOf time.Time) Greeks {
+	t := c.Expiry.Sub(asOf).Hours() / 24 / 365
+	if t <= 0 || vol <= 0 || spot <= 0 {
+		g := Greeks{Price: c.Intrinsic(spot)}
+		if g.Price > 0 {
+			g.Delta = 1
+			if c.Kind == Put {
+				g.Delta = -1
+			}
+		}
+		return g
+	}
+	sqrtT := math.Sqrt(t)
+	discount := math.Exp(-rate * t)
+	d1 := (math.Log(spot/c.Strike) + (rate+vol*vol/2)*t) / (vol * sqrtT)
+	carry := 1.0
+	if c.Future {
+		// Black-76: the futures price carries no cost, both legs are discounted.
+		d1 = (math.Log(spot/c.Strike) + vol*vol/2*t) / (vol * sqrtT)
+		carry = discount
+	}
+	d2 := d1 - vol*sqrtT
+	pdf := math.Exp(-d1*d1/2) / math.Sqrt(2*math.Pi)
+
+	var g Greeks
+	if c.Kind == Call {
+		g.Price = carry*spot*cdf(d1) - c.Strike*discount*cdf(d2)
+		g.Delta = carry * cdf(d1)
+	} else {
+		g.Price = c.Strike*discount*cdf(-d2) - carry*spot*cdf(-d1)
+		g.Delta = -carry * cdf(-d1)
+	}
+	g.Gamma = carry * pdf / (spot * vol * sqrtT)
+	g.Vega = carry * spot * pdf * sqrtT / 100
+	decay := -carry * spot * pdf * vol / (2 * sqrtT)
+	switch {
+	case c.Future:
+		g.Theta = decay + rate*g.Price
+	case c.Kind == Call:
+		g.Theta = decay - rate*c.Strike*discount*cdf(d2)
+	default:
+		g.Theta = decay + rate*c.Strike*discount*cdf(-d2)
+	}
+	g.Theta /= 365
+	return g
+}
+
+func cdf(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+// Pricer is a price provider that values option contracts without market
+// quotes from the close of their underlying.
+type Pricer struct {
+	Market marketdata.Provider
+	// Contract returns the terms of an option symbol.
+	Contract func(symbol string) (Contract, bool)
+	// Volatility returns the volatility input for an underlying.
+	Volatility func(underlying string) float64
+	// Rate returns the annual risk-free rate.
+	Rate func() float64
+}
+
+// Bars implements marketdata.Provider.
+func (p *Pricer) Bars(symbol string, from, to time.Time) ([]marketdata.Bar, error) {
+	return p.Market.Bars(symbol, from, to)
+}
+
+// Close implements marketdata.Provider. Model prices are per contract.
+func (p *Pricer) Close(symbol string, date time.Time) (float64, error) {
+	px, err := p.Market.Close(symbol, date)
+	if err == nil || !errors.Is(err, marketdata.ErrNoData) {
+		return px, err
+	}
+	c, ok := p.Contract(symbol)
+	if !ok {
+		return 0, err
+	}
+	spot, err := p.Market.Close(c.Underlying, date)
+	if err != nil {
+		return 0, err
+	}
+	return Value(c, spot, p.Volatility(c.Underlying), p.Rate(), marketdata.Day(date)).Price * c.Multiplier, nil
+}
+
+// Settlement returns the transactions that close qty contracts held in
+// account at expiry; a negative qty closes written contracts. Out-of-the-money
+// options expire worthless. In-the-money options are closed at intrinsic value
+// and, unless they are futures options, exercised or assigned: the underlying
+// is bought (long calls, written puts) or sold (long puts, written calls) at
+// the expiry close, so the net cash equals paying or receiving the strike.
+// Options are cash settled when the account holds too little of the
+// underlying to sell.
+func Settlement(symbol, account string, c Contract, qty, spot, underlyingHeld float64) []ledger.Transaction {
+	intrinsic := c.Intrinsic(spot)
+	closing := ledger.Transaction{
+		Date:     c.Expiry,
+		Type:     ledger.TxSell,
+		Account:  account,
+		Symbol:   symbol,
+		Quantity: qty,
+		Price:    intrinsic * c.Multiplier,
+		Note:     "option expired worthless",
+	}
+	exercised := "option exercised"
+	if qty < 0 {
+		closing.Type, closing.Quantity = ledger.TxBuy, -qty
+		exercised = "option assigned"
+	}
+	if intrinsic == 0 {
+		return []ledger.Transaction{closing}
+	}
+	shares := closing.Quantity * c.Multiplier
+	closing.Note = exercised
+	switch {
+	case c.Future:
+		closing.Note = "option cash settled"
+		return []ledger.Transaction{closing}
+	case (c.Kind == Call) == (qty > 0):
+		return []ledger.Transaction{closing, {Date: c.Expiry, Type: ledger.TxBuy, Account: account, Symbol: c.Underlying, Quantity: shares, Price: spot, Note: "assigned from " + symbol}}
+	case underlyingHeld >= shares:
+		return []ledger.Transaction{closing, {Date: c.Expiry, Type: ledger.TxSell, Account: account, Symbol: c.Underlying, Quantity: shares, Price: spot, Note: "assigned from " + symbol}}
+	}
+	closing.Note = "option cash settled"
+	return []ledger.Transaction{closing}
+}
@@ -0,0 +1,112 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func TestValueBlackScholes(t *testing.T) {
+	// Arrange
+	asOf := date("2025-01-01")
+	call := Contract{Underlying: "ABC", Kind: Call, Strike: 100, Expiry: asOf.AddDate(0, 0, 365), Multiplier: 100}
+	put := call
+	put.Kind = Put
+
+	// Act
+	c := Value(call, 100, 0.2, 0.05, asOf)
+	p := Value(put, 100, 0.2, 0.05, asOf)
+
+	// Assert
+	assert.InDelta(t, 10.4506, c.Price, 1e-4)
+	assert.InDelta(t, 5.5735, p.Price, 1e-4)
+	assert.InDelta(t, 0.6368, c.Delta, 1e-4)
+	assert.InDelta(t, c.Delta-1, p.Delta, 1e-12)
+	assert.InDelta(t, 0.018762, c.Gamma, 1e-6)
+	assert.InDelta(t, 0.37524, c.Vega, 1e-5)
+	assert.InDelta(t, -6.4140/365, c.Theta, 1e-5)
+}
+
+func TestValueBlack76(t *testing.T) {
+	asOf := date("2025-01-01")
+	call := Contract{Underlying: "ES", Kind: Call, Strike: 100, Expiry: asOf.AddDate(0, 0, 365), Multiplier: 50, Future: true}
+	put := call
+	put.Kind = Put
+
+	c := Value(call, 100, 0.2, 0.05, asOf)
+	p := Value(put, 100, 0.2, 0.05, asOf)
+
+	// At the money the call and put are worth the same.
+	assert.InDelta(t, 7.5771, c.Price, 1e-4)
+	assert.InDelta(t, c.Price, p.Price, 1e-9)
+	assert.InDelta(t, c.Delta-p.Delta, 0.951229, 1e-6)
+}
+
+func TestPricer(t *testing.T) {
+	// Arrange
+	market := marketdata.NewStore()
+	market.Put("ABC", marketdata.Bar{Date: date("2025-01-02"), Close: 120})
+	contract := Contract{Underlying: "ABC", Kind: Call, Strike: 100, Expiry: date("2025-01-02"), Multiplier: 100}
+	p := &Pricer{
+		Market:     market,
+		Contract:   func(symbol string) (Contract, bool) { return contract, symbol == "ABC250102C100" },
+		Volatility: func(string) float64 { return 0.3 },
+		Rate:       func() float64 { return 0.04 },
+	}
+
+	// Act
+	px, err := p.Close("ABC250102C100", date("2025-01-02"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, 2000, px, 1e-9)
+	_, err = p.Close("XYZ", date("2025-01-02"))
+	assert.ErrorIs(t, err, marketdata.ErrNoData)
+}
+
+func TestSettlement(t *testing.T) {
+	call := Contract{Underlying: "ABC", Kind: Call, Strike: 100, Expiry: date("2025-03-21"), Multiplier: 100}
+	put := call
+	put.Kind = Put
+
+	expired := Settlement("C100", "", call, 2, 90, 0)
+	require.Len(t, expired, 1)
+	assert.Equal(t, ledger.TxSell, expired[0].Type)
+	assert.InDelta(t, 0, expired[0].Price, 1e-12)
+
+	assigned := Settlement("C100", "", call, 2, 110, 0)
+	require.Len(t, assigned, 2)
+	assert.InDelta(t, 1000, assigned[0].Price, 1e-12)
+	assert.Equal(t, ledger.Transaction{Date: call.Expiry, Type: ledger.TxBuy, Symbol: "ABC", Quantity: 200, Price: 110, Note: "assigned from C100"}, assigned[1])
+	cash := 2*assigned[0].Price - 200*110
+	assert.InDelta(t, -200*100, cash, 1e-9, "net cash pays the strike")
+
+	assert.Len(t, Settlement("P100", "", put, 1, 90, 100), 2)
+	assert.Len(t, Settlement("P100", "", put, 1, 90, 50), 1)
+
+	// Written options are bought back; assignment reverses the trade.
+	worthless := Settlement("C100", "", call, -2, 90, 0)
+	require.Len(t, worthless, 1)
+	assert.Equal(t, ledger.TxBuy, worthless[0].Type)
+	assert.InDelta(t, 2, worthless[0].Quantity, 1e-12)
+	assert.InDelta(t, 0, worthless[0].Price, 1e-12)
+
+	written := Settlement("P100", "", put, -2, 90, 0)
+	require.Len(t, written, 2)
+	assert.Equal(t, ledger.Transaction{Date: put.Expiry, Type: ledger.TxBuy, Symbol: "P100", Quantity: 2, Price: 1000, Note: "option assigned"}, written[0])
+	assert.Equal(t, ledger.Transaction{Date: put.Expiry, Type: ledger.TxBuy, Symbol: "ABC", Quantity: 200, Price: 90, Note: "assigned from P100"}, written[1])
+	cash = -2*written[0].Price - 200*90
+	assert.InDelta(t, -200*100, cash, 1e-9, "net cash pays the strike")
+
+	assert.Equal(t, ledger.TxSell, Settlement("C100", "", call, -1, 110, 100)[1].Type)
+	assert.Len(t, Settlement("C100", "", call, -1, 110, 50), 1)
+}
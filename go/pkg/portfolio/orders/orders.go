@@ -0,0 +1,243 @@
+package orders
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+)
+
+// ErrInvalidOrder is returned for orders that cannot be accepted.
+var ErrInvalidOrder = errors.New("orders: invalid order")
+
+// Side is the direction of an order.
+type Side string
+
+// Order sides.
+const (
+	Buy  Side = "buy"
+	Sell Side = "sell"
+)
+
+// Type is the execution condition of an order.
+type Type string
+
+// Order types.
+const (
+	// Market orders fill at the next tick.
+	Market Type = "market"
+	// Limit orders fill at the limit price or better.
+	Limit Type = "limit"
+	// Stop orders become market orders once the stop price trades.
+	Stop Type = "stop"
+	// StopLimit orders become limit orders once the stop price trades.
+	StopLimit Type = "stop_limit"
+)
+
+// TimeInForce is how long an order stays working.
+type TimeInForce string
+
+// Times in force.
+const (
+	// Day orders expire at the end of the trading day they were placed.
+	Day TimeInForce = "day"
+	// GTC orders work until filled or cancelled.
+	GTC TimeInForce = "gtc"
+)
+
+// Status is the lifecycle state of an order.
+type Status string
+
+// Order statuses. Every status but Open is final.
+const (
+	Open      Status = "open"
+	Filled    Status = "filled"
+	Cancelled Status = "cancelled"
+	Expired   Status = "expired"
+	Rejected  Status = "rejected"
+)
+
+// Order is a paper trading order.
+type Order struct {
+	ID          string
+	Account     string
+	Symbol      string
+	Side        Side
+	Type        Type
+	Quantity    float64
+	LimitPrice  float64
+	StopPrice   float64
+	TimeInForce TimeInForce
+	Created     time.Time
+
+	Status Status
+	// Triggered marks stop and stop-limit orders whose stop price traded.
+	Triggered bool
+	// Updated is the time of the last status change.
+	Updated time.Time
+	// FillPrice and Commission are set once filled.
+	FillPrice  float64
+	Commission float64
+	// Reason explains rejections.
+	Reason string
+}
+
+// Validate checks the order terms.
+func (o Order) Validate() error {
+	if o.Symbol == "" {
+		return fmt.Errorf("%w: symbol is required", ErrInvalidOrder)
+	}
+	if o.Side != Buy && o.Side != Sell {
+		return fmt.Errorf("%w: unknown side %q", ErrInvalidOrder, o.Side)
+	}
+	if o.Quantity <= 0 {
+		return fmt.Errorf("%w: quantity must be positive", ErrInvalidOrder)
+	}
+	if o.TimeInForce != Day && o.TimeInForce != GTC {
+		return fmt.Errorf("%w: unknown time in force %q", ErrInvalidOrder, o.TimeInForce)
+	}
+	switch o.Type {
+	case Market:
+	case Limit:
+		if o.LimitPrice <= 0 {
+			return fmt.Errorf("%w: limit orders need a positive limit price", ErrInvalidOrder)
+		}
+	case Stop:
+		if o.StopPrice <= 0 {
+			return fmt.Errorf("%w: stop orders need a positive stop price", ErrInvalidOrder)
+		}
+	case StopLimit:
+		if o.LimitPrice <= 0 || o.StopPrice <= 0 {
+			return fmt.Errorf("%w: stop-limit orders need positive stop and limit prices", ErrInvalidOrder)
+		}
+	default:
+		return fmt.Errorf("%w: unknown order type %q", ErrInvalidOrder, o.Type)
+	}
+	return nil
+}
+
+// Done reports whether the order reached a final status.
+func (o Order) Done() bool {
+	return o.Status != Open
+}
+
+// Slippage moves fill prices against the order: by Bps basis points of the
+// price plus Fixed per unit. Fills of limit orders never go through the limit.
+type Slippage struct {
+	Bps   float64 `mapstructure:"bps"`
+	Fixed float64 `mapstructure:"fixed"`
+}
+
+// Apply returns the price a side pays or receives when price trades.
+func (s Slippage) Apply(side Side, price float64) float64 {
+	slip := price*s.Bps/10000 + s.Fixed
+	if side == Sell {
+		return max(price-slip, 0)
+	}
+	return price + slip
+}
+
+// Commission charges PerOrder plus PerShare per unit plus Rate of the traded
+// value, but at least Minimum.
+type Commission struct {
+	PerOrder float64 `mapstructure:"per_order"`
+	PerShare float64 `mapstructure:"per_share"`
+	Rate     float64 `mapstructure:"rate"`
+	Minimum  float64 `mapstructure:"minimum"`
+}
+
+// Fee returns the commission of a fill.
+func (c Commission) Fee(quantity, price float64) float64 {
+	return max(c.PerOrder+c.PerShare*quantity+c.Rate*quantity*price, c.Minimum)
+}
+
+// Simulator matches paper orders against market ticks. It is loaded from the
+// application config, hence the mapstructure tags.
+type Simulator struct {
+	Slippage   Slippage   `mapstructure:"slippage"`
+	Commission Commission `mapstructure:"commission"`
+}
+
+// Validate checks the cost models.
+func (sim Simulator) Validate() error {
+	for name, v := range map[string]float64{
+		"slippage bps":         sim.Slippage.Bps,
+		"slippage fixed":       sim.Slippage.Fixed,
+		"commission per order": sim.Commission.PerOrder,
+		"commission per share": sim.Commission.PerShare,
+		"commission rate":      sim.Commission.Rate,
+		"commission minimum":   sim.Commission.Minimum,
+	} {
+		if v < 0 {
+			return fmt.Errorf("%w: %s must not be negative", ErrInvalidOrder, name)
+		}
+	}
+	return nil
+}
+
+// Expire expires an open day order placed before the day of at and reports
+// whether it did.
+func Expire(o *Order, at time.Time) bool {
+	if o.Done() || o.TimeInForce != Day || !marketdata.Day(at).After(marketdata.Day(o.Created)) {
+		return false
+	}
+	o.Status, o.Updated = Expired, at
+	return true
+}
+
+// Match offers a tick at price to an open order. Day orders placed on an
+// earlier day expire instead. It updates the order's status and returns true
+// when the order filled or expired.
+func (sim Simulator) Match(o *Order, price float64, at time.Time) bool {
+	if o.Done() {
+		return false
+	}
+	if Expire(o, at) {
+		return true
+	}
+	if (o.Type == Stop || o.Type == StopLimit) && !o.Triggered {
+		if (o.Side == Buy && price < o.StopPrice) || (o.Side == Sell && price > o.StopPrice) {
+			return false
+		}
+		o.Triggered = true
+	}
+
+	fill := sim.Slippage.Apply(o.Side, price)
+	if o.Type == Limit || o.Type == StopLimit {
+		if o.Side == Buy {
+			if price > o.LimitPrice {
+				return false
+			}
+			fill = min(fill, o.LimitPrice)
+		} else {
+			if price < o.LimitPrice {
+				return false
+			}
+			fill = max(fill, o.LimitPrice)
+		}
+	}
+	o.Status, o.Updated = Filled, at
+	o.FillPrice = fill
+	o.Commission = sim.Commission.Fee(o.Quantity, fill)
+	return true
+}
+
+// Transaction returns the ledger trade of a filled order.
+func (o Order) Transaction() ledger.Transaction {
+	typ := ledger.TxBuy
+	if o.Side == Sell {
+		typ = ledger.TxSell
+	}
+	return ledger.Transaction{
+		Date:     marketdata.Day(o.Updated),
+		Type:     typ,
+		Account:  o.Account,
+		Symbol:   o.Symbol,
+		Quantity: o.Quantity,
+		Price:    o.FillPrice,
+		Fee:      o.Commission,
+		Note:     "paper order " + o.ID,
+	}
+}
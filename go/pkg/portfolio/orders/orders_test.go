@@ -0,0 +1,98 @@
+package orders
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	ok := Order{Symbol: "ABC", Side: Buy, Type: Market, Quantity: 1, TimeInForce: Day}
+	require.NoError(t, ok.Validate())
+
+	for name, o := range map[string]Order{
+		"no symbol":       {Side: Buy, Type: Market, Quantity: 1, TimeInForce: Day},
+		"zero quantity":   {Symbol: "ABC", Side: Buy, Type: Market, TimeInForce: Day},
+		"limit no price":  {Symbol: "ABC", Side: Buy, Type: Limit, Quantity: 1, TimeInForce: GTC},
+		"stop-limit stop": {Symbol: "ABC", Side: Sell, Type: StopLimit, Quantity: 1, LimitPrice: 9, TimeInForce: GTC},
+		"bad tif":         {Symbol: "ABC", Side: Sell, Type: Market, Quantity: 1, TimeInForce: "ioc"},
+	} {
+		assert.ErrorIs(t, o.Validate(), ErrInvalidOrder, name)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	// Arrange
+	sim := Simulator{Slippage: Slippage{Bps: 10}, Commission: Commission{PerShare: 0.01, Minimum: 1}}
+	placed := time.Date(2025, 3, 3, 15, 0, 0, 0, time.UTC)
+	at := placed.Add(time.Minute)
+
+	tests := []struct {
+		name   string
+		order  Order
+		prices []float64
+		status Status
+		fill   float64
+	}{
+		{"market buy pays slippage", Order{Side: Buy, Type: Market}, []float64{100}, Filled, 100.1},
+		{"market sell receives less", Order{Side: Sell, Type: Market}, []float64{100}, Filled, 99.9},
+		{"limit buy waits", Order{Side: Buy, Type: Limit, LimitPrice: 95}, []float64{100, 96}, Open, 0},
+		{"limit buy capped at limit", Order{Side: Buy, Type: Limit, LimitPrice: 95}, []float64{100, 95}, Filled, 95},
+		{"limit sell", Order{Side: Sell, Type: Limit, LimitPrice: 105}, []float64{104, 106}, Filled, 106 * 0.999},
+		{"sell stop triggers", Order{Side: Sell, Type: Stop, StopPrice: 90}, []float64{95, 89}, Filled, 89 * 0.999},
+		{"buy stop waits", Order{Side: Buy, Type: Stop, StopPrice: 110}, []float64{100, 109}, Open, 0},
+		{"stop-limit triggered above limit", Order{Side: Buy, Type: StopLimit, StopPrice: 110, LimitPrice: 111}, []float64{112}, Open, 0},
+		{"stop-limit fills after trigger", Order{Side: Buy, Type: StopLimit, StopPrice: 110, LimitPrice: 111}, []float64{112, 110.5}, Filled, 110.5 * 1.001},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := tt.order
+			o.Symbol, o.Quantity, o.TimeInForce, o.Created, o.Status = "ABC", 200, GTC, placed, Open
+
+			// Act
+			for _, px := range tt.prices {
+				sim.Match(&o, px, at)
+			}
+
+			// Assert
+			assert.Equal(t, tt.status, o.Status)
+			assert.InDelta(t, tt.fill, o.FillPrice, 1e-9)
+			if o.Status == Filled {
+				assert.InDelta(t, 2, o.Commission, 1e-9)
+			}
+		})
+	}
+}
+
+func TestMatchExpiresDayOrders(t *testing.T) {
+	// Arrange
+	placed := time.Date(2025, 3, 3, 15, 0, 0, 0, time.UTC)
+	day := Order{Symbol: "ABC", Side: Buy, Type: Limit, LimitPrice: 90, Quantity: 1, TimeInForce: Day, Created: placed, Status: Open}
+	gtc := day
+	gtc.TimeInForce = GTC
+
+	// Act
+	next := placed.AddDate(0, 0, 1)
+	expired := Simulator{}.Match(&day, 100, next)
+	Simulator{}.Match(&gtc, 100, next)
+
+	// Assert
+	assert.True(t, expired)
+	assert.Equal(t, Expired, day.Status)
+	assert.Equal(t, Open, gtc.Status)
+	assert.False(t, Simulator{}.Match(&day, 80, next), "final orders no longer match")
+}
+
+func TestTransaction(t *testing.T) {
+	o := Order{ID: "ord-1", Account: "paper", Symbol: "ABC", Side: Sell, Quantity: 5, FillPrice: 10, Commission: 1,
+		Updated: time.Date(2025, 3, 3, 15, 0, 0, 0, time.UTC)}
+
+	tx := o.Transaction()
+
+	assert.Equal(t, ledger.TxSell, tx.Type)
+	assert.Equal(t, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), tx.Date)
+	assert.InDelta(t, 49, tx.CashEffect(), 1e-9)
+}
@@ -0,0 +1,298 @@
+package performance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+)
+
+// Period names a return measurement window.
+type Period string
+
+const (
+	Period1D        Period = "1D"
+	PeriodMTD       Period = "MTD"
+	PeriodQTD       Period = "QTD"
+	PeriodYTD       Period = "YTD"
+	Period1Y        Period = "1Y"
+	PeriodInception Period = "inception"
+	PeriodCustom    Period = "custom"
+)
+
+var (
+	// ErrInvalidPeriod is returned when a period cannot be resolved to dates.
+	ErrInvalidPeriod = errors.New("performance: invalid period")
+	// ErrNoSolution is returned when XIRR does not converge.
+	ErrNoSolution = errors.New("performance: no IRR solution")
+)
+
+// Range resolves the period to an inclusive [from, to] date range ending at
+// asOf. Custom periods use start and end; inception starts at inception.
+func (p Period) Range(asOf, inception time.Time, start, end *time.Time) (time.Time, time.Time, error) {
+	to := marketdata.Day(asOf)
+	var from time.Time
+	switch p {
+	case Period1D:
+		from = to
+	case PeriodMTD:
+		from = time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case PeriodQTD:
+		q := (int(to.Month()) - 1) / 3
+		from = time.Date(to.Year(), time.Month(q*3+1), 1, 0, 0, 0, 0, time.UTC)
+	case PeriodYTD:
+		from = time.Date(to.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	case Period1Y:
+		from = to.AddDate(-1, 0, 1)
+	case PeriodInception:
+		from = marketdata.Day(inception)
+	case PeriodCustom:
+		if start == nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: custom period requires a start date", ErrInvalidPeriod)
+		}
+		from = marketdata.Day(*start)
+		if end != nil {
+			to = marketdata.Day(*end)
+		}
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %q", ErrInvalidPeriod, p)
+	}
+	if from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: start %s is after end %s", ErrInvalidPeriod, from.Format(time.DateOnly), to.Format(time.DateOnly))
+	}
+	if !inception.IsZero() && from.Before(marketdata.Day(inception)) {
+		from = marketdata.Day(inception)
+	}
+	return from, to, nil
+}
+
+// Point is the end-of-day value of a portfolio together with the external
+// cash flow that arrived since the previous point.
+type Point struct {
+	Date  time.Time
+	Value float64
+	Flow  float64
+}
+
+// Series values the ledger at the close of every trading day in [from, to].
+// The first point is the base valuation on the last trading day before from,
+// so the series carries one more point than the number of days measured.
+func Series(l *ledger.Ledger, prices marketdata.Provider, from, to time.Time) ([]Point, error) {
+	base := marketdata.Day(from).AddDate(0, 0, -1)
+	for !marketdata.IsTradingDay(base) {
+		base = base.AddDate(0, 0, -1)
+	}
+	days := append([]time.Time{base}, marketdata.TradingDays(from, to)...)
+	if !marketdata.IsTradingDay(marketdata.Day(to)) {
+		// Flows dated on a closing weekend still belong to the period.
+		days = append(days, marketdata.Day(to))
+	}
+
+	txns := l.Transactions()
+	points := make([]Point, 0, len(days))
+	next := 0
+	for _, day := range days {
+		eod := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		flow := 0.0
+		for next < len(txns) && !txns[next].Date.After(eod) {
+			flow += txns[next].ExternalFlow()
+			next++
+		}
+		value, err := Value(l, prices, eod)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, Point{Date: day, Value: value, Flow: flow})
+	}
+	points[0].Flow = 0
+	return points, nil
+}
+
+// Value returns cash plus the market value of all positions at asOf.
+func Value(l *ledger.Ledger, prices marketdata.Provider, asOf time.Time) (float64, error) {
+	v, err := holdings.Value(l, prices, asOf)
+	if err != nil {
+		return 0, err
+	}
+	return v.Total, nil
+}
+
+// TimeWeighted chain-links daily sub-period returns so that external cash
+// flows do not count as performance. Flows are assumed to arrive at the close
+// of their day, matching trades booked at closing prices.
+func TimeWeighted(points []Point) float64 {
+	growth := 1.0
+	for _, r := range DailyReturns(points) {
+		growth *= 1 + r
+	}
+	return growth - 1
+}
+
+// DailyReturns returns the flow-adjusted return of each point after the first.
+// Days without a prior value (before inception) have a zero return.
+func DailyReturns(points []Point) []float64 {
+	if len(points) < 2 {
+		return nil
+	}
+	returns := make([]float64, len(points)-1)
+	for i := 1; i < len(points); i++ {
+		if points[i-1].Value <= 0 {
+			continue
+		}
+		returns[i-1] = (points[i].Value-points[i].Flow)/points[i-1].Value - 1
+	}
+	return returns
+}
+
+// CashFlow is a dated amount from the investor's perspective: money paid into
+// the portfolio is negative, money received (or held at the end) is positive.
+type CashFlow struct {
+	Date   time.Time
+	Amount float64
+}
+
+// XIRR returns the annualized internal rate of return of irregular flows.
+func XIRR(flows []CashFlow) (float64, error) {
+	if len(flows) < 2 {
+		return 0, fmt.Errorf("%w: need at least two cash flows", ErrNoSolution)
+	}
+	var pos, neg bool
+	for _, f := range flows {
+		pos = pos || f.Amount > 0
+		neg = neg || f.Amount < 0
+	}
+	if !pos || !neg {
+		return 0, fmt.Errorf("%w: cash flows must change sign", ErrNoSolution)
+	}
+
+	t0 := flows[0].Date
+	npv := func(r float64) (float64, float64) {
+		var v, dv float64
+		for _, f := range flows {
+			t := f.Date.Sub(t0).Hours() / 24 / 365
+			d := math.Pow(1+r, t)
+			v += f.Amount / d
+			dv -= t * f.Amount / (d * (1 + r))
+		}
+		return v, dv
+	}
+
+	// Newton's method usually converges within a few iterations.
+	r := 0.1
+	for i := 0; i < 50; i++ {
+		v, dv := npv(r)
+		if math.Abs(v) < 1e-9 {
+			return r, nil
+		}
+		if dv == 0 {
+			break
+		}
+		next := r - v/dv
+		if next <= -1 || math.IsNaN(next) || math.IsInf(next, 0) {
+			break
+		}
+		if math.Abs(next-r) < 1e-12 {
+			return next, nil
+		}
+		r = next
+	}
+
+	// Fall back to bisection over a bracket that contains a sign change.
+	lo, hi := -0.999999, 1.0
+	vlo, _ := npv(lo)
+	vhi, _ := npv(hi)
+	for vlo*vhi > 0 && hi < 1e9 {
+		hi *= 2
+		vhi, _ = npv(hi)
+	}
+	if vlo*vhi > 0 {
+		return 0, ErrNoSolution
+	}
+	for i := 0; i < 300; i++ {
+		mid := (lo + hi) / 2
+		vmid, _ := npv(mid)
+		if math.Abs(vmid) < 1e-9 || hi-lo < 1e-12 {
+			return mid, nil
+		}
+		if vmid*vlo < 0 {
+			hi = mid
+		} else {
+			lo, vlo = mid, vmid
+		}
+	}
+	return (lo + hi) / 2, nil
+}
+
+// MoneyWeighted returns the annualized money-weighted return (XIRR) of a value
+// series, treating the base value as the initial investment and the final
+// value as the terminal proceeds.
+func MoneyWeighted(points []Point) (float64, error) {
+	if len(points) < 2 {
+		return 0, fmt.Errorf("%w: need at least two valuations", ErrNoSolution)
+	}
+	flows := []CashFlow{{Date: points[0].Date, Amount: -points[0].Value}}
+	for _, p := range points[1:] {
+		if p.Flow != 0 {
+			flows = append(flows, CashFlow{Date: p.Date, Amount: -p.Flow})
+		}
+	}
+	last := points[len(points)-1]
+	flows = append(flows, CashFlow{Date: last.Date, Amount: last.Value})
+	return XIRR(flows)
+}
+
+// Returns summarizes performance over a period.
+type Returns struct {
+	Period           Period
+	From             time.Time
+	To               time.Time
+	StartValue       float64
+	EndValue         float64
+	NetContributions float64
+	// Gain is the change in value not explained by external cash flows.
+	Gain         float64
+	TimeWeighted float64
+	// AnnualizedTimeWeighted is only set for periods of at least one year.
+	AnnualizedTimeWeighted *float64
+	// MoneyWeighted is the XIRR over the period, de-annualized for periods
+	// shorter than one year.
+	MoneyWeighted *float64
+}
+
+// Compute measures time- and money-weighted returns of the ledger over
+// [from, to].
+func Compute(l *ledger.Ledger, prices marketdata.Provider, period Period, from, to time.Time) (*Returns, error) {
+	points, err := Series(l, prices, from, to)
+	if err != nil {
+		return nil, err
+	}
+	res := &Returns{
+		Period:       period,
+		From:         from,
+		To:           to,
+		StartValue:   points[0].Value,
+		EndValue:     points[len(points)-1].Value,
+		TimeWeighted: TimeWeighted(points),
+	}
+	for _, p := range points {
+		res.NetContributions += p.Flow
+	}
+	res.Gain = res.EndValue - res.StartValue - res.NetContributions
+
+	years := to.Sub(points[0].Date).Hours() / 24 / 365
+	if years >= 1 {
+		ann := math.Pow(1+res.TimeWeighted, 1/years) - 1
+		res.AnnualizedTimeWeighted = &ann
+	}
+	if irr, err := MoneyWeighted(points); err == nil {
+		if years < 1 {
+			irr = math.Pow(1+irr, years) - 1
+		}
+		res.MoneyWeighted = &irr
+	}
+	return res, nil
+}
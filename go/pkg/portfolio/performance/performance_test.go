@@ -0,0 +1,111 @@
+package performance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func TestXIRR(t *testing.T) {
+	// Arrange: invest 1000 and receive 1100 exactly one year later
+	flows := []CashFlow{
+		{Date: date("2025-01-01"), Amount: -1000},
+		{Date: date("2026-01-01"), Amount: 1100},
+	}
+
+	// Act
+	r, err := XIRR(flows)
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, 0.1, r, 1e-6)
+
+	_, err = XIRR([]CashFlow{{Date: date("2025-01-01"), Amount: -1}, {Date: date("2025-06-01"), Amount: -1}})
+	assert.ErrorIs(t, err, ErrNoSolution)
+}
+
+func TestTimeWeightedIgnoresDeposits(t *testing.T) {
+	// Arrange: the portfolio gains 10%, doubles in size through a deposit,
+	// then gains another 10%.
+	points := []Point{
+		{Value: 1000},
+		{Value: 1100},
+		{Value: 2200, Flow: 1100},
+		{Value: 2420},
+	}
+
+	// Act
+	twr := TimeWeighted(points)
+
+	// Assert
+	assert.InDelta(t, 0.21, twr, 1e-9)
+}
+
+func TestPeriodRange(t *testing.T) {
+	asOf := date("2026-05-20")
+	inception := date("2024-03-15")
+
+	tests := []struct {
+		period Period
+		from   string
+	}{
+		{Period1D, "2026-05-20"},
+		{PeriodMTD, "2026-05-01"},
+		{PeriodQTD, "2026-04-01"},
+		{PeriodYTD, "2026-01-01"},
+		{Period1Y, "2025-05-21"},
+		{PeriodInception, "2024-03-15"},
+	}
+	for _, tt := range tests {
+		from, to, err := tt.period.Range(asOf, inception, nil, nil)
+		require.NoError(t, err, tt.period)
+		assert.Equal(t, date(tt.from), from, tt.period)
+		assert.Equal(t, asOf, to, tt.period)
+	}
+
+	_, _, err := PeriodCustom.Range(asOf, inception, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestCompute(t *testing.T) {
+	// Arrange: price doubles while a deposit lands mid-period
+	prices := marketdata.NewStore()
+	prices.Put("ABC",
+		marketdata.Bar{Date: date("2026-01-05"), Close: 10},
+		marketdata.Bar{Date: date("2026-01-06"), Close: 15},
+		marketdata.Bar{Date: date("2026-01-07"), Close: 20},
+	)
+	l := ledger.New("USD")
+	for _, tx := range []ledger.Transaction{
+		{Date: date("2026-01-05"), Type: ledger.TxDeposit, Amount: 1000},
+		{Date: date("2026-01-05"), Type: ledger.TxBuy, Symbol: "ABC", Quantity: 100, Price: 10},
+		{Date: date("2026-01-06"), Type: ledger.TxDeposit, Amount: 1500},
+		{Date: date("2026-01-06"), Type: ledger.TxBuy, Symbol: "ABC", Quantity: 100, Price: 15},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+
+	// Act
+	res, err := Compute(l, prices, PeriodInception, date("2026-01-05"), date("2026-01-07"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, 0, res.StartValue, 1e-9)
+	assert.InDelta(t, 4000, res.EndValue, 1e-9)
+	assert.InDelta(t, 2500, res.NetContributions, 1e-9)
+	assert.InDelta(t, 1500, res.Gain, 1e-9)
+	assert.InDelta(t, 1.0, res.TimeWeighted, 1e-9)
+	require.NotNil(t, res.MoneyWeighted)
+	assert.Greater(t, *res.MoneyWeighted, 0.0)
+	assert.Nil(t, res.AnnualizedTimeWeighted)
+}
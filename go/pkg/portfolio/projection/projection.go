@@ -0,0 +1,226 @@
+package projection
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// ErrInvalidPlan is returned for plans that cannot be simulated.
+var ErrInvalidPlan = errors.New("projection: invalid plan")
+
+// Assumption is the capital market assumption for one asset class. Rates are
+// annual decimal fractions.
+type Assumption struct {
+	AssetClass     string
+	Weight         float64
+	ExpectedReturn float64
+	Volatility     float64
+}
+
+// DefaultAssumptions are used for asset classes without explicit assumptions.
+var DefaultAssumptions = map[string]Assumption{
+	"equity":       {AssetClass: "equity", ExpectedReturn: 0.07, Volatility: 0.16},
+	"fixed_income": {AssetClass: "fixed_income", ExpectedReturn: 0.04, Volatility: 0.06},
+	"cash":         {AssetClass: "cash", ExpectedReturn: 0.02, Volatility: 0.01},
+}
+
+// FallbackAssumption applies to asset classes missing from DefaultAssumptions.
+var FallbackAssumption = Assumption{ExpectedReturn: 0.05, Volatility: 0.12}
+
+// AssumptionFor returns the default assumption for an asset class.
+func AssumptionFor(assetClass string) Assumption {
+	a, ok := DefaultAssumptions[assetClass]
+	if !ok {
+		a = FallbackAssumption
+	}
+	a.AssetClass = assetClass
+	return a
+}
+
+// Plan describes the portfolio and cash flows to project.
+type Plan struct {
+	Start      time.Time
+	End        time.Time
+	StartValue float64
+	// Assets are rebalanced to their weights every month.
+	Assets []Assumption
+	// MonthlyContribution and MonthlyWithdrawal are in today's money and grow
+	// with inflation.
+	MonthlyContribution float64
+	MonthlyWithdrawal   float64
+	// WithdrawalStart is when withdrawals begin, e.g. a retirement date. The
+	// zero value starts them immediately.
+	WithdrawalStart time.Time
+	Inflation       float64
+	// Goal is a target value in today's money to reach by GoalDate.
+	Goal     float64
+	GoalDate time.Time
+	Paths    int
+	Seed     int64
+}
+
+// Validate checks the plan.
+func (p Plan) Validate() error {
+	if !p.End.After(p.Start) {
+		return fmt.Errorf("%w: end must be after start", ErrInvalidPlan)
+	}
+	if p.Paths < 1 {
+		return fmt.Errorf("%w: at least one path is required", ErrInvalidPlan)
+	}
+	if p.StartValue < 0 || p.MonthlyContribution < 0 || p.MonthlyWithdrawal < 0 {
+		return fmt.Errorf("%w: values and cash flows must not be negative", ErrInvalidPlan)
+	}
+	if len(p.Assets) == 0 {
+		return fmt.Errorf("%w: no asset classes", ErrInvalidPlan)
+	}
+	total := 0.0
+	for _, a := range p.Assets {
+		if a.Weight < 0 || a.Volatility < 0 || a.ExpectedReturn <= -1 {
+			return fmt.Errorf("%w: %s has a negative weight or volatility, or a return of -100%% or less", ErrInvalidPlan, a.AssetClass)
+		}
+		total += a.Weight
+	}
+	if math.Abs(total-1) > 1e-6 {
+		return fmt.Errorf("%w: asset class weights sum to %g, not 1", ErrInvalidPlan, total)
+	}
+	if !p.GoalDate.IsZero() && (p.GoalDate.Before(p.Start) || p.GoalDate.After(p.End)) {
+		return fmt.Errorf("%w: goal date must fall between start and end", ErrInvalidPlan)
+	}
+	return nil
+}
+
+// Band holds percentiles of the simulated value on a date, in today's money.
+type Band struct {
+	Date time.Time
+	P5   float64
+	P25  float64
+	P50  float64
+	P75  float64
+	P95  float64
+}
+
+// Result summarizes the simulated paths.
+type Result struct {
+	// Bands are reported at each anniversary of the start and at the end.
+	Bands []Band
+	// GoalProbability is the share of paths at or above the goal on the goal
+	// date; nil when the plan has no goal.
+	GoalProbability *float64
+	// DepletionProbability is the share of paths that ran out of money.
+	DepletionProbability float64
+}
+
+// Simulate runs the plan month by month. Asset class returns are lognormal
+// and independent of each other.
+func Simulate(p Plan) (*Result, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	months := monthsBetween(p.Start, p.End)
+	goalDate := p.GoalDate
+	if goalDate.IsZero() {
+		goalDate = p.End
+	}
+	goalMonth := monthsBetween(p.Start, goalDate)
+
+	// Report yearly and at the end.
+	var reportMonths []int
+	for m := 12; m < months; m += 12 {
+		reportMonths = append(reportMonths, m)
+	}
+	reportMonths = append(reportMonths, months)
+	samples := make([][]float64, len(reportMonths))
+
+	drift := make([]float64, len(p.Assets))
+	shock := make([]float64, len(p.Assets))
+	for i, a := range p.Assets {
+		// Lognormal parameters whose arithmetic mean is the expected return.
+		sigma := math.Sqrt(math.Log(1 + a.Volatility*a.Volatility/((1+a.ExpectedReturn)*(1+a.ExpectedReturn))))
+		drift[i] = (math.Log(1+a.ExpectedReturn) - sigma*sigma/2) / 12
+		shock[i] = sigma / math.Sqrt(12)
+	}
+	monthlyInflation := math.Pow(1+p.Inflation, 1.0/12)
+
+	rng := rand.New(rand.NewSource(p.Seed)) //nolint:gosec // reproducible simulation, not security sensitive
+	reached, depleted := 0, 0
+	for path := 0; path < p.Paths; path++ {
+		value, prices, next := p.StartValue, 1.0, 0
+		wasDepleted := false
+		for m := 1; m <= months; m++ {
+			growth := 0.0
+			for i, a := range p.Assets {
+				growth += a.Weight * math.Exp(drift[i]+shock[i]*rng.NormFloat64())
+			}
+			prices *= monthlyInflation
+			value = value*growth + p.MonthlyContribution*prices
+			if !p.Start.AddDate(0, m, 0).Before(p.WithdrawalStart) {
+				value -= p.MonthlyWithdrawal * prices
+			}
+			if value <= 0 {
+				value = 0
+				wasDepleted = true
+			}
+			if m == goalMonth && value/prices >= p.Goal {
+				reached++
+			}
+			if next < len(reportMonths) && m == reportMonths[next] {
+				samples[next] = append(samples[next], value/prices)
+				next++
+			}
+		}
+		if wasDepleted {
+			depleted++
+		}
+	}
+
+	res := &Result{DepletionProbability: float64(depleted) / float64(p.Paths)}
+	if p.Goal > 0 {
+		prob := float64(reached) / float64(p.Paths)
+		if goalMonth == 0 && p.StartValue >= p.Goal {
+			prob = 1
+		}
+		res.GoalProbability = &prob
+	}
+	for i, m := range reportMonths {
+		values := samples[i]
+		sort.Float64s(values)
+		date := p.Start.AddDate(0, m, 0)
+		if m == months {
+			date = p.End
+		}
+		res.Bands = append(res.Bands, Band{
+			Date: date,
+			P5:   percentile(values, 0.05),
+			P25:  percentile(values, 0.25),
+			P50:  percentile(values, 0.50),
+			P75:  percentile(values, 0.75),
+			P95:  percentile(values, 0.95),
+		})
+	}
+	return res, nil
+}
+
+// monthsBetween counts whole months from start to end, rounding a partial
+// month up.
+func monthsBetween(start, end time.Time) int {
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+	if start.AddDate(0, months, 0).Before(end) {
+		months++
+	}
+	return months
+}
+
+// percentile interpolates linearly between the closest ranks of sorted values.
+func percentile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := min(lo+1, len(sorted)-1)
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(pos-float64(lo))
+}
@@ -0,0 +1,110 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func TestSimulateDeterministic(t *testing.T) {
+	// Arrange: with no volatility every path is the same.
+	plan := Plan{
+		Start:               date("2026-01-15"),
+		End:                 date("2028-01-15"),
+		StartValue:          1000,
+		Assets:              []Assumption{{AssetClass: "cash", Weight: 1}},
+		MonthlyContribution: 100,
+		Goal:                3000,
+		Paths:               10,
+	}
+
+	// Act
+	res, err := Simulate(plan)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, res.Bands, 2)
+	assert.Equal(t, date("2027-01-15"), res.Bands[0].Date)
+	assert.InDelta(t, 2200, res.Bands[0].P50, 1e-9)
+	assert.InDelta(t, 3400, res.Bands[1].P5, 1e-9)
+	assert.InDelta(t, 3400, res.Bands[1].P95, 1e-9)
+	require.NotNil(t, res.GoalProbability)
+	assert.InDelta(t, 1, *res.GoalProbability, 1e-12)
+	assert.Zero(t, res.DepletionProbability)
+
+	plan.Goal, plan.GoalDate = 2500, date("2027-01-15")
+	res, err = Simulate(plan)
+	require.NoError(t, err)
+	assert.Zero(t, *res.GoalProbability)
+}
+
+func TestSimulateWithdrawalsAndInflation(t *testing.T) {
+	// Arrange
+	plan := Plan{
+		Start:             date("2026-01-01"),
+		End:               date("2027-01-01"),
+		StartValue:        1000,
+		Assets:            []Assumption{{AssetClass: "cash", Weight: 1}},
+		MonthlyWithdrawal: 100,
+		WithdrawalStart:   date("2026-04-01"),
+		Inflation:         0.03,
+		Paths:             1,
+	}
+
+	// Act
+	res, err := Simulate(plan)
+
+	// Assert: withdrawals start in month three and exhaust the money.
+	require.NoError(t, err)
+	assert.InDelta(t, 1, res.DepletionProbability, 1e-12)
+	assert.Nil(t, res.GoalProbability)
+	assert.Zero(t, res.Bands[0].P50)
+}
+
+func TestSimulateRandom(t *testing.T) {
+	plan := Plan{
+		Start:      date("2026-01-01"),
+		End:        date("2046-01-01"),
+		StartValue: 100000,
+		Assets: []Assumption{
+			{AssetClass: "equity", Weight: 0.6, ExpectedReturn: 0.07, Volatility: 0.16},
+			{AssetClass: "fixed_income", Weight: 0.4, ExpectedReturn: 0.04, Volatility: 0.06},
+		},
+		Inflation: 0.02,
+		Goal:      200000,
+		Paths:     2000,
+		Seed:      1,
+	}
+
+	res, err := Simulate(plan)
+	require.NoError(t, err)
+	again, err := Simulate(plan)
+	require.NoError(t, err)
+
+	assert.Equal(t, res, again)
+	assert.Len(t, res.Bands, 20)
+	last := res.Bands[len(res.Bands)-1]
+	assert.Less(t, last.P5, last.P25)
+	assert.Less(t, last.P25, last.P50)
+	assert.Less(t, last.P75, last.P95)
+	assert.Greater(t, *res.GoalProbability, 0.0)
+	assert.Less(t, *res.GoalProbability, 1.0)
+}
+
+func TestValidate(t *testing.T) {
+	plan := Plan{Start: date("2026-01-01"), End: date("2027-01-01"), Paths: 1, Assets: []Assumption{{AssetClass: "equity", Weight: 0.5}}}
+	assert.ErrorIs(t, plan.Validate(), ErrInvalidPlan)
+	plan.Assets[0].Weight = 1
+	assert.NoError(t, plan.Validate())
+	plan.GoalDate = date("2030-01-01")
+	assert.ErrorIs(t, plan.Validate(), ErrInvalidPlan)
+	assert.Equal(t, 0.07, AssumptionFor("equity").ExpectedReturn)
+	assert.Equal(t, "crypto", AssumptionFor("crypto").AssetClass)
+}
@@ -0,0 +1,275 @@
+package rebalance
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+)
+
+// ErrInvalidTargets is returned when a target allocation model is malformed.
+var ErrInvalidTargets = errors.New("rebalance: invalid targets")
+
+// Target is the desired weight of a symbol and the tolerance band around it.
+type Target struct {
+	Symbol string
+	Weight float64
+	// Tolerance is the absolute drift allowed either side of Weight.
+	Tolerance float64
+}
+
+// ValidateTargets checks weights and bands. Weights may sum to less than one;
+// the remainder is the target cash weight.
+func ValidateTargets(targets []Target) error {
+	seen := map[string]bool{}
+	total := 0.0
+	for _, t := range targets {
+		if t.Symbol == "" {
+			return fmt.Errorf("%w: missing symbol", ErrInvalidTargets)
+		}
+		if seen[t.Symbol] {
+			return fmt.Errorf("%w: duplicate symbol %s", ErrInvalidTargets, t.Symbol)
+		}
+		seen[t.Symbol] = true
+		if t.Weight < 0 || t.Weight > 1 || t.Tolerance < 0 {
+			return fmt.Errorf("%w: %s weight must be within [0, 1] and tolerance non-negative", ErrInvalidTargets, t.Symbol)
+		}
+		total += t.Weight
+	}
+	if total > 1+1e-9 {
+		return fmt.Errorf("%w: weights sum to %g, more than 1", ErrInvalidTargets, total)
+	}
+	return nil
+}
+
+// Side is the direction of a proposed trade.
+type Side string
+
+const (
+	SideBuy  Side = "buy"
+	SideSell Side = "sell"
+)
+
+// Options constrain a rebalance proposal.
+type Options struct {
+	AsOf time.Time
+	// Prices returns the execution price assumed for a symbol.
+	Prices func(symbol string) (float64, error)
+	// LotSize returns the trading increment of a symbol.
+	LotSize func(symbol string) float64
+	// MinTradeValue drops trades worth less than this amount.
+	MinTradeValue float64
+	// AvoidShortTermGains limits sells to lots that are long-term or at a loss.
+	AvoidShortTermGains bool
+}
+
+// Trade is a proposed order. Nothing is executed.
+type Trade struct {
+	Symbol          string
+	Side            Side
+	Quantity        float64
+	Price           float64
+	Value           float64
+	CurrentWeight   float64
+	TargetWeight    float64
+	ProjectedWeight float64
+}
+
+// Proposal is a reviewable list of trades that brings drifted positions back
+// within their bands.
+type Proposal struct {
+	Trades     []Trade
+	CashBefore float64
+	CashAfter  float64
+	Warnings   []string
+}
+
+type position struct {
+	symbol    string
+	value     float64
+	quantity  float64
+	price     float64
+	target    Target
+	delta     float64
+	tradedQty float64
+}
+
+// Propose computes the trades needed to bring every position outside its
+// tolerance band back to target. Positions within their bands are left alone,
+// holdings outside the model have a target of zero, and buys are funded by
+// cash on hand plus sell proceeds.
+func Propose(v *holdings.Valuation, lots []ledger.Lot, targets []Target, opts Options) (*Proposal, error) {
+	if err := ValidateTargets(targets); err != nil {
+		return nil, err
+	}
+	lotSize := opts.LotSize
+	if lotSize == nil {
+		lotSize = func(string) float64 { return 1 }
+	}
+
+	positions := map[string]*position{}
+	for _, h := range v.Holdings {
+		p, ok := positions[h.Symbol]
+		if !ok {
+			p = &position{symbol: h.Symbol, price: h.Price}
+			positions[h.Symbol] = p
+		}
+		p.value += h.Value
+		p.quantity += h.Quantity
+	}
+	for _, t := range targets {
+		p, ok := positions[t.Symbol]
+		if !ok {
+			p = &position{symbol: t.Symbol}
+			positions[t.Symbol] = p
+		}
+		p.target = t
+	}
+
+	prop := &Proposal{CashBefore: v.TotalCash()}
+	total := v.Total
+	if total <= 0 {
+		return prop, nil
+	}
+
+	var sells, buys []*position
+	for _, p := range positions {
+		if p.price == 0 {
+			px, err := opts.Prices(p.symbol)
+			if err != nil {
+				return nil, err
+			}
+			p.price = px
+		}
+		drift := p.value/total - p.target.Weight
+		if math.Abs(drift) <= p.target.Tolerance+1e-12 {
+			continue
+		}
+		p.delta = p.target.Weight*total - p.value
+		if p.delta < 0 {
+			sells = append(sells, p)
+		} else {
+			buys = append(buys, p)
+		}
+	}
+	bySymbol := func(ps []*position) {
+		sort.Slice(ps, func(i, j int) bool { return ps[i].symbol < ps[j].symbol })
+	}
+	bySymbol(sells)
+	bySymbol(buys)
+
+	cash := prop.CashBefore
+	for _, p := range sells {
+		qty := math.Min(roundDown(-p.delta/p.price, lotSize(p.symbol)), p.quantity)
+		if p.target.Weight == 0 {
+			// Positions outside the model are liquidated, odd lots included.
+			qty = p.quantity
+		}
+		if opts.AvoidShortTermGains {
+			limit := sellableWithoutShortTermGain(lots, p.symbol, p.price, opts.AsOf)
+			if qty > limit {
+				prop.Warnings = append(prop.Warnings, fmt.Sprintf("%s: sell reduced from %g to %g to avoid realizing short-term gains", p.symbol, qty, limit))
+				qty = roundDown(limit, lotSize(p.symbol))
+			}
+		}
+		if !keep(prop, p, qty, opts.MinTradeValue) {
+			continue
+		}
+		p.tradedQty = -qty
+		cash += qty * p.price
+	}
+
+	// Buys share the available cash in proportion to their shortfall.
+	need := 0.0
+	for _, p := range buys {
+		need += p.delta
+	}
+	available := cash - math.Max(0, 1-sumWeights(targets))*total
+	scale := 1.0
+	if need > available {
+		scale = math.Max(0, available/need)
+		prop.Warnings = append(prop.Warnings, fmt.Sprintf("insufficient cash: buys scaled to %.1f%% of the required amount", scale*100))
+	}
+	for _, p := range buys {
+		qty := roundDown(p.delta*scale/p.price, lotSize(p.symbol))
+		if qty <= 0 && scale < 1 {
+			continue
+		}
+		if !keep(prop, p, qty, opts.MinTradeValue) {
+			continue
+		}
+		p.tradedQty = qty
+		cash -= qty * p.price
+	}
+
+	for _, p := range append(sells, buys...) {
+		if p.tradedQty == 0 {
+			continue
+		}
+		side := SideBuy
+		if p.tradedQty < 0 {
+			side = SideSell
+		}
+		qty := math.Abs(p.tradedQty)
+		prop.Trades = append(prop.Trades, Trade{
+			Symbol:          p.symbol,
+			Side:            side,
+			Quantity:        qty,
+			Price:           p.price,
+			Value:           qty * p.price,
+			CurrentWeight:   p.value / total,
+			TargetWeight:    p.target.Weight,
+			ProjectedWeight: (p.value + p.tradedQty*p.price) / total,
+		})
+	}
+	prop.CashAfter = cash
+	return prop, nil
+}
+
+// keep reports whether a trade of qty is worth proposing and records why not.
+func keep(prop *Proposal, p *position, qty, minValue float64) bool {
+	if qty <= 0 {
+		prop.Warnings = append(prop.Warnings, fmt.Sprintf("%s: drift cannot be corrected in whole trading lots", p.symbol))
+		return false
+	}
+	if qty*p.price < minValue {
+		prop.Warnings = append(prop.Warnings, fmt.Sprintf("%s: trade of %.2f is below the minimum trade value", p.symbol, qty*p.price))
+		return false
+	}
+	return true
+}
+
+// sellableWithoutShortTermGain returns how many units can be sold, relieving
+// lots first-in first-out, before a short-term lot with a gain would be hit.
+func sellableWithoutShortTermGain(lots []ledger.Lot, symbol string, price float64, asOf time.Time) float64 {
+	qty := 0.0
+	for _, lot := range lots {
+		if lot.Symbol != symbol {
+			continue
+		}
+		if !lot.LongTerm(asOf) && price > lot.UnitCost() {
+			break
+		}
+		qty += lot.Quantity
+	}
+	return qty
+}
+
+func roundDown(qty, lot float64) float64 {
+	if lot <= 0 {
+		return qty
+	}
+	return math.Floor(qty/lot+1e-9) * lot
+}
+
+func sumWeights(targets []Target) float64 {
+	total := 0.0
+	for _, t := range targets {
+		total += t.Weight
+	}
+	return total
+}
@@ -0,0 +1,83 @@
+package rebalance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var asOf = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// testValuation holds 70% equity, 20% bonds and 10% cash.
+func testValuation() *holdings.Valuation {
+	return &holdings.Valuation{
+		Holdings: []holdings.Holding{
+			{Symbol: "EQ", Quantity: 70, Price: 100, Value: 7000},
+			{Symbol: "BD", Quantity: 40, Price: 50, Value: 2000},
+		},
+		Cash:  map[string]float64{"": 1000},
+		Total: 10000,
+	}
+}
+
+func testLots() []ledger.Lot {
+	return []ledger.Lot{
+		{Symbol: "EQ", Acquired: asOf.AddDate(-2, 0, 0), Quantity: 50, CostBasis: 2500},
+		{Symbol: "EQ", Acquired: asOf.AddDate(0, -1, 0), Quantity: 20, CostBasis: 1600},
+		{Symbol: "BD", Acquired: asOf.AddDate(-2, 0, 0), Quantity: 40, CostBasis: 2400},
+	}
+}
+
+func noPrices(string) (float64, error) { return 0, nil }
+
+func TestProposeWithinBands(t *testing.T) {
+	targets := []Target{{"EQ", 0.65, 0.1}, {"BD", 0.25, 0.1}}
+
+	prop, err := Propose(testValuation(), testLots(), targets, Options{AsOf: asOf, Prices: noPrices})
+
+	require.NoError(t, err)
+	assert.Empty(t, prop.Trades)
+	assert.InDelta(t, 1000, prop.CashAfter, 1e-9)
+}
+
+func TestProposeBackToTarget(t *testing.T) {
+	targets := []Target{{"EQ", 0.6, 0.05}, {"BD", 0.35, 0.05}}
+
+	prop, err := Propose(testValuation(), testLots(), targets, Options{AsOf: asOf, Prices: noPrices})
+
+	require.NoError(t, err)
+	require.Len(t, prop.Trades, 2)
+	assert.Equal(t, Trade{Symbol: "EQ", Side: SideSell, Quantity: 10, Price: 100, Value: 1000, CurrentWeight: 0.7, TargetWeight: 0.6, ProjectedWeight: 0.6}, prop.Trades[0])
+	assert.Equal(t, SideBuy, prop.Trades[1].Side)
+	// 500 cash stays as the 5% cash target, leaving 1500 for bonds
+	assert.InDelta(t, 30, prop.Trades[1].Quantity, 1e-9)
+	assert.InDelta(t, 500, prop.CashAfter, 1e-9)
+}
+
+func TestProposeConstraints(t *testing.T) {
+	// Selling 60 EQ would reach the short-term lot bought last month at 80,
+	// and the proceeds cannot fund the whole bond purchase.
+	prop, err := Propose(testValuation(), testLots(), []Target{{"EQ", 0.1, 0.05}, {"BD", 0.85, 0.05}}, Options{
+		AsOf:                asOf,
+		Prices:              noPrices,
+		LotSize:             func(string) float64 { return 20 },
+		AvoidShortTermGains: true,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, prop.Trades, 2)
+	assert.InDelta(t, 40, prop.Trades[0].Quantity, 1e-9)
+	assert.InDelta(t, 80, prop.Trades[1].Quantity, 1e-9)
+	assert.Len(t, prop.Warnings, 2)
+
+	targets := []Target{{"EQ", 0.4, 0.05}, {"BD", 0.55, 0.05}}
+	prop, err = Propose(testValuation(), testLots(), targets, Options{AsOf: asOf, Prices: noPrices, MinTradeValue: 5000})
+	require.NoError(t, err)
+	assert.Empty(t, prop.Trades)
+
+	assert.ErrorIs(t, ValidateTargets([]Target{{"EQ", 0.8, 0}, {"BD", 0.3, 0}}), ErrInvalidTargets)
+}
@@ -0,0 +1,258 @@
+// Package reconcile compares the holdings derived from a ledger with position
+// snapshots from a broker and tracks the breaks between them.
+//
+// A break is a quantity mismatch, a symbol missing on either side or a cash
+// difference in an account on a date. Each carries the adjusting entries that
+// would bring the ledger in line with the broker. Breaks are tracked across
+// runs: a break found again is updated rather than duplicated, and an open
+// break that no longer shows up is resolved.
+package reconcile
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/statement"
+)
+
+// ErrInvalidStatus is returned for status changes a break does not allow.
+var ErrInvalidStatus = errors.New("reconcile: invalid status change")
+
+// Kind classifies a break.
+type Kind string
+
+// Break kinds.
+const (
+	// QuantityMismatch is a symbol held on both sides in different quantities.
+	QuantityMismatch Kind = "quantity_mismatch"
+	// MissingInLedger is a position the broker reports and the ledger lacks.
+	MissingInLedger Kind = "missing_in_ledger"
+	// MissingInStatement is a ledger holding the broker does not report.
+	MissingInStatement Kind = "missing_in_statement"
+	// CashDifference is a cash balance that differs from the ledger.
+	CashDifference Kind = "cash_difference"
+)
+
+// Status is the review state of a break.
+type Status string
+
+// Break statuses.
+const (
+	Open     Status = "open"
+	Resolved Status = "resolved"
+	Ignored  Status = "ignored"
+)
+
+// Tolerances below which quantities and cash balances agree.
+const (
+	quantityTolerance = 1e-6
+	cashTolerance     = 0.005
+)
+
+// Break is a difference between the ledger and a broker snapshot.
+type Break struct {
+	ID      string
+	Account string
+	AsOf    time.Time
+	Kind    Kind
+	// Symbol is empty for cash differences.
+	Symbol string
+	// Statement and Ledger are the quantities, or the cash balances, on each
+	// side.
+	Statement float64
+	Ledger    float64
+	// Price values the position adjustments; zero when no price is known.
+	Price float64
+	// Adjustments are the entries that would clear the break.
+	Adjustments []ledger.Transaction
+
+	Status Status
+	Note   string
+	// Detected is when the break was first found and Updated when it last
+	// changed.
+	Detected time.Time
+	Updated  time.Time
+	// Posted holds the IDs of the adjustments posted to resolve the break.
+	Posted []string
+}
+
+// Difference is the statement less the ledger quantity or cash.
+func (b *Break) Difference() float64 {
+	return b.Statement - b.Ledger
+}
+
+// key identifies a break across runs.
+func (b *Break) key() string {
+	return b.Account + "\x00" + b.AsOf.Format(time.DateOnly) + "\x00" + b.Symbol
+}
+
+// Holdings are the ledger quantities and cash of an account on a date.
+type Holdings struct {
+	Quantities map[string]float64
+	Cash       float64
+}
+
+// Compare returns the breaks between a statement and the ledger holdings of
+// its account. Ledger holdings the statement leaves out are only breaks when
+// the statement lists positions, and cash only when it has a balance. price
+// values positions the statement gives no price for.
+func Compare(st statement.Statement, held Holdings, price func(symbol string) (float64, bool)) []Break {
+	prices := map[string]float64{}
+	for _, p := range st.Positions {
+		if p.Price != 0 {
+			prices[p.Symbol] = p.Price
+		}
+	}
+	quantities := held.Quantities
+	if st.Positions == nil {
+		// Without a position list only the cash can be compared.
+		quantities = nil
+	}
+
+	var breaks []Break
+	for _, d := range statement.ReconcilePositions(st.Positions, quantities) {
+		if math.Abs(d.Statement-d.Ledger) < quantityTolerance {
+			continue
+		}
+		b := Break{Account: st.Account, AsOf: st.AsOf, Kind: QuantityMismatch, Symbol: d.Symbol, Statement: d.Statement, Ledger: d.Ledger}
+		switch {
+		case math.Abs(d.Ledger) < quantityTolerance:
+			b.Kind = MissingInLedger
+		case !listed(st.Positions, d.Symbol):
+			b.Kind = MissingInStatement
+		}
+		b.Price = prices[d.Symbol]
+		if b.Price == 0 && price != nil {
+			b.Price, _ = price(d.Symbol)
+		}
+		b.Adjustments = positionAdjustments(b)
+		breaks = append(breaks, b)
+	}
+	if st.Cash != nil && math.Abs(*st.Cash-held.Cash) >= cashTolerance {
+		b := Break{Account: st.Account, AsOf: st.AsOf, Kind: CashDifference, Statement: *st.Cash, Ledger: held.Cash}
+		b.Adjustments = cashAdjustments(b)
+		breaks = append(breaks, b)
+	}
+	return breaks
+}
+
+func listed(positions []statement.Position, symbol string) bool {
+	for _, p := range positions {
+		if p.Symbol == symbol {
+			return true
+		}
+	}
+	return false
+}
+
+// positionAdjustments books the difference as securities transferred in or
+// out at the break price: a buy funded by a deposit of its value, or a sale
+// whose proceeds are withdrawn, so that cash is left alone. Without a price
+// there is nothing to suggest.
+func positionAdjustments(b Break) []ledger.Transaction {
+	diff := b.Difference()
+	if b.Price <= 0 {
+		return nil
+	}
+	qty := math.Abs(diff)
+	value := math.Round(qty*b.Price*100) / 100
+	note := fmt.Sprintf("reconciliation: %s %s on %s", b.Kind, b.Symbol, b.AsOf.Format(time.DateOnly))
+	trade := ledger.Transaction{Date: b.AsOf, Type: ledger.TxBuy, Account: b.Account, Symbol: b.Symbol, Quantity: qty, Price: b.Price, Note: note}
+	cash := ledger.Transaction{Date: b.AsOf, Type: ledger.TxDeposit, Account: b.Account, Amount: value, Note: note}
+	if diff < 0 {
+		trade.Type, cash.Type = ledger.TxSell, ledger.TxWithdrawal
+		return []ledger.Transaction{trade, cash}
+	}
+	return []ledger.Transaction{cash, trade}
+}
+
+// cashAdjustments books a cash difference as a deposit or withdrawal.
+func cashAdjustments(b Break) []ledger.Transaction {
+	diff := math.Round(b.Difference()*100) / 100
+	tx := ledger.Transaction{
+		Date: b.AsOf, Type: ledger.TxDeposit, Account: b.Account, Amount: math.Abs(diff),
+		Note: fmt.Sprintf("reconciliation: %s on %s", CashDifference, b.AsOf.Format(time.DateOnly)),
+	}
+	if diff < 0 {
+		tx.Type = ledger.TxWithdrawal
+	}
+	return []ledger.Transaction{tx}
+}
+
+// Outcome counts the changes a run made to the tracked breaks.
+type Outcome struct {
+	// Opened are new breaks, including resolved breaks found again.
+	Opened int
+	// Updated are open or ignored breaks found again.
+	Updated int
+	// Resolved are open breaks of a checked account and date that were not
+	// found again.
+	Resolved int
+}
+
+// Merge records the breaks found by a run that checked the given statements
+// into tracked, which it returns with the outcome. newID names new breaks.
+// Found breaks are updated in place, so they carry their tracked ID and
+// status.
+func Merge(tracked []*Break, checked []statement.Statement, found []Break, now time.Time, newID func() string) ([]*Break, Outcome) {
+	var out Outcome
+	byKey := map[string]*Break{}
+	for _, b := range tracked {
+		byKey[b.key()] = b
+	}
+	seen := map[string]bool{}
+	for i := range found {
+		f := &found[i]
+		k := f.key()
+		seen[k] = true
+		b, ok := byKey[k]
+		if !ok {
+			f.ID, f.Status, f.Detected, f.Updated = newID(), Open, now, now
+			b = new(Break)
+			*b = *f
+			tracked = append(tracked, b)
+			byKey[k] = b
+			out.Opened++
+			continue
+		}
+		if b.Status == Resolved {
+			b.Status, b.Note, b.Posted = Open, "", nil
+			out.Opened++
+		} else {
+			out.Updated++
+		}
+		b.Kind, b.Statement, b.Ledger, b.Price, b.Adjustments, b.Updated = f.Kind, f.Statement, f.Ledger, f.Price, f.Adjustments, now
+		*f = *b
+	}
+
+	covered := map[string]bool{}
+	for _, st := range checked {
+		covered[st.Account+"\x00"+st.AsOf.Format(time.DateOnly)] = true
+	}
+	for _, b := range tracked {
+		if b.Status != Open || seen[b.key()] || !covered[b.Account+"\x00"+b.AsOf.Format(time.DateOnly)] {
+			continue
+		}
+		b.Status, b.Note, b.Updated = Resolved, "matched on a later run", now
+		out.Resolved++
+	}
+	return tracked, out
+}
+
+// SetStatus changes the status of a break. Ignoring or reopening a break is
+// always allowed; a resolved break can only be reopened.
+func (b *Break) SetStatus(status Status, note string, now time.Time) error {
+	switch status {
+	case Open, Resolved, Ignored:
+	default:
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidStatus, status)
+	}
+	if b.Status == Resolved && status != Open && status != Resolved {
+		return fmt.Errorf("%w: break %s is resolved; reopen it first", ErrInvalidStatus, b.ID)
+	}
+	b.Status, b.Note, b.Updated = status, note, now
+	return nil
+}
@@ -0,0 +1,144 @@
+package reconcile
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/statement"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func cash(v float64) *float64 { return &v }
+
+func TestCompare(t *testing.T) {
+	// Arrange
+	st := statement.Statement{
+		Account: "ira",
+		AsOf:    date("2024-06-28"),
+		Positions: []statement.Position{
+			{Symbol: "AAPL", Quantity: 12, Price: 210},
+			{Symbol: "MSFT", Quantity: 5},
+			{Symbol: "SPY", Quantity: 10, Price: 540},
+		},
+		Cash: cash(1050.25),
+	}
+	held := Holdings{Quantities: map[string]float64{"AAPL": 10, "SPY": 10, "AGG": 4}, Cash: 1000}
+	price := func(symbol string) (float64, bool) { return map[string]float64{"AGG": 98.5}[symbol], symbol == "AGG" }
+
+	// Act
+	breaks := Compare(st, held, price)
+
+	// Assert
+	require.Len(t, breaks, 4)
+	aapl, agg, msft, cashBreak := breaks[0], breaks[1], breaks[2], breaks[3]
+	assert.Equal(t, QuantityMismatch, aapl.Kind)
+	assert.InDelta(t, 2, aapl.Difference(), 1e-9)
+	assert.Equal(t, []ledger.Transaction{
+		{Date: date("2024-06-28"), Type: ledger.TxDeposit, Account: "ira", Amount: 420, Note: "reconciliation: quantity_mismatch AAPL on 2024-06-28"},
+		{Date: date("2024-06-28"), Type: ledger.TxBuy, Account: "ira", Symbol: "AAPL", Quantity: 2, Price: 210, Note: "reconciliation: quantity_mismatch AAPL on 2024-06-28"},
+	}, aapl.Adjustments)
+
+	assert.Equal(t, MissingInStatement, agg.Kind)
+	assert.InDelta(t, 98.5, agg.Price, 1e-9)
+	require.Len(t, agg.Adjustments, 2)
+	assert.Equal(t, ledger.TxSell, agg.Adjustments[0].Type)
+	assert.Equal(t, ledger.TxWithdrawal, agg.Adjustments[1].Type)
+	assert.InDelta(t, 394, agg.Adjustments[1].Amount, 1e-9)
+
+	// Without a price there is no adjustment to suggest.
+	assert.Equal(t, MissingInLedger, msft.Kind)
+	assert.Empty(t, msft.Adjustments)
+
+	assert.Equal(t, CashDifference, cashBreak.Kind)
+	assert.Empty(t, cashBreak.Symbol)
+	assert.Equal(t, []ledger.Transaction{
+		{Date: date("2024-06-28"), Type: ledger.TxDeposit, Account: "ira", Amount: 50.25, Note: "reconciliation: cash_difference on 2024-06-28"},
+	}, cashBreak.Adjustments)
+}
+
+func TestCompareWithoutPositionList(t *testing.T) {
+	// Arrange
+	st := statement.Statement{AsOf: date("2024-06-28"), Cash: cash(1000.004)}
+	held := Holdings{Quantities: map[string]float64{"AAPL": 10}, Cash: 1000}
+
+	// Act
+	breaks := Compare(st, held, nil)
+
+	// Assert
+	assert.Empty(t, breaks)
+}
+
+func TestMerge(t *testing.T) {
+	// Arrange
+	seq := 0
+	newID := func() string { seq++; return fmt.Sprintf("brk-%d", seq) }
+	day := date("2024-06-28")
+	checked := []statement.Statement{{Account: "ira", AsOf: day}}
+	first := []Break{
+		{Account: "ira", AsOf: day, Kind: QuantityMismatch, Symbol: "AAPL", Statement: 12, Ledger: 10},
+		{Account: "ira", AsOf: day, Kind: CashDifference, Statement: 5, Ledger: 0},
+		{Account: "ira", AsOf: day, Kind: MissingInLedger, Symbol: "MSFT", Statement: 5},
+	}
+	t0, t1 := date("2024-07-01"), date("2024-07-02")
+
+	// Act
+	tracked, out1 := Merge(nil, checked, first, t0, newID)
+	require.NoError(t, tracked[2].SetStatus(Ignored, "pending transfer", t0))
+	second := []Break{
+		{Account: "ira", AsOf: day, Kind: QuantityMismatch, Symbol: "AAPL", Statement: 12, Ledger: 11},
+		{Account: "ira", AsOf: day, Kind: MissingInLedger, Symbol: "MSFT", Statement: 5},
+	}
+	tracked, out2 := Merge(tracked, checked, second, t1, newID)
+
+	// Assert
+	assert.Equal(t, Outcome{Opened: 3}, out1)
+	assert.Equal(t, "brk-1", first[0].ID)
+	assert.Equal(t, Outcome{Updated: 2, Resolved: 1}, out2)
+	require.Len(t, tracked, 3)
+	assert.Equal(t, "brk-1", second[0].ID)
+	assert.Equal(t, Open, tracked[0].Status)
+	assert.InDelta(t, 11, tracked[0].Ledger, 1e-9)
+	assert.Equal(t, t1, tracked[0].Updated)
+	assert.Equal(t, Resolved, tracked[1].Status)
+	assert.Equal(t, Ignored, second[1].Status)
+}
+
+func TestMergeLeavesOtherAccountsOpen(t *testing.T) {
+	// Arrange
+	newID := func() string { return "brk-1" }
+	day := date("2024-06-28")
+	tracked, _ := Merge(nil, []statement.Statement{{Account: "ira", AsOf: day}},
+		[]Break{{Account: "ira", AsOf: day, Kind: CashDifference, Statement: 5}}, day, newID)
+
+	// Act
+	tracked, out := Merge(tracked, []statement.Statement{{Account: "taxable", AsOf: day}}, nil, day, newID)
+
+	// Assert
+	assert.Equal(t, Outcome{}, out)
+	assert.Equal(t, Open, tracked[0].Status)
+}
+
+func TestSetStatus(t *testing.T) {
+	// Arrange
+	b := &Break{ID: "brk-1", Status: Resolved}
+
+	// Act
+	ignoreErr := b.SetStatus(Ignored, "", time.Now())
+	unknownErr := b.SetStatus("closed", "", time.Now())
+	reopenErr := b.SetStatus(Open, "wrong fix", time.Now())
+
+	// Assert
+	assert.ErrorIs(t, ignoreErr, ErrInvalidStatus)
+	assert.ErrorIs(t, unknownErr, ErrInvalidStatus)
+	require.NoError(t, reopenErr)
+	assert.Equal(t, Open, b.Status)
+	assert.Equal(t, "wrong fix", b.Note)
+}
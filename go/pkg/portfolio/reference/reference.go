@@ -0,0 +1,240 @@
+package reference
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnsupportedFormat is returned for reference files that are neither YAML
+// nor CSV.
+var ErrUnsupportedFormat = errors.New("reference: unsupported file format")
+
+// Instrument holds the classification data of a tradable instrument.
+type Instrument struct {
+	Symbol     string `yaml:"symbol"`
+	Name       string `yaml:"name"`
+	AssetClass string `yaml:"asset_class"`
+	Sector     string `yaml:"sector"`
+	Country    string `yaml:"country"`
+	Region     string `yaml:"region"`
+	Currency   string `yaml:"currency"`
+	// LotSize is the trading increment; zero means whole units.
+	LotSize float64 `yaml:"lot_size"`
+	// Duration is the modified duration of interest-rate sensitive instruments.
+	Duration float64           `yaml:"duration"`
+	Tags     map[string]string `yaml:"tags"`
+
+	// Bond terms; Maturity (YYYY-MM-DD) marks the instrument as a bond. Coupon
+	// is the annual rate as a decimal and prices are per bond of FaceValue.
+	Coupon          float64 `yaml:"coupon"`
+	CouponFrequency int     `yaml:"coupon_frequency"`
+	DayCount        string  `yaml:"day_count"`
+	Maturity        string  `yaml:"maturity"`
+	FaceValue       float64 `yaml:"face_value"`
+
+	// Option terms; OptionType (call or put) marks the instrument as an option
+	// contract. Multiplier defaults to 100 and FuturesOption selects Black-76.
+	Underlying    string  `yaml:"underlying"`
+	OptionType    string  `yaml:"option_type"`
+	Strike        float64 `yaml:"strike"`
+	Expiry        string  `yaml:"expiry"`
+	Multiplier    float64 `yaml:"multiplier"`
+	FuturesOption bool    `yaml:"futures_option"`
+}
+
+// IsOption reports whether the instrument is an option contract.
+func (i Instrument) IsOption() bool {
+	return i.OptionType != ""
+}
+
+// IsBond reports whether the instrument carries bond terms.
+func (i Instrument) IsBond() bool {
+	return i.Maturity != ""
+}
+
+// TradingLot returns the trading increment of the instrument.
+func (i Instrument) TradingLot() float64 {
+	if i.LotSize > 0 {
+		return i.LotSize
+	}
+	return 1
+}
+
+// Store is the instrument reference store, keyed by symbol.
+type Store struct {
+	mu          sync.RWMutex
+	instruments map[string]Instrument
+}
+
+// NewStore returns an empty reference store.
+func NewStore() *Store {
+	return &Store{instruments: map[string]Instrument{}}
+}
+
+// Put adds or replaces instruments.
+func (s *Store) Put(instruments ...Instrument) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, inst := range instruments {
+		s.instruments[inst.Symbol] = inst
+	}
+}
+
+// Get returns the instrument for symbol.
+func (s *Store) Get(symbol string) (Instrument, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	inst, ok := s.instruments[symbol]
+	return inst, ok
+}
+
+// All returns every instrument sorted by symbol.
+func (s *Store) All() []Instrument {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	all := make([]Instrument, 0, len(s.instruments))
+	for _, inst := range s.instruments {
+		all = append(all, inst)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Symbol < all[j].Symbol })
+	return all
+}
+
+// LoadFile adds the instruments of a local YAML (.yaml, .yml) or CSV (.csv)
+// file to the store.
+func (s *Store) LoadFile(path string) error {
+	var parse func(io.Reader) ([]Instrument, error)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		parse = ParseYAML
+	case ".csv":
+		parse = ParseCSV
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, path)
+	}
+
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	instruments, err := parse(f)
+	if err != nil {
+		return fmt.Errorf("reference: %s: %w", path, err)
+	}
+	s.Put(instruments...)
+	return nil
+}
+
+// ParseYAML reads a document with a top-level "instruments" list.
+func ParseYAML(r io.Reader) ([]Instrument, error) {
+	var doc struct {
+		Instruments []Instrument `yaml:"instruments"`
+	}
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	for i, inst := range doc.Instruments {
+		if inst.Symbol == "" {
+			return nil, fmt.Errorf("instrument %d has no symbol", i+1)
+		}
+	}
+	return doc.Instruments, nil
+}
+
+// ParseCSV reads a CSV file with a header row. Recognised columns are symbol,
+// name, asset_class, sector, country, region, currency, lot_size, duration and
+// the bond terms coupon, coupon_frequency, day_count, maturity and face_value,
+// and the option terms underlying, option_type, strike, expiry, multiplier and
+// futures_option; any column named "tag:<key>" becomes a custom tag.
+func ParseCSV(r io.Reader) ([]Instrument, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	header := rows[0]
+	col := map[string]int{}
+	for i, h := range header {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	if _, ok := col["symbol"]; !ok {
+		return nil, errors.New("missing symbol column")
+	}
+
+	field := func(row []string, name string) string {
+		if i, ok := col[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+	var instruments []Instrument
+	for n, row := range rows[1:] {
+		inst := Instrument{
+			Symbol:     field(row, "symbol"),
+			Name:       field(row, "name"),
+			AssetClass: field(row, "asset_class"),
+			Sector:     field(row, "sector"),
+			Country:    field(row, "country"),
+			Region:     field(row, "region"),
+			Currency:   field(row, "currency"),
+			DayCount:   field(row, "day_count"),
+			Maturity:   field(row, "maturity"),
+			Underlying: field(row, "underlying"),
+			OptionType: field(row, "option_type"),
+			Expiry:     field(row, "expiry"),
+		}
+		if inst.Symbol == "" {
+			return nil, fmt.Errorf("row %d has no symbol", n+2)
+		}
+		if v := field(row, "lot_size"); v != "" {
+			if inst.LotSize, err = strconv.ParseFloat(v, 64); err != nil {
+				return nil, fmt.Errorf("row %d: bad lot_size %q", n+2, v)
+			}
+		}
+		for name, dst := range map[string]*float64{
+			"duration": &inst.Duration, "coupon": &inst.Coupon, "face_value": &inst.FaceValue,
+			"strike": &inst.Strike, "multiplier": &inst.Multiplier,
+		} {
+			if v := field(row, name); v != "" {
+				if *dst, err = strconv.ParseFloat(v, 64); err != nil {
+					return nil, fmt.Errorf("row %d: bad %s %q", n+2, name, v)
+				}
+			}
+		}
+		if v := field(row, "coupon_frequency"); v != "" {
+			if inst.CouponFrequency, err = strconv.Atoi(v); err != nil {
+				return nil, fmt.Errorf("row %d: bad coupon_frequency %q", n+2, v)
+			}
+		}
+		if v := field(row, "futures_option"); v != "" {
+			if inst.FuturesOption, err = strconv.ParseBool(v); err != nil {
+				return nil, fmt.Errorf("row %d: bad futures_option %q", n+2, v)
+			}
+		}
+		for name := range col {
+			if key, ok := strings.CutPrefix(name, "tag:"); ok {
+				if v := field(row, name); v != "" {
+					if inst.Tags == nil {
+						inst.Tags = map[string]string{}
+					}
+					inst.Tags[key] = v
+				}
+			}
+		}
+		instruments = append(instruments, inst)
+	}
+	return instruments, nil
+}
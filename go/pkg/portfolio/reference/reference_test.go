@@ -0,0 +1,57 @@
+package reference
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFile(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	yamlPath := filepath.Join(dir, "instruments.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte(`
+instruments:
+  - symbol: AAPL
+    name: Apple Inc.
+    asset_class: equity
+    sector: Technology
+    country: US
+    region: North America
+    currency: USD
+    tags:
+      style: growth
+`), 0o600))
+	csvPath := filepath.Join(dir, "instruments.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte("symbol,asset_class,tag:style,coupon,coupon_frequency,day_count,maturity,face_value\n"+
+		"AGG,fixed_income,core,,,,,\n"+
+		"UST30,fixed_income,,0.045,2,ACT/ACT,2030-11-15,1000\n"), 0o600))
+
+	// Act
+	store := NewStore()
+	require.NoError(t, store.LoadFile(yamlPath))
+	require.NoError(t, store.LoadFile(csvPath))
+
+	// Assert
+	aapl, ok := store.Get("AAPL")
+	require.True(t, ok)
+	assert.Equal(t, "Technology", aapl.Sector)
+	assert.Equal(t, "growth", aapl.Tags["style"])
+	agg, ok := store.Get("AGG")
+	require.True(t, ok)
+	assert.Equal(t, "fixed_income", agg.AssetClass)
+	assert.Equal(t, "core", agg.Tags["style"])
+	assert.False(t, agg.IsBond())
+	bond, ok := store.Get("UST30")
+	require.True(t, ok)
+	assert.True(t, bond.IsBond())
+	assert.Equal(t, 2, bond.CouponFrequency)
+	assert.InDelta(t, 0.045, bond.Coupon, 1e-12)
+	assert.InDelta(t, 1000, bond.FaceValue, 1e-12)
+	assert.Len(t, store.All(), 3)
+
+	assert.ErrorIs(t, store.LoadFile(filepath.Join(dir, "instruments.json")), ErrUnsupportedFormat)
+}
@@ -0,0 +1,170 @@
+package risk
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+)
+
+// TradingDaysPerYear annualizes daily statistics.
+const TradingDaysPerYear = 252
+
+// ErrInsufficientData is returned when there are too few observations.
+var ErrInsufficientData = errors.New("risk: insufficient data")
+
+// Series is a daily return series aligned with an optional benchmark.
+type Series struct {
+	// Dates has one more entry than Returns: Dates[0] is the base day and
+	// Dates[i+1] is the day of Returns[i].
+	Dates     []time.Time
+	Returns   []float64
+	Benchmark []float64
+}
+
+// FromPoints builds a series from portfolio valuations, dropping days before
+// the portfolio was funded. benchmarkLevels, when not nil, are index levels on
+// the same dates as points.
+func FromPoints(points []performance.Point, benchmarkLevels []float64) Series {
+	daily := performance.DailyReturns(points)
+	var s Series
+	for i, r := range daily {
+		if points[i].Value <= 0 {
+			continue
+		}
+		if len(s.Dates) == 0 {
+			s.Dates = append(s.Dates, points[i].Date)
+		}
+		s.Dates = append(s.Dates, points[i+1].Date)
+		s.Returns = append(s.Returns, r)
+		if benchmarkLevels != nil {
+			s.Benchmark = append(s.Benchmark, benchmarkLevels[i+1]/benchmarkLevels[i]-1)
+		}
+	}
+	return s
+}
+
+// Window returns the sub-series of returns [from, to).
+func (s Series) Window(from, to int) Series {
+	w := Series{Dates: s.Dates[from : to+1], Returns: s.Returns[from:to]}
+	if s.Benchmark != nil {
+		w.Benchmark = s.Benchmark[from:to]
+	}
+	return w
+}
+
+// Metrics are annualized risk statistics over a series.
+type Metrics struct {
+	Start       time.Time
+	End         time.Time
+	Volatility  float64
+	Sharpe      float64
+	Sortino     float64
+	Beta        *float64
+	Correlation *float64
+	// MaxDrawdown is the largest peak-to-trough decline as a positive fraction.
+	MaxDrawdown    float64
+	DrawdownPeak   time.Time
+	DrawdownTrough time.Time
+}
+
+// Compute measures the series. riskFree is the annual risk-free rate.
+func Compute(s Series, riskFree float64) (*Metrics, error) {
+	n := len(s.Returns)
+	if n < 2 {
+		return nil, ErrInsufficientData
+	}
+	rfDaily := riskFree / TradingDaysPerYear
+	m := &Metrics{Start: s.Dates[0], End: s.Dates[n]}
+
+	excess := make([]float64, n)
+	downside := 0.0
+	for i, r := range s.Returns {
+		excess[i] = r - rfDaily
+		if excess[i] < 0 {
+			downside += excess[i] * excess[i]
+		}
+	}
+	sd := stddev(s.Returns)
+	m.Volatility = sd * math.Sqrt(TradingDaysPerYear)
+	annualExcess := mean(excess) * TradingDaysPerYear
+	if m.Volatility > 0 {
+		m.Sharpe = annualExcess / m.Volatility
+	}
+	if dd := math.Sqrt(downside/float64(n)) * math.Sqrt(TradingDaysPerYear); dd > 0 {
+		m.Sortino = annualExcess / dd
+	}
+
+	if len(s.Benchmark) == n {
+		cov := covariance(s.Returns, s.Benchmark)
+		if v := covariance(s.Benchmark, s.Benchmark); v > 0 {
+			beta := cov / v
+			m.Beta = &beta
+			if sd > 0 {
+				corr := cov / (sd * math.Sqrt(v))
+				m.Correlation = &corr
+			}
+		}
+	}
+
+	m.MaxDrawdown, m.DrawdownPeak, m.DrawdownTrough = maxDrawdown(s)
+	return m, nil
+}
+
+// Rolling computes metrics over every window of the given number of returns,
+// one result per day from the first full window onwards.
+func Rolling(s Series, window int, riskFree float64) ([]Metrics, error) {
+	if window < 2 {
+		return nil, ErrInsufficientData
+	}
+	var res []Metrics
+	for end := window; end <= len(s.Returns); end++ {
+		m, err := Compute(s.Window(end-window, end), riskFree)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *m)
+	}
+	return res, nil
+}
+
+func maxDrawdown(s Series) (float64, time.Time, time.Time) {
+	level, peakLevel := 1.0, 1.0
+	peak := s.Dates[0]
+	var worst float64
+	var worstPeak, worstTrough time.Time
+	for i, r := range s.Returns {
+		level *= 1 + r
+		if level > peakLevel {
+			peakLevel, peak = level, s.Dates[i+1]
+			continue
+		}
+		if dd := 1 - level/peakLevel; dd > worst {
+			worst, worstPeak, worstTrough = dd, peak, s.Dates[i+1]
+		}
+	}
+	return worst, worstPeak, worstTrough
+}
+
+func mean(xs []float64) float64 {
+	total := 0.0
+	for _, x := range xs {
+		total += x
+	}
+	return total / float64(len(xs))
+}
+
+// covariance returns the sample covariance of two equally long series.
+func covariance(xs, ys []float64) float64 {
+	mx, my := mean(xs), mean(ys)
+	total := 0.0
+	for i := range xs {
+		total += (xs[i] - mx) * (ys[i] - my)
+	}
+	return total / float64(len(xs)-1)
+}
+
+func stddev(xs []float64) float64 {
+	return math.Sqrt(covariance(xs, xs))
+}
@@ -0,0 +1,228 @@
+package risk
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func testPoints() []performance.Point {
+	values := []float64{0, 100, 110, 99, 88, 121}
+	points := make([]performance.Point, len(values))
+	day := date("2026-02-02")
+	for i, v := range values {
+		points[i] = performance.Point{Date: day.AddDate(0, 0, i), Value: v}
+	}
+	points[1].Flow = 100
+	return points
+}
+
+func TestFromPoints(t *testing.T) {
+	// Arrange
+	points := testPoints()
+	levels := []float64{1, 1, 1.05, 1, 0.9, 1.1}
+
+	// Act
+	s := FromPoints(points, levels)
+
+	// Assert: the unfunded first day is dropped.
+	require.Len(t, s.Returns, 4)
+	assert.Equal(t, points[1].Date, s.Dates[0])
+	assert.InDeltaSlice(t, []float64{0.1, -0.1, -1.0 / 9, 0.375}, s.Returns, 1e-9)
+	assert.InDeltaSlice(t, []float64{0.05, 1/1.05 - 1, -0.1, 1.1/0.9 - 1}, s.Benchmark, 1e-9)
+}
+
+func TestCompute(t *testing.T) {
+	// Arrange
+	s := FromPoints(testPoints(), nil)
+	s.Benchmark = make([]float64, len(s.Returns))
+	for i, r := range s.Returns {
+		s.Benchmark[i] = r / 2
+	}
+
+	// Act
+	m, err := Compute(s, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, stddev(s.Returns)*math.Sqrt(252), m.Volatility, 1e-9)
+	assert.InDelta(t, mean(s.Returns)*252/m.Volatility, m.Sharpe, 1e-9)
+	assert.Greater(t, m.Sortino, m.Sharpe)
+	require.NotNil(t, m.Beta)
+	assert.InDelta(t, 2, *m.Beta, 1e-9)
+	assert.InDelta(t, 1, *m.Correlation, 1e-9)
+
+	// 110 -> 88 is the deepest fall.
+	assert.InDelta(t, 0.2, m.MaxDrawdown, 1e-9)
+	assert.Equal(t, date("2026-02-04"), m.DrawdownPeak)
+	assert.Equal(t, date("2026-02-06"), m.DrawdownTrough)
+
+	// A higher risk-free rate lowers risk-adjusted returns.
+	withRate, err := Compute(s, 0.05)
+	require.NoError(t, err)
+	assert.Less(t, withRate.Sharpe, m.Sharpe)
+	assert.InDelta(t, m.Volatility, withRate.Volatility, 1e-12)
+
+	_, err = Compute(s.Window(0, 1), 0)
+	assert.ErrorIs(t, err, ErrInsufficientData)
+}
+
+func TestRolling(t *testing.T) {
+	s := FromPoints(testPoints(), nil)
+
+	windows, err := Rolling(s, 3, 0)
+
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+	assert.Equal(t, date("2026-02-03"), windows[0].Start)
+	assert.Equal(t, date("2026-02-06"), windows[0].End)
+	assert.Equal(t, date("2026-02-07"), windows[1].End)
+	assert.Nil(t, windows[0].Beta)
+}
+
+func TestValueAtRiskHistorical(t *testing.T) {
+	// Arrange
+	exposures := []Exposure{{Symbol: "ABC", Value: 100}}
+	returns := [][]float64{{0, -0.10, 0, 0.05, 0, -0.05, 0, 0, 0, 0}}
+
+	// Act
+	v, err := ValueAtRisk(exposures, returns, VaROptions{Method: Historical, Confidence: 0.9, Horizon: 1})
+
+	// Assert: the second-worst of ten outcomes is the 90% VaR.
+	require.NoError(t, err)
+	assert.InDelta(t, 5, v.ValueAtRisk, 1e-9)
+	assert.InDelta(t, 7.5, v.ExpectedShortfall, 1e-9)
+	require.Len(t, v.Contributions, 1)
+	assert.InDelta(t, 5, v.Contributions[0].Component, 1e-9)
+	assert.InDelta(t, 0.05, v.Contributions[0].Marginal, 1e-9)
+
+	// Two-day horizons compound overlapping daily returns.
+	v, err = ValueAtRisk(exposures, returns, VaROptions{Method: Historical, Confidence: 0.9, Horizon: 2})
+	require.NoError(t, err)
+	assert.InDelta(t, 10, v.ValueAtRisk, 1e-9)
+}
+
+func TestValueAtRiskParametricAndMonteCarlo(t *testing.T) {
+	// Arrange
+	exposures := []Exposure{{Symbol: "ABC", Value: 600}, {Symbol: "XYZ", Value: 400}}
+	returns := [][]float64{
+		{0.01, -0.02, 0.015, -0.005, 0.01, -0.01, 0.02, -0.015},
+		{0.005, -0.01, 0.0, 0.01, -0.005, 0.0, 0.01, -0.01},
+	}
+
+	// Act
+	parametric, err := ValueAtRisk(exposures, returns, VaROptions{Method: Parametric, Confidence: 0.99, Horizon: 10})
+	require.NoError(t, err)
+	opts := VaROptions{Method: MonteCarlo, Confidence: 0.99, Horizon: 10, Simulations: 20000, Seed: 7}
+	mc, err := ValueAtRisk(exposures, returns, opts)
+	require.NoError(t, err)
+	again, err := ValueAtRisk(exposures, returns, opts)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Greater(t, parametric.ValueAtRisk, 0.0)
+	assert.Greater(t, parametric.ExpectedShortfall, parametric.ValueAtRisk)
+	for _, v := range []*VaR{parametric, mc} {
+		total := 0.0
+		for _, c := range v.Contributions {
+			total += c.Component
+		}
+		assert.InDelta(t, v.ValueAtRisk, total, 1e-6)
+	}
+	assert.InEpsilon(t, parametric.ValueAtRisk, mc.ValueAtRisk, 0.1)
+	assert.Equal(t, mc, again, "a fixed seed reproduces the simulation")
+
+	_, err = ValueAtRisk(exposures, returns, VaROptions{Method: Parametric, Confidence: 1, Horizon: 1})
+	assert.ErrorIs(t, err, ErrInvalidOptions)
+	_, err = ValueAtRisk(exposures, returns, VaROptions{Method: "unknown", Confidence: 0.95, Horizon: 1})
+	assert.ErrorIs(t, err, ErrInvalidOptions)
+}
+
+func TestVolatility(t *testing.T) {
+	exposures := []Exposure{{Symbol: "ABC", Value: 600}, {Symbol: "XYZ", Value: 400}}
+	returns := [][]float64{{0.01, -0.02, 0.015}, {0.005, -0.01, 0.0}}
+
+	vol, err := Volatility(exposures, returns, 2000)
+
+	require.NoError(t, err)
+	pnl := []float64{(6 + 2) / 2000.0, (-12 - 4) / 2000.0, 9 / 2000.0}
+	assert.InDelta(t, stddev(pnl)*math.Sqrt(TradingDaysPerYear), vol, 1e-12)
+	_, err = Volatility(exposures, returns[:1], 2000)
+	assert.ErrorIs(t, err, ErrInvalidOptions)
+}
+
+func TestStress(t *testing.T) {
+	// Arrange
+	positions := []Position{
+		{Symbol: "SPY", AssetClass: "equity", Currency: "USD", Value: 6000},
+		{Symbol: "AGG", AssetClass: "fixed_income", Currency: "USD", Duration: 6, Value: 3000},
+		{Symbol: "EWJ", AssetClass: "equity", Currency: "JPY", Value: 1000},
+	}
+	builtin := map[string]Scenario{}
+	for _, sc := range BuiltinScenarios() {
+		require.NoError(t, sc.Validate())
+		builtin[sc.Name] = sc
+	}
+
+	// Act
+	crash, err := Stress(builtin["equity_crash"], positions, 500, "USD", nil)
+	require.NoError(t, err)
+	rates, err := Stress(builtin["rates_up_200bp"], positions, 500, "USD", nil)
+	require.NoError(t, err)
+	usd, err := Stress(builtin["usd_up_10"], positions, 500, "USD", nil)
+	require.NoError(t, err)
+
+	// Assert
+	assert.InDelta(t, 10500, crash.CurrentValue, 1e-9)
+	assert.InDelta(t, 2800, crash.Loss, 1e-9)
+	assert.Equal(t, "SPY", crash.Impacts[0].Symbol)
+	assert.InDelta(t, -2400, crash.Impacts[0].PnL, 1e-9)
+
+	assert.InDelta(t, 360, rates.Loss, 1e-9)
+	assert.Equal(t, "AGG", rates.Impacts[0].Symbol)
+
+	// Only the yen holding loses value in dollars.
+	assert.InDelta(t, 1000-1000/1.1, usd.Loss, 1e-9)
+	assert.Equal(t, "EWJ", usd.Impacts[0].Symbol)
+}
+
+func TestStressReplay(t *testing.T) {
+	// Arrange
+	prices := marketdata.NewStore()
+	prices.Put("SPY",
+		marketdata.Bar{Date: date("2020-02-19"), Close: 400},
+		marketdata.Bar{Date: date("2020-03-23"), Close: 260},
+	)
+	sc := Scenario{
+		Name:         "covid",
+		AssetClasses: map[string]float64{"equity": -0.5},
+		ReplayStart:  "2020-02-19",
+		ReplayEnd:    "2020-03-23",
+	}
+	positions := []Position{
+		{Symbol: "SPY", AssetClass: "equity", Value: 1000},
+		{Symbol: "NEW", AssetClass: "equity", Value: 100},
+	}
+
+	// Act
+	res, err := Stress(sc, positions, 0, "USD", prices)
+
+	// Assert: NEW has no history and falls back to its asset class shock.
+	require.NoError(t, err)
+	assert.InDelta(t, 350+50, res.Loss, 1e-9)
+	require.Len(t, res.Warnings, 1)
+
+	sc.ReplayEnd = "2020-01-01"
+	assert.ErrorIs(t, sc.Validate(), ErrInvalidScenario)
+	assert.ErrorIs(t, Scenario{Name: "x", Symbols: map[string]float64{"SPY": -1}}.Validate(), ErrInvalidScenario)
+}
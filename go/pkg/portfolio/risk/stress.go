@@ -0,0 +1,204 @@
+package risk
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+)
+
+// ErrInvalidScenario is returned for malformed stress scenarios.
+var ErrInvalidScenario = errors.New("risk: invalid scenario")
+
+// Scenario is a named set of shocks applied to current holdings. Shocks are
+// decimal price changes, e.g. -0.4 for a 40% fall. Scenarios are loaded from
+// the application config, hence the mapstructure tags.
+type Scenario struct {
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	// AssetClasses shocks every instrument of an asset class.
+	AssetClasses map[string]float64 `mapstructure:"asset_classes"`
+	// Symbols shocks single instruments and takes precedence over AssetClasses.
+	Symbols map[string]float64 `mapstructure:"symbols"`
+	// RateShift is a parallel change in yields, e.g. 0.02 for +200bp. Prices
+	// move by minus the instrument duration times the shift.
+	RateShift float64 `mapstructure:"rate_shift"`
+	// Currencies moves each currency against all others, e.g. USD: 0.1 for a
+	// 10% stronger dollar.
+	Currencies map[string]float64 `mapstructure:"currencies"`
+	// ReplayStart and ReplayEnd replay the price change of each held symbol
+	// between two historical dates (YYYY-MM-DD) instead of AssetClasses.
+	ReplayStart string `mapstructure:"replay_start"`
+	ReplayEnd   string `mapstructure:"replay_end"`
+}
+
+// BuiltinScenarios returns the scenarios available without configuration.
+func BuiltinScenarios() []Scenario {
+	return []Scenario{
+		{
+			Name:         "equity_crash",
+			Description:  "2008-style equity crash: equities fall 40%",
+			AssetClasses: map[string]float64{"equity": -0.40},
+		},
+		{
+			Name:        "rates_up_200bp",
+			Description: "Parallel rise in interest rates of 200 basis points",
+			RateShift:   0.02,
+		},
+		{
+			Name:        "usd_up_10",
+			Description: "US dollar strengthens 10% against all currencies",
+			Currencies:  map[string]float64{"USD": 0.10},
+		},
+	}
+}
+
+// Normalized returns the scenario with upper-cased symbols and currencies,
+// as configuration keys may not keep their case.
+func (sc Scenario) Normalized() Scenario {
+	upper := func(shocks map[string]float64) map[string]float64 {
+		if shocks == nil {
+			return nil
+		}
+		res := make(map[string]float64, len(shocks))
+		for key, shock := range shocks {
+			res[strings.ToUpper(strings.TrimSpace(key))] = shock
+		}
+		return res
+	}
+	sc.Symbols = upper(sc.Symbols)
+	sc.Currencies = upper(sc.Currencies)
+	return sc
+}
+
+// Validate checks the scenario.
+func (sc Scenario) Validate() error {
+	if sc.Name == "" {
+		return fmt.Errorf("%w: missing name", ErrInvalidScenario)
+	}
+	for _, shocks := range []map[string]float64{sc.AssetClasses, sc.Symbols, sc.Currencies} {
+		for key, shock := range shocks {
+			if shock <= -1 {
+				return fmt.Errorf("%w: %s: %s shock must be greater than -100%%", ErrInvalidScenario, sc.Name, key)
+			}
+		}
+	}
+	if sc.ReplayStart != "" || sc.ReplayEnd != "" {
+		start, end, err := sc.replayWindow()
+		if err != nil {
+			return err
+		}
+		if !start.Before(end) {
+			return fmt.Errorf("%w: %s: replay start must be before replay end", ErrInvalidScenario, sc.Name)
+		}
+	}
+	return nil
+}
+
+func (sc Scenario) replayWindow() (time.Time, time.Time, error) {
+	start, err := time.Parse(time.DateOnly, sc.ReplayStart)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %s: bad replay start %q", ErrInvalidScenario, sc.Name, sc.ReplayStart)
+	}
+	end, err := time.Parse(time.DateOnly, sc.ReplayEnd)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %s: bad replay end %q", ErrInvalidScenario, sc.Name, sc.ReplayEnd)
+	}
+	return start, end, nil
+}
+
+// Position is a holding with the attributes scenarios shock.
+type Position struct {
+	Symbol     string
+	AssetClass string
+	Currency   string
+	// Duration is the modified duration used for rate shifts.
+	Duration float64
+	Value    float64
+}
+
+// Impact is the effect of a scenario on one position.
+type Impact struct {
+	Symbol         string
+	AssetClass     string
+	Value          float64
+	ProjectedValue float64
+	PnL            float64
+	// Shock is the total price change applied, as a decimal.
+	Shock float64
+}
+
+// StressResult is the projected effect of a scenario. Loss is positive when
+// the portfolio loses value.
+type StressResult struct {
+	CurrentValue   float64
+	ProjectedValue float64
+	Loss           float64
+	// Impacts are ordered from the worst contributor to the best.
+	Impacts  []Impact
+	Warnings []string
+}
+
+// Stress applies a scenario to positions and cash held in the base currency.
+// prices supplies history for replay scenarios.
+func Stress(sc Scenario, positions []Position, cash float64, base string, prices marketdata.Provider) (*StressResult, error) {
+	if err := sc.Validate(); err != nil {
+		return nil, err
+	}
+	var replay func(symbol string) (float64, error)
+	if sc.ReplayStart != "" {
+		start, end, _ := sc.replayWindow()
+		replay = func(symbol string) (float64, error) {
+			from, err := prices.Close(symbol, start)
+			if err != nil {
+				return 0, err
+			}
+			to, err := prices.Close(symbol, end)
+			if err != nil {
+				return 0, err
+			}
+			return to/from - 1, nil
+		}
+	}
+
+	res := &StressResult{CurrentValue: cash, ProjectedValue: cash}
+	for _, p := range positions {
+		price := 0.0
+		if shock, ok := sc.Symbols[p.Symbol]; ok {
+			price = shock
+		} else if replay != nil {
+			r, err := replay(p.Symbol)
+			if err != nil {
+				res.Warnings = append(res.Warnings, fmt.Sprintf("%s: no price history for the replay window, using asset class shocks", p.Symbol))
+				r = sc.AssetClasses[p.AssetClass]
+			}
+			price = r
+		} else {
+			price = sc.AssetClasses[p.AssetClass]
+		}
+		price -= p.Duration * sc.RateShift
+
+		currency := p.Currency
+		if currency == "" {
+			currency = base
+		}
+		fx := (1 + sc.Currencies[currency]) / (1 + sc.Currencies[base])
+		projected := p.Value * (1 + price) * fx
+		res.Impacts = append(res.Impacts, Impact{
+			Symbol:         p.Symbol,
+			AssetClass:     p.AssetClass,
+			Value:          p.Value,
+			ProjectedValue: projected,
+			PnL:            projected - p.Value,
+			Shock:          (1+price)*fx - 1,
+		})
+		res.CurrentValue += p.Value
+		res.ProjectedValue += projected
+	}
+	sort.SliceStable(res.Impacts, func(i, j int) bool { return res.Impacts[i].PnL < res.Impacts[j].PnL })
+	res.Loss = res.CurrentValue - res.ProjectedValue
+	return res, nil
+}
@@ -0,0 +1,337 @@
+package risk
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+)
+
+// ErrInvalidOptions is returned for unusable VaR settings.
+var ErrInvalidOptions = errors.New("risk: invalid options")
+
+// Method is a Value-at-Risk estimation method.
+type Method string
+
+const (
+	// Historical revalues positions under past horizon returns.
+	Historical Method = "historical"
+	// Parametric assumes normally distributed returns (variance-covariance).
+	Parametric Method = "parametric"
+	// MonteCarlo draws correlated normal returns from the estimated covariance.
+	MonteCarlo Method = "monte_carlo"
+)
+
+// Exposure is the current market value held in a symbol.
+type Exposure struct {
+	Symbol string
+	Value  float64
+}
+
+// VaROptions configure a Value-at-Risk estimate.
+type VaROptions struct {
+	Method     Method
+	Confidence float64
+	// Horizon is the holding period in trading days.
+	Horizon     int
+	Simulations int
+	// Seed makes Monte Carlo draws reproducible.
+	Seed int64
+}
+
+// Contribution splits portfolio VaR across positions.
+type Contribution struct {
+	Symbol string
+	Value  float64
+	// Marginal is the change in VaR per unit of value added to the position.
+	Marginal float64
+	// Component is Marginal times Value; components sum to the portfolio VaR.
+	Component float64
+}
+
+// VaR is a loss estimate at a confidence level over a horizon. Losses are
+// positive amounts.
+type VaR struct {
+	Method            Method
+	Confidence        float64
+	Horizon           int
+	PortfolioValue    float64
+	ValueAtRisk       float64
+	ExpectedShortfall float64
+	Contributions     []Contribution
+}
+
+// AssetReturns returns the daily close-to-close returns of each symbol over
+// consecutive days, aligned by day.
+func AssetReturns(prices marketdata.Provider, symbols []string, days []time.Time) ([][]float64, error) {
+	res := make([][]float64, len(symbols))
+	for i, sym := range symbols {
+		prev := 0.0
+		for j, day := range days {
+			px, err := prices.Close(sym, day)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", sym, err)
+			}
+			if j > 0 {
+				res[i] = append(res[i], px/prev-1)
+			}
+			prev = px
+		}
+	}
+	return res, nil
+}
+
+// Volatility returns the annualized volatility of the daily P&L of exposures
+// held at their current values, as a share of base. returns holds the daily
+// returns of each exposure, aligned by day.
+func Volatility(exposures []Exposure, returns [][]float64, base float64) (float64, error) {
+	if len(returns) != len(exposures) {
+		return 0, fmt.Errorf("%w: %d return series for %d exposures", ErrInvalidOptions, len(returns), len(exposures))
+	}
+	if len(exposures) == 0 || base == 0 {
+		return 0, nil
+	}
+	if len(returns[0]) < 2 {
+		return 0, ErrInsufficientData
+	}
+	pnl := make([]float64, len(returns[0]))
+	for i, e := range exposures {
+		for t, r := range returns[i] {
+			pnl[t] += e.Value * r / base
+		}
+	}
+	return stddev(pnl) * math.Sqrt(TradingDaysPerYear), nil
+}
+
+// ValueAtRisk estimates the loss of exposures that is not exceeded with the
+// given confidence over the horizon, and the expected shortfall beyond it.
+// returns holds the daily returns of each exposure, aligned by day.
+func ValueAtRisk(exposures []Exposure, returns [][]float64, opts VaROptions) (*VaR, error) {
+	if opts.Confidence <= 0 || opts.Confidence >= 1 || opts.Horizon < 1 {
+		return nil, fmt.Errorf("%w: confidence must be within (0, 1) and horizon at least one day", ErrInvalidOptions)
+	}
+	if len(returns) != len(exposures) {
+		return nil, fmt.Errorf("%w: %d return series for %d exposures", ErrInvalidOptions, len(returns), len(exposures))
+	}
+	res := &VaR{Method: opts.Method, Confidence: opts.Confidence, Horizon: opts.Horizon}
+	values := make([]float64, len(exposures))
+	for i, e := range exposures {
+		values[i] = e.Value
+		res.PortfolioValue += e.Value
+	}
+	if len(exposures) == 0 {
+		return res, nil
+	}
+	days := len(returns[0])
+	if days < 2 {
+		return nil, ErrInsufficientData
+	}
+
+	var components []float64
+	switch opts.Method {
+	case Parametric:
+		components = res.parametric(values, returns)
+	case Historical:
+		scenarios := horizonReturns(returns, opts.Horizon)
+		if len(scenarios) < 2 {
+			return nil, ErrInsufficientData
+		}
+		components = res.simulated(values, scenarios)
+	case MonteCarlo:
+		if opts.Simulations < 2 {
+			return nil, fmt.Errorf("%w: at least two simulations are required", ErrInvalidOptions)
+		}
+		scenarios, err := simulate(returns, opts)
+		if err != nil {
+			return nil, err
+		}
+		components = res.simulated(values, scenarios)
+	default:
+		return nil, fmt.Errorf("%w: unknown method %q", ErrInvalidOptions, opts.Method)
+	}
+
+	for i, e := range exposures {
+		c := Contribution{Symbol: e.Symbol, Value: e.Value, Component: components[i]}
+		if e.Value != 0 {
+			c.Marginal = c.Component / e.Value
+		}
+		res.Contributions = append(res.Contributions, c)
+	}
+	return res, nil
+}
+
+// parametric fills VaR and ES from the normal distribution of horizon P&L and
+// returns Euler components.
+func (v *VaR) parametric(values []float64, returns [][]float64) []float64 {
+	n := len(values)
+	h := float64(v.Horizon)
+	cov := covarianceMatrix(returns)
+	mu := 0.0
+	for i := range values {
+		mu += values[i] * mean(returns[i]) * h
+	}
+	// sigmaX is the covariance matrix applied to the position values.
+	sigmaX := make([]float64, n)
+	variance := 0.0
+	for i := range values {
+		for j := range values {
+			sigmaX[i] += cov[i][j] * values[j] * h
+		}
+		variance += values[i] * sigmaX[i]
+	}
+	sd := math.Sqrt(math.Max(variance, 0))
+	z := math.Sqrt2 * math.Erfinv(2*v.Confidence-1)
+	v.ValueAtRisk = z*sd - mu
+	pdf := math.Exp(-z*z/2) / math.Sqrt(2*math.Pi)
+	v.ExpectedShortfall = sd*pdf/(1-v.Confidence) - mu
+
+	components := make([]float64, n)
+	for i := range values {
+		if sd > 0 {
+			components[i] = values[i] * z * sigmaX[i] / sd
+		}
+		components[i] -= values[i] * mean(returns[i]) * h
+	}
+	return components
+}
+
+// simulated fills VaR and ES from return scenarios, one row of asset returns
+// per scenario, and allocates VaR in proportion to each position's average
+// loss in the tail.
+func (v *VaR) simulated(values []float64, scenarios [][]float64) []float64 {
+	type outcome struct {
+		pnl       float64
+		positions []float64
+	}
+	outcomes := make([]outcome, len(scenarios))
+	for s, rs := range scenarios {
+		o := outcome{positions: make([]float64, len(values))}
+		for i, r := range rs {
+			o.positions[i] = values[i] * r
+			o.pnl += o.positions[i]
+		}
+		outcomes[s] = o
+	}
+	sort.SliceStable(outcomes, func(i, j int) bool { return outcomes[i].pnl < outcomes[j].pnl })
+
+	cut := min(int(math.Floor((1-v.Confidence)*float64(len(outcomes))+1e-9)), len(outcomes)-1)
+	tail := outcomes[:cut+1]
+	v.ValueAtRisk = -outcomes[cut].pnl
+	components := make([]float64, len(values))
+	for _, o := range tail {
+		v.ExpectedShortfall -= o.pnl / float64(len(tail))
+		for i, p := range o.positions {
+			components[i] -= p / float64(len(tail))
+		}
+	}
+	if v.ExpectedShortfall != 0 {
+		scale := v.ValueAtRisk / v.ExpectedShortfall
+		for i := range components {
+			components[i] *= scale
+		}
+	}
+	return components
+}
+
+// horizonReturns compounds daily returns over every overlapping horizon and
+// returns one row of asset returns per scenario.
+func horizonReturns(returns [][]float64, horizon int) [][]float64 {
+	days := len(returns[0])
+	var scenarios [][]float64
+	for start := 0; start+horizon <= days; start++ {
+		row := make([]float64, len(returns))
+		for i, rs := range returns {
+			growth := 1.0
+			for _, r := range rs[start : start+horizon] {
+				growth *= 1 + r
+			}
+			row[i] = growth - 1
+		}
+		scenarios = append(scenarios, row)
+	}
+	return scenarios
+}
+
+// simulate draws horizon returns from a multivariate normal distribution with
+// the sample mean and covariance of daily returns scaled to the horizon.
+func simulate(returns [][]float64, opts VaROptions) ([][]float64, error) {
+	n := len(returns)
+	h := float64(opts.Horizon)
+	cov := covarianceMatrix(returns)
+	for i := range cov {
+		for j := range cov[i] {
+			cov[i][j] *= h
+		}
+	}
+	lower, err := cholesky(cov)
+	if err != nil {
+		return nil, err
+	}
+	mu := make([]float64, n)
+	for i := range returns {
+		mu[i] = mean(returns[i]) * h
+	}
+
+	rng := rand.New(rand.NewSource(opts.Seed)) //nolint:gosec // reproducible simulation, not security sensitive
+	scenarios := make([][]float64, opts.Simulations)
+	draws := make([]float64, n)
+	for s := range scenarios {
+		for i := range draws {
+			draws[i] = rng.NormFloat64()
+		}
+		row := make([]float64, n)
+		for i := range row {
+			row[i] = mu[i]
+			for j := 0; j <= i; j++ {
+				row[i] += lower[i][j] * draws[j]
+			}
+		}
+		scenarios[s] = row
+	}
+	return scenarios, nil
+}
+
+func covarianceMatrix(returns [][]float64) [][]float64 {
+	cov := make([][]float64, len(returns))
+	for i := range returns {
+		cov[i] = make([]float64, len(returns))
+		for j := range returns {
+			cov[i][j] = covariance(returns[i], returns[j])
+		}
+	}
+	return cov
+}
+
+// cholesky factors a positive semi-definite matrix into a lower triangular
+// matrix L with L·Lᵀ equal to the input. Perfectly correlated or constant
+// assets leave zero pivots, which are skipped.
+func cholesky(a [][]float64) ([][]float64, error) {
+	n := len(a)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := a[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum < -1e-12 {
+					return nil, fmt.Errorf("%w: covariance matrix is not positive semi-definite", ErrInvalidOptions)
+				}
+				l[i][i] = math.Sqrt(math.Max(sum, 0))
+				continue
+			}
+			if l[j][j] > 0 {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	return l, nil
+}
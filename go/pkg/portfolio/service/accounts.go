@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/accounts"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+)
+
+// AddAccounts registers accounts in every portfolio, e.g. from the
+// application config. Portfolios imported later get them too.
+func (s *PortfolioService) AddAccounts(accts ...accounts.Account) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.configuredAccounts = append(s.configuredAccounts, accts...)
+	for _, pf := range s.portfolios {
+		for _, a := range accts {
+			a = a.WithDefaults(pf.ledger.Currency())
+			if err := validateAccount(a, pf.ledger.Currency()); err != nil {
+				return fmt.Errorf("%s: %w", pf.id, err)
+			}
+			pf.accounts[a.ID] = a
+		}
+	}
+	return nil
+}
+
+// ListAccounts lists the registered accounts of a portfolio and the accounts
+// its transactions refer to, by ID.
+func (s *PortfolioService) ListAccounts(ctx context.Context, p *genportfolio.ListAccountsPayload) ([]*genportfolio.Account, error) {
+	s.logger.DebugContext(ctx, "portfolio.listAccounts", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := holdings.Value(pf.ledger, s.prices, s.now())
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]float64{}
+	for _, h := range v.Holdings {
+		values[h.Account] += h.Value
+	}
+
+	s.mu.RLock()
+	ids := make([]string, 0, len(pf.accounts))
+	for id := range pf.accounts {
+		ids = append(ids, id)
+	}
+	s.mu.RUnlock()
+	for id := range v.Cash {
+		ids = append(ids, id)
+	}
+	for id := range values {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	res := []*genportfolio.Account{}
+	for i, id := range ids {
+		if i > 0 && ids[i-1] == id {
+			continue
+		}
+		a, registered := s.account(pf, id)
+		res = append(res, toAccount(a, registered, v.Cash[id], values[id]))
+	}
+	return res, nil
+}
+
+// SetAccount registers or updates an account of a portfolio.
+func (s *PortfolioService) SetAccount(ctx context.Context, p *genportfolio.SetAccountPayload) (*genportfolio.Account, error) {
+	s.logger.DebugContext(ctx, "portfolio.setAccount", "portfolio_id", p.PortfolioID, "id", p.ID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	a := accounts.Account{ID: p.ID, Type: accounts.Type(p.Type)}
+	if p.Name != nil {
+		a.Name = *p.Name
+	}
+	if p.TaxTreatment != nil {
+		a.TaxTreatment = accounts.TaxTreatment(*p.TaxTreatment)
+	}
+	if p.Custodian != nil {
+		a.Custodian = *p.Custodian
+	}
+	if p.Currency != nil {
+		a.Currency = *p.Currency
+	}
+	a = a.WithDefaults(pf.ledger.Currency())
+	if err := validateAccount(a, pf.ledger.Currency()); err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	s.mu.Lock()
+	pf.accounts[a.ID] = a
+	s.mu.Unlock()
+
+	v, err := holdings.Value(pf.ledger.Account(a.ID), s.prices, s.now())
+	if err != nil {
+		return nil, err
+	}
+	return toAccount(a, true, v.Cash[a.ID], v.Total-v.Cash[a.ID]), nil
+}
+
+// RemoveAccount removes a registered account no transaction refers to.
+func (s *PortfolioService) RemoveAccount(ctx context.Context, p *genportfolio.RemoveAccountPayload) (*genportfolio.Account, error) {
+	s.logger.DebugContext(ctx, "portfolio.removeAccount", "portfolio_id", p.PortfolioID, "id", p.ID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	if referenced(pf.ledger, p.ID) {
+		return nil, genportfolio.BadRequest(fmt.Sprintf("account %q has transactions", p.ID))
+	}
+	s.mu.Lock()
+	a, ok := pf.accounts[p.ID]
+	delete(pf.accounts, p.ID)
+	s.mu.Unlock()
+	if !ok {
+		return nil, genportfolio.NotFound(fmt.Sprintf("account %q not found", p.ID))
+	}
+	return toAccount(a, true, 0, 0), nil
+}
+
+// validateAccount checks an account of a portfolio in currency. Balances
+// are kept in the portfolio currency, so other base currencies are rejected.
+func validateAccount(a accounts.Account, currency string) error {
+	if err := a.Validate(); err != nil {
+		return err
+	}
+	if a.Currency != currency {
+		return fmt.Errorf("%w: %s: currency %s differs from the portfolio currency %s", accounts.ErrInvalidAccount, a.ID, a.Currency, currency)
+	}
+	return nil
+}
+
+// account returns the account id of pf and whether it is registered. An
+// account that is not registered is a taxable account in the portfolio
+// currency.
+func (s *PortfolioService) account(pf *portfolioState, id string) (accounts.Account, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if a, ok := pf.accounts[id]; ok {
+		return a, true
+	}
+	return accounts.Implicit(id, pf.ledger.Currency()), false
+}
+
+// sheltered returns whether the accounts of pf are tax-advantaged.
+func (s *PortfolioService) sheltered(pf *portfolioState) func(account string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	advantaged := map[string]bool{}
+	for id, a := range pf.accounts {
+		advantaged[id] = a.TaxAdvantaged()
+	}
+	return func(account string) bool { return advantaged[account] }
+}
+
+// scope returns the ledger of pf, or of one of its accounts when account is
+// set. Unknown accounts are not found.
+func (s *PortfolioService) scope(pf *portfolioState, account *string) (*ledger.Ledger, error) {
+	if account == nil {
+		return pf.ledger, nil
+	}
+	s.mu.RLock()
+	_, registered := pf.accounts[*account]
+	s.mu.RUnlock()
+	if !registered && !referenced(pf.ledger, *account) {
+		return nil, genportfolio.NotFound(fmt.Sprintf("account %q not found", *account))
+	}
+	return pf.ledger.Account(*account), nil
+}
+
+// referenced reports whether a transaction of l belongs to account.
+func referenced(l *ledger.Ledger, account string) bool {
+	for _, tx := range l.Transactions() {
+		if !tx.IsCorporateAction() && tx.Account == account {
+			return true
+		}
+	}
+	return false
+}
+
+func toAccount(a accounts.Account, registered bool, cash, value float64) *genportfolio.Account {
+	res := &genportfolio.Account{
+		ID:            a.ID,
+		Name:          a.Name,
+		Type:          string(a.Type),
+		TaxTreatment:  string(a.TaxTreatment),
+		Currency:      a.Currency,
+		Registered:    registered,
+		Cash:          cash,
+		HoldingsValue: value,
+		TotalValue:    cash + value,
+	}
+	if a.Custodian != "" {
+		res.Custodian = &a.Custodian
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/allocation"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+)
+
+// LoadInstruments adds instrument reference data from a local YAML or CSV file.
+func (s *PortfolioService) LoadInstruments(path string) error {
+	return s.instruments.LoadFile(path)
+}
+
+// GetAllocation groups the holdings of a portfolio, or of one of its
+// accounts, by the requested dimension.
+func (s *PortfolioService) GetAllocation(ctx context.Context, p *genportfolio.GetAllocationPayload) (*genportfolio.Allocation, error) {
+	s.logger.DebugContext(ctx, "portfolio.getAllocation", "portfolio_id", p.PortfolioID, "dimension", p.Dimension)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	tag := ""
+	if p.Tag != nil {
+		tag = *p.Tag
+	}
+
+	l, err := s.scope(pf, p.Account)
+	if err != nil {
+		return nil, err
+	}
+	v, err := holdings.Value(l, s.prices, s.now())
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := allocation.Group(v, s.instruments, allocation.Dimension(p.Dimension), tag)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+
+	res := &genportfolio.Allocation{
+		PortfolioID: pf.id,
+		Dimension:   p.Dimension,
+		Tag:         p.Tag,
+		Account:     p.Account,
+		AsOf:        v.AsOf.Format(time.DateOnly),
+		Currency:    v.Currency,
+		TotalValue:  v.Total,
+		Buckets:     make([]*genportfolio.AllocationBucket, len(buckets)),
+	}
+	for i, b := range buckets {
+		res.Buckets[i] = toAllocationBucket(b)
+	}
+	return res, nil
+}
+
+func toAllocationBucket(b allocation.Bucket) *genportfolio.AllocationBucket {
+	symbols := b.Symbols
+	if symbols == nil {
+		symbols = []string{}
+	}
+	return &genportfolio.AllocationBucket{Key: b.Key, Value: b.Value, Weight: b.Weight, Symbols: symbols}
+}
@@ -0,0 +1,479 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/accounts"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/archive"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/benchmark"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/margin"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/orders"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/rebalance"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reconcile"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reference"
+)
+
+// archiveExtension is the file extension of archive downloads.
+const archiveExtension = ".portfolio.json"
+
+// ExportArchive exports a portfolio as a versioned JSON archive.
+func (s *PortfolioService) ExportArchive(ctx context.Context, p *genportfolio.ExportArchivePayload) (*genportfolio.ExportFile, error) {
+	s.logger.DebugContext(ctx, "portfolio.exportArchive", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := archive.Write(&buf, s.archivePortfolio(pf), s.now()); err != nil {
+		return nil, err
+	}
+	return &genportfolio.ExportFile{
+		ContentDisposition: fmt.Sprintf("attachment; filename=%q", pf.id+archiveExtension),
+		Content:            buf.String(),
+	}, nil
+}
+
+// archivePortfolio returns the archived state of a portfolio, with the
+// reference data of the instruments in its ledger, targets and benchmark.
+func (s *PortfolioService) archivePortfolio(pf *portfolioState) archive.Portfolio {
+	res := archive.Portfolio{
+		ID:           pf.id,
+		Currency:     pf.ledger.Currency(),
+		Accounts:     []archive.Account{},
+		Transactions: []archive.Transaction{},
+		Instruments:  []archive.Instrument{},
+		Targets:      []archive.Target{},
+		Settings:     archive.Settings{MarginAccounts: []archive.MarginAccount{}},
+		Orders:       []archive.Order{},
+		Breaks:       []archive.Break{},
+	}
+	for _, tx := range pf.ledger.Transactions() {
+		res.Transactions = append(res.Transactions, archiveTransaction(tx))
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res.Paper = pf.paper
+	for _, a := range pf.accounts {
+		res.Accounts = append(res.Accounts, archive.Account{
+			ID:           a.ID,
+			Name:         a.Name,
+			Type:         string(a.Type),
+			TaxTreatment: string(a.TaxTreatment),
+			Custodian:    a.Custodian,
+			Currency:     a.Currency,
+		})
+	}
+	sort.Slice(res.Accounts, func(i, j int) bool { return res.Accounts[i].ID < res.Accounts[j].ID })
+	symbols := ledgerSymbols(pf.ledger)
+	for _, t := range pf.targets {
+		res.Targets = append(res.Targets, archive.Target{Symbol: t.Symbol, Weight: t.Weight, Tolerance: t.Tolerance})
+		symbols = append(symbols, t.Symbol)
+	}
+	if def := pf.benchmark; len(def.Components) > 0 {
+		b := &archive.Benchmark{Name: def.Name, Rebalance: string(def.Rebalance)}
+		for _, c := range def.Components {
+			b.Components = append(b.Components, archive.Component{Symbol: c.Symbol, Weight: c.Weight})
+			symbols = append(symbols, c.Symbol)
+		}
+		res.Settings.Benchmark = b
+	}
+	accounts := make([]string, 0, len(pf.margin))
+	for account := range pf.margin {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+	for _, account := range accounts {
+		acct := pf.margin[account]
+		res.Settings.MarginAccounts = append(res.Settings.MarginAccounts, archive.MarginAccount{
+			Account:                     account,
+			InitialRequirement:          acct.terms.InitialRequirement,
+			MaintenanceRequirement:      acct.terms.MaintenanceRequirement,
+			ShortMaintenanceRequirement: acct.terms.ShortMaintenanceRequirement,
+			LoanRate:                    acct.terms.LoanRate,
+			BorrowRate:                  acct.terms.BorrowRate,
+			BorrowRates:                 acct.terms.BorrowRates,
+			ChargedThrough:              acct.charged,
+		})
+	}
+	for _, o := range pf.orders {
+		res.Orders = append(res.Orders, archive.Order{
+			ID:          o.ID,
+			Account:     o.Account,
+			Symbol:      o.Symbol,
+			Side:        string(o.Side),
+			Type:        string(o.Type),
+			Quantity:    o.Quantity,
+			LimitPrice:  o.LimitPrice,
+			StopPrice:   o.StopPrice,
+			TimeInForce: string(o.TimeInForce),
+			Created:     o.Created,
+			Status:      string(o.Status),
+			Triggered:   o.Triggered,
+			Updated:     o.Updated,
+			FillPrice:   o.FillPrice,
+			Commission:  o.Commission,
+			Reason:      o.Reason,
+		})
+	}
+	for _, b := range pf.breaks {
+		ab := archive.Break{
+			ID:          b.ID,
+			Account:     b.Account,
+			AsOf:        b.AsOf,
+			Kind:        string(b.Kind),
+			Symbol:      b.Symbol,
+			Statement:   b.Statement,
+			Ledger:      b.Ledger,
+			Price:       b.Price,
+			Adjustments: []archive.Transaction{},
+			Status:      string(b.Status),
+			Note:        b.Note,
+			Detected:    b.Detected,
+			Updated:     b.Updated,
+			Posted:      b.Posted,
+		}
+		for _, tx := range b.Adjustments {
+			ab.Adjustments = append(ab.Adjustments, archiveTransaction(tx))
+		}
+		res.Breaks = append(res.Breaks, ab)
+	}
+
+	// Option contracts bring their underlying along.
+	seen := map[string]bool{}
+	for i := 0; i < len(symbols); i++ {
+		symbol := symbols[i]
+		if seen[symbol] {
+			continue
+		}
+		seen[symbol] = true
+		inst, ok := s.instruments.Get(symbol)
+		if !ok {
+			continue
+		}
+		if inst.Underlying != "" {
+			symbols = append(symbols, inst.Underlying)
+		}
+		res.Instruments = append(res.Instruments, archive.Instrument{
+			Symbol:          inst.Symbol,
+			Name:            inst.Name,
+			AssetClass:      inst.AssetClass,
+			Sector:          inst.Sector,
+			Country:         inst.Country,
+			Region:          inst.Region,
+			Currency:        inst.Currency,
+			LotSize:         inst.LotSize,
+			Duration:        inst.Duration,
+			Tags:            inst.Tags,
+			Coupon:          inst.Coupon,
+			CouponFrequency: inst.CouponFrequency,
+			DayCount:        inst.DayCount,
+			Maturity:        inst.Maturity,
+			FaceValue:       inst.FaceValue,
+			Underlying:      inst.Underlying,
+			OptionType:      inst.OptionType,
+			Strike:          inst.Strike,
+			Expiry:          inst.Expiry,
+			Multiplier:      inst.Multiplier,
+			FuturesOption:   inst.FuturesOption,
+		})
+	}
+	sort.Slice(res.Instruments, func(i, j int) bool { return res.Instruments[i].Symbol < res.Instruments[j].Symbol })
+	return res
+}
+
+// archiveTransaction returns the archived form of a ledger transaction.
+func archiveTransaction(tx ledger.Transaction) archive.Transaction {
+	return archive.Transaction{
+		ID:            tx.ID,
+		Date:          tx.Date,
+		Type:          string(tx.Type),
+		Account:       tx.Account,
+		Symbol:        tx.Symbol,
+		Quantity:      tx.Quantity,
+		Price:         tx.Price,
+		Amount:        tx.Amount,
+		Fee:           tx.Fee,
+		Note:          tx.Note,
+		ExDate:        tx.ExDate,
+		Withholding:   tx.Withholding,
+		Qualified:     tx.Qualified,
+		NewSymbol:     tx.NewSymbol,
+		Ratio:         tx.Ratio,
+		BasisFraction: tx.BasisFraction,
+		CashInLieu:    tx.CashInLieu,
+	}
+}
+
+// ledgerTransaction returns the ledger transaction of an archived one.
+func ledgerTransaction(tx archive.Transaction) ledger.Transaction {
+	return ledger.Transaction{
+		ID:            tx.ID,
+		Date:          tx.Date,
+		Type:          ledger.TxType(tx.Type),
+		Account:       tx.Account,
+		Symbol:        tx.Symbol,
+		Quantity:      tx.Quantity,
+		Price:         tx.Price,
+		Amount:        tx.Amount,
+		Fee:           tx.Fee,
+		Note:          tx.Note,
+		ExDate:        tx.ExDate,
+		Withholding:   tx.Withholding,
+		Qualified:     tx.Qualified,
+		NewSymbol:     tx.NewSymbol,
+		Ratio:         tx.Ratio,
+		BasisFraction: tx.BasisFraction,
+		CashInLieu:    tx.CashInLieu,
+	}
+}
+
+// ImportArchive imports a portfolio archive, migrating older schema versions.
+// The portfolio is rebuilt in full before it is stored, so a failed import
+// leaves the existing portfolio untouched.
+func (s *PortfolioService) ImportArchive(ctx context.Context, p *genportfolio.ImportArchivePayload) (*genportfolio.ArchiveImport, error) {
+	s.logger.DebugContext(ctx, "portfolio.importArchive", "portfolio_id", p.PortfolioID, "replace", p.Replace)
+	a, version, err := archive.Read(strings.NewReader(p.Content))
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	pf, instruments, err := restorePortfolio(a.Portfolio)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	if err := s.configure(pf); err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	if p.PortfolioID != nil && *p.PortfolioID != "" {
+		pf.id = *p.PortfolioID
+	}
+
+	s.mu.Lock()
+	_, exists := s.portfolios[pf.id]
+	if exists && !p.Replace {
+		s.mu.Unlock()
+		return nil, genportfolio.BadRequest(fmt.Sprintf("portfolio %q exists; set replace to overwrite it", pf.id))
+	}
+	s.portfolios[pf.id] = pf
+	// New orders and breaks are numbered after the imported ones.
+	for _, o := range pf.orders {
+		advanceSeq(&s.orderSeq, "ord-%d", o.ID)
+	}
+	for _, b := range pf.breaks {
+		advanceSeq(&s.breakSeq, "brk-%d", b.ID)
+	}
+	s.mu.Unlock()
+	s.instruments.Put(instruments...)
+
+	return &genportfolio.ArchiveImport{
+		PortfolioID:    pf.id,
+		SchemaVersion:  version,
+		Migrated:       version < archive.SchemaVersion(),
+		Replaced:       exists,
+		CreatedAt:      a.CreatedAt.Format(time.RFC3339),
+		Transactions:   len(a.Portfolio.Transactions),
+		Instruments:    len(instruments),
+		Targets:        len(pf.targets),
+		Accounts:       len(pf.accounts),
+		MarginAccounts: len(pf.margin),
+	}, nil
+}
+
+// advanceSeq raises seq to the number of id, read with format, when higher.
+func advanceSeq(seq *int, format, id string) {
+	var n int
+	if _, err := fmt.Sscanf(id, format, &n); err == nil && n > *seq {
+		*seq = n
+	}
+}
+
+// configure registers the configured accounts and margin accounts in pf,
+// which is not shared yet. Those pf already has are kept.
+func (s *PortfolioService) configure(pf *portfolioState) error {
+	s.mu.RLock()
+	accts, terms := s.configuredAccounts, s.configuredMargin
+	s.mu.RUnlock()
+	for _, a := range accts {
+		a = a.WithDefaults(pf.ledger.Currency())
+		if _, ok := pf.accounts[a.ID]; ok {
+			continue
+		}
+		if err := validateAccount(a, pf.ledger.Currency()); err != nil {
+			return err
+		}
+		pf.accounts[a.ID] = a
+	}
+	for _, t := range terms {
+		if _, ok := pf.margin[t.Account]; ok {
+			continue
+		}
+		pf.margin[t.Account] = &marginAccount{terms: t}
+		pf.ledger.SetMarginAccount(t.Account, true)
+	}
+	return nil
+}
+
+// restorePortfolio rebuilds the state of an archived portfolio and returns it
+// with the instruments it refers to, after checking its settings.
+func restorePortfolio(a archive.Portfolio) (*portfolioState, []reference.Instrument, error) {
+	pf := &portfolioState{
+		id:       a.ID,
+		ledger:   ledger.New(a.Currency),
+		accounts: map[string]accounts.Account{},
+		margin:   map[string]*marginAccount{},
+		paper:    a.Paper,
+	}
+	for _, acct := range a.Accounts {
+		restored := accounts.Account{
+			ID:           acct.ID,
+			Name:         acct.Name,
+			Type:         accounts.Type(acct.Type),
+			TaxTreatment: accounts.TaxTreatment(acct.TaxTreatment),
+			Custodian:    acct.Custodian,
+			Currency:     acct.Currency,
+		}.WithDefaults(a.Currency)
+		if err := validateAccount(restored, a.Currency); err != nil {
+			return nil, nil, err
+		}
+		pf.accounts[restored.ID] = restored
+	}
+	for _, t := range a.Targets {
+		pf.targets = append(pf.targets, rebalance.Target{Symbol: t.Symbol, Weight: t.Weight, Tolerance: t.Tolerance})
+	}
+	if err := rebalance.ValidateTargets(pf.targets); err != nil {
+		return nil, nil, err
+	}
+	if b := a.Settings.Benchmark; b != nil {
+		pf.benchmark = benchmark.Definition{Name: b.Name, Rebalance: benchmark.Rebalance(b.Rebalance)}
+		for _, c := range b.Components {
+			pf.benchmark.Components = append(pf.benchmark.Components, benchmark.Component{Symbol: c.Symbol, Weight: c.Weight})
+		}
+		if err := pf.benchmark.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, m := range a.Settings.MarginAccounts {
+		t := margin.Terms{
+			Account:                     m.Account,
+			InitialRequirement:          m.InitialRequirement,
+			MaintenanceRequirement:      m.MaintenanceRequirement,
+			ShortMaintenanceRequirement: m.ShortMaintenanceRequirement,
+			LoanRate:                    m.LoanRate,
+			BorrowRate:                  m.BorrowRate,
+			BorrowRates:                 m.BorrowRates,
+		}.WithDefaults()
+		if err := t.Validate(); err != nil {
+			return nil, nil, err
+		}
+		pf.margin[t.Account] = &marginAccount{terms: t, charged: m.ChargedThrough}
+		pf.ledger.SetMarginAccount(t.Account, true)
+	}
+
+	seen := map[string]bool{}
+	for _, tx := range a.Transactions {
+		if tx.ID == "" {
+			return nil, nil, errors.New("transaction without an id")
+		}
+		if seen[tx.ID] {
+			return nil, nil, fmt.Errorf("duplicate transaction %s", tx.ID)
+		}
+		seen[tx.ID] = true
+		if _, err := pf.ledger.Post(ledgerTransaction(tx)); err != nil {
+			return nil, nil, fmt.Errorf("transaction %s: %w", tx.ID, err)
+		}
+	}
+
+	if len(a.Orders) > 0 && !a.Paper {
+		return nil, nil, errors.New("orders in a portfolio that is not a paper portfolio")
+	}
+	for _, ao := range a.Orders {
+		o := &orders.Order{
+			ID:          ao.ID,
+			Account:     ao.Account,
+			Symbol:      ao.Symbol,
+			Side:        orders.Side(ao.Side),
+			Type:        orders.Type(ao.Type),
+			Quantity:    ao.Quantity,
+			LimitPrice:  ao.LimitPrice,
+			StopPrice:   ao.StopPrice,
+			TimeInForce: orders.TimeInForce(ao.TimeInForce),
+			Created:     ao.Created,
+			Status:      orders.Status(ao.Status),
+			Triggered:   ao.Triggered,
+			Updated:     ao.Updated,
+			FillPrice:   ao.FillPrice,
+			Commission:  ao.Commission,
+			Reason:      ao.Reason,
+		}
+		if o.ID == "" {
+			return nil, nil, errors.New("order without an id")
+		}
+		if err := o.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("order %s: %w", o.ID, err)
+		}
+		pf.orders = append(pf.orders, o)
+	}
+	for _, ab := range a.Breaks {
+		if ab.ID == "" {
+			return nil, nil, errors.New("reconciliation break without an id")
+		}
+		b := &reconcile.Break{
+			ID:        ab.ID,
+			Account:   ab.Account,
+			AsOf:      ab.AsOf,
+			Kind:      reconcile.Kind(ab.Kind),
+			Symbol:    ab.Symbol,
+			Statement: ab.Statement,
+			Ledger:    ab.Ledger,
+			Price:     ab.Price,
+			Status:    reconcile.Status(ab.Status),
+			Note:      ab.Note,
+			Detected:  ab.Detected,
+			Updated:   ab.Updated,
+			Posted:    ab.Posted,
+		}
+		for _, tx := range ab.Adjustments {
+			b.Adjustments = append(b.Adjustments, ledgerTransaction(tx))
+		}
+		pf.breaks = append(pf.breaks, b)
+	}
+
+	instruments := make([]reference.Instrument, 0, len(a.Instruments))
+	for _, inst := range a.Instruments {
+		if inst.Symbol == "" {
+			return nil, nil, errors.New("instrument without a symbol")
+		}
+		instruments = append(instruments, reference.Instrument{
+			Symbol:          inst.Symbol,
+			Name:            inst.Name,
+			AssetClass:      inst.AssetClass,
+			Sector:          inst.Sector,
+			Country:         inst.Country,
+			Region:          inst.Region,
+			Currency:        inst.Currency,
+			LotSize:         inst.LotSize,
+			Duration:        inst.Duration,
+			Tags:            inst.Tags,
+			Coupon:          inst.Coupon,
+			CouponFrequency: inst.CouponFrequency,
+			DayCount:        inst.DayCount,
+			Maturity:        inst.Maturity,
+			FaceValue:       inst.FaceValue,
+			Underlying:      inst.Underlying,
+			OptionType:      inst.OptionType,
+			Strike:          inst.Strike,
+			Expiry:          inst.Expiry,
+			Multiplier:      inst.Multiplier,
+			FuturesOption:   inst.FuturesOption,
+		})
+	}
+	return pf, instruments, nil
+}
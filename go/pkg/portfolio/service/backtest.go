@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/backtest"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+)
+
+// RunBacktest replays stored prices through an allocation strategy with the
+// instrument lot sizes and the default risk-free rate.
+func (s *PortfolioService) RunBacktest(ctx context.Context, p *genportfolio.RunBacktestPayload) (*genportfolio.BacktestResult, error) {
+	s.logger.DebugContext(ctx, "portfolio.runBacktest", "strategy", p.Strategy, "start", p.Start, "end", p.End)
+	st := backtest.Strategy{
+		Name:         p.Name,
+		Kind:         backtest.Kind(p.Strategy),
+		Start:        p.Start,
+		End:          p.End,
+		Currency:     p.Currency,
+		InitialCash:  p.InitialCash,
+		Targets:      make([]backtest.Target, len(p.Targets)),
+		Frequency:    backtest.Frequency(p.Frequency),
+		Threshold:    p.Threshold,
+		Contribution: p.Contribution,
+		FeeRate:      p.FeeRate,
+	}
+	for i, t := range p.Targets {
+		st.Targets[i] = backtest.Target{Symbol: t.Symbol, Weight: t.Weight}
+	}
+	res, err := backtest.Run(st, s.prices, backtest.Options{LotSize: s.lotSize, RiskFreeRate: s.rate()})
+	if errors.Is(err, backtest.ErrInvalidStrategy) || errors.Is(err, marketdata.ErrNoData) {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out := &genportfolio.BacktestResult{
+		Name:                res.Strategy.Name,
+		Strategy:            string(res.Strategy.Kind),
+		Start:               res.Returns.From.Format(time.DateOnly),
+		End:                 res.Returns.To.Format(time.DateOnly),
+		Currency:            res.Strategy.Currency,
+		EndValue:            res.Returns.EndValue,
+		NetContributions:    res.Returns.NetContributions,
+		Gain:                res.Returns.Gain,
+		TimeWeightedReturn:  res.Returns.TimeWeighted,
+		AnnualizedReturn:    res.Returns.AnnualizedTimeWeighted,
+		MoneyWeightedReturn: res.Returns.MoneyWeighted,
+		Trades:              res.Trades,
+		TradedValue:         res.TradedValue,
+		Fees:                res.Fees,
+		Turnover:            res.Turnover,
+		EquityCurve:         make([]*genportfolio.BacktestPoint, len(res.Points)),
+	}
+	if m := res.Metrics; m != nil {
+		out.Volatility, out.SharpeRatio, out.MaxDrawdown = m.Volatility, m.Sharpe, m.MaxDrawdown
+		if m.MaxDrawdown > 0 {
+			peak, trough := m.DrawdownPeak.Format(time.DateOnly), m.DrawdownTrough.Format(time.DateOnly)
+			out.MaxDrawdownPeak, out.MaxDrawdownTrough = &peak, &trough
+		}
+	}
+	returns := performance.DailyReturns(res.Points)
+	index, peak := 1.0, 1.0
+	for i, pt := range res.Points {
+		if i > 0 {
+			index *= 1 + returns[i-1]
+			peak = max(peak, index)
+		}
+		out.EquityCurve[i] = &genportfolio.BacktestPoint{
+			Date:     pt.Date.Format(time.DateOnly),
+			Value:    pt.Value,
+			Flow:     pt.Flow,
+			Drawdown: 1 - index/peak,
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/benchmark"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+)
+
+// SetBenchmark replaces the benchmark of a portfolio.
+func (s *PortfolioService) SetBenchmark(ctx context.Context, p *genportfolio.SetBenchmarkPayload) (*genportfolio.BenchmarkDefinition, error) {
+	s.logger.DebugContext(ctx, "portfolio.setBenchmark", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	def := benchmark.Definition{Name: p.Benchmark.Name, Rebalance: benchmark.Rebalance(p.Benchmark.Rebalance)}
+	for _, c := range p.Benchmark.Components {
+		def.Components = append(def.Components, benchmark.Component{Symbol: c.Symbol, Weight: c.Weight})
+	}
+	if p.Benchmark.Blend != nil {
+		if len(def.Components) > 0 {
+			return nil, genportfolio.BadRequest("set either components or blend")
+		}
+		if def.Components, err = benchmark.Parse(*p.Benchmark.Blend); err != nil {
+			return nil, genportfolio.BadRequest(err.Error())
+		}
+	}
+	if err := def.Validate(); err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	for _, c := range def.Components {
+		if _, err := s.market.Close(c.Symbol, s.now()); err != nil {
+			return nil, genportfolio.BadRequest(err.Error())
+		}
+	}
+
+	s.mu.Lock()
+	pf.benchmark = def
+	s.mu.Unlock()
+	return toBenchmarkDefinition(def), nil
+}
+
+// GetBenchmarkComparison compares portfolio returns with its benchmark.
+func (s *PortfolioService) GetBenchmarkComparison(ctx context.Context, p *genportfolio.GetBenchmarkComparisonPayload) (*genportfolio.BenchmarkComparison, error) {
+	s.logger.DebugContext(ctx, "portfolio.getBenchmarkComparison", "portfolio_id", p.PortfolioID, "period", p.Period)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := s.resolvePeriod(pf.ledger, performance.Period(p.Period), p.Start, p.End)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	def := pf.benchmark
+	s.mu.RUnlock()
+	if len(def.Components) == 0 {
+		return nil, genportfolio.NotFound("portfolio " + pf.id + " has no benchmark")
+	}
+
+	points, err := performance.Series(pf.ledger, s.prices, from, to)
+	if err != nil {
+		return nil, err
+	}
+	cmp, err := benchmark.Compare(def, s.market, points)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &genportfolio.BenchmarkComparison{
+		PortfolioID:     pf.id,
+		Benchmark:       toBenchmarkDefinition(def),
+		Start:           from.Format(time.DateOnly),
+		End:             to.Format(time.DateOnly),
+		PortfolioReturn: cmp.PortfolioReturn,
+		BenchmarkReturn: cmp.BenchmarkReturn,
+		ExcessReturn:    cmp.ExcessReturn,
+		TrackingError:   cmp.TrackingError,
+		Series:          make([]*genportfolio.BenchmarkComparisonPoint, len(cmp.Points)),
+	}
+	for i, pt := range cmp.Points {
+		res.Series[i] = &genportfolio.BenchmarkComparisonPoint{
+			Date:      pt.Date.Format(time.DateOnly),
+			Portfolio: pt.Portfolio,
+			Benchmark: pt.Benchmark,
+		}
+	}
+	return res, nil
+}
+
+func toBenchmarkDefinition(def benchmark.Definition) *genportfolio.BenchmarkDefinition {
+	blend := def.String()
+	res := &genportfolio.BenchmarkDefinition{Name: def.Name, Blend: &blend, Rebalance: string(def.Rebalance)}
+	for _, c := range def.Components {
+		res.Components = append(res.Components, &genportfolio.BenchmarkComponent{Symbol: c.Symbol, Weight: c.Weight})
+	}
+	return res
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/fixedincome"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reference"
+)
+
+// PriceBond computes bond analytics from either a clean price or a yield.
+func (s *PortfolioService) PriceBond(ctx context.Context, p *genportfolio.PriceBondPayload) (*genportfolio.BondAnalytics, error) {
+	s.logger.DebugContext(ctx, "portfolio.priceBond", "maturity", p.Maturity)
+	maturity, err := parseDate(&p.Maturity)
+	if err != nil {
+		return nil, err
+	}
+	settle := marketdata.Day(s.now())
+	if p.Settlement != nil {
+		d, err := parseDate(p.Settlement)
+		if err != nil {
+			return nil, err
+		}
+		settle = *d
+	}
+	b := fixedincome.Bond{
+		Coupon:    p.Coupon,
+		Frequency: p.CouponFrequency,
+		DayCount:  fixedincome.DayCount(p.DayCount),
+		Maturity:  *maturity,
+		Face:      p.FaceValue,
+	}
+
+	var a fixedincome.Analytics
+	switch {
+	case p.CleanPrice != nil && p.Yield == nil:
+		a, err = b.FromPrice(settle, *p.CleanPrice)
+	case p.Yield != nil && p.CleanPrice == nil:
+		a, err = b.FromYield(settle, *p.Yield)
+	default:
+		return nil, genportfolio.BadRequest("specify either clean_price or yield")
+	}
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	return toBondAnalytics(settle, a), nil
+}
+
+// ListBondPositions values the bonds held in a portfolio. Bonds at or past
+// maturity have no yield to report and are left out.
+func (s *PortfolioService) ListBondPositions(ctx context.Context, p *genportfolio.ListBondPositionsPayload) ([]*genportfolio.BondPosition, error) {
+	s.logger.DebugContext(ctx, "portfolio.listBondPositions", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	settle := marketdata.Day(now)
+	res := []*genportfolio.BondPosition{}
+	for _, h := range s.heldBonds(pf, now) {
+		if !settle.Before(h.bond.Maturity) {
+			continue
+		}
+		clean, err := s.market.Close(h.inst.Symbol, now)
+		if err != nil {
+			return nil, err
+		}
+		a, err := h.bond.FromPrice(settle, clean)
+		if err != nil {
+			return nil, genportfolio.BadRequest(fmt.Sprintf("%s: %v", h.inst.Symbol, err))
+		}
+		res = append(res, &genportfolio.BondPosition{
+			Symbol:          h.inst.Symbol,
+			Quantity:        h.quantity,
+			Coupon:          h.bond.Coupon,
+			CouponFrequency: h.bond.Frequency,
+			DayCount:        string(h.bond.DayCount),
+			Maturity:        h.inst.Maturity,
+			FaceValue:       h.bond.Face,
+			Analytics:       toBondAnalytics(settle, a),
+			MarketValue:     h.quantity * a.Dirty,
+			AccruedValue:    h.quantity * a.Accrued,
+		})
+	}
+	return res, nil
+}
+
+// GetCashFlowLadder lists the coupon and principal payments expected from the
+// bonds held over the coming years.
+func (s *PortfolioService) GetCashFlowLadder(ctx context.Context, p *genportfolio.GetCashFlowLadderPayload) (*genportfolio.CashFlowLadder, error) {
+	s.logger.DebugContext(ctx, "portfolio.getCashFlowLadder", "portfolio_id", p.PortfolioID, "years", p.Years)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	from := marketdata.Day(now)
+	to := from.AddDate(p.Years, 0, 0)
+	res := &genportfolio.CashFlowLadder{
+		PortfolioID: pf.id,
+		AsOf:        from.Format(time.DateOnly),
+		End:         to.Format(time.DateOnly),
+		Currency:    pf.ledger.Currency(),
+		Flows:       []*genportfolio.BondCashFlow{},
+		ByYear:      []*genportfolio.CashFlowLadderYear{},
+	}
+	byYear := map[int]*genportfolio.CashFlowLadderYear{}
+	for _, h := range s.heldBonds(pf, now) {
+		// Payments due today have not been received yet.
+		for _, cf := range h.bond.CashFlows(from.AddDate(0, 0, -1), to) {
+			coupon, principal := h.quantity*cf.Coupon, h.quantity*cf.Principal
+			res.Flows = append(res.Flows, &genportfolio.BondCashFlow{
+				Date:      cf.Date.Format(time.DateOnly),
+				Symbol:    h.inst.Symbol,
+				Coupon:    coupon,
+				Principal: principal,
+				Total:     coupon + principal,
+			})
+			y, ok := byYear[cf.Date.Year()]
+			if !ok {
+				y = &genportfolio.CashFlowLadderYear{Year: cf.Date.Year()}
+				byYear[cf.Date.Year()] = y
+				res.ByYear = append(res.ByYear, y)
+			}
+			y.Coupon += coupon
+			y.Principal += principal
+			y.Total += coupon + principal
+			res.Total += coupon + principal
+		}
+	}
+	sort.SliceStable(res.Flows, func(i, j int) bool { return res.Flows[i].Date < res.Flows[j].Date })
+	sort.Slice(res.ByYear, func(i, j int) bool { return res.ByYear[i].Year < res.ByYear[j].Year })
+	return res, nil
+}
+
+// heldBond is a bond position with its parsed terms.
+type heldBond struct {
+	inst     reference.Instrument
+	bond     fixedincome.Bond
+	quantity float64
+}
+
+// heldBonds returns the long bond positions of pf at asOf, sorted by symbol.
+// Instruments with unusable terms are logged and skipped.
+func (s *PortfolioService) heldBonds(pf *portfolioState, asOf time.Time) []heldBond {
+	var res []heldBond
+	for sym, qty := range pf.ledger.Snapshot(asOf).Quantities {
+		inst, ok := s.instruments.Get(sym)
+		if !ok || !inst.IsBond() || qty <= 0 {
+			continue
+		}
+		b, err := bondTerms(inst)
+		if err != nil {
+			s.logger.Warn("skipping bond with invalid terms", "symbol", sym, "error", err)
+			continue
+		}
+		res = append(res, heldBond{inst: inst, bond: b, quantity: qty})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].inst.Symbol < res[j].inst.Symbol })
+	return res
+}
+
+// bondTerms reads the bond terms of an instrument. Face value defaults to 100
+// and the day count to 30/360.
+func bondTerms(inst reference.Instrument) (fixedincome.Bond, error) {
+	maturity, err := time.Parse(time.DateOnly, inst.Maturity)
+	if err != nil {
+		return fixedincome.Bond{}, fmt.Errorf("%w: bad maturity %q", fixedincome.ErrInvalidBond, inst.Maturity)
+	}
+	b := fixedincome.Bond{
+		Coupon:    inst.Coupon,
+		Frequency: inst.CouponFrequency,
+		DayCount:  fixedincome.DayCount(inst.DayCount),
+		Maturity:  maturity,
+		Face:      inst.FaceValue,
+	}
+	if b.DayCount == "" {
+		b.DayCount = fixedincome.Thirty360
+	}
+	if b.Face == 0 {
+		b.Face = 100
+	}
+	return b, b.Validate()
+}
+
+// bondDuration returns the modified duration of a bond at its latest clean
+// price, or zero when it cannot be computed.
+func (s *PortfolioService) bondDuration(inst reference.Instrument, asOf time.Time) float64 {
+	b, err := bondTerms(inst)
+	if err != nil {
+		return 0
+	}
+	clean, err := s.market.Close(inst.Symbol, asOf)
+	if err != nil {
+		return 0
+	}
+	a, err := b.FromPrice(marketdata.Day(asOf), clean)
+	if err != nil {
+		return 0
+	}
+	return a.Modified
+}
+
+func toBondAnalytics(settle time.Time, a fixedincome.Analytics) *genportfolio.BondAnalytics {
+	return &genportfolio.BondAnalytics{
+		Settlement:       settle.Format(time.DateOnly),
+		CleanPrice:       a.Clean,
+		DirtyPrice:       a.Dirty,
+		AccruedInterest:  a.Accrued,
+		YieldToMaturity:  a.Yield,
+		MacaulayDuration: a.Macaulay,
+		ModifiedDuration: a.Modified,
+	}
+}
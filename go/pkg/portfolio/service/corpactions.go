@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/corpactions"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+)
+
+// LoadCorporateActions applies the corporate actions of a local YAML or CSV
+// file to every portfolio.
+func (s *PortfolioService) LoadCorporateActions(path string) error {
+	actions, err := corpactions.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, pf := range s.portfolios {
+		if _, err := postActions(pf.ledger, actions); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// ListCorporateActions returns the corporate actions in a portfolio ledger.
+func (s *PortfolioService) ListCorporateActions(ctx context.Context, p *genportfolio.ListCorporateActionsPayload) ([]*genportfolio.CorporateAction, error) {
+	s.logger.DebugContext(ctx, "portfolio.listCorporateActions", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	res := []*genportfolio.CorporateAction{}
+	for _, tx := range pf.ledger.Transactions() {
+		if tx.IsCorporateAction() {
+			res = append(res, toCorporateAction(tx))
+		}
+	}
+	return res, nil
+}
+
+// ApplyCorporateActions records corporate actions in a portfolio ledger.
+func (s *PortfolioService) ApplyCorporateActions(ctx context.Context, p *genportfolio.ApplyCorporateActionsPayload) ([]*genportfolio.CorporateAction, error) {
+	s.logger.DebugContext(ctx, "portfolio.applyCorporateActions", "portfolio_id", p.PortfolioID, "count", len(p.Actions))
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	actions := make([]corpactions.Action, len(p.Actions))
+	for i, a := range p.Actions {
+		actions[i] = corpactions.Action{
+			Date:          a.Date,
+			Type:          a.Type,
+			Symbol:        a.Symbol,
+			Ratio:         a.Ratio,
+			CashPerShare:  a.CashPerShare,
+			BasisFraction: a.BasisFraction,
+			Price:         a.Price,
+			CashInLieu:    a.CashInLieu,
+		}
+		if a.NewSymbol != nil {
+			actions[i].NewSymbol = *a.NewSymbol
+		}
+		if a.Note != nil {
+			actions[i].Note = *a.Note
+		}
+	}
+	posted, err := postActions(pf.ledger, actions)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	res := make([]*genportfolio.CorporateAction, len(posted))
+	for i, tx := range posted {
+		res[i] = toCorporateAction(tx)
+	}
+	return res, nil
+}
+
+// postActions posts every action or, when any of them fails, none.
+func postActions(l *ledger.Ledger, actions []corpactions.Action) ([]ledger.Transaction, error) {
+	txns := make([]ledger.Transaction, len(actions))
+	for i, a := range actions {
+		tx, err := a.Transaction()
+		if err != nil {
+			return nil, err
+		}
+		txns[i] = tx
+	}
+	return l.PostAll(txns...)
+}
+
+func toCorporateAction(tx ledger.Transaction) *genportfolio.CorporateAction {
+	a := corpactions.FromTransaction(tx)
+	res := &genportfolio.CorporateAction{
+		ID:            tx.ID,
+		Date:          a.Date,
+		Type:          a.Type,
+		Symbol:        a.Symbol,
+		Ratio:         a.Ratio,
+		CashPerShare:  a.CashPerShare,
+		BasisFraction: a.BasisFraction,
+		Price:         a.Price,
+		CashInLieu:    a.CashInLieu,
+	}
+	if a.NewSymbol != "" {
+		res.NewSymbol = &a.NewSymbol
+	}
+	if a.Note != "" {
+		res.Note = &a.Note
+	}
+	return res
+}
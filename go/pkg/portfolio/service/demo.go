@@ -0,0 +1,98 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/benchmark"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/rebalance"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reference"
+)
+
+// demoInstruments seeds simulated price history until a real market data
+// provider is configured.
+var demoInstruments = []struct {
+	symbol string
+	start  float64
+	drift  float64
+	vol    float64
+}{
+	{"AAPL", 150, 0.12, 0.28},
+	{"MSFT", 300, 0.10, 0.25},
+	{"SPY", 450, 0.08, 0.16},
+	{"AGG", 100, 0.03, 0.05},
+}
+
+// demoReference classifies the demo instruments; files loaded with
+// LoadInstruments override these entries.
+var demoReference = []reference.Instrument{
+	{Symbol: "AAPL", Name: "Apple Inc.", AssetClass: "equity", Sector: "Technology", Country: "US", Region: "North America", Currency: "USD", Tags: map[string]string{"style": "growth"}},
+	{Symbol: "MSFT", Name: "Microsoft Corp.", AssetClass: "equity", Sector: "Technology", Country: "US", Region: "North America", Currency: "USD", Tags: map[string]string{"style": "growth"}},
+	{Symbol: "SPY", Name: "SPDR S&P 500 ETF", AssetClass: "equity", Sector: "Diversified", Country: "US", Region: "North America", Currency: "USD", Tags: map[string]string{"style": "core"}},
+	{Symbol: "AGG", Name: "iShares Core US Aggregate Bond ETF", AssetClass: "fixed_income", Sector: "Government & Corporate Bonds", Country: "US", Region: "North America", Currency: "USD", Duration: 6, Tags: map[string]string{"style": "core"}},
+}
+
+// demoBenchmark is the classic 60/40 blend the demo portfolio is measured
+// against.
+var demoBenchmark = benchmark.Definition{
+	Name:       "60/40",
+	Components: []benchmark.Component{{Symbol: "SPY", Weight: 0.6}, {Symbol: "AGG", Weight: 0.4}},
+	Rebalance:  benchmark.RebalanceMonthly,
+}
+
+// demoTargets is the target allocation model of the demo portfolio.
+var demoTargets = []rebalance.Target{
+	{Symbol: "SPY", Weight: 0.40, Tolerance: 0.05},
+	{Symbol: "AAPL", Weight: 0.15, Tolerance: 0.05},
+	{Symbol: "MSFT", Weight: 0.15, Tolerance: 0.05},
+	{Symbol: "AGG", Weight: 0.25, Tolerance: 0.05},
+}
+
+// seedDemo fills market with three years of history and returns the ledger of
+// the demo portfolio: an initial deposit invested two years ago, a top-up a
+// year later and a recent withdrawal.
+func seedDemo(market *marketdata.Store, now time.Time) *ledger.Ledger {
+	from := marketdata.Day(now).AddDate(-3, 0, 0)
+	for i, inst := range demoInstruments {
+		market.Put(inst.symbol, marketdata.RandomWalk(int64(i+1), from, now, inst.start, inst.drift, inst.vol)...)
+	}
+
+	l := ledger.New("USD")
+	post := func(tx ledger.Transaction) {
+		if _, err := l.Post(tx); err != nil {
+			panic("demo ledger: " + err.Error())
+		}
+	}
+	buy := func(day time.Time, symbol string, budget float64) {
+		px, err := market.Close(symbol, day)
+		if err != nil {
+			panic("demo ledger: " + err.Error())
+		}
+		if qty := math.Floor(budget / px); qty > 0 {
+			post(ledger.Transaction{Date: day, Type: ledger.TxBuy, Symbol: symbol, Quantity: qty, Price: px})
+		}
+	}
+
+	inception := tradingDayOnOrAfter(marketdata.Day(now).AddDate(-2, 0, 0))
+	post(ledger.Transaction{Date: inception, Type: ledger.TxDeposit, Amount: 10000, Note: "initial deposit"})
+	buy(inception, "SPY", 4000)
+	buy(inception, "AAPL", 2000)
+	buy(inception, "MSFT", 2000)
+	buy(inception, "AGG", 1500)
+
+	topUp := tradingDayOnOrAfter(marketdata.Day(now).AddDate(-1, 0, 0))
+	post(ledger.Transaction{Date: topUp, Type: ledger.TxDeposit, Amount: 2500, Note: "annual contribution"})
+	buy(topUp, "AGG", 2000)
+
+	post(ledger.Transaction{Date: tradingDayOnOrAfter(marketdata.Day(now).AddDate(0, 0, -90)), Type: ledger.TxWithdrawal, Amount: 500})
+	return l
+}
+
+func tradingDayOnOrAfter(day time.Time) time.Time {
+	for !marketdata.IsTradingDay(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
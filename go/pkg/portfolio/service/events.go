@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+)
+
+// maxEvents is the number of recent events kept.
+const maxEvents = 1000
+
+// Event types.
+const (
+	eventMarginCall  = "margin_call"
+	eventOrderStatus = "order_status"
+)
+
+// event is a notable portfolio event.
+type event struct {
+	id          int
+	time        time.Time
+	portfolioID string
+	typ         string
+	account     string
+	message     string
+}
+
+// emit logs an event at level and keeps it for ListEvents.
+func (s *PortfolioService) emit(level slog.Level, portfolioID, typ, account, message string) {
+	s.logger.Log(context.Background(), level, message, "portfolio_id", portfolioID, "event", typ, "account", account)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eventSeq++
+	s.events = append(s.events, event{
+		id:          s.eventSeq,
+		time:        s.now(),
+		portfolioID: portfolioID,
+		typ:         typ,
+		account:     account,
+		message:     message,
+	})
+	if len(s.events) > maxEvents {
+		s.events = append([]event(nil), s.events[len(s.events)-maxEvents:]...)
+	}
+}
+
+// ListEvents lists the recent events of a portfolio, oldest first.
+func (s *PortfolioService) ListEvents(ctx context.Context, p *genportfolio.ListEventsPayload) ([]*genportfolio.PortfolioEvent, error) {
+	s.logger.DebugContext(ctx, "portfolio.listEvents", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	var since time.Time
+	if p.Since != nil {
+		if since, err = time.Parse(time.RFC3339, *p.Since); err != nil {
+			return nil, genportfolio.BadRequest(err.Error())
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := []*genportfolio.PortfolioEvent{}
+	for _, e := range s.events {
+		if e.portfolioID != pf.id || !e.time.After(since) || (p.Type != nil && *p.Type != e.typ) {
+			continue
+		}
+		ev := &genportfolio.PortfolioEvent{
+			ID:          e.id,
+			Time:        e.time.Format(time.RFC3339),
+			PortfolioID: e.portfolioID,
+			Type:        e.typ,
+			Message:     e.message,
+		}
+		if e.account != "" {
+			account := e.account
+			ev.Account = &account
+		}
+		res = append(res, ev)
+	}
+	return res, nil
+}
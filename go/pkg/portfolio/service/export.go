@@ -0,0 +1,106 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/journal"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+)
+
+// journalExtensions are the file extensions of journal downloads by format.
+var journalExtensions = map[journal.Format]string{
+	journal.FormatBeancount: ".beancount",
+	journal.FormatHledger:   ".journal",
+}
+
+// ExportJournal exports the ledger of a portfolio as a Beancount or hledger
+// journal.
+func (s *PortfolioService) ExportJournal(ctx context.Context, p *genportfolio.ExportJournalPayload) (*genportfolio.ExportFile, error) {
+	s.logger.DebugContext(ctx, "portfolio.exportJournal", "portfolio_id", p.PortfolioID, "format", p.Format)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	format, err := journal.ParseFormat(p.Format)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+
+	names := map[string]string{}
+	for _, symbol := range ledgerSymbols(pf.ledger) {
+		if inst, ok := s.instruments.Get(symbol); ok && inst.Name != "" {
+			names[symbol] = inst.Name
+		}
+	}
+	var buf bytes.Buffer
+	err = journal.Write(&buf, pf.ledger, journal.Options{
+		Format: format,
+		Title:  "Portfolio " + pf.id,
+		Names:  names,
+		Prices: s.journalPrices(pf.ledger, s.now()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &genportfolio.ExportFile{
+		ContentDisposition: fmt.Sprintf("attachment; filename=%q", pf.id+journalExtensions[format]),
+		Content:            buf.String(),
+	}, nil
+}
+
+// ledgerSymbols returns the symbols traded or received in the ledger, sorted.
+func ledgerSymbols(l *ledger.Ledger) []string {
+	seen := map[string]bool{}
+	for _, tx := range l.Transactions() {
+		for _, symbol := range []string{tx.Symbol, tx.NewSymbol} {
+			if symbol != "" {
+				seen[symbol] = true
+			}
+		}
+	}
+	symbols := make([]string, 0, len(seen))
+	for symbol := range seen {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// journalPrices returns the closes of the instruments held at each month end
+// since inception and at now. Instruments without prices are left out.
+func (s *PortfolioService) journalPrices(l *ledger.Ledger, now time.Time) []journal.Price {
+	inception := l.Inception()
+	if inception.IsZero() {
+		return nil
+	}
+	now = marketdata.Day(now)
+	var dates []time.Time
+	for d := marketdata.Day(inception).AddDate(0, 0, 1-inception.Day()).AddDate(0, 1, -1); d.Before(now); d = d.AddDate(0, 0, 1).AddDate(0, 1, -1) {
+		dates = append(dates, d)
+	}
+	dates = append(dates, now)
+
+	var prices []journal.Price
+	for _, d := range dates {
+		held := l.Snapshot(d).Quantities
+		symbols := make([]string, 0, len(held))
+		for symbol, qty := range held {
+			if qty != 0 {
+				symbols = append(symbols, symbol)
+			}
+		}
+		sort.Strings(symbols)
+		for _, symbol := range symbols {
+			if px, err := s.prices.Close(symbol, d); err == nil {
+				prices = append(prices, journal.Price{Date: d, Symbol: symbol, Close: px})
+			}
+		}
+	}
+	return prices
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/tax"
+)
+
+// SetHarvestReplacements sets the candidate replacements of symbols sold to
+// harvest a loss, in order of preference. Symbols are upper-cased, as
+// configuration keys may not keep their case.
+func (s *PortfolioService) SetHarvestReplacements(replacements map[string][]string) error {
+	normalized := make(map[string][]string, len(replacements))
+	for symbol, candidates := range replacements {
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		for _, c := range candidates {
+			c = strings.ToUpper(strings.TrimSpace(c))
+			if c == "" || c == symbol {
+				return fmt.Errorf("harvest replacements of %s: invalid replacement %q", symbol, c)
+			}
+			normalized[symbol] = append(normalized[symbol], c)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.harvestReplacements = normalized
+	return nil
+}
+
+// FindTaxLossHarvests suggests sales of lots at a loss paired with
+// replacements, avoiding wash sales.
+func (s *PortfolioService) FindTaxLossHarvests(ctx context.Context, p *genportfolio.FindTaxLossHarvestsPayload) (*genportfolio.TaxLossHarvest, error) {
+	s.logger.DebugContext(ctx, "portfolio.findTaxLossHarvests", "portfolio_id", p.PortfolioID, "min_loss", p.MinLoss)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	adjusted := s.adjustedLots(pf, now)
+	sheltered := s.sheltered(pf)
+	s.mu.RLock()
+	opts := tax.HarvestOptions{
+		AsOf:          now,
+		MinLoss:       p.MinLoss,
+		ShortTermRate: p.ShortTermRate,
+		LongTermRate:  p.LongTermRate,
+		Replacements:  s.harvestReplacements,
+		Equivalents:   s.equivalents,
+		Sheltered:     sheltered,
+		Price: func(symbol string) (float64, bool) {
+			px, err := s.prices.Close(symbol, now)
+			return px, err == nil
+		},
+		LotSize: s.lotSize,
+	}
+	s.mu.RUnlock()
+	plan := tax.FindHarvests(adjusted, opts)
+
+	res := &genportfolio.TaxLossHarvest{
+		PortfolioID:   pf.id,
+		AsOf:          now.Format(time.DateOnly),
+		Opportunities: []*genportfolio.HarvestOpportunity{},
+		Skipped:       []*genportfolio.SkippedHarvest{},
+	}
+	for _, h := range plan.Harvests {
+		if p.Account != nil && *p.Account != h.Account {
+			continue
+		}
+		o := &genportfolio.HarvestOpportunity{
+			Account:          h.Account,
+			Symbol:           h.Symbol,
+			Lots:             h.LotIDs,
+			Quantity:         h.Quantity,
+			Price:            h.Price,
+			CostBasis:        h.CostBasis,
+			MarketValue:      h.MarketValue,
+			Loss:             h.Loss(),
+			ShortTermLoss:    h.ShortTermLoss,
+			LongTermLoss:     h.LongTermLoss,
+			EstimatedSavings: h.Savings,
+			RepurchaseAfter:  h.RepurchaseAfter.Format(time.DateOnly),
+		}
+		if h.Replacement != "" {
+			o.Replacement = &h.Replacement
+		}
+		if h.ReplacementPrice > 0 {
+			o.ReplacementPrice, o.ReplacementQuantity = &h.ReplacementPrice, &h.ReplacementQuantity
+		}
+		res.Opportunities = append(res.Opportunities, o)
+		res.TotalLoss += h.Loss()
+		res.EstimatedSavings += h.Savings
+	}
+	for _, sk := range plan.Skipped {
+		if p.Account != nil && *p.Account != sk.Account {
+			continue
+		}
+		res.Skipped = append(res.Skipped, &genportfolio.SkippedHarvest{
+			Account: sk.Account,
+			Symbol:  sk.Symbol,
+			Loss:    sk.Loss,
+			Reason:  sk.Reason,
+		})
+	}
+	return res, nil
+}
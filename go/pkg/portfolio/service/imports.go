@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/journal"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/statement"
+)
+
+// AddImportProfiles adds or replaces CSV mapping profiles by name.
+func (s *PortfolioService) AddImportProfiles(profiles ...statement.Profile) error {
+	for i, p := range profiles {
+		profiles[i] = p.WithDefaults()
+		if err := profiles[i].Validate(); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range profiles {
+		s.importProfiles[p.Name] = p
+	}
+	return nil
+}
+
+// ImportCsv imports a broker CSV statement with a named mapping profile.
+func (s *PortfolioService) ImportCsv(ctx context.Context, p *genportfolio.ImportCsvPayload) (*genportfolio.ImportReport, error) {
+	s.logger.DebugContext(ctx, "portfolio.importCsv", "portfolio_id", p.PortfolioID, "profile", p.Profile, "dry_run", p.DryRun)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	profile, ok := s.importProfiles[p.Profile]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, genportfolio.BadRequest(fmt.Sprintf("unknown import profile %q", p.Profile))
+	}
+	rows, err := statement.ParseCSV(strings.NewReader(p.Content), profile)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	res, _ := s.importRows(pf, rows, p.Account, p.DryRun, "csv import: "+profile.Name)
+	res.Format, res.Profile = "csv", &profile.Name
+	return res, nil
+}
+
+// ImportOfx imports an OFX or QFX statement and reconciles its positions and
+// cash against the ledger.
+func (s *PortfolioService) ImportOfx(ctx context.Context, p *genportfolio.ImportOfxPayload) (*genportfolio.ImportReport, error) {
+	s.logger.DebugContext(ctx, "portfolio.importOfx", "portfolio_id", p.PortfolioID, "dry_run", p.DryRun)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	sts, err := statement.ParseOFX(strings.NewReader(p.Content))
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	var rows []statement.Row
+	accounts := make([]string, len(sts))
+	for i, st := range sts {
+		accounts[i] = p.Account
+		if accounts[i] == "" {
+			accounts[i] = st.Account
+		}
+		for _, r := range st.Rows {
+			r.Tx.Account = accounts[i]
+			rows = append(rows, r)
+		}
+	}
+	res, l := s.importRows(pf, rows, "", p.DryRun, "ofx import")
+	res.Format = "ofx"
+	res.Reconciliation = []*genportfolio.AccountReconciliation{}
+	for i, st := range sts {
+		if st.Positions != nil || st.Cash != nil {
+			res.Reconciliation = append(res.Reconciliation, s.reconcileStatement(l, accounts[i], st))
+		}
+	}
+	return res, nil
+}
+
+// ImportJournal imports the transactions of a Beancount or hledger journal.
+func (s *PortfolioService) ImportJournal(ctx context.Context, p *genportfolio.ImportJournalPayload) (*genportfolio.ImportReport, error) {
+	s.logger.DebugContext(ctx, "portfolio.importJournal", "portfolio_id", p.PortfolioID, "root", p.Root, "dry_run", p.DryRun)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := journal.Read(strings.NewReader(p.Content), journal.ReadOptions{Root: p.Root, Currency: pf.ledger.Currency()})
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	res, _ := s.importRows(pf, rows, p.Account, p.DryRun, "journal import")
+	res.Format = "journal"
+	return res, nil
+}
+
+// reconcileStatement compares the positions and cash of a statement with an
+// account in the ledger on the statement date.
+func (s *PortfolioService) reconcileStatement(l *ledger.Ledger, account string, st statement.Statement) *genportfolio.AccountReconciliation {
+	asOf := st.AsOf
+	if asOf.IsZero() {
+		asOf = s.now()
+	}
+	acct, ok := l.Snapshot(asOf).Accounts[account]
+	if !ok {
+		acct = &ledger.AccountSnapshot{Quantities: map[string]float64{}}
+	}
+	res := &genportfolio.AccountReconciliation{
+		Account:       account,
+		AsOf:          asOf.Format(time.DateOnly),
+		Positions:     []*genportfolio.PositionCheck{},
+		StatementCash: st.Cash,
+		LedgerCash:    acct.Cash,
+		Matched:       st.Cash == nil || math.Abs(*st.Cash-acct.Cash) < 0.005,
+	}
+	if st.Positions != nil {
+		for _, d := range statement.ReconcilePositions(st.Positions, acct.Quantities) {
+			res.Positions = append(res.Positions, &genportfolio.PositionCheck{
+				Symbol:            d.Symbol,
+				StatementQuantity: d.Statement,
+				LedgerQuantity:    d.Ledger,
+				Difference:        d.Statement - d.Ledger,
+				Matched:           d.Matched(),
+			})
+			res.Matched = res.Matched && d.Matched()
+		}
+	}
+	return res
+}
+
+// importRows deduplicates statement rows against the ledger and posts the new
+// ones in date order, or posts them to a copy of the ledger in a dry run.
+// Rows without an account are booked to account. It returns the report and the
+// ledger posted to.
+func (s *PortfolioService) importRows(pf *portfolioState, rows []statement.Row, account string, dryRun bool, note string) (*genportfolio.ImportReport, *ledger.Ledger) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := pf.ledger
+	if dryRun {
+		l = l.Clone()
+	}
+	for i := range rows {
+		if rows[i].Tx.Account == "" {
+			rows[i].Tx.Account = account
+		}
+	}
+	statement.Dedupe(l.Transactions(), rows)
+
+	// Statements are often newest first; posting oldest first lets sales find
+	// the lots bought earlier in the same file.
+	pending := make([]*statement.Row, 0, len(rows))
+	for i := range rows {
+		if rows[i].Status == "" {
+			pending = append(pending, &rows[i])
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool { return pending[i].Tx.Date.Before(pending[j].Tx.Date) })
+	for _, r := range pending {
+		tx := r.Tx
+		if tx.Note == "" {
+			tx.Note = note
+		}
+		posted, err := l.Post(tx)
+		if err != nil {
+			r.Status, r.Err = statement.StatusError, err
+			continue
+		}
+		r.Tx, r.Status = posted, statement.StatusImported
+	}
+
+	res := &genportfolio.ImportReport{PortfolioID: pf.id, DryRun: dryRun, Rows: make([]*genportfolio.ImportRow, len(rows))}
+	for i, r := range rows {
+		row := &genportfolio.ImportRow{Line: r.Line, Status: string(r.Status)}
+		switch r.Status {
+		case statement.StatusImported:
+			res.Imported++
+		case statement.StatusDuplicate:
+			res.Duplicates++
+		case statement.StatusSkipped:
+			res.Skipped++
+		case statement.StatusError:
+			res.Errors++
+		}
+		if r.Err != nil {
+			msg := r.Err.Error()
+			row.Error = &msg
+		}
+		if r.Fingerprint != "" {
+			row.Fingerprint = &r.Fingerprint
+		}
+		if r.Tx.Type != "" {
+			row.Transaction = toLedgerTransaction(r.Tx, !dryRun && r.Status == statement.StatusImported)
+		}
+		res.Rows[i] = row
+	}
+	return res, l
+}
+
+// toLedgerTransaction converts a cash or trade transaction, with its ID when
+// it is in the ledger.
+func toLedgerTransaction(tx ledger.Transaction, posted bool) *genportfolio.LedgerTransaction {
+	res := &genportfolio.LedgerTransaction{
+		Date:     tx.Date.Format(time.DateOnly),
+		Type:     string(tx.Type),
+		Account:  tx.Account,
+		Symbol:   tx.Symbol,
+		Quantity: tx.Quantity,
+		Price:    tx.Price,
+		Amount:   tx.Amount,
+		Fee:      tx.Fee,
+	}
+	if posted {
+		res.ID = &tx.ID
+	}
+	if tx.Note != "" {
+		res.Note = &tx.Note
+	}
+	return res
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/income"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+)
+
+// RecordIncome posts a dividend or interest payment and, when requested,
+// reinvests a dividend at the pay-date price.
+func (s *PortfolioService) RecordIncome(ctx context.Context, p *genportfolio.RecordIncomePayload) (*genportfolio.IncomeRecord, error) {
+	s.logger.DebugContext(ctx, "portfolio.recordIncome", "portfolio_id", p.PortfolioID, "type", p.Type, "symbol", p.Symbol)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	payDate, err := parseDate(&p.PayDate)
+	if err != nil {
+		return nil, err
+	}
+	exDate, err := parseDate(p.ExDate)
+	if err != nil {
+		return nil, err
+	}
+	tx := ledger.Transaction{
+		Date:        *payDate,
+		Type:        ledger.TxType(p.Type),
+		Account:     p.Account,
+		Amount:      p.Amount,
+		Withholding: p.Withholding,
+		Qualified:   p.Qualified,
+	}
+	if exDate != nil {
+		tx.ExDate = *exDate
+	}
+	if p.Symbol != nil {
+		tx.Symbol = *p.Symbol
+	}
+	if p.Note != nil {
+		tx.Note = *p.Note
+	}
+	if err := tx.Validate(); err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+
+	price := 0.0
+	if p.Reinvest {
+		if tx.Type != ledger.TxDividend {
+			return nil, genportfolio.BadRequest("only dividends can be reinvested")
+		}
+		if p.Price != nil {
+			price = *p.Price
+		} else if price, err = s.market.Close(tx.Symbol, tx.Date); err != nil {
+			return nil, genportfolio.BadRequest("no " + tx.Symbol + " price on the pay date; specify a reinvestment price")
+		}
+		if price <= 0 {
+			return nil, genportfolio.BadRequest("reinvestment price must be positive")
+		}
+		if tx.CashEffect() <= 0 {
+			return nil, genportfolio.BadRequest("nothing to reinvest after withholding")
+		}
+	}
+
+	// The dividend and its reinvestment are posted together or not at all.
+	txs := []ledger.Transaction{tx}
+	if p.Reinvest {
+		txs = append(txs, income.Reinvestment(tx, price))
+	}
+	posted, err := pf.ledger.PostAll(txs...)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	res := toIncomeRecord(posted[0])
+	if p.Reinvest {
+		buy := posted[1]
+		res.ReinvestmentID = &buy.ID
+		res.ReinvestedQuantity = &buy.Quantity
+	}
+	return res, nil
+}
+
+// GetIncome summarizes income over a period and forecasts the next 12 months
+// from current holdings.
+func (s *PortfolioService) GetIncome(ctx context.Context, p *genportfolio.GetIncomePayload) (*genportfolio.IncomeSummary, error) {
+	s.logger.DebugContext(ctx, "portfolio.getIncome", "portfolio_id", p.PortfolioID, "period", p.Period, "interval", p.Interval)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := s.resolvePeriod(pf.ledger, performance.Period(p.Period), p.Start, p.End)
+	if err != nil {
+		return nil, err
+	}
+	interval := income.Interval(p.Interval)
+	if _, err := interval.Key(to); err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+
+	entries := income.Entries(pf.ledger, from, to, func(symbol string) string {
+		if inst, ok := s.instruments.Get(symbol); ok {
+			return inst.Currency
+		}
+		return ""
+	})
+	res := &genportfolio.IncomeSummary{
+		PortfolioID: pf.id,
+		Start:       from.Format(time.DateOnly),
+		End:         to.Format(time.DateOnly),
+		Currency:    pf.ledger.Currency(),
+		Interval:    p.Interval,
+		ByPeriod: toIncomeBuckets(income.Group(entries, func(e income.Entry) string {
+			key, _ := interval.Key(e.PayDate)
+			return key
+		})),
+		ByHolding: toIncomeBuckets(income.Group(entries, func(e income.Entry) string {
+			if e.Symbol == "" {
+				return "cash"
+			}
+			return e.Symbol
+		})),
+		ByCurrency: toIncomeBuckets(income.Group(entries, func(e income.Entry) string { return e.Currency })),
+		Forecast:   []*genportfolio.IncomeForecast{},
+	}
+	for _, e := range entries {
+		res.Gross += e.Gross
+		res.Withholding += e.Withholding
+		res.Net += e.Net
+		if e.Class == income.ClassQualified {
+			res.Qualified += e.Gross
+		} else {
+			res.Ordinary += e.Gross
+		}
+	}
+	for _, b := range income.Forecast(pf.ledger, s.now()) {
+		res.Forecast = append(res.Forecast, &genportfolio.IncomeForecast{Month: b.Key, Amount: b.Gross})
+		res.ForecastTotal += b.Gross
+	}
+	return res, nil
+}
+
+func toIncomeRecord(tx ledger.Transaction) *genportfolio.IncomeRecord {
+	r := &genportfolio.IncomeRecord{
+		ID:          tx.ID,
+		Type:        string(tx.Type),
+		Account:     tx.Account,
+		PayDate:     tx.Date.Format(time.DateOnly),
+		Amount:      tx.Amount,
+		Withholding: tx.Withholding,
+		Net:         tx.CashEffect(),
+		Qualified:   tx.Qualified,
+	}
+	if tx.Symbol != "" {
+		r.Symbol = &tx.Symbol
+	}
+	if !tx.ExDate.IsZero() {
+		exDate := tx.ExDate.Format(time.DateOnly)
+		r.ExDate = &exDate
+	}
+	return r
+}
+
+func toIncomeBuckets(buckets []income.Bucket) []*genportfolio.IncomeBucket {
+	res := make([]*genportfolio.IncomeBucket, len(buckets))
+	for i, b := range buckets {
+		res[i] = &genportfolio.IncomeBucket{Key: b.Key, Gross: b.Gross, Withholding: b.Withholding, Net: b.Net}
+	}
+	return res
+}
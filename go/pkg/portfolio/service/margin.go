@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/margin"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+)
+
+// marginAccount is a margin account of a portfolio.
+type marginAccount struct {
+	terms margin.Terms
+	// charged is the day through which interest and borrow fees have been
+	// charged.
+	charged time.Time
+	// call marks an open margin call.
+	call bool
+}
+
+// AddMarginAccounts makes accounts margin accounts in every portfolio, e.g.
+// from the application config. Portfolios imported later get them too.
+func (s *PortfolioService) AddMarginAccounts(terms ...margin.Terms) error {
+	for _, t := range terms {
+		t = t.WithDefaults()
+		if err := t.Validate(); err != nil {
+			return err
+		}
+		s.mu.Lock()
+		s.configuredMargin = append(s.configuredMargin, t)
+		pfs := make([]*portfolioState, 0, len(s.portfolios))
+		for _, pf := range s.portfolios {
+			pfs = append(pfs, pf)
+		}
+		s.mu.Unlock()
+		for _, pf := range pfs {
+			s.setMarginAccount(pf, t)
+		}
+	}
+	return nil
+}
+
+// setMarginAccount registers validated terms for an account of pf.
+func (s *PortfolioService) setMarginAccount(pf *portfolioState, t margin.Terms) {
+	s.mu.Lock()
+	if acct, ok := pf.margin[t.Account]; ok {
+		acct.terms = t
+	} else {
+		pf.margin[t.Account] = &marginAccount{terms: t}
+	}
+	s.mu.Unlock()
+	pf.ledger.SetMarginAccount(t.Account, true)
+}
+
+// SetMarginAccount makes an account a margin account of a portfolio.
+func (s *PortfolioService) SetMarginAccount(ctx context.Context, p *genportfolio.SetMarginAccountPayload) (*genportfolio.MarginTerms, error) {
+	s.logger.DebugContext(ctx, "portfolio.setMarginAccount", "portfolio_id", p.PortfolioID, "account", p.Account)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	t := margin.Terms{
+		Account:                     p.Account,
+		InitialRequirement:          p.InitialRequirement,
+		MaintenanceRequirement:      p.MaintenanceRequirement,
+		ShortMaintenanceRequirement: p.ShortMaintenanceRequirement,
+		LoanRate:                    p.LoanRate,
+		BorrowRate:                  p.BorrowRate,
+		BorrowRates:                 p.BorrowRates,
+	}.WithDefaults()
+	if err := t.Validate(); err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	s.setMarginAccount(pf, t)
+	return &genportfolio.MarginTerms{
+		Account:                     t.Account,
+		InitialRequirement:          t.InitialRequirement,
+		MaintenanceRequirement:      t.MaintenanceRequirement,
+		ShortMaintenanceRequirement: t.ShortMaintenanceRequirement,
+		LoanRate:                    t.LoanRate,
+		BorrowRate:                  t.BorrowRate,
+		BorrowRates:                 t.BorrowRates,
+	}, nil
+}
+
+// CheckMargin charges interest and borrow fees and checks the maintenance
+// requirement of the margin accounts of every portfolio.
+func (s *PortfolioService) CheckMargin() error {
+	s.mu.RLock()
+	pfs := make([]*portfolioState, 0, len(s.portfolios))
+	for _, pf := range s.portfolios {
+		pfs = append(pfs, pf)
+	}
+	s.mu.RUnlock()
+	for _, pf := range pfs {
+		if _, err := s.marginStatus(pf, s.now(), true); err != nil {
+			return fmt.Errorf("%s: %w", pf.id, err)
+		}
+	}
+	return nil
+}
+
+// marginStatus evaluates the margin accounts of pf at asOf. With charge, the
+// interest and borrow fees of completed months are posted first and a
+// margin-call event is emitted for each account whose equity fell below its
+// maintenance requirement; otherwise nothing changes and uncharged months
+// are reported as accrued. Charges are read, posted and recorded under the
+// posting lock of pf.
+func (s *PortfolioService) marginStatus(pf *portfolioState, asOf time.Time, charge bool) ([]*genportfolio.MarginAccountStatus, error) {
+	pf.posting.Lock()
+	defer pf.posting.Unlock()
+	s.mu.RLock()
+	accounts := make([]string, 0, len(pf.margin))
+	for account := range pf.margin {
+		accounts = append(accounts, account)
+	}
+	s.mu.RUnlock()
+	sort.Strings(accounts)
+
+	today := marketdata.Day(asOf)
+	res := make([]*genportfolio.MarginAccountStatus, 0, len(accounts))
+	for _, account := range accounts {
+		s.mu.RLock()
+		terms, from := pf.margin[account].terms, pf.margin[account].charged
+		s.mu.RUnlock()
+		if from.IsZero() {
+			from = today
+			if inception := pf.ledger.Inception(); !inception.IsZero() {
+				from = marketdata.Day(inception).AddDate(0, 0, -1)
+			}
+		}
+		charges, pending, err := margin.Charges(pf.ledger, terms, s.prices, from, today)
+		if err != nil {
+			return nil, err
+		}
+		if charge {
+			if _, err := pf.ledger.PostAll(charges...); err != nil {
+				return nil, err
+			}
+			// Advance to the last month end charged.
+			next := today.AddDate(0, 0, 1)
+			monthEnd := time.Date(next.Year(), next.Month(), 1, 0, 0, 0, 0, next.Location()).AddDate(0, 0, -1)
+			s.mu.Lock()
+			if acct := pf.margin[account]; monthEnd.After(acct.charged) {
+				acct.charged = monthEnd
+			}
+			s.mu.Unlock()
+		} else {
+			for _, tx := range charges {
+				switch tx.Type {
+				case ledger.TxMarginInterest:
+					pending.Interest += tx.Amount
+				case ledger.TxBorrowFee:
+					pending.BorrowFees[tx.Symbol] += tx.Amount
+				}
+			}
+		}
+
+		snap := pf.ledger.Snapshot(asOf)
+		cash, values := 0.0, map[string]float64{}
+		if acct, ok := snap.Accounts[account]; ok {
+			cash = acct.Cash
+			for sym, qty := range acct.Quantities {
+				if qty == 0 {
+					continue
+				}
+				px, err := s.prices.Close(sym, asOf)
+				if err != nil {
+					return nil, err
+				}
+				values[sym] = qty * px
+			}
+		}
+		st := margin.Evaluate(terms, cash, values)
+
+		out := &genportfolio.MarginAccountStatus{
+			Account:                account,
+			Cash:                   st.Cash,
+			LongValue:              st.LongValue,
+			ShortValue:             st.ShortValue,
+			Equity:                 st.Equity,
+			LoanBalance:            st.LoanBalance,
+			InitialRequirement:     st.InitialRequirement,
+			MaintenanceRequirement: st.MaintenanceRequirement,
+			MaintenanceExcess:      st.MaintenanceExcess,
+			BuyingPower:            st.BuyingPower,
+			MarginCall:             st.MarginCall,
+			AccruedInterest:        pending.Interest,
+		}
+		for _, fee := range pending.BorrowFees {
+			out.AccruedBorrowFees += fee
+		}
+		lots, _ := pf.ledger.Lots(asOf)
+		for _, lot := range lots {
+			if lot.Account == account && lot.Short() {
+				out.ShortProceeds -= lot.CostBasis
+			}
+		}
+		for _, tx := range pf.ledger.Transactions() {
+			if tx.Account != account || tx.Date.After(asOf) {
+				continue
+			}
+			switch tx.Type {
+			case ledger.TxMarginInterest:
+				out.InterestCharged += tx.Amount
+			case ledger.TxBorrowFee:
+				out.BorrowFeesCharged += tx.Amount
+			}
+		}
+		res = append(res, out)
+		if !charge {
+			continue
+		}
+
+		// Raise a margin call on the transition only.
+		s.mu.Lock()
+		acct := pf.margin[account]
+		raise := st.MarginCall && !acct.call
+		acct.call = st.MarginCall
+		s.mu.Unlock()
+		if raise {
+			s.emit(slog.LevelWarn, pf.id, eventMarginCall, account, fmt.Sprintf(
+				"margin call on %s: equity %.2f is below the maintenance requirement %.2f", account, st.Equity, st.MaintenanceRequirement))
+		}
+	}
+	return res, nil
+}
+
+// GetMarginStatus returns the margin position of each margin account of a
+// portfolio, with interest and borrow fees not yet charged as accrued.
+func (s *PortfolioService) GetMarginStatus(ctx context.Context, p *genportfolio.GetMarginStatusPayload) (*genportfolio.MarginStatus, error) {
+	s.logger.DebugContext(ctx, "portfolio.getMarginStatus", "portfolio_id", p.PortfolioID)
+	return s.marginStatusOf(p.PortfolioID, false)
+}
+
+// ChargeMargin charges the margin interest and borrow fees of completed
+// months and returns the margin position of each margin account of a
+// portfolio.
+func (s *PortfolioService) ChargeMargin(ctx context.Context, p *genportfolio.ChargeMarginPayload) (*genportfolio.MarginStatus, error) {
+	s.logger.DebugContext(ctx, "portfolio.chargeMargin", "portfolio_id", p.PortfolioID)
+	return s.marginStatusOf(p.PortfolioID, true)
+}
+
+// marginStatusOf returns the margin status of a portfolio, charging first
+// with charge.
+func (s *PortfolioService) marginStatusOf(id string, charge bool) (*genportfolio.MarginStatus, error) {
+	pf, err := s.portfolio(id)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	accounts, err := s.marginStatus(pf, now, charge)
+	if err != nil {
+		return nil, err
+	}
+	return &genportfolio.MarginStatus{
+		PortfolioID: pf.id,
+		AsOf:        now.Format(time.DateOnly),
+		Currency:    pf.ledger.Currency(),
+		Accounts:    accounts,
+	}, nil
+}
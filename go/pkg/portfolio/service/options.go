@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/options"
+)
+
+// defaultOptionVolatility is the volatility input used for underlyings
+// without a configured one.
+const defaultOptionVolatility = 0.25
+
+// SetOptionVolatility sets the default annual volatility used to value
+// options and overrides per underlying. A non-positive default keeps the
+// current one. Underlyings are upper-cased, as configuration keys may not
+// keep their case.
+func (s *PortfolioService) SetOptionVolatility(def float64, byUnderlying map[string]float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if def > 0 {
+		s.optionVolatility = def
+	}
+	for sym, vol := range byUnderlying {
+		s.optionVolatilities[strings.ToUpper(strings.TrimSpace(sym))] = vol
+	}
+}
+
+// volatility returns the volatility input for an underlying.
+func (s *PortfolioService) volatility(underlying string) float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if vol, ok := s.optionVolatilities[underlying]; ok {
+		return vol
+	}
+	return s.optionVolatility
+}
+
+// rate returns the default risk-free rate.
+func (s *PortfolioService) rate() float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.riskFreeRate
+}
+
+// optionContract returns the contract terms of an option instrument.
+func (s *PortfolioService) optionContract(symbol string) (options.Contract, bool) {
+	inst, ok := s.instruments.Get(symbol)
+	if !ok || !inst.IsOption() {
+		return options.Contract{}, false
+	}
+	expiry, err := time.Parse(time.DateOnly, inst.Expiry)
+	if err != nil {
+		return options.Contract{}, false
+	}
+	c := options.Contract{
+		Underlying: inst.Underlying,
+		Kind:       options.Kind(inst.OptionType),
+		Strike:     inst.Strike,
+		Expiry:     expiry,
+		Multiplier: inst.Multiplier,
+		Future:     inst.FuturesOption,
+	}
+	if c.Multiplier == 0 {
+		c.Multiplier = 100
+	}
+	return c, c.Validate() == nil
+}
+
+// SettleExpiredOptions closes or assigns the expired options of every
+// portfolio.
+func (s *PortfolioService) SettleExpiredOptions() error {
+	s.mu.RLock()
+	pfs := make([]*portfolioState, 0, len(s.portfolios))
+	for _, pf := range s.portfolios {
+		pfs = append(pfs, pf)
+	}
+	s.mu.RUnlock()
+	for _, pf := range pfs {
+		if _, err := s.settleExpiries(pf, s.now()); err != nil {
+			return fmt.Errorf("%s: %w", pf.id, err)
+		}
+	}
+	return nil
+}
+
+// settleExpiries posts the settlement of options held or written in pf that
+// expired before the day of asOf. Positions are read and settled under the
+// posting lock of pf.
+func (s *PortfolioService) settleExpiries(pf *portfolioState, asOf time.Time) ([]ledger.Transaction, error) {
+	pf.posting.Lock()
+	defer pf.posting.Unlock()
+	today := marketdata.Day(asOf)
+	var posted []ledger.Transaction
+	snap := pf.ledger.Snapshot(asOf)
+	accounts := make([]string, 0, len(snap.Accounts))
+	for account := range snap.Accounts {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+	for _, account := range accounts {
+		symbols := make([]string, 0, len(snap.Accounts[account].Quantities))
+		for sym := range snap.Accounts[account].Quantities {
+			symbols = append(symbols, sym)
+		}
+		sort.Strings(symbols)
+		for _, sym := range symbols {
+			qty := snap.Accounts[account].Quantities[sym]
+			c, ok := s.optionContract(sym)
+			if !ok || qty == 0 || !c.Expiry.Before(today) {
+				continue
+			}
+			spot, err := s.market.Close(c.Underlying, c.Expiry)
+			if err != nil {
+				return posted, err
+			}
+			held := 0.0
+			if acct, ok := pf.ledger.Snapshot(c.Expiry).Accounts[account]; ok {
+				held = acct.Quantities[c.Underlying]
+			}
+			// A contract settles in full or not at all.
+			txs, err := pf.ledger.PostAll(options.Settlement(sym, account, c, qty, spot, held)...)
+			if err != nil {
+				return posted, err
+			}
+			posted = append(posted, txs...)
+		}
+	}
+	if len(posted) > 0 {
+		s.logger.Info("settled expired options", "portfolio_id", pf.id, "transactions", len(posted))
+	}
+	return posted, nil
+}
+
+// SettleOptionExpiries closes or assigns the expired options of a portfolio.
+func (s *PortfolioService) SettleOptionExpiries(ctx context.Context, p *genportfolio.SettleOptionExpiriesPayload) ([]*genportfolio.OptionSettlement, error) {
+	s.logger.DebugContext(ctx, "portfolio.settleOptionExpiries", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	posted, err := s.settleExpiries(pf, s.now())
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*genportfolio.OptionSettlement, len(posted))
+	for i, tx := range posted {
+		res[i] = &genportfolio.OptionSettlement{
+			ID:       tx.ID,
+			Date:     tx.Date.Format(time.DateOnly),
+			Account:  tx.Account,
+			Symbol:   tx.Symbol,
+			Type:     string(tx.Type),
+			Quantity: tx.Quantity,
+			Price:    tx.Price,
+			Note:     tx.Note,
+		}
+	}
+	return res, nil
+}
+
+// ListOptionPositions values the options held with their greeks and the
+// delta-adjusted exposure to each underlying. Expired options not yet
+// settled are valued at their intrinsic value.
+func (s *PortfolioService) ListOptionPositions(ctx context.Context, p *genportfolio.ListOptionPositionsPayload) (*genportfolio.OptionPositions, error) {
+	s.logger.DebugContext(ctx, "portfolio.listOptionPositions", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	rate := s.rate()
+	snap := pf.ledger.Snapshot(now)
+	res := &genportfolio.OptionPositions{
+		PortfolioID: pf.id,
+		AsOf:        now.Format(time.DateOnly),
+		Currency:    pf.ledger.Currency(),
+		Positions:   []*genportfolio.OptionPosition{},
+		Exposures:   []*genportfolio.UnderlyingExposure{},
+	}
+	exposures := map[string]*genportfolio.UnderlyingExposure{}
+	exposure := func(underlying string) (*genportfolio.UnderlyingExposure, error) {
+		if e, ok := exposures[underlying]; ok {
+			return e, nil
+		}
+		spot, err := s.market.Close(underlying, now)
+		if err != nil {
+			return nil, err
+		}
+		e := &genportfolio.UnderlyingExposure{Underlying: underlying, Price: spot, Shares: snap.Quantities[underlying]}
+		exposures[underlying] = e
+		return e, nil
+	}
+
+	for account, acct := range snap.Accounts {
+		for sym, qty := range acct.Quantities {
+			c, ok := s.optionContract(sym)
+			if !ok {
+				continue
+			}
+			e, err := exposure(c.Underlying)
+			if err != nil {
+				return nil, err
+			}
+			vol := s.volatility(c.Underlying)
+			if p.Volatility != nil {
+				vol = *p.Volatility
+			}
+			g := options.Value(c, e.Price, vol, rate, marketdata.Day(now))
+			units := qty * c.Multiplier
+			res.Positions = append(res.Positions, &genportfolio.OptionPosition{
+				Account:         account,
+				Symbol:          sym,
+				Underlying:      c.Underlying,
+				OptionType:      string(c.Kind),
+				Strike:          c.Strike,
+				Expiry:          c.Expiry.Format(time.DateOnly),
+				Multiplier:      c.Multiplier,
+				FuturesOption:   c.Future,
+				Quantity:        qty,
+				UnderlyingPrice: e.Price,
+				Volatility:      vol,
+				Price:           g.Price * c.Multiplier,
+				MarketValue:     g.Price * units,
+				Delta:           g.Delta * units,
+				Gamma:           g.Gamma * units,
+				Theta:           g.Theta * units,
+				Vega:            g.Vega * units,
+			})
+			e.OptionDelta += g.Delta * units
+		}
+	}
+	sort.Slice(res.Positions, func(i, j int) bool {
+		if res.Positions[i].Account != res.Positions[j].Account {
+			return res.Positions[i].Account < res.Positions[j].Account
+		}
+		return res.Positions[i].Symbol < res.Positions[j].Symbol
+	})
+	for _, e := range exposures {
+		e.DeltaAdjustedShares = e.Shares + e.OptionDelta
+		e.DeltaAdjustedExposure = e.DeltaAdjustedShares * e.Price
+		res.Exposures = append(res.Exposures, e)
+	}
+	sort.Slice(res.Exposures, func(i, j int) bool { return res.Exposures[i].Underlying < res.Exposures[j].Underlying })
+	return res, nil
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/orders"
+)
+
+// SetPaperTrading sets the slippage and commission models of the order
+// matching simulator.
+func (s *PortfolioService) SetPaperTrading(sim orders.Simulator) error {
+	if err := sim.Validate(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.simulator = sim
+	return nil
+}
+
+// PlaceOrder accepts an order on a paper portfolio and offers it the latest
+// price straight away.
+func (s *PortfolioService) PlaceOrder(ctx context.Context, p *genportfolio.PlaceOrderPayload) (*genportfolio.Order, error) {
+	s.logger.DebugContext(ctx, "portfolio.placeOrder", "portfolio_id", p.PortfolioID, "symbol", p.Symbol)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	if !pf.paper {
+		return nil, genportfolio.BadRequest("portfolio " + pf.id + " is not a paper portfolio")
+	}
+	now := s.now()
+	o := &orders.Order{
+		Symbol:      p.Symbol,
+		Side:        orders.Side(p.Side),
+		Type:        orders.Type(p.Type),
+		Quantity:    p.Quantity,
+		TimeInForce: orders.TimeInForce(p.TimeInForce),
+		Created:     now,
+		Updated:     now,
+		Status:      orders.Open,
+	}
+	if p.Account != nil {
+		o.Account = *p.Account
+	}
+	if p.LimitPrice != nil {
+		o.LimitPrice = *p.LimitPrice
+	}
+	if p.StopPrice != nil {
+		o.StopPrice = *p.StopPrice
+	}
+	if err := o.Validate(); err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	if _, err := s.prices.Close(o.Symbol, now); err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+
+	s.mu.Lock()
+	s.orderSeq++
+	o.ID = fmt.Sprintf("ord-%06d", s.orderSeq)
+	pf.orders = append(pf.orders, o)
+	s.mu.Unlock()
+	s.emitOrder(pf, o)
+
+	s.matchOrders(pf, now)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return toOrder(o), nil
+}
+
+// ListOrders lists the orders of a portfolio, oldest first.
+func (s *PortfolioService) ListOrders(ctx context.Context, p *genportfolio.ListOrdersPayload) ([]*genportfolio.Order, error) {
+	s.logger.DebugContext(ctx, "portfolio.listOrders", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := []*genportfolio.Order{}
+	for _, o := range pf.orders {
+		if p.Status == nil || string(o.Status) == *p.Status {
+			res = append(res, toOrder(o))
+		}
+	}
+	return res, nil
+}
+
+// CancelOrder cancels an open order.
+func (s *PortfolioService) CancelOrder(ctx context.Context, p *genportfolio.CancelOrderPayload) (*genportfolio.Order, error) {
+	s.logger.DebugContext(ctx, "portfolio.cancelOrder", "portfolio_id", p.PortfolioID, "id", p.ID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	var o *orders.Order
+	for _, candidate := range pf.orders {
+		if candidate.ID == p.ID {
+			o = candidate
+		}
+	}
+	if o == nil {
+		s.mu.Unlock()
+		return nil, genportfolio.NotFound("order " + p.ID + " not found")
+	}
+	if o.Done() {
+		status := o.Status
+		s.mu.Unlock()
+		return nil, genportfolio.BadRequest(fmt.Sprintf("order %s is already %s", p.ID, status))
+	}
+	o.Status, o.Updated = orders.Cancelled, s.now()
+	res := toOrder(o)
+	s.mu.Unlock()
+	s.emitOrder(pf, o)
+	return res, nil
+}
+
+// MatchOrders offers the latest prices to the open orders of every paper
+// portfolio. Outside trading days it only expires day orders.
+func (s *PortfolioService) MatchOrders() {
+	s.mu.RLock()
+	pfs := make([]*portfolioState, 0, len(s.portfolios))
+	for _, pf := range s.portfolios {
+		if pf.paper {
+			pfs = append(pfs, pf)
+		}
+	}
+	s.mu.RUnlock()
+	for _, pf := range pfs {
+		s.matchOrders(pf, s.now())
+	}
+}
+
+// matchOrders offers the prices at asOf to the open orders of pf and posts
+// fills to its ledger. Fills the account cannot settle are rejected.
+func (s *PortfolioService) matchOrders(pf *portfolioState, asOf time.Time) {
+	s.mu.RLock()
+	var open []*orders.Order
+	for _, o := range pf.orders {
+		if !o.Done() {
+			open = append(open, o)
+		}
+	}
+	s.mu.RUnlock()
+
+	trading := marketdata.IsTradingDay(asOf)
+	for _, o := range open {
+		// Prices are read before locking: option prices take the lock.
+		px, err := s.prices.Close(o.Symbol, asOf)
+		s.mu.Lock()
+		var changed bool
+		switch {
+		case !trading || err != nil:
+			changed = orders.Expire(o, asOf)
+		default:
+			changed = s.simulator.Match(o, px, asOf)
+		}
+		filled := changed && o.Status == orders.Filled
+		s.mu.Unlock()
+		if !changed {
+			continue
+		}
+		if filled {
+			if err := s.postFill(pf, o); err != nil {
+				s.mu.Lock()
+				o.Status, o.Reason, o.FillPrice, o.Commission = orders.Rejected, err.Error(), 0, 0
+				s.mu.Unlock()
+			}
+		}
+		s.emitOrder(pf, o)
+	}
+}
+
+// postFill posts a filled order to the ledger. Buys outside margin accounts
+// need enough cash in the account, which is checked and spent under the
+// posting lock of pf.
+func (s *PortfolioService) postFill(pf *portfolioState, o *orders.Order) error {
+	s.mu.RLock()
+	tx := o.Transaction()
+	s.mu.RUnlock()
+	pf.posting.Lock()
+	defer pf.posting.Unlock()
+	if tx.Type == ledger.TxBuy && !pf.ledger.IsMarginAccount(tx.Account) {
+		cash := 0.0
+		if acct, ok := pf.ledger.Snapshot(tx.Date).Accounts[tx.Account]; ok {
+			cash = acct.Cash
+		}
+		if cost := -tx.CashEffect(); cost > cash+1e-9 {
+			return fmt.Errorf("insufficient cash: buying for %.2f with %.2f available", cost, cash)
+		}
+	}
+	_, err := pf.ledger.Post(tx)
+	return err
+}
+
+// emitOrder records the current status of o as an order event.
+func (s *PortfolioService) emitOrder(pf *portfolioState, o *orders.Order) {
+	s.mu.RLock()
+	msg := fmt.Sprintf("order %s to %s %g %s is %s", o.ID, o.Side, o.Quantity, o.Symbol, o.Status)
+	switch o.Status {
+	case orders.Filled:
+		msg += fmt.Sprintf(" at %.4f", o.FillPrice)
+	case orders.Rejected:
+		msg += ": " + o.Reason
+	}
+	account := o.Account
+	s.mu.RUnlock()
+	s.emit(slog.LevelInfo, pf.id, eventOrderStatus, account, msg)
+}
+
+// toOrder converts an order; callers hold s.mu.
+func toOrder(o *orders.Order) *genportfolio.Order {
+	res := &genportfolio.Order{
+		ID:          o.ID,
+		Account:     o.Account,
+		Symbol:      o.Symbol,
+		Side:        string(o.Side),
+		Type:        string(o.Type),
+		Quantity:    o.Quantity,
+		TimeInForce: string(o.TimeInForce),
+		Status:      string(o.Status),
+		Triggered:   o.Triggered,
+		Created:     o.Created.Format(time.RFC3339),
+		Updated:     o.Updated.Format(time.RFC3339),
+		FillPrice:   o.FillPrice,
+		Commission:  o.Commission,
+	}
+	if o.LimitPrice > 0 {
+		v := o.LimitPrice
+		res.LimitPrice = &v
+	}
+	if o.StopPrice > 0 {
+		v := o.StopPrice
+		res.StopPrice = &v
+	}
+	if o.Reason != "" {
+		v := o.Reason
+		res.Reason = &v
+	}
+	return res
+}
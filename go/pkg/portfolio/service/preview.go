@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/allocation"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/risk"
+)
+
+// previewConfidence is the confidence level of the value at risk in trade
+// previews.
+const previewConfidence = 0.95
+
+// PreviewTrades applies hypothetical trades to a copy of the ledger and
+// compares the summary, allocation, realized gains and risk before and after.
+// Nothing is persisted.
+func (s *PortfolioService) PreviewTrades(ctx context.Context, p *genportfolio.PreviewTradesPayload) (*genportfolio.TradePreview, error) {
+	s.logger.DebugContext(ctx, "portfolio.previewTrades", "portfolio_id", p.PortfolioID, "trades", len(p.Trades))
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	after := pf.ledger.Clone()
+	sales := map[string]bool{}
+	for i, t := range p.Trades {
+		tx := ledger.Transaction{Date: marketdata.Day(now), Type: ledger.TxBuy, Symbol: t.Symbol, Quantity: t.Quantity, Fee: t.Fee, Note: "preview"}
+		if t.Side == "sell" {
+			tx.Type = ledger.TxSell
+		}
+		if t.Account != nil {
+			tx.Account = *t.Account
+		}
+		if t.Price != nil {
+			tx.Price = *t.Price
+		} else if tx.Price, err = s.prices.Close(t.Symbol, now); err != nil {
+			return nil, genportfolio.BadRequest(fmt.Sprintf("trade %d: %s", i+1, err))
+		}
+		posted, err := after.Post(tx)
+		if err != nil {
+			return nil, genportfolio.BadRequest(fmt.Sprintf("trade %d: %s", i+1, err))
+		}
+		sales[posted.ID] = true
+	}
+
+	res := &genportfolio.TradePreview{
+		PortfolioID: pf.id,
+		AsOf:        now.Format(time.DateOnly),
+		Currency:    pf.ledger.Currency(),
+		Dimension:   p.Dimension,
+		Allocation:  []*genportfolio.AllocationChange{},
+		Realized:    []*genportfolio.RealizedLot{},
+		Warnings:    []string{},
+	}
+	if res.Before, err = s.summarize(pf.ledger, now); err != nil {
+		return nil, err
+	}
+	if res.After, err = s.summarize(after, now); err != nil {
+		return nil, err
+	}
+	vBefore, err := holdings.Value(pf.ledger, s.prices, now)
+	if err != nil {
+		return nil, err
+	}
+	vAfter, err := holdings.Value(after, s.prices, now)
+	if err != nil {
+		return nil, err
+	}
+	res.CashBefore, res.CashAfter = vBefore.TotalCash(), vAfter.TotalCash()
+	accounts := make([]string, 0, len(vAfter.Cash))
+	for account := range vAfter.Cash {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+	for _, account := range accounts {
+		if cash := vAfter.Cash[account]; cash < 0 && !after.IsMarginAccount(account) {
+			res.Warnings = append(res.Warnings, fmt.Sprintf("account %q would have negative cash of %.2f", account, cash))
+		}
+	}
+
+	if res.Allocation, err = s.allocationChanges(vBefore, vAfter, allocation.Dimension(p.Dimension)); err != nil {
+		return nil, err
+	}
+
+	_, disposals := after.Lots(now)
+	for _, d := range disposals {
+		if !sales[d.SaleID] {
+			continue
+		}
+		res.Realized = append(res.Realized, &genportfolio.RealizedLot{
+			Account:   d.Account,
+			Symbol:    d.Symbol,
+			LotID:     d.LotID,
+			Acquired:  d.Acquired.Format(time.DateOnly),
+			Quantity:  d.Quantity,
+			Proceeds:  d.Proceeds,
+			CostBasis: d.CostBasis,
+			Gain:      d.Gain(),
+			LongTerm:  d.LongTerm(),
+		})
+		if d.LongTerm() {
+			res.LongTermGain += d.Gain()
+		} else {
+			res.ShortTermGain += d.Gain()
+		}
+	}
+	res.EstimatedTax = estimatedTax(res.ShortTermGain, res.LongTermGain, p.ShortTermTaxRate, p.LongTermTaxRate)
+
+	if res.RiskBefore, err = s.holdingsRisk(vBefore, p.Lookback); err != nil {
+		return nil, err
+	}
+	if res.RiskAfter, err = s.holdingsRisk(vAfter, p.Lookback); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// allocationChanges pairs the allocation buckets of two valuations by key.
+func (s *PortfolioService) allocationChanges(before, after *holdings.Valuation, dim allocation.Dimension) ([]*genportfolio.AllocationChange, error) {
+	bBefore, err := allocation.Group(before, s.instruments, dim, "")
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	bAfter, err := allocation.Group(after, s.instruments, dim, "")
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	byKey := map[string]*genportfolio.AllocationChange{}
+	change := func(key string) *genportfolio.AllocationChange {
+		if c, ok := byKey[key]; ok {
+			return c
+		}
+		c := &genportfolio.AllocationChange{Key: key}
+		byKey[key] = c
+		return c
+	}
+	for _, b := range bBefore {
+		c := change(b.Key)
+		c.ValueBefore, c.WeightBefore = b.Value, b.Weight
+	}
+	for _, b := range bAfter {
+		c := change(b.Key)
+		c.ValueAfter, c.WeightAfter = b.Value, b.Weight
+	}
+	res := make([]*genportfolio.AllocationChange, 0, len(byKey))
+	for _, c := range byKey {
+		res = append(res, c)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+	return res, nil
+}
+
+// holdingsRisk estimates the one-day risk of a valuation from the returns of
+// its instruments over the last lookback trading days.
+func (s *PortfolioService) holdingsRisk(v *holdings.Valuation, lookback int) (*genportfolio.HoldingsRisk, error) {
+	var exposures []risk.Exposure
+	for sym, value := range v.BySymbol() {
+		if value != 0 {
+			exposures = append(exposures, risk.Exposure{Symbol: sym, Value: value})
+		}
+	}
+	sort.Slice(exposures, func(i, j int) bool { return exposures[i].Symbol < exposures[j].Symbol })
+	symbols := make([]string, len(exposures))
+	for i, e := range exposures {
+		symbols[i] = e.Symbol
+	}
+	days := marketdata.TradingDays(v.AsOf.AddDate(0, 0, -2*lookback), v.AsOf)
+	if len(days) > lookback+1 {
+		days = days[len(days)-lookback-1:]
+	}
+	returns, err := risk.AssetReturns(s.prices, symbols, days)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	vol, err := risk.Volatility(exposures, returns, v.Total)
+	if errors.Is(err, risk.ErrInsufficientData) {
+		return nil, genportfolio.BadRequest("too few trading days of price history for risk")
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &genportfolio.HoldingsRisk{Volatility: vol}
+	if len(exposures) == 0 {
+		return res, nil
+	}
+	vr, err := risk.ValueAtRisk(exposures, returns, risk.VaROptions{Method: risk.Parametric, Confidence: previewConfidence, Horizon: 1})
+	if err != nil {
+		return nil, err
+	}
+	res.ValueAtRisk, res.ExpectedShortfall = vr.ValueAtRisk, vr.ExpectedShortfall
+	return res, nil
+}
+
+// estimatedTax taxes net short- and long-term gains at their rates after
+// netting a loss in one term against a gain in the other.
+func estimatedTax(shortTerm, longTerm, shortRate, longRate float64) float64 {
+	switch {
+	case shortTerm < 0 && longTerm > 0:
+		longTerm, shortTerm = max(longTerm+shortTerm, 0), 0
+	case longTerm < 0 && shortTerm > 0:
+		shortTerm, longTerm = max(shortTerm+longTerm, 0), 0
+	}
+	return max(shortTerm, 0)*shortRate + max(longTerm, 0)*longRate
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/allocation"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/projection"
+)
+
+// ProjectPortfolio runs a Monte Carlo projection of the portfolio value.
+func (s *PortfolioService) ProjectPortfolio(ctx context.Context, p *genportfolio.ProjectPortfolioPayload) (*genportfolio.Projection, error) {
+	s.logger.DebugContext(ctx, "portfolio.projectPortfolio", "portfolio_id", p.PortfolioID, "end", p.End)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	v, err := holdings.Value(pf.ledger, s.prices, now)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := projection.Plan{
+		Start:               marketdata.Day(now),
+		StartValue:          v.Total,
+		MonthlyContribution: p.MonthlyContribution,
+		MonthlyWithdrawal:   p.MonthlyWithdrawal,
+		Inflation:           p.Inflation,
+		Paths:               p.Paths,
+		Seed:                now.UnixNano(),
+	}
+	if p.StartValue != nil {
+		plan.StartValue = *p.StartValue
+	}
+	if p.Goal != nil {
+		plan.Goal = *p.Goal
+	}
+	if p.Seed != nil {
+		plan.Seed = *p.Seed
+	}
+	for _, d := range []struct {
+		in  *string
+		out *time.Time
+	}{{&p.End, &plan.End}, {p.WithdrawalStart, &plan.WithdrawalStart}, {p.GoalDate, &plan.GoalDate}} {
+		t, err := parseDate(d.in)
+		if err != nil {
+			return nil, err
+		}
+		if t != nil {
+			*d.out = *t
+		}
+	}
+	if plan.Assets, err = s.assumptions(v, p.Assumptions); err != nil {
+		return nil, err
+	}
+
+	res, err := projection.Simulate(plan)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+
+	out := &genportfolio.Projection{
+		PortfolioID:          pf.id,
+		Start:                plan.Start.Format(time.DateOnly),
+		End:                  plan.End.Format(time.DateOnly),
+		StartValue:           plan.StartValue,
+		Paths:                plan.Paths,
+		Bands:                make([]*genportfolio.ProjectionBand, len(res.Bands)),
+		GoalProbability:      res.GoalProbability,
+		DepletionProbability: res.DepletionProbability,
+	}
+	for _, a := range plan.Assets {
+		out.Assumptions = append(out.Assumptions, &genportfolio.AssetClassAssumption{
+			AssetClass:     a.AssetClass,
+			Weight:         &a.Weight,
+			ExpectedReturn: &a.ExpectedReturn,
+			Volatility:     &a.Volatility,
+		})
+	}
+	for i, b := range res.Bands {
+		out.Bands[i] = &genportfolio.ProjectionBand{
+			Date: b.Date.Format(time.DateOnly),
+			P5:   b.P5,
+			P25:  b.P25,
+			P50:  b.P50,
+			P75:  b.P75,
+			P95:  b.P95,
+		}
+	}
+	if res.GoalProbability != nil {
+		goalDate := plan.End
+		if !plan.GoalDate.IsZero() {
+			goalDate = plan.GoalDate
+		}
+		date := goalDate.Format(time.DateOnly)
+		out.Goal, out.GoalDate = &plan.Goal, &date
+	}
+	return out, nil
+}
+
+// assumptions resolves the asset class mix of a projection. Explicit weights
+// replace the current allocation; otherwise the allocation by asset class is
+// used with any return and volatility overrides applied.
+func (s *PortfolioService) assumptions(v *holdings.Valuation, overrides []*genportfolio.AssetClassAssumption) ([]projection.Assumption, error) {
+	apply := func(a projection.Assumption, o *genportfolio.AssetClassAssumption) projection.Assumption {
+		if o.Weight != nil {
+			a.Weight = *o.Weight
+		}
+		if o.ExpectedReturn != nil {
+			a.ExpectedReturn = *o.ExpectedReturn
+		}
+		if o.Volatility != nil {
+			a.Volatility = *o.Volatility
+		}
+		return a
+	}
+	byClass := map[string]*genportfolio.AssetClassAssumption{}
+	explicitWeights := false
+	for _, o := range overrides {
+		byClass[o.AssetClass] = o
+		explicitWeights = explicitWeights || o.Weight != nil
+	}
+
+	var res []projection.Assumption
+	if explicitWeights {
+		for _, o := range overrides {
+			res = append(res, apply(projection.AssumptionFor(o.AssetClass), o))
+		}
+		return res, nil
+	}
+	buckets, err := allocation.Group(v, s.instruments, allocation.DimensionAssetClass, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(buckets) == 0 {
+		return nil, genportfolio.BadRequest("portfolio is empty; provide asset class weights")
+	}
+	for _, b := range buckets {
+		a := projection.AssumptionFor(b.Key)
+		a.Weight = b.Weight
+		if o, ok := byClass[b.Key]; ok {
+			a = apply(a, o)
+		}
+		res = append(res, a)
+	}
+	return res, nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/rebalance"
+)
+
+// GetTargetAllocation returns the target allocation model of a portfolio.
+func (s *PortfolioService) GetTargetAllocation(ctx context.Context, p *genportfolio.GetTargetAllocationPayload) (*genportfolio.TargetAllocation, error) {
+	s.logger.DebugContext(ctx, "portfolio.getTargetAllocation", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	targets := pf.targets
+	s.mu.RUnlock()
+	return toTargetAllocation(pf.id, targets), nil
+}
+
+// SetTargetAllocation replaces the target allocation model of a portfolio.
+func (s *PortfolioService) SetTargetAllocation(ctx context.Context, p *genportfolio.SetTargetAllocationPayload) (*genportfolio.TargetAllocation, error) {
+	s.logger.DebugContext(ctx, "portfolio.setTargetAllocation", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]rebalance.Target, len(p.Targets))
+	for i, t := range p.Targets {
+		targets[i] = rebalance.Target{Symbol: t.Symbol, Weight: t.Weight, Tolerance: t.Tolerance}
+	}
+	if err := rebalance.ValidateTargets(targets); err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+
+	s.mu.Lock()
+	pf.targets = targets
+	s.mu.Unlock()
+	return toTargetAllocation(pf.id, targets), nil
+}
+
+// ProposeRebalance proposes the trades that bring drifted positions back
+// within their tolerance bands. Nothing is executed.
+func (s *PortfolioService) ProposeRebalance(ctx context.Context, p *genportfolio.ProposeRebalancePayload) (*genportfolio.RebalanceProposal, error) {
+	s.logger.DebugContext(ctx, "portfolio.proposeRebalance", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	targets := pf.targets
+	s.mu.RUnlock()
+	if len(targets) == 0 {
+		return nil, genportfolio.NotFound("portfolio " + pf.id + " has no target allocation")
+	}
+
+	now := s.now()
+	v, err := holdings.Value(pf.ledger, s.prices, now)
+	if err != nil {
+		return nil, err
+	}
+	all, _ := pf.ledger.Lots(now)
+	var lots []ledger.Lot
+	for _, lot := range all {
+		if !lot.Short() {
+			lots = append(lots, lot)
+		}
+	}
+	prop, err := rebalance.Propose(v, lots, targets, rebalance.Options{
+		AsOf:                now,
+		Prices:              func(symbol string) (float64, error) { return s.prices.Close(symbol, now) },
+		LotSize:             s.lotSize,
+		MinTradeValue:       p.MinTradeValue,
+		AvoidShortTermGains: p.AvoidShortTermGains,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &genportfolio.RebalanceProposal{
+		PortfolioID: pf.id,
+		AsOf:        now.Format(time.DateOnly),
+		CashBefore:  prop.CashBefore,
+		CashAfter:   prop.CashAfter,
+		Trades:      make([]*genportfolio.ProposedTrade, len(prop.Trades)),
+		Warnings:    append([]string{}, prop.Warnings...),
+	}
+	for i, t := range prop.Trades {
+		res.Trades[i] = &genportfolio.ProposedTrade{
+			Symbol:          t.Symbol,
+			Side:            string(t.Side),
+			Quantity:        t.Quantity,
+			Price:           t.Price,
+			Value:           t.Value,
+			CurrentWeight:   t.CurrentWeight,
+			TargetWeight:    t.TargetWeight,
+			ProjectedWeight: t.ProjectedWeight,
+		}
+	}
+	return res, nil
+}
+
+// lotSize returns the trading increment from the instrument reference store.
+func (s *PortfolioService) lotSize(symbol string) float64 {
+	inst, _ := s.instruments.Get(symbol)
+	return inst.TradingLot()
+}
+
+func toTargetAllocation(id string, targets []rebalance.Target) *genportfolio.TargetAllocation {
+	res := &genportfolio.TargetAllocation{
+		PortfolioID: id,
+		Targets:     make([]*genportfolio.TargetWeight, len(targets)),
+		CashWeight:  1,
+	}
+	for i, t := range targets {
+		res.Targets[i] = &genportfolio.TargetWeight{Symbol: t.Symbol, Weight: t.Weight, Tolerance: t.Tolerance}
+		res.CashWeight -= t.Weight
+	}
+	return res
+}
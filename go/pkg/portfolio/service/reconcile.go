@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reconcile"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/statement"
+)
+
+// eventReconciliation reports breaks found by a reconciliation run.
+const eventReconciliation = "reconciliation"
+
+// ReconcilePositions reconciles the ledger of a portfolio against a broker
+// position snapshot and tracks the breaks found.
+func (s *PortfolioService) ReconcilePositions(ctx context.Context, p *genportfolio.ReconcilePositionsPayload) (*genportfolio.Reconciliation, error) {
+	s.logger.DebugContext(ctx, "portfolio.reconcilePositions", "portfolio_id", p.PortfolioID, "format", p.Format)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	asOf := s.now()
+	if p.AsOf != nil {
+		if asOf, err = time.Parse(time.DateOnly, *p.AsOf); err != nil {
+			return nil, genportfolio.BadRequest(err.Error())
+		}
+	}
+	sts, err := s.readSnapshot(p, asOf)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+	if len(sts) == 0 {
+		return nil, genportfolio.BadRequest("the snapshot has no positions or cash balances")
+	}
+
+	res := &genportfolio.Reconciliation{
+		PortfolioID: pf.id,
+		Format:      p.Format,
+		Accounts:    make([]*genportfolio.ReconciledAccount, len(sts)),
+		Breaks:      []*genportfolio.ReconciliationBreak{},
+	}
+	var found []reconcile.Break
+	for i, st := range sts {
+		held := reconcile.Holdings{Quantities: map[string]float64{}}
+		if acct, ok := pf.ledger.Snapshot(st.AsOf).Accounts[st.Account]; ok {
+			held = reconcile.Holdings{Quantities: acct.Quantities, Cash: acct.Cash}
+		}
+		breaks := reconcile.Compare(st, held, func(symbol string) (float64, bool) {
+			px, err := s.prices.Close(symbol, st.AsOf)
+			return px, err == nil
+		})
+		found = append(found, breaks...)
+		res.Accounts[i] = &genportfolio.ReconciledAccount{
+			Account:   st.Account,
+			AsOf:      st.AsOf.Format(time.DateOnly),
+			Positions: len(st.Positions),
+			Cash:      st.Cash != nil,
+			Breaks:    len(breaks),
+		}
+	}
+
+	s.mu.Lock()
+	var out reconcile.Outcome
+	pf.breaks, out = reconcile.Merge(pf.breaks, sts, found, s.now(), func() string {
+		s.breakSeq++
+		return fmt.Sprintf("brk-%06d", s.breakSeq)
+	})
+	for i := range found {
+		res.Breaks = append(res.Breaks, toReconciliationBreak(&found[i]))
+	}
+	s.mu.Unlock()
+	res.Opened, res.Updated, res.Resolved = out.Opened, out.Updated, out.Resolved
+	if out.Opened > 0 {
+		s.emit(slog.LevelWarn, pf.id, eventReconciliation, "",
+			fmt.Sprintf("reconciliation of portfolio %s found %d new breaks", pf.id, out.Opened))
+	}
+	return res, nil
+}
+
+// readSnapshot reads the statements of a position snapshot, booked to the
+// payload account, with undated statements as of asOf.
+func (s *PortfolioService) readSnapshot(p *genportfolio.ReconcilePositionsPayload, asOf time.Time) ([]statement.Statement, error) {
+	day := time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+	if p.Format == "ofx" {
+		sts, err := statement.ParseOFX(strings.NewReader(p.Content))
+		if err != nil {
+			return nil, err
+		}
+		res := make([]statement.Statement, 0, len(sts))
+		for _, st := range sts {
+			if st.Positions == nil && st.Cash == nil {
+				continue
+			}
+			if p.Account != "" {
+				st.Account = p.Account
+			}
+			if st.AsOf.IsZero() {
+				st.AsOf = day
+			}
+			res = append(res, st)
+		}
+		return res, nil
+	}
+
+	s.mu.RLock()
+	profile, ok := s.importProfiles[p.Profile]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown import profile %q", p.Profile)
+	}
+	sts, err := statement.ParsePositionsCSV(strings.NewReader(p.Content), profile, day)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sts {
+		if sts[i].Account == "" {
+			sts[i].Account = p.Account
+		}
+	}
+	return sts, nil
+}
+
+// ListReconciliationBreaks lists the reconciliation breaks of a portfolio.
+func (s *PortfolioService) ListReconciliationBreaks(ctx context.Context, p *genportfolio.ListReconciliationBreaksPayload) ([]*genportfolio.ReconciliationBreak, error) {
+	s.logger.DebugContext(ctx, "portfolio.listReconciliationBreaks", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := []*genportfolio.ReconciliationBreak{}
+	for _, b := range pf.breaks {
+		if (p.Status != nil && *p.Status != string(b.Status)) || (p.Account != nil && *p.Account != b.Account) {
+			continue
+		}
+		res = append(res, toReconciliationBreak(b))
+	}
+	return res, nil
+}
+
+// UpdateReconciliationBreak sets the status of a reconciliation break,
+// posting its adjustments when it is resolved with apply.
+func (s *PortfolioService) UpdateReconciliationBreak(ctx context.Context, p *genportfolio.UpdateReconciliationBreakPayload) (*genportfolio.ReconciliationBreak, error) {
+	s.logger.DebugContext(ctx, "portfolio.updateReconciliationBreak", "portfolio_id", p.PortfolioID, "id", p.ID, "status", p.Status, "apply", p.Apply)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var b *reconcile.Break
+	for _, candidate := range pf.breaks {
+		if candidate.ID == p.ID {
+			b = candidate
+		}
+	}
+	if b == nil {
+		return nil, genportfolio.NotFound("break " + p.ID + " not found")
+	}
+	status := reconcile.Status(p.Status)
+	if p.Apply {
+		switch {
+		case status != reconcile.Resolved:
+			return nil, genportfolio.BadRequest("apply only resolves breaks")
+		case b.Status != reconcile.Open:
+			return nil, genportfolio.BadRequest(fmt.Sprintf("break %s is %s; only open breaks can be applied", b.ID, b.Status))
+		case len(b.Adjustments) == 0:
+			return nil, genportfolio.BadRequest(fmt.Sprintf("break %s has no suggested adjustments", b.ID))
+		}
+	}
+	note := ""
+	if p.Note != nil {
+		note = *p.Note
+	}
+	prev := *b
+	if err := b.SetStatus(status, note, s.now()); err != nil {
+		if errors.Is(err, reconcile.ErrInvalidStatus) {
+			return nil, genportfolio.BadRequest(err.Error())
+		}
+		return nil, err
+	}
+	if p.Apply {
+		// The adjustments post together, so one the ledger refuses leaves
+		// the break open and posts nothing.
+		posted, err := pf.ledger.PostAll(b.Adjustments...)
+		if err != nil {
+			*b = prev
+			return nil, genportfolio.BadRequest(fmt.Sprintf("posting the adjustments of break %s: %v", b.ID, err))
+		}
+		b.Posted = nil
+		for _, tx := range posted {
+			b.Posted = append(b.Posted, tx.ID)
+		}
+	}
+	return toReconciliationBreak(b), nil
+}
+
+func toReconciliationBreak(b *reconcile.Break) *genportfolio.ReconciliationBreak {
+	res := &genportfolio.ReconciliationBreak{
+		ID:          b.ID,
+		Account:     b.Account,
+		AsOf:        b.AsOf.Format(time.DateOnly),
+		Kind:        string(b.Kind),
+		Statement:   b.Statement,
+		Ledger:      b.Ledger,
+		Difference:  b.Difference(),
+		Adjustments: make([]*genportfolio.LedgerTransaction, len(b.Adjustments)),
+		Status:      string(b.Status),
+		Detected:    b.Detected.Format(time.RFC3339),
+		Updated:     b.Updated.Format(time.RFC3339),
+		Posted:      b.Posted,
+	}
+	for i, tx := range b.Adjustments {
+		res.Adjustments[i] = toLedgerTransaction(tx, false)
+	}
+	if b.Symbol != "" {
+		v := b.Symbol
+		res.Symbol = &v
+	}
+	if b.Price > 0 {
+		v := b.Price
+		res.Price = &v
+	}
+	if b.Note != "" {
+		v := b.Note
+		res.Note = &v
+	}
+	return res
+}
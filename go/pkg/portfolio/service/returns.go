@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+)
+
+// GetReturns returns time-weighted and money-weighted returns for a period.
+func (s *PortfolioService) GetReturns(ctx context.Context, p *genportfolio.GetReturnsPayload) (*genportfolio.PortfolioReturns, error) {
+	s.logger.DebugContext(ctx, "portfolio.getReturns", "portfolio_id", p.PortfolioID, "period", p.Period)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	l, err := s.scope(pf, p.Account)
+	if err != nil {
+		return nil, err
+	}
+	period := performance.Period(p.Period)
+	from, to, err := s.resolvePeriod(l, period, p.Start, p.End)
+	if err != nil {
+		return nil, err
+	}
+	res, err := performance.Compute(l, s.prices, period, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genportfolio.PortfolioReturns{
+		PortfolioID:                  pf.id,
+		Period:                       string(res.Period),
+		Account:                      p.Account,
+		Start:                        res.From.Format(time.DateOnly),
+		End:                          res.To.Format(time.DateOnly),
+		StartValue:                   res.StartValue,
+		EndValue:                     res.EndValue,
+		NetContributions:             res.NetContributions,
+		Gain:                         res.Gain,
+		TimeWeightedReturn:           res.TimeWeighted,
+		AnnualizedTimeWeightedReturn: res.AnnualizedTimeWeighted,
+		MoneyWeightedReturn:          res.MoneyWeighted,
+	}, nil
+}
+
+// resolvePeriod turns period payload attributes into a date range for the
+// ledger l.
+func (s *PortfolioService) resolvePeriod(l *ledger.Ledger, period performance.Period, start, end *string) (time.Time, time.Time, error) {
+	from, err := parseDate(start)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := parseDate(end)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	f, t, err := period.Range(s.now(), l.Inception(), from, to)
+	if err != nil {
+		return time.Time{}, time.Time{}, genportfolio.BadRequest(err.Error())
+	}
+	return f, t, nil
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/risk"
+)
+
+// SetRiskFreeRate sets the annual risk-free rate used when a request does not
+// specify one.
+func (s *PortfolioService) SetRiskFreeRate(rate float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.riskFreeRate = rate
+}
+
+// GetRiskMetrics measures the risk of daily portfolio returns over a period.
+func (s *PortfolioService) GetRiskMetrics(ctx context.Context, p *genportfolio.GetRiskMetricsPayload) (*genportfolio.RiskMetrics, error) {
+	s.logger.DebugContext(ctx, "portfolio.getRiskMetrics", "portfolio_id", p.PortfolioID, "period", p.Period)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := s.resolvePeriod(pf.ledger, performance.Period(p.Period), p.Start, p.End)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	def := pf.benchmark
+	rate := s.riskFreeRate
+	s.mu.RUnlock()
+	if p.RiskFreeRate != nil {
+		rate = *p.RiskFreeRate
+	}
+
+	points, err := performance.Series(pf.ledger, s.prices, from, to)
+	if err != nil {
+		return nil, err
+	}
+	var levels []float64
+	if len(def.Components) > 0 {
+		days := make([]time.Time, len(points))
+		for i, pt := range points {
+			days[i] = pt.Date
+		}
+		if levels, err = def.Levels(s.market, days); err != nil {
+			return nil, err
+		}
+	}
+	series := risk.FromPoints(points, levels)
+
+	m, err := risk.Compute(series, rate)
+	if errors.Is(err, risk.ErrInsufficientData) {
+		return nil, genportfolio.BadRequest("period has too few trading days for risk metrics")
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &genportfolio.RiskMetrics{
+		PortfolioID:  pf.id,
+		Start:        m.Start.Format(time.DateOnly),
+		End:          m.End.Format(time.DateOnly),
+		RiskFreeRate: rate,
+		Volatility:   m.Volatility,
+		SharpeRatio:  m.Sharpe,
+		SortinoRatio: m.Sortino,
+		Beta:         m.Beta,
+		Correlation:  m.Correlation,
+		MaxDrawdown:  m.MaxDrawdown,
+		Rolling:      []*genportfolio.RiskWindow{},
+	}
+	if len(def.Components) > 0 {
+		res.Benchmark = toBenchmarkDefinition(def)
+	}
+	if m.MaxDrawdown > 0 {
+		peak, trough := m.DrawdownPeak.Format(time.DateOnly), m.DrawdownTrough.Format(time.DateOnly)
+		res.MaxDrawdownPeak, res.MaxDrawdownTrough = &peak, &trough
+	}
+
+	if p.Window != nil {
+		windows, err := risk.Rolling(series, *p.Window, rate)
+		if err != nil {
+			return nil, genportfolio.BadRequest(err.Error())
+		}
+		for _, w := range windows {
+			res.Rolling = append(res.Rolling, &genportfolio.RiskWindow{
+				Start:        w.Start.Format(time.DateOnly),
+				End:          w.End.Format(time.DateOnly),
+				Volatility:   w.Volatility,
+				SharpeRatio:  w.Sharpe,
+				SortinoRatio: w.Sortino,
+				Beta:         w.Beta,
+				Correlation:  w.Correlation,
+				MaxDrawdown:  w.MaxDrawdown,
+			})
+		}
+	}
+	return res, nil
+}
+
+// GetValueAtRisk estimates the loss of current holdings at a confidence level
+// from locally stored price history.
+func (s *PortfolioService) GetValueAtRisk(ctx context.Context, p *genportfolio.GetValueAtRiskPayload) (*genportfolio.ValueAtRisk, error) {
+	s.logger.DebugContext(ctx, "portfolio.getValueAtRisk", "portfolio_id", p.PortfolioID, "method", p.Method)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	asOf := s.now()
+	v, err := holdings.Value(pf.ledger, s.prices, asOf)
+	if err != nil {
+		return nil, err
+	}
+	var exposures []risk.Exposure
+	for sym, value := range v.BySymbol() {
+		exposures = append(exposures, risk.Exposure{Symbol: sym, Value: value})
+	}
+	sort.Slice(exposures, func(i, j int) bool { return exposures[i].Symbol < exposures[j].Symbol })
+	symbols := make([]string, len(exposures))
+	for i, e := range exposures {
+		symbols[i] = e.Symbol
+	}
+
+	// Calendar days are doubled to cover weekends before trimming to lookback.
+	days := marketdata.TradingDays(asOf.AddDate(0, 0, -2*p.Lookback), asOf)
+	if len(days) > p.Lookback+1 {
+		days = days[len(days)-p.Lookback-1:]
+	}
+	returns, err := risk.AssetReturns(s.prices, symbols, days)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+
+	opts := risk.VaROptions{
+		Method:      risk.Method(p.Method),
+		Confidence:  p.Confidence,
+		Horizon:     p.Horizon,
+		Simulations: p.Simulations,
+		Seed:        asOf.UnixNano(),
+	}
+	if p.Seed != nil {
+		opts.Seed = *p.Seed
+	}
+	res, err := risk.ValueAtRisk(exposures, returns, opts)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+
+	out := &genportfolio.ValueAtRisk{
+		PortfolioID:       pf.id,
+		AsOf:              asOf.Format(time.DateOnly),
+		Method:            string(res.Method),
+		Confidence:        res.Confidence,
+		Horizon:           res.Horizon,
+		Lookback:          len(days) - 1,
+		PortfolioValue:    res.PortfolioValue,
+		ValueAtRisk:       res.ValueAtRisk,
+		ExpectedShortfall: res.ExpectedShortfall,
+		Contributions:     make([]*genportfolio.VaRContribution, len(res.Contributions)),
+	}
+	for i, c := range res.Contributions {
+		out.Contributions[i] = &genportfolio.VaRContribution{
+			Symbol:       c.Symbol,
+			Value:        c.Value,
+			MarginalVar:  c.Marginal,
+			ComponentVar: c.Component,
+		}
+	}
+	return out, nil
+}
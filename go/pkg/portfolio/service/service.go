@@ -3,62 +3,216 @@ package service
 import (
 	"context"
 	"log/slog"
+	"math/rand"
 	"sync"
 	"time"
 
 	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/accounts"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/benchmark"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/margin"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/options"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/orders"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/performance"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/rebalance"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reconcile"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reference"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/risk"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/statement"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/tax"
 )
 
+// defaultPortfolioID identifies the portfolio methods use when none is given.
+const defaultPortfolioID = "default"
+
 // PortfolioService implementation.
 type PortfolioService struct {
 	logger *slog.Logger
 	mu     sync.RWMutex
-	state  *genportfolio.PortfolioSummary
+	now    func() time.Time
+	market *marketdata.Store
+	// prices values holdings: market closes, with options without quotes
+	// valued from their underlying.
+	prices      marketdata.Provider
+	instruments *reference.Store
+	portfolios  map[string]*portfolioState
+	// riskFreeRate is the default annual risk-free rate, guarded by mu.
+	riskFreeRate float64
+	// scenarios are the stress scenarios by name, guarded by mu.
+	scenarios map[string]risk.Scenario
+	// optionVolatility and optionVolatilities (per underlying) are the
+	// volatility inputs of option valuation, guarded by mu.
+	optionVolatility   float64
+	optionVolatilities map[string]float64
+	// events are recent notable events, oldest first, guarded by mu.
+	events   []event
+	eventSeq int
+	// simulator fills paper orders, guarded by mu with orderSeq.
+	simulator orders.Simulator
+	orderSeq  int
+	// importProfiles are the CSV mapping profiles by name, guarded by mu.
+	importProfiles map[string]statement.Profile
+	// breakSeq numbers reconciliation breaks, guarded by mu.
+	breakSeq int
+	// equivalents are the substantially identical securities of the wash-sale
+	// rule, guarded by mu.
+	equivalents tax.Equivalents
+	// harvestReplacements are the candidate replacements of symbols sold to
+	// harvest a loss, guarded by mu.
+	harvestReplacements map[string][]string
+	// configuredAccounts and configuredMargin are registered in every
+	// portfolio, including those imported later, guarded by mu.
+	configuredAccounts []accounts.Account
+	configuredMargin   []margin.Terms
+}
+
+// portfolioState holds the books and settings of a single portfolio. Settings
+// are guarded by PortfolioService.mu.
+type portfolioState struct {
+	id        string
+	ledger    *ledger.Ledger
+	benchmark benchmark.Definition
+	targets   []rebalance.Target
+	// accounts holds the registered accounts by ID.
+	accounts map[string]accounts.Account
+	// margin holds the margin accounts by account.
+	margin map[string]*marginAccount
+	// paper portfolios accept simulated orders, kept oldest first.
+	paper  bool
+	orders []*orders.Order
+	// breaks are the reconciliation breaks, oldest first.
+	breaks []*reconcile.Break
+	// posting serializes the jobs that post transactions derived from the
+	// ledger, such as margin charges and order fills, so that none is posted
+	// twice or against cash already spent.
+	posting sync.Mutex
 }
 
 // NewPortfolioService returns the portfolio business service.
 func NewPortfolioService(logger *slog.Logger) *PortfolioService {
-	return &PortfolioService{
-		logger: logger,
-		state: &genportfolio.PortfolioSummary{
-			Balance:       12500.50,
-			Currency:      "USD",
-			ChangePercent: 12.5,
-		},
+	s := &PortfolioService{
+		logger:      logger,
+		now:         time.Now,
+		market:      marketdata.NewStore(),
+		instruments: reference.NewStore(),
+		portfolios:  map[string]*portfolioState{},
+		scenarios:   map[string]risk.Scenario{},
+
+		optionVolatility:   defaultOptionVolatility,
+		optionVolatilities: map[string]float64{},
+		importProfiles:     map[string]statement.Profile{statement.Generic.Name: statement.Generic},
+	}
+	s.prices = &options.Pricer{Market: s.market, Contract: s.optionContract, Volatility: s.volatility, Rate: s.rate}
+	for _, sc := range risk.BuiltinScenarios() {
+		s.scenarios[sc.Name] = sc
+	}
+	s.instruments.Put(demoReference...)
+	s.portfolios[defaultPortfolioID] = &portfolioState{
+		id:        defaultPortfolioID,
+		ledger:    seedDemo(s.market, s.now()),
+		benchmark: demoBenchmark,
+		targets:   demoTargets,
+		accounts:  map[string]accounts.Account{},
+		margin:    map[string]*marginAccount{},
+		paper:     true,
 	}
+	return s
 }
 
-// StartSimulation starts a background routine to simulate state changes.
+// StartSimulation starts a background routine to simulate market ticks.
 func (s *PortfolioService) StartSimulation(ctx context.Context) {
 	go func() {
 		ticker := time.NewTicker(1 * time.Second)
 		defer ticker.Stop()
+		var settled time.Time
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
-				s.mu.Lock()
-				// Mock variation: balance and change percent fluctuate slightly
-				now := time.Now()
-				s.state.Balance = 12500.50 + float64(now.Second())
-				s.state.ChangePercent = 12.5 + (float64(now.Second()) / 10.0)
-				s.mu.Unlock()
+				now := s.now()
+				if day := marketdata.Day(now); !day.Equal(settled) {
+					if err := s.SettleExpiredOptions(); err != nil {
+						s.logger.Warn("settling expired options", "error", err)
+					}
+					if err := s.CheckMargin(); err != nil {
+						s.logger.Warn("checking margin", "error", err)
+					}
+					settled = day
+				}
+				if !marketdata.IsTradingDay(now) {
+					s.MatchOrders()
+					continue
+				}
+				// Mock variation: each price moves by up to ±0.05% per tick
+				for _, sym := range s.market.Symbols() {
+					px, err := s.market.Close(sym, now)
+					if err != nil {
+						continue
+					}
+					s.market.Tick(sym, px*(1+(rand.Float64()-0.5)/1000), now) //nolint:gosec // simulated prices only
+				}
+				s.MatchOrders()
 			}
 		}
 	}()
 }
 
-// GetPortfolioSummary returns the current portfolio summary.
-func (s *PortfolioService) GetPortfolioSummary(ctx context.Context) (*genportfolio.PortfolioSummary, error) {
-	s.logger.DebugContext(ctx, "portfolio.getPortfolioSummary")
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+// GetPortfolioSummary returns the current summary of a portfolio or one of
+// its accounts.
+func (s *PortfolioService) GetPortfolioSummary(ctx context.Context, p *genportfolio.GetPortfolioSummaryPayload) (*genportfolio.PortfolioSummary, error) {
+	s.logger.DebugContext(ctx, "portfolio.getPortfolioSummary", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	l, err := s.scope(pf, p.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.summarize(l, s.now())
+}
+
+// summarize returns the balance of l at now and its change over the day.
+func (s *PortfolioService) summarize(l *ledger.Ledger, now time.Time) (*genportfolio.PortfolioSummary, error) {
+	from, to, err := performance.Period1D.Range(now, l.Inception(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	daily, err := performance.Compute(l, s.prices, performance.Period1D, from, to)
+	if err != nil {
+		return nil, err
+	}
 
-	// Return a copy to avoid external mutations affecting our internal state
 	return &genportfolio.PortfolioSummary{
-		Balance:       s.state.Balance,
-		Currency:      s.state.Currency,
-		ChangePercent: s.state.ChangePercent,
+		Balance:       daily.EndValue,
+		Currency:      l.Currency(),
+		ChangePercent: daily.TimeWeighted * 100,
 	}, nil
 }
+
+// portfolio looks up a portfolio by ID.
+func (s *PortfolioService) portfolio(id string) (*portfolioState, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	p, ok := s.portfolios[id]
+	if !ok {
+		return nil, genportfolio.NotFound("portfolio " + id + " not found")
+	}
+	return p, nil
+}
+
+// parseDate parses an optional YYYY-MM-DD payload attribute.
+func parseDate(v *string) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	t, err := time.Parse(time.DateOnly, *v)
+	if err != nil {
+		return nil, genportfolio.BadRequest("invalid date " + *v)
+	}
+	return &t, nil
+}
@@ -1,28 +1,1524 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"log/slog"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/accounts"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/archive"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/journal"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/margin"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/marketdata"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/orders"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reconcile"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/reference"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/risk"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/statement"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+func newTestService() *PortfolioService {
+	return NewPortfolioService(slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
 func TestPortfolioGetPortfolioSummary(t *testing.T) {
 	// Arrange
-	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
-
 	ctx := context.Background()
-	svc := NewPortfolioService(logger)
+	svc := newTestService()
 
 	// Act
-	res, err := svc.GetPortfolioSummary(ctx)
+	res, err := svc.GetPortfolioSummary(ctx, &genportfolio.GetPortfolioSummaryPayload{PortfolioID: "default"})
 
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
-	assert.Equal(t, 12500.50, res.Balance)
+	assert.Greater(t, res.Balance, 0.0)
 	assert.Equal(t, "USD", res.Currency)
-	assert.Equal(t, 12.5, res.ChangePercent)
+
+	daily, err := svc.GetReturns(ctx, &genportfolio.GetReturnsPayload{PortfolioID: "default", Period: "1D"})
+	require.NoError(t, err)
+	assert.InDelta(t, daily.EndValue, res.Balance, 1e-9)
+	assert.InDelta(t, daily.TimeWeightedReturn*100, res.ChangePercent, 1e-9)
+}
+
+func TestPortfolioGetReturns(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+
+	// Act
+	res, err := svc.GetReturns(ctx, &genportfolio.GetReturnsPayload{PortfolioID: "default", Period: "inception"})
+
+	// Assert: deposits and withdrawals are contributions, not performance
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, res.StartValue)
+	assert.InDelta(t, 12000, res.NetContributions, 1e-9)
+	assert.InDelta(t, res.EndValue-res.NetContributions, res.Gain, 1e-9)
+	assert.NotNil(t, res.AnnualizedTimeWeightedReturn)
+	assert.NotNil(t, res.MoneyWeightedReturn)
+
+	_, err = svc.GetReturns(ctx, &genportfolio.GetReturnsPayload{PortfolioID: "missing", Period: "YTD"})
+	var notFound genportfolio.NotFound
+	assert.ErrorAs(t, err, &notFound)
+
+	_, err = svc.GetReturns(ctx, &genportfolio.GetReturnsPayload{PortfolioID: "default", Period: "custom"})
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioBenchmarkComparison(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	_, err := svc.SetBenchmark(ctx, &genportfolio.SetBenchmarkPayload{
+		PortfolioID: "default",
+		Benchmark: &genportfolio.BenchmarkDefinition{
+			Name:       "S&P 500",
+			Components: []*genportfolio.BenchmarkComponent{{Symbol: "SPY", Weight: 1}},
+			Rebalance:  "none",
+		},
+	})
+	require.NoError(t, err)
+
+	// Act
+	res, err := svc.GetBenchmarkComparison(ctx, &genportfolio.GetBenchmarkComparisonPayload{PortfolioID: "default", Period: "1Y"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "S&P 500", res.Benchmark.Name)
+	assert.InDelta(t, res.PortfolioReturn-res.BenchmarkReturn, res.ExcessReturn, 1e-12)
+	assert.Greater(t, res.TrackingError, 0.0)
+	assert.NotEmpty(t, res.Series)
+
+	_, err = svc.SetBenchmark(ctx, &genportfolio.SetBenchmarkPayload{
+		PortfolioID: "default",
+		Benchmark: &genportfolio.BenchmarkDefinition{
+			Name:       "Unknown",
+			Components: []*genportfolio.BenchmarkComponent{{Symbol: "NOPE", Weight: 1}},
+			Rebalance:  "none",
+		},
+	})
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+
+	blend := "60% SPY / 40% AGG"
+	set, err := svc.SetBenchmark(ctx, &genportfolio.SetBenchmarkPayload{
+		PortfolioID: "default",
+		Benchmark:   &genportfolio.BenchmarkDefinition{Name: "60/40", Blend: &blend, Rebalance: "monthly"},
+	})
+	require.NoError(t, err)
+	require.Len(t, set.Components, 2)
+	assert.Equal(t, &genportfolio.BenchmarkComponent{Symbol: "AGG", Weight: 0.4}, set.Components[1])
+	assert.Equal(t, &blend, set.Blend)
+	_, err = svc.SetBenchmark(ctx, &genportfolio.SetBenchmarkPayload{
+		PortfolioID: "default",
+		Benchmark: &genportfolio.BenchmarkDefinition{
+			Name:       "Both",
+			Components: []*genportfolio.BenchmarkComponent{{Symbol: "SPY", Weight: 1}},
+			Blend:      &blend,
+			Rebalance:  "none",
+		},
+	})
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioGetAllocation(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+
+	// Act
+	res, err := svc.GetAllocation(ctx, &genportfolio.GetAllocationPayload{PortfolioID: "default", Dimension: "asset_class"})
+
+	// Assert
+	require.NoError(t, err)
+	total := 0.0
+	keys := map[string]bool{}
+	for _, b := range res.Buckets {
+		total += b.Weight
+		keys[b.Key] = true
+	}
+	assert.InDelta(t, 1, total, 1e-9)
+	assert.True(t, keys["equity"])
+	assert.True(t, keys["fixed_income"])
+
+	_, err = svc.GetAllocation(ctx, &genportfolio.GetAllocationPayload{PortfolioID: "default", Dimension: "tag"})
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioProposeRebalance(t *testing.T) {
+	// Arrange: move everything into bonds so the demo portfolio is far off target
+	ctx := context.Background()
+	svc := newTestService()
+	targets, err := svc.SetTargetAllocation(ctx, &genportfolio.SetTargetAllocationPayload{
+		PortfolioID: "default",
+		Targets:     []*genportfolio.TargetWeight{{Symbol: "AGG", Weight: 0.9, Tolerance: 0.02}},
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.1, targets.CashWeight, 1e-9)
+
+	// Act
+	res, err := svc.ProposeRebalance(ctx, &genportfolio.ProposeRebalancePayload{PortfolioID: "default"})
+
+	// Assert: positions outside the model are sold and bonds are bought
+	require.NoError(t, err)
+	sides := map[string]string{}
+	for _, tr := range res.Trades {
+		sides[tr.Symbol] = tr.Side
+	}
+	assert.Equal(t, map[string]string{"SPY": "sell", "AAPL": "sell", "MSFT": "sell", "AGG": "buy"}, sides)
+	assert.GreaterOrEqual(t, res.CashAfter, 0.0)
+
+	_, err = svc.SetTargetAllocation(ctx, &genportfolio.SetTargetAllocationPayload{
+		PortfolioID: "default",
+		Targets:     []*genportfolio.TargetWeight{{Symbol: "AGG", Weight: 0.9}, {Symbol: "SPY", Weight: 0.2}},
+	})
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioGetRiskMetrics(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	svc.SetRiskFreeRate(0.03)
+	window := 63
+
+	// Act
+	res, err := svc.GetRiskMetrics(ctx, &genportfolio.GetRiskMetricsPayload{PortfolioID: "default", Period: "1Y", Window: &window})
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, 0.03, res.RiskFreeRate, 1e-12)
+	assert.Greater(t, res.Volatility, 0.0)
+	require.NotNil(t, res.Benchmark)
+	require.NotNil(t, res.Beta)
+	require.NotNil(t, res.Correlation)
+	assert.LessOrEqual(t, *res.Correlation, 1.0)
+	if res.MaxDrawdown > 0 {
+		require.NotNil(t, res.MaxDrawdownPeak)
+		assert.Less(t, *res.MaxDrawdownPeak, *res.MaxDrawdownTrough)
+	}
+	require.NotEmpty(t, res.Rolling)
+	assert.Equal(t, res.End, res.Rolling[len(res.Rolling)-1].End)
+
+	_, err = svc.GetRiskMetrics(ctx, &genportfolio.GetRiskMetricsPayload{PortfolioID: "default", Period: "1D"})
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioGetValueAtRisk(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	seed := int64(42)
+	payload := func(method string) *genportfolio.GetValueAtRiskPayload {
+		return &genportfolio.GetValueAtRiskPayload{
+			PortfolioID: "default",
+			Method:      method,
+			Confidence:  0.99,
+			Horizon:     5,
+			Lookback:    252,
+			Simulations: 5000,
+			Seed:        &seed,
+		}
+	}
+
+	for _, method := range []string{"historical", "parametric", "monte_carlo"} {
+		// Act
+		res, err := svc.GetValueAtRisk(ctx, payload(method))
+
+		// Assert
+		require.NoError(t, err, method)
+		assert.Equal(t, 252, res.Lookback)
+		assert.Greater(t, res.ValueAtRisk, 0.0, method)
+		assert.GreaterOrEqual(t, res.ExpectedShortfall, res.ValueAtRisk, method)
+		assert.Len(t, res.Contributions, 4)
+		total := 0.0
+		for _, c := range res.Contributions {
+			total += c.ComponentVar
+		}
+		assert.InDelta(t, res.ValueAtRisk, total, 1e-6, method)
+	}
+
+	first, err := svc.GetValueAtRisk(ctx, payload("monte_carlo"))
+	require.NoError(t, err)
+	second, err := svc.GetValueAtRisk(ctx, payload("monte_carlo"))
+	require.NoError(t, err)
+	assert.Equal(t, first.ValueAtRisk, second.ValueAtRisk)
+}
+
+func TestPortfolioRunStressTest(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	require.NoError(t, svc.AddStressScenarios(risk.Scenario{
+		Name:    "aapl_halves",
+		Symbols: map[string]float64{"AAPL": -0.5},
+	}))
+
+	// Act
+	scenarios, err := svc.ListStressScenarios(ctx)
+	require.NoError(t, err)
+	crash, err := svc.RunStressTest(ctx, &genportfolio.RunStressTestPayload{PortfolioID: "default", Scenario: "equity_crash", Top: 2})
+	require.NoError(t, err)
+	rates, err := svc.RunStressTest(ctx, &genportfolio.RunStressTestPayload{PortfolioID: "default", Scenario: "rates_up_200bp", Top: 5})
+	require.NoError(t, err)
+	custom, err := svc.RunStressTest(ctx, &genportfolio.RunStressTestPayload{PortfolioID: "default", Scenario: "aapl_halves", Top: 5})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Len(t, scenarios, 4)
+	assert.Greater(t, crash.Loss, 0.0)
+	assert.InDelta(t, crash.CurrentValue-crash.ProjectedValue, crash.Loss, 1e-9)
+	require.Len(t, crash.WorstContributors, 2)
+	assert.Equal(t, "SPY", crash.WorstContributors[0].Symbol)
+	assert.InDelta(t, -0.4, crash.WorstContributors[0].Shock, 1e-12)
+	assert.Equal(t, "AGG", rates.WorstContributors[0].Symbol)
+	assert.InDelta(t, -0.12, rates.WorstContributors[0].Shock, 1e-12)
+	assert.Equal(t, "AAPL", custom.WorstContributors[0].Symbol)
+
+	_, err = svc.RunStressTest(ctx, &genportfolio.RunStressTestPayload{PortfolioID: "default", Scenario: "missing", Top: 5})
+	var notFound genportfolio.NotFound
+	assert.ErrorAs(t, err, &notFound)
+	assert.Error(t, svc.AddStressScenarios(risk.Scenario{}))
+}
+
+func TestStressScenariosFromConfig(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	v := viper.New()
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+portfolio:
+  stress-scenarios:
+    - name: aapl_halves
+      symbols:
+        AAPL: -0.5
+      currencies:
+        EUR: 0.1
+`)))
+	var scenarios []risk.Scenario
+	require.NoError(t, v.UnmarshalKey("portfolio.stress-scenarios", &scenarios))
+	svc := newTestService()
+
+	// Act
+	err := svc.AddStressScenarios(scenarios...)
+	require.NoError(t, err)
+	res, err := svc.RunStressTest(ctx, &genportfolio.RunStressTestPayload{PortfolioID: "default", Scenario: "aapl_halves", Top: 1})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, res.WorstContributors, 1)
+	assert.Equal(t, "AAPL", res.WorstContributors[0].Symbol)
+	assert.InDelta(t, -0.5, res.WorstContributors[0].Shock, 1e-12)
+	listed, err := svc.ListStressScenarios(ctx)
+	require.NoError(t, err)
+	for _, sc := range listed {
+		if sc.Name == "aapl_halves" {
+			assert.Equal(t, map[string]float64{"EUR": 0.1}, sc.Currencies)
+		}
+	}
+}
+
+func TestPortfolioProjectPortfolio(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	goal := 20000.0
+	seed := int64(3)
+	end := svc.now().AddDate(10, 0, 0).Format("2006-01-02")
+	equityReturn := 0.09
+
+	// Act
+	res, err := svc.ProjectPortfolio(ctx, &genportfolio.ProjectPortfolioPayload{
+		PortfolioID:         "default",
+		End:                 end,
+		MonthlyContribution: 100,
+		Inflation:           0.02,
+		Goal:                &goal,
+		Assumptions:         []*genportfolio.AssetClassAssumption{{AssetClass: "equity", ExpectedReturn: &equityReturn}},
+		Paths:               500,
+		Seed:                &seed,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Greater(t, res.StartValue, 0.0)
+	assert.Len(t, res.Bands, 10)
+	assert.Equal(t, end, res.Bands[9].Date)
+	require.NotNil(t, res.GoalProbability)
+	assert.Equal(t, end, *res.GoalDate)
+	weights := 0.0
+	for _, a := range res.Assumptions {
+		weights += *a.Weight
+		if a.AssetClass == "equity" {
+			assert.InDelta(t, 0.09, *a.ExpectedReturn, 1e-12)
+		}
+	}
+	assert.InDelta(t, 1, weights, 1e-9)
+
+	_, err = svc.ProjectPortfolio(ctx, &genportfolio.ProjectPortfolioPayload{PortfolioID: "default", End: "2000-01-01", Paths: 10})
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioCorporateActions(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	today := svc.now().Format("2006-01-02")
+	before := l.Snapshot(svc.now()).Quantities["AAPL"]
+
+	// Act
+	applied, err := svc.ApplyCorporateActions(ctx, &genportfolio.ApplyCorporateActionsPayload{
+		PortfolioID: "default",
+		Actions:     []*genportfolio.CorporateActionInput{{Date: today, Type: "split", Symbol: "AAPL", Ratio: 4}},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+	assert.NotEmpty(t, applied[0].ID)
+	assert.InDelta(t, before*4, l.Snapshot(svc.now()).Quantities["AAPL"], 1e-9)
+
+	newSymbol := "MSFX"
+	_, err = svc.ApplyCorporateActions(ctx, &genportfolio.ApplyCorporateActionsPayload{
+		PortfolioID: "default",
+		Actions: []*genportfolio.CorporateActionInput{
+			{Date: today, Type: "symbol_change", Symbol: "MSFT", NewSymbol: &newSymbol},
+			{Date: today, Type: "spin_off", Symbol: "MSFT", Ratio: 1},
+		},
+	})
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+
+	listed, err := svc.ListCorporateActions(ctx, &genportfolio.ListCorporateActionsPayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	assert.Len(t, listed, 1, "an invalid batch posts nothing")
+}
+
+func TestPortfolioIncome(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	payDay := tradingDayOnOrAfter(svc.now().AddDate(0, -1, 0))
+	exDate := payDay.AddDate(0, 0, -14).Format(time.DateOnly)
+	symbol := "SPY"
+	before := l.Snapshot(payDay).Quantities[symbol]
+
+	// Act
+	rec, err := svc.RecordIncome(ctx, &genportfolio.RecordIncomePayload{
+		PortfolioID: "default",
+		Type:        "dividend",
+		Symbol:      &symbol,
+		PayDate:     payDay.Format(time.DateOnly),
+		ExDate:      &exDate,
+		Amount:      40,
+		Withholding: 6,
+		Qualified:   true,
+		Reinvest:    true,
+	})
+	require.NoError(t, err)
+	_, err = svc.RecordIncome(ctx, &genportfolio.RecordIncomePayload{
+		PortfolioID: "default",
+		Type:        "interest",
+		PayDate:     payDay.Format(time.DateOnly),
+		Amount:      5,
+	})
+	require.NoError(t, err)
+	summary, err := svc.GetIncome(ctx, &genportfolio.GetIncomePayload{PortfolioID: "default", Period: "1Y", Interval: "quarter"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, 34, rec.Net, 1e-9)
+	require.NotNil(t, rec.ReinvestedQuantity)
+	px, err := svc.market.Close(symbol, payDay)
+	require.NoError(t, err)
+	assert.InDelta(t, 34/px, *rec.ReinvestedQuantity, 1e-9)
+	assert.InDelta(t, before+34/px, l.Snapshot(payDay).Quantities[symbol], 1e-9)
+
+	assert.InDelta(t, 45, summary.Gross, 1e-9)
+	assert.InDelta(t, 39, summary.Net, 1e-9)
+	assert.InDelta(t, 40, summary.Qualified, 1e-9)
+	assert.InDelta(t, 5, summary.Ordinary, 1e-9)
+	require.Len(t, summary.ByHolding, 2)
+	assert.Equal(t, "SPY", summary.ByHolding[0].Key)
+	require.Len(t, summary.ByCurrency, 1)
+	require.Len(t, summary.Forecast, 1)
+	assert.InDelta(t, 5+40*(before+34/px)/before, summary.ForecastTotal, 1e-9)
+
+	_, err = svc.RecordIncome(ctx, &genportfolio.RecordIncomePayload{PortfolioID: "default", Type: "interest", PayDate: payDay.Format(time.DateOnly), Amount: 5, Reinvest: true})
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+
+	// A dividend withheld in full leaves nothing to reinvest and is not posted.
+	posted := len(l.Transactions())
+	_, err = svc.RecordIncome(ctx, &genportfolio.RecordIncomePayload{
+		PortfolioID: "default",
+		Type:        "dividend",
+		Symbol:      &symbol,
+		PayDate:     payDay.Format(time.DateOnly),
+		Amount:      10,
+		Withholding: 10,
+		Reinvest:    true,
+	})
+	assert.ErrorAs(t, err, &badRequest)
+	assert.Len(t, l.Transactions(), posted)
+}
+
+func TestPortfolioBonds(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	now := svc.now()
+	day := tradingDayOnOrAfter(now.AddDate(0, 0, -10))
+	maturity := day.AddDate(3, 0, 0)
+	svc.instruments.Put(reference.Instrument{
+		Symbol: "T3Y", AssetClass: "fixed_income", Currency: "USD",
+		Coupon: 0.04, CouponFrequency: 2, DayCount: "ACT/ACT", Maturity: maturity.Format(time.DateOnly), FaceValue: 1000,
+	})
+	svc.market.Put("T3Y", marketdata.Bar{Date: day, Close: 990})
+	svc.instruments.Put(reference.Instrument{
+		Symbol: "TMAT", AssetClass: "fixed_income", Currency: "USD",
+		Coupon: 0.02, CouponFrequency: 2, Maturity: day.AddDate(0, 0, 5).Format(time.DateOnly), FaceValue: 1000,
+	})
+	svc.market.Put("TMAT", marketdata.Bar{Date: day, Close: 1000})
+	_, err := l.Post(ledger.Transaction{Date: day, Type: ledger.TxDeposit, Amount: 3000})
+	require.NoError(t, err)
+	_, err = l.Post(ledger.Transaction{Date: day, Type: ledger.TxBuy, Symbol: "T3Y", Quantity: 2, Price: 990})
+	require.NoError(t, err)
+	_, err = l.Post(ledger.Transaction{Date: day, Type: ledger.TxBuy, Symbol: "TMAT", Quantity: 1, Price: 1000})
+	require.NoError(t, err)
+
+	// Act
+	positions, err := svc.ListBondPositions(ctx, &genportfolio.ListBondPositionsPayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	ladder, err := svc.GetCashFlowLadder(ctx, &genportfolio.GetCashFlowLadderPayload{PortfolioID: "default", Years: 5})
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, positions, 1, "the matured bond is left out")
+	assert.Equal(t, "T3Y", positions[0].Symbol)
+	a := positions[0].Analytics
+	assert.InDelta(t, 990, a.CleanPrice, 1e-6)
+	assert.Greater(t, a.YieldToMaturity, 0.04)
+	assert.InDelta(t, 2*a.DirtyPrice, positions[0].MarketValue, 1e-9)
+	assert.Greater(t, a.ModifiedDuration, 2.0)
+
+	require.Len(t, ladder.Flows, 6)
+	assert.InDelta(t, 6*2*20+2*1000, ladder.Total, 1e-9)
+	last := ladder.Flows[len(ladder.Flows)-1]
+	assert.Equal(t, maturity.Format(time.DateOnly), last.Date)
+	assert.InDelta(t, 2000, last.Principal, 1e-9)
+
+	settlement, yield := "2025-11-15", 0.05
+	priced, err := svc.PriceBond(ctx, &genportfolio.PriceBondPayload{
+		Coupon: 0.05, CouponFrequency: 2, DayCount: "30/360", Maturity: "2030-11-15", FaceValue: 100,
+		Settlement: &settlement, Yield: &yield,
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 100, priced.CleanPrice, 1e-9)
+	_, err = svc.PriceBond(ctx, &genportfolio.PriceBondPayload{CouponFrequency: 2, DayCount: "30/360", Maturity: "2030-11-15", FaceValue: 100})
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioOptions(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	svc.SetOptionVolatility(0.3, map[string]float64{"MSFT": 0.2})
+	l := svc.portfolios["default"].ledger
+	now := svc.now()
+	day := tradingDayOnOrAfter(now.AddDate(0, 0, -20))
+	spot, err := svc.market.Close("AAPL", day)
+	require.NoError(t, err)
+	expired := tradingDayOnOrAfter(day.AddDate(0, 0, 3))
+	expiredSpot, err := svc.market.Close("AAPL", expired)
+	require.NoError(t, err)
+	svc.instruments.Put(
+		reference.Instrument{Symbol: "AAPL-C", AssetClass: "equity", Underlying: "AAPL", OptionType: "call", Strike: spot, Expiry: now.AddDate(0, 3, 0).Format(time.DateOnly)},
+		reference.Instrument{Symbol: "AAPL-ITM", AssetClass: "equity", Underlying: "AAPL", OptionType: "call", Strike: expiredSpot - 10, Expiry: expired.Format(time.DateOnly)},
+		reference.Instrument{Symbol: "AAPL-P", AssetClass: "equity", Underlying: "AAPL", OptionType: "put", Strike: expiredSpot + 10, Expiry: expired.Format(time.DateOnly)},
+		reference.Instrument{Symbol: "AAPL-OTM", AssetClass: "equity", Underlying: "AAPL", OptionType: "call", Strike: expiredSpot + 50, Expiry: expired.Format(time.DateOnly)},
+	)
+	l.SetMarginAccount("writer", true)
+	for _, tx := range []ledger.Transaction{
+		{Date: day, Type: ledger.TxDeposit, Amount: 100000},
+		{Date: day, Type: ledger.TxBuy, Symbol: "AAPL-C", Quantity: 2, Price: 500},
+		{Date: day, Type: ledger.TxBuy, Symbol: "AAPL-ITM", Quantity: 1, Price: 1200},
+		{Date: day, Type: ledger.TxDeposit, Account: "writer", Amount: 100000},
+		{Date: day, Type: ledger.TxSell, Account: "writer", Symbol: "AAPL-P", Quantity: 1, Price: 1500},
+		{Date: day, Type: ledger.TxSell, Account: "writer", Symbol: "AAPL-OTM", Quantity: 1, Price: 100},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	shares := l.Snapshot(now).Quantities["AAPL"]
+	posted := len(l.Transactions())
+
+	// Act
+	unsettled, err := svc.ListOptionPositions(ctx, &genportfolio.ListOptionPositionsPayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	listed := len(l.Transactions())
+	settled, err := svc.SettleOptionExpiries(ctx, &genportfolio.SettleOptionExpiriesPayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	res, err := svc.ListOptionPositions(ctx, &genportfolio.ListOptionPositionsPayload{PortfolioID: "default"})
+
+	// Assert
+	assert.Len(t, unsettled.Positions, 4)
+	assert.Equal(t, posted, listed, "listing positions settles nothing")
+	assert.Len(t, settled, 5)
+	require.NoError(t, err)
+	require.Len(t, res.Positions, 1, "the expired options are settled")
+	pos := res.Positions[0]
+	assert.InDelta(t, 0.3, pos.Volatility, 1e-12)
+	assert.Greater(t, pos.Delta, 0.0)
+	assert.Less(t, pos.Delta, 200.0)
+	assert.Less(t, pos.Theta, 0.0)
+	require.Len(t, res.Exposures, 1)
+	e := res.Exposures[0]
+	assert.InDelta(t, shares+200, e.Shares, 1e-9, "the in-the-money call was exercised and the written put assigned")
+	writer := l.Snapshot(now).Accounts["writer"]
+	assert.Equal(t, map[string]float64{"AAPL": 100}, writer.Quantities)
+	assert.InDelta(t, 100000+1500+100-1000-100*expiredSpot, writer.Cash, 1e-6, "the put writer pays the strike")
+	assert.InDelta(t, (e.Shares+pos.Delta)*e.Price, e.DeltaAdjustedExposure, 1e-6)
+
+	summary, err := svc.GetAllocation(ctx, &genportfolio.GetAllocationPayload{PortfolioID: "default", Dimension: "asset_class"})
+	require.NoError(t, err, "options are valued from their underlying")
+	assert.NotEmpty(t, summary.Buckets)
+
+	again, err := svc.SettleOptionExpiries(ctx, &genportfolio.SettleOptionExpiriesPayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	assert.Empty(t, again)
+}
+
+func TestPortfolioMargin(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	now := svc.now()
+	day := tradingDayOnOrAfter(now.AddDate(0, 0, -10))
+	aapl, err := svc.market.Close("AAPL", day)
+	require.NoError(t, err)
+	msft, err := svc.market.Close("MSFT", day)
+	require.NoError(t, err)
+	_, err = svc.SetMarginAccount(ctx, &genportfolio.SetMarginAccountPayload{
+		PortfolioID: "default", Account: "margin",
+		InitialRequirement: 0.5, MaintenanceRequirement: 0.25, ShortMaintenanceRequirement: 0.3, LoanRate: 0.08,
+	})
+	require.NoError(t, err)
+	for _, tx := range []ledger.Transaction{
+		{Date: day, Type: ledger.TxDeposit, Account: "margin", Amount: 10000},
+		{Date: day, Type: ledger.TxBuy, Account: "margin", Symbol: "AAPL", Quantity: 15000 / aapl, Price: aapl},
+		{Date: day, Type: ledger.TxSell, Account: "margin", Symbol: "MSFT", Quantity: 2000 / msft, Price: msft},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	_, err = l.Post(ledger.Transaction{Date: day, Type: ledger.TxSell, Symbol: "MSFT", Quantity: 1e9, Price: msft})
+	require.ErrorIs(t, err, ledger.ErrInsufficientQuantity, "only margin accounts sell short")
+
+	posted := len(l.Transactions())
+
+	// Act
+	res, err := svc.GetMarginStatus(ctx, &genportfolio.GetMarginStatusPayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	afterGet := len(l.Transactions())
+	charged, err := svc.ChargeMargin(ctx, &genportfolio.ChargeMarginPayload{PortfolioID: "default"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	assert.Equal(t, posted, afterGet, "reading the status posts nothing")
+	assert.Zero(t, res.Accounts[0].InterestCharged)
+	require.Len(t, charged.Accounts, 1)
+	assert.InDelta(t, res.Accounts[0].AccruedInterest, charged.Accounts[0].AccruedInterest+charged.Accounts[0].InterestCharged, 1e-9)
+	st := charged.Accounts[0]
+	assert.InDelta(t, 2000, st.ShortProceeds, 1e-6)
+	assert.InDelta(t, -3000, st.Cash+st.InterestCharged, 1e-6)
+	assert.InDelta(t, st.Cash+st.LongValue-st.ShortValue, st.Equity, 1e-6)
+	assert.InDelta(t, 0.25*st.LongValue+0.3*st.ShortValue, st.MaintenanceRequirement, 1e-6)
+	assert.False(t, st.MarginCall)
+	assert.Greater(t, st.BuyingPower, 0.0)
+	assert.Greater(t, st.AccruedInterest+st.InterestCharged, 0.0)
+
+	// Raising the requirement above equity triggers a single margin call.
+	_, err = svc.SetMarginAccount(ctx, &genportfolio.SetMarginAccountPayload{
+		PortfolioID: "default", Account: "margin",
+		InitialRequirement: 1, MaintenanceRequirement: 1, ShortMaintenanceRequirement: 1,
+	})
+	require.NoError(t, err)
+	res, err = svc.GetMarginStatus(ctx, &genportfolio.GetMarginStatusPayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	assert.True(t, res.Accounts[0].MarginCall)
+	for range 2 {
+		res, err = svc.ChargeMargin(ctx, &genportfolio.ChargeMarginPayload{PortfolioID: "default"})
+		require.NoError(t, err)
+		assert.True(t, res.Accounts[0].MarginCall)
+		assert.Less(t, res.Accounts[0].MaintenanceExcess, 0.0)
+	}
+	typ := "margin_call"
+	events, err := svc.ListEvents(ctx, &genportfolio.ListEventsPayload{PortfolioID: "default", Type: &typ})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "margin", *events[0].Account)
+}
+
+func TestOptionVolatilitiesFromConfig(t *testing.T) {
+	// Arrange
+	v := viper.New()
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+portfolio:
+  option-volatilities:
+    MSFT: 0.2
+    Aapl: 0.35
+`)))
+	var volatilities map[string]float64
+	require.NoError(t, v.UnmarshalKey("portfolio.option-volatilities", &volatilities))
+	svc := newTestService()
+
+	// Act
+	svc.SetOptionVolatility(0.3, volatilities)
+
+	// Assert
+	assert.InDelta(t, 0.2, svc.volatility("MSFT"), 1e-12)
+	assert.InDelta(t, 0.35, svc.volatility("AAPL"), 1e-12)
+	assert.InDelta(t, 0.3, svc.volatility("GOOG"), 1e-12)
+}
+
+func TestMarginChargesPostedOnce(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	day := tradingDayOnOrAfter(svc.now().AddDate(0, -3, 0))
+	aapl, err := svc.market.Close("AAPL", day)
+	require.NoError(t, err)
+	_, err = svc.SetMarginAccount(ctx, &genportfolio.SetMarginAccountPayload{
+		PortfolioID: "default", Account: "margin",
+		InitialRequirement: 0.5, MaintenanceRequirement: 0.25, ShortMaintenanceRequirement: 0.3, LoanRate: 0.08,
+	})
+	require.NoError(t, err)
+	for _, tx := range []ledger.Transaction{
+		{Date: day, Type: ledger.TxDeposit, Account: "margin", Amount: 10000},
+		{Date: day, Type: ledger.TxBuy, Account: "margin", Symbol: "AAPL", Quantity: 15000 / aapl, Price: aapl},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := svc.ChargeMargin(ctx, &genportfolio.ChargeMarginPayload{PortfolioID: "default"})
+			assert.NoError(t, err)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	// Assert: one interest charge per completed month.
+	charged := map[time.Time]int{}
+	for _, tx := range l.Transactions() {
+		if tx.Type == ledger.TxMarginInterest {
+			charged[tx.Date]++
+		}
+	}
+	assert.NotEmpty(t, charged)
+	for date, n := range charged {
+		assert.Equal(t, 1, n, "interest for %s", date.Format(time.DateOnly))
+	}
+}
+
+func TestPortfolioOrders(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	now := tradingDayOnOrAfter(marketdata.Day(time.Now()).AddDate(0, 0, -10)).Add(15 * time.Hour)
+	svc.now = func() time.Time { return now }
+	require.NoError(t, svc.SetPaperTrading(orders.Simulator{Slippage: orders.Slippage{Bps: 10}, Commission: orders.Commission{PerOrder: 1}}))
+	l := svc.portfolios["default"].ledger
+	_, err := l.Post(ledger.Transaction{Date: now.AddDate(0, 0, -1), Type: ledger.TxDeposit, Account: "paper", Amount: 10000})
+	require.NoError(t, err)
+	px, err := svc.market.Close("AAPL", now)
+	require.NoError(t, err)
+	account := "paper"
+	place := func(p genportfolio.PlaceOrderPayload) *genportfolio.Order {
+		p.PortfolioID, p.Account, p.Symbol = "default", &account, "AAPL"
+		if p.Type == "" {
+			p.Type = "market"
+		}
+		if p.TimeInForce == "" {
+			p.TimeInForce = "day"
+		}
+		o, err := svc.PlaceOrder(ctx, &p)
+		require.NoError(t, err)
+		return o
+	}
+
+	// Act
+	market := place(genportfolio.PlaceOrderPayload{Side: "buy", Quantity: 10})
+	above := px * 2
+	limit := place(genportfolio.PlaceOrderPayload{Side: "sell", Type: "limit", Quantity: 5, LimitPrice: &above, TimeInForce: "gtc"})
+	below := px / 2
+	day := place(genportfolio.PlaceOrderPayload{Side: "buy", Type: "limit", Quantity: 1, LimitPrice: &below})
+	tooBig := place(genportfolio.PlaceOrderPayload{Side: "buy", Quantity: 1e6})
+	cancelled, err := svc.CancelOrder(ctx, &genportfolio.CancelOrderPayload{PortfolioID: "default", ID: limit.ID})
+	require.NoError(t, err)
+	now = tradingDayOnOrAfter(now.AddDate(0, 0, 1)).Add(15 * time.Hour)
+	svc.MatchOrders()
+
+	// Assert
+	assert.Equal(t, "filled", market.Status)
+	assert.InDelta(t, px*1.001, market.FillPrice, 1e-9)
+	assert.InDelta(t, 1, market.Commission, 1e-9)
+	assert.InDelta(t, 10, l.Snapshot(now).Accounts["paper"].Quantities["AAPL"], 1e-9)
+	assert.InDelta(t, 10000-10*px*1.001-1, l.Snapshot(now).Accounts["paper"].Cash, 1e-6)
+	assert.Equal(t, "open", limit.Status)
+	assert.Equal(t, "cancelled", cancelled.Status)
+	assert.Equal(t, "rejected", tooBig.Status)
+	require.NotNil(t, tooBig.Reason)
+	assert.Contains(t, *tooBig.Reason, "insufficient cash")
+
+	_, err = svc.CancelOrder(ctx, &genportfolio.CancelOrderPayload{PortfolioID: "default", ID: market.ID})
+	assert.Error(t, err, "filled orders cannot be cancelled")
+	status := "expired"
+	expired, err := svc.ListOrders(ctx, &genportfolio.ListOrdersPayload{PortfolioID: "default", Status: &status})
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, day.ID, expired[0].ID)
+
+	typ := "order_status"
+	events, err := svc.ListEvents(ctx, &genportfolio.ListEventsPayload{PortfolioID: "default", Type: &typ})
+	require.NoError(t, err)
+	assert.Len(t, events, 8, "placed and final status of each order")
+}
+
+func TestPortfolioPreviewTrades(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	txns := len(l.Transactions())
+	held := l.Snapshot(svc.now()).Quantities["AAPL"]
+	aggPrice := 90.0
+	payload := &genportfolio.PreviewTradesPayload{
+		PortfolioID: "default",
+		Trades: []*genportfolio.TradeInput{
+			{Symbol: "AAPL", Side: "sell", Quantity: held},
+			{Symbol: "AGG", Side: "buy", Quantity: 10, Price: &aggPrice, Fee: 1},
+		},
+		Dimension:        "asset_class",
+		ShortTermTaxRate: 0.3,
+		LongTermTaxRate:  0.15,
+		Lookback:         60,
+	}
+
+	// Act
+	res, err := svc.PreviewTrades(ctx, payload)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, l.Transactions(), txns, "nothing is persisted")
+	require.Len(t, res.Realized, 1)
+	lot := res.Realized[0]
+	assert.True(t, lot.LongTerm)
+	assert.InDelta(t, held, lot.Quantity, 1e-9)
+	assert.InDelta(t, lot.Gain, res.LongTermGain, 1e-9)
+	assert.InDelta(t, max(lot.Gain, 0)*0.15, res.EstimatedTax, 1e-9)
+	assert.InDelta(t, res.CashBefore+lot.Proceeds-901, res.CashAfter, 1e-6)
+	aggClose, err := svc.market.Close("AGG", svc.now())
+	require.NoError(t, err)
+	assert.InDelta(t, res.Before.Balance+10*(aggClose-aggPrice)-1, res.After.Balance, 1e-6)
+	for _, c := range res.Allocation {
+		if c.Key == "equity" {
+			assert.Less(t, c.WeightAfter, c.WeightBefore)
+		}
+	}
+	assert.Greater(t, res.RiskBefore.Volatility, 0.0)
+	assert.Greater(t, res.RiskAfter.ValueAtRisk, 0.0)
+	assert.Empty(t, res.Warnings)
+
+	payload.Trades = []*genportfolio.TradeInput{{Symbol: "AAPL", Side: "sell", Quantity: held + 1}}
+	_, err = svc.PreviewTrades(ctx, payload)
+	assert.Error(t, err, "oversold sales are rejected")
+}
+
+func TestEstimatedTax(t *testing.T) {
+	assert.InDelta(t, 100*0.3+50*0.15, estimatedTax(100, 50, 0.3, 0.15), 1e-9)
+	assert.InDelta(t, 60*0.15, estimatedTax(-40, 100, 0.3, 0.15), 1e-9)
+	assert.InDelta(t, 0, estimatedTax(-100, 50, 0.3, 0.15), 1e-9)
+}
+
+func TestPortfolioRunBacktest(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	payload := &genportfolio.RunBacktestPayload{
+		Strategy:    "periodic_rebalance",
+		Start:       svc.now().AddDate(0, -6, 0).Format(time.DateOnly),
+		End:         svc.now().Format(time.DateOnly),
+		Currency:    "USD",
+		InitialCash: 100000,
+		Targets: []*genportfolio.BacktestTarget{
+			{Symbol: "SPY", Weight: 0.6},
+			{Symbol: "AGG", Weight: 0.4},
+		},
+		Frequency: "monthly",
+		FeeRate:   0.001,
+	}
+
+	// Act
+	res, err := svc.RunBacktest(ctx, payload)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotEmpty(t, res.EquityCurve)
+	assert.Greater(t, res.Trades, 2, "monthly rebalances trade after the initial purchase")
+	assert.Greater(t, res.Fees, 0.0)
+	assert.InDelta(t, res.EquityCurve[len(res.EquityCurve)-1].Value, res.EndValue, 1e-6)
+	worst := 0.0
+	for _, p := range res.EquityCurve {
+		assert.GreaterOrEqual(t, p.Drawdown, 0.0)
+		worst = max(worst, p.Drawdown)
+	}
+	assert.InDelta(t, res.MaxDrawdown, worst, 1e-9)
+
+	payload.Targets[0].Symbol = "NOPE"
+	_, err = svc.RunBacktest(ctx, payload)
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioImportCsv(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	require.NoError(t, svc.AddImportProfiles(statement.Profile{
+		Name:       "broker",
+		DateFormat: "01/02/2006",
+		Columns:    statement.Columns{Date: "Date", Action: "Action", Symbol: "Symbol", Quantity: "Quantity", Price: "Price", Amount: "Amount"},
+		Actions:    map[string]string{"Bought": "buy", "Sold": "sell", "Wire": statement.ActionTransfer},
+	}))
+	l := svc.portfolios["default"].ledger
+	txns := len(l.Transactions())
+	// Newest first, as brokers export it.
+	content := "Date,Action,Symbol,Quantity,Price,Amount\n" +
+		"01/06/2025,Sold,NEWCO,5,12,\n" +
+		"01/03/2025,Bought,NEWCO,10,10,\n" +
+		"01/02/2025,Wire,,,,1000\n" +
+		"01/02/2025,Journal,,,,1\n"
+	payload := &genportfolio.ImportCsvPayload{PortfolioID: "default", Profile: "broker", Account: "imported", DryRun: true, Content: content}
+
+	// Act
+	preview, err := svc.ImportCsv(ctx, payload)
+	require.NoError(t, err)
+	payload.DryRun = false
+	res, err := svc.ImportCsv(ctx, payload)
+	require.NoError(t, err)
+	again, err := svc.ImportCsv(ctx, payload)
+	require.NoError(t, err)
+
+	// Assert
+	for _, r := range []*genportfolio.ImportReport{preview, res} {
+		assert.Equal(t, 3, r.Imported)
+		assert.Equal(t, 1, r.Errors)
+		require.Len(t, r.Rows, 4)
+		assert.Equal(t, "imported", r.Rows[0].Status, "the sale posts after the earlier buy")
+		assert.Equal(t, 2, r.Rows[0].Line)
+		require.NotNil(t, r.Rows[3].Error)
+		assert.Contains(t, *r.Rows[3].Error, "unknown action")
+	}
+	assert.Nil(t, preview.Rows[0].Transaction.ID, "dry runs post nothing")
+	require.NotNil(t, res.Rows[0].Transaction.ID)
+	assert.Equal(t, "imported", res.Rows[2].Transaction.Account)
+	assert.Len(t, l.Transactions(), txns+3)
+	assert.InDelta(t, 5, l.Snapshot(svc.now()).Accounts["imported"].Quantities["NEWCO"], 1e-9)
+	assert.Equal(t, 0, again.Imported)
+	assert.Equal(t, 3, again.Duplicates)
+	assert.Equal(t, *res.Rows[1].Fingerprint, *again.Rows[1].Fingerprint)
+	assert.Len(t, l.Transactions(), txns+3)
+
+	payload.Profile = "missing"
+	_, err = svc.ImportCsv(ctx, payload)
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioImportOfx(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	txns := len(l.Transactions())
+	content := `OFXHEADER:100
+DATA:OFXSGML
+
+<OFX><INVSTMTMSGSRSV1><INVSTMTTRNRS><INVSTMTRS>
+<DTASOF>20250131<CURDEF>USD
+<INVACCTFROM><BROKERID>broker.example<ACCTID>U9</INVACCTFROM>
+<INVTRANLIST><DTSTART>20250101<DTEND>20250131
+<INVBANKTRAN><STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20250102<TRNAMT>5000<FITID>1</STMTTRN></INVBANKTRAN>
+<BUYSTOCK><INVBUY><INVTRAN><FITID>2<DTTRADE>20250103</INVTRAN><SECID><UNIQUEID>NEWCO<UNIQUEIDTYPE>TICKER</SECID>
+<UNITS>20<UNITPRICE>100<COMMISSION>1<TOTAL>-2001</INVBUY><BUYTYPE>BUY</BUYSTOCK>
+</INVTRANLIST>
+<INVPOSLIST><POSSTOCK><INVPOS><SECID><UNIQUEID>NEWCO<UNIQUEIDTYPE>TICKER</SECID><POSTYPE>LONG<UNITS>20<UNITPRICE>101</INVPOS></POSSTOCK></INVPOSLIST>
+<INVBAL><AVAILCASH>2999</INVBAL>
+</INVSTMTRS></INVSTMTTRNRS></INVSTMTMSGSRSV1></OFX>`
+	payload := &genportfolio.ImportOfxPayload{PortfolioID: "default", DryRun: true, Content: content}
+
+	// Act
+	preview, err := svc.ImportOfx(ctx, payload)
+	require.NoError(t, err)
+	payload.DryRun = false
+	res, err := svc.ImportOfx(ctx, payload)
+	require.NoError(t, err)
+	again, err := svc.ImportOfx(ctx, payload)
+	require.NoError(t, err)
+
+	// Assert
+	for _, r := range []*genportfolio.ImportReport{preview, res, again} {
+		require.Len(t, r.Reconciliation, 1)
+		rec := r.Reconciliation[0]
+		assert.Equal(t, "U9", rec.Account, "the statement account number is the default account")
+		assert.Equal(t, "2025-01-31", rec.AsOf)
+		assert.True(t, rec.Matched)
+		require.Len(t, rec.Positions, 1)
+		assert.InDelta(t, 20, rec.Positions[0].LedgerQuantity, 1e-9)
+		assert.InDelta(t, 2999, rec.LedgerCash, 1e-9)
+	}
+	assert.Equal(t, 2, res.Imported)
+	assert.Equal(t, "ofx", res.Format)
+	assert.Equal(t, 2, again.Duplicates)
+	assert.Len(t, l.Transactions(), txns+2)
+
+	payload.Account = "other"
+	other, err := svc.ImportOfx(ctx, payload)
+	require.NoError(t, err)
+	assert.Equal(t, 2, other.Imported, "the same statement books anew to another account")
+
+	payload.Account, payload.DryRun = "U9", true
+	payload.Content = strings.Replace(content, "<UNITS>20<UNITPRICE>101", "<UNITS>25<UNITPRICE>101", 1)
+	broken, err := svc.ImportOfx(ctx, payload)
+	require.NoError(t, err)
+	assert.False(t, broken.Reconciliation[0].Matched)
+	assert.InDelta(t, 5, broken.Reconciliation[0].Positions[0].Difference, 1e-9)
+
+	payload.Content = "not a statement"
+	_, err = svc.ImportOfx(ctx, payload)
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioExportJournal(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	txns := svc.portfolios["default"].ledger.Transactions()
+
+	for _, format := range []string{"beancount", "hledger"} {
+		// Act
+		res, err := svc.ExportJournal(ctx, &genportfolio.ExportJournalPayload{PortfolioID: "default", Format: format})
+		require.NoError(t, err)
+		back, err := svc.ImportJournal(ctx, &genportfolio.ImportJournalPayload{PortfolioID: "default", Root: journal.DefaultRoot, DryRun: true, Content: res.Content})
+		require.NoError(t, err)
+
+		// Assert
+		assert.Contains(t, res.ContentDisposition, `filename="default.`)
+		assert.Contains(t, res.Content, "Portfolio default")
+		assert.Contains(t, res.Content, "AAPL")
+		assert.Equal(t, "journal", back.Format)
+		assert.Equal(t, len(txns), back.Duplicates, "%s: an exported journal reads back as the ledger", format)
+		assert.Zero(t, back.Imported+back.Errors)
+	}
+	beancount, err := svc.ExportJournal(ctx, &genportfolio.ExportJournalPayload{PortfolioID: "default", Format: "beancount"})
+	require.NoError(t, err)
+	assert.Regexp(t, `(?m)^\d{4}-\d{2}-\d{2} price AAPL [\d.]+ USD$`, beancount.Content)
+
+	_, err = svc.ExportJournal(ctx, &genportfolio.ExportJournalPayload{PortfolioID: "default", Format: "ledger"})
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioImportJournal(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	txns := len(l.Transactions())
+	content := `2025-01-02 * "Funding"
+  Assets:Broker:Cash  5000.00 USD
+  Assets:Bank
+
+2025-01-03 * "Buy"
+  Assets:Broker:NEWCO  20 NEWCO {100.05 USD}
+  Assets:Broker:Cash  -2001.00 USD
+
+2025-01-04 * "Groceries"
+  Expenses:Food  20.00 USD
+  Assets:Bank
+`
+	payload := &genportfolio.ImportJournalPayload{PortfolioID: "default", Account: "broker", Root: "Assets:Broker", Content: content}
+
+	// Act
+	res, err := svc.ImportJournal(ctx, payload)
+	require.NoError(t, err)
+	again, err := svc.ImportJournal(ctx, payload)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 2, res.Imported)
+	assert.Equal(t, 1, res.Skipped)
+	assert.Equal(t, 2, again.Duplicates)
+	assert.Len(t, l.Transactions(), txns+2)
+	assert.InDelta(t, 20, l.Snapshot(svc.now()).Accounts["broker"].Quantities["NEWCO"], 1e-9)
+
+	payload.Content = "no journal here"
+	_, err = svc.ImportJournal(ctx, payload)
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestPortfolioArchiveRoundTrip(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	src := svc.portfolios["default"]
+	svc.setMarginAccount(src, margin.Terms{Account: "margin", LoanRate: 0.08}.WithDefaults())
+	require.NoError(t, svc.AddAccounts(accounts.Account{ID: "ira", Type: accounts.IRA, Custodian: "Vanguard"}))
+	exported, err := svc.ExportArchive(ctx, &genportfolio.ExportArchivePayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	copyID := "copy"
+
+	// Act
+	res, err := svc.ImportArchive(ctx, &genportfolio.ImportArchivePayload{PortfolioID: &copyID, Content: exported.Content})
+	require.NoError(t, err)
+	_, existsErr := svc.ImportArchive(ctx, &genportfolio.ImportArchivePayload{Content: exported.Content})
+	replaced, replaceErr := svc.ImportArchive(ctx, &genportfolio.ImportArchivePayload{Replace: true, Content: exported.Content})
+	_, garbageErr := svc.ImportArchive(ctx, &genportfolio.ImportArchivePayload{Content: strings.Replace(exported.Content, `"tx-000001"`, `"tx-999999"`, 1)})
+
+	// Assert
+	assert.Equal(t, `attachment; filename="default.portfolio.json"`, exported.ContentDisposition)
+	assert.Equal(t, "copy", res.PortfolioID)
+	assert.False(t, res.Replaced)
+	assert.False(t, res.Migrated)
+	assert.Equal(t, 1, res.MarginAccounts)
+	assert.Equal(t, 1, res.Accounts)
+	assert.Equal(t, len(src.ledger.Transactions()), res.Transactions)
+	dst := svc.portfolios["copy"]
+	assert.Equal(t, src.ledger.Transactions(), dst.ledger.Transactions())
+	assert.Equal(t, src.targets, dst.targets)
+	assert.Equal(t, src.benchmark, dst.benchmark)
+	assert.Equal(t, src.paper, dst.paper)
+	assert.Equal(t, src.accounts, dst.accounts)
+	assert.Equal(t, src.margin["margin"].terms, dst.margin["margin"].terms)
+	assert.True(t, dst.ledger.IsMarginAccount("margin"))
+	want, err := svc.GetReturns(ctx, &genportfolio.GetReturnsPayload{PortfolioID: "default", Period: "inception"})
+	require.NoError(t, err)
+	got, err := svc.GetReturns(ctx, &genportfolio.GetReturnsPayload{PortfolioID: "copy", Period: "inception"})
+	require.NoError(t, err)
+	assert.InDelta(t, want.EndValue, got.EndValue, 1e-6)
+
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, existsErr, &badRequest)
+	require.NoError(t, replaceErr)
+	assert.True(t, replaced.Replaced)
+	require.ErrorAs(t, garbageErr, &badRequest)
+	assert.Contains(t, string(badRequest), "checksum")
+}
+
+func TestArchivePaperOrdersAndBreaks(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	src := svc.portfolios["default"]
+	now := marketdata.Day(svc.now())
+	src.paper = true
+	src.orders = []*orders.Order{{
+		ID: "ord-000007", Symbol: "AAPL", Side: orders.Buy, Type: orders.Limit, Quantity: 5, LimitPrice: 1,
+		TimeInForce: orders.GTC, Created: now, Status: orders.Open, Updated: now,
+	}}
+	src.breaks = []*reconcile.Break{{
+		ID: "brk-000003", AsOf: now, Kind: reconcile.QuantityMismatch, Symbol: "AAPL", Statement: 11, Ledger: 10, Price: 150,
+		Adjustments: []ledger.Transaction{{Date: now, Type: ledger.TxBuy, Symbol: "AAPL", Quantity: 1, Price: 150}},
+		Status:      reconcile.Open, Detected: now, Updated: now,
+	}}
+	exported, err := svc.ExportArchive(ctx, &genportfolio.ExportArchivePayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	notPaper := svc.archivePortfolio(src)
+	notPaper.Paper = false
+	var buf bytes.Buffer
+	require.NoError(t, archive.Write(&buf, notPaper, now))
+	copyID, otherID := "copy", "other"
+
+	// Act
+	_, err = svc.ImportArchive(ctx, &genportfolio.ImportArchivePayload{PortfolioID: &copyID, Content: exported.Content})
+	require.NoError(t, err)
+	_, notPaperErr := svc.ImportArchive(ctx, &genportfolio.ImportArchivePayload{PortfolioID: &otherID, Content: buf.String()})
+
+	// Assert
+	dst := svc.portfolios["copy"]
+	assert.Equal(t, src.orders, dst.orders)
+	assert.Equal(t, src.breaks, dst.breaks)
+	assert.Equal(t, 7, svc.orderSeq, "new orders are numbered after the imported ones")
+	assert.Equal(t, 3, svc.breakSeq)
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, notPaperErr, &badRequest)
+	assert.NotContains(t, svc.portfolios, "other")
+}
+
+func TestConfiguredAccountsApplyToImports(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	plain, err := svc.ExportArchive(ctx, &genportfolio.ExportArchivePayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	require.NoError(t, svc.AddAccounts(accounts.Account{ID: "hsa", Type: accounts.HSA}))
+	require.NoError(t, svc.AddMarginAccounts(margin.Terms{Account: "margin", LoanRate: 0.08}))
+	custodian := "Fidelity"
+	_, err = svc.SetAccount(ctx, &genportfolio.SetAccountPayload{PortfolioID: "default", ID: "hsa", Type: "hsa", Custodian: &custodian})
+	require.NoError(t, err)
+	edited, err := svc.ExportArchive(ctx, &genportfolio.ExportArchivePayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	laterID, keptID := "later", "kept"
+
+	// Act
+	later, err := svc.ImportArchive(ctx, &genportfolio.ImportArchivePayload{PortfolioID: &laterID, Content: plain.Content})
+	require.NoError(t, err)
+	_, err = svc.ImportArchive(ctx, &genportfolio.ImportArchivePayload{PortfolioID: &keptID, Content: edited.Content})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 1, later.Accounts)
+	assert.Equal(t, 1, later.MarginAccounts)
+	pf := svc.portfolios["later"]
+	assert.Equal(t, accounts.TaxExempt, pf.accounts["hsa"].TaxTreatment)
+	assert.InDelta(t, 0.08, pf.margin["margin"].terms.LoanRate, 1e-12)
+	assert.True(t, pf.ledger.IsMarginAccount("margin"))
+	assert.Equal(t, "Fidelity", svc.portfolios["kept"].accounts["hsa"].Custodian, "archived accounts are kept")
+}
+
+func TestPortfolioReconcilePositions(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	asOf := svc.now().Format(time.DateOnly)
+	held := l.Snapshot(svc.now()).Accounts[""]
+	// The broker reports one more AAPL share, no AGG and the ledger cash.
+	var content strings.Builder
+	content.WriteString("symbol,quantity,price\n")
+	for symbol, qty := range held.Quantities {
+		switch symbol {
+		case "AAPL":
+			qty++
+		case "AGG":
+			continue
+		}
+		fmt.Fprintf(&content, "%s,%g,\n", symbol, qty)
+	}
+	fmt.Fprintf(&content, "CASH,%.2f,\n", held.Cash)
+	payload := &genportfolio.ReconcilePositionsPayload{PortfolioID: "default", Format: "csv", Profile: "generic", AsOf: &asOf, Content: content.String()}
+
+	// Act
+	res, err := svc.ReconcilePositions(ctx, payload)
+	require.NoError(t, err)
+	again, err := svc.ReconcilePositions(ctx, payload)
+	require.NoError(t, err)
+	aapl := res.Breaks[0]
+	applied, applyErr := svc.UpdateReconciliationBreak(ctx, &genportfolio.UpdateReconciliationBreakPayload{PortfolioID: "default", ID: aapl.ID, Status: "resolved", Apply: true})
+	agg := svc.portfolios["default"].breaks[1]
+	adjustments := agg.Adjustments
+	agg.Adjustments = append(append([]ledger.Transaction(nil), adjustments...), ledger.Transaction{Date: agg.AsOf, Type: ledger.TxSell, Symbol: "NOPE", Quantity: 1, Price: 1})
+	posted := len(l.Transactions())
+	_, partialErr := svc.UpdateReconciliationBreak(ctx, &genportfolio.UpdateReconciliationBreakPayload{PortfolioID: "default", ID: agg.ID, Status: "resolved", Apply: true})
+	unposted := len(l.Transactions())
+	agg.Adjustments = adjustments
+	_, ignoreErr := svc.UpdateReconciliationBreak(ctx, &genportfolio.UpdateReconciliationBreakPayload{PortfolioID: "default", ID: res.Breaks[1].ID, Status: "ignored"})
+	_, missingErr := svc.UpdateReconciliationBreak(ctx, &genportfolio.UpdateReconciliationBreakPayload{PortfolioID: "default", ID: "brk-999999", Status: "ignored"})
+	status := "ignored"
+	ignored, err := svc.ListReconciliationBreaks(ctx, &genportfolio.ListReconciliationBreaksPayload{PortfolioID: "default", Status: &status})
+	require.NoError(t, err)
+	final, err := svc.ReconcilePositions(ctx, payload)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 2, res.Opened)
+	require.Len(t, res.Breaks, 2)
+	assert.Equal(t, "AAPL", *aapl.Symbol)
+	assert.Equal(t, "quantity_mismatch", aapl.Kind)
+	assert.Equal(t, "missing_in_statement", res.Breaks[1].Kind)
+	assert.Len(t, aapl.Adjustments, 2)
+	assert.Equal(t, 2, again.Updated)
+	assert.Equal(t, aapl.ID, again.Breaks[0].ID)
+
+	require.NoError(t, applyErr)
+	assert.Equal(t, "resolved", applied.Status)
+	assert.Len(t, applied.Posted, 2)
+	assert.InDelta(t, held.Quantities["AAPL"]+1, l.Snapshot(svc.now()).Accounts[""].Quantities["AAPL"], 1e-9)
+	assert.InDelta(t, held.Cash, l.Snapshot(svc.now()).Accounts[""].Cash, 0.005)
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, partialErr, &badRequest)
+	assert.Equal(t, posted, unposted, "a refused adjustment posts none of the break")
+	require.NoError(t, ignoreErr, "the break stays open")
+	var notFound genportfolio.NotFound
+	assert.ErrorAs(t, missingErr, &notFound)
+	assert.Len(t, ignored, 1)
+
+	// Only the ignored AGG break remains.
+	assert.Equal(t, 0, final.Opened)
+	assert.Equal(t, 1, final.Updated)
+	assert.Len(t, final.Breaks, 1)
+}
+
+func TestPortfolioTaxReport(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	now := marketdata.Day(svc.now())
+	_, err := l.Post(ledger.Transaction{Date: now, Type: ledger.TxSell, Symbol: "AAPL", Quantity: 2, Price: 300})
+	require.NoError(t, err)
+
+	// Act
+	res, err := svc.GetTaxReport(ctx, &genportfolio.GetTaxReportPayload{PortfolioID: "default", Year: now.Year()})
+	require.NoError(t, err)
+	exported, exportErr := svc.ExportTaxReport(ctx, &genportfolio.ExportTaxReportPayload{PortfolioID: "default", Year: now.Year(), Format: "csv"})
+	asJSON, jsonErr := svc.ExportTaxReport(ctx, &genportfolio.ExportTaxReportPayload{PortfolioID: "default", Year: now.Year(), Format: "json"})
+	empty, emptyErr := svc.GetTaxReport(ctx, &genportfolio.GetTaxReportPayload{PortfolioID: "default", Year: 2000})
+	_, missingErr := svc.GetTaxReport(ctx, &genportfolio.GetTaxReportPayload{PortfolioID: "missing", Year: 2000})
+
+	// Assert
+	require.Len(t, res.Accounts, 1)
+	lots := res.Accounts[0].Lots
+	require.NotEmpty(t, lots)
+	quantity := 0.0
+	for _, lot := range lots {
+		assert.Equal(t, "AAPL", lot.Symbol)
+		assert.Equal(t, now.Format(time.DateOnly), lot.Disposed)
+		assert.InDelta(t, lot.Proceeds-lot.CostBasis, lot.Gain, 1e-9)
+		quantity += lot.Quantity
+	}
+	assert.InDelta(t, 2, quantity, 1e-9)
+	assert.InDelta(t, 600, res.Total.Proceeds, 1e-9)
+	assert.InDelta(t, res.ShortTerm.Gain+res.LongTerm.Gain, res.Total.Gain, 1e-9)
+
+	require.NoError(t, exportErr)
+	assert.Equal(t, fmt.Sprintf(`attachment; filename="default-gains-%d.csv"`, now.Year()), exported.ContentDisposition)
+	assert.True(t, strings.HasPrefix(exported.Content, "account,symbol,lot_id,sale_id,"))
+	assert.Equal(t, len(lots)+1, strings.Count(exported.Content, "\n"))
+	require.NoError(t, jsonErr)
+	var decoded struct {
+		Total struct {
+			Proceeds float64 `json:"proceeds"`
+		} `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(asJSON.Content), &decoded))
+	assert.InDelta(t, 600, decoded.Total.Proceeds, 1e-9)
+	require.NoError(t, emptyErr)
+	assert.Empty(t, empty.Accounts)
+	var notFound genportfolio.NotFound
+	assert.ErrorAs(t, missingErr, &notFound)
+}
+
+func TestPortfolioWashSales(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	require.NoError(t, svc.SetIdenticalSecurities([][]string{{"VOO", "IVV"}}))
+	l := svc.portfolios["default"].ledger
+	now := marketdata.Day(svc.now())
+	for _, tx := range []ledger.Transaction{
+		{Date: now.AddDate(0, 0, -90), Type: ledger.TxBuy, Symbol: "VOO", Quantity: 10, Price: 100},
+		{Date: now.AddDate(0, 0, -60), Type: ledger.TxSell, Symbol: "VOO", Quantity: 10, Price: 80},
+		{Date: now.AddDate(0, 0, -50), Type: ledger.TxBuy, Account: "ira", Symbol: "IVV", Quantity: 5, Price: 85},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	ira := "ira"
+	year := now.AddDate(0, 0, -60).Year()
+
+	// Act
+	lots, err := svc.ListLots(ctx, &genportfolio.ListLotsPayload{PortfolioID: "default", Account: &ira})
+	require.NoError(t, err)
+	report, reportErr := svc.GetTaxReport(ctx, &genportfolio.GetTaxReportPayload{PortfolioID: "default", Year: year})
+	groupsErr := svc.SetIdenticalSecurities([][]string{{"VOO"}})
+
+	// Assert
+	require.Len(t, lots, 1)
+	assert.Equal(t, "IVV", lots[0].Symbol)
+	assert.InDelta(t, 100, lots[0].BasisAdjustment, 1e-9)
+	assert.InDelta(t, 5*85+100, lots[0].CostBasis, 1e-9)
+	assert.Equal(t, now.AddDate(0, 0, -80).Format(time.DateOnly), lots[0].HoldingSince)
+	assert.Nil(t, lots[0].Price)
+
+	require.NoError(t, reportErr)
+	require.Len(t, report.WashSales, 1)
+	assert.Equal(t, "ira", report.WashSales[0].ReplacementAccount)
+	assert.InDelta(t, 100, report.WashSales[0].Disallowed, 1e-9)
+	var voo *genportfolio.TaxLotGain
+	for _, acct := range report.Accounts {
+		for _, g := range acct.Lots {
+			if g.Symbol == "VOO" {
+				voo = g
+			}
+		}
+	}
+	require.NotNil(t, voo)
+	assert.InDelta(t, 100, voo.WashSaleAdjustment, 1e-9)
+	assert.InDelta(t, -100, voo.Gain, 1e-9)
+	assert.Error(t, groupsErr)
+}
+
+func TestPortfolioTaxLossHarvests(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	require.NoError(t, svc.SetIdenticalSecurities([][]string{{"VOO", "IVV"}}))
+	require.NoError(t, svc.SetHarvestReplacements(map[string][]string{"voo": {"ivv", "schb"}}))
+	now := marketdata.Day(svc.now())
+	svc.market.Put("VOO", marketdata.Bar{Date: now, Close: 80})
+	svc.market.Put("SCHB", marketdata.Bar{Date: now, Close: 50})
+	_, err := svc.portfolios["default"].ledger.Post(ledger.Transaction{
+		Date: now.AddDate(0, 0, -200), Type: ledger.TxBuy, Account: "taxable", Symbol: "VOO", Quantity: 10, Price: 100,
+	})
+	require.NoError(t, err)
+	account := "taxable"
+
+	// Act
+	res, err := svc.FindTaxLossHarvests(ctx, &genportfolio.FindTaxLossHarvestsPayload{
+		PortfolioID:   "default",
+		Account:       &account,
+		MinLoss:       100,
+		ShortTermRate: 0.3,
+		LongTermRate:  0.15,
+	})
+	invalidErr := svc.SetHarvestReplacements(map[string][]string{"VOO": {"voo"}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, res.Opportunities, 1)
+	o := res.Opportunities[0]
+	assert.Equal(t, "VOO", o.Symbol)
+	assert.InDelta(t, 200, o.Loss, 1e-9)
+	assert.InDelta(t, 60, o.EstimatedSavings, 1e-9)
+	require.NotNil(t, o.Replacement)
+	assert.Equal(t, "SCHB", *o.Replacement)
+	require.NotNil(t, o.ReplacementQuantity)
+	assert.InDelta(t, 16, *o.ReplacementQuantity, 1e-9)
+	assert.Empty(t, res.Skipped)
+	assert.InDelta(t, 60, res.EstimatedSavings, 1e-9)
+	assert.Error(t, invalidErr)
+}
+
+func TestPortfolioAccounts(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	svc := newTestService()
+	l := svc.portfolios["default"].ledger
+	now := marketdata.Day(svc.now())
+	bought, err := svc.prices.Close("AGG", now.AddDate(-1, 0, 0))
+	require.NoError(t, err)
+	sold, err := svc.prices.Close("AGG", now.AddDate(0, 0, -10))
+	require.NoError(t, err)
+	for _, tx := range []ledger.Transaction{
+		{Date: now.AddDate(-1, 0, 0), Type: ledger.TxDeposit, Account: "ira", Amount: 10000},
+		{Date: now.AddDate(-1, 0, 0), Type: ledger.TxBuy, Account: "ira", Symbol: "AGG", Quantity: 20, Price: bought},
+		{Date: now.AddDate(0, 0, -10), Type: ledger.TxSell, Account: "ira", Symbol: "AGG", Quantity: 5, Price: sold},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	custodian, ira, unknown, cad := "Vanguard", "ira", "savings", "CAD"
+	price, err := svc.prices.Close("AGG", now)
+	require.NoError(t, err)
+
+	// Act
+	set, err := svc.SetAccount(ctx, &genportfolio.SetAccountPayload{PortfolioID: "default", ID: "ira", Type: "ira", Custodian: &custodian})
+	require.NoError(t, err)
+	_, err = svc.SetAccount(ctx, &genportfolio.SetAccountPayload{PortfolioID: "default", ID: "hsa", Type: "hsa"})
+	require.NoError(t, err)
+	list, err := svc.ListAccounts(ctx, &genportfolio.ListAccountsPayload{PortfolioID: "default"})
+	require.NoError(t, err)
+	summary, err := svc.GetPortfolioSummary(ctx, &genportfolio.GetPortfolioSummaryPayload{PortfolioID: "default", Account: &ira})
+	require.NoError(t, err)
+	alloc, err := svc.GetAllocation(ctx, &genportfolio.GetAllocationPayload{PortfolioID: "default", Dimension: "asset_class", Account: &ira})
+	require.NoError(t, err)
+	returns, err := svc.GetReturns(ctx, &genportfolio.GetReturnsPayload{PortfolioID: "default", Period: "inception", Account: &ira})
+	require.NoError(t, err)
+	report, err := svc.GetTaxReport(ctx, &genportfolio.GetTaxReportPayload{PortfolioID: "default", Year: now.AddDate(0, 0, -10).Year()})
+	require.NoError(t, err)
+	_, unknownErr := svc.GetAllocation(ctx, &genportfolio.GetAllocationPayload{PortfolioID: "default", Dimension: "asset_class", Account: &unknown})
+	_, usedErr := svc.RemoveAccount(ctx, &genportfolio.RemoveAccountPayload{PortfolioID: "default", ID: "ira"})
+	removed, removeErr := svc.RemoveAccount(ctx, &genportfolio.RemoveAccountPayload{PortfolioID: "default", ID: "hsa"})
+	_, invalidErr := svc.SetAccount(ctx, &genportfolio.SetAccountPayload{PortfolioID: "default", ID: "cad", Type: "taxable", Currency: &unknown})
+	_, currencyErr := svc.SetAccount(ctx, &genportfolio.SetAccountPayload{PortfolioID: "default", ID: "cad", Type: "taxable", Currency: &cad})
+	configErr := svc.AddAccounts(accounts.Account{ID: "cad", Currency: cad})
+
+	// Assert
+	holdingsValue := 15 * price
+	cash := 10000 - 20*bought + 5*sold
+	assert.Equal(t, "tax_deferred", set.TaxTreatment)
+	assert.Equal(t, "USD", set.Currency)
+	assert.InDelta(t, holdingsValue, set.HoldingsValue, 1e-6)
+	assert.InDelta(t, cash, set.Cash, 1e-6)
+
+	require.Len(t, list, 3)
+	assert.Equal(t, "", list[0].ID)
+	assert.False(t, list[0].Registered)
+	assert.Equal(t, "taxable", list[0].TaxTreatment)
+	assert.Equal(t, "hsa", list[1].ID)
+	assert.Equal(t, "tax_exempt", list[1].TaxTreatment)
+	assert.Zero(t, list[1].TotalValue)
+	assert.Equal(t, "ira", list[2].ID)
+	assert.True(t, list[2].Registered)
+	assert.Equal(t, &custodian, list[2].Custodian)
+	assert.InDelta(t, cash+holdingsValue, list[2].TotalValue, 1e-6)
+
+	assert.InDelta(t, cash+holdingsValue, summary.Balance, 1e-6)
+	assert.Equal(t, &ira, alloc.Account)
+	assert.InDelta(t, cash+holdingsValue, alloc.TotalValue, 1e-6)
+	assert.Equal(t, &ira, returns.Account)
+	assert.Equal(t, now.AddDate(-1, 0, 0).Format(time.DateOnly), returns.Start)
+	assert.InDelta(t, 10000, returns.NetContributions, 1e-6)
+	assert.InDelta(t, cash+holdingsValue, returns.EndValue, 1e-6)
+	for _, acct := range report.Accounts {
+		assert.NotEqual(t, "ira", acct.Account, "gains in tax-deferred accounts are not reported")
+	}
+
+	var notFound genportfolio.NotFound
+	var badRequest genportfolio.BadRequest
+	assert.ErrorAs(t, unknownErr, &notFound)
+	assert.ErrorAs(t, usedErr, &badRequest)
+	require.NoError(t, removeErr)
+	assert.Equal(t, "hsa", removed.ID)
+	assert.ErrorAs(t, invalidErr, &badRequest)
+	assert.ErrorAs(t, currencyErr, &badRequest, "balances are kept in the portfolio currency")
+	assert.ErrorIs(t, configErr, accounts.ErrInvalidAccount)
 }
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/holdings"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/risk"
+)
+
+// AddStressScenarios registers custom stress scenarios. A scenario with the
+// name of an existing one replaces it.
+func (s *PortfolioService) AddStressScenarios(scenarios ...risk.Scenario) error {
+	for _, sc := range scenarios {
+		if err := sc.Validate(); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sc := range scenarios {
+		s.scenarios[sc.Name] = sc.Normalized()
+	}
+	return nil
+}
+
+// ListStressScenarios returns the built-in and configured stress scenarios.
+func (s *PortfolioService) ListStressScenarios(ctx context.Context) ([]*genportfolio.StressScenario, error) {
+	s.logger.DebugContext(ctx, "portfolio.listStressScenarios")
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]*genportfolio.StressScenario, 0, len(s.scenarios))
+	for _, sc := range s.scenarios {
+		res = append(res, toStressScenario(sc))
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res, nil
+}
+
+// RunStressTest applies a named scenario to current holdings.
+func (s *PortfolioService) RunStressTest(ctx context.Context, p *genportfolio.RunStressTestPayload) (*genportfolio.StressTestResult, error) {
+	s.logger.DebugContext(ctx, "portfolio.runStressTest", "portfolio_id", p.PortfolioID, "scenario", p.Scenario)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	sc, ok := s.scenarios[p.Scenario]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, genportfolio.NotFound("stress scenario " + p.Scenario + " not found")
+	}
+
+	asOf := s.now()
+	v, err := holdings.Value(pf.ledger, s.prices, asOf)
+	if err != nil {
+		return nil, err
+	}
+	var positions []risk.Position
+	for sym, value := range v.BySymbol() {
+		pos := risk.Position{Symbol: sym, Value: value}
+		if inst, ok := s.instruments.Get(sym); ok {
+			pos.AssetClass, pos.Currency, pos.Duration = inst.AssetClass, inst.Currency, inst.Duration
+			if pos.Duration == 0 && inst.IsBond() {
+				pos.Duration = s.bondDuration(inst, asOf)
+			}
+		}
+		positions = append(positions, pos)
+	}
+	sort.Slice(positions, func(i, j int) bool { return positions[i].Symbol < positions[j].Symbol })
+
+	res, err := risk.Stress(sc, positions, v.TotalCash(), v.Currency, s.prices)
+	if err != nil {
+		return nil, genportfolio.BadRequest(err.Error())
+	}
+
+	out := &genportfolio.StressTestResult{
+		PortfolioID:       pf.id,
+		AsOf:              asOf.Format(time.DateOnly),
+		Scenario:          toStressScenario(sc),
+		Currency:          v.Currency,
+		CurrentValue:      res.CurrentValue,
+		ProjectedValue:    res.ProjectedValue,
+		Loss:              res.Loss,
+		WorstContributors: []*genportfolio.StressImpact{},
+		Warnings:          append([]string{}, res.Warnings...),
+	}
+	if res.CurrentValue != 0 {
+		out.LossPercent = res.Loss / res.CurrentValue
+	}
+	for i, imp := range res.Impacts {
+		if i == p.Top {
+			break
+		}
+		out.WorstContributors = append(out.WorstContributors, &genportfolio.StressImpact{
+			Symbol:         imp.Symbol,
+			AssetClass:     imp.AssetClass,
+			Value:          imp.Value,
+			ProjectedValue: imp.ProjectedValue,
+			Pnl:            imp.PnL,
+			Shock:          imp.Shock,
+		})
+	}
+	return out, nil
+}
+
+func toStressScenario(sc risk.Scenario) *genportfolio.StressScenario {
+	res := &genportfolio.StressScenario{
+		Name:         sc.Name,
+		Description:  sc.Description,
+		AssetClasses: sc.AssetClasses,
+		Symbols:      sc.Symbols,
+		RateShift:    sc.RateShift,
+		Currencies:   sc.Currencies,
+	}
+	if sc.ReplayStart != "" {
+		start, end := sc.ReplayStart, sc.ReplayEnd
+		res.ReplayStart, res.ReplayEnd = &start, &end
+	}
+	return res
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	genportfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/goa_gen/gen/portfolio"
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/tax"
+)
+
+// SetIdenticalSecurities sets the groups of substantially identical securities
+// the wash-sale rule matches, besides the same symbol.
+func (s *PortfolioService) SetIdenticalSecurities(groups [][]string) error {
+	e, err := tax.NewEquivalents(groups)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.equivalents = e
+	return nil
+}
+
+// GetTaxReport reports the capital gains realized in a year.
+func (s *PortfolioService) GetTaxReport(ctx context.Context, p *genportfolio.GetTaxReportPayload) (*genportfolio.TaxReport, error) {
+	s.logger.DebugContext(ctx, "portfolio.getTaxReport", "portfolio_id", p.PortfolioID, "year", p.Year)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	r := s.taxReport(pf, p.Year)
+	res := &genportfolio.TaxReport{
+		PortfolioID: pf.id,
+		Year:        r.Year,
+		Currency:    r.Currency,
+		Accounts:    make([]*genportfolio.TaxAccount, len(r.Accounts)),
+		ShortTerm:   toTaxTotals(r.ShortTerm),
+		LongTerm:    toTaxTotals(r.LongTerm),
+		Total:       toTaxTotals(r.Total),
+		WashSales:   make([]*genportfolio.WashSale, len(r.WashSales)),
+	}
+	for i, acct := range r.Accounts {
+		a := &genportfolio.TaxAccount{
+			Account:   acct.Account,
+			Lots:      make([]*genportfolio.TaxLotGain, len(acct.Gains)),
+			ShortTerm: toTaxTotals(acct.ShortTerm),
+			LongTerm:  toTaxTotals(acct.LongTerm),
+			Total:     toTaxTotals(acct.Total),
+		}
+		for j, g := range acct.Gains {
+			a.Lots[j] = &genportfolio.TaxLotGain{
+				Account:            g.Account,
+				Symbol:             g.Symbol,
+				LotID:              g.LotID,
+				SaleID:             g.SaleID,
+				Acquired:           g.Acquired.Format(time.DateOnly),
+				Disposed:           g.Disposed.Format(time.DateOnly),
+				Quantity:           g.Quantity,
+				Proceeds:           g.Proceeds,
+				CostBasis:          g.CostBasis,
+				BasisAdjustment:    g.BasisAdjustment,
+				WashSaleAdjustment: g.WashSale,
+				Gain:               g.Amount(),
+				Term:               string(g.Term),
+				ShortSale:          g.Short,
+			}
+		}
+		res.Accounts[i] = a
+	}
+	for i, w := range r.WashSales {
+		res.WashSales[i] = &genportfolio.WashSale{
+			SaleID:              w.SaleID,
+			LotID:               w.LotID,
+			Account:             w.Account,
+			Symbol:              w.Symbol,
+			Disposed:            w.Disposed.Format(time.DateOnly),
+			Quantity:            w.Quantity,
+			Disallowed:          w.Disallowed,
+			ReplacementLotID:    w.ReplacementLotID,
+			ReplacementAccount:  w.ReplacementAccount,
+			ReplacementSymbol:   w.ReplacementSymbol,
+			ReplacementAcquired: w.ReplacementAcquired.Format(time.DateOnly),
+			Permanent:           w.Permanent,
+		}
+	}
+	return res, nil
+}
+
+// ExportTaxReport exports the capital gains report of a year as CSV or JSON.
+func (s *PortfolioService) ExportTaxReport(ctx context.Context, p *genportfolio.ExportTaxReportPayload) (*genportfolio.ExportFile, error) {
+	s.logger.DebugContext(ctx, "portfolio.exportTaxReport", "portfolio_id", p.PortfolioID, "year", p.Year)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	r := s.taxReport(pf, p.Year)
+	var buf bytes.Buffer
+	if p.Format == "json" {
+		err = tax.WriteJSON(&buf, pf.id, r)
+	} else {
+		err = tax.WriteCSV(&buf, r)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &genportfolio.ExportFile{
+		ContentDisposition: fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-gains-%d.%s", pf.id, p.Year, p.Format)),
+		Content:            buf.String(),
+	}, nil
+}
+
+// ListLots lists the open lots of a portfolio adjusted for wash sales.
+func (s *PortfolioService) ListLots(ctx context.Context, p *genportfolio.ListLotsPayload) ([]*genportfolio.TaxLot, error) {
+	s.logger.DebugContext(ctx, "portfolio.listLots", "portfolio_id", p.PortfolioID)
+	pf, err := s.portfolio(p.PortfolioID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	lots := s.adjustedLots(pf, now).Lots
+	sort.SliceStable(lots, func(i, j int) bool { return lots[i].Acquired.Before(lots[j].Acquired) })
+	res := []*genportfolio.TaxLot{}
+	for _, lot := range lots {
+		if (p.Account != nil && *p.Account != lot.Account) || (p.Symbol != nil && *p.Symbol != lot.Symbol) {
+			continue
+		}
+		l := &genportfolio.TaxLot{
+			ID:              lot.ID,
+			Account:         lot.Account,
+			Symbol:          lot.Symbol,
+			Acquired:        lot.Acquired.Format(time.DateOnly),
+			HoldingSince:    lot.HoldingSince.Format(time.DateOnly),
+			Quantity:        lot.Quantity,
+			CostBasis:       lot.CostBasis,
+			BasisAdjustment: lot.BasisAdjustment,
+			UnitCost:        lot.UnitCost(),
+			Term:            string(tax.ShortTerm),
+		}
+		if lot.LongTerm(now) {
+			l.Term = string(tax.LongTerm)
+		}
+		if px, err := s.prices.Close(lot.Symbol, now); err == nil {
+			value := px * lot.Quantity
+			gain := value - lot.CostBasis
+			l.Price, l.MarketValue, l.UnrealizedGain = &px, &value, &gain
+		}
+		res = append(res, l)
+	}
+	return res, nil
+}
+
+// adjustedLots returns the lots and gains of a portfolio through asOf,
+// adjusted for wash sales. Gains of tax-advantaged accounts are left out.
+func (s *PortfolioService) adjustedLots(pf *portfolioState, asOf time.Time) tax.Adjusted {
+	lots, disposals := pf.ledger.Lots(asOf)
+	s.mu.RLock()
+	equivalents := s.equivalents
+	s.mu.RUnlock()
+	return tax.AdjustWashSales(lots, disposals, equivalents, s.sheltered(pf))
+}
+
+// taxReport builds the capital gains report of a year. Purchases up to 30
+// days into the next year can still make a December loss a wash sale.
+func (s *PortfolioService) taxReport(pf *portfolioState, year int) tax.Report {
+	end := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	return tax.Build(s.adjustedLots(pf, end.Add(tax.WashSaleWindow)), year, pf.ledger.Currency())
+}
+
+func toTaxTotals(t tax.Totals) *genportfolio.TaxTotals {
+	return &genportfolio.TaxTotals{
+		Proceeds:           t.Proceeds,
+		CostBasis:          t.CostBasis,
+		WashSaleAdjustment: t.WashSale,
+		Gain:               t.Gain,
+	}
+}
@@ -0,0 +1,390 @@
+package statement
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+)
+
+// ErrInvalidProfile is returned for mapping profiles that cannot be used.
+var ErrInvalidProfile = errors.New("statement: invalid mapping profile")
+
+// Actions that a profile may translate broker codes to, besides the ledger
+// transaction types.
+const (
+	// ActionTrade is a buy for positive quantities and a sell for negative
+	// ones.
+	ActionTrade = "trade"
+	// ActionTransfer is a deposit for positive amounts and a withdrawal for
+	// negative ones.
+	ActionTransfer = "transfer"
+	// ActionSkip ignores the row, e.g. for journal entries.
+	ActionSkip = "skip"
+)
+
+// Columns names the header of each field in the CSV file. Only the date and
+// action columns are required; other fields may be left out of the profile or
+// the file.
+type Columns struct {
+	Date        string `mapstructure:"date"`
+	Action      string `mapstructure:"action"`
+	Account     string `mapstructure:"account"`
+	Symbol      string `mapstructure:"symbol"`
+	Quantity    string `mapstructure:"quantity"`
+	Price       string `mapstructure:"price"`
+	Amount      string `mapstructure:"amount"`
+	Fee         string `mapstructure:"fee"`
+	Description string `mapstructure:"description"`
+}
+
+// fields are the statement fields that a profile maps to columns.
+var fields = []string{"date", "action", "account", "symbol", "quantity", "price", "amount", "fee", "description"}
+
+func (c Columns) byField() map[string]string {
+	return map[string]string{
+		"date":        c.Date,
+		"action":      c.Action,
+		"account":     c.Account,
+		"symbol":      c.Symbol,
+		"quantity":    c.Quantity,
+		"price":       c.Price,
+		"amount":      c.Amount,
+		"fee":         c.Fee,
+		"description": c.Description,
+	}
+}
+
+// Profile maps the CSV statement of a broker to ledger transactions.
+type Profile struct {
+	Name string `mapstructure:"name"`
+	// Delimiter separates fields; a comma by default.
+	Delimiter string `mapstructure:"delimiter"`
+	// SkipRows is the number of records before the header, e.g. an account
+	// preamble.
+	SkipRows int `mapstructure:"skip_rows"`
+	// DateFormat is a Go time layout; 2006-01-02 by default.
+	DateFormat string `mapstructure:"date_format"`
+	// Decimal and Thousands are the number separators; "." and "," by
+	// default.
+	Decimal   string  `mapstructure:"decimal"`
+	Thousands string  `mapstructure:"thousands"`
+	Columns   Columns `mapstructure:"columns"`
+	// Actions translates broker action codes, case-insensitively, to a ledger
+	// transaction type, trade, transfer or skip. Codes that are not listed
+	// must be ledger transaction types.
+	Actions map[string]string `mapstructure:"actions"`
+	// InvertQuantity and InvertAmount flip the sign convention of trades and
+	// transfers for brokers that show sales as positive quantities or
+	// withdrawals as positive amounts.
+	InvertQuantity bool `mapstructure:"invert_quantity"`
+	InvertAmount   bool `mapstructure:"invert_amount"`
+}
+
+// Generic is the built-in profile for files that use the ledger field names
+// as columns and transaction types as actions.
+var Generic = Profile{
+	Name: "generic",
+	Columns: Columns{
+		Date:        "date",
+		Action:      "type",
+		Account:     "account",
+		Symbol:      "symbol",
+		Quantity:    "quantity",
+		Price:       "price",
+		Amount:      "amount",
+		Fee:         "fee",
+		Description: "note",
+	},
+}
+
+// WithDefaults fills the unset separators and date format.
+func (p Profile) WithDefaults() Profile {
+	if p.Delimiter == "" {
+		p.Delimiter = ","
+	}
+	if p.DateFormat == "" {
+		p.DateFormat = time.DateOnly
+	}
+	if p.Decimal == "" {
+		p.Decimal = "."
+	}
+	if p.Thousands == "" && p.Decimal != "," {
+		p.Thousands = ","
+	}
+	return p
+}
+
+// Validate checks the profile after defaults are applied.
+func (p Profile) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("%w: missing name", ErrInvalidProfile)
+	}
+	if utf8.RuneCountInString(p.Delimiter) != 1 {
+		return fmt.Errorf("%w: %s: delimiter must be a single character", ErrInvalidProfile, p.Name)
+	}
+	if p.Decimal == p.Thousands {
+		return fmt.Errorf("%w: %s: decimal and thousands separators must differ", ErrInvalidProfile, p.Name)
+	}
+	if p.Columns.Date == "" || p.Columns.Action == "" {
+		return fmt.Errorf("%w: %s: date and action columns are required", ErrInvalidProfile, p.Name)
+	}
+	if p.SkipRows < 0 {
+		return fmt.Errorf("%w: %s: skip_rows must not be negative", ErrInvalidProfile, p.Name)
+	}
+	for code, action := range p.Actions {
+		if !knownAction(action) {
+			return fmt.Errorf("%w: %s: action %q maps to unknown %q", ErrInvalidProfile, p.Name, code, action)
+		}
+	}
+	return nil
+}
+
+func knownAction(action string) bool {
+	switch action {
+	case ActionTrade, ActionTransfer, ActionSkip:
+		return true
+	}
+	return cashOrTrade(ledger.TxType(action))
+}
+
+// cashOrTrade reports whether typ can be imported from a statement.
+func cashOrTrade(typ ledger.TxType) bool {
+	switch typ {
+	case ledger.TxDeposit, ledger.TxWithdrawal, ledger.TxBuy, ledger.TxSell, ledger.TxDividend,
+		ledger.TxInterest, ledger.TxFee, ledger.TxMarginInterest, ledger.TxBorrowFee:
+		return true
+	}
+	return false
+}
+
+// ParseCSV reads a CSV statement with a profile. Rows that cannot be read are
+// returned with their error rather than failing the whole file; the error is
+// for unreadable files and missing columns.
+func ParseCSV(r io.Reader, p Profile) ([]Row, error) {
+	p = p.WithDefaults()
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	cr, index, err := p.open(r, "date", "action")
+	if err != nil || cr == nil {
+		return nil, err
+	}
+
+	actions := make(map[string]string, len(p.Actions))
+	for code, action := range p.Actions {
+		actions[strings.ToLower(strings.TrimSpace(code))] = action
+	}
+	var rows []Row
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			rows = append(rows, Row{Line: parseErr.StartLine, Status: StatusError, Err: err})
+			continue
+		}
+		field := func(name string) string {
+			if i, ok := index[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		line, _ := cr.FieldPos(0)
+		row := Row{Line: line}
+		skip, err := p.transaction(&row.Tx, actions, field)
+		switch {
+		case err != nil:
+			row.Status, row.Err = StatusError, err
+		case skip:
+			row.Status = StatusSkipped
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// open reads the preamble and header of a CSV file and returns the reader
+// positioned at the first row with the column index of each mapped field. It
+// returns a nil reader for an empty file.
+func (p Profile) open(r io.Reader, required ...string) (*csv.Reader, map[string]int, error) {
+	cr := csv.NewReader(r)
+	cr.Comma, _ = utf8.DecodeRuneInString(p.Delimiter)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	for range p.SkipRows {
+		if _, err := cr.Read(); err != nil {
+			return nil, nil, fmt.Errorf("skipping preamble: %w", err)
+		}
+	}
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	col := map[string]int{}
+	for i, h := range header {
+		col[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+	headers := p.Columns.byField()
+	index := map[string]int{}
+	for _, name := range fields {
+		if headers[name] == "" {
+			continue
+		}
+		if i, ok := col[strings.ToLower(headers[name])]; ok {
+			index[name] = i
+		}
+	}
+	for _, name := range required {
+		if _, ok := index[name]; !ok {
+			if headers[name] == "" {
+				return nil, nil, fmt.Errorf("profile %s maps no %s column", p.Name, name)
+			}
+			return nil, nil, fmt.Errorf("missing %q column", headers[name])
+		}
+	}
+	return cr, index, nil
+}
+
+// transaction fills tx from the fields of a row and reports rows to skip.
+func (p Profile) transaction(tx *ledger.Transaction, actions map[string]string, field func(name string) string) (bool, error) {
+	code := field("action")
+	action, ok := actions[strings.ToLower(code)]
+	if !ok {
+		action = strings.ToLower(code)
+		if !cashOrTrade(ledger.TxType(action)) {
+			return false, fmt.Errorf("unknown action %q", code)
+		}
+	}
+	if action == ActionSkip {
+		return true, nil
+	}
+
+	value := field("date")
+	date, err := time.Parse(p.DateFormat, value)
+	if err != nil {
+		return false, fmt.Errorf("bad date %q: want %s", value, p.DateFormat)
+	}
+	tx.Date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	tx.Account = field("account")
+	tx.Symbol = field("symbol")
+	tx.Note = field("description")
+	number := func(name string) (float64, bool, error) {
+		value := field(name)
+		if value == "" {
+			return 0, false, nil
+		}
+		v, err := p.parseNumber(value)
+		if err != nil {
+			return 0, false, fmt.Errorf("bad %s %q", name, value)
+		}
+		return v, true, nil
+	}
+	qty, _, err := number("quantity")
+	if err != nil {
+		return false, err
+	}
+	price, hasPrice, err := number("price")
+	if err != nil {
+		return false, err
+	}
+	amount, hasAmount, err := number("amount")
+	if err != nil {
+		return false, err
+	}
+	fee, _, err := number("fee")
+	if err != nil {
+		return false, err
+	}
+	if p.InvertQuantity {
+		qty = -qty
+	}
+	if p.InvertAmount {
+		amount = -amount
+	}
+
+	switch action {
+	case ActionTrade:
+		tx.Type = ledger.TxBuy
+		if qty < 0 {
+			tx.Type = ledger.TxSell
+		}
+	case ActionTransfer:
+		tx.Type = ledger.TxDeposit
+		if amount < 0 {
+			tx.Type = ledger.TxWithdrawal
+		}
+	default:
+		tx.Type = ledger.TxType(action)
+	}
+	tx.Fee = math.Abs(fee)
+	switch tx.Type {
+	case ledger.TxBuy, ledger.TxSell:
+		tx.Quantity = math.Abs(qty)
+		tx.Price = math.Abs(price)
+		if !hasPrice && hasAmount && tx.Quantity > 0 {
+			// The amount is the net cash of the trade.
+			if tx.Type == ledger.TxBuy {
+				tx.Price = (math.Abs(amount) - tx.Fee) / tx.Quantity
+			} else {
+				tx.Price = (math.Abs(amount) + tx.Fee) / tx.Quantity
+			}
+		} else if !hasPrice {
+			return false, fmt.Errorf("%s needs a price or an amount", tx.Type)
+		}
+	default:
+		tx.Amount = math.Abs(amount)
+		if tx.Type == ledger.TxFee && tx.Amount == 0 {
+			tx.Amount, tx.Fee = tx.Fee, 0
+		}
+	}
+	if err := tx.Validate(); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// parseNumber reads a number with the profile separators. Currency signs are
+// ignored and parentheses mean a negative number.
+func (p Profile) parseNumber(s string) (float64, error) {
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative, s = true, s[1:len(s)-1]
+	}
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case '$', '€', '£', '¥', ' ', ' ':
+			return -1
+		}
+		return r
+	}, s)
+	if p.Thousands != "" {
+		s = strings.ReplaceAll(s, p.Thousands, "")
+	}
+	if p.Decimal != "." {
+		s = strings.ReplaceAll(s, p.Decimal, ".")
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if negative {
+		v = -v
+	}
+	return v, nil
+}
@@ -0,0 +1,436 @@
+package statement
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+)
+
+// ErrInvalidOFX is returned for files that are not OFX statements.
+var ErrInvalidOFX = errors.New("statement: invalid OFX")
+
+// Statement is a bank or investment statement read from an OFX or QFX file.
+type Statement struct {
+	// Account is the account number at the institution.
+	Account  string
+	Currency string
+	// AsOf is the date of the positions and balance, or zero when the
+	// statement does not say.
+	AsOf time.Time
+	// Rows holds the transactions. Transactions the ledger cannot represent
+	// are skipped rows with the reason in Err.
+	Rows []Row
+	// Positions is nil when the statement has no position list.
+	Positions []Position
+	// Cash is the cash balance, if the statement has one.
+	Cash *float64
+}
+
+// Position is a holding reported by a statement; short positions have a
+// negative quantity.
+type Position struct {
+	Symbol   string
+	Quantity float64
+	Price    float64
+}
+
+// ParseOFX reads the statements of an OFX 1.x (SGML) or 2.x (XML) file,
+// including Quicken QFX files. Securities are identified by their ticker in the
+// security list when it has one.
+func ParseOFX(r io.Reader) ([]Statement, error) {
+	root, err := parseSGML(r)
+	if err != nil {
+		return nil, err
+	}
+	ofx := root.child("OFX")
+	if ofx == nil {
+		return nil, fmt.Errorf("%w: missing OFX element", ErrInvalidOFX)
+	}
+	tickers := map[string]string{}
+	for _, info := range ofx.find("SECINFO") {
+		if id, ticker := info.text("SECID", "UNIQUEID"), info.text("TICKER"); id != "" && ticker != "" {
+			tickers[id] = ticker
+		}
+	}
+
+	var res []Statement
+	for _, rs := range ofx.find("INVSTMTRS") {
+		st, err := parseInvestment(rs, tickers)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, st)
+	}
+	for _, name := range []string{"STMTRS", "CCSTMTRS"} {
+		for _, rs := range ofx.find(name) {
+			st, err := parseBank(rs)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, st)
+		}
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("%w: no statements", ErrInvalidOFX)
+	}
+	return res, nil
+}
+
+func parseInvestment(rs *element, tickers map[string]string) (Statement, error) {
+	st := Statement{Account: rs.text("INVACCTFROM", "ACCTID"), Currency: rs.text("CURDEF")}
+	var err error
+	if st.AsOf, err = asOf(rs.text("DTASOF"), rs.text("INVTRANLIST", "DTEND")); err != nil {
+		return Statement{}, err
+	}
+	symbol := func(e *element) string {
+		id := e.text("SECID", "UNIQUEID")
+		if ticker, ok := tickers[id]; ok {
+			return ticker
+		}
+		return id
+	}
+
+	if list := rs.child("INVTRANLIST"); list != nil {
+		for _, e := range list.children {
+			// DTSTART and DTEND are leaves among the transactions.
+			if len(e.children) > 0 {
+				st.Rows = append(st.Rows, investmentRows(e, symbol)...)
+			}
+		}
+	}
+	if list := rs.child("INVPOSLIST"); list != nil {
+		st.Positions = []Position{}
+		for _, e := range list.children {
+			pos := e.child("INVPOS")
+			if pos == nil {
+				continue
+			}
+			p := Position{Symbol: symbol(pos)}
+			if p.Quantity, err = ofxNumber(pos.text("UNITS")); err != nil {
+				return Statement{}, fmt.Errorf("%w: line %d: position units: %v", ErrInvalidOFX, pos.line, err)
+			}
+			if p.Price, err = ofxNumber(pos.text("UNITPRICE")); err != nil {
+				return Statement{}, fmt.Errorf("%w: line %d: position price: %v", ErrInvalidOFX, pos.line, err)
+			}
+			if pos.text("POSTYPE") == "SHORT" {
+				p.Quantity = -math.Abs(p.Quantity)
+			}
+			st.Positions = append(st.Positions, p)
+		}
+	}
+	if v := rs.text("INVBAL", "AVAILCASH"); v != "" {
+		cash, err := ofxNumber(v)
+		if err != nil {
+			return Statement{}, fmt.Errorf("%w: available cash: %v", ErrInvalidOFX, err)
+		}
+		st.Cash = &cash
+	}
+	return st, nil
+}
+
+func parseBank(rs *element) (Statement, error) {
+	st := Statement{Currency: rs.text("CURDEF"), Account: rs.text("BANKACCTFROM", "ACCTID")}
+	if st.Account == "" {
+		st.Account = rs.text("CCACCTFROM", "ACCTID")
+	}
+	if list := rs.child("BANKTRANLIST"); list != nil {
+		for _, e := range list.children {
+			if e.name == "STMTTRN" {
+				st.Rows = append(st.Rows, bankRow(e))
+			}
+		}
+	}
+	var err error
+	if st.AsOf, err = asOf(rs.text("LEDGERBAL", "DTASOF"), rs.text("BANKTRANLIST", "DTEND")); err != nil {
+		return Statement{}, err
+	}
+	if v := rs.text("LEDGERBAL", "BALAMT"); v != "" {
+		cash, err := ofxNumber(v)
+		if err != nil {
+			return Statement{}, fmt.Errorf("%w: ledger balance: %v", ErrInvalidOFX, err)
+		}
+		st.Cash = &cash
+	}
+	return st, nil
+}
+
+// investmentRows reads an investment transaction; reinvestments and transfers
+// read as two ledger transactions.
+func investmentRows(e *element, symbol func(*element) string) []Row {
+	if e.name == "INVBANKTRAN" {
+		if trn := e.child("STMTTRN"); trn != nil {
+			return []Row{bankRow(trn)}
+		}
+	}
+	// Buys and sells wrap their details in INVBUY and INVSELL.
+	detail := e
+	if inner := e.child("INVBUY"); inner != nil {
+		detail = inner
+	} else if inner := e.child("INVSELL"); inner != nil {
+		detail = inner
+	}
+	tran := detail.child("INVTRAN")
+	if tran == nil {
+		return []Row{{Line: e.line, Status: StatusError, Err: fmt.Errorf("%s without INVTRAN", e.name)}}
+	}
+	row := Row{Line: e.line}
+	fail := func(err error) []Row {
+		row.Status, row.Err = StatusError, err
+		return []Row{row}
+	}
+	date, err := ofxDate(tran.text("DTTRADE"))
+	if err != nil {
+		return fail(err)
+	}
+	num := map[string]float64{}
+	for _, name := range []string{"UNITS", "UNITPRICE", "COMMISSION", "FEES", "TOTAL", "WITHHOLDING", "AVGCOSTBASIS"} {
+		if num[name], err = ofxNumber(detail.text(name)); err != nil {
+			return fail(fmt.Errorf("bad %s: %v", strings.ToLower(name), err))
+		}
+	}
+	row.Tx = ledger.Transaction{Date: date, Symbol: symbol(detail), Note: tran.text("MEMO")}
+	fee := math.Abs(num["COMMISSION"]) + math.Abs(num["FEES"])
+	income := func(kind string) ledger.TxType {
+		if kind == "INTEREST" {
+			return ledger.TxInterest
+		}
+		return ledger.TxDividend
+	}
+
+	var rows []Row
+	switch e.name {
+	case "BUYSTOCK", "BUYMF", "BUYOTHER":
+		row.Tx.Type, row.Tx.Quantity, row.Tx.Price, row.Tx.Fee = ledger.TxBuy, math.Abs(num["UNITS"]), num["UNITPRICE"], fee
+		rows = []Row{row}
+	case "SELLSTOCK", "SELLMF", "SELLOTHER":
+		row.Tx.Type, row.Tx.Quantity, row.Tx.Price, row.Tx.Fee = ledger.TxSell, math.Abs(num["UNITS"]), num["UNITPRICE"], fee
+		rows = []Row{row}
+	case "INCOME":
+		row.Tx.Type, row.Tx.Amount, row.Tx.Withholding = income(e.text("INCOMETYPE")), math.Abs(num["TOTAL"]), math.Abs(num["WITHHOLDING"])
+		rows = []Row{row}
+	case "REINVEST":
+		// The income is paid and spent on units in one go.
+		paid := row
+		paid.Tx.Type, paid.Tx.Amount = income(e.text("INCOMETYPE")), math.Abs(num["TOTAL"])
+		row.Tx.Type, row.Tx.Quantity, row.Tx.Price, row.Tx.Fee = ledger.TxBuy, math.Abs(num["UNITS"]), num["UNITPRICE"], fee
+		rows = []Row{paid, row}
+	case "TRANSFER":
+		// Securities moving between institutions are bought or sold against
+		// an external flow of their value: at the average cost basis when
+		// they come in and at the price when they go out.
+		qty := math.Abs(num["UNITS"])
+		cash := row
+		row.Tx.Quantity, row.Tx.Price = qty, num["UNITPRICE"]
+		if e.text("TFERACTION") == "OUT" {
+			row.Tx.Type = ledger.TxSell
+			cash.Tx.Type = ledger.TxWithdrawal
+		} else {
+			row.Tx.Type = ledger.TxBuy
+			cash.Tx.Type = ledger.TxDeposit
+			if basis := num["AVGCOSTBASIS"]; basis != 0 {
+				row.Tx.Price = math.Abs(basis)
+			}
+		}
+		cash.Tx.Symbol, cash.Tx.Amount = "", qty*row.Tx.Price
+		if cash.Tx.Amount == 0 {
+			rows = []Row{row}
+		} else if row.Tx.Type == ledger.TxBuy {
+			rows = []Row{cash, row}
+		} else {
+			rows = []Row{row, cash}
+		}
+	case "MARGININTEREST":
+		row.Tx.Type, row.Tx.Symbol, row.Tx.Amount = ledger.TxMarginInterest, "", math.Abs(num["TOTAL"])
+		rows = []Row{row}
+	default:
+		return []Row{{Line: e.line, Status: StatusSkipped, Err: fmt.Errorf("unsupported %s transaction", e.name)}}
+	}
+	for i := range rows {
+		if err := rows[i].Tx.Validate(); err != nil {
+			rows[i].Status, rows[i].Err = StatusError, err
+		}
+	}
+	return rows
+}
+
+// bankRow reads a bank transaction: interest, fees and otherwise deposits and
+// withdrawals by sign.
+func bankRow(e *element) Row {
+	row := Row{Line: e.line}
+	date, err := ofxDate(e.text("DTPOSTED"))
+	if err != nil {
+		row.Status, row.Err = StatusError, err
+		return row
+	}
+	amount, err := ofxNumber(e.text("TRNAMT"))
+	if err != nil {
+		row.Status, row.Err = StatusError, fmt.Errorf("bad amount: %v", err)
+		return row
+	}
+	note := e.text("NAME")
+	if memo := e.text("MEMO"); memo != "" {
+		note = strings.TrimSpace(note + " " + memo)
+	}
+	row.Tx = ledger.Transaction{Date: date, Amount: math.Abs(amount), Note: note}
+	switch {
+	case e.text("TRNTYPE") == "INT" || e.text("TRNTYPE") == "DIV":
+		row.Tx.Type = ledger.TxInterest
+	case e.text("TRNTYPE") == "FEE" || e.text("TRNTYPE") == "SRVCHG":
+		row.Tx.Type = ledger.TxFee
+	case amount < 0:
+		row.Tx.Type = ledger.TxWithdrawal
+	default:
+		row.Tx.Type = ledger.TxDeposit
+	}
+	if err := row.Tx.Validate(); err != nil {
+		row.Status, row.Err = StatusError, err
+	}
+	return row
+}
+
+// asOf reads the first date given; statements without one have a zero date.
+func asOf(dates ...string) (time.Time, error) {
+	for _, d := range dates {
+		if d != "" {
+			t, err := ofxDate(d)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidOFX, err)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, nil
+}
+
+// ofxDate reads the date of an OFX datetime such as 20250103120000.000[-5:EST].
+func ofxDate(s string) (time.Time, error) {
+	if len(s) < 8 {
+		return time.Time{}, fmt.Errorf("bad date %q", s)
+	}
+	t, err := time.Parse("20060102", s[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("bad date %q", s)
+	}
+	return t, nil
+}
+
+// ofxNumber reads an OFX amount, which may use a decimal comma. Empty amounts
+// are zero.
+func ofxNumber(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(strings.ReplaceAll(s, ",", "."), 64)
+}
+
+// element is a node of an OFX document. Leaf elements hold text, aggregates
+// hold children.
+type element struct {
+	name     string
+	value    string
+	line     int
+	children []*element
+}
+
+func (e *element) child(name string) *element {
+	for _, c := range e.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// text returns the text of the descendant at path, or "".
+func (e *element) text(path ...string) string {
+	for _, name := range path {
+		if e = e.child(name); e == nil {
+			return ""
+		}
+	}
+	return e.value
+}
+
+// find returns the descendants named name, outermost first.
+func (e *element) find(name string) []*element {
+	var res []*element
+	for _, c := range e.children {
+		if c.name == name {
+			res = append(res, c)
+			continue
+		}
+		res = append(res, c.find(name)...)
+	}
+	return res
+}
+
+// parseSGML reads the element tree of an OFX document. OFX 1.x leaves the
+// closing tags of leaf elements out, so a leaf ends at the next tag; OFX 2.x is
+// XML, where the same rule reads the closing tags. Headers, processing
+// instructions and comments are ignored.
+func parseSGML(r io.Reader) (*element, error) {
+	br := bufio.NewReader(r)
+	root := &element{}
+	stack := []*element{root}
+	line := 1
+	top := func() *element { return stack[len(stack)-1] }
+	for {
+		// Text up to the next tag.
+		text, err := br.ReadString('<')
+		line += strings.Count(text, "\n")
+		if t := strings.TrimSpace(strings.TrimSuffix(text, "<")); t != "" && len(stack) > 1 {
+			top().value = unescape(t)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		tag, err := br.ReadString('>')
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: unterminated tag", ErrInvalidOFX, line)
+		}
+		tagLine := line
+		line += strings.Count(tag, "\n")
+		tag = strings.TrimSpace(strings.TrimSuffix(tag, ">"))
+		switch {
+		case tag == "" || tag[0] == '?' || tag[0] == '!':
+			continue
+		case tag[0] == '/':
+			name := strings.ToUpper(strings.TrimSpace(tag[1:]))
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].name == name {
+					stack = stack[:i]
+					break
+				}
+			}
+		default:
+			// A leaf with text is complete once another element starts.
+			if len(stack) > 1 && top().value != "" {
+				stack = stack[:len(stack)-1]
+			}
+			selfClosing := strings.HasSuffix(tag, "/")
+			name := strings.ToUpper(strings.Fields(strings.TrimSuffix(tag, "/"))[0])
+			e := &element{name: name, line: tagLine}
+			top().children = append(top().children, e)
+			if !selfClosing {
+				stack = append(stack, e)
+			}
+		}
+	}
+	return root, nil
+}
+
+var unescaper = strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&", "&quot;", `"`, "&apos;", "'", "&nbsp;", " ")
+
+func unescape(s string) string {
+	return unescaper.Replace(s)
+}
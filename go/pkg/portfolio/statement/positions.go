@@ -0,0 +1,130 @@
+package statement
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CashSymbol marks the cash balance rows of a position file, besides rows
+// without a symbol.
+const CashSymbol = "CASH"
+
+// ParsePositionsCSV reads a broker position file with the date, account,
+// symbol, quantity, price and amount columns of a profile. Each account and
+// date becomes a statement listing every position held, so that holdings the
+// file leaves out count as closed. Rows without a symbol, or with CashSymbol,
+// hold the cash balance in their amount, or their quantity when there is no
+// amount. Rows without a date are as of asOf.
+//
+// Unlike ParseCSV, an unreadable row fails the whole file: reconciling part
+// of a position file would report every position it lost as a break.
+func ParsePositionsCSV(r io.Reader, p Profile, asOf time.Time) ([]Statement, error) {
+	p = p.WithDefaults()
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	cr, index, err := p.open(r, "symbol", "quantity")
+	if err != nil || cr == nil {
+		return nil, err
+	}
+	if _, ok := index["date"]; !ok && asOf.IsZero() {
+		return nil, errors.New("the file has no date column; give the as-of date")
+	}
+
+	type key struct {
+		account string
+		asOf    time.Time
+	}
+	byKey := map[key]*Statement{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := index[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		number := func(name string) (float64, bool, error) {
+			value := field(name)
+			if value == "" {
+				return 0, false, nil
+			}
+			v, err := p.parseNumber(value)
+			if err != nil {
+				return 0, false, fmt.Errorf("line %d: bad %s %q", line, name, value)
+			}
+			return v, true, nil
+		}
+
+		k := key{account: field("account"), asOf: asOf}
+		if value := field("date"); value != "" {
+			date, err := time.Parse(p.DateFormat, value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: bad date %q: want %s", line, value, p.DateFormat)
+			}
+			k.asOf = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		} else if asOf.IsZero() {
+			return nil, fmt.Errorf("line %d: missing date", line)
+		}
+		st, ok := byKey[k]
+		if !ok {
+			st = &Statement{Account: k.account, AsOf: k.asOf, Positions: []Position{}}
+			byKey[k] = st
+		}
+
+		qty, hasQty, err := number("quantity")
+		if err != nil {
+			return nil, err
+		}
+		price, _, err := number("price")
+		if err != nil {
+			return nil, err
+		}
+		amount, hasAmount, err := number("amount")
+		if err != nil {
+			return nil, err
+		}
+		symbol := field("symbol")
+		if symbol == "" || strings.EqualFold(symbol, CashSymbol) {
+			if !hasAmount {
+				amount = qty
+			}
+			cash := amount
+			if st.Cash != nil {
+				cash += *st.Cash
+			}
+			st.Cash = &cash
+			continue
+		}
+		if !hasQty {
+			return nil, fmt.Errorf("line %d: missing quantity of %s", line, symbol)
+		}
+		if price == 0 && hasAmount && qty != 0 {
+			price = amount / qty
+		}
+		st.Positions = append(st.Positions, Position{Symbol: symbol, Quantity: qty, Price: price})
+	}
+
+	res := make([]Statement, 0, len(byKey))
+	for _, st := range byKey {
+		res = append(res, *st)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Account != res[j].Account {
+			return res[i].Account < res[j].Account
+		}
+		return res[i].AsOf.Before(res[j].AsOf)
+	})
+	return res, nil
+}
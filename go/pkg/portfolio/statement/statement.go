@@ -0,0 +1,104 @@
+package statement
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+)
+
+// Status is the outcome of importing a statement row.
+type Status string
+
+const (
+	// StatusImported rows were posted, or would be in a dry run.
+	StatusImported Status = "imported"
+	// StatusDuplicate rows match a transaction already in the ledger.
+	StatusDuplicate Status = "duplicate"
+	// StatusSkipped rows carry an action the profile ignores.
+	StatusSkipped Status = "skipped"
+	// StatusError rows could not be read or posted.
+	StatusError Status = "error"
+)
+
+// Row is a statement row read into a ledger transaction. Status is empty until
+// the row is deduplicated and posted, unless the row was skipped or failed.
+type Row struct {
+	// Line is the line of the row in the file.
+	Line        int
+	Tx          ledger.Transaction
+	Fingerprint string
+	Status      Status
+	Err         error
+}
+
+// Fingerprint identifies a transaction by its content, ignoring the ID and
+// note, so that the same statement row imported twice is recognized.
+func Fingerprint(tx ledger.Transaction) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s|%s|%s|%s|%.8f|%.8f|%.4f|%.4f|%.4f",
+		tx.Date.Format(time.DateOnly), tx.Type, tx.Account, tx.Symbol, tx.Quantity, tx.Price, tx.Amount, tx.Fee, tx.Withholding))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Dedupe fingerprints the pending rows and marks those already in existing as
+// duplicates. Identical rows are matched one for one, so a statement with two
+// identical trades imports both unless the ledger already holds two.
+func Dedupe(existing []ledger.Transaction, rows []Row) {
+	held := map[string]int{}
+	for _, tx := range existing {
+		held[Fingerprint(tx)]++
+	}
+	for i := range rows {
+		r := &rows[i]
+		if r.Status != "" {
+			continue
+		}
+		r.Fingerprint = Fingerprint(r.Tx)
+		if held[r.Fingerprint] > 0 {
+			held[r.Fingerprint]--
+			r.Status = StatusDuplicate
+		}
+	}
+}
+
+// Difference compares the quantity of a symbol in a statement and the ledger.
+type Difference struct {
+	Symbol    string
+	Statement float64
+	Ledger    float64
+}
+
+// Matched reports whether the quantities agree.
+func (d Difference) Matched() bool {
+	return math.Abs(d.Statement-d.Ledger) < 1e-6
+}
+
+// ReconcilePositions compares statement positions with the quantities held in
+// the ledger, including holdings the statement does not list, by symbol.
+func ReconcilePositions(positions []Position, held map[string]float64) []Difference {
+	bySymbol := map[string]*Difference{}
+	diff := func(symbol string) *Difference {
+		d, ok := bySymbol[symbol]
+		if !ok {
+			d = &Difference{Symbol: symbol}
+			bySymbol[symbol] = d
+		}
+		return d
+	}
+	for _, p := range positions {
+		diff(p.Symbol).Statement += p.Quantity
+	}
+	for symbol, qty := range held {
+		diff(symbol).Ledger = qty
+	}
+	res := make([]Difference, 0, len(bySymbol))
+	for _, d := range bySymbol {
+		res = append(res, *d)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Symbol < res[j].Symbol })
+	return res
+}
@@ -0,0 +1,349 @@
+package statement
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+// broker is a European broker profile with a preamble, semicolons, day-first
+// dates, decimal commas and its own action codes.
+var broker = Profile{
+	Name:       "broker",
+	Delimiter:  ";",
+	SkipRows:   2,
+	DateFormat: "02.01.2006",
+	Decimal:    ",",
+	Thousands:  ".",
+	Columns: Columns{
+		Date:        "Trade Date",
+		Action:      "Action",
+		Symbol:      "Ticker",
+		Quantity:    "Qty",
+		Amount:      "Net Amount",
+		Fee:         "Commission",
+		Description: "Description",
+	},
+	Actions: map[string]string{
+		"TRD": ActionTrade,
+		"CSH": ActionTransfer,
+		"DIV": "dividend",
+		"JNL": ActionSkip,
+	},
+}
+
+func TestParseCSVGeneric(t *testing.T) {
+	// Arrange
+	in := "date,type,account,symbol,quantity,price,amount,fee,note\n" +
+		"2025-01-02,deposit,ira,,,,\"$1,000.00\",,funding\n" +
+		"2025-01-03,buy,ira,AAPL,2,150.5,,1,\n"
+
+	// Act
+	rows, err := ParseCSV(strings.NewReader(in), Generic)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, 2, rows[0].Line)
+	assert.Equal(t, ledger.Transaction{Date: date("2025-01-02"), Type: ledger.TxDeposit, Account: "ira", Amount: 1000, Note: "funding"}, rows[0].Tx)
+	assert.Equal(t, ledger.Transaction{Date: date("2025-01-03"), Type: ledger.TxBuy, Account: "ira", Symbol: "AAPL", Quantity: 2, Price: 150.5, Fee: 1}, rows[1].Tx)
+	assert.Empty(t, rows[1].Status)
+}
+
+func TestParseCSVProfile(t *testing.T) {
+	// Arrange
+	in := "Account;U1234567\n" +
+		"Period;2025\n" +
+		"Trade Date;Action;Ticker;Qty;Net Amount;Commission;Description\n" +
+		"02.01.2025;CSH;;;10.000,00;;Wire in\n" +
+		"03.01.2025;TRD;SAP;10;(2.001,50);1,50;Buy SAP\n" +
+		"10.01.2025;TRD;SAP;-4;798,50;1,50;Sell SAP\n" +
+		"15.01.2025;DIV;SAP;;22,00;;Dividend\n" +
+		"16.01.2025;JNL;;;5,00;;Internal\n" +
+		"20.01.2025;CSH;;;-500,00;;Wire out\n"
+
+	// Act
+	rows, err := ParseCSV(strings.NewReader(in), broker)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, rows, 6)
+	assert.Equal(t, 4, rows[0].Line)
+	assert.Equal(t, ledger.TxDeposit, rows[0].Tx.Type)
+	assert.InDelta(t, 10000, rows[0].Tx.Amount, 1e-9)
+	buy := rows[1].Tx
+	assert.Equal(t, ledger.TxBuy, buy.Type)
+	assert.Equal(t, date("2025-01-03"), buy.Date)
+	assert.InDelta(t, 10, buy.Quantity, 1e-9)
+	assert.InDelta(t, 200, buy.Price, 1e-9, "price is derived from the net amount less commission")
+	assert.InDelta(t, 1.5, buy.Fee, 1e-9)
+	sell := rows[2].Tx
+	assert.Equal(t, ledger.TxSell, sell.Type)
+	assert.InDelta(t, 4, sell.Quantity, 1e-9)
+	assert.InDelta(t, 200, sell.Price, 1e-9)
+	assert.Equal(t, ledger.TxDividend, rows[3].Tx.Type)
+	assert.Equal(t, StatusSkipped, rows[4].Status)
+	assert.Equal(t, ledger.TxWithdrawal, rows[5].Tx.Type)
+	assert.InDelta(t, 500, rows[5].Tx.Amount, 1e-9)
+}
+
+func TestParseCSVRowErrors(t *testing.T) {
+	// Arrange
+	in := "date,type,symbol,quantity,price,amount\n" +
+		"2025-13-01,deposit,,,,100\n" +
+		"2025-01-02,transfer_in,,,,100\n" +
+		"2025-01-02,buy,AAPL,1,abc,\n" +
+		"2025-01-02,dividend,,,,5\n" +
+		"2025-01-02,deposit,,,,100\n"
+
+	// Act
+	rows, err := ParseCSV(strings.NewReader(in), Generic)
+
+	// Assert
+	require.NoError(t, err, "missing optional columns and bad rows do not fail the file")
+	require.Len(t, rows, 5)
+	for i, want := range []string{"bad date", "unknown action", "bad price", "dividend requires a symbol"} {
+		assert.Equal(t, StatusError, rows[i].Status)
+		assert.ErrorContains(t, rows[i].Err, want)
+		assert.Equal(t, i+2, rows[i].Line)
+	}
+	assert.Empty(t, rows[4].Status)
+}
+
+func TestParseCSVMissingColumn(t *testing.T) {
+	_, err := ParseCSV(strings.NewReader("date,symbol\n2025-01-02,AAPL\n"), Generic)
+
+	assert.ErrorContains(t, err, `missing "type" column`)
+}
+
+func TestProfileValidate(t *testing.T) {
+	for name, mutate := range map[string]func(*Profile){
+		"no name":        func(p *Profile) { p.Name = "" },
+		"long delimiter": func(p *Profile) { p.Delimiter = ";;" },
+		"same separator": func(p *Profile) { p.Thousands = "," },
+		"no date column": func(p *Profile) { p.Columns.Date = "" },
+		"unknown action": func(p *Profile) { p.Actions = map[string]string{"X": "split"} },
+	} {
+		p := broker
+		mutate(&p)
+		assert.ErrorIs(t, p.WithDefaults().Validate(), ErrInvalidProfile, name)
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	// Arrange
+	buy := ledger.Transaction{Date: date("2025-01-03"), Type: ledger.TxBuy, Symbol: "AAPL", Quantity: 1, Price: 150}
+	posted := buy
+	posted.ID, posted.Note = "tx-000001", "entered by hand"
+	rows := []Row{{Tx: buy}, {Tx: buy}, {Tx: buy, Status: StatusError}}
+
+	// Act
+	Dedupe([]ledger.Transaction{posted}, rows)
+
+	// Assert
+	assert.Equal(t, StatusDuplicate, rows[0].Status)
+	assert.Empty(t, rows[1].Status, "a second identical trade is new")
+	assert.Equal(t, rows[0].Fingerprint, rows[1].Fingerprint)
+	assert.Empty(t, rows[2].Fingerprint)
+	buy.Quantity = 2
+	assert.NotEqual(t, Fingerprint(buy), rows[0].Fingerprint)
+}
+
+// sgmlStatement is an OFX 1.x investment statement, with unclosed leaf tags.
+const sgmlStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+ENCODING:USASCII
+
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO</STATUS><DTSERVER>20250201</SONRS></SIGNONMSGSRSV1>
+<INVSTMTMSGSRSV1><INVSTMTTRNRS><TRNUID>1
+<INVSTMTRS>
+<DTASOF>20250131160000.000[-5:EST]
+<CURDEF>USD
+<INVACCTFROM><BROKERID>broker.example<ACCTID>U123</INVACCTFROM>
+<INVTRANLIST>
+<DTSTART>20250101<DTEND>20250131
+<INVBANKTRAN><STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20250102<TRNAMT>5000.00<FITID>1<NAME>Wire in</STMTTRN><SUBACCTFUND>CASH</INVBANKTRAN>
+<BUYSTOCK><INVBUY><INVTRAN><FITID>2<DTTRADE>20250103<MEMO>Buy ACME &amp; Co</INVTRAN>
+<SECID><UNIQUEID>000000001<UNIQUEIDTYPE>CUSIP</SECID>
+<UNITS>20<UNITPRICE>100.00<COMMISSION>1.00<FEES>0.50<TOTAL>-2001.50<SUBACCTSEC>CASH<SUBACCTFUND>CASH</INVBUY><BUYTYPE>BUY</BUYSTOCK>
+<SELLSTOCK><INVSELL><INVTRAN><FITID>3<DTTRADE>20250110</INVTRAN>
+<SECID><UNIQUEID>000000001<UNIQUEIDTYPE>CUSIP</SECID>
+<UNITS>-5<UNITPRICE>110.00<COMMISSION>1.00<TOTAL>549.00</INVSELL><SELLTYPE>SELL</SELLSTOCK>
+<INCOME><INVTRAN><FITID>4<DTTRADE>20250115</INVTRAN><SECID><UNIQUEID>000000001<UNIQUEIDTYPE>CUSIP</SECID>
+<INCOMETYPE>DIV<TOTAL>12.00<WITHHOLDING>1.80<SUBACCTSEC>CASH<SUBACCTFUND>CASH</INCOME>
+<REINVEST><INVTRAN><FITID>5<DTTRADE>20250120</INVTRAN><SECID><UNIQUEID>000000002<UNIQUEIDTYPE>CUSIP</SECID>
+<INCOMETYPE>DIV<TOTAL>-50.00<UNITS>0.5<UNITPRICE>100.00</REINVEST>
+<TRANSFER><INVTRAN><FITID>6<DTTRADE>20250125</INVTRAN><SECID><UNIQUEID>000000002<UNIQUEIDTYPE>CUSIP</SECID>
+<SUBACCTSEC>CASH<UNITS>10<TFERACTION>IN<POSTYPE>LONG<AVGCOSTBASIS>80.00</TRANSFER>
+<SPLIT><INVTRAN><FITID>7<DTTRADE>20250128</INVTRAN><SECID><UNIQUEID>000000001<UNIQUEIDTYPE>CUSIP</SECID></SPLIT>
+</INVTRANLIST>
+<INVPOSLIST>
+<POSSTOCK><INVPOS><SECID><UNIQUEID>000000001<UNIQUEIDTYPE>CUSIP</SECID><HELDINACCT>CASH<POSTYPE>LONG<UNITS>15<UNITPRICE>112.00<MKTVAL>1680.00<DTPRICEASOF>20250131</INVPOS></POSSTOCK>
+<POSMF><INVPOS><SECID><UNIQUEID>000000002<UNIQUEIDTYPE>CUSIP</SECID><HELDINACCT>CASH<POSTYPE>LONG<UNITS>10.5<UNITPRICE>101.00<MKTVAL>1060.50<DTPRICEASOF>20250131</INVPOS></POSMF>
+</INVPOSLIST>
+<INVBAL><AVAILCASH>3558.30<MARGINBALANCE>0<SHORTBALANCE>0</INVBAL>
+</INVSTMTRS>
+</INVSTMTTRNRS></INVSTMTMSGSRSV1>
+<SECLISTMSGSRSV1><SECLIST>
+<STOCKINFO><SECINFO><SECID><UNIQUEID>000000001<UNIQUEIDTYPE>CUSIP</SECID><SECNAME>Acme<TICKER>ACME</SECINFO></STOCKINFO>
+<MFINFO><SECINFO><SECID><UNIQUEID>000000002<UNIQUEIDTYPE>CUSIP</SECID><SECNAME>Fund<TICKER>FUND</SECINFO></MFINFO>
+</SECLIST></SECLISTMSGSRSV1>
+</OFX>
+`
+
+func TestParseOFXInvestment(t *testing.T) {
+	// Act
+	sts, err := ParseOFX(strings.NewReader(sgmlStatement))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, sts, 1)
+	st := sts[0]
+	assert.Equal(t, "U123", st.Account)
+	assert.Equal(t, "USD", st.Currency)
+	assert.Equal(t, date("2025-01-31"), st.AsOf)
+	want := []ledger.Transaction{
+		{Date: date("2025-01-02"), Type: ledger.TxDeposit, Amount: 5000, Note: "Wire in"},
+		{Date: date("2025-01-03"), Type: ledger.TxBuy, Symbol: "ACME", Quantity: 20, Price: 100, Fee: 1.5, Note: "Buy ACME & Co"},
+		{Date: date("2025-01-10"), Type: ledger.TxSell, Symbol: "ACME", Quantity: 5, Price: 110, Fee: 1},
+		{Date: date("2025-01-15"), Type: ledger.TxDividend, Symbol: "ACME", Amount: 12, Withholding: 1.8},
+		{Date: date("2025-01-20"), Type: ledger.TxDividend, Symbol: "FUND", Amount: 50},
+		{Date: date("2025-01-20"), Type: ledger.TxBuy, Symbol: "FUND", Quantity: 0.5, Price: 100},
+		{Date: date("2025-01-25"), Type: ledger.TxDeposit, Amount: 800},
+		{Date: date("2025-01-25"), Type: ledger.TxBuy, Symbol: "FUND", Quantity: 10, Price: 80},
+	}
+	require.Len(t, st.Rows, len(want)+1)
+	for i, tx := range want {
+		assert.Empty(t, st.Rows[i].Status, "row %d", i)
+		assert.Equal(t, tx, st.Rows[i].Tx, "row %d", i)
+	}
+	skipped := st.Rows[len(want)]
+	assert.Equal(t, StatusSkipped, skipped.Status)
+	assert.ErrorContains(t, skipped.Err, "unsupported SPLIT")
+	assert.Equal(t, 28, skipped.Line)
+	assert.Equal(t, []Position{{Symbol: "ACME", Quantity: 15, Price: 112}, {Symbol: "FUND", Quantity: 10.5, Price: 101}}, st.Positions)
+	require.NotNil(t, st.Cash)
+	assert.InDelta(t, 3558.3, *st.Cash, 1e-9)
+}
+
+func TestParseOFXBankXML(t *testing.T) {
+	// Arrange
+	in := `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+  <BANKMSGSRSV1>
+    <STMTTRNRS>
+      <STMTRS>
+        <CURDEF>USD</CURDEF>
+        <BANKACCTFROM><BANKID>123</BANKID><ACCTID>9876</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>
+        <BANKTRANLIST>
+          <DTSTART>20250101</DTSTART><DTEND>20250131</DTEND>
+          <STMTTRN><TRNTYPE>DIRECTDEP</TRNTYPE><DTPOSTED>20250105</DTPOSTED><TRNAMT>2500.00</TRNAMT><FITID>a</FITID><NAME>Payroll</NAME></STMTTRN>
+          <STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20250110</DTPOSTED><TRNAMT>-100.00</TRNAMT><FITID>b</FITID><NAME>Transfer</NAME><MEMO>to savings</MEMO></STMTTRN>
+          <STMTTRN><TRNTYPE>INT</TRNTYPE><DTPOSTED>20250131</DTPOSTED><TRNAMT>1,25</TRNAMT><FITID>c</FITID></STMTTRN>
+          <STMTTRN><TRNTYPE>SRVCHG</TRNTYPE><DTPOSTED>20250131</DTPOSTED><TRNAMT>-5.00</TRNAMT><FITID>d</FITID></STMTTRN>
+        </BANKTRANLIST>
+        <LEDGERBAL><BALAMT>2396.25</BALAMT><DTASOF>20250131</DTASOF></LEDGERBAL>
+      </STMTRS>
+    </STMTTRNRS>
+  </BANKMSGSRSV1>
+</OFX>`
+
+	// Act
+	sts, err := ParseOFX(strings.NewReader(in))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, sts, 1)
+	st := sts[0]
+	assert.Equal(t, "9876", st.Account)
+	assert.Nil(t, st.Positions)
+	require.Len(t, st.Rows, 4)
+	assert.Equal(t, ledger.Transaction{Date: date("2025-01-05"), Type: ledger.TxDeposit, Amount: 2500, Note: "Payroll"}, st.Rows[0].Tx)
+	assert.Equal(t, ledger.Transaction{Date: date("2025-01-10"), Type: ledger.TxWithdrawal, Amount: 100, Note: "Transfer to savings"}, st.Rows[1].Tx)
+	assert.Equal(t, ledger.TxInterest, st.Rows[2].Tx.Type)
+	assert.InDelta(t, 1.25, st.Rows[2].Tx.Amount, 1e-9)
+	assert.Equal(t, ledger.TxFee, st.Rows[3].Tx.Type)
+	assert.Equal(t, 11, st.Rows[0].Line)
+	require.NotNil(t, st.Cash)
+	assert.InDelta(t, 2396.25, *st.Cash, 1e-9)
+	assert.Equal(t, date("2025-01-31"), st.AsOf)
+}
+
+func TestParseOFXInvalid(t *testing.T) {
+	for name, in := range map[string]string{
+		"not ofx":       "date,type\n2025-01-01,deposit\n",
+		"no statements": "<OFX><SIGNONMSGSRSV1></SIGNONMSGSRSV1></OFX>",
+	} {
+		_, err := ParseOFX(strings.NewReader(in))
+		assert.ErrorIs(t, err, ErrInvalidOFX, name)
+	}
+}
+
+func TestReconcilePositions(t *testing.T) {
+	diffs := ReconcilePositions(
+		[]Position{{Symbol: "ACME", Quantity: 15}, {Symbol: "FUND", Quantity: 10.5}},
+		map[string]float64{"ACME": 15, "OLD": 3},
+	)
+
+	assert.Equal(t, []Difference{
+		{Symbol: "ACME", Statement: 15, Ledger: 15},
+		{Symbol: "FUND", Statement: 10.5},
+		{Symbol: "OLD", Ledger: 3},
+	}, diffs)
+	assert.True(t, diffs[0].Matched())
+	assert.False(t, diffs[1].Matched())
+}
+
+func TestParsePositionsCSV(t *testing.T) {
+	// Arrange
+	in := `date,account,symbol,quantity,price,amount
+2024-06-28,ira,AAPL,10,210,2100
+2024-06-28,ira,CASH,,,"1,250.50"
+2024-06-28,ira,SPY,3,,"1,620"
+2024-06-28,,MSFT,5,450,
+2024-06-28,,,,,-20
+2024-06-27,ira,AAPL,8,205,
+`
+
+	// Act
+	sts, err := ParsePositionsCSV(strings.NewReader(in), Generic, time.Time{})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, sts, 3)
+	assert.Equal(t, "", sts[0].Account)
+	assert.Equal(t, []Position{{Symbol: "MSFT", Quantity: 5, Price: 450}}, sts[0].Positions)
+	require.NotNil(t, sts[0].Cash)
+	assert.InDelta(t, -20, *sts[0].Cash, 1e-9)
+	assert.Equal(t, date("2024-06-27"), sts[1].AsOf)
+	assert.Nil(t, sts[1].Cash)
+	assert.Equal(t, []Position{{Symbol: "AAPL", Quantity: 10, Price: 210}, {Symbol: "SPY", Quantity: 3, Price: 540}}, sts[2].Positions)
+	assert.InDelta(t, 1250.5, *sts[2].Cash, 1e-9)
+}
+
+func TestParsePositionsCSVErrors(t *testing.T) {
+	// Act
+	_, noDate := ParsePositionsCSV(strings.NewReader("symbol,quantity\nAAPL,1\n"), Generic, time.Time{})
+	undated, dateErr := ParsePositionsCSV(strings.NewReader("symbol,quantity\nAAPL,1\n"), Generic, date("2024-06-28"))
+	_, badRow := ParsePositionsCSV(strings.NewReader("date,symbol,quantity\n2024-06-28,AAPL,ten\n"), Generic, time.Time{})
+	_, noQuantity := ParsePositionsCSV(strings.NewReader("date,symbol\n2024-06-28,AAPL\n"), Generic, time.Time{})
+
+	// Assert
+	assert.Error(t, noDate)
+	require.NoError(t, dateErr)
+	assert.Equal(t, date("2024-06-28"), undated[0].AsOf)
+	assert.ErrorContains(t, badRow, "line 2: bad quantity")
+	assert.ErrorContains(t, noQuantity, `missing "quantity" column`)
+}
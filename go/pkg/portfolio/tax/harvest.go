@@ -0,0 +1,217 @@
+package tax
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// HarvestOptions configure FindHarvests.
+type HarvestOptions struct {
+	AsOf time.Time
+	// MinLoss is the smallest loss worth harvesting in an account and symbol.
+	MinLoss float64
+	// ShortTermRate and LongTermRate are the marginal tax rates, as decimal
+	// fractions, saved on short-term and long-term losses.
+	ShortTermRate float64
+	LongTermRate  float64
+	// Replacements lists the candidate replacements of each symbol in order
+	// of preference.
+	Replacements map[string][]string
+	// Equivalents may be nil when only the same symbol is identical.
+	Equivalents Equivalents
+	// Sheltered reports the tax-advantaged accounts, whose losses cannot be
+	// harvested; nil means all accounts are taxable.
+	Sheltered func(account string) bool
+	// Price returns the latest price of a symbol.
+	Price func(symbol string) (float64, bool)
+	// LotSize returns the trading lot of a symbol; nil means whole units.
+	LotSize func(symbol string) float64
+}
+
+// Harvest is a suggested sale of lots at a loss and the purchase of a
+// replacement that is not substantially identical.
+type Harvest struct {
+	Account string
+	Symbol  string
+	// LotIDs are the lots the sale relieves, first in, first out.
+	LotIDs      []string
+	Quantity    float64
+	Price       float64
+	CostBasis   float64
+	MarketValue float64
+	// ShortTermLoss and LongTermLoss are the net losses by holding period;
+	// negative for a net gain.
+	ShortTermLoss float64
+	LongTermLoss  float64
+	// Savings is the tax saved on the losses at the marginal rates.
+	Savings float64
+	// Replacement is empty when no candidate replacement is eligible.
+	Replacement string
+	// ReplacementPrice and ReplacementQuantity are zero when the replacement
+	// has no price.
+	ReplacementPrice    float64
+	ReplacementQuantity float64
+	// RepurchaseAfter is the first day the sold security can be bought back
+	// without a wash sale.
+	RepurchaseAfter time.Time
+}
+
+// Loss returns the net loss of the sale.
+func (h Harvest) Loss() float64 {
+	return h.ShortTermLoss + h.LongTermLoss
+}
+
+// Skipped is a loss above the threshold that is not suggested for harvest.
+type Skipped struct {
+	Account string
+	Symbol  string
+	Loss    float64
+	Reason  string
+}
+
+// HarvestPlan holds the harvests suggested, largest saving first, then
+// largest loss, and the losses skipped.
+type HarvestPlan struct {
+	Harvests []Harvest
+	Skipped  []Skipped
+}
+
+// FindHarvests scans the open long lots of each account and symbol for
+// unrealized losses. Sales relieve lots first in, first out, so a harvest
+// sells the oldest lots up to the one that makes the net loss largest. Losses
+// below MinLoss and losses in Sheltered accounts are ignored. A loss is skipped when substantially identical
+// shares bought within WashSaleWindow would remain held, which would make the
+// sale a wash sale. Replacements are the first candidates of the symbol that
+// are neither substantially identical to a symbol harvested nor to a symbol
+// sold at a loss within WashSaleWindow, whose loss buying them would wash.
+func FindHarvests(adjusted Adjusted, opts HarvestOptions) HarvestPlan {
+	type key struct{ account, symbol string }
+	held := map[key][]OpenLot{}
+	var keys []key
+	for _, lot := range adjusted.Lots {
+		if lot.Short() || (opts.Sheltered != nil && opts.Sheltered(lot.Account)) {
+			continue
+		}
+		k := key{lot.Account, lot.Symbol}
+		if _, ok := held[k]; !ok {
+			keys = append(keys, k)
+		}
+		held[k] = append(held[k], lot)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].account != keys[j].account {
+			return keys[i].account < keys[j].account
+		}
+		return keys[i].symbol < keys[j].symbol
+	})
+
+	recent := func(t time.Time) bool {
+		return !t.After(opts.AsOf) && opts.AsOf.Sub(t) <= WashSaleWindow
+	}
+	plan := HarvestPlan{Harvests: []Harvest{}, Skipped: []Skipped{}}
+	for _, k := range keys {
+		px, ok := opts.Price(k.symbol)
+		if !ok {
+			continue
+		}
+		lots := held[k]
+		sort.SliceStable(lots, func(i, j int) bool { return lots[i].Acquired.Before(lots[j].Acquired) })
+
+		// Sell the prefix of lots with the largest net loss.
+		h := Harvest{Account: k.account, Symbol: k.symbol, Price: px}
+		var shortTerm, longTerm float64
+		best := 0
+		for i, lot := range lots {
+			gain := lot.Quantity*px - lot.CostBasis
+			if lot.LongTerm(opts.AsOf) {
+				longTerm -= gain
+			} else {
+				shortTerm -= gain
+			}
+			if shortTerm+longTerm > h.Loss()+1e-9 {
+				best = i + 1
+				h.ShortTermLoss, h.LongTermLoss = shortTerm, longTerm
+			}
+		}
+		if best == 0 || h.Loss() < opts.MinLoss || h.Loss() <= 1e-9 {
+			continue
+		}
+		sold := map[string]bool{}
+		for _, lot := range lots[:best] {
+			h.LotIDs = append(h.LotIDs, lot.ID)
+			h.Quantity += lot.Quantity
+			h.CostBasis += lot.CostBasis
+			sold[lot.ID] = true
+		}
+		h.MarketValue = h.Quantity * px
+
+		var conflicts []string
+		for _, lot := range adjusted.Lots {
+			if !sold[lot.ID] && !lot.Short() && recent(lot.Acquired) && opts.Equivalents.Identical(lot.Symbol, k.symbol) {
+				conflicts = append(conflicts, lot.ID)
+			}
+		}
+		if len(conflicts) > 0 {
+			plan.Skipped = append(plan.Skipped, Skipped{
+				Account: k.account,
+				Symbol:  k.symbol,
+				Loss:    h.Loss(),
+				Reason: fmt.Sprintf("substantially identical shares bought within %d days would wash the loss: %s",
+					int(WashSaleWindow.Hours()/24), strings.Join(conflicts, ", ")),
+			})
+			continue
+		}
+		h.Savings = h.ShortTermLoss*opts.ShortTermRate + h.LongTermLoss*opts.LongTermRate
+		h.RepurchaseAfter = opts.AsOf.Add(WashSaleWindow + 24*time.Hour)
+		plan.Harvests = append(plan.Harvests, h)
+	}
+
+	var recentLosses []string
+	for _, g := range adjusted.Gains {
+		if !g.Short && recent(g.Disposed) && g.Amount() < -1e-9 {
+			recentLosses = append(recentLosses, g.Symbol)
+		}
+	}
+	washes := func(candidate string) bool {
+		for _, h := range plan.Harvests {
+			if opts.Equivalents.Identical(candidate, h.Symbol) {
+				return true
+			}
+		}
+		for _, sym := range recentLosses {
+			if opts.Equivalents.Identical(candidate, sym) {
+				return true
+			}
+		}
+		return false
+	}
+	for i := range plan.Harvests {
+		h := &plan.Harvests[i]
+		for _, candidate := range opts.Replacements[h.Symbol] {
+			if washes(candidate) {
+				continue
+			}
+			h.Replacement = candidate
+			if px, ok := opts.Price(candidate); ok && px > 0 {
+				lot := 1.0
+				if opts.LotSize != nil && opts.LotSize(candidate) > 0 {
+					lot = opts.LotSize(candidate)
+				}
+				h.ReplacementPrice = px
+				h.ReplacementQuantity = math.Floor(h.MarketValue/px/lot) * lot
+			}
+			break
+		}
+	}
+	sort.SliceStable(plan.Harvests, func(i, j int) bool {
+		a, b := plan.Harvests[i], plan.Harvests[j]
+		if math.Abs(a.Savings-b.Savings) > 1e-9 {
+			return a.Savings > b.Savings
+		}
+		return a.Loss() > b.Loss()
+	})
+	return plan
+}
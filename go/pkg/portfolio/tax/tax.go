@@ -0,0 +1,312 @@
+// Package tax builds capital gains reports from the lots a ledger disposed of.
+//
+// A report covers the disposals of one calendar year, one line per lot
+// relieved by a sale, classified short-term or long-term by holding period and
+// grouped by account. Losses are adjusted for wash sales first.
+package tax
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Term is the holding period classification of a gain.
+type Term string
+
+// Holding period classifications.
+const (
+	ShortTerm Term = "short"
+	LongTerm  Term = "long"
+)
+
+// Gain is the realized gain or loss on part of a lot.
+type Gain struct {
+	Account string
+	Symbol  string
+	// LotID is the transaction that opened the lot and SaleID the one that
+	// closed it.
+	LotID    string
+	SaleID   string
+	Acquired time.Time
+	Disposed time.Time
+	Quantity float64
+	Proceeds float64
+	// CostBasis is the basis relieved from the lot, including BasisAdjustment.
+	CostBasis float64
+	// BasisAdjustment is the loss deferred into these shares by earlier wash
+	// sales.
+	BasisAdjustment float64
+	// WashSale is the loss disallowed by the wash-sale rule, added back to
+	// the gain and deferred into the basis of the replacement shares.
+	WashSale float64
+	Term     Term
+	// Short marks the covering of a short sale.
+	Short bool
+}
+
+// Amount is the reportable gain: proceeds less basis, with disallowed losses
+// added back.
+func (g Gain) Amount() float64 {
+	return g.Proceeds - g.CostBasis + g.WashSale
+}
+
+// Totals sum the gains of a term or a group.
+type Totals struct {
+	Proceeds  float64
+	CostBasis float64
+	WashSale  float64
+	Gain      float64
+}
+
+func (t *Totals) add(g Gain) {
+	t.Proceeds += g.Proceeds
+	t.CostBasis += g.CostBasis
+	t.WashSale += g.WashSale
+	t.Gain += g.Amount()
+}
+
+// Summary splits totals by term.
+type Summary struct {
+	ShortTerm Totals
+	LongTerm  Totals
+	Total     Totals
+}
+
+func (s *Summary) add(g Gain) {
+	if g.Term == LongTerm {
+		s.LongTerm.add(g)
+	} else {
+		s.ShortTerm.add(g)
+	}
+	s.Total.add(g)
+}
+
+// Account is the part of a report for one account.
+type Account struct {
+	Account string
+	Gains   []Gain
+	Summary
+}
+
+// Report is the capital gains report of a year.
+type Report struct {
+	Year     int
+	Currency string
+	Accounts []Account
+	Summary
+	// WashSales are the wash sales of losses realized in the year.
+	WashSales []WashSale
+}
+
+// Build returns the report of the gains realized in year, ordered by account,
+// then disposal date, symbol and acquisition date.
+func Build(adjusted Adjusted, year int, currency string) Report {
+	res := Report{Year: year, Currency: currency, Accounts: []Account{}, WashSales: []WashSale{}}
+	for _, w := range adjusted.WashSales {
+		if w.Disposed.Year() == year {
+			res.WashSales = append(res.WashSales, w)
+		}
+	}
+	byAccount := map[string]*Account{}
+	for _, g := range adjusted.Gains {
+		if g.Disposed.Year() != year {
+			continue
+		}
+		acct, ok := byAccount[g.Account]
+		if !ok {
+			acct = &Account{Account: g.Account}
+			byAccount[g.Account] = acct
+		}
+		acct.Gains = append(acct.Gains, g)
+		acct.add(g)
+		res.add(g)
+	}
+
+	names := make([]string, 0, len(byAccount))
+	for name := range byAccount {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		acct := byAccount[name]
+		sort.SliceStable(acct.Gains, func(i, j int) bool {
+			a, b := acct.Gains[i], acct.Gains[j]
+			if !a.Disposed.Equal(b.Disposed) {
+				return a.Disposed.Before(b.Disposed)
+			}
+			if a.Symbol != b.Symbol {
+				return a.Symbol < b.Symbol
+			}
+			return a.Acquired.Before(b.Acquired)
+		})
+		res.Accounts = append(res.Accounts, *acct)
+	}
+	return res
+}
+
+// csvHeader names the columns written by WriteCSV.
+var csvHeader = []string{
+	"account", "symbol", "lot_id", "sale_id", "quantity", "acquired", "disposed", "term",
+	"proceeds", "cost_basis", "wash_sale_adjustment", "gain",
+}
+
+// WriteCSV writes one row per gain, by account, with amounts in cents.
+func WriteCSV(w io.Writer, r Report) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	money := func(v float64) string { return strconv.FormatFloat(v, 'f', 2, 64) }
+	for _, acct := range r.Accounts {
+		for _, g := range acct.Gains {
+			err := cw.Write([]string{
+				g.Account,
+				g.Symbol,
+				g.LotID,
+				g.SaleID,
+				strconv.FormatFloat(g.Quantity, 'f', -1, 64),
+				g.Acquired.Format(time.DateOnly),
+				g.Disposed.Format(time.DateOnly),
+				string(g.Term),
+				money(g.Proceeds),
+				money(g.CostBasis),
+				money(g.WashSale),
+				money(g.Amount()),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// jsonGain, jsonTotals, jsonAccount, jsonWashSale and jsonReport shape the JSON written by
+// WriteJSON like the getTaxReport API result.
+type jsonGain struct {
+	Account   string  `json:"account"`
+	Symbol    string  `json:"symbol"`
+	LotID     string  `json:"lot_id"`
+	SaleID    string  `json:"sale_id"`
+	Acquired  string  `json:"acquired"`
+	Disposed  string  `json:"disposed"`
+	Quantity  float64 `json:"quantity"`
+	Proceeds  float64 `json:"proceeds"`
+	CostBasis float64 `json:"cost_basis"`
+	Deferred  float64 `json:"basis_adjustment"`
+	WashSale  float64 `json:"wash_sale_adjustment"`
+	Gain      float64 `json:"gain"`
+	Term      Term    `json:"term"`
+	ShortSale bool    `json:"short_sale"`
+}
+
+type jsonTotals struct {
+	Proceeds  float64 `json:"proceeds"`
+	CostBasis float64 `json:"cost_basis"`
+	WashSale  float64 `json:"wash_sale_adjustment"`
+	Gain      float64 `json:"gain"`
+}
+
+type jsonAccount struct {
+	Account   string     `json:"account"`
+	Lots      []jsonGain `json:"lots"`
+	ShortTerm jsonTotals `json:"short_term"`
+	LongTerm  jsonTotals `json:"long_term"`
+	Total     jsonTotals `json:"total"`
+}
+
+type jsonWashSale struct {
+	SaleID              string  `json:"sale_id"`
+	LotID               string  `json:"lot_id"`
+	Account             string  `json:"account"`
+	Symbol              string  `json:"symbol"`
+	Disposed            string  `json:"disposed"`
+	Quantity            float64 `json:"quantity"`
+	Disallowed          float64 `json:"disallowed"`
+	ReplacementLotID    string  `json:"replacement_lot_id"`
+	ReplacementAccount  string  `json:"replacement_account"`
+	ReplacementSymbol   string  `json:"replacement_symbol"`
+	ReplacementAcquired string  `json:"replacement_acquired"`
+	Permanent           bool    `json:"permanent"`
+}
+
+type jsonReport struct {
+	PortfolioID string         `json:"portfolio_id"`
+	Year        int            `json:"year"`
+	Currency    string         `json:"currency"`
+	Accounts    []jsonAccount  `json:"accounts"`
+	ShortTerm   jsonTotals     `json:"short_term"`
+	LongTerm    jsonTotals     `json:"long_term"`
+	Total       jsonTotals     `json:"total"`
+	WashSales   []jsonWashSale `json:"wash_sales"`
+}
+
+func toJSONTotals(t Totals) jsonTotals {
+	return jsonTotals{Proceeds: t.Proceeds, CostBasis: t.CostBasis, WashSale: t.WashSale, Gain: t.Gain}
+}
+
+// WriteJSON writes the report of a portfolio as indented JSON.
+func WriteJSON(w io.Writer, portfolioID string, r Report) error {
+	out := jsonReport{
+		PortfolioID: portfolioID,
+		Year:        r.Year,
+		Currency:    r.Currency,
+		Accounts:    make([]jsonAccount, len(r.Accounts)),
+		ShortTerm:   toJSONTotals(r.ShortTerm),
+		LongTerm:    toJSONTotals(r.LongTerm),
+		Total:       toJSONTotals(r.Total),
+		WashSales:   make([]jsonWashSale, len(r.WashSales)),
+	}
+	for i, w := range r.WashSales {
+		out.WashSales[i] = jsonWashSale{
+			SaleID:              w.SaleID,
+			LotID:               w.LotID,
+			Account:             w.Account,
+			Symbol:              w.Symbol,
+			Disposed:            w.Disposed.Format(time.DateOnly),
+			Quantity:            w.Quantity,
+			Disallowed:          w.Disallowed,
+			ReplacementLotID:    w.ReplacementLotID,
+			ReplacementAccount:  w.ReplacementAccount,
+			ReplacementSymbol:   w.ReplacementSymbol,
+			ReplacementAcquired: w.ReplacementAcquired.Format(time.DateOnly),
+			Permanent:           w.Permanent,
+		}
+	}
+	for i, acct := range r.Accounts {
+		a := jsonAccount{
+			Account:   acct.Account,
+			Lots:      make([]jsonGain, len(acct.Gains)),
+			ShortTerm: toJSONTotals(acct.ShortTerm),
+			LongTerm:  toJSONTotals(acct.LongTerm),
+			Total:     toJSONTotals(acct.Total),
+		}
+		for j, g := range acct.Gains {
+			a.Lots[j] = jsonGain{
+				Account:   g.Account,
+				Symbol:    g.Symbol,
+				LotID:     g.LotID,
+				SaleID:    g.SaleID,
+				Acquired:  g.Acquired.Format(time.DateOnly),
+				Disposed:  g.Disposed.Format(time.DateOnly),
+				Quantity:  g.Quantity,
+				Proceeds:  g.Proceeds,
+				CostBasis: g.CostBasis,
+				Deferred:  g.BasisAdjustment,
+				WashSale:  g.WashSale,
+				Gain:      g.Amount(),
+				Term:      g.Term,
+				ShortSale: g.Short,
+			}
+		}
+		out.Accounts[i] = a
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -0,0 +1,380 @@
+package tax
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+// gains returns the gains of the sample ledger, which has no wash sales.
+func gains(t *testing.T) Adjusted {
+	t.Helper()
+	lots, disposals := sample(t).Lots(date("2025-12-31"))
+	return AdjustWashSales(lots, disposals, nil, nil)
+}
+
+// sample returns a ledger with long-term and short-term sales in 2024, a sale
+// in 2025 and a sale in a second account.
+func sample(t *testing.T) *ledger.Ledger {
+	t.Helper()
+	l := ledger.New("USD")
+	for _, tx := range []ledger.Transaction{
+		{Date: date("2022-01-03"), Type: ledger.TxDeposit, Amount: 10000},
+		{Date: date("2022-01-04"), Type: ledger.TxBuy, Symbol: "AAPL", Quantity: 10, Price: 100, Fee: 1},
+		{Date: date("2023-11-01"), Type: ledger.TxBuy, Symbol: "AAPL", Quantity: 10, Price: 150},
+		{Date: date("2024-03-01"), Type: ledger.TxSell, Symbol: "AAPL", Quantity: 15, Price: 140, Fee: 1.5},
+		{Date: date("2024-01-02"), Type: ledger.TxDeposit, Account: "ira", Amount: 5000},
+		{Date: date("2024-01-03"), Type: ledger.TxBuy, Account: "ira", Symbol: "SPY", Quantity: 5, Price: 470},
+		{Date: date("2024-06-03"), Type: ledger.TxSell, Account: "ira", Symbol: "SPY", Quantity: 5, Price: 530},
+		{Date: date("2025-02-03"), Type: ledger.TxSell, Symbol: "AAPL", Quantity: 5, Price: 200},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	return l
+}
+
+func TestBuild(t *testing.T) {
+	// Arrange
+	all := gains(t)
+
+	// Act
+	r := Build(all, 2024, "USD")
+
+	// Assert
+	require.Len(t, r.Accounts, 2)
+	taxable, ira := r.Accounts[0], r.Accounts[1]
+	assert.Equal(t, "", taxable.Account)
+	require.Len(t, taxable.Gains, 2)
+	first, second := taxable.Gains[0], taxable.Gains[1]
+	assert.Equal(t, LongTerm, first.Term)
+	assert.InDelta(t, 10, first.Quantity, 1e-9)
+	assert.InDelta(t, 1399, first.Proceeds, 1e-9)
+	assert.InDelta(t, 1001, first.CostBasis, 1e-9)
+	assert.Equal(t, ShortTerm, second.Term)
+	assert.InDelta(t, 699.5-750, second.Amount(), 1e-9)
+	assert.InDelta(t, 398, taxable.LongTerm.Gain, 1e-9)
+	assert.InDelta(t, -50.5, taxable.ShortTerm.Gain, 1e-9)
+
+	assert.Equal(t, "ira", ira.Account)
+	assert.InDelta(t, 300, ira.ShortTerm.Gain, 1e-9)
+	assert.InDelta(t, 249.5, r.ShortTerm.Gain, 1e-9)
+	assert.InDelta(t, 398, r.LongTerm.Gain, 1e-9)
+	assert.InDelta(t, 647.5, r.Total.Gain, 1e-9)
+}
+
+func TestBuildLeapYearAnniversary(t *testing.T) {
+	// Arrange: 366 days separate 2023-03-01 and 2024-03-01.
+	l := ledger.New("USD")
+	for _, tx := range []ledger.Transaction{
+		{Date: date("2023-02-28"), Type: ledger.TxDeposit, Amount: 1000},
+		{Date: date("2023-03-01"), Type: ledger.TxBuy, Symbol: "AAPL", Quantity: 10, Price: 100},
+		{Date: date("2024-03-01"), Type: ledger.TxSell, Symbol: "AAPL", Quantity: 5, Price: 120},
+		{Date: date("2024-03-02"), Type: ledger.TxSell, Symbol: "AAPL", Quantity: 5, Price: 120},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	lots, disposals := l.Lots(date("2024-12-31"))
+
+	// Act
+	r := Build(AdjustWashSales(lots, disposals, nil, nil), 2024, "USD")
+
+	// Assert
+	require.Len(t, r.Accounts, 1)
+	require.Len(t, r.Accounts[0].Gains, 2)
+	assert.Equal(t, ShortTerm, r.Accounts[0].Gains[0].Term, "sold on the anniversary")
+	assert.Equal(t, LongTerm, r.Accounts[0].Gains[1].Term, "sold the day after")
+	assert.InDelta(t, 100, r.ShortTerm.Gain, 1e-9)
+	assert.InDelta(t, 100, r.LongTerm.Gain, 1e-9)
+}
+
+func TestBuildWashSale(t *testing.T) {
+	// Arrange
+	all := gains(t)
+	for i := range all.Gains {
+		if all.Gains[i].LotID == "tx-000003" {
+			all.Gains[i].WashSale = 50.5
+		}
+	}
+
+	// Act
+	r := Build(all, 2024, "USD")
+
+	// Assert
+	assert.InDelta(t, 50.5, r.Accounts[0].Gains[1].WashSale, 1e-9)
+	assert.InDelta(t, 0, r.Accounts[0].Gains[1].Amount(), 1e-9)
+	assert.InDelta(t, 50.5, r.ShortTerm.WashSale, 1e-9)
+	assert.InDelta(t, 300, r.ShortTerm.Gain, 1e-9)
+}
+
+func TestBuildEmptyYear(t *testing.T) {
+	// Arrange
+	all := gains(t)
+
+	// Act
+	r := Build(all, 2023, "USD")
+
+	// Assert
+	assert.Empty(t, r.Accounts)
+	assert.Equal(t, Totals{}, r.Total)
+	assert.Empty(t, r.WashSales)
+}
+
+func TestWriteCSV(t *testing.T) {
+	// Arrange
+	all := gains(t)
+	var buf bytes.Buffer
+
+	// Act
+	err := WriteCSV(&buf, Build(all, 2024, "USD"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "account,symbol,lot_id,sale_id,quantity,acquired,disposed,term,proceeds,cost_basis,wash_sale_adjustment,gain\n"+
+		",AAPL,tx-000002,tx-000004,10,2022-01-04,2024-03-01,long,1399.00,1001.00,0.00,398.00\n"+
+		",AAPL,tx-000003,tx-000004,5,2023-11-01,2024-03-01,short,699.50,750.00,0.00,-50.50\n"+
+		"ira,SPY,tx-000006,tx-000007,5,2024-01-03,2024-06-03,short,2650.00,2350.00,0.00,300.00\n", buf.String())
+}
+
+func TestWriteJSON(t *testing.T) {
+	// Arrange
+	all := gains(t)
+	var buf bytes.Buffer
+
+	// Act
+	err := WriteJSON(&buf, "default", Build(all, 2024, "USD"))
+
+	// Assert
+	require.NoError(t, err)
+	var decoded jsonReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "default", decoded.PortfolioID)
+	require.Len(t, decoded.Accounts, 2)
+	assert.Equal(t, "2022-01-04", decoded.Accounts[0].Lots[0].Acquired)
+	assert.Equal(t, LongTerm, decoded.Accounts[0].Lots[0].Term)
+	assert.InDelta(t, 647.5, decoded.Total.Gain, 1e-9)
+	assert.Contains(t, buf.String(), `"wash_sale_adjustment": 0`)
+}
+
+// washLedger sells VOO at a loss, then buys IVV in another account and VOO
+// again within 30 days, and sells the VOO replacement shares later.
+func washLedger(t *testing.T) *ledger.Ledger {
+	t.Helper()
+	l := ledger.New("USD")
+	for _, tx := range []ledger.Transaction{
+		{Date: date("2024-01-02"), Type: ledger.TxBuy, Symbol: "VOO", Quantity: 10, Price: 100},
+		{Date: date("2024-03-01"), Type: ledger.TxSell, Symbol: "VOO", Quantity: 10, Price: 80},
+		{Date: date("2024-03-15"), Type: ledger.TxBuy, Account: "ira", Symbol: "IVV", Quantity: 4, Price: 85},
+		{Date: date("2024-03-20"), Type: ledger.TxBuy, Symbol: "VOO", Quantity: 10, Price: 82},
+		{Date: date("2024-06-03"), Type: ledger.TxSell, Symbol: "VOO", Quantity: 10, Price: 90},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	return l
+}
+
+func TestAdjustWashSales(t *testing.T) {
+	// Arrange
+	lots, disposals := washLedger(t).Lots(date("2024-12-31"))
+	equivalents, err := NewEquivalents([][]string{{"VOO", "IVV", "SPY"}})
+	require.NoError(t, err)
+
+	// Act
+	res := AdjustWashSales(lots, disposals, equivalents, nil)
+
+	// Assert
+	require.Len(t, res.WashSales, 2)
+	assert.Equal(t, "tx-000003", res.WashSales[0].ReplacementLotID)
+	assert.Equal(t, "ira", res.WashSales[0].ReplacementAccount)
+	assert.InDelta(t, 4, res.WashSales[0].Quantity, 1e-9)
+	assert.InDelta(t, 80, res.WashSales[0].Disallowed, 1e-9)
+	assert.Equal(t, "tx-000004", res.WashSales[1].ReplacementLotID)
+	assert.InDelta(t, 6, res.WashSales[1].Quantity, 1e-9)
+	assert.InDelta(t, 120, res.WashSales[1].Disallowed, 1e-9)
+
+	require.Len(t, res.Gains, 3)
+	loss := res.Gains[0]
+	assert.InDelta(t, -200, loss.Proceeds-loss.CostBasis, 1e-9)
+	assert.InDelta(t, 200, loss.WashSale, 1e-9)
+	assert.InDelta(t, 0, loss.Amount(), 1e-9)
+
+	// The replacement shares are sold first, with the deferred loss in their
+	// basis, then the rest of the lot.
+	replaced, rest := res.Gains[1], res.Gains[2]
+	assert.InDelta(t, 6, replaced.Quantity, 1e-9)
+	assert.InDelta(t, 120, replaced.BasisAdjustment, 1e-9)
+	assert.InDelta(t, 6*82+120, replaced.CostBasis, 1e-9)
+	assert.InDelta(t, 6*90-6*82-120, replaced.Amount(), 1e-9)
+	assert.InDelta(t, 0, replaced.WashSale, 1e-9)
+	assert.InDelta(t, 4, rest.Quantity, 1e-9)
+	assert.InDelta(t, 4*(90-82), rest.Amount(), 1e-9)
+
+	require.Len(t, res.Lots, 1)
+	ira := res.Lots[0]
+	assert.Equal(t, "IVV", ira.Symbol)
+	assert.InDelta(t, 80, ira.BasisAdjustment, 1e-9)
+	assert.InDelta(t, 4*85+80, ira.CostBasis, 1e-9)
+	assert.Equal(t, date("2024-03-15").Add(-date("2024-03-01").Sub(date("2024-01-02"))), ira.HoldingSince)
+}
+
+func TestAdjustWashSalesSameSymbolOnly(t *testing.T) {
+	// Arrange
+	lots, disposals := washLedger(t).Lots(date("2024-12-31"))
+
+	// Act
+	res := AdjustWashSales(lots, disposals, nil, nil)
+
+	// Assert
+	require.Len(t, res.WashSales, 1)
+	assert.Equal(t, "tx-000004", res.WashSales[0].ReplacementLotID)
+	assert.InDelta(t, 200, res.WashSales[0].Disallowed, 1e-9)
+	require.Len(t, res.Gains, 2)
+	assert.InDelta(t, 10*90-10*82-200, res.Gains[1].Amount(), 1e-9)
+	assert.InDelta(t, 0, res.Lots[0].BasisAdjustment, 1e-9)
+}
+
+func TestAdjustWashSalesSheltered(t *testing.T) {
+	// Arrange
+	ira := func(account string) bool { return account == "ira" }
+	lots, disposals := washLedger(t).Lots(date("2024-12-31"))
+	equivalents, err := NewEquivalents([][]string{{"VOO", "IVV"}})
+	require.NoError(t, err)
+	sampleLots, sampleDisposals := sample(t).Lots(date("2025-12-31"))
+
+	// Act
+	res := AdjustWashSales(lots, disposals, equivalents, ira)
+	report := Build(AdjustWashSales(sampleLots, sampleDisposals, nil, ira), 2024, "USD")
+
+	// Assert: the IRA purchase washes the loss for good.
+	require.Len(t, res.WashSales, 2)
+	assert.True(t, res.WashSales[0].Permanent)
+	assert.InDelta(t, 80, res.WashSales[0].Disallowed, 1e-9)
+	assert.False(t, res.WashSales[1].Permanent)
+	assert.InDelta(t, 200, res.Gains[0].WashSale, 1e-9)
+	require.Len(t, res.Lots, 1)
+	assert.InDelta(t, 0, res.Lots[0].BasisAdjustment, 1e-9)
+	assert.InDelta(t, 4*85, res.Lots[0].CostBasis, 1e-9)
+	assert.Equal(t, date("2024-03-15"), res.Lots[0].HoldingSince)
+
+	// Sales in the IRA are not reported.
+	require.Len(t, report.Accounts, 1)
+	assert.Equal(t, "", report.Accounts[0].Account)
+}
+
+func TestNewEquivalents(t *testing.T) {
+	// Act
+	e, err := NewEquivalents([][]string{{"goog", "GOOGL"}, {"VOO", "IVV"}})
+	_, singleErr := NewEquivalents([][]string{{"VOO"}})
+	_, duplicateErr := NewEquivalents([][]string{{"VOO", "IVV"}, {"IVV", "SPY"}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, e.Identical("GOOG", "GOOGL"))
+	assert.True(t, e.Identical("AAPL", "AAPL"))
+	assert.False(t, e.Identical("GOOG", "VOO"))
+	assert.False(t, e.Identical("AAPL", "MSFT"))
+	assert.ErrorIs(t, singleErr, ErrInvalidGroups)
+	assert.ErrorIs(t, duplicateErr, ErrInvalidGroups)
+}
+
+func TestBuildWashSalesOfTheYear(t *testing.T) {
+	// Arrange
+	lots, disposals := washLedger(t).Lots(date("2024-12-31"))
+	adjusted := AdjustWashSales(lots, disposals, nil, nil)
+
+	// Act
+	r := Build(adjusted, 2024, "USD")
+	next := Build(adjusted, 2025, "USD")
+
+	// Assert
+	require.Len(t, r.WashSales, 1)
+	assert.InDelta(t, 200, r.ShortTerm.WashSale, 1e-9)
+	assert.InDelta(t, 10*90-10*82-200, r.Total.Gain, 1e-9)
+	assert.Empty(t, next.WashSales)
+}
+
+func TestFindHarvests(t *testing.T) {
+	// Arrange
+	l := ledger.New("USD")
+	for _, tx := range []ledger.Transaction{
+		{Date: date("2024-01-02"), Type: ledger.TxBuy, Symbol: "VOO", Quantity: 10, Price: 100},
+		{Date: date("2024-02-01"), Type: ledger.TxBuy, Symbol: "VOO", Quantity: 10, Price: 60},
+		{Date: date("2024-01-05"), Type: ledger.TxBuy, Account: "ira", Symbol: "QQQ", Quantity: 5, Price: 400},
+		{Date: date("2023-01-03"), Type: ledger.TxBuy, Symbol: "AAPL", Quantity: 10, Price: 200},
+		{Date: date("2024-06-10"), Type: ledger.TxBuy, Account: "ira", Symbol: "AAPL", Quantity: 1, Price: 150},
+		{Date: date("2024-01-03"), Type: ledger.TxBuy, Symbol: "VTI", Quantity: 10, Price: 250},
+		{Date: date("2024-06-03"), Type: ledger.TxSell, Symbol: "VTI", Quantity: 10, Price: 230},
+	} {
+		_, err := l.Post(tx)
+		require.NoError(t, err)
+	}
+	asOf := date("2024-06-20")
+	lots, disposals := l.Lots(asOf)
+	equivalents, err := NewEquivalents([][]string{{"VOO", "IVV"}})
+	require.NoError(t, err)
+	prices := map[string]float64{"VOO": 80, "QQQ": 350, "AAPL": 150, "SCHB": 50}
+	opts := HarvestOptions{
+		AsOf:          asOf,
+		MinLoss:       100,
+		ShortTermRate: 0.35,
+		LongTermRate:  0.15,
+		Replacements:  map[string][]string{"VOO": {"IVV", "VTI", "SCHB"}, "QQQ": {"ONEQ"}},
+		Equivalents:   equivalents,
+		Price: func(symbol string) (float64, bool) {
+			px, ok := prices[symbol]
+			return px, ok
+		},
+	}
+	adjusted := AdjustWashSales(lots, disposals, equivalents, nil)
+
+	// Act
+	plan := FindHarvests(adjusted, opts)
+	opts.MinLoss = 300
+	large := FindHarvests(adjusted, opts)
+	opts.MinLoss = 100
+	opts.Sheltered = func(account string) bool { return account == "ira" }
+	taxable := FindHarvests(adjusted, opts)
+
+	// Assert
+	require.Len(t, plan.Harvests, 2)
+	qqq, voo := plan.Harvests[0], plan.Harvests[1]
+	assert.Equal(t, "QQQ", qqq.Symbol)
+	assert.InDelta(t, 250, qqq.ShortTermLoss, 1e-9)
+	assert.InDelta(t, 87.5, qqq.Savings, 1e-9)
+	assert.Equal(t, "ONEQ", qqq.Replacement)
+	assert.Zero(t, qqq.ReplacementQuantity)
+
+	// Only the older VOO lot is at a loss; selling both would net nothing.
+	assert.Equal(t, []string{"tx-000001"}, voo.LotIDs)
+	assert.InDelta(t, 200, voo.Loss(), 1e-9)
+	assert.InDelta(t, 70, voo.Savings, 1e-9)
+	assert.Equal(t, "SCHB", voo.Replacement, "IVV is identical and VTI was just sold at a loss")
+	assert.InDelta(t, 16, voo.ReplacementQuantity, 1e-9)
+	assert.Equal(t, date("2024-07-21"), voo.RepurchaseAfter)
+
+	require.Len(t, plan.Skipped, 1)
+	assert.Equal(t, "AAPL", plan.Skipped[0].Symbol)
+	assert.InDelta(t, 500, plan.Skipped[0].Loss, 1e-9)
+	assert.Contains(t, plan.Skipped[0].Reason, "tx-000005")
+
+	assert.Empty(t, large.Harvests)
+	assert.Len(t, large.Skipped, 1)
+
+	// The IRA loss cannot be harvested, but the IRA purchase still washes
+	// the AAPL loss.
+	require.Len(t, taxable.Harvests, 1)
+	assert.Equal(t, "VOO", taxable.Harvests[0].Symbol)
+	assert.Len(t, taxable.Skipped, 1)
+}
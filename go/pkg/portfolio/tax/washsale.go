@@ -0,0 +1,364 @@
+package tax
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/modules/portfolio/go/pkg/portfolio/ledger"
+)
+
+// WashSaleWindow is how far before or after a loss sale a purchase of
+// substantially identical securities makes it a wash sale.
+const WashSaleWindow = 30 * 24 * time.Hour
+
+// ErrInvalidGroups is returned for malformed substantially identical groups.
+var ErrInvalidGroups = errors.New("invalid substantially identical groups")
+
+// Equivalents maps symbols to the group of substantially identical securities
+// they belong to. A symbol is always identical to itself.
+type Equivalents map[string]int
+
+// NewEquivalents returns the equivalents of groups of symbols, such as share
+// classes of one company or funds tracking the same index. A symbol may be in
+// one group only.
+func NewEquivalents(groups [][]string) (Equivalents, error) {
+	e := Equivalents{}
+	for i, group := range groups {
+		if len(group) < 2 {
+			return nil, fmt.Errorf("%w: group %d needs at least two symbols", ErrInvalidGroups, i+1)
+		}
+		for _, sym := range group {
+			sym = strings.ToUpper(strings.TrimSpace(sym))
+			if sym == "" {
+				return nil, fmt.Errorf("%w: group %d has an empty symbol", ErrInvalidGroups, i+1)
+			}
+			if _, ok := e[sym]; ok {
+				return nil, fmt.Errorf("%w: %s is in more than one group", ErrInvalidGroups, sym)
+			}
+			e[sym] = i
+		}
+	}
+	return e, nil
+}
+
+// Identical reports whether a and b are substantially identical.
+func (e Equivalents) Identical(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ga, ok := e[a]
+	if !ok {
+		return false
+	}
+	gb, ok := e[b]
+	return ok && ga == gb
+}
+
+// WashSale is a loss disallowed because substantially identical shares were
+// bought within the wash-sale window. The loss is deferred into the basis of
+// the replacement shares, whose holding period starts earlier by the time the
+// sold shares were held.
+type WashSale struct {
+	SaleID   string
+	LotID    string
+	Account  string
+	Symbol   string
+	Disposed time.Time
+	// Quantity is the number of sold shares matched with replacement shares.
+	Quantity float64
+	// Disallowed is the loss deferred into the replacement shares.
+	Disallowed          float64
+	ReplacementLotID    string
+	ReplacementAccount  string
+	ReplacementSymbol   string
+	ReplacementAcquired time.Time
+	// Permanent marks a loss disallowed by a purchase in a tax-advantaged
+	// account, which is lost rather than deferred.
+	Permanent bool
+}
+
+// OpenLot is an open lot with the losses deferred into it by wash sales.
+type OpenLot struct {
+	ledger.Lot
+	// BasisAdjustment is the deferred loss included in CostBasis.
+	BasisAdjustment float64
+	// HoldingSince starts the holding period of the lot's oldest shares,
+	// before Acquired for replacement shares of a wash sale.
+	HoldingSince time.Time
+}
+
+// LongTerm reports whether gains on the lot's oldest shares are long-term at
+// asOf.
+func (l OpenLot) LongTerm(asOf time.Time) bool {
+	return !l.Short() && ledger.HeldLongTerm(l.HoldingSince, asOf)
+}
+
+// Adjusted holds the gains, open lots and wash sales of a ledger after
+// wash-sale adjustments.
+type Adjusted struct {
+	Gains     []Gain
+	Lots      []OpenLot
+	WashSales []WashSale
+}
+
+// tranche is a part of a lot sharing one basis adjustment and holding period.
+type tranche struct {
+	quantity float64
+	deferred float64
+	since    time.Time
+	// replacement marks shares already matched with a loss sale, which
+	// cannot replace another.
+	replacement bool
+}
+
+// lotState follows the shares of a lot through the disposals.
+type lotState struct {
+	id       string
+	account  string
+	symbol   string
+	acquired time.Time
+	tranches []*tranche
+}
+
+// take removes qty shares from the lot, oldest holding period first, and
+// returns the parts removed.
+func (l *lotState) take(qty float64) []tranche {
+	sort.SliceStable(l.tranches, func(i, j int) bool { return l.tranches[i].since.Before(l.tranches[j].since) })
+	var parts []tranche
+	for _, t := range l.tranches {
+		if qty <= 1e-9 {
+			break
+		}
+		if t.quantity <= 1e-9 {
+			continue
+		}
+		q := math.Min(t.quantity, qty)
+		deferred := t.deferred * q / t.quantity
+		parts = append(parts, tranche{quantity: q, deferred: deferred, since: t.since, replacement: t.replacement})
+		t.deferred -= deferred
+		t.quantity -= q
+		qty -= q
+	}
+	if qty > 1e-9 {
+		// The lot grew outside the replay, e.g. by a split: the rest has no
+		// adjustment.
+		parts = append(parts, tranche{quantity: qty, since: l.acquired})
+	}
+	return parts
+}
+
+// AdjustWashSales applies the wash-sale rule to the disposals and open lots
+// of a ledger, across all its accounts. Disposals are taken in date order: a
+// loss on shares sold is disallowed, share for share, when substantially
+// identical shares were bought within WashSaleWindow before or after the sale,
+// earliest purchase first. Shares sold in the same sale and shares already
+// replacing an earlier loss do not count. The loss is added to the basis of the
+// replacement shares, which carry it into their own sale. Covering a short
+// sale is not adjusted. equivalents may be nil when only the same symbol is
+// identical.
+//
+// sheltered reports the tax-advantaged accounts, whose sales realize no
+// reportable gain. Their purchases still wash losses in other accounts, but
+// the loss is disallowed permanently instead of deferred. sheltered may be nil
+// when all accounts are taxable.
+func AdjustWashSales(lots []ledger.Lot, disposals []ledger.Disposal, equivalents Equivalents, sheltered func(account string) bool) Adjusted {
+	if sheltered == nil {
+		sheltered = func(string) bool { return false }
+	}
+	type key struct{ id, symbol string }
+	states := map[key]*lotState{}
+	state := func(id, account, symbol string, acquired time.Time) *lotState {
+		k := key{id, symbol}
+		st, ok := states[k]
+		if !ok {
+			st = &lotState{id: id, account: account, symbol: symbol, acquired: acquired}
+			states[k] = st
+		}
+		return st
+	}
+	// Rebuild each long lot as bought: shares disposed of plus shares held.
+	for _, d := range disposals {
+		if d.Short {
+			continue
+		}
+		st := state(d.LotID, d.Account, d.Symbol, d.Acquired)
+		st.tranches = appendShares(st.tranches, d.Quantity, d.Acquired)
+	}
+	for _, lot := range lots {
+		if lot.Short() {
+			continue
+		}
+		st := state(lot.ID, lot.Account, lot.Symbol, lot.Acquired)
+		st.tranches = appendShares(st.tranches, lot.Quantity, lot.Acquired)
+	}
+	candidates := make([]*lotState, 0, len(states))
+	for _, st := range states {
+		candidates = append(candidates, st)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if !a.acquired.Equal(b.acquired) {
+			return a.acquired.Before(b.acquired)
+		}
+		if a.id != b.id {
+			return a.id < b.id
+		}
+		return a.symbol < b.symbol
+	})
+	sold := map[string]map[*lotState]bool{}
+	for _, d := range disposals {
+		if d.Short {
+			continue
+		}
+		if sold[d.SaleID] == nil {
+			sold[d.SaleID] = map[*lotState]bool{}
+		}
+		sold[d.SaleID][states[key{d.LotID, d.Symbol}]] = true
+	}
+
+	order := make([]ledger.Disposal, len(disposals))
+	copy(order, disposals)
+	sort.SliceStable(order, func(i, j int) bool { return order[i].Disposed.Before(order[j].Disposed) })
+
+	var res Adjusted
+	for _, d := range order {
+		if sheltered(d.Account) {
+			if !d.Short {
+				states[key{d.LotID, d.Symbol}].take(d.Quantity)
+			}
+			continue
+		}
+		if d.Short {
+			res.Gains = append(res.Gains, newGain(d, d.Quantity, d.Proceeds, d.CostBasis, 0, d.Acquired))
+			continue
+		}
+		st := states[key{d.LotID, d.Symbol}]
+		for _, part := range st.take(d.Quantity) {
+			share := part.quantity / d.Quantity
+			g := newGain(d, part.quantity, d.Proceeds*share, d.CostBasis*share+part.deferred, part.deferred, part.since)
+			loss := -g.Amount()
+			if loss > 1e-9 {
+				unmatched := part.quantity
+				for _, r := range candidates {
+					if unmatched <= 1e-9 {
+						break
+					}
+					if sold[d.SaleID][r] || !equivalents.Identical(d.Symbol, r.symbol) ||
+						math.Abs(d.Disposed.Sub(r.acquired).Hours()) > WashSaleWindow.Hours() {
+						continue
+					}
+					q := math.Min(unmatched, r.available())
+					if q <= 1e-9 {
+						continue
+					}
+					disallowed := loss * q / part.quantity
+					permanent := sheltered(r.account)
+					if permanent {
+						r.replace(q, 0, r.acquired)
+					} else {
+						r.replace(q, disallowed, r.acquired.Add(-d.Disposed.Sub(part.since)))
+					}
+					g.WashSale += disallowed
+					res.WashSales = append(res.WashSales, WashSale{
+						SaleID:              d.SaleID,
+						LotID:               d.LotID,
+						Account:             d.Account,
+						Symbol:              d.Symbol,
+						Disposed:            d.Disposed,
+						Quantity:            q,
+						Disallowed:          disallowed,
+						ReplacementLotID:    r.id,
+						ReplacementAccount:  r.account,
+						ReplacementSymbol:   r.symbol,
+						ReplacementAcquired: r.acquired,
+						Permanent:           permanent,
+					})
+					unmatched -= q
+				}
+			}
+			res.Gains = append(res.Gains, g)
+		}
+	}
+
+	for _, lot := range lots {
+		open := OpenLot{Lot: lot, HoldingSince: lot.Acquired}
+		if st, ok := states[key{lot.ID, lot.Symbol}]; ok && !lot.Short() {
+			for _, t := range st.tranches {
+				if t.quantity <= 1e-9 {
+					continue
+				}
+				open.BasisAdjustment += t.deferred
+				if t.since.Before(open.HoldingSince) {
+					open.HoldingSince = t.since
+				}
+			}
+			open.CostBasis += open.BasisAdjustment
+		}
+		res.Lots = append(res.Lots, open)
+	}
+	return res
+}
+
+// appendShares adds shares to the unadjusted tranche of a lot.
+func appendShares(tranches []*tranche, qty float64, since time.Time) []*tranche {
+	if len(tranches) == 0 {
+		return []*tranche{{quantity: qty, since: since}}
+	}
+	tranches[0].quantity += qty
+	return tranches
+}
+
+// available returns the shares of the lot that can still replace sold shares.
+func (l *lotState) available() float64 {
+	total := 0.0
+	for _, t := range l.tranches {
+		if !t.replacement {
+			total += t.quantity
+		}
+	}
+	return total
+}
+
+// replace marks qty shares of the lot as replacement shares carrying the
+// deferred loss and an earlier holding period start.
+func (l *lotState) replace(qty, deferred float64, since time.Time) {
+	left := qty
+	for _, t := range l.tranches {
+		if left <= 1e-9 {
+			break
+		}
+		if t.replacement || t.quantity <= 1e-9 {
+			continue
+		}
+		q := math.Min(t.quantity, left)
+		t.quantity -= q
+		left -= q
+	}
+	l.tranches = append(l.tranches, &tranche{quantity: qty, deferred: deferred, since: since, replacement: true})
+}
+
+// newGain returns the gain on qty shares of a disposal.
+func newGain(d ledger.Disposal, qty, proceeds, basis, adjustment float64, since time.Time) Gain {
+	g := Gain{
+		Account:         d.Account,
+		Symbol:          d.Symbol,
+		LotID:           d.LotID,
+		SaleID:          d.SaleID,
+		Acquired:        d.Acquired,
+		Disposed:        d.Disposed,
+		Quantity:        qty,
+		Proceeds:        proceeds,
+		CostBasis:       basis,
+		BasisAdjustment: adjustment,
+		Term:            ShortTerm,
+		Short:           d.Short,
+	}
+	if !d.Short && ledger.HeldLongTerm(since, d.Disposed) {
+		g.Term = LongTerm
+	}
+	return g
+}